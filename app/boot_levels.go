@@ -23,6 +23,7 @@ import (
 	"github.com/cortezaproject/corteza-server/pkg/corredor"
 	"github.com/cortezaproject/corteza-server/pkg/dal"
 	"github.com/cortezaproject/corteza-server/pkg/dal/capabilities"
+	estore "github.com/cortezaproject/corteza-server/pkg/envoy/store"
 	"github.com/cortezaproject/corteza-server/pkg/eventbus"
 	"github.com/cortezaproject/corteza-server/pkg/geolocation"
 	"github.com/cortezaproject/corteza-server/pkg/healthcheck"
@@ -340,6 +341,17 @@ func (app *CortezaApp) InitServices(ctx context.Context) (err error) {
 		return err
 	}
 
+	{
+		var kk []dal.EncryptionKey
+		if kk, err = dal.ParseEncryptionKeys(app.Opt.DB.EncryptionKeys); err != nil {
+			return err
+		}
+
+		if err = dal.Service().SetEncryptionKeys(kk...); err != nil {
+			return err
+		}
+	}
+
 	if app.Opt.Auth.DefaultClient != "" {
 		// default client will help streamline authorization with default clients
 		app.DefaultAuthClient, err = store.LookupAuthClientByHandle(ctx, app.Store, app.Opt.Auth.DefaultClient)
@@ -493,6 +505,20 @@ func (app *CortezaApp) InitServices(ctx context.Context) (err error) {
 		return fmt.Errorf("could not initialize automation services: %w", err)
 	}
 
+	if app.Opt.Eventbus.SchedulerEnabled && autService.DefaultCluster != nil {
+		// scheduler ticks are dispatched only
+		// by one of the clustered instances
+		scheduler.Service().SetLeaser(autService.DefaultCluster)
+	}
+
+	if app.Opt.Federation.Enabled {
+		// Paired federation nodes can be used as DAL connections
+		// and must be registered before compose modules are loaded
+		if err = fedService.ReloadDALConnections(ctx, app.Store, dal.Service()); err != nil {
+			return fmt.Errorf("could not register federation node DAL connections: %w", err)
+		}
+	}
+
 	// Initializes compose services
 	//
 	// Note: this is a legacy approach, all services from all 3 apps
@@ -502,6 +528,10 @@ func (app *CortezaApp) InitServices(ctx context.Context) (err error) {
 		Discovery:  app.Opt.Discovery,
 		Storage:    app.Opt.ObjStore,
 		UserFinder: sysService.DefaultUser,
+
+		RecordImporter:   estore.ImportRecords,
+		NamespaceDecoder: estore.DecodeNamespace,
+		ResourceEncoder:  estore.EncodeResources,
 	})
 
 	if err != nil {
@@ -5,6 +5,8 @@ import (
 	"sync"
 
 	authCommands "github.com/cortezaproject/corteza-server/auth/commands"
+	automationCommands "github.com/cortezaproject/corteza-server/automation/commands"
+	composeCommands "github.com/cortezaproject/corteza-server/compose/commands"
 	federationCommands "github.com/cortezaproject/corteza-server/federation/commands"
 	"github.com/cortezaproject/corteza-server/pkg/actionlog"
 	"github.com/cortezaproject/corteza-server/pkg/api/server"
@@ -140,6 +142,8 @@ func (app *CortezaApp) InitCLI() {
 		provisionCmd,
 		authCommands.Command(ctx, app, storeInit),
 		federationCommands.Sync(ctx, app),
+		composeCommands.Records(ctx, app),
+		automationCommands.Workflows(ctx, app),
 		cli.EnvCommand(),
 		cli.VersionCommand(),
 		fakerCommands.Seeder(ctx, app),
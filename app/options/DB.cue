@@ -12,6 +12,14 @@ DB: schema.#optionsGroup & {
 			defaultValue: "sqlite3://file::memory:?cache=shared&mode=memory"
 			description:  "Database connection string."
 		}
+		encryption_keys: {
+			description: """
+				Comma separated list of keys used to encrypt values of encrypted module fields.
+				Each key is formatted as `<id>:<base64 encoded 32 byte key>`.
+				The first key is used for encryption, all keys are used for decryption.
+				To rotate keys, prepend a new key and re-encrypt existing values with `records reencrypt`.
+				"""
+		}
 	}
 	title: "Connection to data store backend"
 }
@@ -35,6 +35,22 @@ apigw: schema.#optionsGroup & {
 			defaultGoExpr: "false"
 			description:   "Profiler enabled for all routes"
 		}
+		profiler_max_hits: {
+			type:          "int"
+			defaultGoExpr: "1000"
+			description:   "Max number of profiled requests kept in memory, oldest are removed first"
+		}
+		profiler_retention: {
+			type:          "time.Duration"
+			defaultGoExpr: "time.Hour * 24"
+			defaultValue:  "24h"
+			description:   "How long are profiled requests kept, in memory and in store"
+		}
+		profiler_store: {
+			type:          "bool"
+			defaultGoExpr: "false"
+			description:   "Keep profiled requests in store, so they are kept after restart and shared between server instances; request bodies are not stored"
+		}
 		log_request_body: {
 			type:        "bool"
 			description: "Enable incoming request body output in logs"
@@ -6,6 +6,11 @@ import (
 
 workflow: schema.#optionsGroup & {
 	handle: "workflow"
+
+	imports: [
+		"\"time\"",
+	]
+
 	options: {
 		register: {
 			type:          "bool"
@@ -26,6 +31,26 @@ workflow: schema.#optionsGroup & {
 			defaultGoExpr: "true"
 			description:   "Enables execution stack trace construction"
 		}
+		cluster_enabled: {
+			type:        "bool"
+			description: """
+				Enables coordination of workflow sessions and scheduled events between
+				multiple server instances that share the same database.
+
+				Each session is executed by one instance at the time, prompts are routed
+				to the instance that runs the session and sessions of the instance that
+				stopped responding are adopted by other instances.
+				"""
+		}
+		cluster_node: {
+			description: "Unique name of the server instance. When not set, it is generated from the hostname on start."
+		}
+		cluster_lease_ttl: {
+			type:          "time.Duration"
+			defaultGoExpr: "time.Second * 30"
+			defaultValue:  "30s"
+			description:   "How long instance holds the session without renewal; sessions of the instance that does not renew them in this time are adopted by other instances."
+		}
 	}
 	title: "Workflow"
 }
@@ -0,0 +1,169 @@
+package automation
+
+// This file is auto-generated.
+//
+// Changes to this file may cause incorrect behavior and will be lost if
+// the code is regenerated.
+//
+// Definitions file that controls how this file is generated:
+// automation/automation/workflows_handler.yaml
+
+import (
+	"context"
+	atypes "github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/wfexec"
+)
+
+var _ wfexec.ExecResponse
+
+type (
+	workflowsHandlerRegistry interface {
+		AddFunctions(ff ...*atypes.Function)
+		Type(ref string) expr.Type
+	}
+)
+
+func (h workflowsHandler) register() {
+	h.reg.AddFunctions(
+		h.Exec(),
+	)
+}
+
+type (
+	workflowsExecArgs struct {
+		hasWorkflow    bool
+		Workflow       interface{}
+		workflowID     uint64
+		workflowHandle string
+
+		hasInput bool
+		Input    *expr.Vars
+
+		hasAsync bool
+		Async    bool
+
+		hasTrace bool
+		Trace    bool
+	}
+
+	workflowsExecResults struct {
+		Results   *expr.Vars
+		SessionID uint64
+	}
+)
+
+func (a workflowsExecArgs) GetWorkflow() (bool, uint64, string) {
+	return a.hasWorkflow, a.workflowID, a.workflowHandle
+}
+
+// Exec function Execute workflow
+//
+// expects implementation of exec function:
+// func (h workflowsHandler) exec(ctx context.Context, args *workflowsExecArgs) (results *workflowsExecResults, err error) {
+//    return
+// }
+func (h workflowsHandler) Exec() *atypes.Function {
+	return &atypes.Function{
+		Ref:    "workflowsExec",
+		Kind:   "function",
+		Labels: map[string]string{"workflow": "step,workflow"},
+		Meta: &atypes.FunctionMeta{
+			Short:       "Execute workflow",
+			Description: "Executes another workflow (sub-workflow) and returns its results.\nErrors from the sub-workflow can be handled by the calling workflow's error-handler step.",
+		},
+
+		Parameters: []*atypes.Param{
+			{
+				Name:  "workflow",
+				Types: []string{"ID", "Handle"}, Required: true,
+			},
+			{
+				Name:  "input",
+				Types: []string{"Vars"},
+			},
+			{
+				Name:  "async",
+				Types: []string{"Boolean"},
+			},
+			{
+				Name:  "trace",
+				Types: []string{"Boolean"},
+			},
+		},
+
+		Results: []*atypes.Param{
+
+			{
+				Name:  "results",
+				Types: []string{"Vars"},
+			},
+
+			{
+				Name:  "sessionID",
+				Types: []string{"ID"},
+			},
+		},
+
+		Handler: func(ctx context.Context, in *expr.Vars) (out *expr.Vars, err error) {
+			var (
+				args = &workflowsExecArgs{
+					hasWorkflow: in.Has("workflow"),
+					hasInput:    in.Has("input"),
+					hasAsync:    in.Has("async"),
+					hasTrace:    in.Has("trace"),
+				}
+			)
+
+			if err = in.Decode(args); err != nil {
+				return
+			}
+
+			// Converting Workflow argument
+			if args.hasWorkflow {
+				aux := expr.Must(expr.Select(in, "workflow"))
+				switch aux.Type() {
+				case h.reg.Type("ID").Type():
+					args.workflowID = aux.Get().(uint64)
+				case h.reg.Type("Handle").Type():
+					args.workflowHandle = aux.Get().(string)
+				}
+			}
+
+			var results *workflowsExecResults
+			if results, err = h.exec(ctx, args); err != nil {
+				return
+			}
+
+			out = &expr.Vars{}
+
+			{
+				// converting results.Results (*expr.Vars) to Vars
+				var (
+					tval expr.TypedValue
+				)
+
+				if tval, err = h.reg.Type("Vars").Cast(results.Results); err != nil {
+					return
+				} else if err = expr.Assign(out, "results", tval); err != nil {
+					return
+				}
+			}
+
+			{
+				// converting results.SessionID (uint64) to ID
+				var (
+					tval expr.TypedValue
+				)
+
+				if tval, err = h.reg.Type("ID").Cast(results.SessionID); err != nil {
+					return
+				} else if err = expr.Assign(out, "sessionID", tval); err != nil {
+					return
+				}
+			}
+
+			return
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package automation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+)
+
+type (
+	workflowsHandler struct {
+		reg workflowsHandlerRegistry
+		svc workflowExecutor
+	}
+
+	workflowExecutor interface {
+		ExecSubWorkflow(ctx context.Context, workflowID uint64, handle string, p types.WorkflowExecParams) (*expr.Vars, uint64, error)
+	}
+)
+
+func WorkflowsHandler(reg workflowsHandlerRegistry, svc workflowExecutor) *workflowsHandler {
+	h := &workflowsHandler{
+		reg: reg,
+		svc: svc,
+	}
+
+	h.register()
+	return h
+}
+
+// exec executes sub-workflow
+//
+// Results of the sub-workflow are returned only when it is executed synchronously
+func (h workflowsHandler) exec(ctx context.Context, args *workflowsExecArgs) (r *workflowsExecResults, err error) {
+	if !args.hasWorkflow {
+		return nil, fmt.Errorf("could not execute workflow, workflow ID or handle missing")
+	}
+
+	r = &workflowsExecResults{}
+
+	r.Results, r.SessionID, err = h.svc.ExecSubWorkflow(ctx, args.workflowID, args.workflowHandle, types.WorkflowExecParams{
+		Input: args.Input,
+		Async: args.Async,
+		Trace: args.Trace,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("sub-workflow failed: %w", err)
+	}
+
+	if r.Results == nil {
+		r.Results = &expr.Vars{}
+	}
+
+	return
+}
@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cortezaproject/corteza-server/automation/service"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/cli"
+	"github.com/spf13/cobra"
+)
+
+type (
+	serviceInitializer interface {
+		InitServices(ctx context.Context) error
+	}
+)
+
+func Workflows(ctx context.Context, app serviceInitializer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "workflows",
+		Aliases: []string{"workflow"},
+		Short:   "Workflow management",
+	}
+
+	cmd.AddCommand(workflowsTest(ctx, app))
+
+	return cmd
+}
+
+func workflowsTest(ctx context.Context, app serviceInitializer) *cobra.Command {
+	var (
+		verbose bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "test [workflow-ID] [test-case-ID...]",
+		Short: "Run workflow test cases in a sandbox",
+		Long: "Runs test cases of the workflow draft. Functions used by the workflow are intercepted;\n" +
+			"mocked functions return configured values and other functions fail unless passed through.",
+		Args:    cobra.MinimumNArgs(1),
+		PreRunE: commandPreRunInitService(app),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx = auth.SetIdentityToContext(ctx, auth.ServiceUser())
+
+			ids := make([]uint64, len(args))
+			for i, arg := range args {
+				var err error
+				ids[i], err = strconv.ParseUint(arg, 10, 64)
+				cli.HandleError(err)
+			}
+
+			rr, err := service.DefaultWorkflow.RunTestCases(ctx, ids[0], ids[1:]...)
+			cli.HandleError(err)
+
+			var failed int
+			for _, r := range rr {
+				status := "PASS"
+				if !r.Passed {
+					status = "FAIL"
+					failed++
+				}
+
+				cmd.Printf("%s %-20d %s (%dms)\n", status, r.TestCaseID, r.Name, r.ElapsedTime)
+				for _, f := range r.Failures {
+					cmd.Printf("     - %s\n", f)
+				}
+
+				if r.Error != "" {
+					cmd.Printf("     error: %s\n", r.Error)
+				}
+
+				if verbose || !r.Passed {
+					cmd.Print(r.Trace.String())
+				}
+			}
+
+			cmd.Printf("%d test case(s), %d failed\n", len(rr), failed)
+			if failed > 0 {
+				cli.HandleError(fmt.Errorf("workflow test cases failed"))
+			}
+		},
+	}
+
+	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Print step trace of passed test cases")
+
+	return cmd
+}
+
+func commandPreRunInitService(app serviceInitializer) func(*cobra.Command, []string) error {
+	return func(_ *cobra.Command, _ []string) error {
+		return app.InitServices(cli.Context())
+	}
+}
@@ -8,9 +8,14 @@ component: schema.#component & {
 	handle: "automation"
 
 	resources: {
-		"workflow": workflow
-		"session":  session
-		"trigger":  trigger
+		"workflow":           workflow
+		"workflow-revision":  workflowRevision
+		"workflow-test-case": workflowTestCase
+		"session":            session
+		"session-resume":     sessionResume
+		"lease":              lease
+		"task":               task
+		"trigger":            trigger
 	}
 
 	rbac: operations: {
@@ -0,0 +1,85 @@
+package automation
+
+import (
+	"github.com/cortezaproject/corteza-server/codegen/schema"
+)
+
+lease: schema.#Resource & {
+	features: {
+		labels: false
+		paging: false
+		sorting: false
+		checkFn: false
+	}
+
+	struct: {
+		name:       { primaryKey: true }
+		owner:      {}
+		expires_at: schema.SortableTimestampField
+		created_at: schema.SortableTimestampField
+		updated_at: schema.SortableTimestampNilField
+	}
+
+	filter: {
+		struct: {
+			owner: {}
+		}
+
+		byValue: ["owner"]
+	}
+
+	store: {
+		ident: "automationLease"
+
+		settings: {
+			rdbms: {
+				table: "automation_leases"
+			}
+		}
+
+		api: {
+			lookups: [
+				{
+					fields: ["name"]
+					description: """
+						searches for lease by name
+
+						It returns lease even if expired
+						"""
+				},
+			]
+
+			functions: [
+				{
+					expIdent: "AcquireAutomationLease"
+					description: """
+						creates or renews a lease
+
+						Returns true if lease is acquired; when lease is held by
+						another owner and did not expire yet, false is returned
+						"""
+					args: [
+						{ ident: "lease", goType: "*types.Lease" },
+					]
+					return: [ "bool" ]
+				},
+				{
+					expIdent: "ReleaseAutomationLease"
+					description: """
+						removes the lease if held by the owner
+						"""
+					args: [
+						{ ident: "name",  goType: "string" },
+						{ ident: "owner", goType: "string" },
+					]
+				},
+				{
+					expIdent: "DeleteExpiredAutomationLeases"
+					description: """
+						removes all expired leases
+						"""
+				},
+			]
+		}
+	}
+}
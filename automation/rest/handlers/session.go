@@ -23,6 +23,12 @@ type (
 		Read(context.Context, *request.SessionRead) (interface{}, error)
 		Trace(context.Context, *request.SessionTrace) (interface{}, error)
 		Delete(context.Context, *request.SessionDelete) (interface{}, error)
+		DebugState(context.Context, *request.SessionDebugState) (interface{}, error)
+		Breakpoints(context.Context, *request.SessionBreakpoints) (interface{}, error)
+		StateScope(context.Context, *request.SessionStateScope) (interface{}, error)
+		StepState(context.Context, *request.SessionStepState) (interface{}, error)
+		ContinueState(context.Context, *request.SessionContinueState) (interface{}, error)
+		Replay(context.Context, *request.SessionReplay) (interface{}, error)
 		ListPrompts(context.Context, *request.SessionListPrompts) (interface{}, error)
 		ResumeState(context.Context, *request.SessionResumeState) (interface{}, error)
 		DeleteState(context.Context, *request.SessionDeleteState) (interface{}, error)
@@ -30,13 +36,19 @@ type (
 
 	// HTTP API interface
 	Session struct {
-		List        func(http.ResponseWriter, *http.Request)
-		Read        func(http.ResponseWriter, *http.Request)
-		Trace       func(http.ResponseWriter, *http.Request)
-		Delete      func(http.ResponseWriter, *http.Request)
-		ListPrompts func(http.ResponseWriter, *http.Request)
-		ResumeState func(http.ResponseWriter, *http.Request)
-		DeleteState func(http.ResponseWriter, *http.Request)
+		List          func(http.ResponseWriter, *http.Request)
+		Read          func(http.ResponseWriter, *http.Request)
+		Trace         func(http.ResponseWriter, *http.Request)
+		Delete        func(http.ResponseWriter, *http.Request)
+		DebugState    func(http.ResponseWriter, *http.Request)
+		Breakpoints   func(http.ResponseWriter, *http.Request)
+		StateScope    func(http.ResponseWriter, *http.Request)
+		StepState     func(http.ResponseWriter, *http.Request)
+		ContinueState func(http.ResponseWriter, *http.Request)
+		Replay        func(http.ResponseWriter, *http.Request)
+		ListPrompts   func(http.ResponseWriter, *http.Request)
+		ResumeState   func(http.ResponseWriter, *http.Request)
+		DeleteState   func(http.ResponseWriter, *http.Request)
 	}
 )
 
@@ -106,6 +118,102 @@ func NewSession(h SessionAPI) *Session {
 
 			api.Send(w, r, value)
 		},
+		DebugState: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewSessionDebugState()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.DebugState(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Breakpoints: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewSessionBreakpoints()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Breakpoints(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		StateScope: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewSessionStateScope()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.StateScope(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		StepState: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewSessionStepState()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.StepState(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		ContinueState: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewSessionContinueState()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.ContinueState(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Replay: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewSessionReplay()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Replay(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
 		ListPrompts: func(w http.ResponseWriter, r *http.Request) {
 			defer r.Body.Close()
 			params := request.NewSessionListPrompts()
@@ -164,6 +272,12 @@ func (h Session) MountRoutes(r chi.Router, middlewares ...func(http.Handler) htt
 		r.Get("/sessions/{sessionID}", h.Read)
 		r.Get("/sessions/{sessionID}/trace", h.Trace)
 		r.Delete("/sessions/{sessionID}", h.Delete)
+		r.Get("/sessions/{sessionID}/debug", h.DebugState)
+		r.Put("/sessions/{sessionID}/debug/breakpoints", h.Breakpoints)
+		r.Patch("/sessions/{sessionID}/debug/state/{stateID}/scope", h.StateScope)
+		r.Post("/sessions/{sessionID}/debug/state/{stateID}/step", h.StepState)
+		r.Post("/sessions/{sessionID}/debug/state/{stateID}/continue", h.ContinueState)
+		r.Post("/sessions/{sessionID}/replay", h.Replay)
 		r.Get("/sessions/prompts", h.ListPrompts)
 		r.Post("/sessions/{sessionID}/state/{stateID}", h.ResumeState)
 		r.Delete("/sessions/{sessionID}/state/{stateID}", h.DeleteState)
@@ -25,6 +25,7 @@ type (
 		Read(context.Context, *request.TriggerRead) (interface{}, error)
 		Delete(context.Context, *request.TriggerDelete) (interface{}, error)
 		Undelete(context.Context, *request.TriggerUndelete) (interface{}, error)
+		Schedule(context.Context, *request.TriggerSchedule) (interface{}, error)
 	}
 
 	// HTTP API interface
@@ -35,6 +36,7 @@ type (
 		Read     func(http.ResponseWriter, *http.Request)
 		Delete   func(http.ResponseWriter, *http.Request)
 		Undelete func(http.ResponseWriter, *http.Request)
+		Schedule func(http.ResponseWriter, *http.Request)
 	}
 )
 
@@ -134,6 +136,22 @@ func NewTrigger(h TriggerAPI) *Trigger {
 				return
 			}
 
+			api.Send(w, r, value)
+		},
+		Schedule: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewTriggerSchedule()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Schedule(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
 			api.Send(w, r, value)
 		},
 	}
@@ -148,5 +166,6 @@ func (h Trigger) MountRoutes(r chi.Router, middlewares ...func(http.Handler) htt
 		r.Get("/triggers/{triggerID}", h.Read)
 		r.Delete("/triggers/{triggerID}", h.Delete)
 		r.Post("/triggers/{triggerID}/undelete", h.Undelete)
+		r.Get("/triggers/{triggerID}/schedule", h.Schedule)
 	})
 }
@@ -25,20 +25,40 @@ type (
 		Read(context.Context, *request.WorkflowRead) (interface{}, error)
 		Delete(context.Context, *request.WorkflowDelete) (interface{}, error)
 		Undelete(context.Context, *request.WorkflowUndelete) (interface{}, error)
+		Publish(context.Context, *request.WorkflowPublish) (interface{}, error)
+		Revisions(context.Context, *request.WorkflowRevisions) (interface{}, error)
+		RevisionsDiff(context.Context, *request.WorkflowRevisionsDiff) (interface{}, error)
+		Rollback(context.Context, *request.WorkflowRollback) (interface{}, error)
+		TestCases(context.Context, *request.WorkflowTestCases) (interface{}, error)
+		TestCaseCreate(context.Context, *request.WorkflowTestCaseCreate) (interface{}, error)
+		TestCaseUpdate(context.Context, *request.WorkflowTestCaseUpdate) (interface{}, error)
+		TestCaseDelete(context.Context, *request.WorkflowTestCaseDelete) (interface{}, error)
+		TestCasesRun(context.Context, *request.WorkflowTestCasesRun) (interface{}, error)
 		Test(context.Context, *request.WorkflowTest) (interface{}, error)
 		Exec(context.Context, *request.WorkflowExec) (interface{}, error)
+		Debug(context.Context, *request.WorkflowDebug) (interface{}, error)
 	}
 
 	// HTTP API interface
 	Workflow struct {
-		List     func(http.ResponseWriter, *http.Request)
-		Create   func(http.ResponseWriter, *http.Request)
-		Update   func(http.ResponseWriter, *http.Request)
-		Read     func(http.ResponseWriter, *http.Request)
-		Delete   func(http.ResponseWriter, *http.Request)
-		Undelete func(http.ResponseWriter, *http.Request)
-		Test     func(http.ResponseWriter, *http.Request)
-		Exec     func(http.ResponseWriter, *http.Request)
+		List           func(http.ResponseWriter, *http.Request)
+		Create         func(http.ResponseWriter, *http.Request)
+		Update         func(http.ResponseWriter, *http.Request)
+		Read           func(http.ResponseWriter, *http.Request)
+		Delete         func(http.ResponseWriter, *http.Request)
+		Undelete       func(http.ResponseWriter, *http.Request)
+		Publish        func(http.ResponseWriter, *http.Request)
+		Revisions      func(http.ResponseWriter, *http.Request)
+		RevisionsDiff  func(http.ResponseWriter, *http.Request)
+		Rollback       func(http.ResponseWriter, *http.Request)
+		TestCases      func(http.ResponseWriter, *http.Request)
+		TestCaseCreate func(http.ResponseWriter, *http.Request)
+		TestCaseUpdate func(http.ResponseWriter, *http.Request)
+		TestCaseDelete func(http.ResponseWriter, *http.Request)
+		TestCasesRun   func(http.ResponseWriter, *http.Request)
+		Test           func(http.ResponseWriter, *http.Request)
+		Exec           func(http.ResponseWriter, *http.Request)
+		Debug          func(http.ResponseWriter, *http.Request)
 	}
 )
 
@@ -140,6 +160,150 @@ func NewWorkflow(h WorkflowAPI) *Workflow {
 
 			api.Send(w, r, value)
 		},
+		Publish: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWorkflowPublish()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Publish(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Revisions: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWorkflowRevisions()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Revisions(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		RevisionsDiff: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWorkflowRevisionsDiff()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.RevisionsDiff(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Rollback: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWorkflowRollback()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Rollback(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		TestCases: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWorkflowTestCases()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.TestCases(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		TestCaseCreate: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWorkflowTestCaseCreate()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.TestCaseCreate(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		TestCaseUpdate: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWorkflowTestCaseUpdate()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.TestCaseUpdate(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		TestCaseDelete: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWorkflowTestCaseDelete()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.TestCaseDelete(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		TestCasesRun: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWorkflowTestCasesRun()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.TestCasesRun(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
 		Test: func(w http.ResponseWriter, r *http.Request) {
 			defer r.Body.Close()
 			params := request.NewWorkflowTest()
@@ -170,6 +334,22 @@ func NewWorkflow(h WorkflowAPI) *Workflow {
 				return
 			}
 
+			api.Send(w, r, value)
+		},
+		Debug: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewWorkflowDebug()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Debug(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
 			api.Send(w, r, value)
 		},
 	}
@@ -184,7 +364,17 @@ func (h Workflow) MountRoutes(r chi.Router, middlewares ...func(http.Handler) ht
 		r.Get("/workflows/{workflowID}", h.Read)
 		r.Delete("/workflows/{workflowID}", h.Delete)
 		r.Post("/workflows/{workflowID}/undelete", h.Undelete)
+		r.Post("/workflows/{workflowID}/publish", h.Publish)
+		r.Get("/workflows/{workflowID}/revisions", h.Revisions)
+		r.Get("/workflows/{workflowID}/revisions/diff", h.RevisionsDiff)
+		r.Post("/workflows/{workflowID}/revisions/{revision}/rollback", h.Rollback)
+		r.Get("/workflows/{workflowID}/test-cases", h.TestCases)
+		r.Post("/workflows/{workflowID}/test-cases", h.TestCaseCreate)
+		r.Put("/workflows/{workflowID}/test-cases/{testCaseID}", h.TestCaseUpdate)
+		r.Delete("/workflows/{workflowID}/test-cases/{testCaseID}", h.TestCaseDelete)
+		r.Post("/workflows/{workflowID}/test-cases/run", h.TestCasesRun)
 		r.Post("/workflows/{workflowID}/test", h.Test)
 		r.Post("/workflows/{workflowID}/exec", h.Exec)
+		r.Post("/workflows/{workflowID}/debug", h.Debug)
 	})
 }
@@ -46,6 +46,11 @@ type (
 		// Filter by workflow ID
 		WorkflowID []string
 
+		// CallerSessionID GET parameter
+		//
+		// Filter by ID of the session that invoked the sub-workflow
+		CallerSessionID []string
+
 		// CreatedBy GET parameter
 		//
 		// Filter by creators ID
@@ -58,7 +63,7 @@ type (
 
 		// Status GET parameter
 		//
-		// Filter by status: started (0), prompted (1), suspended (2), failed (3) and completed (4)
+		// Filter by status: started (0), prompted (1), suspended (2), failed (3), completed (4) and paused (5)
 		Status []uint
 
 		// EventType GET parameter
@@ -108,6 +113,88 @@ type (
 		SessionID uint64 `json:",string"`
 	}
 
+	SessionDebugState struct {
+		// SessionID PATH parameter
+		//
+		// Session ID
+		SessionID uint64 `json:",string"`
+	}
+
+	SessionBreakpoints struct {
+		// SessionID PATH parameter
+		//
+		// Session ID
+		SessionID uint64 `json:",string"`
+
+		// StepID POST parameter
+		//
+		// Steps to pause the session on
+		StepID []string
+	}
+
+	SessionStateScope struct {
+		// SessionID PATH parameter
+		//
+		// Session ID
+		SessionID uint64 `json:",string"`
+
+		// StateID PATH parameter
+		//
+		// State ID
+		StateID uint64 `json:",string"`
+
+		// Scope POST parameter
+		//
+		// Variables merged into the scope
+		Scope *expr.Vars
+	}
+
+	SessionStepState struct {
+		// SessionID PATH parameter
+		//
+		// Session ID
+		SessionID uint64 `json:",string"`
+
+		// StateID PATH parameter
+		//
+		// State ID
+		StateID uint64 `json:",string"`
+	}
+
+	SessionContinueState struct {
+		// SessionID PATH parameter
+		//
+		// Session ID
+		SessionID uint64 `json:",string"`
+
+		// StateID PATH parameter
+		//
+		// State ID
+		StateID uint64 `json:",string"`
+	}
+
+	SessionReplay struct {
+		// SessionID PATH parameter
+		//
+		// Session ID
+		SessionID uint64 `json:",string"`
+
+		// Frame POST parameter
+		//
+		// Index of the stacktrace frame
+		Frame uint
+
+		// Input POST parameter
+		//
+		// Variables merged into the scope of the frame
+		Input *expr.Vars
+
+		// Breakpoints POST parameter
+		//
+		// Steps to pause the new session on
+		Breakpoints []string
+	}
+
 	SessionListPrompts struct {
 	}
 
@@ -149,16 +236,17 @@ func NewSessionList() *SessionList {
 // Auditable returns all auditable/loggable parameters
 func (r SessionList) Auditable() map[string]interface{} {
 	return map[string]interface{}{
-		"sessionID":    r.SessionID,
-		"workflowID":   r.WorkflowID,
-		"createdBy":    r.CreatedBy,
-		"completed":    r.Completed,
-		"status":       r.Status,
-		"eventType":    r.EventType,
-		"resourceType": r.ResourceType,
-		"limit":        r.Limit,
-		"pageCursor":   r.PageCursor,
-		"sort":         r.Sort,
+		"sessionID":       r.SessionID,
+		"workflowID":      r.WorkflowID,
+		"callerSessionID": r.CallerSessionID,
+		"createdBy":       r.CreatedBy,
+		"completed":       r.Completed,
+		"status":          r.Status,
+		"eventType":       r.EventType,
+		"resourceType":    r.ResourceType,
+		"limit":           r.Limit,
+		"pageCursor":      r.PageCursor,
+		"sort":            r.Sort,
 	}
 }
 
@@ -172,6 +260,11 @@ func (r SessionList) GetWorkflowID() []string {
 	return r.WorkflowID
 }
 
+// Auditable returns all auditable/loggable parameters
+func (r SessionList) GetCallerSessionID() []string {
+	return r.CallerSessionID
+}
+
 // Auditable returns all auditable/loggable parameters
 func (r SessionList) GetCreatedBy() []string {
 	return r.CreatedBy
@@ -241,6 +334,17 @@ func (r *SessionList) Fill(req *http.Request) (err error) {
 				return err
 			}
 		}
+		if val, ok := tmp["callerSessionID[]"]; ok {
+			r.CallerSessionID, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["callerSessionID"]; ok {
+			r.CallerSessionID, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
 		if val, ok := tmp["createdBy[]"]; ok {
 			r.CreatedBy, err = val, nil
 			if err != nil {
@@ -409,6 +513,444 @@ func (r *SessionDelete) Fill(req *http.Request) (err error) {
 	return err
 }
 
+// NewSessionDebugState request
+func NewSessionDebugState() *SessionDebugState {
+	return &SessionDebugState{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionDebugState) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"sessionID": r.SessionID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionDebugState) GetSessionID() uint64 {
+	return r.SessionID
+}
+
+// Fill processes request and fills internal variables
+func (r *SessionDebugState) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "sessionID")
+		r.SessionID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewSessionBreakpoints request
+func NewSessionBreakpoints() *SessionBreakpoints {
+	return &SessionBreakpoints{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionBreakpoints) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"sessionID": r.SessionID,
+		"stepID":    r.StepID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionBreakpoints) GetSessionID() uint64 {
+	return r.SessionID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionBreakpoints) GetStepID() []string {
+	return r.StepID
+}
+
+// Fill processes request and fills internal variables
+func (r *SessionBreakpoints) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		//if val, ok := req.Form["stepID[]"]; ok && len(val) > 0  {
+		//    r.StepID, err = val, nil
+		//    if err != nil {
+		//        return err
+		//    }
+		//}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "sessionID")
+		r.SessionID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewSessionStateScope request
+func NewSessionStateScope() *SessionStateScope {
+	return &SessionStateScope{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionStateScope) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"sessionID": r.SessionID,
+		"stateID":   r.StateID,
+		"scope":     r.Scope,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionStateScope) GetSessionID() uint64 {
+	return r.SessionID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionStateScope) GetStateID() uint64 {
+	return r.StateID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionStateScope) GetScope() *expr.Vars {
+	return r.Scope
+}
+
+// Fill processes request and fills internal variables
+func (r *SessionStateScope) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+			if val, ok := req.MultipartForm.Value["scope[]"]; ok {
+				r.Scope, err = types.ParseWorkflowVariables(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["scope"]; ok {
+				r.Scope, err = types.ParseWorkflowVariables(val)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		if val, ok := req.Form["scope[]"]; ok {
+			r.Scope, err = types.ParseWorkflowVariables(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["scope"]; ok {
+			r.Scope, err = types.ParseWorkflowVariables(val)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "sessionID")
+		r.SessionID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "stateID")
+		r.StateID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewSessionStepState request
+func NewSessionStepState() *SessionStepState {
+	return &SessionStepState{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionStepState) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"sessionID": r.SessionID,
+		"stateID":   r.StateID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionStepState) GetSessionID() uint64 {
+	return r.SessionID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionStepState) GetStateID() uint64 {
+	return r.StateID
+}
+
+// Fill processes request and fills internal variables
+func (r *SessionStepState) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "sessionID")
+		r.SessionID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "stateID")
+		r.StateID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewSessionContinueState request
+func NewSessionContinueState() *SessionContinueState {
+	return &SessionContinueState{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionContinueState) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"sessionID": r.SessionID,
+		"stateID":   r.StateID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionContinueState) GetSessionID() uint64 {
+	return r.SessionID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionContinueState) GetStateID() uint64 {
+	return r.StateID
+}
+
+// Fill processes request and fills internal variables
+func (r *SessionContinueState) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "sessionID")
+		r.SessionID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "stateID")
+		r.StateID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewSessionReplay request
+func NewSessionReplay() *SessionReplay {
+	return &SessionReplay{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionReplay) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"sessionID":   r.SessionID,
+		"frame":       r.Frame,
+		"input":       r.Input,
+		"breakpoints": r.Breakpoints,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionReplay) GetSessionID() uint64 {
+	return r.SessionID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionReplay) GetFrame() uint {
+	return r.Frame
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionReplay) GetInput() *expr.Vars {
+	return r.Input
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SessionReplay) GetBreakpoints() []string {
+	return r.Breakpoints
+}
+
+// Fill processes request and fills internal variables
+func (r *SessionReplay) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+			if val, ok := req.MultipartForm.Value["frame"]; ok && len(val) > 0 {
+				r.Frame, err = payload.ParseUint(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["input[]"]; ok {
+				r.Input, err = types.ParseWorkflowVariables(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["input"]; ok {
+				r.Input, err = types.ParseWorkflowVariables(val)
+				if err != nil {
+					return err
+				}
+			}
+
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		if val, ok := req.Form["frame"]; ok && len(val) > 0 {
+			r.Frame, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["input[]"]; ok {
+			r.Input, err = types.ParseWorkflowVariables(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["input"]; ok {
+			r.Input, err = types.ParseWorkflowVariables(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		//if val, ok := req.Form["breakpoints[]"]; ok && len(val) > 0  {
+		//    r.Breakpoints, err = val, nil
+		//    if err != nil {
+		//        return err
+		//    }
+		//}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "sessionID")
+		r.SessionID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
 // NewSessionListPrompts request
 func NewSessionListPrompts() *SessionListPrompts {
 	return &SessionListPrompts{}
@@ -0,0 +1,679 @@
+package request
+
+// This file is auto-generated.
+//
+// Changes to this file may cause incorrect behavior and will be lost if
+// the code is regenerated.
+//
+// Definitions file that controls how this file is generated:
+//
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/payload"
+	"github.com/go-chi/chi/v5"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+)
+
+// dummy vars to prevent
+// unused imports complain
+var (
+	_ = chi.URLParam
+	_ = multipart.ErrMessageTooLarge
+	_ = payload.ParseUint64s
+	_ = strings.ToLower
+	_ = io.EOF
+	_ = fmt.Errorf
+	_ = json.NewEncoder
+)
+
+type (
+	// Internal API interface
+	TaskList struct {
+		// TaskID GET parameter
+		//
+		// Filter by task ID
+		TaskID []string
+
+		// SessionID GET parameter
+		//
+		// Filter by session ID
+		SessionID []string
+
+		// WorkflowID GET parameter
+		//
+		// Filter by workflow ID
+		WorkflowID []string
+
+		// AssignedUserID GET parameter
+		//
+		// Filter by assigned user ID
+		AssignedUserID []string
+
+		// AssignedRoleID GET parameter
+		//
+		// Filter by assigned role ID
+		AssignedRoleID []string
+
+		// Status GET parameter
+		//
+		// Filter by status: pending, claimed, completed, escalated or canceled
+		Status []string
+
+		// Completed GET parameter
+		//
+		// Exclude (0, default), include (1) or return only (2) completed tasks
+		Completed uint
+
+		// Limit GET parameter
+		//
+		// Limit
+		Limit uint
+	}
+
+	TaskInbox struct {
+		// WorkflowID GET parameter
+		//
+		// Filter by workflow ID
+		WorkflowID []string
+
+		// AssignedRoleID GET parameter
+		//
+		// Filter by assigned role ID
+		AssignedRoleID []string
+
+		// Status GET parameter
+		//
+		// Filter by status: pending, claimed, completed, escalated or canceled
+		Status []string
+
+		// Completed GET parameter
+		//
+		// Exclude (0, default), include (1) or return only (2) completed tasks
+		Completed uint
+
+		// Limit GET parameter
+		//
+		// Limit
+		Limit uint
+	}
+
+	TaskRead struct {
+		// TaskID PATH parameter
+		//
+		// Task ID
+		TaskID uint64 `json:",string"`
+	}
+
+	TaskClaim struct {
+		// TaskID PATH parameter
+		//
+		// Task ID
+		TaskID uint64 `json:",string"`
+	}
+
+	TaskRelease struct {
+		// TaskID PATH parameter
+		//
+		// Task ID
+		TaskID uint64 `json:",string"`
+	}
+
+	TaskDelegate struct {
+		// TaskID PATH parameter
+		//
+		// Task ID
+		TaskID uint64 `json:",string"`
+
+		// UserID POST parameter
+		//
+		// User to delegate the task to
+		UserID uint64 `json:",string"`
+	}
+
+	TaskComplete struct {
+		// TaskID PATH parameter
+		//
+		// Task ID
+		TaskID uint64 `json:",string"`
+
+		// Input POST parameter
+		//
+		// Prompt variables
+		Input *expr.Vars
+	}
+)
+
+// NewTaskList request
+func NewTaskList() *TaskList {
+	return &TaskList{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskList) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"taskID":         r.TaskID,
+		"sessionID":      r.SessionID,
+		"workflowID":     r.WorkflowID,
+		"assignedUserID": r.AssignedUserID,
+		"assignedRoleID": r.AssignedRoleID,
+		"status":         r.Status,
+		"completed":      r.Completed,
+		"limit":          r.Limit,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskList) GetTaskID() []string {
+	return r.TaskID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskList) GetSessionID() []string {
+	return r.SessionID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskList) GetWorkflowID() []string {
+	return r.WorkflowID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskList) GetAssignedUserID() []string {
+	return r.AssignedUserID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskList) GetAssignedRoleID() []string {
+	return r.AssignedRoleID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskList) GetStatus() []string {
+	return r.Status
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskList) GetCompleted() uint {
+	return r.Completed
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskList) GetLimit() uint {
+	return r.Limit
+}
+
+// Fill processes request and fills internal variables
+func (r *TaskList) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["taskID[]"]; ok {
+			r.TaskID, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["taskID"]; ok {
+			r.TaskID, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["sessionID[]"]; ok {
+			r.SessionID, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["sessionID"]; ok {
+			r.SessionID, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["workflowID[]"]; ok {
+			r.WorkflowID, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["workflowID"]; ok {
+			r.WorkflowID, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["assignedUserID[]"]; ok {
+			r.AssignedUserID, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["assignedUserID"]; ok {
+			r.AssignedUserID, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["assignedRoleID[]"]; ok {
+			r.AssignedRoleID, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["assignedRoleID"]; ok {
+			r.AssignedRoleID, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["status[]"]; ok {
+			r.Status, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["status"]; ok {
+			r.Status, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["completed"]; ok && len(val) > 0 {
+			r.Completed, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["limit"]; ok && len(val) > 0 {
+			r.Limit, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return err
+}
+
+// NewTaskInbox request
+func NewTaskInbox() *TaskInbox {
+	return &TaskInbox{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskInbox) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"workflowID":     r.WorkflowID,
+		"assignedRoleID": r.AssignedRoleID,
+		"status":         r.Status,
+		"completed":      r.Completed,
+		"limit":          r.Limit,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskInbox) GetWorkflowID() []string {
+	return r.WorkflowID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskInbox) GetAssignedRoleID() []string {
+	return r.AssignedRoleID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskInbox) GetStatus() []string {
+	return r.Status
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskInbox) GetCompleted() uint {
+	return r.Completed
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskInbox) GetLimit() uint {
+	return r.Limit
+}
+
+// Fill processes request and fills internal variables
+func (r *TaskInbox) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["workflowID[]"]; ok {
+			r.WorkflowID, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["workflowID"]; ok {
+			r.WorkflowID, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["assignedRoleID[]"]; ok {
+			r.AssignedRoleID, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["assignedRoleID"]; ok {
+			r.AssignedRoleID, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["status[]"]; ok {
+			r.Status, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["status"]; ok {
+			r.Status, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["completed"]; ok && len(val) > 0 {
+			r.Completed, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["limit"]; ok && len(val) > 0 {
+			r.Limit, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return err
+}
+
+// NewTaskRead request
+func NewTaskRead() *TaskRead {
+	return &TaskRead{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskRead) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"taskID": r.TaskID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskRead) GetTaskID() uint64 {
+	return r.TaskID
+}
+
+// Fill processes request and fills internal variables
+func (r *TaskRead) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "taskID")
+		r.TaskID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewTaskClaim request
+func NewTaskClaim() *TaskClaim {
+	return &TaskClaim{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskClaim) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"taskID": r.TaskID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskClaim) GetTaskID() uint64 {
+	return r.TaskID
+}
+
+// Fill processes request and fills internal variables
+func (r *TaskClaim) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "taskID")
+		r.TaskID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewTaskRelease request
+func NewTaskRelease() *TaskRelease {
+	return &TaskRelease{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskRelease) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"taskID": r.TaskID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskRelease) GetTaskID() uint64 {
+	return r.TaskID
+}
+
+// Fill processes request and fills internal variables
+func (r *TaskRelease) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "taskID")
+		r.TaskID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewTaskDelegate request
+func NewTaskDelegate() *TaskDelegate {
+	return &TaskDelegate{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskDelegate) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"taskID": r.TaskID,
+		"userID": r.UserID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskDelegate) GetTaskID() uint64 {
+	return r.TaskID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskDelegate) GetUserID() uint64 {
+	return r.UserID
+}
+
+// Fill processes request and fills internal variables
+func (r *TaskDelegate) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+			if val, ok := req.MultipartForm.Value["userID"]; ok && len(val) > 0 {
+				r.UserID, err = payload.ParseUint64(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		if val, ok := req.Form["userID"]; ok && len(val) > 0 {
+			r.UserID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "taskID")
+		r.TaskID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewTaskComplete request
+func NewTaskComplete() *TaskComplete {
+	return &TaskComplete{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskComplete) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"taskID": r.TaskID,
+		"input":  r.Input,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskComplete) GetTaskID() uint64 {
+	return r.TaskID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TaskComplete) GetInput() *expr.Vars {
+	return r.Input
+}
+
+// Fill processes request and fills internal variables
+func (r *TaskComplete) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+			if val, ok := req.MultipartForm.Value["input[]"]; ok {
+				r.Input, err = types.ParseWorkflowVariables(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["input"]; ok {
+				r.Input, err = types.ParseWorkflowVariables(val)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		if val, ok := req.Form["input[]"]; ok {
+			r.Input, err = types.ParseWorkflowVariables(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["input"]; ok {
+			r.Input, err = types.ParseWorkflowVariables(val)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "taskID")
+		r.TaskID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
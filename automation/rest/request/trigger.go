@@ -222,6 +222,18 @@ type (
 		// Trigger ID
 		TriggerID uint64 `json:",string"`
 	}
+
+	TriggerSchedule struct {
+		// TriggerID PATH parameter
+		//
+		// Trigger ID
+		TriggerID uint64 `json:",string"`
+
+		// Limit GET parameter
+		//
+		// Number of fire times to return (10 by default)
+		Limit uint
+	}
 )
 
 // NewTriggerList request
@@ -1086,3 +1098,56 @@ func (r *TriggerUndelete) Fill(req *http.Request) (err error) {
 
 	return err
 }
+
+// NewTriggerSchedule request
+func NewTriggerSchedule() *TriggerSchedule {
+	return &TriggerSchedule{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TriggerSchedule) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"triggerID": r.TriggerID,
+		"limit":     r.Limit,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TriggerSchedule) GetTriggerID() uint64 {
+	return r.TriggerID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r TriggerSchedule) GetLimit() uint {
+	return r.Limit
+}
+
+// Fill processes request and fills internal variables
+func (r *TriggerSchedule) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["limit"]; ok && len(val) > 0 {
+			r.Limit, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "triggerID")
+		r.TriggerID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
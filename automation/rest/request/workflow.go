@@ -218,6 +218,209 @@ type (
 		WorkflowID uint64 `json:",string"`
 	}
 
+	WorkflowPublish struct {
+		// WorkflowID PATH parameter
+		//
+		// Workflow ID
+		WorkflowID uint64 `json:",string"`
+	}
+
+	WorkflowRevisions struct {
+		// WorkflowID PATH parameter
+		//
+		// Workflow ID
+		WorkflowID uint64 `json:",string"`
+
+		// Limit GET parameter
+		//
+		// Limit
+		Limit uint
+
+		// PageCursor GET parameter
+		//
+		// Page cursor
+		PageCursor string
+
+		// Sort GET parameter
+		//
+		// Sort items
+		Sort string
+	}
+
+	WorkflowRevisionsDiff struct {
+		// WorkflowID PATH parameter
+		//
+		// Workflow ID
+		WorkflowID uint64 `json:",string"`
+
+		// From GET parameter
+		//
+		// Revision to compare from; 0 compares against the draft
+		From uint
+
+		// To GET parameter
+		//
+		// Revision to compare to; 0 compares against the draft
+		To uint
+	}
+
+	WorkflowRollback struct {
+		// WorkflowID PATH parameter
+		//
+		// Workflow ID
+		WorkflowID uint64 `json:",string"`
+
+		// Revision PATH parameter
+		//
+		// Revision
+		Revision uint
+	}
+
+	WorkflowTestCases struct {
+		// WorkflowID PATH parameter
+		//
+		// Workflow ID
+		WorkflowID uint64 `json:",string"`
+
+		// Limit GET parameter
+		//
+		// Limit
+		Limit uint
+
+		// PageCursor GET parameter
+		//
+		// Page cursor
+		PageCursor string
+
+		// Sort GET parameter
+		//
+		// Sort items
+		Sort string
+	}
+
+	WorkflowTestCaseCreate struct {
+		// WorkflowID PATH parameter
+		//
+		// Workflow ID
+		WorkflowID uint64 `json:",string"`
+
+		// Meta POST parameter
+		//
+		// Test case meta data
+		Meta *types.WorkflowTestCaseMeta
+
+		// StepID POST parameter
+		//
+		// Step to start the workflow on
+		StepID uint64 `json:",string"`
+
+		// Input POST parameter
+		//
+		// Input scope
+		Input *expr.Vars
+
+		// Mocks POST parameter
+		//
+		// Mocked functions
+		Mocks types.WorkflowTestMockSet
+
+		// Passthrough POST parameter
+		//
+		// Functions executed without interception
+		Passthrough types.WorkflowTestPassthrough
+
+		// Assertions POST parameter
+		//
+		// Assertions evaluated over the results
+		Assertions types.TestSet
+
+		// ExpectError POST parameter
+		//
+		// Expected workflow error
+		ExpectError string
+
+		// OwnedBy POST parameter
+		//
+		// Owner of the test case
+		OwnedBy uint64 `json:",string"`
+	}
+
+	WorkflowTestCaseUpdate struct {
+		// WorkflowID PATH parameter
+		//
+		// Workflow ID
+		WorkflowID uint64 `json:",string"`
+
+		// TestCaseID PATH parameter
+		//
+		// Test case ID
+		TestCaseID uint64 `json:",string"`
+
+		// Meta POST parameter
+		//
+		// Test case meta data
+		Meta *types.WorkflowTestCaseMeta
+
+		// StepID POST parameter
+		//
+		// Step to start the workflow on
+		StepID uint64 `json:",string"`
+
+		// Input POST parameter
+		//
+		// Input scope
+		Input *expr.Vars
+
+		// Mocks POST parameter
+		//
+		// Mocked functions
+		Mocks types.WorkflowTestMockSet
+
+		// Passthrough POST parameter
+		//
+		// Functions executed without interception
+		Passthrough types.WorkflowTestPassthrough
+
+		// Assertions POST parameter
+		//
+		// Assertions evaluated over the results
+		Assertions types.TestSet
+
+		// ExpectError POST parameter
+		//
+		// Expected workflow error
+		ExpectError string
+
+		// OwnedBy POST parameter
+		//
+		// Owner of the test case
+		OwnedBy uint64 `json:",string"`
+	}
+
+	WorkflowTestCaseDelete struct {
+		// WorkflowID PATH parameter
+		//
+		// Workflow ID
+		WorkflowID uint64 `json:",string"`
+
+		// TestCaseID PATH parameter
+		//
+		// Test case ID
+		TestCaseID uint64 `json:",string"`
+	}
+
+	WorkflowTestCasesRun struct {
+		// WorkflowID PATH parameter
+		//
+		// Workflow ID
+		WorkflowID uint64 `json:",string"`
+
+		// TestCaseID POST parameter
+		//
+		// Run only test cases with these IDs
+		TestCaseID []string
+	}
+
 	WorkflowTest struct {
 		// WorkflowID PATH parameter
 		//
@@ -266,6 +469,28 @@ type (
 		// Execute step and return immediately
 		Async bool
 	}
+
+	WorkflowDebug struct {
+		// WorkflowID PATH parameter
+		//
+		// Workflow ID
+		WorkflowID uint64 `json:",string"`
+
+		// StepID POST parameter
+		//
+		// Step ID
+		StepID uint64 `json:",string"`
+
+		// Input POST parameter
+		//
+		// Input
+		Input *expr.Vars
+
+		// Breakpoints POST parameter
+		//
+		// Steps to pause the session on
+		Breakpoints []string
+	}
 )
 
 // NewWorkflowList request
@@ -1150,103 +1375,25 @@ func (r *WorkflowUndelete) Fill(req *http.Request) (err error) {
 	return err
 }
 
-// NewWorkflowTest request
-func NewWorkflowTest() *WorkflowTest {
-	return &WorkflowTest{}
+// NewWorkflowPublish request
+func NewWorkflowPublish() *WorkflowPublish {
+	return &WorkflowPublish{}
 }
 
 // Auditable returns all auditable/loggable parameters
-func (r WorkflowTest) Auditable() map[string]interface{} {
+func (r WorkflowPublish) Auditable() map[string]interface{} {
 	return map[string]interface{}{
 		"workflowID": r.WorkflowID,
-		"scope":      r.Scope,
-		"runAs":      r.RunAs,
 	}
 }
 
 // Auditable returns all auditable/loggable parameters
-func (r WorkflowTest) GetWorkflowID() uint64 {
+func (r WorkflowPublish) GetWorkflowID() uint64 {
 	return r.WorkflowID
 }
 
-// Auditable returns all auditable/loggable parameters
-func (r WorkflowTest) GetScope() *expr.Vars {
-	return r.Scope
-}
-
-// Auditable returns all auditable/loggable parameters
-func (r WorkflowTest) GetRunAs() bool {
-	return r.RunAs
-}
-
 // Fill processes request and fills internal variables
-func (r *WorkflowTest) Fill(req *http.Request) (err error) {
-
-	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
-		err = json.NewDecoder(req.Body).Decode(r)
-
-		switch {
-		case err == io.EOF:
-			err = nil
-		case err != nil:
-			return fmt.Errorf("error parsing http request body: %w", err)
-		}
-	}
-
-	{
-		// Caching 32MB to memory, the rest to disk
-		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
-			return err
-		} else if err == nil {
-			// Multipart params
-
-			if val, ok := req.MultipartForm.Value["scope[]"]; ok {
-				r.Scope, err = types.ParseWorkflowVariables(val)
-				if err != nil {
-					return err
-				}
-			} else if val, ok := req.MultipartForm.Value["scope"]; ok {
-				r.Scope, err = types.ParseWorkflowVariables(val)
-				if err != nil {
-					return err
-				}
-			}
-
-			if val, ok := req.MultipartForm.Value["runAs"]; ok && len(val) > 0 {
-				r.RunAs, err = payload.ParseBool(val[0]), nil
-				if err != nil {
-					return err
-				}
-			}
-		}
-	}
-
-	{
-		if err = req.ParseForm(); err != nil {
-			return err
-		}
-
-		// POST params
-
-		if val, ok := req.Form["scope[]"]; ok {
-			r.Scope, err = types.ParseWorkflowVariables(val)
-			if err != nil {
-				return err
-			}
-		} else if val, ok := req.Form["scope"]; ok {
-			r.Scope, err = types.ParseWorkflowVariables(val)
-			if err != nil {
-				return err
-			}
-		}
-
-		if val, ok := req.Form["runAs"]; ok && len(val) > 0 {
-			r.RunAs, err = payload.ParseBool(val[0]), nil
-			if err != nil {
-				return err
-			}
-		}
-	}
+func (r *WorkflowPublish) Fill(req *http.Request) (err error) {
 
 	{
 		var val string
@@ -1263,20 +1410,1081 @@ func (r *WorkflowTest) Fill(req *http.Request) (err error) {
 	return err
 }
 
-// NewWorkflowExec request
-func NewWorkflowExec() *WorkflowExec {
-	return &WorkflowExec{}
+// NewWorkflowRevisions request
+func NewWorkflowRevisions() *WorkflowRevisions {
+	return &WorkflowRevisions{}
 }
 
 // Auditable returns all auditable/loggable parameters
-func (r WorkflowExec) Auditable() map[string]interface{} {
+func (r WorkflowRevisions) Auditable() map[string]interface{} {
 	return map[string]interface{}{
 		"workflowID": r.WorkflowID,
-		"stepID":     r.StepID,
-		"input":      r.Input,
-		"trace":      r.Trace,
-		"wait":       r.Wait,
-		"async":      r.Async,
+		"limit":      r.Limit,
+		"pageCursor": r.PageCursor,
+		"sort":       r.Sort,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowRevisions) GetWorkflowID() uint64 {
+	return r.WorkflowID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowRevisions) GetLimit() uint {
+	return r.Limit
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowRevisions) GetPageCursor() string {
+	return r.PageCursor
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowRevisions) GetSort() string {
+	return r.Sort
+}
+
+// Fill processes request and fills internal variables
+func (r *WorkflowRevisions) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["limit"]; ok && len(val) > 0 {
+			r.Limit, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["pageCursor"]; ok && len(val) > 0 {
+			r.PageCursor, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["sort"]; ok && len(val) > 0 {
+			r.Sort, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "workflowID")
+		r.WorkflowID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewWorkflowRevisionsDiff request
+func NewWorkflowRevisionsDiff() *WorkflowRevisionsDiff {
+	return &WorkflowRevisionsDiff{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowRevisionsDiff) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"workflowID": r.WorkflowID,
+		"from":       r.From,
+		"to":         r.To,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowRevisionsDiff) GetWorkflowID() uint64 {
+	return r.WorkflowID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowRevisionsDiff) GetFrom() uint {
+	return r.From
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowRevisionsDiff) GetTo() uint {
+	return r.To
+}
+
+// Fill processes request and fills internal variables
+func (r *WorkflowRevisionsDiff) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["from"]; ok && len(val) > 0 {
+			r.From, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["to"]; ok && len(val) > 0 {
+			r.To, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "workflowID")
+		r.WorkflowID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewWorkflowRollback request
+func NewWorkflowRollback() *WorkflowRollback {
+	return &WorkflowRollback{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowRollback) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"workflowID": r.WorkflowID,
+		"revision":   r.Revision,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowRollback) GetWorkflowID() uint64 {
+	return r.WorkflowID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowRollback) GetRevision() uint {
+	return r.Revision
+}
+
+// Fill processes request and fills internal variables
+func (r *WorkflowRollback) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "workflowID")
+		r.WorkflowID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "revision")
+		r.Revision, err = payload.ParseUint(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewWorkflowTestCases request
+func NewWorkflowTestCases() *WorkflowTestCases {
+	return &WorkflowTestCases{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCases) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"workflowID": r.WorkflowID,
+		"limit":      r.Limit,
+		"pageCursor": r.PageCursor,
+		"sort":       r.Sort,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCases) GetWorkflowID() uint64 {
+	return r.WorkflowID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCases) GetLimit() uint {
+	return r.Limit
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCases) GetPageCursor() string {
+	return r.PageCursor
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCases) GetSort() string {
+	return r.Sort
+}
+
+// Fill processes request and fills internal variables
+func (r *WorkflowTestCases) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["limit"]; ok && len(val) > 0 {
+			r.Limit, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["pageCursor"]; ok && len(val) > 0 {
+			r.PageCursor, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["sort"]; ok && len(val) > 0 {
+			r.Sort, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "workflowID")
+		r.WorkflowID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewWorkflowTestCaseCreate request
+func NewWorkflowTestCaseCreate() *WorkflowTestCaseCreate {
+	return &WorkflowTestCaseCreate{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseCreate) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"workflowID":  r.WorkflowID,
+		"meta":        r.Meta,
+		"stepID":      r.StepID,
+		"input":       r.Input,
+		"mocks":       r.Mocks,
+		"passthrough": r.Passthrough,
+		"assertions":  r.Assertions,
+		"expectError": r.ExpectError,
+		"ownedBy":     r.OwnedBy,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseCreate) GetWorkflowID() uint64 {
+	return r.WorkflowID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseCreate) GetMeta() *types.WorkflowTestCaseMeta {
+	return r.Meta
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseCreate) GetStepID() uint64 {
+	return r.StepID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseCreate) GetInput() *expr.Vars {
+	return r.Input
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseCreate) GetMocks() types.WorkflowTestMockSet {
+	return r.Mocks
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseCreate) GetPassthrough() types.WorkflowTestPassthrough {
+	return r.Passthrough
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseCreate) GetAssertions() types.TestSet {
+	return r.Assertions
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseCreate) GetExpectError() string {
+	return r.ExpectError
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseCreate) GetOwnedBy() uint64 {
+	return r.OwnedBy
+}
+
+// Fill processes request and fills internal variables
+func (r *WorkflowTestCaseCreate) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+			if val, ok := req.MultipartForm.Value["meta[]"]; ok {
+				r.Meta, err = types.ParseWorkflowTestCaseMeta(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["meta"]; ok {
+				r.Meta, err = types.ParseWorkflowTestCaseMeta(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["stepID"]; ok && len(val) > 0 {
+				r.StepID, err = payload.ParseUint64(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["input[]"]; ok {
+				r.Input, err = types.ParseWorkflowVariables(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["input"]; ok {
+				r.Input, err = types.ParseWorkflowVariables(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["mocks[]"]; ok {
+				r.Mocks, err = types.ParseWorkflowTestMockSet(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["mocks"]; ok {
+				r.Mocks, err = types.ParseWorkflowTestMockSet(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["passthrough[]"]; ok {
+				r.Passthrough, err = types.ParseWorkflowTestPassthrough(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["passthrough"]; ok {
+				r.Passthrough, err = types.ParseWorkflowTestPassthrough(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["assertions[]"]; ok {
+				r.Assertions, err = types.ParseTestSet(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["assertions"]; ok {
+				r.Assertions, err = types.ParseTestSet(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["expectError"]; ok && len(val) > 0 {
+				r.ExpectError, err = val[0], nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["ownedBy"]; ok && len(val) > 0 {
+				r.OwnedBy, err = payload.ParseUint64(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		if val, ok := req.Form["meta[]"]; ok {
+			r.Meta, err = types.ParseWorkflowTestCaseMeta(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["meta"]; ok {
+			r.Meta, err = types.ParseWorkflowTestCaseMeta(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["stepID"]; ok && len(val) > 0 {
+			r.StepID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["input[]"]; ok {
+			r.Input, err = types.ParseWorkflowVariables(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["input"]; ok {
+			r.Input, err = types.ParseWorkflowVariables(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["mocks[]"]; ok {
+			r.Mocks, err = types.ParseWorkflowTestMockSet(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["mocks"]; ok {
+			r.Mocks, err = types.ParseWorkflowTestMockSet(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["passthrough[]"]; ok {
+			r.Passthrough, err = types.ParseWorkflowTestPassthrough(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["passthrough"]; ok {
+			r.Passthrough, err = types.ParseWorkflowTestPassthrough(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["assertions[]"]; ok {
+			r.Assertions, err = types.ParseTestSet(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["assertions"]; ok {
+			r.Assertions, err = types.ParseTestSet(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["expectError"]; ok && len(val) > 0 {
+			r.ExpectError, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["ownedBy"]; ok && len(val) > 0 {
+			r.OwnedBy, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "workflowID")
+		r.WorkflowID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewWorkflowTestCaseUpdate request
+func NewWorkflowTestCaseUpdate() *WorkflowTestCaseUpdate {
+	return &WorkflowTestCaseUpdate{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseUpdate) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"workflowID":  r.WorkflowID,
+		"testCaseID":  r.TestCaseID,
+		"meta":        r.Meta,
+		"stepID":      r.StepID,
+		"input":       r.Input,
+		"mocks":       r.Mocks,
+		"passthrough": r.Passthrough,
+		"assertions":  r.Assertions,
+		"expectError": r.ExpectError,
+		"ownedBy":     r.OwnedBy,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseUpdate) GetWorkflowID() uint64 {
+	return r.WorkflowID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseUpdate) GetTestCaseID() uint64 {
+	return r.TestCaseID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseUpdate) GetMeta() *types.WorkflowTestCaseMeta {
+	return r.Meta
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseUpdate) GetStepID() uint64 {
+	return r.StepID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseUpdate) GetInput() *expr.Vars {
+	return r.Input
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseUpdate) GetMocks() types.WorkflowTestMockSet {
+	return r.Mocks
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseUpdate) GetPassthrough() types.WorkflowTestPassthrough {
+	return r.Passthrough
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseUpdate) GetAssertions() types.TestSet {
+	return r.Assertions
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseUpdate) GetExpectError() string {
+	return r.ExpectError
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseUpdate) GetOwnedBy() uint64 {
+	return r.OwnedBy
+}
+
+// Fill processes request and fills internal variables
+func (r *WorkflowTestCaseUpdate) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+			if val, ok := req.MultipartForm.Value["meta[]"]; ok {
+				r.Meta, err = types.ParseWorkflowTestCaseMeta(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["meta"]; ok {
+				r.Meta, err = types.ParseWorkflowTestCaseMeta(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["stepID"]; ok && len(val) > 0 {
+				r.StepID, err = payload.ParseUint64(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["input[]"]; ok {
+				r.Input, err = types.ParseWorkflowVariables(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["input"]; ok {
+				r.Input, err = types.ParseWorkflowVariables(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["mocks[]"]; ok {
+				r.Mocks, err = types.ParseWorkflowTestMockSet(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["mocks"]; ok {
+				r.Mocks, err = types.ParseWorkflowTestMockSet(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["passthrough[]"]; ok {
+				r.Passthrough, err = types.ParseWorkflowTestPassthrough(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["passthrough"]; ok {
+				r.Passthrough, err = types.ParseWorkflowTestPassthrough(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["assertions[]"]; ok {
+				r.Assertions, err = types.ParseTestSet(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["assertions"]; ok {
+				r.Assertions, err = types.ParseTestSet(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["expectError"]; ok && len(val) > 0 {
+				r.ExpectError, err = val[0], nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["ownedBy"]; ok && len(val) > 0 {
+				r.OwnedBy, err = payload.ParseUint64(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		if val, ok := req.Form["meta[]"]; ok {
+			r.Meta, err = types.ParseWorkflowTestCaseMeta(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["meta"]; ok {
+			r.Meta, err = types.ParseWorkflowTestCaseMeta(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["stepID"]; ok && len(val) > 0 {
+			r.StepID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["input[]"]; ok {
+			r.Input, err = types.ParseWorkflowVariables(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["input"]; ok {
+			r.Input, err = types.ParseWorkflowVariables(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["mocks[]"]; ok {
+			r.Mocks, err = types.ParseWorkflowTestMockSet(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["mocks"]; ok {
+			r.Mocks, err = types.ParseWorkflowTestMockSet(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["passthrough[]"]; ok {
+			r.Passthrough, err = types.ParseWorkflowTestPassthrough(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["passthrough"]; ok {
+			r.Passthrough, err = types.ParseWorkflowTestPassthrough(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["assertions[]"]; ok {
+			r.Assertions, err = types.ParseTestSet(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["assertions"]; ok {
+			r.Assertions, err = types.ParseTestSet(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["expectError"]; ok && len(val) > 0 {
+			r.ExpectError, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["ownedBy"]; ok && len(val) > 0 {
+			r.OwnedBy, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "workflowID")
+		r.WorkflowID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "testCaseID")
+		r.TestCaseID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewWorkflowTestCaseDelete request
+func NewWorkflowTestCaseDelete() *WorkflowTestCaseDelete {
+	return &WorkflowTestCaseDelete{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseDelete) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"workflowID": r.WorkflowID,
+		"testCaseID": r.TestCaseID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseDelete) GetWorkflowID() uint64 {
+	return r.WorkflowID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCaseDelete) GetTestCaseID() uint64 {
+	return r.TestCaseID
+}
+
+// Fill processes request and fills internal variables
+func (r *WorkflowTestCaseDelete) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "workflowID")
+		r.WorkflowID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "testCaseID")
+		r.TestCaseID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewWorkflowTestCasesRun request
+func NewWorkflowTestCasesRun() *WorkflowTestCasesRun {
+	return &WorkflowTestCasesRun{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCasesRun) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"workflowID": r.WorkflowID,
+		"testCaseID": r.TestCaseID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCasesRun) GetWorkflowID() uint64 {
+	return r.WorkflowID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTestCasesRun) GetTestCaseID() []string {
+	return r.TestCaseID
+}
+
+// Fill processes request and fills internal variables
+func (r *WorkflowTestCasesRun) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		//if val, ok := req.Form["testCaseID[]"]; ok && len(val) > 0  {
+		//    r.TestCaseID, err = val, nil
+		//    if err != nil {
+		//        return err
+		//    }
+		//}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "workflowID")
+		r.WorkflowID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewWorkflowTest request
+func NewWorkflowTest() *WorkflowTest {
+	return &WorkflowTest{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTest) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"workflowID": r.WorkflowID,
+		"scope":      r.Scope,
+		"runAs":      r.RunAs,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTest) GetWorkflowID() uint64 {
+	return r.WorkflowID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTest) GetScope() *expr.Vars {
+	return r.Scope
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowTest) GetRunAs() bool {
+	return r.RunAs
+}
+
+// Fill processes request and fills internal variables
+func (r *WorkflowTest) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+			if val, ok := req.MultipartForm.Value["scope[]"]; ok {
+				r.Scope, err = types.ParseWorkflowVariables(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["scope"]; ok {
+				r.Scope, err = types.ParseWorkflowVariables(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["runAs"]; ok && len(val) > 0 {
+				r.RunAs, err = payload.ParseBool(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		if val, ok := req.Form["scope[]"]; ok {
+			r.Scope, err = types.ParseWorkflowVariables(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["scope"]; ok {
+			r.Scope, err = types.ParseWorkflowVariables(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["runAs"]; ok && len(val) > 0 {
+			r.RunAs, err = payload.ParseBool(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "workflowID")
+		r.WorkflowID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewWorkflowExec request
+func NewWorkflowExec() *WorkflowExec {
+	return &WorkflowExec{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowExec) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"workflowID": r.WorkflowID,
+		"stepID":     r.StepID,
+		"input":      r.Input,
+		"trace":      r.Trace,
+		"wait":       r.Wait,
+		"async":      r.Async,
 	}
 }
 
@@ -1435,3 +2643,130 @@ func (r *WorkflowExec) Fill(req *http.Request) (err error) {
 
 	return err
 }
+
+// NewWorkflowDebug request
+func NewWorkflowDebug() *WorkflowDebug {
+	return &WorkflowDebug{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowDebug) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"workflowID":  r.WorkflowID,
+		"stepID":      r.StepID,
+		"input":       r.Input,
+		"breakpoints": r.Breakpoints,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowDebug) GetWorkflowID() uint64 {
+	return r.WorkflowID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowDebug) GetStepID() uint64 {
+	return r.StepID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowDebug) GetInput() *expr.Vars {
+	return r.Input
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r WorkflowDebug) GetBreakpoints() []string {
+	return r.Breakpoints
+}
+
+// Fill processes request and fills internal variables
+func (r *WorkflowDebug) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+			if val, ok := req.MultipartForm.Value["stepID"]; ok && len(val) > 0 {
+				r.StepID, err = payload.ParseUint64(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["input[]"]; ok {
+				r.Input, err = types.ParseWorkflowVariables(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["input"]; ok {
+				r.Input, err = types.ParseWorkflowVariables(val)
+				if err != nil {
+					return err
+				}
+			}
+
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		if val, ok := req.Form["stepID"]; ok && len(val) > 0 {
+			r.StepID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["input[]"]; ok {
+			r.Input, err = types.ParseWorkflowVariables(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["input"]; ok {
+			r.Input, err = types.ParseWorkflowVariables(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		//if val, ok := req.Form["breakpoints[]"]; ok && len(val) > 0  {
+		//    r.Breakpoints, err = val, nil
+		//    if err != nil {
+		//        return err
+		//    }
+		//}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "workflowID")
+		r.WorkflowID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
@@ -16,6 +16,7 @@ func MountRoutes() func(r chi.Router) {
 			handlers.NewWorkflow(Workflow{}.New()).MountRoutes(r)
 			handlers.NewTrigger(Trigger{}.New()).MountRoutes(r)
 			handlers.NewSession(Session{}.New()).MountRoutes(r)
+			handlers.NewTask(Task{}.New()).MountRoutes(r)
 			handlers.NewFunction(Function{}.New()).MountRoutes(r)
 			handlers.NewType(Type{}.New()).MountRoutes(r)
 			handlers.NewPermissions(Permissions{}.New()).MountRoutes(r)
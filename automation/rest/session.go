@@ -24,6 +24,18 @@ type (
 		LookupByID(ctx context.Context, sessionID uint64) (*types.Session, error)
 		Resume(sessionID, stateID uint64, i auth.Identifiable, input *expr.Vars) error
 		PendingPrompts(context.Context) []*wfexec.PendingPrompt
+
+		Trace(ctx context.Context, sessionID uint64) (types.Stacktrace, error)
+		DebugState(ctx context.Context, sessionID uint64) (*types.SessionDebugState, error)
+		UpdateBreakpoints(ctx context.Context, sessionID uint64, stepID ...uint64) error
+		UpdateStateScope(ctx context.Context, sessionID, stateID uint64, vars *expr.Vars) error
+		StepState(ctx context.Context, sessionID, stateID uint64) error
+		ContinueState(ctx context.Context, sessionID, stateID uint64) error
+		Replay(ctx context.Context, sessionID uint64, p service.SessionReplayParams) (uint64, error)
+	}
+
+	sessionReplayPayload struct {
+		SessionID uint64 `json:"sessionID,string"`
 	}
 
 	sessionSetPayload struct {
@@ -53,13 +65,14 @@ func (ctrl Session) List(ctx context.Context, r *request.SessionList) (interface
 	var (
 		err error
 		f   = types.SessionFilter{
-			WorkflowID:   payload.ParseUint64s(r.WorkflowID),
-			SessionID:    payload.ParseUint64s(r.SessionID),
-			CreatedBy:    payload.ParseUint64s(r.CreatedBy),
-			EventType:    r.EventType,
-			ResourceType: r.ResourceType,
-			Completed:    filter.State(r.Completed),
-			Status:       r.Status,
+			WorkflowID:      payload.ParseUint64s(r.WorkflowID),
+			SessionID:       payload.ParseUint64s(r.SessionID),
+			CallerSessionID: payload.ParseUint64s(r.CallerSessionID),
+			CreatedBy:       payload.ParseUint64s(r.CreatedBy),
+			EventType:       r.EventType,
+			ResourceType:    r.ResourceType,
+			Completed:       filter.State(r.Completed),
+			Status:          r.Status,
 		}
 	)
 
@@ -83,8 +96,42 @@ func (ctrl Session) Delete(ctx context.Context, r *request.SessionDelete) (inter
 	return nil, fmt.Errorf("not implemented")
 }
 
-func (ctrl Session) Trace(ctx context.Context, trace *request.SessionTrace) (interface{}, error) {
-	return nil, fmt.Errorf("not implemented")
+func (ctrl Session) Trace(ctx context.Context, r *request.SessionTrace) (interface{}, error) {
+	return ctrl.svc.Trace(ctx, r.SessionID)
+}
+
+func (ctrl Session) DebugState(ctx context.Context, r *request.SessionDebugState) (interface{}, error) {
+	return ctrl.svc.DebugState(ctx, r.SessionID)
+}
+
+func (ctrl Session) Breakpoints(ctx context.Context, r *request.SessionBreakpoints) (interface{}, error) {
+	return api.OK(), ctrl.svc.UpdateBreakpoints(ctx, r.SessionID, payload.ParseUint64s(r.StepID)...)
+}
+
+func (ctrl Session) StateScope(ctx context.Context, r *request.SessionStateScope) (interface{}, error) {
+	return api.OK(), ctrl.svc.UpdateStateScope(ctx, r.SessionID, r.StateID, r.Scope)
+}
+
+func (ctrl Session) StepState(ctx context.Context, r *request.SessionStepState) (interface{}, error) {
+	return api.OK(), ctrl.svc.StepState(ctx, r.SessionID, r.StateID)
+}
+
+func (ctrl Session) ContinueState(ctx context.Context, r *request.SessionContinueState) (interface{}, error) {
+	return api.OK(), ctrl.svc.ContinueState(ctx, r.SessionID, r.StateID)
+}
+
+func (ctrl Session) Replay(ctx context.Context, r *request.SessionReplay) (interface{}, error) {
+	sessionID, err := ctrl.svc.Replay(ctx, r.SessionID, service.SessionReplayParams{
+		Frame:       r.Frame,
+		Input:       r.Input,
+		Breakpoints: payload.ParseUint64s(r.Breakpoints),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &sessionReplayPayload{SessionID: sessionID}, nil
 }
 
 func (ctrl Session) ListPrompts(ctx context.Context, r *request.SessionListPrompts) (interface{}, error) {
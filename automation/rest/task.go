@@ -0,0 +1,104 @@
+package rest
+
+import (
+	"context"
+
+	"github.com/cortezaproject/corteza-server/automation/rest/request"
+	"github.com/cortezaproject/corteza-server/automation/service"
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/cortezaproject/corteza-server/pkg/payload"
+)
+
+type (
+	Task struct {
+		svc taskService
+	}
+
+	taskService interface {
+		Search(ctx context.Context, filter types.TaskFilter) (types.TaskSet, types.TaskFilter, error)
+		Inbox(ctx context.Context, filter types.TaskFilter) (types.TaskSet, types.TaskFilter, error)
+		LookupByID(ctx context.Context, taskID uint64) (*types.Task, error)
+		Claim(ctx context.Context, taskID uint64) (*types.Task, error)
+		Release(ctx context.Context, taskID uint64) (*types.Task, error)
+		Delegate(ctx context.Context, taskID, userID uint64) (*types.Task, error)
+		Complete(ctx context.Context, taskID uint64, input *expr.Vars) (*types.Task, error)
+	}
+
+	taskSetPayload struct {
+		Filter types.TaskFilter `json:"filter"`
+		Set    types.TaskSet    `json:"set"`
+	}
+)
+
+func (Task) New() *Task {
+	ctrl := &Task{}
+	ctrl.svc = service.DefaultTask
+	return ctrl
+}
+
+func (ctrl Task) List(ctx context.Context, r *request.TaskList) (interface{}, error) {
+	var (
+		f = types.TaskFilter{
+			TaskID:         payload.ParseUint64s(r.TaskID),
+			SessionID:      payload.ParseUint64s(r.SessionID),
+			WorkflowID:     payload.ParseUint64s(r.WorkflowID),
+			AssignedUserID: payload.ParseUint64s(r.AssignedUserID),
+			AssignedRoleID: payload.ParseUint64s(r.AssignedRoleID),
+			Status:         r.Status,
+			Completed:      filter.State(r.Completed),
+			Limit:          r.Limit,
+		}
+	)
+
+	set, filter, err := ctrl.svc.Search(ctx, f)
+	return ctrl.makeFilterPayload(set, filter, err)
+}
+
+func (ctrl Task) Inbox(ctx context.Context, r *request.TaskInbox) (interface{}, error) {
+	var (
+		f = types.TaskFilter{
+			WorkflowID:     payload.ParseUint64s(r.WorkflowID),
+			AssignedRoleID: payload.ParseUint64s(r.AssignedRoleID),
+			Status:         r.Status,
+			Completed:      filter.State(r.Completed),
+			Limit:          r.Limit,
+		}
+	)
+
+	set, filter, err := ctrl.svc.Inbox(ctx, f)
+	return ctrl.makeFilterPayload(set, filter, err)
+}
+
+func (ctrl Task) Read(ctx context.Context, r *request.TaskRead) (interface{}, error) {
+	return ctrl.svc.LookupByID(ctx, r.TaskID)
+}
+
+func (ctrl Task) Claim(ctx context.Context, r *request.TaskClaim) (interface{}, error) {
+	return ctrl.svc.Claim(ctx, r.TaskID)
+}
+
+func (ctrl Task) Release(ctx context.Context, r *request.TaskRelease) (interface{}, error) {
+	return ctrl.svc.Release(ctx, r.TaskID)
+}
+
+func (ctrl Task) Delegate(ctx context.Context, r *request.TaskDelegate) (interface{}, error) {
+	return ctrl.svc.Delegate(ctx, r.TaskID, r.UserID)
+}
+
+func (ctrl Task) Complete(ctx context.Context, r *request.TaskComplete) (interface{}, error) {
+	return ctrl.svc.Complete(ctx, r.TaskID, r.Input)
+}
+
+func (ctrl Task) makeFilterPayload(tt types.TaskSet, f types.TaskFilter, err error) (*taskSetPayload, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tt) == 0 {
+		tt = make([]*types.Task, 0)
+	}
+
+	return &taskSetPayload{Filter: f, Set: tt}, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cortezaproject/corteza-server/pkg/api"
 	"github.com/cortezaproject/corteza-server/pkg/filter"
 	"github.com/cortezaproject/corteza-server/pkg/payload"
+	"time"
 )
 
 type (
@@ -19,9 +20,15 @@ type (
 			Update(ctx context.Context, upd *types.Trigger) (*types.Trigger, error)
 			DeleteByID(ctx context.Context, triggerID uint64) error
 			UndeleteByID(ctx context.Context, triggerID uint64) error
+			Schedule(ctx context.Context, triggerID uint64, limit uint) ([]time.Time, error)
 		}
 	}
 
+	triggerSchedulePayload struct {
+		TriggerID uint64      `json:"triggerID,string"`
+		FireTimes []time.Time `json:"fireTimes"`
+	}
+
 	triggerSetPayload struct {
 		Filter types.TriggerFilter `json:"filter"`
 		Set    types.TriggerSet    `json:"set"`
@@ -107,6 +114,15 @@ func (ctrl Trigger) Undelete(ctx context.Context, r *request.TriggerUndelete) (i
 	return api.OK(), ctrl.svc.UndeleteByID(ctx, r.TriggerID)
 }
 
+func (ctrl Trigger) Schedule(ctx context.Context, r *request.TriggerSchedule) (interface{}, error) {
+	tt, err := ctrl.svc.Schedule(ctx, r.TriggerID, r.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &triggerSchedulePayload{TriggerID: r.TriggerID, FireTimes: tt}, nil
+}
+
 func (ctrl Trigger) makeFilterPayload(ctx context.Context, uu types.TriggerSet, f types.TriggerFilter, err error) (*triggerSetPayload, error) {
 	if err != nil {
 		return nil, err
@@ -24,7 +24,17 @@ type (
 			Update(ctx context.Context, upd *types.Workflow) (*types.Workflow, error)
 			DeleteByID(ctx context.Context, workflowID uint64) error
 			UndeleteByID(ctx context.Context, workflowID uint64) error
+			Publish(ctx context.Context, workflowID uint64) (*types.Workflow, error)
+			Rollback(ctx context.Context, workflowID uint64, revision uint) (*types.Workflow, error)
+			SearchRevisions(ctx context.Context, workflowID uint64, f types.WorkflowRevisionFilter) (types.WorkflowRevisionSet, types.WorkflowRevisionFilter, error)
+			DiffRevisions(ctx context.Context, workflowID uint64, from, to uint) (*types.WorkflowRevisionDiff, error)
+			SearchTestCases(ctx context.Context, workflowID uint64, f types.WorkflowTestCaseFilter) (types.WorkflowTestCaseSet, types.WorkflowTestCaseFilter, error)
+			CreateTestCase(ctx context.Context, new *types.WorkflowTestCase) (*types.WorkflowTestCase, error)
+			UpdateTestCase(ctx context.Context, upd *types.WorkflowTestCase) (*types.WorkflowTestCase, error)
+			DeleteTestCase(ctx context.Context, workflowID, testCaseID uint64) error
+			RunTestCases(ctx context.Context, workflowID uint64, testCaseID ...uint64) ([]*types.WorkflowTestResult, error)
 			Exec(ctx context.Context, workflowID uint64, p types.WorkflowExecParams) (*expr.Vars, types.Stacktrace, error)
+			Debug(ctx context.Context, workflowID uint64, p types.WorkflowExecParams) (uint64, error)
 		}
 
 		// cross-link with compose service to load module on resolved records
@@ -63,6 +73,25 @@ type (
 		Set    []*workflowPayload   `json:"set"`
 	}
 
+	workflowRevisionSetPayload struct {
+		Filter types.WorkflowRevisionFilter `json:"filter"`
+		Set    types.WorkflowRevisionSet    `json:"set"`
+	}
+
+	workflowTestCaseSetPayload struct {
+		Filter types.WorkflowTestCaseFilter `json:"filter"`
+		Set    types.WorkflowTestCaseSet    `json:"set"`
+	}
+
+	workflowTestRunPayload struct {
+		Passed  bool                        `json:"passed"`
+		Results []*types.WorkflowTestResult `json:"results"`
+	}
+
+	workflowDebugPayload struct {
+		SessionID uint64 `json:"sessionID,string"`
+	}
+
 	workflowExecPayload struct {
 		Results *expr.Vars       `json:"results"`
 		Trace   types.Stacktrace `json:"trace,omitempty"`
@@ -158,6 +187,111 @@ func (ctrl Workflow) Undelete(ctx context.Context, r *request.WorkflowUndelete)
 	return api.OK(), ctrl.svc.UndeleteByID(ctx, r.WorkflowID)
 }
 
+func (ctrl Workflow) Publish(ctx context.Context, r *request.WorkflowPublish) (interface{}, error) {
+	wf, err := ctrl.svc.Publish(ctx, r.WorkflowID)
+	return ctrl.makePayload(ctx, wf, err)
+}
+
+func (ctrl Workflow) Revisions(ctx context.Context, r *request.WorkflowRevisions) (interface{}, error) {
+	var (
+		err error
+		f   = types.WorkflowRevisionFilter{}
+	)
+
+	if f.Paging, err = filter.NewPaging(r.Limit, r.PageCursor); err != nil {
+		return nil, err
+	}
+
+	if f.Sorting, err = filter.NewSorting(r.Sort); err != nil {
+		return nil, err
+	}
+
+	set, f, err := ctrl.svc.SearchRevisions(ctx, r.WorkflowID, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workflowRevisionSetPayload{Filter: f, Set: set}, nil
+}
+
+func (ctrl Workflow) RevisionsDiff(ctx context.Context, r *request.WorkflowRevisionsDiff) (interface{}, error) {
+	return ctrl.svc.DiffRevisions(ctx, r.WorkflowID, r.From, r.To)
+}
+
+func (ctrl Workflow) Rollback(ctx context.Context, r *request.WorkflowRollback) (interface{}, error) {
+	wf, err := ctrl.svc.Rollback(ctx, r.WorkflowID, r.Revision)
+	return ctrl.makePayload(ctx, wf, err)
+}
+
+func (ctrl Workflow) TestCases(ctx context.Context, r *request.WorkflowTestCases) (interface{}, error) {
+	var (
+		err error
+		f   = types.WorkflowTestCaseFilter{}
+	)
+
+	if f.Paging, err = filter.NewPaging(r.Limit, r.PageCursor); err != nil {
+		return nil, err
+	}
+
+	if f.Sorting, err = filter.NewSorting(r.Sort); err != nil {
+		return nil, err
+	}
+
+	set, f, err := ctrl.svc.SearchTestCases(ctx, r.WorkflowID, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workflowTestCaseSetPayload{Filter: f, Set: set}, nil
+}
+
+func (ctrl Workflow) TestCaseCreate(ctx context.Context, r *request.WorkflowTestCaseCreate) (interface{}, error) {
+	return ctrl.svc.CreateTestCase(ctx, &types.WorkflowTestCase{
+		WorkflowID:  r.WorkflowID,
+		Meta:        r.Meta,
+		StepID:      r.StepID,
+		Input:       r.Input,
+		Mocks:       r.Mocks,
+		Passthrough: r.Passthrough,
+		Assertions:  r.Assertions,
+		ExpectError: r.ExpectError,
+		OwnedBy:     r.OwnedBy,
+	})
+}
+
+func (ctrl Workflow) TestCaseUpdate(ctx context.Context, r *request.WorkflowTestCaseUpdate) (interface{}, error) {
+	return ctrl.svc.UpdateTestCase(ctx, &types.WorkflowTestCase{
+		ID:          r.TestCaseID,
+		WorkflowID:  r.WorkflowID,
+		Meta:        r.Meta,
+		StepID:      r.StepID,
+		Input:       r.Input,
+		Mocks:       r.Mocks,
+		Passthrough: r.Passthrough,
+		Assertions:  r.Assertions,
+		ExpectError: r.ExpectError,
+		OwnedBy:     r.OwnedBy,
+	})
+}
+
+func (ctrl Workflow) TestCaseDelete(ctx context.Context, r *request.WorkflowTestCaseDelete) (interface{}, error) {
+	return api.OK(), ctrl.svc.DeleteTestCase(ctx, r.WorkflowID, r.TestCaseID)
+}
+
+func (ctrl Workflow) TestCasesRun(ctx context.Context, r *request.WorkflowTestCasesRun) (interface{}, error) {
+	rr, err := ctrl.svc.RunTestCases(ctx, r.WorkflowID, payload.ParseUint64s(r.TestCaseID)...)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &workflowTestRunPayload{Passed: true, Results: rr}
+	for _, res := range rr {
+		p.Passed = p.Passed && res.Passed
+	}
+
+	return p, nil
+}
+
 func (ctrl Workflow) Exec(ctx context.Context, r *request.WorkflowExec) (interface{}, error) {
 	var (
 		wep = &workflowExecPayload{}
@@ -172,16 +306,54 @@ func (ctrl Workflow) Exec(ctx context.Context, r *request.WorkflowExec) (interfa
 		}
 	)
 
-	if execParams.Input != nil {
-		if err = execParams.Input.ResolveTypes(service.Registry().Type); err != nil {
-			return nil, err
+	if err = ctrl.resolveInput(ctx, execParams.Input); err != nil {
+		return nil, err
+	}
+
+	wep.Results, wep.Trace, err = ctrl.svc.Exec(ctx, r.WorkflowID, execParams)
+
+	if err != nil && wep.Trace != nil && r.Trace {
+		// in case of an error & trace enabled (and stacktrace present)
+		// we'll suppress the error
+		wep.Error = err.Error()
+		return wep, nil
+	}
+
+	return wep, err
+}
+
+func (ctrl Workflow) Debug(ctx context.Context, r *request.WorkflowDebug) (interface{}, error) {
+	var (
+		wdp = &workflowDebugPayload{}
+		err error
+
+		execParams = types.WorkflowExecParams{
+			StepID:      r.StepID,
+			Input:       r.Input,
+			Breakpoints: payload.ParseUint64s(r.Breakpoints),
+		}
+	)
+
+	if err = ctrl.resolveInput(ctx, execParams.Input); err != nil {
+		return nil, err
+	}
+
+	wdp.SessionID, err = ctrl.svc.Debug(ctx, r.WorkflowID, execParams)
+	return wdp, err
+}
+
+// resolveInput resolves types of the input variables
+func (ctrl Workflow) resolveInput(ctx context.Context, input *expr.Vars) (err error) {
+	if input != nil {
+		if err = input.ResolveTypes(service.Registry().Type); err != nil {
+			return err
 		}
 	}
 
 	// Now that all types are resolved we have to load modules and link them to records
 	//
 	// Very naive approach for now.
-	execParams.Input.Each(func(k string, v expr.TypedValue) error {
+	input.Each(func(k string, v expr.TypedValue) error {
 		switch c := v.(type) {
 		case *automation.ComposeRecord:
 			rec := c.GetValue()
@@ -199,16 +371,7 @@ func (ctrl Workflow) Exec(ctx context.Context, r *request.WorkflowExec) (interfa
 		return nil
 	})
 
-	wep.Results, wep.Trace, err = ctrl.svc.Exec(ctx, r.WorkflowID, execParams)
-
-	if err != nil && wep.Trace != nil && r.Trace {
-		// in case of an error & trace enabled (and stacktrace present)
-		// we'll suppress the error
-		wep.Error = err.Error()
-		return wep, nil
-	}
-
-	return wep, err
+	return nil
 }
 
 func (ctrl Workflow) makeFilterPayload(ctx context.Context, set types.WorkflowSet, f types.WorkflowFilter, err error) (*workflowSetPayload, error) {
@@ -22,6 +22,21 @@ type (
 
 var (
 	defaultRegistry = initRegistry()
+
+	// functions without side effects that are not intercepted
+	// when workflow runs in a sandbox
+	sandboxSafeFunctions = map[string]bool{
+		"jsenvExecute": true,
+		"jwtGenerate":  true,
+		"logDebug":     true,
+		"logInfo":      true,
+		"logWarn":      true,
+		"logError":     true,
+		"loopSequence": true,
+		"loopDo":       true,
+		"loopEach":     true,
+		"loopLines":    true,
+	}
 )
 
 func Registry() *registry {
@@ -77,6 +92,33 @@ func (r registry) Functions() []*types.Function {
 	return ff
 }
 
+// sandbox returns a copy of the registry with intercepted functions
+//
+// Mocked functions are replaced with their mocks; functions without side effects
+// and functions marked as passthrough are kept and all other functions fail when executed.
+func (r *registry) sandbox(mm types.WorkflowTestMockSet, pt types.WorkflowTestPassthrough) *registry {
+	defer r.lock.RUnlock()
+	r.lock.RLock()
+
+	sr := initRegistry()
+	for ref, fn := range r.functions {
+		switch {
+		case mm.Function(ref) != nil:
+			sr.functions[ref] = mm.Function(ref).Mock(fn)
+		case sandboxSafeFunctions[ref] || pt.Has(ref):
+			sr.functions[ref] = fn
+		default:
+			sr.functions[ref] = types.InterceptFunction(fn)
+		}
+	}
+
+	for ref, t := range r.types {
+		sr.types[ref] = t
+	}
+
+	return sr
+}
+
 func (r *registry) AddTypes(tt ...expr.Type) {
 	defer r.lock.Unlock()
 	r.lock.Lock()
@@ -51,6 +51,11 @@ var (
 	DefaultWorkflow *workflow
 	DefaultTrigger  *trigger
 	DefaultSession  *session
+	DefaultTask     *task
+
+	// DefaultCluster is set when workflow sessions
+	// are coordinated between multiple server instances
+	DefaultCluster *sessionCluster
 
 	// wrapper around time.Now() that will aid service testing
 	now = func() *time.Time {
@@ -94,8 +99,18 @@ func Initialize(ctx context.Context, log *zap.Logger, s store.Storer, ws websock
 	DefaultSession = Session(DefaultLogger.Named("session"), c.Workflow, ws)
 	DefaultWorkflow = Workflow(DefaultLogger.Named("workflow"), c.Corredor, c.Workflow)
 	DefaultTrigger = Trigger(DefaultLogger.Named("trigger"), c.Workflow)
+	DefaultTask = Task(DefaultLogger.Named("task"), DefaultSession)
 
 	DefaultWorkflow.triggers = DefaultTrigger
+	DefaultSession.graphs = DefaultWorkflow
+	DefaultSession.tasks = DefaultTask
+
+	if c.Workflow.ClusterEnabled {
+		DefaultCluster = SessionCluster(DefaultStore, c.Workflow.ClusterNode, c.Workflow.ClusterLeaseTtl)
+		DefaultSession.cluster = DefaultCluster
+		DefaultTask.SetLeaser(DefaultCluster)
+		log.Info("workflow session clustering enabled", zap.String("node", DefaultCluster.node))
+	}
 
 	Registry().AddTypes(
 		&expr.Any{},
@@ -126,6 +141,7 @@ func Initialize(ctx context.Context, log *zap.Logger, s store.Storer, ws websock
 	automation.Oauth2Handler(Registry())
 	automation.LoopHandler(Registry(), DefaultWorkflow.parser)
 	automation.CorredorHandler(Registry(), corredor.Service())
+	automation.WorkflowsHandler(Registry(), DefaultWorkflow)
 	automation.EmailHandler(Registry())
 	automation.JwtHandler(Registry())
 	automation.ApigwBodyHandler(Registry())
@@ -142,6 +158,7 @@ func Activate(ctx context.Context) (err error) {
 
 func Watchers(ctx context.Context) {
 	DefaultSession.Watch(ctx)
+	DefaultTask.Watch(ctx)
 	return
 }
 
@@ -32,6 +32,18 @@ type (
 		pool         map[uint64]*types.Session
 		spawnQueue   chan *spawn
 		promptSender promptSender
+
+		// registry is used to resolve types of
+		// the restored and routed values
+		reg *registry
+
+		// set when sessions are coordinated
+		// between multiple server instances
+		cluster *sessionCluster
+		graphs  sessionGraphLoader
+
+		// keeps task inbox in sync with session's prompts
+		tasks sessionTaskTracker
 	}
 
 	spawn struct {
@@ -42,6 +54,12 @@ type (
 		runner     auth.Identifiable
 		trace      bool
 		callStack  []uint64
+
+		// set when session is restored
+		sessionID uint64
+
+		// additional session options
+		opts []wfexec.SessionOpt
 	}
 
 	sessionAccessController interface {
@@ -49,6 +67,12 @@ type (
 		CanManageSessionsOnWorkflow(context.Context, *types.Workflow) bool
 	}
 
+	sessionTaskTracker interface {
+		prompted(context.Context, *types.Session, []*wfexec.PendingPrompt)
+		resume(ctx context.Context, sessionID, stateID uint64, i auth.Identifiable, input *expr.Vars) error
+		finished(ctx context.Context, sessionID uint64)
+	}
+
 	WaitFn func(ctx context.Context) (*expr.Vars, wfexec.SessionStatus, types.Stacktrace, error)
 )
 
@@ -72,6 +96,7 @@ func Session(log *zap.Logger, opt options.WorkflowOpt, ps promptSender) *session
 		pool:         make(map[uint64]*types.Session),
 		spawnQueue:   make(chan *spawn),
 		promptSender: ps,
+		reg:          Registry(),
 	}
 }
 
@@ -124,29 +149,51 @@ func (svc *session) LookupByID(ctx context.Context, sessionID uint64) (res *type
 	return res, svc.recordAction(ctx, sap, SessionActionLookup, err)
 }
 
+// resumeAll adopts suspended sessions from the store
+//
+// Only sessions that are not leased by other
+// server instances are resumed (see sessionCluster)
 func (svc *session) resumeAll(ctx context.Context) error {
-	// In theory we could resume active/pending/prompt sessions from persistent store
-	// so that they can survive server termination
+	if svc.cluster == nil {
+		return nil
+	}
 
-	// @todo resume active sessions from storage
-	//       load all active sessions from store and load them into the pool
-	//
-	return nil
+	return svc.adopt(ctx)
 }
 
+// suspendAll stops suspended sessions and releases their leases
+// so that they can be adopted by other server instances
+//
+// Suspended sessions are already flushed to the store with their
+// snapshots (see stateChangeHandler); active sessions are left running.
 func (svc *session) suspendAll(ctx context.Context) error {
-	// In theory we could suspend active/pending/prompt sessions to persistent store
-	// so that they can survive server termination
-
-	// @todo suspend active sessions to storage:
-	//       stop watcher queue
-	//       run gc
-	//       stop worker on each session
-	//       flush session to store (like we're doing in the status handler
+	if svc.cluster == nil {
+		return nil
+	}
+
+	svc.mux.Lock()
+	defer svc.mux.Unlock()
+
+	for _, ses := range svc.pool {
+		if !ses.Suspended() {
+			continue
+		}
+
+		ses.Cancel()
+		delete(svc.pool, ses.ID)
+
+		if err := svc.cluster.releaseSession(ctx, ses.ID); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 // PendingPrompts returns all prompts on all sessions owned by current user
+//
+// When sessions are coordinated between multiple server instances, prompts
+// of sessions running on other instances are included
 func (svc *session) PendingPrompts(ctx context.Context) (pp []*wfexec.PendingPrompt) {
 	var (
 		i = auth.GetIdentityFromContext(ctx)
@@ -157,12 +204,15 @@ func (svc *session) PendingPrompts(ctx context.Context) (pp []*wfexec.PendingPro
 	}
 
 	svc.mux.RLock()
-	defer svc.mux.RUnlock()
-
 	pp = make([]*wfexec.PendingPrompt, 0, len(svc.pool))
 	for _, s := range svc.pool {
 		pp = append(pp, s.PendingPrompts(i.Identity())...)
 	}
+	svc.mux.RUnlock()
+
+	if svc.cluster != nil {
+		pp = append(pp, svc.routedPendingPrompts(ctx, i.Identity())...)
+	}
 
 	return
 }
@@ -175,15 +225,35 @@ func (svc *session) PendingPrompts(ctx context.Context) (pp []*wfexec.PendingPro
 // used for the execution of the workflow. See watch function!
 //
 // It does not check user's permissions to execute workflow(s) so it should be used only when !
-func (svc *session) Start(ctx context.Context, g *wfexec.Graph, ssp types.SessionStartParams) (wait WaitFn, err error) {
+func (svc *session) Start(ctx context.Context, g *wfexec.Graph, ssp types.SessionStartParams) (wait WaitFn, sessionID uint64, err error) {
 	var (
 		start wfexec.Step
+		ses   *types.Session
 	)
 
 	if g == nil {
-		return nil, errors.InvalidData("cannot start workflow, uninitialized graph")
+		return nil, 0, errors.InvalidData("cannot start workflow, uninitialized graph")
+	}
+
+	if start, err = startingStep(g, ssp.StepID); err != nil {
+		return nil, 0, err
+	}
+
+	if ses, err = svc.prepare(ctx, g, &ssp); err != nil {
+		return
 	}
 
+	if err = ses.Exec(ctx, start, ssp.Input); err != nil {
+		return
+	}
+
+	return func(ctx context.Context) (*expr.Vars, wfexec.SessionStatus, types.Stacktrace, error) {
+		return ses.WaitResults(ctx)
+	}, ses.ID, nil
+}
+
+// prepare spawns new session, applies start params and prepares the input
+func (svc *session) prepare(ctx context.Context, g *wfexec.Graph, ssp *types.SessionStartParams) (ses *types.Session, err error) {
 	if len(ssp.CallStack) > svc.opt.CallStackSize {
 		return nil, WorkflowErrMaximumCallStackSizeExceeded()
 	}
@@ -198,50 +268,89 @@ func (svc *session) Start(ctx context.Context, g *wfexec.Graph, ssp types.Sessio
 		ssp.Runner = ssp.Invoker
 	}
 
-	if ssp.StepID == 0 {
-		// starting step is not explicitly set
-		// find orphan step
-		switch oo := g.Orphans(); len(oo) {
-		case 1:
-			start = oo[0]
-		case 0:
-			return nil, errors.InvalidData("could not find starting step")
-		default:
-			return nil, errors.InvalidData("cannot start workflow session multiple starting steps found")
-		}
-	} else if start = g.StepByID(ssp.StepID); start == nil {
-		return nil, errors.InvalidData("trigger staring step references non-existing step")
-	} else if len(g.Parents(g.StepByID(ssp.StepID))) > 0 {
-		return nil, errors.InvalidData("cannot start workflow on a step with parents")
-	}
-
 	var (
-		ses = svc.spawn(g, ssp.WorkflowID, ssp.Trace, ssp.CallStack, ssp.Runner, ssp.Invoker)
+		opts []wfexec.SessionOpt
 	)
 
+	if len(ssp.Breakpoints) > 0 {
+		opts = append(opts, wfexec.SetBreakpoints(ssp.Breakpoints...))
+	}
+
+	ses = svc.spawn(g, ssp.WorkflowID, ssp.Trace, ssp.CallStack, ssp.Runner, ssp.Invoker, opts...)
+
 	ses.CreatedAt = *now()
 	ses.CreatedBy = ssp.Invoker.Identity()
 	ses.Status = types.SessionStarted
-	ses.Apply(ssp)
+	ses.Apply(*ssp)
+
+	if svc.cluster != nil {
+		ses.Snapshot = &types.SessionSnapshot{
+			Node:      svc.cluster.node,
+			Invoker:   types.MakeSessionIdentity(ssp.Invoker),
+			Runner:    types.MakeSessionIdentity(ssp.Runner),
+			CallStack: ssp.CallStack,
+		}
+
+		// session needs to be leased before it is stored
+		// or other instances might try to adopt it
+		//
+		// when this fails, lease is acquired on the next renewal
+		if _, err = svc.cluster.acquireSession(ctx, ses.ID); err != nil {
+			svc.log.Warn("failed to acquire session lease", zap.Uint64("sessionID", ses.ID), zap.Error(err))
+		}
+	}
 
 	_ = ssp.Input.AssignFieldValue("eventType", expr.Must(expr.NewString(ssp.EventType)))
 	_ = ssp.Input.AssignFieldValue("resourceType", expr.Must(expr.NewString(ssp.ResourceType)))
 	_ = ssp.Input.AssignFieldValue("invoker", expr.Must(expr.NewAny(ssp.Invoker)))
 	_ = ssp.Input.AssignFieldValue("runner", expr.Must(expr.NewAny(ssp.Runner)))
 
-	if err = ses.Exec(ctx, start, ssp.Input); err != nil {
-		return
+	return ses, nil
+}
+
+// startingStep returns step the session is started on
+//
+// When step is not explicitly set, the only orphan step is used
+func startingStep(g *wfexec.Graph, stepID uint64) (start wfexec.Step, err error) {
+	if stepID == 0 {
+		// starting step is not explicitly set
+		// find orphan step
+		switch oo := g.Orphans(); len(oo) {
+		case 1:
+			return oo[0], nil
+		case 0:
+			return nil, errors.InvalidData("could not find starting step")
+		default:
+			return nil, errors.InvalidData("cannot start workflow session multiple starting steps found")
+		}
 	}
 
-	return func(ctx context.Context) (*expr.Vars, wfexec.SessionStatus, types.Stacktrace, error) {
-		return ses.WaitResults(ctx)
-	}, nil
+	if start = g.StepByID(stepID); start == nil {
+		return nil, errors.InvalidData("trigger staring step references non-existing step")
+	} else if len(g.Parents(start)) > 0 {
+		return nil, errors.InvalidData("cannot start workflow on a step with parents")
+	}
+
+	return
 }
 
 // Resume resumes suspended session/state
 //
 // Session can only be resumed by knowing session and state ID. Resume is an asynchronous operation
+//
+// When session is running on another server instance, resume request is
+// stored and picked up by the instance that holds the session lease
 func (svc *session) Resume(sessionID, stateID uint64, i auth.Identifiable, input *expr.Vars) error {
+	if svc.tasks != nil {
+		// completes the task of the prompt as well
+		return svc.tasks.resume(context.Background(), sessionID, stateID, i, input)
+	}
+
+	return svc.resume(sessionID, stateID, i, input)
+}
+
+// resume resumes prompted state without updating the task of the prompt
+func (svc *session) resume(sessionID, stateID uint64, i auth.Identifiable, input *expr.Vars) error {
 	var (
 		ctx = auth.SetIdentityToContext(context.Background(), i)
 	)
@@ -250,6 +359,10 @@ func (svc *session) Resume(sessionID, stateID uint64, i auth.Identifiable, input
 	defer svc.mux.RUnlock()
 	ses := svc.pool[sessionID]
 	if ses == nil {
+		if svc.cluster != nil {
+			return svc.route(ctx, sessionID, stateID, i, input)
+		}
+
 		return errors.NotFound("session not found")
 	}
 
@@ -269,7 +382,7 @@ func (svc *session) Resume(sessionID, stateID uint64, i auth.Identifiable, input
 //
 // We need initial context for the session because we want to catch all cancellations or timeouts from there
 // and not from any potential HTTP requests or similar temporary context that can prematurely destroy a workflow session
-func (svc *session) spawn(g *wfexec.Graph, workflowID uint64, trace bool, callStack []uint64, runner, invoker auth.Identifiable) (ses *types.Session) {
+func (svc *session) spawn(g *wfexec.Graph, workflowID uint64, trace bool, callStack []uint64, runner, invoker auth.Identifiable, opts ...wfexec.SessionOpt) (ses *types.Session) {
 	s := &spawn{
 		workflowID: workflowID,
 		session:    make(chan *wfexec.Session, 1),
@@ -278,6 +391,7 @@ func (svc *session) spawn(g *wfexec.Graph, workflowID uint64, trace bool, callSt
 		callStack:  callStack,
 		invoker:    invoker,
 		runner:     runner,
+		opts:       opts,
 	}
 
 	// Send new-session request
@@ -285,6 +399,12 @@ func (svc *session) spawn(g *wfexec.Graph, workflowID uint64, trace bool, callSt
 
 	// blocks until session is set
 	ses = types.NewSession(<-s.session)
+	svc.addToPool(ses)
+	return ses
+}
+
+// addToPool adds spawned or restored session to the pool
+func (svc *session) addToPool(ses *types.Session) {
 	if !svc.opt.StackTraceEnabled {
 		ses.DisableStacktrace()
 	}
@@ -292,7 +412,6 @@ func (svc *session) spawn(g *wfexec.Graph, workflowID uint64, trace bool, callSt
 	svc.mux.Lock()
 	svc.pool[ses.ID] = ses
 	svc.mux.Unlock()
-	return ses
 }
 
 // Watch looks over session's spawn queue
@@ -318,6 +437,12 @@ func (svc *session) Watch(ctx context.Context) {
 					wfexec.SetHandler(svc.stateChangeHandler(ctx)),
 				}
 
+				if s.sessionID > 0 {
+					opts = append(opts, wfexec.SetSessionID(s.sessionID))
+				}
+
+				opts = append(opts, s.opts...)
+
 				if svc.opt.ExecDebug {
 					log := svc.log.
 						Named("exec").
@@ -356,6 +481,10 @@ func (svc *session) Watch(ctx context.Context) {
 		//svc.suspendAll(ctx)
 	}()
 
+	if svc.cluster != nil {
+		svc.watchCluster(ctx)
+	}
+
 	svc.log.Debug("watcher initialized")
 }
 
@@ -442,6 +571,7 @@ func (svc *session) stateChangeHandler(ctx context.Context) wfexec.StateChangeHa
 		case wfexec.SessionPrompted:
 			ses.SuspendedAt = now()
 			ses.Status = types.SessionPrompted
+			svc.snapshot(ses, s)
 
 			// Send the pending prompts to user
 			if svc.promptSender != nil {
@@ -462,24 +592,56 @@ func (svc *session) stateChangeHandler(ctx context.Context) wfexec.StateChangeHa
 				}
 			}
 
+			if svc.tasks != nil {
+				svc.tasks.prompted(ctx, ses, s.AllPendingPrompts())
+			}
+
 		case wfexec.SessionDelayed:
 			ses.SuspendedAt = now()
 			ses.Status = types.SessionSuspended
+			svc.snapshot(ses, s)
 
 		case wfexec.SessionCompleted:
 			ses.SuspendedAt = nil
 			ses.CompletedAt = now()
 			ses.Status = types.SessionCompleted
 
+			if svc.tasks != nil {
+				svc.tasks.finished(ctx, ses.ID)
+			}
+
 		case wfexec.SessionFailed:
 			ses.SuspendedAt = nil
 			ses.CompletedAt = now()
 			ses.Error = state.Error()
 			ses.Status = types.SessionFailed
 
+			if svc.tasks != nil {
+				svc.tasks.finished(ctx, ses.ID)
+			}
+
+		case wfexec.SessionPaused:
+			ses.Status = types.SessionPaused
+
 		default:
+			resumed := false
+
+			switch ses.Status {
+			case types.SessionPaused:
+				// continued by the debugger
+				ses.Status = types.SessionStarted
+
+			case types.SessionPrompted, types.SessionSuspended:
+				// resumed; snapshot is stored right away so that
+				// the resumed states are not restored again
+				ses.SuspendedAt = nil
+				ses.Status = types.SessionStarted
+				svc.snapshot(ses, s)
+				resumed = svc.cluster != nil
+			}
+
 			// force update on every F new frames (F=sessionStateFlushFrequency) but only when stacktrace is not nil
-			update = ses.RuntimeStacktrace != nil && len(ses.RuntimeStacktrace)%sessionStateFlushFrequency == 0
+			update = resumed || ses.RuntimeStacktrace != nil && len(ses.RuntimeStacktrace)%sessionStateFlushFrequency == 0
 		}
 
 		if !update {
@@ -491,6 +653,12 @@ func (svc *session) stateChangeHandler(ctx context.Context) wfexec.StateChangeHa
 		if err := svc.store.UpsertAutomationSession(ctx, ses); err != nil {
 			log.Error("failed to update session", zap.Error(err))
 		}
+
+		if svc.cluster != nil && ses.CompletedAt != nil {
+			if err := svc.cluster.releaseSession(ctx, ses.ID); err != nil {
+				log.Error("failed to release session lease", zap.Error(err))
+			}
+		}
 	}
 }
 
@@ -344,6 +344,46 @@ func SessionActionUndelete(props ...*sessionActionProps) *sessionAction {
 	return a
 }
 
+// SessionActionDebug returns "automation:session.debug" action
+//
+// This function is auto-generated.
+//
+func SessionActionDebug(props ...*sessionActionProps) *sessionAction {
+	a := &sessionAction{
+		timestamp: time.Now(),
+		resource:  "automation:session",
+		action:    "debug",
+		log:       "debugged {{session}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// SessionActionReplay returns "automation:session.replay" action
+//
+// This function is auto-generated.
+//
+func SessionActionReplay(props ...*sessionActionProps) *sessionAction {
+	a := &sessionAction{
+		timestamp: time.Now(),
+		resource:  "automation:session",
+		action:    "replay",
+		log:       "replayed {{session}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
 // *********************************************************************************************************************
 // *********************************************************************************************************************
 // Error constructors
@@ -452,6 +492,105 @@ func SessionErrInvalidID(mm ...*sessionActionProps) *errors.Error {
 	return e
 }
 
+// SessionErrNotRunning returns "automation:session.notRunning" as *errors.Error
+//
+// This function is auto-generated.
+//
+func SessionErrNotRunning(mm ...*sessionActionProps) *errors.Error {
+	var p = &sessionActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("session is not running on this server", nil),
+
+		errors.Meta("type", "notRunning"),
+		errors.Meta("resource", "automation:session"),
+
+		errors.Meta(sessionPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "session.errors.notRunning"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// SessionErrInvalidFrame returns "automation:session.invalidFrame" as *errors.Error
+//
+// This function is auto-generated.
+//
+func SessionErrInvalidFrame(mm ...*sessionActionProps) *errors.Error {
+	var p = &sessionActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid stacktrace frame", nil),
+
+		errors.Meta("type", "invalidFrame"),
+		errors.Meta("resource", "automation:session"),
+
+		errors.Meta(sessionPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "session.errors.invalidFrame"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// SessionErrDebugFailed returns "automation:session.debugFailed" as *errors.Error
+//
+// This function is auto-generated.
+//
+func SessionErrDebugFailed(mm ...*sessionActionProps) *errors.Error {
+	var p = &sessionActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("debugger failed", nil),
+
+		errors.Meta("type", "debugFailed"),
+		errors.Meta("resource", "automation:session"),
+
+		errors.Meta(sessionPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "session.errors.debugFailed"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
 // SessionErrStaleData returns "automation:session.staleData" as *errors.Error
 //
 //
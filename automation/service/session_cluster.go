@@ -0,0 +1,526 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/errors"
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/cortezaproject/corteza-server/pkg/sentry"
+	"github.com/cortezaproject/corteza-server/pkg/wfexec"
+	"github.com/cortezaproject/corteza-server/store"
+	"go.uber.org/zap"
+)
+
+type (
+	// sessionCluster coordinates workflow sessions between
+	// server instances (nodes) that share the same store
+	//
+	// Each session is leased by the node that runs it; lease is renewed
+	// while the node is alive. Node keeps its own lease as a heartbeat.
+	// Sessions with expired leases of nodes that are gone are adopted
+	// by other nodes and restored from the stored snapshot.
+	sessionCluster struct {
+		store store.Storer
+
+		// unique name of this node
+		node string
+
+		// how long is the lease valid without renewal
+		ttl time.Duration
+
+		// how often are leases renewed (and orphaned sessions adopted)
+		renewEvery time.Duration
+
+		// how often are routed resume requests picked up
+		pollEvery time.Duration
+	}
+
+	// sessionGraphLoader loads graph of the workflow revision
+	// that the restored session was started on
+	sessionGraphLoader interface {
+		sessionGraph(ctx context.Context, workflowID uint64, revision uint) (*wfexec.Graph, error)
+	}
+)
+
+const (
+	sessionLeasePrefix = "session:"
+	nodeLeasePrefix    = "node:"
+)
+
+func SessionCluster(s store.Storer, node string, ttl time.Duration) *sessionCluster {
+	if node = strings.TrimSpace(node); node == "" {
+		host, _ := os.Hostname()
+		node = fmt.Sprintf("%s-%d", host, nextID())
+	}
+
+	if ttl <= 0 {
+		ttl = time.Second * 30
+	}
+
+	return &sessionCluster{
+		store:      s,
+		node:       node,
+		ttl:        ttl,
+		renewEvery: ttl / 3,
+		pollEvery:  time.Second,
+	}
+}
+
+// Acquire creates or renews named lease held by this node
+//
+// Returns false if lease is held by another node
+func (c *sessionCluster) Acquire(ctx context.Context, name string, ttl time.Duration) (bool, error) {
+	return store.AcquireAutomationLease(ctx, c.store, &types.Lease{
+		Name:      name,
+		Owner:     c.node,
+		ExpiresAt: time.Now().Add(ttl),
+	})
+}
+
+func (c *sessionCluster) acquireSession(ctx context.Context, sessionID uint64) (bool, error) {
+	return c.Acquire(ctx, sessionLease(sessionID), c.ttl)
+}
+
+func (c *sessionCluster) releaseSession(ctx context.Context, sessionID uint64) error {
+	return store.ReleaseAutomationLease(ctx, c.store, sessionLease(sessionID), c.node)
+}
+
+func sessionLease(sessionID uint64) string {
+	return fmt.Sprintf("%s%d", sessionLeasePrefix, sessionID)
+}
+
+// heartbeat renews lease of this node
+func (c *sessionCluster) heartbeat(ctx context.Context) (bool, error) {
+	return c.Acquire(ctx, nodeLeasePrefix+c.node, c.ttl)
+}
+
+func (c *sessionCluster) releaseNode(ctx context.Context) error {
+	return store.ReleaseAutomationLease(ctx, c.store, nodeLeasePrefix+c.node, c.node)
+}
+
+// alive returns true if lease is held and not yet expired
+func (c *sessionCluster) alive(ctx context.Context, name string) (bool, error) {
+	l, err := store.LookupAutomationLeaseByName(ctx, c.store, name)
+	if errors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return !l.Expired(time.Now()), nil
+}
+
+// orphaned returns true if the session lease expired
+// and the node that was running the session is gone
+func (c *sessionCluster) orphaned(ctx context.Context, ses *types.Session) (bool, error) {
+	var owner string
+
+	l, err := store.LookupAutomationLeaseByName(ctx, c.store, sessionLease(ses.ID))
+	switch {
+	case err == nil:
+		if !l.Expired(time.Now()) {
+			return false, nil
+		}
+
+		owner = l.Owner
+
+	case errors.IsNotFound(err):
+		if ses.Snapshot != nil {
+			owner = ses.Snapshot.Node
+		}
+
+	default:
+		return false, err
+	}
+
+	if owner == "" || owner == c.node {
+		return true, nil
+	}
+
+	alive, err := c.alive(ctx, nodeLeasePrefix+owner)
+	return !alive, err
+}
+
+// watchCluster renews leases of the running sessions, adopts orphaned
+// sessions and picks up resume requests routed from other nodes
+func (svc *session) watchCluster(ctx context.Context) {
+	var (
+		renewTicker = time.NewTicker(svc.cluster.renewEvery)
+		pollTicker  = time.NewTicker(svc.cluster.pollEvery)
+	)
+
+	go func() {
+		defer sentry.Recover()
+		defer renewTicker.Stop()
+		defer pollTicker.Stop()
+
+		if _, err := svc.cluster.heartbeat(ctx); err != nil {
+			svc.log.Error("failed to acquire node lease", zap.Error(err))
+		}
+
+		if err := svc.resumeAll(ctx); err != nil {
+			svc.log.Error("failed to adopt sessions", zap.Error(err))
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				// context is done at this point,
+				// suspended sessions are released without it
+				if err := svc.suspendAll(context.Background()); err != nil {
+					svc.log.Error("failed to release sessions", zap.Error(err))
+				}
+
+				if err := svc.cluster.releaseNode(context.Background()); err != nil {
+					svc.log.Error("failed to release node lease", zap.Error(err))
+				}
+
+				return
+
+			case <-renewTicker.C:
+				if _, err := svc.cluster.heartbeat(ctx); err != nil {
+					svc.log.Error("failed to renew node lease", zap.Error(err))
+				}
+
+				svc.renewLeases(ctx)
+
+				if err := svc.adopt(ctx); err != nil {
+					svc.log.Error("failed to adopt sessions", zap.Error(err))
+				}
+
+				if err := store.DeleteExpiredAutomationLeases(ctx, svc.store); err != nil {
+					svc.log.Error("failed to delete expired leases", zap.Error(err))
+				}
+
+			case <-pollTicker.C:
+				if err := svc.resumeRouted(ctx); err != nil {
+					svc.log.Error("failed to resume routed sessions", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// renewLeases renews leases of all running sessions
+//
+// Sessions with leases taken over by other nodes are stopped
+// and removed from the pool
+func (svc *session) renewLeases(ctx context.Context) {
+	svc.mux.RLock()
+	ids := make([]uint64, 0, len(svc.pool))
+	for ID, ses := range svc.pool {
+		if !ses.GC() {
+			ids = append(ids, ID)
+		}
+	}
+	svc.mux.RUnlock()
+
+	for _, ID := range ids {
+		ok, err := svc.cluster.acquireSession(ctx, ID)
+		if err != nil {
+			svc.log.Error("failed to renew session lease", zap.Uint64("sessionID", ID), zap.Error(err))
+			continue
+		}
+
+		if ok {
+			continue
+		}
+
+		svc.mux.Lock()
+		if ses := svc.pool[ID]; ses != nil {
+			svc.log.Warn("session lease lost, stopping session", zap.Uint64("sessionID", ID))
+			ses.Cancel()
+			delete(svc.pool, ID)
+		}
+		svc.mux.Unlock()
+	}
+}
+
+// adopt restores unfinished sessions with expired leases
+// that were running on nodes that are gone
+func (svc *session) adopt(ctx context.Context) error {
+	set, _, err := store.SearchAutomationSessions(ctx, svc.store, types.SessionFilter{
+		Completed: filter.StateExcluded,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, ses := range set {
+		if ses.Status != types.SessionStarted && !ses.Suspended() {
+			continue
+		}
+
+		svc.mux.RLock()
+		pooled := svc.pool[ses.ID] != nil
+		svc.mux.RUnlock()
+
+		if pooled {
+			continue
+		}
+
+		if ok, err := svc.cluster.orphaned(ctx, ses); err != nil {
+			return err
+		} else if !ok {
+			// still running on another node
+			continue
+		}
+
+		if ok, err := svc.cluster.acquireSession(ctx, ses.ID); err != nil {
+			return err
+		} else if !ok {
+			// leased by another node
+			continue
+		}
+
+		// session might have been completed by the previous
+		// lease holder before we acquired the lease
+		if ses, err = loadSession(ctx, svc.store, ses.ID); err != nil {
+			return err
+		}
+
+		if ses.CompletedAt != nil {
+			if err = svc.cluster.releaseSession(ctx, ses.ID); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if ses.Snapshot != nil {
+			// session is now running on this node
+			ses.Snapshot.Node = svc.cluster.node
+			if err = store.UpdateAutomationSession(ctx, svc.store, ses); err != nil {
+				return err
+			}
+		}
+
+		if err = svc.restore(ctx, ses); err != nil {
+			svc.log.Warn("could not restore session", zap.Uint64("sessionID", ses.ID), zap.Error(err))
+
+			if err = svc.abandon(ctx, ses, err); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		svc.log.Info("session adopted", zap.Uint64("sessionID", ses.ID), zap.Uint64("workflowID", ses.WorkflowID))
+	}
+
+	return nil
+}
+
+// restore spawns stored session from its snapshot and adds it to the pool
+func (svc *session) restore(ctx context.Context, ses *types.Session) (err error) {
+	var (
+		g   *wfexec.Graph
+		ws  *wfexec.Session
+		sss = ses.Snapshot
+	)
+
+	if sss == nil || len(sss.States) == 0 {
+		return fmt.Errorf("session was interrupted before it was suspended")
+	}
+
+	if err = sss.ResolveTypes(svc.reg.Type); err != nil {
+		return
+	}
+
+	if g, err = svc.graphs.sessionGraph(ctx, ses.WorkflowID, ses.WorkflowRevision); err != nil {
+		return
+	}
+
+	s := &spawn{
+		workflowID: ses.WorkflowID,
+		session:    make(chan *wfexec.Session, 1),
+		graph:      g,
+		callStack:  sss.CallStack,
+		invoker:    sss.Invoker.Identifiable(),
+		runner:     sss.Runner.Identifiable(),
+		sessionID:  ses.ID,
+	}
+
+	select {
+	case svc.spawnQueue <- s:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	ws = <-s.session
+	if err = ws.Restore(sss.States...); err != nil {
+		ws.Cancel()
+		return
+	}
+
+	svc.addToPool(types.RestoreSession(ses, ws))
+	return nil
+}
+
+// abandon marks session that can not be restored as failed
+func (svc *session) abandon(ctx context.Context, ses *types.Session, reason error) (err error) {
+	ses.Status = types.SessionFailed
+	ses.Error = fmt.Sprintf("could not restore session: %v", reason)
+	ses.SuspendedAt = nil
+	ses.CompletedAt = now()
+	ses.Snapshot = nil
+
+	if err = store.UpsertAutomationSession(ctx, svc.store, ses); err != nil {
+		return
+	}
+
+	return svc.cluster.releaseSession(ctx, ses.ID)
+}
+
+// snapshot stores suspended states of the session
+//
+// Sessions with states that can not be suspended (ie. inside loops)
+// are stored without states and can not be restored by other nodes
+func (svc *session) snapshot(ses *types.Session, s *wfexec.Session) {
+	if svc.cluster == nil || ses.Snapshot == nil {
+		return
+	}
+
+	var err error
+	if ses.Snapshot.States, err = s.Snapshot(); err != nil {
+		svc.log.Debug("session can not be restored", zap.Uint64("sessionID", ses.ID), zap.Error(err))
+	}
+}
+
+// route stores resume request for the session running on another node
+func (svc *session) route(ctx context.Context, sessionID, stateID uint64, i auth.Identifiable, input *expr.Vars) error {
+	ses, err := store.LookupAutomationSessionByID(ctx, svc.store, sessionID)
+	if errors.IsNotFound(err) || (err == nil && ses.CompletedAt != nil) {
+		return errors.NotFound("session not found")
+	} else if err != nil {
+		return err
+	}
+
+	st := ses.Snapshot.State(stateID)
+	if st == nil || st.Prompt == nil {
+		return wfexec.ErrUnexistingState
+	}
+
+	if st.Prompt.OwnerID != i.Identity() {
+		return fmt.Errorf("state access denied")
+	}
+
+	return store.CreateAutomationSessionResume(ctx, svc.store, &types.SessionResume{
+		ID:        nextID(),
+		SessionID: sessionID,
+		StateID:   stateID,
+		Input:     input,
+		CreatedAt: *now(),
+		CreatedBy: i.Identity(),
+	})
+}
+
+// resumeRouted resumes pooled sessions with resume requests routed from other nodes
+func (svc *session) resumeRouted(ctx context.Context) error {
+	svc.mux.RLock()
+	ids := make([]uint64, 0, len(svc.pool))
+	for ID := range svc.pool {
+		ids = append(ids, ID)
+	}
+	svc.mux.RUnlock()
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	rr, _, err := store.SearchAutomationSessionResumes(ctx, svc.store, types.SessionResumeFilter{SessionID: ids})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range rr {
+		log := svc.log.With(zap.Uint64("sessionID", r.SessionID), zap.Uint64("stateID", r.StateID))
+
+		if err = r.Input.ResolveTypes(svc.reg.Type); err == nil {
+			err = svc.Resume(r.SessionID, r.StateID, auth.Authenticated(r.CreatedBy), r.Input)
+		}
+
+		if err != nil {
+			if time.Since(r.CreatedAt) < svc.cluster.ttl {
+				// request is kept and retried on the next poll
+				log.Warn("failed to resume routed session", zap.Error(err))
+				continue
+			}
+
+			log.Error("failed to resume routed session, dropping request", zap.Error(err))
+		}
+
+		if err = store.DeleteAutomationSessionResume(ctx, svc.store, r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// routedPendingPrompts returns prompts of suspended sessions running on other nodes
+func (svc *session) routedPendingPrompts(ctx context.Context, ownerID uint64) (pp []*wfexec.PendingPrompt) {
+	set, _, err := store.SearchAutomationSessions(ctx, svc.store, types.SessionFilter{
+		Completed: filter.StateExcluded,
+	})
+
+	if err != nil {
+		svc.log.Error("failed to load pending prompts", zap.Error(err))
+		return
+	}
+
+	svc.mux.RLock()
+	defer svc.mux.RUnlock()
+
+	for _, ses := range set {
+		if ses.Status != types.SessionPrompted || svc.pool[ses.ID] != nil {
+			continue
+		}
+
+		pp = append(pp, ses.Snapshot.PendingPrompts(ses.ID, ownerID)...)
+	}
+
+	return
+}
+
+// sessionGraph returns graph of the given workflow revision
+//
+// Cached graph is used when session was started on the published revision
+func (svc *workflow) sessionGraph(ctx context.Context, workflowID uint64, revision uint) (*wfexec.Graph, error) {
+	svc.mux.RLock()
+	c := svc.cache[workflowID]
+	svc.mux.RUnlock()
+
+	if c != nil && c.wf != nil && c.wf.PublishedRevision == revision {
+		return c.g, nil
+	}
+
+	wf, err := loadWorkflow(ctx, svc.store, workflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	if revision > 0 {
+		rev, err := svc.lookupRevision(ctx, svc.store, workflowID, revision)
+		if err != nil {
+			return nil, err
+		}
+
+		wf = wf.Published(rev)
+	}
+
+	g, issues := Convert(svc, wf)
+	if len(issues) > 0 {
+		return nil, issues
+	}
+
+	return g, nil
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/errors"
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/options"
+	"github.com/cortezaproject/corteza-server/pkg/wfexec"
+	"github.com/cortezaproject/corteza-server/store"
+	"github.com/cortezaproject/corteza-server/store/adapters/rdbms/drivers/sqlite"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type (
+	clusterTestGraphs struct {
+		answers chan string
+	}
+
+	clusterTestSender struct{}
+)
+
+func (c clusterTestGraphs) sessionGraph(context.Context, uint64, uint) (*wfexec.Graph, error) {
+	return clusterTestGraph(c.answers), nil
+}
+
+func (clusterTestSender) Send(string, interface{}, ...uint64) error { return nil }
+
+// clusterTestGraph builds start -> prompt -> end graph
+//
+// Prompt step waits for the "answer" from user 42 and sends it to the channel
+func clusterTestGraph(answers chan<- string) *wfexec.Graph {
+	var (
+		g = wfexec.NewGraph()
+
+		pass = func(context.Context, *wfexec.ExecRequest) (wfexec.ExecResponse, error) {
+			return &expr.Vars{}, nil
+		}
+
+		start = wfexec.NewGenericStep(pass)
+		end   = wfexec.NewGenericStep(pass)
+
+		prompt = wfexec.NewGenericStep(func(_ context.Context, r *wfexec.ExecRequest) (wfexec.ExecResponse, error) {
+			if !r.Input.Has("answer") {
+				return wfexec.Prompt(42, "test", nil), nil
+			}
+
+			answers <- r.Input.Dict()["answer"].(string)
+			return r.Input, nil
+		})
+	)
+
+	start.SetID(1)
+	prompt.SetID(2)
+	end.SetID(3)
+
+	g.AddStep(start, prompt)
+	g.AddStep(prompt, end)
+	return g
+}
+
+func TestSessionCluster(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		reg = initRegistry()
+
+		answers = make(chan string, 1)
+
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+
+		s, err = sqlite.ConnectInMemoryWithDebug(ctx)
+
+		// starts a node that shares the store with other nodes
+		node = func(name string, ttl, renewEvery time.Duration) (svc *session, stop context.CancelFunc) {
+			svc = Session(zap.NewNop(), options.WorkflowOpt{CallStackSize: 16}, clusterTestSender{})
+			svc.store = s
+			svc.reg = reg
+			svc.graphs = clusterTestGraphs{answers: answers}
+			svc.cluster = &sessionCluster{
+				store:      s,
+				node:       name,
+				ttl:        ttl,
+				renewEvery: renewEvery,
+				pollEvery:  time.Millisecond * 10,
+			}
+
+			var nodeCtx context.Context
+			nodeCtx, stop = context.WithCancel(ctx)
+			svc.Watch(nodeCtx)
+			return
+		}
+
+		start = func(svc *session) uint64 {
+			_, sessionID, err := svc.Start(ctx, clusterTestGraph(answers), types.SessionStartParams{
+				WorkflowID: 1,
+				Invoker:    auth.Authenticated(42),
+				Input:      &expr.Vars{},
+			})
+
+			req.NoError(err)
+			return sessionID
+		}
+
+		waitFor = func(sessionID uint64, status types.SessionStatus) (ses *types.Session) {
+			req.Eventually(func() bool {
+				ses, err = store.LookupAutomationSessionByID(ctx, s, sessionID)
+				return err == nil && ses.Status == status
+			}, time.Second*5, time.Millisecond*10)
+
+			return
+		}
+
+		answer = func(svc *session, sessionID, stateID uint64) error {
+			input, err := expr.NewVars(map[string]interface{}{"answer": "yes"})
+			req.NoError(err)
+			return svc.Resume(sessionID, stateID, auth.Authenticated(42), input)
+		}
+
+		requireReleased = func(sessionID uint64) {
+			req.Eventually(func() bool {
+				_, err = store.LookupAutomationLeaseByName(ctx, s, sessionLease(sessionID))
+				return errors.IsNotFound(err)
+			}, time.Second*5, time.Millisecond*10)
+		}
+	)
+
+	defer cancel()
+	req.NoError(err)
+	req.NoError(store.Upgrade(ctx, zap.NewNop(), s))
+
+	reg.AddTypes(&expr.Any{}, &expr.String{})
+
+	nodeA, stopA := node("A", time.Second, time.Millisecond*100)
+	defer stopA()
+
+	nodeB, stopB := node("B", time.Second, time.Millisecond*100)
+	defer stopB()
+
+	t.Run("resume is routed to the lease holder", func(t *testing.T) {
+		var (
+			sessionID = start(nodeA)
+			ses       = waitFor(sessionID, types.SessionPrompted)
+		)
+
+		req.NotNil(ses.Snapshot)
+		req.Len(ses.Snapshot.States, 1)
+
+		// prompt is visible on the node that does not run the session
+		pp := nodeB.PendingPrompts(auth.SetIdentityToContext(ctx, auth.Authenticated(42)))
+		req.Len(pp, 1)
+		req.Equal(sessionID, pp[0].SessionID)
+
+		stateID := ses.Snapshot.States[0].StateID
+		req.EqualError(nodeB.Resume(sessionID, stateID, auth.Authenticated(7), &expr.Vars{}), "state access denied")
+		req.EqualError(nodeB.Resume(sessionID, stateID+1, auth.Authenticated(42), &expr.Vars{}), "unexisting state")
+		req.NoError(answer(nodeB, sessionID, stateID))
+
+		req.Equal("yes", <-answers)
+		waitFor(sessionID, types.SessionCompleted)
+		requireReleased(sessionID)
+	})
+
+	t.Run("session of a live node is not adopted", func(t *testing.T) {
+		var (
+			// session without a lease (ie. renewal failed)
+			// that is still running on node B
+			running = &types.Session{
+				ID:        nextID(),
+				Status:    types.SessionStarted,
+				CreatedAt: *now(),
+				Snapshot:  &types.SessionSnapshot{Node: "B"},
+			}
+
+			// session that was running on a node that is gone
+			orphaned = &types.Session{
+				ID:        nextID(),
+				Status:    types.SessionStarted,
+				CreatedAt: *now(),
+				Snapshot:  &types.SessionSnapshot{Node: "gone"},
+			}
+		)
+
+		req.NoError(store.CreateAutomationSession(ctx, s, running, orphaned))
+		req.NoError(nodeA.adopt(ctx))
+
+		ses, err := store.LookupAutomationSessionByID(ctx, s, running.ID)
+		req.NoError(err)
+		req.Equal(types.SessionStarted, ses.Status)
+
+		// orphaned session was interrupted before it was suspended
+		// and can not be restored
+		ses, err = store.LookupAutomationSessionByID(ctx, s, orphaned.ID)
+		req.NoError(err)
+		req.Equal(types.SessionFailed, ses.Status)
+
+		req.NoError(store.DeleteAutomationSession(ctx, s, running, orphaned))
+	})
+
+	t.Run("orphaned session is adopted", func(t *testing.T) {
+		// node that holds short leases and never renews them;
+		// it behaves as if it crashed after the session was suspended
+		nodeC, stopC := node("C", time.Millisecond*200, time.Hour)
+		defer stopC()
+
+		var (
+			sessionID = start(nodeC)
+			ses       = waitFor(sessionID, types.SessionPrompted)
+			stateID   = ses.Snapshot.States[0].StateID
+		)
+
+		req.Eventually(func() bool {
+			nodeA.mux.RLock()
+			defer nodeA.mux.RUnlock()
+			nodeB.mux.RLock()
+			defer nodeB.mux.RUnlock()
+			return nodeA.pool[sessionID] != nil || nodeB.pool[sessionID] != nil
+		}, time.Second*5, time.Millisecond*10)
+
+		// resume through the node that used to run the session;
+		// request is routed to the node that adopted it
+		nodeC.mux.Lock()
+		delete(nodeC.pool, sessionID)
+		nodeC.mux.Unlock()
+
+		req.NoError(answer(nodeC, sessionID, stateID))
+
+		req.Equal("yes", <-answers)
+		waitFor(sessionID, types.SessionCompleted)
+		requireReleased(sessionID)
+	})
+}
@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/wfexec"
+)
+
+type (
+	// SessionReplayParams configure replay of the stored session
+	SessionReplayParams struct {
+		// Index of the stacktrace frame session is replayed from
+		Frame uint
+
+		// Variables merged into the scope of the frame
+		Input *expr.Vars
+
+		// Steps the debugger pauses the new session on
+		Breakpoints []uint64
+	}
+)
+
+// Trace returns stacktrace of the session
+//
+// Runtime stacktrace is returned for sessions running on this server
+func (svc *session) Trace(ctx context.Context, sessionID uint64) (st types.Stacktrace, err error) {
+	var (
+		ses *types.Session
+	)
+
+	if ses, err = svc.LookupByID(ctx, sessionID); err != nil {
+		return
+	}
+
+	svc.mux.RLock()
+	defer svc.mux.RUnlock()
+
+	if running := svc.pool[sessionID]; running != nil && running.RuntimeStacktrace != nil {
+		return running.RuntimeStacktrace, nil
+	}
+
+	return ses.Stacktrace, nil
+}
+
+// DebugState returns breakpoints and states paused by the debugger
+func (svc *session) DebugState(ctx context.Context, sessionID uint64) (ds *types.SessionDebugState, err error) {
+	err = svc.debug(ctx, sessionID, func(ses *types.Session) error {
+		ds = ses.DebugState()
+		return nil
+	})
+
+	return
+}
+
+// UpdateBreakpoints replaces breakpoints of the running session
+func (svc *session) UpdateBreakpoints(ctx context.Context, sessionID uint64, stepID ...uint64) error {
+	return svc.debug(ctx, sessionID, func(ses *types.Session) error {
+		return ses.UpdateBreakpoints(stepID...)
+	})
+}
+
+// UpdateStateScope merges variables into the scope of the paused state
+func (svc *session) UpdateStateScope(ctx context.Context, sessionID, stateID uint64, vars *expr.Vars) error {
+	return svc.debug(ctx, sessionID, func(ses *types.Session) (err error) {
+		if vars != nil {
+			if err = vars.ResolveTypes(svc.reg.Type); err != nil {
+				return
+			}
+		}
+
+		return ses.SetStateScope(stateID, vars)
+	})
+}
+
+// StepState executes the paused state and pauses on the states that follow
+func (svc *session) StepState(ctx context.Context, sessionID, stateID uint64) error {
+	return svc.debug(ctx, sessionID, func(ses *types.Session) error {
+		return ses.Step(ctx, stateID)
+	})
+}
+
+// ContinueState executes the paused state and continues until the next breakpoint
+func (svc *session) ContinueState(ctx context.Context, sessionID, stateID uint64) error {
+	return svc.debug(ctx, sessionID, func(ses *types.Session) error {
+		return ses.Continue(ctx, stateID)
+	})
+}
+
+// Replay starts new session from the stacktrace frame of the stored session
+//
+// New session is started on the step of the frame with the scope the step
+// was originally executed with. Current user is used as invoker and runner.
+//
+// Loops and error handlers that were active when the step was
+// originally executed are not restored.
+func (svc *session) Replay(ctx context.Context, sessionID uint64, p SessionReplayParams) (newSessionID uint64, err error) {
+	var (
+		sap = &sessionActionProps{session: &types.Session{ID: sessionID}}
+	)
+
+	err = func() (err error) {
+		var (
+			ses   *types.Session
+			wf    *types.Workflow
+			g     *wfexec.Graph
+			step  wfexec.Step
+			frame *wfexec.Frame
+			scope *expr.Vars
+			rpl   *types.Session
+		)
+
+		if !svc.ac.CanSearchSessions(ctx) {
+			return SessionErrNotAllowedToRead()
+		}
+
+		if ses, err = loadSession(ctx, svc.store, sessionID); err != nil {
+			return
+		}
+
+		sap.setSession(ses)
+
+		if wf, err = loadWorkflow(ctx, svc.store, ses.WorkflowID); err != nil {
+			return
+		}
+
+		if !svc.ac.CanManageSessionsOnWorkflow(ctx, wf) {
+			return SessionErrNotAllowedToManage(sap)
+		}
+
+		if p.Frame >= uint(len(ses.Stacktrace)) {
+			return SessionErrInvalidFrame(sap)
+		}
+
+		if frame = ses.Stacktrace[p.Frame]; frame.StepID == 0 {
+			// final frame, nothing to replay
+			return SessionErrInvalidFrame(sap)
+		}
+
+		if g, err = svc.graphs.sessionGraph(ctx, ses.WorkflowID, ses.WorkflowRevision); err != nil {
+			return
+		}
+
+		if step = g.StepByID(frame.StepID); step == nil {
+			return SessionErrInvalidFrame(sap)
+		}
+
+		scope = (&expr.Vars{}).MustMerge(frame.Scope)
+		if err = scope.ResolveTypes(svc.reg.Type); err != nil {
+			return
+		}
+
+		if p.Input != nil {
+			if err = p.Input.ResolveTypes(svc.reg.Type); err != nil {
+				return
+			}
+
+			scope = scope.MustMerge(p.Input)
+		}
+
+		rpl, err = svc.prepare(ctx, g, &types.SessionStartParams{
+			Invoker: auth.GetIdentityFromContext(ctx),
+
+			WorkflowID:       ses.WorkflowID,
+			WorkflowRevision: ses.WorkflowRevision,
+			KeepFor:          wf.KeepSessions,
+			Trace:            true,
+			Input:            scope,
+			StepID:           frame.StepID,
+			EventType:        "onReplay",
+			ResourceType:     ses.ResourceType,
+			Breakpoints:      p.Breakpoints,
+		})
+
+		if err != nil {
+			return
+		}
+
+		newSessionID = rpl.ID
+		return rpl.Replay(ctx, step, scope)
+	}()
+
+	return newSessionID, svc.recordAction(ctx, sap, SessionActionReplay, err)
+}
+
+// debug checks access to the session running on this server and calls fn
+func (svc *session) debug(ctx context.Context, sessionID uint64, fn func(*types.Session) error) (err error) {
+	var (
+		sap = &sessionActionProps{session: &types.Session{ID: sessionID}}
+	)
+
+	err = func() (err error) {
+		var (
+			ses *types.Session
+			wf  *types.Workflow
+		)
+
+		if sessionID == 0 {
+			return SessionErrInvalidID()
+		}
+
+		svc.mux.RLock()
+		ses = svc.pool[sessionID]
+		svc.mux.RUnlock()
+
+		if ses == nil {
+			return SessionErrNotRunning(sap)
+		}
+
+		sap.setSession(ses)
+
+		if wf, err = loadWorkflow(ctx, svc.store, ses.WorkflowID); err != nil {
+			return
+		}
+
+		if !svc.ac.CanManageSessionsOnWorkflow(ctx, wf) {
+			return SessionErrNotAllowedToManage(sap)
+		}
+
+		if err = fn(ses); err != nil {
+			return SessionErrDebugFailed(sap).Wrap(err)
+		}
+
+		return nil
+	}()
+
+	return svc.recordAction(ctx, sap, SessionActionDebug, err)
+}
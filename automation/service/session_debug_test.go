@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/options"
+	"github.com/cortezaproject/corteza-server/pkg/wfexec"
+	"github.com/cortezaproject/corteza-server/store"
+	"github.com/cortezaproject/corteza-server/store/adapters/rdbms/drivers/sqlite"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type (
+	debugTestGraphs struct{}
+
+	debugTestAccessControl struct{}
+)
+
+func (debugTestGraphs) sessionGraph(context.Context, uint64, uint) (*wfexec.Graph, error) {
+	return debugTestGraph(), nil
+}
+
+func (debugTestAccessControl) CanSearchSessions(context.Context) bool { return true }
+func (debugTestAccessControl) CanManageSessionsOnWorkflow(context.Context, *types.Workflow) bool {
+	return true
+}
+
+// debugTestGraph builds s1 -> s2 -> s3 graph
+//
+// Each step appends its name to the path variable
+func debugTestGraph() *wfexec.Graph {
+	var (
+		g = wfexec.NewGraph()
+
+		step = func(ID uint64, name string) wfexec.Step {
+			s := wfexec.NewGenericStep(func(_ context.Context, r *wfexec.ExecRequest) (wfexec.ExecResponse, error) {
+				path, _ := r.Scope.Dict()["path"].(string)
+				return expr.NewVars(map[string]interface{}{"path": path + "/" + name})
+			})
+
+			s.SetID(ID)
+			return s
+		}
+
+		s1 = step(1, "s1")
+		s2 = step(2, "s2")
+		s3 = step(3, "s3")
+	)
+
+	g.AddStep(s1, s2)
+	g.AddStep(s2, s3)
+	g.AddStep(s3)
+	return g
+}
+
+func TestSessionDebugger(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+
+		s, err = sqlite.ConnectInMemoryWithDebug(ctx)
+
+		svc = Session(zap.NewNop(), options.WorkflowOpt{CallStackSize: 16, StackTraceEnabled: true}, clusterTestSender{})
+
+		paused = func(sessionID uint64) (p *wfexec.PausedState) {
+			req.Eventually(func() bool {
+				ds, err := svc.DebugState(ctx, sessionID)
+				if err != nil || len(ds.Paused) == 0 {
+					return false
+				}
+
+				p = ds.Paused[0]
+				return true
+			}, time.Second*5, time.Millisecond*10)
+
+			return
+		}
+
+		completed = func(sessionID uint64) (ses *types.Session) {
+			req.Eventually(func() bool {
+				ses, err = store.LookupAutomationSessionByID(ctx, s, sessionID)
+				return err == nil && ses.Status == types.SessionCompleted
+			}, time.Second*5, time.Millisecond*10)
+
+			return
+		}
+
+		finalPath = func(ses *types.Session) interface{} {
+			last := ses.Stacktrace[len(ses.Stacktrace)-1]
+			return last.Scope.Dict()["path"]
+		}
+
+		vars = func(path string) *expr.Vars {
+			vv, err := expr.NewVars(map[string]interface{}{"path": path})
+			req.NoError(err)
+			return vv
+		}
+	)
+
+	defer cancel()
+	req.NoError(err)
+	req.NoError(store.Upgrade(ctx, zap.NewNop(), s))
+	req.NoError(store.CreateAutomationWorkflow(ctx, s, &types.Workflow{ID: 1, Handle: "debug", CreatedAt: time.Now()}))
+
+	ctx = auth.SetIdentityToContext(ctx, auth.Authenticated(42))
+
+	svc.store = s
+	svc.ac = debugTestAccessControl{}
+	svc.graphs = debugTestGraphs{}
+	svc.reg.AddTypes(&expr.Any{}, &expr.String{})
+	svc.Watch(ctx)
+
+	_, sessionID, err := svc.Start(ctx, debugTestGraph(), types.SessionStartParams{
+		WorkflowID:  1,
+		Invoker:     auth.Authenticated(42),
+		Input:       vars(""),
+		Trace:       true,
+		Breakpoints: []uint64{2},
+	})
+	req.NoError(err)
+
+	t.Run("pause on breakpoint, modify scope and continue", func(t *testing.T) {
+		p := paused(sessionID)
+		req.Equal(uint64(2), p.StepID)
+		req.Equal("/s1", p.Scope.Dict()["path"])
+
+		req.NoError(svc.UpdateStateScope(ctx, sessionID, p.StateID, vars("/edited")))
+		req.NoError(svc.ContinueState(ctx, sessionID, p.StateID))
+
+		req.Equal("/edited/s2/s3", finalPath(completed(sessionID)))
+	})
+
+	t.Run("replay from frame", func(t *testing.T) {
+		ses := completed(sessionID)
+
+		frame := -1
+		for i, f := range ses.Stacktrace {
+			if f.StepID == 3 {
+				frame = i
+			}
+		}
+
+		req.NotEqual(-1, frame)
+
+		replayID, err := svc.Replay(ctx, sessionID, SessionReplayParams{Frame: uint(frame), Input: vars("/replayed")})
+		req.NoError(err)
+		req.NotEqual(sessionID, replayID)
+
+		replayed := completed(replayID)
+		req.Equal("onReplay", replayed.EventType)
+		req.Equal("/replayed/s3", finalPath(replayed))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := svc.Replay(ctx, sessionID, SessionReplayParams{Frame: 1000})
+		req.Error(err)
+
+		// session is no longer running
+		req.Eventually(func() bool {
+			_, err = svc.DebugState(ctx, sessionID)
+			return err != nil
+		}, time.Second*5, time.Millisecond*10)
+	})
+}
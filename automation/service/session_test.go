@@ -20,16 +20,16 @@ func TestSession_Start(t *testing.T) {
 		err error
 	)
 
-	_, err = ses.Start(ctx, g, types.SessionStartParams{Invoker: auth.Anonymous()})
+	_, _, err = ses.Start(ctx, g, types.SessionStartParams{Invoker: auth.Anonymous()})
 	req.EqualError(err, "could not find starting step")
 
 	g.AddStep(wfexec.NewGenericStep(nil))
-	_, err = ses.Start(ctx, g, types.SessionStartParams{StepID: 4321, Invoker: auth.Anonymous()})
+	_, _, err = ses.Start(ctx, g, types.SessionStartParams{StepID: 4321, Invoker: auth.Anonymous()})
 	req.EqualError(err, "trigger staring step references non-existing step")
 
 	// Adding another orphaned step and starting session w/o explicitly specifying the starting step
 	g.AddStep(wfexec.NewGenericStep(nil))
-	_, err = ses.Start(ctx, g, types.SessionStartParams{Invoker: auth.Anonymous()})
+	_, _, err = ses.Start(ctx, g, types.SessionStartParams{Invoker: auth.Anonymous()})
 	req.EqualError(err, "cannot start workflow session multiple starting steps found")
 
 	// add a generic step with a known ID so we can use it as a starting point
@@ -38,7 +38,7 @@ func TestSession_Start(t *testing.T) {
 	g.AddStep(s)
 	// add parents to the 42 step
 	g.AddStep(wfexec.NewGenericStep(nil), s)
-	_, err = ses.Start(ctx, g, types.SessionStartParams{StepID: 42, Invoker: auth.Anonymous()})
+	_, _, err = ses.Start(ctx, g, types.SessionStartParams{StepID: 42, Invoker: auth.Anonymous()})
 	req.EqualError(err, "cannot start workflow on a step with parents")
 
 }
@@ -0,0 +1,535 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/pkg/actionlog"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/errors"
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/cortezaproject/corteza-server/pkg/scheduler"
+	"github.com/cortezaproject/corteza-server/pkg/sentry"
+	"github.com/cortezaproject/corteza-server/pkg/wfexec"
+	"github.com/cortezaproject/corteza-server/store"
+	"go.uber.org/zap"
+)
+
+type (
+	// task keeps persisted inbox of the workflow prompts
+	//
+	// Task is created for every prompt, completed when the prompted
+	// state is resumed and canceled when the session is finished
+	task struct {
+		store     store.Storer
+		actionlog actionlog.Recorder
+		ac        taskAccessController
+		log       *zap.Logger
+		session   taskSessionResumer
+
+		// when set, escalation of every tick is leased
+		// so that it runs only on one server instance
+		leaser taskLeaser
+	}
+
+	taskAccessController interface {
+		CanSearchSessions(context.Context) bool
+		CanManageSessionsOnWorkflow(context.Context, *types.Workflow) bool
+	}
+
+	taskSessionResumer interface {
+		resume(sessionID, stateID uint64, i auth.Identifiable, input *expr.Vars) error
+	}
+
+	taskLeaser interface {
+		Acquire(ctx context.Context, name string, ttl time.Duration) (bool, error)
+	}
+)
+
+const (
+	// how often open tasks are checked for missed deadlines
+	taskEscalationInterval = time.Minute
+)
+
+func Task(log *zap.Logger, ses taskSessionResumer) *task {
+	return &task{
+		log:       log,
+		actionlog: DefaultActionlog,
+		store:     DefaultStore,
+		ac:        DefaultAccessControl,
+		session:   ses,
+	}
+}
+
+// Search returns tasks matching the filter
+//
+// Only users that can search sessions can search all tasks,
+// everyone else should use Inbox
+func (svc *task) Search(ctx context.Context, filter types.TaskFilter) (rr types.TaskSet, f types.TaskFilter, err error) {
+	var (
+		tap = &taskActionProps{filter: &filter}
+	)
+
+	err = func() (err error) {
+		if !svc.ac.CanSearchSessions(ctx) {
+			return TaskErrNotAllowedToRead()
+		}
+
+		rr, f, err = store.SearchAutomationTasks(ctx, svc.store, filter)
+		return err
+	}()
+
+	return rr, f, svc.recordAction(ctx, tap, TaskActionSearch, err)
+}
+
+// Inbox returns tasks of the current user
+//
+// Inbox contains tasks assigned to the user and tasks assigned to any
+// of user's roles that are not claimed by another member of the role
+func (svc *task) Inbox(ctx context.Context, filter types.TaskFilter) (rr types.TaskSet, f types.TaskFilter, err error) {
+	var (
+		tap = &taskActionProps{filter: &filter}
+		i   = auth.GetIdentityFromContext(ctx)
+	)
+
+	err = func() (err error) {
+		if !i.Valid() {
+			return TaskErrNotAllowedToRead()
+		}
+
+		if filter.InboxRoleID = i.Roles(); len(filter.AssignedRoleID) > 0 {
+			filter.InboxRoleID = intersectIDs(i.Roles(), filter.AssignedRoleID)
+		}
+
+		filter.InboxUserID = i.Identity()
+		filter.AssignedUserID = nil
+		filter.AssignedRoleID = nil
+
+		rr, f, err = store.SearchAutomationTasks(ctx, svc.store, filter)
+		return err
+	}()
+
+	return rr, f, svc.recordAction(ctx, tap, TaskActionSearch, err)
+}
+
+// LookupByID returns task assigned to the current user or user's role
+func (svc *task) LookupByID(ctx context.Context, taskID uint64) (t *types.Task, err error) {
+	var (
+		tap = &taskActionProps{task: &types.Task{ID: taskID}}
+	)
+
+	err = func() (err error) {
+		if t, err = svc.load(ctx, taskID, tap); err != nil {
+			return
+		}
+
+		if !svc.canRead(ctx, t) {
+			return TaskErrNotAllowedToRead(tap)
+		}
+
+		return nil
+	}()
+
+	return t, svc.recordAction(ctx, tap, TaskActionLookup, err)
+}
+
+// Claim assigns task of the role to the current user
+//
+// Claimed task is removed from inbox of other members of the role
+func (svc *task) Claim(ctx context.Context, taskID uint64) (t *types.Task, err error) {
+	var (
+		tap = &taskActionProps{task: &types.Task{ID: taskID}}
+		i   = auth.GetIdentityFromContext(ctx)
+	)
+
+	err = func() (err error) {
+		if t, err = svc.load(ctx, taskID, tap); err != nil {
+			return
+		}
+
+		if !t.Open() {
+			return TaskErrNotOpen(tap)
+		}
+
+		if t.AssignedRoleID == 0 || t.ClaimedBy > 0 {
+			return TaskErrNotClaimable(tap)
+		}
+
+		if !hasRole(i, t.AssignedRoleID) {
+			return TaskErrNotAllowedToClaim(tap)
+		}
+
+		t.ClaimedBy = i.Identity()
+		t.Status = types.TaskClaimed
+		t.UpdatedAt = now()
+
+		return svc.transition(ctx, t, types.TaskPending, tap)
+	}()
+
+	return t, svc.recordAction(ctx, tap, TaskActionClaim, err)
+}
+
+// Release returns claimed task back to all members of the role
+func (svc *task) Release(ctx context.Context, taskID uint64) (t *types.Task, err error) {
+	var (
+		tap = &taskActionProps{task: &types.Task{ID: taskID}}
+		i   = auth.GetIdentityFromContext(ctx)
+	)
+
+	err = func() (err error) {
+		if t, err = svc.load(ctx, taskID, tap); err != nil {
+			return
+		}
+
+		if !t.Open() {
+			return TaskErrNotOpen(tap)
+		}
+
+		if t.ClaimedBy == 0 {
+			return TaskErrNotClaimed(tap)
+		}
+
+		if t.ClaimedBy != i.Identity() && !svc.canManage(ctx, t) {
+			return TaskErrNotAllowedToRelease(tap)
+		}
+
+		t.ClaimedBy = 0
+		t.Status = types.TaskPending
+		t.UpdatedAt = now()
+
+		return svc.transition(ctx, t, types.TaskClaimed, tap)
+	}()
+
+	return t, svc.recordAction(ctx, tap, TaskActionRelease, err)
+}
+
+// Delegate assigns task to another user
+//
+// Role assignment and claim are removed; task can only be completed
+// by the delegate (or delegated further)
+func (svc *task) Delegate(ctx context.Context, taskID, userID uint64) (t *types.Task, err error) {
+	var (
+		tap = &taskActionProps{task: &types.Task{ID: taskID}}
+		i   = auth.GetIdentityFromContext(ctx)
+	)
+
+	err = func() (err error) {
+		if t, err = svc.load(ctx, taskID, tap); err != nil {
+			return
+		}
+
+		if !t.Open() {
+			return TaskErrNotOpen(tap)
+		}
+
+		if userID == 0 || userID == t.AssignedUserID {
+			return TaskErrInvalidDelegate(tap)
+		}
+
+		if !svc.canAct(ctx, t) {
+			return TaskErrNotAllowedToDelegate(tap)
+		}
+
+		from := t.Status
+
+		t.AssignedUserID = userID
+		t.AssignedRoleID = 0
+		t.ClaimedBy = 0
+		t.DelegatedBy = i.Identity()
+		t.Status = types.TaskPending
+		t.UpdatedAt = now()
+
+		return svc.transition(ctx, t, from, tap)
+	}()
+
+	return t, svc.recordAction(ctx, tap, TaskActionDelegate, err)
+}
+
+// Complete resumes the prompted state of the session with the given input
+//
+// Session is resumed with the identity of the prompt owner
+func (svc *task) Complete(ctx context.Context, taskID uint64, input *expr.Vars) (t *types.Task, err error) {
+	var (
+		tap = &taskActionProps{task: &types.Task{ID: taskID}}
+		i   = auth.GetIdentityFromContext(ctx)
+	)
+
+	err = func() (err error) {
+		if t, err = svc.load(ctx, taskID, tap); err != nil {
+			return
+		}
+
+		if !t.Open() {
+			return TaskErrNotOpen(tap)
+		}
+
+		if !svc.canAct(ctx, t) {
+			return TaskErrNotAllowedToComplete(tap)
+		}
+
+		return svc.close(ctx, t, types.TaskCompleted, i.Identity(), auth.Authenticated(t.OwnerID), input)
+	}()
+
+	return t, svc.recordAction(ctx, tap, TaskActionComplete, err)
+}
+
+// SetLeaser configures leasing of the escalation
+//
+// Must be set when multiple server instances share the same store;
+// escalation runs only on the instance that acquires the lease
+func (svc *task) SetLeaser(l taskLeaser) {
+	svc.leaser = l
+}
+
+// Watch periodically escalates tasks with missed deadlines
+func (svc *task) Watch(ctx context.Context) {
+	ticker := time.NewTicker(taskEscalationInterval)
+
+	go func() {
+		defer sentry.Recover()
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				svc.escalate(ctx)
+			}
+		}
+	}()
+}
+
+// escalate resumes sessions of the overdue tasks
+//
+// Prompted state is resumed with the escalated flag so
+// that the workflow can continue down the escalation path
+func (svc *task) escalate(ctx context.Context) {
+	if svc.leaser != nil {
+		acquired, err := scheduler.AcquireTick(ctx, svc.leaser, "tasks", taskEscalationInterval)
+		if err != nil {
+			svc.log.Warn("failed to acquire task escalation lease", zap.Error(err))
+			return
+		}
+
+		if !acquired {
+			return
+		}
+	}
+
+	set, _, err := store.SearchAutomationTasks(ctx, svc.store, types.TaskFilter{
+		Status:    []string{string(types.TaskPending), string(types.TaskClaimed)},
+		Completed: filter.StateExcluded,
+	})
+
+	if err != nil {
+		svc.log.Error("failed to load open tasks", zap.Error(err))
+		return
+	}
+
+	for _, t := range set {
+		if !t.Overdue(*now()) {
+			continue
+		}
+
+		input, _ := expr.NewVars(nil)
+		_ = input.Set(types.TaskInputEscalated, true)
+
+		err = svc.close(ctx, t, types.TaskEscalated, 0, auth.Authenticated(t.OwnerID), input)
+		if err = svc.recordAction(ctx, &taskActionProps{task: t}, TaskActionEscalate, err); err != nil {
+			svc.log.Error("failed to escalate task", zap.Uint64("taskID", t.ID), zap.Error(err))
+		}
+	}
+}
+
+// close closes the task and resumes the prompted state
+//
+// Task is closed before the state is resumed so that it is not canceled
+// when the session finishes right away and so that it can not be closed twice.
+// When resume fails, task is reopened unless the prompted state is gone;
+// task of the state that no longer exists is canceled.
+func (svc *task) close(ctx context.Context, t *types.Task, status types.TaskStatus, userID uint64, i auth.Identifiable, input *expr.Vars) (err error) {
+	var (
+		open = *t
+	)
+
+	t.Status = status
+	t.CompletedAt = now()
+	t.CompletedBy = userID
+
+	if err = svc.transition(ctx, t, open.Status, &taskActionProps{task: t}); err != nil {
+		return
+	}
+
+	if err = svc.session.resume(t.SessionID, t.StateID, i, input); err == nil {
+		return
+	}
+
+	closed := *t
+	if errors.IsNotFound(err) || errors.Is(err, wfexec.ErrUnexistingState) {
+		t.Status = types.TaskCanceled
+		t.CompletedBy = 0
+	} else {
+		*t = open
+	}
+
+	if rErr := svc.transition(ctx, t, closed.Status, &taskActionProps{task: t}); rErr != nil {
+		svc.log.Error("failed to revert closed task", zap.Uint64("taskID", t.ID), zap.Error(rErr))
+	}
+
+	return
+}
+
+// transition updates the task if its status did not change since it was loaded
+func (svc *task) transition(ctx context.Context, t *types.Task, from types.TaskStatus, tap *taskActionProps) error {
+	if ok, err := store.TransitionAutomationTask(ctx, svc.store, t, from); err != nil {
+		return err
+	} else if !ok {
+		return TaskErrChanged(tap)
+	}
+
+	return nil
+}
+
+// prompted creates tasks for session's pending prompts
+//
+// Prompts that already have a task are skipped
+func (svc *task) prompted(ctx context.Context, ses *types.Session, pp []*wfexec.PendingPrompt) {
+	for _, p := range pp {
+		log := svc.log.With(zap.Uint64("sessionID", ses.ID), zap.Uint64("stateID", p.StateID))
+
+		_, err := store.LookupAutomationTaskBySessionIDStateID(ctx, svc.store, ses.ID, p.StateID)
+		if err == nil {
+			continue
+		} else if !errors.IsNotFound(err) {
+			log.Error("failed to lookup task", zap.Error(err))
+			continue
+		}
+
+		t, err := types.MakeTask(ses, p, *now())
+		if err != nil {
+			log.Error("failed to make task", zap.Error(err))
+			continue
+		}
+
+		t.ID = nextID()
+		if err = store.CreateAutomationTask(ctx, svc.store, t); err != nil {
+			log.Error("failed to create task", zap.Error(err))
+		}
+	}
+}
+
+// resume resumes the prompted state directly on the session
+//
+// Open task of the prompt is completed by the resuming user
+func (svc *task) resume(ctx context.Context, sessionID, stateID uint64, i auth.Identifiable, input *expr.Vars) error {
+	t, err := store.LookupAutomationTaskBySessionIDStateID(ctx, svc.store, sessionID, stateID)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	if t == nil || !t.Open() {
+		return svc.session.resume(sessionID, stateID, i, input)
+	}
+
+	return svc.close(ctx, t, types.TaskCompleted, i.Identity(), i, input)
+}
+
+// finished cancels all open tasks of the completed or failed session
+func (svc *task) finished(ctx context.Context, sessionID uint64) {
+	set, _, err := store.SearchAutomationTasks(ctx, svc.store, types.TaskFilter{
+		SessionID: []uint64{sessionID},
+		Status:    []string{string(types.TaskPending), string(types.TaskClaimed)},
+	})
+
+	if err != nil {
+		svc.log.Error("failed to load session tasks", zap.Error(err))
+		return
+	}
+
+	for _, t := range set {
+		from := t.Status
+		t.Status = types.TaskCanceled
+		t.CompletedAt = now()
+
+		// task closed in the meantime is left as it is
+		if _, err = store.TransitionAutomationTask(ctx, svc.store, t, from); err != nil {
+			svc.log.Error("failed to cancel session task", zap.Uint64("taskID", t.ID), zap.Error(err))
+		}
+	}
+}
+
+func (svc *task) load(ctx context.Context, taskID uint64, tap *taskActionProps) (t *types.Task, err error) {
+	if taskID == 0 {
+		return nil, TaskErrInvalidID()
+	}
+
+	if t, err = store.LookupAutomationTaskByID(ctx, svc.store, taskID); errors.IsNotFound(err) {
+		return nil, TaskErrNotFound(tap)
+	} else if err != nil {
+		return
+	}
+
+	tap.setTask(t)
+	return
+}
+
+// canRead checks if current user is involved in the task or can manage workflow's sessions
+func (svc *task) canRead(ctx context.Context, t *types.Task) bool {
+	i := auth.GetIdentityFromContext(ctx)
+	if i.Valid() && (i.Identity() == t.OwnerID || i.Identity() == t.DelegatedBy) {
+		return true
+	}
+
+	return svc.canAct(ctx, t)
+}
+
+// canAct checks if current user can complete or delegate the task
+//
+// Task of the role can be completed by any member of the role until it is claimed
+func (svc *task) canAct(ctx context.Context, t *types.Task) bool {
+	i := auth.GetIdentityFromContext(ctx)
+	if t.AssignedTo(i.Identity()) {
+		return true
+	}
+
+	if t.ClaimedBy == 0 && t.AssignedRoleID > 0 && hasRole(i, t.AssignedRoleID) {
+		return true
+	}
+
+	return svc.canManage(ctx, t)
+}
+
+func (svc *task) canManage(ctx context.Context, t *types.Task) bool {
+	wf, err := loadWorkflow(ctx, svc.store, t.WorkflowID)
+	if err != nil {
+		return false
+	}
+
+	return svc.ac.CanManageSessionsOnWorkflow(ctx, wf)
+}
+
+func hasRole(i auth.Identifiable, roleID uint64) bool {
+	for _, r := range i.Roles() {
+		if r == roleID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func intersectIDs(aa, bb []uint64) (out []uint64) {
+	for _, a := range aa {
+		for _, b := range bb {
+			if a == b {
+				out = append(out, a)
+				break
+			}
+		}
+	}
+
+	return
+}
@@ -0,0 +1,807 @@
+package service
+
+// This file is auto-generated.
+//
+// Changes to this file may cause incorrect behavior and will be lost if
+// the code is regenerated.
+//
+// Definitions file that controls how this file is generated:
+// automation/service/task_actions.yaml
+
+import (
+	"context"
+	"fmt"
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/pkg/actionlog"
+	"github.com/cortezaproject/corteza-server/pkg/errors"
+	"github.com/cortezaproject/corteza-server/pkg/locale"
+	"strings"
+	"time"
+)
+
+type (
+	taskActionProps struct {
+		task   *types.Task
+		filter *types.TaskFilter
+	}
+
+	taskAction struct {
+		timestamp time.Time
+		resource  string
+		action    string
+		log       string
+		severity  actionlog.Severity
+
+		// prefix for error when action fails
+		errorMessage string
+
+		props *taskActionProps
+	}
+
+	taskLogMetaKey   struct{}
+	taskPropsMetaKey struct{}
+)
+
+var (
+	// just a placeholder to cover template cases w/o fmt package use
+	_ = fmt.Println
+)
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Props methods
+// setTask updates taskActionProps's task
+//
+// Allows method chaining
+//
+// This function is auto-generated.
+//
+func (p *taskActionProps) setTask(task *types.Task) *taskActionProps {
+	p.task = task
+	return p
+}
+
+// setFilter updates taskActionProps's filter
+//
+// Allows method chaining
+//
+// This function is auto-generated.
+//
+func (p *taskActionProps) setFilter(filter *types.TaskFilter) *taskActionProps {
+	p.filter = filter
+	return p
+}
+
+// Serialize converts taskActionProps to actionlog.Meta
+//
+// This function is auto-generated.
+//
+func (p taskActionProps) Serialize() actionlog.Meta {
+	var (
+		m = make(actionlog.Meta)
+	)
+
+	if p.task != nil {
+		m.Set("task.ID", p.task.ID, true)
+		m.Set("task.SessionID", p.task.SessionID, true)
+		m.Set("task.StateID", p.task.StateID, true)
+	}
+	if p.filter != nil {
+	}
+
+	return m
+}
+
+// tr translates string and replaces meta value placeholder with values
+//
+// This function is auto-generated.
+//
+func (p taskActionProps) Format(in string, err error) string {
+	var (
+		pairs = []string{"{{err}}"}
+		// first non-empty string
+		fns = func(ii ...interface{}) string {
+			for _, i := range ii {
+				if s := fmt.Sprintf("%v", i); len(s) > 0 {
+					return s
+				}
+			}
+
+			return ""
+		}
+	)
+
+	if err != nil {
+		pairs = append(pairs, err.Error())
+	} else {
+		pairs = append(pairs, "nil")
+	}
+
+	if p.task != nil {
+		// replacement for "{{task}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{task}}",
+			fns(
+				p.task.ID,
+				p.task.SessionID,
+				p.task.StateID,
+			),
+		)
+		pairs = append(pairs, "{{task.ID}}", fns(p.task.ID))
+		pairs = append(pairs, "{{task.SessionID}}", fns(p.task.SessionID))
+		pairs = append(pairs, "{{task.StateID}}", fns(p.task.StateID))
+	}
+
+	if p.filter != nil {
+		// replacement for "{{filter}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{filter}}",
+			fns(),
+		)
+	}
+	return strings.NewReplacer(pairs...).Replace(in)
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Action methods
+
+// String returns loggable description as string
+//
+// This function is auto-generated.
+//
+func (a *taskAction) String() string {
+	var props = &taskActionProps{}
+
+	if a.props != nil {
+		props = a.props
+	}
+
+	return props.Format(a.log, nil)
+}
+
+func (e *taskAction) ToAction() *actionlog.Action {
+	return &actionlog.Action{
+		Resource:    e.resource,
+		Action:      e.action,
+		Severity:    e.severity,
+		Description: e.String(),
+		Meta:        e.props.Serialize(),
+	}
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Action constructors
+
+// TaskActionSearch returns "automation:task.search" action
+//
+// This function is auto-generated.
+//
+func TaskActionSearch(props ...*taskActionProps) *taskAction {
+	a := &taskAction{
+		timestamp: time.Now(),
+		resource:  "automation:task",
+		action:    "search",
+		log:       "searched for matching tasks",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// TaskActionLookup returns "automation:task.lookup" action
+//
+// This function is auto-generated.
+//
+func TaskActionLookup(props ...*taskActionProps) *taskAction {
+	a := &taskAction{
+		timestamp: time.Now(),
+		resource:  "automation:task",
+		action:    "lookup",
+		log:       "looked-up for a {{task}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// TaskActionClaim returns "automation:task.claim" action
+//
+// This function is auto-generated.
+//
+func TaskActionClaim(props ...*taskActionProps) *taskAction {
+	a := &taskAction{
+		timestamp: time.Now(),
+		resource:  "automation:task",
+		action:    "claim",
+		log:       "claimed {{task}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// TaskActionRelease returns "automation:task.release" action
+//
+// This function is auto-generated.
+//
+func TaskActionRelease(props ...*taskActionProps) *taskAction {
+	a := &taskAction{
+		timestamp: time.Now(),
+		resource:  "automation:task",
+		action:    "release",
+		log:       "released {{task}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// TaskActionDelegate returns "automation:task.delegate" action
+//
+// This function is auto-generated.
+//
+func TaskActionDelegate(props ...*taskActionProps) *taskAction {
+	a := &taskAction{
+		timestamp: time.Now(),
+		resource:  "automation:task",
+		action:    "delegate",
+		log:       "delegated {{task}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// TaskActionComplete returns "automation:task.complete" action
+//
+// This function is auto-generated.
+//
+func TaskActionComplete(props ...*taskActionProps) *taskAction {
+	a := &taskAction{
+		timestamp: time.Now(),
+		resource:  "automation:task",
+		action:    "complete",
+		log:       "completed {{task}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// TaskActionEscalate returns "automation:task.escalate" action
+//
+// This function is auto-generated.
+//
+func TaskActionEscalate(props ...*taskActionProps) *taskAction {
+	a := &taskAction{
+		timestamp: time.Now(),
+		resource:  "automation:task",
+		action:    "escalate",
+		log:       "escalated {{task}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Error constructors
+
+// TaskErrGeneric returns "automation:task.generic" as *errors.Error
+//
+// This function is auto-generated.
+//
+func TaskErrGeneric(mm ...*taskActionProps) *errors.Error {
+	var p = &taskActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("failed to complete request due to internal error", nil),
+
+		errors.Meta("type", "generic"),
+		errors.Meta("resource", "automation:task"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(taskLogMetaKey{}, "{err}"),
+		errors.Meta(taskPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "task.errors.generic"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// TaskErrNotFound returns "automation:task.notFound" as *errors.Error
+//
+// This function is auto-generated.
+//
+func TaskErrNotFound(mm ...*taskActionProps) *errors.Error {
+	var p = &taskActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("task not found", nil),
+
+		errors.Meta("type", "notFound"),
+		errors.Meta("resource", "automation:task"),
+
+		errors.Meta(taskPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "task.errors.notFound"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// TaskErrInvalidID returns "automation:task.invalidID" as *errors.Error
+//
+// This function is auto-generated.
+//
+func TaskErrInvalidID(mm ...*taskActionProps) *errors.Error {
+	var p = &taskActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid ID", nil),
+
+		errors.Meta("type", "invalidID"),
+		errors.Meta("resource", "automation:task"),
+
+		errors.Meta(taskPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "task.errors.invalidID"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// TaskErrNotOpen returns "automation:task.notOpen" as *errors.Error
+//
+// This function is auto-generated.
+//
+func TaskErrNotOpen(mm ...*taskActionProps) *errors.Error {
+	var p = &taskActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("task is already completed, escalated or canceled", nil),
+
+		errors.Meta("type", "notOpen"),
+		errors.Meta("resource", "automation:task"),
+
+		errors.Meta(taskPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "task.errors.notOpen"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// TaskErrNotClaimable returns "automation:task.notClaimable" as *errors.Error
+//
+// This function is auto-generated.
+//
+func TaskErrNotClaimable(mm ...*taskActionProps) *errors.Error {
+	var p = &taskActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("task is not assigned to a role or is already claimed", nil),
+
+		errors.Meta("type", "notClaimable"),
+		errors.Meta("resource", "automation:task"),
+
+		errors.Meta(taskPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "task.errors.notClaimable"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// TaskErrNotClaimed returns "automation:task.notClaimed" as *errors.Error
+//
+// This function is auto-generated.
+//
+func TaskErrNotClaimed(mm ...*taskActionProps) *errors.Error {
+	var p = &taskActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("task is not claimed", nil),
+
+		errors.Meta("type", "notClaimed"),
+		errors.Meta("resource", "automation:task"),
+
+		errors.Meta(taskPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "task.errors.notClaimed"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// TaskErrChanged returns "automation:task.changed" as *errors.Error
+//
+// This function is auto-generated.
+//
+func TaskErrChanged(mm ...*taskActionProps) *errors.Error {
+	var p = &taskActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("task was changed in the meantime", nil),
+
+		errors.Meta("type", "changed"),
+		errors.Meta("resource", "automation:task"),
+
+		errors.Meta(taskPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "task.errors.changed"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// TaskErrInvalidDelegate returns "automation:task.invalidDelegate" as *errors.Error
+//
+// This function is auto-generated.
+//
+func TaskErrInvalidDelegate(mm ...*taskActionProps) *errors.Error {
+	var p = &taskActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid user to delegate task to", nil),
+
+		errors.Meta("type", "invalidDelegate"),
+		errors.Meta("resource", "automation:task"),
+
+		errors.Meta(taskPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "task.errors.invalidDelegate"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// TaskErrNotAllowedToRead returns "automation:task.notAllowedToRead" as *errors.Error
+//
+// This function is auto-generated.
+//
+func TaskErrNotAllowedToRead(mm ...*taskActionProps) *errors.Error {
+	var p = &taskActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("not allowed to read this task", nil),
+
+		errors.Meta("type", "notAllowedToRead"),
+		errors.Meta("resource", "automation:task"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(taskLogMetaKey{}, "failed to read {{task}}; insufficient permissions"),
+		errors.Meta(taskPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "task.errors.notAllowedToRead"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// TaskErrNotAllowedToClaim returns "automation:task.notAllowedToClaim" as *errors.Error
+//
+// This function is auto-generated.
+//
+func TaskErrNotAllowedToClaim(mm ...*taskActionProps) *errors.Error {
+	var p = &taskActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("not allowed to claim this task", nil),
+
+		errors.Meta("type", "notAllowedToClaim"),
+		errors.Meta("resource", "automation:task"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(taskLogMetaKey{}, "failed to claim {{task}}; insufficient permissions"),
+		errors.Meta(taskPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "task.errors.notAllowedToClaim"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// TaskErrNotAllowedToRelease returns "automation:task.notAllowedToRelease" as *errors.Error
+//
+// This function is auto-generated.
+//
+func TaskErrNotAllowedToRelease(mm ...*taskActionProps) *errors.Error {
+	var p = &taskActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("not allowed to release this task", nil),
+
+		errors.Meta("type", "notAllowedToRelease"),
+		errors.Meta("resource", "automation:task"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(taskLogMetaKey{}, "failed to release {{task}}; insufficient permissions"),
+		errors.Meta(taskPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "task.errors.notAllowedToRelease"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// TaskErrNotAllowedToDelegate returns "automation:task.notAllowedToDelegate" as *errors.Error
+//
+// This function is auto-generated.
+//
+func TaskErrNotAllowedToDelegate(mm ...*taskActionProps) *errors.Error {
+	var p = &taskActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("not allowed to delegate this task", nil),
+
+		errors.Meta("type", "notAllowedToDelegate"),
+		errors.Meta("resource", "automation:task"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(taskLogMetaKey{}, "failed to delegate {{task}}; insufficient permissions"),
+		errors.Meta(taskPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "task.errors.notAllowedToDelegate"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// TaskErrNotAllowedToComplete returns "automation:task.notAllowedToComplete" as *errors.Error
+//
+// This function is auto-generated.
+//
+func TaskErrNotAllowedToComplete(mm ...*taskActionProps) *errors.Error {
+	var p = &taskActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("not allowed to complete this task", nil),
+
+		errors.Meta("type", "notAllowedToComplete"),
+		errors.Meta("resource", "automation:task"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(taskLogMetaKey{}, "failed to complete {{task}}; insufficient permissions"),
+		errors.Meta(taskPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "task.errors.notAllowedToComplete"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+
+// recordAction is a service helper function wraps function that can return error
+//
+// It will wrap unrecognized/internal errors with generic errors.
+//
+// This function is auto-generated.
+//
+func (svc task) recordAction(ctx context.Context, props *taskActionProps, actionFn func(...*taskActionProps) *taskAction, err error) error {
+	if svc.actionlog == nil || actionFn == nil {
+		// action log disabled or no action fn passed, return error as-is
+		return err
+	} else if err == nil {
+		// action completed w/o error, record it
+		svc.actionlog.Record(ctx, actionFn(props).ToAction())
+		return nil
+	}
+
+	a := actionFn(props).ToAction()
+
+	// Extracting error information and recording it as action
+	a.Error = err.Error()
+
+	switch c := err.(type) {
+	case *errors.Error:
+		m := c.Meta()
+
+		a.Error = err.Error()
+		a.Severity = actionlog.Severity(m.AsInt("severity"))
+		a.Description = props.Format(m.AsString(taskLogMetaKey{}), err)
+
+		if p, has := m[taskPropsMetaKey{}]; has {
+			a.Meta = p.(*taskActionProps).Serialize()
+		}
+
+		svc.actionlog.Record(ctx, a)
+	default:
+		svc.actionlog.Record(ctx, a)
+	}
+
+	// Original error is passed on
+	return err
+}
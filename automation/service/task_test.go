@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/options"
+	"github.com/cortezaproject/corteza-server/pkg/wfexec"
+	"github.com/cortezaproject/corteza-server/store"
+	"github.com/cortezaproject/corteza-server/store/adapters/rdbms/drivers/sqlite"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type (
+	taskTestAccessControl struct{}
+
+	taskTestLeaser bool
+)
+
+func (l taskTestLeaser) Acquire(context.Context, string, time.Duration) (bool, error) {
+	return bool(l), nil
+}
+
+func (taskTestAccessControl) CanSearchSessions(context.Context) bool { return true }
+func (taskTestAccessControl) CanManageSessionsOnWorkflow(context.Context, *types.Workflow) bool {
+	return false
+}
+
+// taskTestGraph builds start -> prompt -> end graph
+//
+// Prompt step is assigned to the role 7 and sends the input it is resumed with to the channel
+func taskTestGraph(dueAt time.Time, inputs chan<- map[string]interface{}) *wfexec.Graph {
+	var (
+		g = wfexec.NewGraph()
+
+		pass = func(context.Context, *wfexec.ExecRequest) (wfexec.ExecResponse, error) {
+			return &expr.Vars{}, nil
+		}
+
+		start = wfexec.NewGenericStep(pass)
+		end   = wfexec.NewGenericStep(pass)
+
+		prompt = wfexec.NewGenericStep(func(_ context.Context, r *wfexec.ExecRequest) (wfexec.ExecResponse, error) {
+			if r.Input == nil {
+				payload, _ := expr.NewVars(nil)
+				_ = payload.Set(types.TaskArgAssignRole, uint64(7))
+				_ = payload.Set(types.TaskArgDueAt, dueAt)
+				return wfexec.Prompt(42, "approval", payload), nil
+			}
+
+			inputs <- r.Input.Dict()
+			return r.Input, nil
+		})
+	)
+
+	start.SetID(1)
+	prompt.SetID(2)
+	end.SetID(3)
+
+	g.AddStep(start, prompt)
+	g.AddStep(prompt, end)
+	return g
+}
+
+func TestTaskInbox(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		ctx, cancel = context.WithTimeout(context.Background(), time.Second*10)
+
+		s, err = sqlite.ConnectInMemoryWithDebug(ctx)
+
+		inputs = make(chan map[string]interface{}, 1)
+
+		workflowID = nextID()
+
+		sesSvc = Session(zap.NewNop(), options.WorkflowOpt{CallStackSize: 16}, clusterTestSender{})
+		svc    = Task(zap.NewNop(), sesSvc)
+
+		// role members and a user that is not a member
+		member1  = auth.SetIdentityToContext(ctx, auth.Authenticated(50, 7))
+		member2  = auth.SetIdentityToContext(ctx, auth.Authenticated(51, 7))
+		outsider = auth.SetIdentityToContext(ctx, auth.Authenticated(60))
+
+		start = func(dueAt time.Time) (tsk *types.Task) {
+			_, sessionID, err := sesSvc.Start(ctx, taskTestGraph(dueAt, inputs), types.SessionStartParams{
+				WorkflowID: workflowID,
+				Invoker:    auth.Authenticated(42),
+				Input:      &expr.Vars{},
+			})
+			req.NoError(err)
+
+			req.Eventually(func() bool {
+				set, _, err := store.SearchAutomationTasks(ctx, s, types.TaskFilter{SessionID: []uint64{sessionID}})
+				if err != nil || len(set) == 0 {
+					return false
+				}
+
+				tsk = set[0]
+				return true
+			}, time.Second*5, time.Millisecond*10)
+
+			return
+		}
+
+		inbox = func(ctx context.Context) types.TaskSet {
+			set, _, err := svc.Inbox(ctx, types.TaskFilter{Status: []string{string(types.TaskPending), string(types.TaskClaimed)}})
+			req.NoError(err)
+			return set
+		}
+
+		lookup = func(taskID uint64) *types.Task {
+			tsk, err := store.LookupAutomationTaskByID(ctx, s, taskID)
+			req.NoError(err)
+			return tsk
+		}
+	)
+
+	defer cancel()
+	req.NoError(err)
+	req.NoError(store.Upgrade(ctx, zap.NewNop(), s))
+	req.NoError(store.CreateAutomationWorkflow(ctx, s, &types.Workflow{ID: workflowID, Handle: "tasks", CreatedAt: time.Now()}))
+
+	sesSvc.store = s
+	sesSvc.tasks = svc
+	sesSvc.reg.AddTypes(&expr.Any{})
+	sesSvc.Watch(ctx)
+
+	svc.store = s
+	svc.ac = taskTestAccessControl{}
+
+	t.Run("claim, release, delegate and complete", func(t *testing.T) {
+		tsk := start(time.Now().Add(time.Hour))
+		req.Equal(types.TaskPending, tsk.Status)
+		req.Equal(uint64(7), tsk.AssignedRoleID)
+		req.Equal(uint64(2), tsk.StepID)
+		req.Equal("approval", tsk.Ref)
+
+		req.Len(inbox(member1), 1)
+		req.Len(inbox(member2), 1)
+		req.Len(inbox(outsider), 0)
+
+		_, err = svc.Claim(outsider, tsk.ID)
+		req.Error(err)
+
+		_, err = svc.Claim(member1, tsk.ID)
+		req.NoError(err)
+		req.Len(inbox(member2), 0)
+
+		// only claimer can release or complete claimed task
+		_, err = svc.Release(member2, tsk.ID)
+		req.Error(err)
+		_, err = svc.Complete(member2, tsk.ID, nil)
+		req.Error(err)
+
+		_, err = svc.Release(member1, tsk.ID)
+		req.NoError(err)
+		req.Len(inbox(member2), 1)
+
+		_, err = svc.Delegate(member2, tsk.ID, 60)
+		req.NoError(err)
+		req.Len(inbox(member1), 0)
+		req.Len(inbox(outsider), 1)
+
+		answer, _ := expr.NewVars(map[string]interface{}{"answer": "yes"})
+		_, err = svc.Complete(outsider, tsk.ID, answer)
+		req.NoError(err)
+		req.Equal("yes", (<-inputs)["answer"])
+
+		tsk = lookup(tsk.ID)
+		req.Equal(types.TaskCompleted, tsk.Status)
+		req.Equal(uint64(60), tsk.CompletedBy)
+		req.Equal(uint64(51), tsk.DelegatedBy)
+		req.NotNil(tsk.CompletedAt)
+
+		_, err = svc.Complete(outsider, tsk.ID, answer)
+		req.Error(err)
+	})
+
+	t.Run("stale claim is rejected", func(t *testing.T) {
+		tsk := start(time.Now().Add(time.Hour))
+		stale := lookup(tsk.ID)
+
+		_, err = svc.Claim(member1, tsk.ID)
+		req.NoError(err)
+
+		stale.ClaimedBy = 51
+		stale.Status = types.TaskClaimed
+		claimed, err := store.TransitionAutomationTask(ctx, s, stale, types.TaskPending)
+		req.NoError(err)
+		req.False(claimed)
+		req.Equal(uint64(50), lookup(tsk.ID).ClaimedBy)
+
+		answer, _ := expr.NewVars(map[string]interface{}{"answer": "yes"})
+		_, err = svc.Complete(member1, tsk.ID, answer)
+		req.NoError(err)
+		req.Equal("yes", (<-inputs)["answer"])
+	})
+
+	t.Run("task of the unexisting state is canceled", func(t *testing.T) {
+		tsk := &types.Task{
+			ID:             nextID(),
+			SessionID:      nextID(),
+			StateID:        nextID(),
+			WorkflowID:     workflowID,
+			OwnerID:        42,
+			AssignedUserID: 60,
+			Status:         types.TaskPending,
+			CreatedAt:      time.Now(),
+		}
+
+		req.NoError(store.CreateAutomationTask(ctx, s, tsk))
+
+		_, err = svc.Complete(outsider, tsk.ID, nil)
+		req.Error(err)
+		req.Equal(types.TaskCanceled, lookup(tsk.ID).Status)
+	})
+
+	t.Run("open tasks of the finished session are canceled", func(t *testing.T) {
+		var (
+			sessionID = nextID()
+
+			open = &types.Task{ID: nextID(), SessionID: sessionID, StateID: nextID(), Status: types.TaskClaimed, CreatedAt: time.Now()}
+			done = &types.Task{ID: nextID(), SessionID: sessionID, StateID: nextID(), Status: types.TaskCompleted, CreatedAt: time.Now()}
+		)
+
+		req.NoError(store.CreateAutomationTask(ctx, s, open, done))
+
+		svc.finished(ctx, sessionID)
+		req.Equal(types.TaskCanceled, lookup(open.ID).Status)
+		req.Equal(types.TaskCompleted, lookup(done.ID).Status)
+	})
+
+	t.Run("escalate overdue", func(t *testing.T) {
+		tsk := start(time.Now().Add(-time.Minute))
+
+		// escalated by another instance
+		svc.SetLeaser(taskTestLeaser(false))
+		svc.escalate(ctx)
+		req.Equal(types.TaskPending, lookup(tsk.ID).Status)
+
+		svc.SetLeaser(taskTestLeaser(true))
+		svc.escalate(ctx)
+		req.Equal(true, (<-inputs)[types.TaskInputEscalated])
+		req.Equal(types.TaskEscalated, lookup(tsk.ID).Status)
+	})
+
+	t.Run("complete on session resume", func(t *testing.T) {
+		tsk := start(time.Now().Add(time.Hour))
+
+		answer, _ := expr.NewVars(map[string]interface{}{"answer": "no"})
+		req.NoError(sesSvc.Resume(tsk.SessionID, tsk.StateID, auth.Authenticated(42), answer))
+		req.Equal("no", (<-inputs)["answer"])
+
+		tsk = lookup(tsk.ID)
+		req.Equal(types.TaskCompleted, tsk.Status)
+		req.Equal(uint64(42), tsk.CompletedBy)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/cortezaproject/corteza-server/automation/types"
 	cmpEvent "github.com/cortezaproject/corteza-server/compose/service/event"
@@ -73,6 +74,9 @@ const (
 	triggerUnchanged     triggerChanges = 0
 	triggerChanged       triggerChanges = 1
 	triggerLabelsChanged triggerChanges = 2
+
+	// number of fire times returned by schedule preview when limit is not set
+	triggerScheduleDefaultLimit = 10
 )
 
 func Trigger(log *zap.Logger, opt options.WorkflowOpt) *trigger {
@@ -183,6 +187,42 @@ func (svc *trigger) LookupByID(ctx context.Context, triggerID uint64) (res *type
 	return res, svc.recordAction(ctx, wap, TriggerActionLookup, err)
 }
 
+// Schedule returns up to limit next fire times of the interval or timestamp trigger
+func (svc *trigger) Schedule(ctx context.Context, triggerID uint64, limit uint) (tt []time.Time, err error) {
+	var (
+		wap = &triggerActionProps{trigger: &types.Trigger{ID: triggerID}}
+		res *types.Trigger
+	)
+
+	if limit == 0 {
+		limit = triggerScheduleDefaultLimit
+	}
+
+	err = func() error {
+		if !svc.ac.CanSearchTriggers(ctx) {
+			return TriggerErrNotAllowedToRead()
+		}
+
+		if res, err = loadTrigger(ctx, svc.store, triggerID); err != nil {
+			return err
+		}
+
+		wap.setTrigger(res)
+
+		if !res.Scheduled() {
+			return TriggerErrNotScheduled(wap)
+		}
+
+		if tt, err = res.FireTimes(*now(), limit); err != nil {
+			return TriggerErrInvalidSchedule(wap).Wrap(err)
+		}
+
+		return nil
+	}()
+
+	return tt, svc.recordAction(ctx, wap, TriggerActionSchedule, err)
+}
+
 // Create adds new trigger resource and saves it into store
 // It updates service's cache
 func (svc *trigger) Create(ctx context.Context, new *types.Trigger) (res *types.Trigger, err error) {
@@ -196,7 +236,8 @@ func (svc *trigger) Create(ctx context.Context, new *types.Trigger) (res *types.
 			wf *types.Workflow
 		)
 
-		if wf, err = loadWorkflow(ctx, svc.store, new.WorkflowID); err != nil {
+		// triggers are bound to the published workflow revision
+		if wf, err = loadPublishedWorkflow(ctx, svc.store, new.WorkflowID); err != nil {
 			return err
 		}
 
@@ -454,11 +495,16 @@ func (svc *trigger) registerWorkflows(ctx context.Context, workflows ...*types.W
 //
 // Loads associated workflow and registers specific trigger
 func (svc *trigger) updateTriggerRegistration(ctx context.Context, t *types.Trigger) error {
-	wf, err := loadWorkflow(ctx, svc.store, t.WorkflowID)
+	wf, err := loadPublishedWorkflow(ctx, svc.store, t.WorkflowID)
 	if err != nil {
 		return err
 	}
 
+	if wf.PublishedRevision == 0 {
+		// triggers are registered when the workflow is published
+		return nil
+	}
+
 	return svc.registerWorkflow(ctx, wf, t)
 }
 
@@ -585,15 +631,31 @@ func (svc *trigger) registerTriggers(wf *types.Workflow, runAs auth.Identifiable
 			eventbus.For(t.ResourceType),
 		)
 
-		for _, c := range t.Constraints {
-			if cnstr, err = eventbus.ConstraintMaker(c.Name, c.Op, c.Values...); err != nil {
+		if t.Interval() {
+			// interval constraints (crontab expressions, timezone and holiday calendars)
+			// are combined into a single schedule
+			if cnstr, err = t.Schedule(); err != nil {
 				log.Debug(
-					"failed to make constraint for workflow trigger",
-					zap.Any("constraint", c),
+					"failed to make schedule for workflow trigger",
+					zap.Any("constraints", t.Constraints),
 					zap.Error(err),
 				)
-			} else {
-				ops = append(ops, eventbus.Constraint(cnstr))
+
+				continue
+			}
+
+			ops = append(ops, eventbus.Constraint(cnstr))
+		} else {
+			for _, c := range t.Constraints {
+				if cnstr, err = eventbus.ConstraintMaker(c.Name, c.Op, c.Values...); err != nil {
+					log.Debug(
+						"failed to make constraint for workflow trigger",
+						zap.Any("constraint", c),
+						zap.Error(err),
+					)
+				} else {
+					ops = append(ops, eventbus.Constraint(cnstr))
+				}
 			}
 		}
 
@@ -702,6 +764,15 @@ func validateWorkflowTriggers(wf *types.Workflow, tt ...*types.Trigger) (wis typ
 			continue
 		}
 
+		if t.Interval() {
+			if _, err := t.Schedule(); err != nil {
+				wis = wis.Append(
+					errors.InvalidData("invalid interval trigger constraints: %v", err),
+					map[string]int{"trigger": i},
+				)
+			}
+		}
+
 		for _, ev := range requireRunAs {
 			if t.ResourceType == ev.ResourceType() && t.EventType == ev.EventType() {
 				if wf.RunAs == 0 {
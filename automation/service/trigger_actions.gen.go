@@ -344,6 +344,26 @@ func TriggerActionUndelete(props ...*triggerActionProps) *triggerAction {
 	return a
 }
 
+// TriggerActionSchedule returns "automation:trigger.schedule" action
+//
+// This function is auto-generated.
+//
+func TriggerActionSchedule(props ...*triggerActionProps) *triggerAction {
+	a := &triggerAction{
+		timestamp: time.Now(),
+		resource:  "automation:trigger",
+		action:    "schedule",
+		log:       "previewed schedule of {{trigger}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
 // *********************************************************************************************************************
 // *********************************************************************************************************************
 // Error constructors
@@ -452,6 +472,72 @@ func TriggerErrInvalidID(mm ...*triggerActionProps) *errors.Error {
 	return e
 }
 
+// TriggerErrNotScheduled returns "automation:trigger.notScheduled" as *errors.Error
+//
+// This function is auto-generated.
+//
+func TriggerErrNotScheduled(mm ...*triggerActionProps) *errors.Error {
+	var p = &triggerActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("trigger is not fired on interval or timestamp", nil),
+
+		errors.Meta("type", "notScheduled"),
+		errors.Meta("resource", "automation:trigger"),
+
+		errors.Meta(triggerPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "trigger.errors.notScheduled"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// TriggerErrInvalidSchedule returns "automation:trigger.invalidSchedule" as *errors.Error
+//
+// This function is auto-generated.
+//
+func TriggerErrInvalidSchedule(mm ...*triggerActionProps) *errors.Error {
+	var p = &triggerActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid trigger schedule", nil),
+
+		errors.Meta("type", "invalidSchedule"),
+		errors.Meta("resource", "automation:trigger"),
+
+		errors.Meta(triggerPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "trigger.errors.invalidSchedule"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
 // TriggerErrStaleData returns "automation:trigger.staleData" as *errors.Error
 //
 //
@@ -236,9 +236,15 @@ func (svc *workflow) Create(ctx context.Context, new *types.Workflow) (wf *types
 			return
 		}
 
-		svc.updateCache(wf, runAs, g)
-
+		// initial definition is published right away;
+		// definition with issues is kept as a draft until fixed and published
 		if len(wf.Issues) == 0 {
+			if err = createWorkflowRevision(ctx, s, wf); err != nil {
+				return
+			}
+
+			svc.updateCache(wf, runAs, g)
+
 			if err = svc.triggers.registerWorkflows(ctx, wf); err != nil {
 				return err
 			}
@@ -312,8 +318,6 @@ func (svc *workflow) updater(ctx context.Context, workflowID uint64, action func
 		res     *types.Workflow
 		aProps  = &workflowActionProps{workflow: &types.Workflow{ID: workflowID}}
 		err     error
-		g       *wfexec.Graph
-		runAs   intAuth.Identifiable
 	)
 
 	err = store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) (err error) {
@@ -333,16 +337,15 @@ func (svc *workflow) updater(ctx context.Context, workflowID uint64, action func
 			return err
 		}
 
-		if g, runAs, err = svc.validateWorkflow(ctx, res); err != nil {
+		// validate the draft, issues are stored with the workflow
+		if _, _, err = svc.validateWorkflow(ctx, res); err != nil {
 			return
 		}
 
-		svc.updateCache(res, runAs, g)
-
-		if len(res.Issues) == 0 {
-			if err = svc.triggers.registerWorkflows(ctx, res); err != nil {
-				return err
-			}
+		// changes on the draft definition do not affect the published revision
+		// but changes on the workflow itself (enabled, deleted...) do
+		if err = svc.activate(ctx, s, res); err != nil {
+			return
 		}
 
 		if changes&workflowChanged > 0 || len(res.Issues) > 0 {
@@ -502,25 +505,46 @@ func (svc *workflow) Load(ctx context.Context) error {
 		return err
 	}
 
+	pub := make(types.WorkflowSet, 0, len(set))
 	for _, wf := range set {
 		svc.wIndex[wf.Handle] = wf.ID
 
+		if wf.PublishedRevision == 0 {
+			// never published, draft is not executed
+			continue
+		}
+
+		if wf, err = publishedWorkflow(ctx, svc.store, wf); err != nil {
+			return err
+		}
+
 		if g, runAs, err = svc.validateWorkflow(ctx, wf); err != nil {
 			continue
 		}
 
 		svc.updateCache(wf, runAs, g)
+		pub = append(pub, wf)
 	}
 
-	return svc.triggers.registerWorkflows(ctx, set...)
+	return svc.triggers.registerWorkflows(ctx, pub...)
 }
 
 // updateCache
 func (svc *workflow) updateCache(wf *types.Workflow, runAs intAuth.Identifiable, g *wfexec.Graph) {
 	defer svc.mux.Unlock()
 	svc.mux.Lock()
+
+	if c := svc.cache[wf.ID]; c != nil && c.wf.Handle != wf.Handle && svc.wIndex[c.wf.Handle] == wf.ID {
+		// handle changed
+		delete(svc.wIndex, c.wf.Handle)
+	}
+
 	if wf.Executable() {
 		svc.cache[wf.ID] = &wfCacheItem{g: g, wf: wf, runAs: runAs}
+
+		if wf.Handle != "" {
+			svc.wIndex[wf.Handle] = wf.ID
+		}
 	} else {
 		// remove deleted
 		delete(svc.cache, wf.ID)
@@ -532,104 +556,192 @@ func (svc *workflow) updateCache(wf *types.Workflow, runAs intAuth.Identifiable,
 func (svc *workflow) Exec(ctx context.Context, workflowID uint64, p types.WorkflowExecParams) (*expr.Vars, types.Stacktrace, error) {
 	var (
 		wap     = &workflowActionProps{}
-		t       *types.Trigger
 		results *expr.Vars
+		wf      *types.Workflow
 		wait    WaitFn
 
 		stacktrace types.Stacktrace
 	)
 
 	err := func() (err error) {
-		svc.mux.Lock()
-		if nil == svc.cache[workflowID] || nil == svc.cache[workflowID].wf {
-			svc.mux.Unlock()
-			return WorkflowErrNotFound()
+		if wf, wait, _, err = svc.start(ctx, wap, workflowID, &p); err != nil {
+			return
 		}
 
-		wf := svc.cache[workflowID].wf
-		svc.mux.Unlock()
+		if !p.Async {
+			if !p.Wait && wf.CheckDeferred() {
+				// deferred workflow, return right away and keep the workflow session
+				// running without waiting for the execution
+				return nil
+			}
+		}
 
-		wap.setWorkflow(wf)
+		// wait for the workflow to complete
+		// reuse scope for results
+		// this will be decoded back to event properties
+		results, _, stacktrace, err = wait(ctx)
+		return err
+	}()
 
-		if !svc.ac.CanExecuteWorkflow(ctx, wf) {
-			return WorkflowErrNotAllowedToExecute()
-		}
+	return results, stacktrace, svc.recordAction(ctx, wap, WorkflowActionExecute, err)
+}
 
-		if !wf.Enabled && !p.Trace {
-			return WorkflowErrDisabled()
-		}
+// Debug starts traced workflow session that pauses on the given breakpoints
+//
+// Function does not wait for the session to complete; paused session is
+// inspected and controlled through the session service
+func (svc *workflow) Debug(ctx context.Context, workflowID uint64, p types.WorkflowExecParams) (sessionID uint64, err error) {
+	var (
+		wap = &workflowActionProps{}
+	)
 
-		// Find the trigger.
-		// @todo can we cache this as well?
-		t, err = func() (*types.Trigger, error) {
-			var tt types.TriggerSet
-			// Load triggers directly from the store. At this point we do not care
-			// about trigger search or read permissions
-			tt, err = loadWorkflowTriggers(ctx, svc.store, workflowID)
-			if err != nil {
-				return nil, err
-			}
+	err = func() (err error) {
+		p.Trace = true
+		p.Async = true
 
-			if p.StepID == 0 && len(tt) > 0 {
-				return tt[0], nil
-			} else {
-				for _, tMatch := range tt {
-					if tMatch.StepID == p.StepID {
-						return tMatch, nil
-					}
-				}
+		_, _, sessionID, err = svc.start(ctx, wap, workflowID, &p)
+		return
+	}()
+
+	return sessionID, svc.recordAction(ctx, wap, WorkflowActionDebug, err)
+}
+
+// ExecSubWorkflow executes workflow from inside of another workflow's session
+//
+// Workflow is referenced by ID or, when ID is not set, by handle. Caller's session
+// and step are read from the context and stored on the new session.
+//
+// Unlike Exec, workflow without a trigger is started on its (only) orphan step.
+// With async execution, function returns right after session is started.
+func (svc *workflow) ExecSubWorkflow(ctx context.Context, workflowID uint64, handle string, p types.WorkflowExecParams) (results *expr.Vars, sessionID uint64, err error) {
+	var (
+		wap  = &workflowActionProps{}
+		wait WaitFn
+	)
+
+	err = func() (err error) {
+		if workflowID == 0 {
+			if workflowID = svc.cachedIDByHandle(handle); workflowID == 0 {
+				return WorkflowErrNotFound()
 			}
+		}
 
-			return nil, nil
-		}()
+		caller := wfexec.GetContextCaller(ctx)
+		p.CallerWorkflowID = caller.WorkflowID
+		p.CallerSessionID = caller.SessionID
+		p.CallerStepID = caller.StepID
 
-		if err != nil {
+		if _, wait, sessionID, err = svc.start(ctx, wap, workflowID, &p); err != nil {
 			return
 		}
 
-		if !p.Trace {
-			if t == nil {
-				return WorkflowErrUnknownWorkflowStep()
-			} else if !t.Enabled {
-				return WorkflowErrDisabled()
-			}
+		if p.Async {
+			return nil
 		}
 
-		if t != nil {
-			wap.setTrigger(t)
-			p.StepID = t.StepID
-			p.EventType = t.EventType
-			p.ResourceType = t.ResourceType
+		results, _, _, err = wait(ctx)
+		return err
+	}()
 
-			// merge with input from trigger
-			// with trigger input vars are overwritten by input vars
-			p.Input = t.Input.MustMerge(p.Input)
-		} else {
-			p.EventType = "onTrace"
-		}
+	return results, sessionID, svc.recordAction(ctx, wap, WorkflowActionExecute, err)
+}
+
+// start checks permissions, resolves the trigger and starts new workflow session
+func (svc *workflow) start(ctx context.Context, wap *workflowActionProps, workflowID uint64, p *types.WorkflowExecParams) (wf *types.Workflow, wait WaitFn, sessionID uint64, err error) {
+	var (
+		t *types.Trigger
+	)
 
-		wait, err = svc.exec(ctx, wf, p)
+	svc.mux.Lock()
+	if nil == svc.cache[workflowID] || nil == svc.cache[workflowID].wf {
+		svc.mux.Unlock()
+		return nil, nil, 0, WorkflowErrNotFound()
+	}
 
+	wf = svc.cache[workflowID].wf
+	svc.mux.Unlock()
+
+	wap.setWorkflow(wf)
+
+	if !svc.ac.CanExecuteWorkflow(ctx, wf) {
+		return nil, nil, 0, WorkflowErrNotAllowedToExecute()
+	}
+
+	if !wf.Enabled && !p.Trace {
+		return nil, nil, 0, WorkflowErrDisabled()
+	}
+
+	// Find the trigger.
+	// @todo can we cache this as well?
+	t, err = func() (*types.Trigger, error) {
+		var tt types.TriggerSet
+		// Load triggers directly from the store. At this point we do not care
+		// about trigger search or read permissions
+		tt, err = loadWorkflowTriggers(ctx, svc.store, workflowID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		if !p.Async {
-			if !p.Wait && wf.CheckDeferred() {
-				// deferred workflow, return right away and keep the workflow session
-				// running without waiting for the execution
-				return nil
+		if p.StepID == 0 && len(tt) > 0 {
+			return tt[0], nil
+		} else {
+			for _, tMatch := range tt {
+				if tMatch.StepID == p.StepID {
+					return tMatch, nil
+				}
 			}
 		}
 
-		// wait for the workflow to complete
-		// reuse scope for results
-		// this will be decoded back to event properties
-		results, _, stacktrace, err = wait(ctx)
-		return err
+		return nil, nil
 	}()
 
-	return results, stacktrace, svc.recordAction(ctx, wap, WorkflowActionExecute, err)
+	if err != nil {
+		return
+	}
+
+	if !p.Trace {
+		// sub-workflows can be executed without a trigger
+		if t == nil && p.CallerSessionID == 0 {
+			return nil, nil, 0, WorkflowErrUnknownWorkflowStep()
+		} else if t != nil && !t.Enabled {
+			return nil, nil, 0, WorkflowErrDisabled()
+		}
+	}
+
+	switch {
+	case t != nil:
+		wap.setTrigger(t)
+		p.StepID = t.StepID
+		p.EventType = t.EventType
+		p.ResourceType = t.ResourceType
+
+		// merge with input from trigger
+		// with trigger input vars are overwritten by input vars
+		p.Input = t.Input.MustMerge(p.Input)
+	case p.CallerSessionID > 0:
+		p.EventType = "onSubWorkflow"
+	default:
+		p.EventType = "onTrace"
+	}
+
+	wait, sessionID, err = svc.exec(ctx, wf, *p)
+	return
+}
+
+// cachedIDByHandle returns ID of the executable workflow with the given handle
+func (svc *workflow) cachedIDByHandle(handle string) uint64 {
+	defer svc.mux.Unlock()
+	svc.mux.Lock()
+
+	if handle == "" {
+		return 0
+	}
+
+	if c := svc.cache[svc.wIndex[handle]]; c != nil && c.wf != nil && c.wf.Handle == handle {
+		return c.wf.ID
+	}
+
+	return 0
 }
 
 // validates workflow by trying to convert it to graph and checking assigned triggers
@@ -673,16 +785,16 @@ func (svc *workflow) validateWorkflow(ctx context.Context, wf *types.Workflow) (
 	return
 }
 
-func (svc *workflow) exec(ctx context.Context, wf *types.Workflow, p types.WorkflowExecParams) (WaitFn, error) {
+func (svc *workflow) exec(ctx context.Context, wf *types.Workflow, p types.WorkflowExecParams) (WaitFn, uint64, error) {
 	if wf.Issues != nil {
-		return nil, wf.Issues
+		return nil, 0, wf.Issues
 	}
 
 	defer svc.mux.Unlock()
 	svc.mux.Lock()
 
 	if svc.cache[wf.ID] == nil {
-		return nil, WorkflowErrInvalidID()
+		return nil, 0, WorkflowErrInvalidID()
 	}
 
 	var (
@@ -699,13 +811,17 @@ func (svc *workflow) exec(ctx context.Context, wf *types.Workflow, p types.Workf
 		Invoker: intAuth.GetIdentityFromContext(ctx),
 		Runner:  runAs,
 
-		WorkflowID:   wf.ID,
-		KeepFor:      wf.KeepSessions,
-		Trace:        wf.Trace || p.Trace,
-		Input:        scope,
-		StepID:       p.StepID,
-		EventType:    p.EventType,
-		ResourceType: p.ResourceType,
+		WorkflowID:       wf.ID,
+		WorkflowRevision: wf.PublishedRevision,
+		KeepFor:          wf.KeepSessions,
+		Trace:            wf.Trace || p.Trace,
+		Input:            scope,
+		StepID:           p.StepID,
+		EventType:        p.EventType,
+		ResourceType:     p.ResourceType,
+		CallerSessionID:  p.CallerSessionID,
+		CallerStepID:     p.CallerStepID,
+		Breakpoints:      p.Breakpoints,
 
 		CallStack: wfexec.GetContextCallStack(ctx),
 	})
@@ -724,7 +840,7 @@ func makeWorkflowHandler(svc *workflow, wf *types.Workflow, t *types.Trigger) ev
 			}
 		}
 
-		wait, err := svc.exec(ctx, wf, types.WorkflowExecParams{
+		wait, _, err := svc.exec(ctx, wf, types.WorkflowExecParams{
 			StepID:       t.StepID,
 			EventType:    t.EventType,
 			ResourceType: t.ResourceType,
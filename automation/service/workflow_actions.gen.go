@@ -26,6 +26,8 @@ type (
 		update   *types.Workflow
 		trigger  *types.Trigger
 		filter   *types.WorkflowFilter
+		revision uint
+		testCase *types.WorkflowTestCase
 	}
 
 	workflowAction struct {
@@ -108,6 +110,28 @@ func (p *workflowActionProps) setFilter(filter *types.WorkflowFilter) *workflowA
 	return p
 }
 
+// setRevision updates workflowActionProps's revision
+//
+// Allows method chaining
+//
+// This function is auto-generated.
+//
+func (p *workflowActionProps) setRevision(revision uint) *workflowActionProps {
+	p.revision = revision
+	return p
+}
+
+// setTestCase updates workflowActionProps's testCase
+//
+// Allows method chaining
+//
+// This function is auto-generated.
+//
+func (p *workflowActionProps) setTestCase(testCase *types.WorkflowTestCase) *workflowActionProps {
+	p.testCase = testCase
+	return p
+}
+
 // Serialize converts workflowActionProps to actionlog.Meta
 //
 // This function is auto-generated.
@@ -137,6 +161,10 @@ func (p workflowActionProps) Serialize() actionlog.Meta {
 	}
 	if p.filter != nil {
 	}
+	m.Set("revision", p.revision, true)
+	if p.testCase != nil {
+		m.Set("testCase.ID", p.testCase.ID, true)
+	}
 
 	return m
 }
@@ -234,6 +262,19 @@ func (p workflowActionProps) Format(in string, err error) string {
 			fns(),
 		)
 	}
+	pairs = append(pairs, "{{revision}}", fns(p.revision))
+
+	if p.testCase != nil {
+		// replacement for "{{testCase}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{testCase}}",
+			fns(
+				p.testCase.ID,
+			),
+		)
+		pairs = append(pairs, "{{testCase.ID}}", fns(p.testCase.ID))
+	}
 	return strings.NewReplacer(pairs...).Replace(in)
 }
 
@@ -389,6 +430,186 @@ func WorkflowActionUndelete(props ...*workflowActionProps) *workflowAction {
 	return a
 }
 
+// WorkflowActionPublish returns "automation:workflow.publish" action
+//
+// This function is auto-generated.
+//
+func WorkflowActionPublish(props ...*workflowActionProps) *workflowAction {
+	a := &workflowAction{
+		timestamp: time.Now(),
+		resource:  "automation:workflow",
+		action:    "publish",
+		log:       "published {{workflow}} revision {{revision}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// WorkflowActionRollback returns "automation:workflow.rollback" action
+//
+// This function is auto-generated.
+//
+func WorkflowActionRollback(props ...*workflowActionProps) *workflowAction {
+	a := &workflowAction{
+		timestamp: time.Now(),
+		resource:  "automation:workflow",
+		action:    "rollback",
+		log:       "rolled back {{workflow}} to revision {{revision}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// WorkflowActionSearchRevisions returns "automation:workflow.searchRevisions" action
+//
+// This function is auto-generated.
+//
+func WorkflowActionSearchRevisions(props ...*workflowActionProps) *workflowAction {
+	a := &workflowAction{
+		timestamp: time.Now(),
+		resource:  "automation:workflow",
+		action:    "searchRevisions",
+		log:       "searched for revisions of {{workflow}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// WorkflowActionDiffRevisions returns "automation:workflow.diffRevisions" action
+//
+// This function is auto-generated.
+//
+func WorkflowActionDiffRevisions(props ...*workflowActionProps) *workflowAction {
+	a := &workflowAction{
+		timestamp: time.Now(),
+		resource:  "automation:workflow",
+		action:    "diffRevisions",
+		log:       "compared revisions of {{workflow}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// WorkflowActionSearchTestCases returns "automation:workflow.searchTestCases" action
+//
+// This function is auto-generated.
+//
+func WorkflowActionSearchTestCases(props ...*workflowActionProps) *workflowAction {
+	a := &workflowAction{
+		timestamp: time.Now(),
+		resource:  "automation:workflow",
+		action:    "searchTestCases",
+		log:       "searched for test cases of {{workflow}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// WorkflowActionCreateTestCase returns "automation:workflow.createTestCase" action
+//
+// This function is auto-generated.
+//
+func WorkflowActionCreateTestCase(props ...*workflowActionProps) *workflowAction {
+	a := &workflowAction{
+		timestamp: time.Now(),
+		resource:  "automation:workflow",
+		action:    "createTestCase",
+		log:       "created {{testCase}} for {{workflow}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// WorkflowActionUpdateTestCase returns "automation:workflow.updateTestCase" action
+//
+// This function is auto-generated.
+//
+func WorkflowActionUpdateTestCase(props ...*workflowActionProps) *workflowAction {
+	a := &workflowAction{
+		timestamp: time.Now(),
+		resource:  "automation:workflow",
+		action:    "updateTestCase",
+		log:       "updated {{testCase}} of {{workflow}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// WorkflowActionDeleteTestCase returns "automation:workflow.deleteTestCase" action
+//
+// This function is auto-generated.
+//
+func WorkflowActionDeleteTestCase(props ...*workflowActionProps) *workflowAction {
+	a := &workflowAction{
+		timestamp: time.Now(),
+		resource:  "automation:workflow",
+		action:    "deleteTestCase",
+		log:       "deleted {{testCase}} of {{workflow}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// WorkflowActionRunTestCases returns "automation:workflow.runTestCases" action
+//
+// This function is auto-generated.
+//
+func WorkflowActionRunTestCases(props ...*workflowActionProps) *workflowAction {
+	a := &workflowAction{
+		timestamp: time.Now(),
+		resource:  "automation:workflow",
+		action:    "runTestCases",
+		log:       "ran test cases of {{workflow}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
 // WorkflowActionExecute returns "automation:workflow.execute" action
 //
 // This function is auto-generated.
@@ -409,6 +630,26 @@ func WorkflowActionExecute(props ...*workflowActionProps) *workflowAction {
 	return a
 }
 
+// WorkflowActionDebug returns "automation:workflow.debug" action
+//
+// This function is auto-generated.
+//
+func WorkflowActionDebug(props ...*workflowActionProps) *workflowAction {
+	a := &workflowAction{
+		timestamp: time.Now(),
+		resource:  "automation:workflow",
+		action:    "debug",
+		log:       "{{workflow}} executed in debug mode",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
 // *********************************************************************************************************************
 // *********************************************************************************************************************
 // Error constructors
@@ -551,6 +792,107 @@ func WorkflowErrDisabled(mm ...*workflowActionProps) *errors.Error {
 	return e
 }
 
+// WorkflowErrRevisionNotFound returns "automation:workflow.revisionNotFound" as *errors.Error
+//
+// This function is auto-generated.
+//
+func WorkflowErrRevisionNotFound(mm ...*workflowActionProps) *errors.Error {
+	var p = &workflowActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("workflow revision not found", nil),
+
+		errors.Meta("type", "revisionNotFound"),
+		errors.Meta("resource", "automation:workflow"),
+
+		errors.Meta(workflowPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "workflow.errors.revisionNotFound"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// WorkflowErrTestCaseNotFound returns "automation:workflow.testCaseNotFound" as *errors.Error
+//
+// This function is auto-generated.
+//
+func WorkflowErrTestCaseNotFound(mm ...*workflowActionProps) *errors.Error {
+	var p = &workflowActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("workflow test case not found", nil),
+
+		errors.Meta("type", "testCaseNotFound"),
+		errors.Meta("resource", "automation:workflow"),
+
+		errors.Meta(workflowPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "workflow.errors.testCaseNotFound"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// WorkflowErrUnpublishable returns "automation:workflow.unpublishable" as *errors.Error
+//
+// This function is auto-generated.
+//
+func WorkflowErrUnpublishable(mm ...*workflowActionProps) *errors.Error {
+	var p = &workflowActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("workflow with issues can not be published", nil),
+
+		errors.Meta("type", "unpublishable"),
+		errors.Meta("resource", "automation:workflow"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(workflowLogMetaKey{}, "failed to publish {{workflow}}; workflow has issues"),
+		errors.Meta(workflowPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "automation"),
+		errors.Meta(locale.ErrorMetaKey{}, "workflow.errors.unpublishable"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
 // WorkflowErrInvalidHandle returns "automation:workflow.invalidHandle" as *errors.Error
 //
 //
@@ -325,9 +325,7 @@ func (svc workflowConverter) convDebugStep(_ *types.WorkflowStep) (wfexec.Step,
 }
 
 func (svc workflowConverter) convFunctionStep(g *wfexec.Graph, s *types.WorkflowStep, out []*types.WorkflowPath) (wfexec.Step, error) {
-	reg := Registry()
-
-	if def := reg.Function(s.Ref); def == nil {
+	if def := svc.reg.Function(s.Ref); def == nil {
 		return nil, errors.Internal("unknown function %q", s.Ref)
 	} else {
 		if def.Kind != string(s.Kind) {
@@ -357,6 +355,21 @@ func (svc workflowConverter) convFunctionStep(g *wfexec.Graph, s *types.Workflow
 			return nil, errors.Internal("failed to verify result expressions for %s %s: %s", s.Kind, s.Ref, err).Wrap(err)
 		}
 
+		retry, timeout, err := s.ExecPolicy()
+		if err != nil {
+			return nil, errors.Internal("failed to configure retry policy for %s %s: %s", s.Kind, s.Ref, err).Wrap(err)
+		}
+
+		if !isIterator && s.Concurrency > 1 {
+			return nil, errors.Internal("concurrency is only supported on iterators")
+		}
+
+		if isIterator && s.Concurrency > 1 && s.Ref == "loopDo" {
+			// loopDo condition depends on the scope modified by
+			// the previous iteration; can not run in parallel
+			return nil, errors.Internal("concurrency is not supported on %s %s", s.Kind, s.Ref)
+		}
+
 		if isIterator {
 			if len(out) != 2 {
 				return nil, fmt.Errorf("expecting exactly 2 outbound paths for iterator")
@@ -372,10 +385,25 @@ func (svc workflowConverter) convFunctionStep(g *wfexec.Graph, s *types.Workflow
 				return nil, nil
 			}
 
-			return types.IteratorStep(def, s.Arguments, s.Results, next, exit)
+			is, err := types.IteratorStep(def, s.Arguments, s.Results, next, exit)
+			if err != nil {
+				return nil, err
+			}
+
+			is.SetRetryPolicy(retry)
+			is.SetTimeout(timeout)
+			is.SetConcurrency(s.Concurrency)
+			return is, nil
 
 		} else {
-			return types.FunctionStep(def, s.Arguments, s.Results)
+			fs, err := types.FunctionStep(def, s.Arguments, s.Results)
+			if err != nil {
+				return nil, err
+			}
+
+			fs.SetRetryPolicy(retry)
+			fs.SetTimeout(timeout)
+			return fs, nil
 		}
 	}
 }
@@ -461,6 +489,12 @@ func (svc workflowConverter) convPromptStep(s *types.WorkflowStep) (wfexec.Step,
 			return nil, err
 		}
 
+		if r.Input.Has(types.TaskInputEscalated) {
+			// session was resumed because the task was not completed in time,
+			// flag is passed on so that the workflow can take the escalation path
+			r.Input.Copy(results, types.TaskInputEscalated)
+		}
+
 		return results, nil
 	}), nil
 }
@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cortezaproject/corteza-server/automation/types"
+	intAuth "github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/errors"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/cortezaproject/corteza-server/store"
+)
+
+// Publish stores workflow draft as a new revision and binds triggers to it
+//
+// When draft is the same as the published revision, no new revision is created.
+func (svc *workflow) Publish(ctx context.Context, workflowID uint64) (wf *types.Workflow, err error) {
+	var (
+		wap = &workflowActionProps{workflow: &types.Workflow{ID: workflowID}}
+	)
+
+	err = store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) (err error) {
+		if wf, err = loadWorkflow(ctx, s, workflowID); err != nil {
+			return
+		}
+
+		wap.setWorkflow(wf)
+
+		if !svc.ac.CanUpdateWorkflow(ctx, wf) {
+			return WorkflowErrNotAllowedToUpdate()
+		}
+
+		if _, _, err = svc.validateWorkflow(ctx, wf); err != nil {
+			return
+		}
+
+		if len(wf.Issues) > 0 {
+			return WorkflowErrUnpublishable()
+		}
+
+		if wf.PublishedRevision > 0 {
+			var pub *types.WorkflowRevision
+			if pub, err = svc.lookupRevision(ctx, s, wf.ID, wf.PublishedRevision); err != nil {
+				return
+			}
+
+			if types.DiffWorkflowRevisions(pub, wf.MakeRevision()).Empty() {
+				wap.setRevision(wf.PublishedRevision)
+				return
+			}
+		}
+
+		if err = createWorkflowRevision(ctx, s, wf); err != nil {
+			return
+		}
+
+		wap.setRevision(wf.PublishedRevision)
+
+		wf.UpdatedAt = now()
+		wf.UpdatedBy = intAuth.GetIdentityFromContext(ctx).Identity()
+		if err = store.UpdateAutomationWorkflow(ctx, s, wf); err != nil {
+			return
+		}
+
+		return svc.activate(ctx, s, wf)
+	})
+
+	return wf, svc.recordAction(ctx, wap, WorkflowActionPublish, err)
+}
+
+// Rollback publishes one of the previous revisions
+//
+// Draft is not modified.
+func (svc *workflow) Rollback(ctx context.Context, workflowID uint64, revision uint) (wf *types.Workflow, err error) {
+	var (
+		wap = &workflowActionProps{workflow: &types.Workflow{ID: workflowID}, revision: revision}
+	)
+
+	err = store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) (err error) {
+		if wf, err = loadWorkflow(ctx, s, workflowID); err != nil {
+			return
+		}
+
+		wap.setWorkflow(wf)
+
+		if !svc.ac.CanUpdateWorkflow(ctx, wf) {
+			return WorkflowErrNotAllowedToUpdate()
+		}
+
+		if _, err = svc.lookupRevision(ctx, s, wf.ID, revision); err != nil {
+			return
+		}
+
+		if wf.PublishedRevision == revision {
+			return
+		}
+
+		wf.PublishedRevision = revision
+		wf.UpdatedAt = now()
+		wf.UpdatedBy = intAuth.GetIdentityFromContext(ctx).Identity()
+		if err = store.UpdateAutomationWorkflow(ctx, s, wf); err != nil {
+			return
+		}
+
+		return svc.activate(ctx, s, wf)
+	})
+
+	return wf, svc.recordAction(ctx, wap, WorkflowActionRollback, err)
+}
+
+// SearchRevisions returns revisions of the workflow
+func (svc *workflow) SearchRevisions(ctx context.Context, workflowID uint64, f types.WorkflowRevisionFilter) (rr types.WorkflowRevisionSet, _ types.WorkflowRevisionFilter, err error) {
+	var (
+		wap = &workflowActionProps{workflow: &types.Workflow{ID: workflowID}}
+	)
+
+	err = func() (err error) {
+		var wf *types.Workflow
+		if wf, err = svc.loadReadable(ctx, wap, workflowID); err != nil {
+			return
+		}
+
+		f.WorkflowID = wf.ID
+
+		if len(f.Sort) == 0 {
+			f.Sort = filter.SortExprSet{&filter.SortExpr{Column: "revision", Descending: true}}
+		}
+
+		rr, f, err = store.SearchAutomationWorkflowRevisions(ctx, svc.store, f)
+		return
+	}()
+
+	return rr, f, svc.recordAction(ctx, wap, WorkflowActionSearchRevisions, err)
+}
+
+// DiffRevisions compares two workflow revisions
+//
+// Revision 0 refers to the current draft
+func (svc *workflow) DiffRevisions(ctx context.Context, workflowID uint64, from, to uint) (d *types.WorkflowRevisionDiff, err error) {
+	var (
+		wap = &workflowActionProps{workflow: &types.Workflow{ID: workflowID}}
+	)
+
+	err = func() (err error) {
+		var (
+			wf       *types.Workflow
+			old, new *types.WorkflowRevision
+		)
+
+		if wf, err = svc.loadReadable(ctx, wap, workflowID); err != nil {
+			return
+		}
+
+		load := func(revision uint) (*types.WorkflowRevision, error) {
+			if revision == 0 {
+				return wf.MakeRevision(), nil
+			}
+
+			return svc.lookupRevision(ctx, svc.store, wf.ID, revision)
+		}
+
+		if old, err = load(from); err != nil {
+			return
+		}
+
+		if new, err = load(to); err != nil {
+			return
+		}
+
+		d = types.DiffWorkflowRevisions(old, new)
+		return
+	}()
+
+	return d, svc.recordAction(ctx, wap, WorkflowActionDiffRevisions, err)
+}
+
+func (svc *workflow) loadReadable(ctx context.Context, wap *workflowActionProps, workflowID uint64) (wf *types.Workflow, err error) {
+	if wf, err = loadWorkflow(ctx, svc.store, workflowID); err != nil {
+		return
+	}
+
+	wap.setWorkflow(wf)
+
+	if !svc.ac.CanReadWorkflow(ctx, wf) {
+		return nil, WorkflowErrNotAllowedToRead()
+	}
+
+	return
+}
+
+func (svc *workflow) lookupRevision(ctx context.Context, s store.AutomationWorkflowRevisions, workflowID uint64, revision uint) (rev *types.WorkflowRevision, err error) {
+	if rev, err = store.LookupAutomationWorkflowRevisionByWorkflowIDRevision(ctx, s, workflowID, revision); errors.IsNotFound(err) {
+		return nil, WorkflowErrRevisionNotFound()
+	}
+
+	return
+}
+
+// activate refreshes cache and trigger registration with the published workflow revision
+func (svc *workflow) activate(ctx context.Context, s store.Storer, wf *types.Workflow) (err error) {
+	if wf.PublishedRevision == 0 {
+		// nothing is published yet, draft is not executed
+		svc.mux.Lock()
+		delete(svc.cache, wf.ID)
+		svc.mux.Unlock()
+
+		svc.triggers.unregisterWorkflows(wf)
+		return
+	}
+
+	var pub *types.Workflow
+	if pub, err = publishedWorkflow(ctx, s, wf); err != nil {
+		return
+	}
+
+	g, runAs, err := svc.validateWorkflow(ctx, pub)
+	if err != nil {
+		return
+	}
+
+	svc.updateCache(pub, runAs, g)
+
+	if len(pub.Issues) == 0 {
+		return svc.triggers.registerWorkflows(ctx, pub)
+	}
+
+	return
+}
+
+// createWorkflowRevision stores the workflow definition as
+// a new revision and marks it as published
+func createWorkflowRevision(ctx context.Context, s store.AutomationWorkflowRevisions, wf *types.Workflow) (err error) {
+	var (
+		rev  = wf.MakeRevision()
+		last types.WorkflowRevisionSet
+	)
+
+	last, _, err = store.SearchAutomationWorkflowRevisions(ctx, s, types.WorkflowRevisionFilter{
+		WorkflowID: wf.ID,
+		Sorting:    filter.Sorting{Sort: filter.SortExprSet{&filter.SortExpr{Column: "revision", Descending: true}}},
+		Paging:     filter.Paging{Limit: 1},
+	})
+	if err != nil {
+		return
+	}
+
+	rev.ID = nextID()
+	rev.Revision = 1
+	rev.CreatedAt = *now()
+	rev.CreatedBy = intAuth.GetIdentityFromContext(ctx).Identity()
+
+	if len(last) > 0 {
+		rev.Revision = last[0].Revision + 1
+	}
+
+	if err = store.CreateAutomationWorkflowRevision(ctx, s, rev); err != nil {
+		return
+	}
+
+	wf.PublishedRevision = rev.Revision
+	return
+}
+
+// publishedWorkflow returns workflow with the definition from the published revision
+//
+// Workflows that were never published are returned as they are.
+func publishedWorkflow(ctx context.Context, s store.AutomationWorkflowRevisions, wf *types.Workflow) (*types.Workflow, error) {
+	if wf.PublishedRevision == 0 {
+		return wf, nil
+	}
+
+	rev, err := store.LookupAutomationWorkflowRevisionByWorkflowIDRevision(ctx, s, wf.ID, wf.PublishedRevision)
+	if errors.IsNotFound(err) {
+		return nil, WorkflowErrRevisionNotFound()
+	} else if err != nil {
+		return nil, err
+	}
+
+	return wf.Published(rev), nil
+}
+
+func loadPublishedWorkflow(ctx context.Context, s store.Storer, workflowID uint64) (*types.Workflow, error) {
+	wf, err := loadWorkflow(ctx, s, workflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	return publishedWorkflow(ctx, s, wf)
+}
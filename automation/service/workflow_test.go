@@ -0,0 +1,39 @@
+package service
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflow_cachedIDByHandle(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		svc = &workflow{
+			cache:  make(map[uint64]*wfCacheItem),
+			wIndex: make(map[string]uint64),
+			mux:    &sync.RWMutex{},
+		}
+
+		wf = &types.Workflow{ID: 42, Handle: "h1", Enabled: true}
+	)
+
+	req.Zero(svc.cachedIDByHandle("h1"))
+
+	svc.updateCache(wf, nil, nil)
+	req.Equal(uint64(42), svc.cachedIDByHandle("h1"))
+
+	// handle changed
+	svc.updateCache(&types.Workflow{ID: 42, Handle: "h2", Enabled: true}, nil, nil)
+	req.Zero(svc.cachedIDByHandle("h1"))
+	req.Equal(uint64(42), svc.cachedIDByHandle("h2"))
+
+	// deleted
+	n := time.Now()
+	svc.updateCache(&types.Workflow{ID: 42, Handle: "h2", Enabled: true, DeletedAt: &n}, nil, nil)
+	req.Zero(svc.cachedIDByHandle("h2"))
+}
@@ -0,0 +1,410 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/automation/types"
+	intAuth "github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/errors"
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/cortezaproject/corteza-server/pkg/wfexec"
+	"github.com/cortezaproject/corteza-server/store"
+)
+
+const (
+	// max time test case can run
+	testCaseTimeout = time.Minute
+)
+
+// SearchTestCases returns test cases of the workflow
+func (svc *workflow) SearchTestCases(ctx context.Context, workflowID uint64, f types.WorkflowTestCaseFilter) (set types.WorkflowTestCaseSet, _ types.WorkflowTestCaseFilter, err error) {
+	var (
+		wap = &workflowActionProps{workflow: &types.Workflow{ID: workflowID}}
+	)
+
+	err = func() (err error) {
+		var wf *types.Workflow
+		if wf, err = svc.loadReadable(ctx, wap, workflowID); err != nil {
+			return
+		}
+
+		f.WorkflowID = []uint64{wf.ID}
+
+		if len(f.Sort) == 0 {
+			f.Sort = filter.SortExprSet{&filter.SortExpr{Column: "created_at"}}
+		}
+
+		set, f, err = store.SearchAutomationWorkflowTestCases(ctx, svc.store, f)
+		return
+	}()
+
+	return set, f, svc.recordAction(ctx, wap, WorkflowActionSearchTestCases, err)
+}
+
+// CreateTestCase adds a new test case to the workflow
+func (svc *workflow) CreateTestCase(ctx context.Context, new *types.WorkflowTestCase) (tc *types.WorkflowTestCase, err error) {
+	var (
+		wap = &workflowActionProps{workflow: &types.Workflow{ID: new.WorkflowID}, testCase: new}
+	)
+
+	err = func() (err error) {
+		if _, err = svc.loadUpdatable(ctx, wap, new.WorkflowID); err != nil {
+			return
+		}
+
+		if err = svc.validateTestCase(new); err != nil {
+			return
+		}
+
+		tc = &types.WorkflowTestCase{
+			ID:          nextID(),
+			WorkflowID:  new.WorkflowID,
+			Meta:        new.Meta,
+			StepID:      new.StepID,
+			Input:       new.Input,
+			Mocks:       new.Mocks,
+			Passthrough: new.Passthrough,
+			Assertions:  new.Assertions,
+			ExpectError: new.ExpectError,
+			OwnedBy:     new.OwnedBy,
+			CreatedAt:   *now(),
+			CreatedBy:   intAuth.GetIdentityFromContext(ctx).Identity(),
+		}
+
+		if tc.OwnedBy == 0 {
+			tc.OwnedBy = tc.CreatedBy
+		}
+
+		wap.setTestCase(tc)
+		return store.CreateAutomationWorkflowTestCase(ctx, svc.store, tc)
+	}()
+
+	return tc, svc.recordAction(ctx, wap, WorkflowActionCreateTestCase, err)
+}
+
+// UpdateTestCase updates test case of the workflow
+func (svc *workflow) UpdateTestCase(ctx context.Context, upd *types.WorkflowTestCase) (tc *types.WorkflowTestCase, err error) {
+	var (
+		wap = &workflowActionProps{workflow: &types.Workflow{ID: upd.WorkflowID}, testCase: upd}
+	)
+
+	err = func() (err error) {
+		if _, err = svc.loadUpdatable(ctx, wap, upd.WorkflowID); err != nil {
+			return
+		}
+
+		if tc, err = svc.lookupTestCase(ctx, upd.WorkflowID, upd.ID); err != nil {
+			return
+		}
+
+		wap.setTestCase(tc)
+
+		if err = svc.validateTestCase(upd); err != nil {
+			return
+		}
+
+		tc.Meta = upd.Meta
+		tc.StepID = upd.StepID
+		tc.Input = upd.Input
+		tc.Mocks = upd.Mocks
+		tc.Passthrough = upd.Passthrough
+		tc.Assertions = upd.Assertions
+		tc.ExpectError = upd.ExpectError
+
+		if upd.OwnedBy > 0 {
+			tc.OwnedBy = upd.OwnedBy
+		}
+
+		tc.UpdatedAt = now()
+		tc.UpdatedBy = intAuth.GetIdentityFromContext(ctx).Identity()
+
+		return store.UpdateAutomationWorkflowTestCase(ctx, svc.store, tc)
+	}()
+
+	return tc, svc.recordAction(ctx, wap, WorkflowActionUpdateTestCase, err)
+}
+
+// DeleteTestCase removes test case from the workflow
+func (svc *workflow) DeleteTestCase(ctx context.Context, workflowID, testCaseID uint64) (err error) {
+	var (
+		wap = &workflowActionProps{workflow: &types.Workflow{ID: workflowID}}
+		tc  *types.WorkflowTestCase
+	)
+
+	err = func() (err error) {
+		if _, err = svc.loadUpdatable(ctx, wap, workflowID); err != nil {
+			return
+		}
+
+		if tc, err = svc.lookupTestCase(ctx, workflowID, testCaseID); err != nil {
+			return
+		}
+
+		wap.setTestCase(tc)
+
+		tc.DeletedAt = now()
+		tc.DeletedBy = intAuth.GetIdentityFromContext(ctx).Identity()
+
+		return store.UpdateAutomationWorkflowTestCase(ctx, svc.store, tc)
+	}()
+
+	return svc.recordAction(ctx, wap, WorkflowActionDeleteTestCase, err)
+}
+
+// RunTestCases runs test cases of the workflow in a sandbox
+//
+// Workflow draft is tested. When no test case IDs are given, all test cases
+// of the workflow are executed.
+func (svc *workflow) RunTestCases(ctx context.Context, workflowID uint64, testCaseID ...uint64) (rr []*types.WorkflowTestResult, err error) {
+	var (
+		wap = &workflowActionProps{workflow: &types.Workflow{ID: workflowID}}
+	)
+
+	err = func() (err error) {
+		var (
+			wf *types.Workflow
+			tt types.WorkflowTestCaseSet
+			r  *types.WorkflowTestResult
+		)
+
+		if wf, err = loadWorkflow(ctx, svc.store, workflowID); err != nil {
+			return
+		}
+
+		wap.setWorkflow(wf)
+
+		if !svc.ac.CanExecuteWorkflow(ctx, wf) {
+			return WorkflowErrNotAllowedToExecute()
+		}
+
+		tt, _, err = store.SearchAutomationWorkflowTestCases(ctx, svc.store, types.WorkflowTestCaseFilter{
+			WorkflowID: []uint64{wf.ID},
+			TestCaseID: testCaseID,
+		})
+		if err != nil {
+			return
+		}
+
+		if len(testCaseID) > 0 && len(tt) != len(testCaseID) {
+			return WorkflowErrTestCaseNotFound()
+		}
+
+		rr = make([]*types.WorkflowTestResult, 0, len(tt))
+		for _, tc := range tt {
+			if r, err = svc.runTestCase(ctx, wf, tc); err != nil {
+				return
+			}
+
+			rr = append(rr, r)
+		}
+
+		return
+	}()
+
+	return rr, svc.recordAction(ctx, wap, WorkflowActionRunTestCases, err)
+}
+
+// runTestCase executes workflow with intercepted functions and checks the results
+//
+// Session is not registered with the session service; it is not stored
+// and can not be resumed. Delays are skipped; prompts can not be answered
+// and test case fails when workflow waits for the input.
+func (svc *workflow) runTestCase(ctx context.Context, wf *types.Workflow, tc *types.WorkflowTestCase) (r *types.WorkflowTestResult, err error) {
+	var (
+		g       *wfexec.Graph
+		ii      types.WorkflowIssueSet
+		start   wfexec.Step
+		ses     *wfexec.Session
+		scope   *expr.Vars
+		invoker = intAuth.GetIdentityFromContext(ctx)
+
+		mux     sync.Mutex
+		started = *now()
+	)
+
+	r = &types.WorkflowTestResult{
+		TestCaseID: tc.ID,
+		WorkflowID: wf.ID,
+	}
+
+	if tc.Meta != nil {
+		r.Name = tc.Meta.Name
+	}
+
+	if err = svc.prepareTestCase(tc); err != nil {
+		return nil, fmt.Errorf("invalid test case %d: %w", tc.ID, err)
+	}
+
+	conv := &workflowConverter{
+		reg:    svc.reg.sandbox(tc.Mocks, tc.Passthrough),
+		parser: svc.parser,
+		log:    svc.log,
+		graphs: svc,
+	}
+
+	if g, ii = conv.makeGraph(wf); len(ii) > 0 {
+		r.Error = ii.Error()
+		r.Failures = append(r.Failures, "workflow has issues")
+		return
+	}
+
+	if start, err = startingStep(g, tc.StepID); err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, testCaseTimeout)
+	defer cancel()
+
+	// prompts are owned by the invoker
+	ctx = context.WithValue(ctx, workflowInvokerCtxKey{}, invoker)
+
+	ses = wfexec.NewSession(ctx, g,
+		wfexec.SetWorkflowID(wf.ID),
+		wfexec.SetCallStack(wf.ID),
+		wfexec.SetLogger(svc.log),
+		wfexec.SetSkipDelays(true),
+		wfexec.SetHandler(func(_ wfexec.SessionStatus, state *wfexec.State, _ *wfexec.Session) {
+			mux.Lock()
+			defer mux.Unlock()
+			r.Trace = append(r.Trace, state.MakeFrame())
+		}),
+	)
+
+	scope = wf.Scope.MustMerge(tc.Input)
+	_ = scope.AssignFieldValue("eventType", expr.Must(expr.NewString("onTest")))
+	_ = scope.AssignFieldValue("invoker", expr.Must(expr.NewAny(invoker)))
+	_ = scope.AssignFieldValue("runner", expr.Must(expr.NewAny(invoker)))
+
+	if err = ses.Exec(ctx, start, scope); err != nil {
+		return
+	}
+
+	// session error is read from the session
+	_ = ses.WaitUntil(ctx, wfexec.SessionFailed, wfexec.SessionCompleted, wfexec.SessionPrompted)
+
+	if ses.Status() == wfexec.SessionPrompted {
+		ses.Cancel()
+		r.Error = "workflow is waiting for the input; prompts are not supported in test cases"
+		r.Failures = append(r.Failures, r.Error)
+	} else if ctx.Err() != nil {
+		// test case timed out; results are reported
+		// as a failure, not as an error
+		r.Error = fmt.Sprintf("test case did not complete in %s", testCaseTimeout)
+		r.Failures = append(r.Failures, r.Error)
+	} else if sErr := ses.Error(); sErr != nil {
+		r.Error = sErr.Error()
+	}
+
+	mux.Lock()
+	defer mux.Unlock()
+
+	r.ElapsedTime = uint(now().Sub(started) / time.Millisecond)
+	r.Results = ses.Result()
+
+	switch {
+	case tc.ExpectError != "" && r.Error == "":
+		r.Failures = append(r.Failures, fmt.Sprintf("expected workflow to fail with %q", tc.ExpectError))
+	case tc.ExpectError != "" && !strings.Contains(r.Error, tc.ExpectError):
+		r.Failures = append(r.Failures, fmt.Sprintf("expected workflow to fail with %q, got %q", tc.ExpectError, r.Error))
+	case tc.ExpectError == "" && r.Error != "" && len(r.Failures) == 0:
+		r.Failures = append(r.Failures, "workflow failed")
+	}
+
+	if r.Error == "" {
+		for _, a := range tc.Assertions {
+			var ok bool
+			if ok, err = a.Test(ctx, r.Results); err != nil {
+				return nil, fmt.Errorf("failed to evaluate assertion %q: %w", a.Expr, err)
+			}
+
+			if ok {
+				continue
+			}
+
+			if a.Error != "" {
+				r.Failures = append(r.Failures, a.Error)
+			} else {
+				r.Failures = append(r.Failures, fmt.Sprintf("assertion %q failed", a.Expr))
+			}
+		}
+	}
+
+	r.Passed = len(r.Failures) == 0
+	return
+}
+
+// validateTestCase checks if test case can be executed
+func (svc *workflow) validateTestCase(tc *types.WorkflowTestCase) error {
+	for _, m := range tc.Mocks {
+		if svc.reg.Function(m.Ref) == nil {
+			return errors.InvalidData("can not mock unknown function %q", m.Ref)
+		}
+	}
+
+	for _, ref := range tc.Passthrough {
+		if svc.reg.Function(ref) == nil {
+			return errors.InvalidData("can not pass through unknown function %q", ref)
+		}
+	}
+
+	if err := svc.prepareTestCase(tc); err != nil {
+		return errors.InvalidData("invalid test case: %v", err).Wrap(err)
+	}
+
+	return nil
+}
+
+// prepareTestCase resolves types of input and mocked values and parses assertions
+func (svc *workflow) prepareTestCase(tc *types.WorkflowTestCase) (err error) {
+	if err = tc.Input.ResolveTypes(svc.reg.Type); err != nil {
+		return
+	}
+
+	for _, m := range tc.Mocks {
+		if err = m.Results.ResolveTypes(svc.reg.Type); err != nil {
+			return
+		}
+
+		for _, i := range m.Items {
+			if err = i.ResolveTypes(svc.reg.Type); err != nil {
+				return
+			}
+		}
+	}
+
+	for _, a := range tc.Assertions {
+		if err = svc.parser.ParseEvaluators(a); err != nil {
+			return fmt.Errorf("failed to parse assertion %q: %w", a.Expr, err)
+		}
+	}
+
+	return
+}
+
+func (svc *workflow) loadUpdatable(ctx context.Context, wap *workflowActionProps, workflowID uint64) (wf *types.Workflow, err error) {
+	if wf, err = loadWorkflow(ctx, svc.store, workflowID); err != nil {
+		return
+	}
+
+	wap.setWorkflow(wf)
+
+	if !svc.ac.CanUpdateWorkflow(ctx, wf) {
+		return nil, WorkflowErrNotAllowedToUpdate()
+	}
+
+	return
+}
+
+func (svc *workflow) lookupTestCase(ctx context.Context, workflowID, testCaseID uint64) (tc *types.WorkflowTestCase, err error) {
+	tc, err = store.LookupAutomationWorkflowTestCaseByID(ctx, svc.store, testCaseID)
+	if errors.IsNotFound(err) || (err == nil && (tc.WorkflowID != workflowID || tc.DeletedAt != nil)) {
+		return nil, WorkflowErrTestCaseNotFound()
+	}
+
+	return
+}
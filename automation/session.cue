@@ -12,12 +12,16 @@ session: schema.#Resource & {
 	struct: {
 		id:          schema.IdField
 		workflow_id: { ident: "workflowID", goType: "uint64", storeIdent: "rel_workflow" }
+		workflow_revision: { goType: "uint" }
+		caller_session_id: { ident: "callerSessionID", goType: "uint64", storeIdent: "rel_caller_session" }
+		caller_step_id: { ident: "callerStepID", goType: "uint64", storeIdent: "rel_caller_step" }
 		event_type: { goType: "string" }
 		resource_type: { goType: "string" }
 		status: { goType: "types.SessionStatus" }
 		input: { goType: "*expr.Vars" }
 		output: { goType: "*expr.Vars" }
 		stacktrace: { goType: "types.Stacktrace" }
+		snapshot: { goType: "*types.SessionSnapshot" }
 
 		created_by: { goType: "uint64" }
 		created_at: schema.SortableTimestampField
@@ -34,11 +38,12 @@ session: schema.#Resource & {
 			created_by: { goType: "[]uint64" }
 			status: { goType: "[]uint" }
 			workflow_id: { goType: "[]uint64", storeIdent: "rel_workflow", ident: "workflowID" }
+			caller_session_id: { goType: "[]uint64", storeIdent: "rel_caller_session", ident: "callerSessionID" }
 			event_type: { goType: "string" }
 			resource_type: { goType: "string" }
 		}
 
-		byValue: ["status", "workflow_id", "event_type", "resource_type", "created_by"]
+		byValue: ["status", "workflow_id", "caller_session_id", "event_type", "resource_type", "created_by"]
 		byNilState: ["completed"]
 	}
 
@@ -0,0 +1,52 @@
+package automation
+
+import (
+	"github.com/cortezaproject/corteza-server/codegen/schema"
+)
+
+sessionResume: schema.#Resource & {
+	features: {
+		labels: false
+		paging: false
+		sorting: false
+		checkFn: false
+	}
+
+	struct: {
+		id:         schema.IdField
+		session_id: { goType: "uint64", storeIdent: "rel_session", ident: "sessionID" }
+		state_id:   { goType: "uint64", storeIdent: "rel_state", ident: "stateID" }
+		input:      { goType: "*expr.Vars" }
+		created_by: { goType: "uint64" }
+		created_at: schema.SortableTimestampField
+	}
+
+	filter: {
+		struct: {
+			session_id: { goType: "[]uint64", storeIdent: "rel_session", ident: "sessionID" }
+		}
+
+		byValue: ["session_id"]
+	}
+
+	store: {
+		ident: "automationSessionResume"
+
+		settings: {
+			rdbms: {
+				table: "automation_session_resumes"
+			}
+		}
+
+		api: {
+			lookups: [
+				{
+					fields: ["id"]
+					description: """
+						searches for session resume request by ID
+						"""
+				},
+			]
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package automation
+
+import (
+	"github.com/cortezaproject/corteza-server/codegen/schema"
+)
+
+task: schema.#Resource & {
+	features: {
+		labels: false
+		paging: false
+		sorting: false
+		checkFn: false
+	}
+
+	struct: {
+		id:               schema.IdField
+		session_id:       { goType: "uint64", storeIdent: "rel_session", ident: "sessionID" }
+		state_id:         { goType: "uint64", storeIdent: "rel_state", ident: "stateID" }
+		workflow_id:      { goType: "uint64", storeIdent: "rel_workflow", ident: "workflowID" }
+		step_id:          { goType: "uint64", storeIdent: "rel_step", ident: "stepID" }
+		ref:              {}
+		payload:          { goType: "*expr.Vars" }
+		owner_id:         { goType: "uint64", storeIdent: "rel_owner", ident: "ownerID" }
+		assigned_user_id: { goType: "uint64", storeIdent: "rel_assigned_user", ident: "assignedUserID" }
+		assigned_role_id: { goType: "uint64", storeIdent: "rel_assigned_role", ident: "assignedRoleID" }
+		claimed_by:       { goType: "uint64" }
+		delegated_by:     { goType: "uint64" }
+		status:           { goType: "types.TaskStatus" }
+		due_at:           schema.SortableTimestampNilField
+		created_at:       schema.SortableTimestampField
+		updated_at:       schema.SortableTimestampNilField
+		completed_at:     schema.SortableTimestampNilField
+		completed_by:     { goType: "uint64" }
+	}
+
+	filter: {
+		struct: {
+			task_id:          { goType: "[]uint64", storeIdent: "id", ident: "taskID" }
+			session_id:       { goType: "[]uint64", storeIdent: "rel_session", ident: "sessionID" }
+			workflow_id:      { goType: "[]uint64", storeIdent: "rel_workflow", ident: "workflowID" }
+			assigned_user_id: { goType: "[]uint64", storeIdent: "rel_assigned_user", ident: "assignedUserID" }
+			assigned_role_id: { goType: "[]uint64", storeIdent: "rel_assigned_role", ident: "assignedRoleID" }
+			status:           { goType: "[]string" }
+			completed:        { goType: "filter.State", storeIdent: "completed_at" }
+		}
+
+		byValue: ["task_id", "session_id", "workflow_id", "assigned_user_id", "assigned_role_id", "status"]
+		byNilState: ["completed"]
+	}
+
+	store: {
+		ident: "automationTask"
+
+		settings: {
+			rdbms: {
+				table: "automation_tasks"
+			}
+		}
+
+		api: {
+			lookups: [
+				{
+					fields: ["id"]
+					description: """
+						searches for task by ID
+
+						It returns task even if completed
+						"""
+				},
+				{
+					fields: ["session_id", "state_id"]
+					description: """
+						searches for task of the prompted session state
+						"""
+				},
+			]
+
+			functions: [
+				{
+					expIdent: "TransitionAutomationTask"
+					description: """
+						updates the task only when it is still in the given status
+
+						Returns true when the task is updated; when the status
+						was changed in the meantime, false is returned
+						"""
+					args: [
+						{ ident: "task", goType: "*types.Task" },
+						{ ident: "from", goType: "types.TaskStatus" },
+					]
+					return: [ "bool" ]
+				},
+			]
+		}
+	}
+}
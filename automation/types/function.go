@@ -38,6 +38,7 @@ type (
 
 	functionStep struct {
 		wfexec.StepIdentifier
+		wfexec.StepExecPolicy
 		def       *Function
 		arguments ExprSet
 		results   ExprSet
@@ -45,6 +46,7 @@ type (
 
 	iteratorStep struct {
 		wfexec.StepIdentifier
+		wfexec.StepExecPolicy
 		def       *Function
 		arguments ExprSet
 		results   ExprSet
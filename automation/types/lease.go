@@ -0,0 +1,35 @@
+package types
+
+import (
+	"time"
+)
+
+type (
+	// Lease grants exclusive right to the owner (server instance)
+	// to run a session or dispatch a scheduled event
+	//
+	// Lease is valid until it expires; owner needs to renew it
+	// before that or it can be acquired by another instance
+	Lease struct {
+		// Name of the leased resource (session:<ID>, scheduler:<time>, node:<name>)
+		Name string `json:"name"`
+
+		// Server instance holding the lease
+		Owner string `json:"owner"`
+
+		ExpiresAt time.Time  `json:"expiresAt"`
+		CreatedAt time.Time  `json:"createdAt,omitempty"`
+		UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	}
+
+	LeaseFilter struct {
+		Owner string `json:"owner"`
+
+		Limit uint `json:"-"`
+	}
+)
+
+// Expired returns true if lease is no longer valid at the given time
+func (l Lease) Expired(at time.Time) bool {
+	return !l.ExpiresAt.After(at)
+}
@@ -32,3 +32,23 @@ func parseStringsInput(ss []string, p interface{}) (err error) {
 
 	return json.Unmarshal([]byte(ss[0]), &p)
 }
+
+func ParseWorkflowTestCaseMeta(ss []string) (p *WorkflowTestCaseMeta, err error) {
+	p = &WorkflowTestCaseMeta{}
+	return p, parseStringsInput(ss, p)
+}
+
+func ParseWorkflowTestMockSet(ss []string) (p WorkflowTestMockSet, err error) {
+	p = WorkflowTestMockSet{}
+	return p, parseStringsInput(ss, &p)
+}
+
+func ParseWorkflowTestPassthrough(ss []string) (p WorkflowTestPassthrough, err error) {
+	p = WorkflowTestPassthrough{}
+	return p, parseStringsInput(ss, &p)
+}
+
+func ParseTestSet(ss []string) (p TestSet, err error) {
+	p = TestSet{}
+	return p, parseStringsInput(ss, &p)
+}
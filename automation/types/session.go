@@ -24,6 +24,13 @@ type (
 		ID         uint64 `json:"sessionID,string"`
 		WorkflowID uint64 `json:"workflowID,string"`
 
+		// WorkflowRevision session was started on
+		WorkflowRevision uint `json:"workflowRevision"`
+
+		// Session and step that invoked this session as a sub-workflow
+		CallerSessionID uint64 `json:"callerSessionID,string,omitempty"`
+		CallerStepID    uint64 `json:"callerStepID,string,omitempty"`
+
 		Status SessionStatus `json:"status,string"`
 
 		EventType    string `json:"eventType"`
@@ -35,6 +42,10 @@ type (
 		// Stacktrace that gets stored (if/when configured)
 		Stacktrace Stacktrace `json:"stacktrace"`
 
+		// Snapshot of the suspended session, used to restore
+		// the session on another server instance
+		Snapshot *SessionSnapshot `json:"-"`
+
 		CreatedAt time.Time  `json:"createdAt,omitempty"`
 		CreatedBy uint64     `json:"createdBy,string"`
 		PurgeAt   *time.Time `json:"purgeAt,omitempty"`
@@ -59,6 +70,46 @@ type (
 		l sync.RWMutex
 	}
 
+	// SessionSnapshot holds everything needed to restore suspended session
+	SessionSnapshot struct {
+		// Server instance running the session
+		Node string `json:"node,omitempty"`
+
+		Invoker SessionIdentity `json:"invoker"`
+		Runner  SessionIdentity `json:"runner"`
+
+		CallStack []uint64 `json:"callStack,omitempty"`
+
+		// Delayed and prompted states; nil when session
+		// has states that can not be suspended
+		States []*wfexec.SuspendedState `json:"states"`
+	}
+
+	SessionIdentity struct {
+		ID    uint64   `json:"id,string"`
+		Roles []uint64 `json:"roles,omitempty"`
+	}
+
+	// SessionResume is a request to resume the prompted session
+	//
+	// Requests are stored when session is running on
+	// another server instance that picks them up
+	SessionResume struct {
+		ID        uint64     `json:"resumeID,string"`
+		SessionID uint64     `json:"sessionID,string"`
+		StateID   uint64     `json:"stateID,string"`
+		Input     *expr.Vars `json:"input"`
+
+		CreatedAt time.Time `json:"createdAt,omitempty"`
+		CreatedBy uint64    `json:"createdBy,string"`
+	}
+
+	SessionResumeFilter struct {
+		SessionID []uint64 `json:"sessionID"`
+
+		Limit uint `json:"-"`
+	}
+
 	SessionStartParams struct {
 		// Always set, users that invoked/started the workflow session
 		Invoker auth.Identifiable
@@ -66,23 +117,32 @@ type (
 		// Optional, (alternative) user that is running the workflow
 		Runner auth.Identifiable
 
-		WorkflowID   uint64
-		KeepFor      int
-		Trace        bool
-		Input        *expr.Vars
-		StepID       uint64
-		EventType    string
-		ResourceType string
+		WorkflowID       uint64
+		WorkflowRevision uint
+		KeepFor          int
+		Trace            bool
+		Input            *expr.Vars
+		StepID           uint64
+		EventType        string
+		ResourceType     string
+
+		// When started as a sub-workflow
+		CallerSessionID uint64
+		CallerStepID    uint64
 
 		CallStack []uint64
+
+		// Steps the debugger pauses the session on
+		Breakpoints []uint64
 	}
 
 	SessionFilter struct {
-		SessionID    []uint64 `json:"sessionID"`
-		WorkflowID   []uint64 `json:"workflowID"`
-		CreatedBy    []uint64 `json:"createdBy"`
-		EventType    string   `json:"eventType"`
-		ResourceType string   `json:"resourceType"`
+		SessionID       []uint64 `json:"sessionID"`
+		WorkflowID      []uint64 `json:"workflowID"`
+		CallerSessionID []uint64 `json:"callerSessionID"`
+		CreatedBy       []uint64 `json:"createdBy"`
+		EventType       string   `json:"eventType"`
+		ResourceType    string   `json:"resourceType"`
 
 		Completed filter.State `json:"deleted"`
 		Status    []uint       `json:"status"`
@@ -109,6 +169,7 @@ const (
 	SessionSuspended
 	SessionFailed
 	SessionCompleted
+	SessionPaused
 )
 
 func NewSession(s *wfexec.Session) *Session {
@@ -118,6 +179,13 @@ func NewSession(s *wfexec.Session) *Session {
 	}
 }
 
+// RestoreSession attaches restored workflow session to the stored session
+func RestoreSession(stored *Session, s *wfexec.Session) *Session {
+	stored.session = s
+	stored.RuntimeStacktrace = stored.Stacktrace
+	return stored
+}
+
 func (s *Session) DisableStacktrace() {
 	s.runtimeOpts.disableStacktrace = true
 }
@@ -130,6 +198,16 @@ func (s *Session) Resume(ctx context.Context, stateID uint64, input *expr.Vars)
 	return s.session.Resume(ctx, stateID, input)
 }
 
+// Cancel stops the execution of the session
+func (s *Session) Cancel() {
+	s.session.Cancel()
+}
+
+// Suspended returns true when session is prompted or delayed
+func (s *Session) Suspended() bool {
+	return s.Status == SessionPrompted || s.Status == SessionSuspended
+}
+
 func (s *Session) PendingPrompts(ownerId uint64) []*wfexec.PendingPrompt {
 	return s.session.UserPendingPrompts(ownerId)
 }
@@ -158,6 +236,9 @@ func (s *Session) Apply(ssp SessionStartParams) {
 	defer s.l.Unlock()
 
 	s.WorkflowID = ssp.WorkflowID
+	s.WorkflowRevision = ssp.WorkflowRevision
+	s.CallerSessionID = ssp.CallerSessionID
+	s.CallerStepID = ssp.CallerStepID
 	s.EventType = ssp.EventType
 	s.ResourceType = ssp.ResourceType
 	s.Input = ssp.Input
@@ -193,6 +274,94 @@ func (s *Session) CopyRuntimeStacktrace() {
 	}
 }
 
+// MakeSessionIdentity returns serializable copy of the identity
+func MakeSessionIdentity(i auth.Identifiable) SessionIdentity {
+	if i == nil {
+		return SessionIdentity{}
+	}
+
+	return SessionIdentity{ID: i.Identity(), Roles: i.Roles()}
+}
+
+// Identifiable returns identity with roles
+func (i SessionIdentity) Identifiable() auth.Identifiable {
+	return auth.Authenticated(i.ID, i.Roles...)
+}
+
+// PendingPrompts returns prompts of the suspended states owned by the given user
+func (s *SessionSnapshot) PendingPrompts(sessionID, ownerID uint64) (pp []*wfexec.PendingPrompt) {
+	if s == nil {
+		return
+	}
+
+	for _, st := range s.States {
+		if p := st.PendingPrompt(sessionID); p != nil && p.OwnerId == ownerID {
+			pp = append(pp, p)
+		}
+	}
+
+	return
+}
+
+// State returns suspended state with the given ID
+func (s *SessionSnapshot) State(stateID uint64) *wfexec.SuspendedState {
+	if s == nil {
+		return nil
+	}
+
+	for _, st := range s.States {
+		if st.StateID == stateID {
+			return st
+		}
+	}
+
+	return nil
+}
+
+// ResolveTypes resolves types of all values in the suspended states
+func (s *SessionSnapshot) ResolveTypes(res func(typ string) expr.Type) (err error) {
+	if s == nil {
+		return
+	}
+
+	for _, st := range s.States {
+		if err = st.Scope.ResolveTypes(res); err != nil {
+			return
+		}
+
+		if st.Prompt != nil {
+			if err = st.Prompt.Payload.ResolveTypes(res); err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+func (s *SessionSnapshot) Scan(value interface{}) error {
+	//lint:ignore S1034 This typecast is intentional, we need to get []byte out of a []uint8
+	switch value.(type) {
+	case nil:
+		*s = SessionSnapshot{}
+	case []uint8:
+		b := value.([]byte)
+		if err := json.Unmarshal(b, s); err != nil {
+			return fmt.Errorf("cannot scan '%v' into SessionSnapshot: %w", string(b), err)
+		}
+	}
+
+	return nil
+}
+
+func (s *SessionSnapshot) Value() (driver.Value, error) {
+	if s == nil {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(s)
+}
+
 func (set *Stacktrace) Scan(value interface{}) error {
 	//lint:ignore S1034 This typecast is intentional, we need to get []byte out of a []uint8
 	switch value.(type) {
@@ -261,6 +430,8 @@ func (s SessionStatus) String() string {
 		return "failed"
 	case SessionCompleted:
 		return "completed"
+	case SessionPaused:
+		return "paused"
 	}
 
 	return "unknown"
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"context"
+
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/wfexec"
+)
+
+type (
+	// SessionDebugState holds breakpoints and paused states of the running session
+	SessionDebugState struct {
+		SessionID   uint64                `json:"sessionID,string"`
+		Status      SessionStatus         `json:"status"`
+		Breakpoints []uint64              `json:"breakpoints,string"`
+		Paused      []*wfexec.PausedState `json:"paused"`
+	}
+)
+
+// DebugState returns breakpoints and paused states of the session
+func (s *Session) DebugState() *SessionDebugState {
+	return &SessionDebugState{
+		SessionID:   s.ID,
+		Status:      s.Status,
+		Breakpoints: s.session.Breakpoints(),
+		Paused:      s.session.PausedStates(),
+	}
+}
+
+func (s *Session) UpdateBreakpoints(stepID ...uint64) error {
+	return s.session.UpdateBreakpoints(stepID...)
+}
+
+func (s *Session) SetStateScope(stateID uint64, vars *expr.Vars) error {
+	return s.session.SetStateScope(stateID, vars)
+}
+
+func (s *Session) Step(ctx context.Context, stateID uint64) error {
+	return s.session.Step(ctx, stateID)
+}
+
+func (s *Session) Continue(ctx context.Context, stateID uint64) error {
+	return s.session.Continue(ctx, stateID)
+}
+
+func (s *Session) Replay(ctx context.Context, step wfexec.Step, scope *expr.Vars) error {
+	return s.session.Replay(ctx, step, scope)
+}
@@ -25,6 +25,18 @@ type (
 		// only valid when kind=function
 		Results []*Expr `json:"results"`
 
+		// Retry policy for failed steps
+		// only valid when kind=function|iterator
+		Retry *WorkflowStepRetry `json:"retry,omitempty"`
+
+		// Max execution time (e.g. "30s")
+		// only valid when kind=function|iterator
+		Timeout string `json:"timeout,omitempty"`
+
+		// Max number of iterations that run at the same time
+		// only valid when kind=iterator
+		Concurrency uint `json:"concurrency,omitempty"`
+
 		Meta WorkflowStepMeta `json:"meta,omitempty"`
 
 		Labels map[string]string `json:"labels,omitempty"`
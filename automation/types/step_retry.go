@@ -0,0 +1,168 @@
+package types
+
+import (
+	goerrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/errors"
+	"github.com/cortezaproject/corteza-server/pkg/wfexec"
+)
+
+type (
+	// WorkflowStepRetry describes how failed function or iterator step is retried
+	WorkflowStepRetry struct {
+		// Max number of attempts, including the first one
+		MaxAttempts uint `json:"maxAttempts" yaml:"maxAttempts"`
+
+		// Wait time before the first retry (e.g. "5s")
+		Delay string `json:"delay,omitempty" yaml:"delay,omitempty"`
+
+		// Upper limit for the wait time (e.g. "1m")
+		MaxDelay string `json:"maxDelay,omitempty" yaml:"maxDelay,omitempty"`
+
+		// How wait time grows with each retry: constant (default), linear or exponential
+		Backoff string `json:"backoff,omitempty" yaml:"backoff,omitempty"`
+
+		// Random variation of the wait time (0-1)
+		Jitter float64 `json:"jitter,omitempty" yaml:"jitter,omitempty"`
+
+		// Kinds of errors that are retried; all errors are retried when empty
+		//
+		// See RetryableErrorKinds for the list of supported kinds
+		RetryOn []string `json:"retryOn,omitempty" yaml:"retryOn,omitempty"`
+	}
+)
+
+var (
+	// RetryableErrorKinds maps error kinds that can be
+	// used in retry policy with the checker functions
+	RetryableErrorKinds = map[string]func(error) bool{
+		"timeout": func(err error) bool { return goerrors.Is(err, wfexec.ErrStepTimeout) },
+
+		"internal":        unwrapErrorKind("internal", errors.IsInternal),
+		"external":        unwrapErrorKind("external", errors.IsExternal),
+		"store":           unwrapErrorKind("store", errors.IsStore),
+		"objStore":        unwrapErrorKind("objStore", errors.IsObjStore),
+		"notFound":        unwrapErrorKind("notFound", errors.IsNotFound),
+		"staleData":       unwrapErrorKind("staleData", errors.IsStaleData),
+		"duplicateData":   unwrapErrorKind("duplicateData", errors.IsDuplicateData),
+		"invalidData":     unwrapErrorKind("invalidData", errors.IsInvalidData),
+		"unauthorized":    unwrapErrorKind("unauthorized", errors.IsUnauthorized),
+		"unauthenticated": unwrapErrorKind("unauthenticated", errors.IsUnauthenticated),
+		"automation":      unwrapErrorKind("automation", errors.IsAutomation),
+
+		// errors that are not of any of the above kinds
+		"other": func(err error) bool {
+			for e := err; e != nil; e = goerrors.Unwrap(e) {
+				if errors.IsAny(e) || goerrors.Is(e, wfexec.ErrStepTimeout) {
+					return false
+				}
+			}
+
+			return true
+		},
+	}
+)
+
+// ExecPolicy converts step's retry and timeout settings
+//
+// Nil retry policy and zero timeout are returned when not set
+func (s WorkflowStep) ExecPolicy() (rp *wfexec.RetryPolicy, timeout time.Duration, err error) {
+	if s.Timeout != "" {
+		if timeout, err = time.ParseDuration(s.Timeout); err != nil {
+			return nil, 0, fmt.Errorf("invalid timeout: %w", err)
+		} else if timeout < 0 {
+			return nil, 0, fmt.Errorf("invalid timeout: expecting positive duration")
+		}
+	}
+
+	if s.Retry == nil || s.Retry.MaxAttempts <= 1 {
+		return
+	}
+
+	rp, err = s.Retry.policy()
+	return
+}
+
+func (r WorkflowStepRetry) policy() (rp *wfexec.RetryPolicy, err error) {
+	rp = &wfexec.RetryPolicy{
+		MaxAttempts: r.MaxAttempts,
+		Jitter:      r.Jitter,
+	}
+
+	if r.Delay != "" {
+		if rp.Delay, err = time.ParseDuration(r.Delay); err != nil {
+			return nil, fmt.Errorf("invalid retry delay: %w", err)
+		}
+	}
+
+	if r.MaxDelay != "" {
+		if rp.MaxDelay, err = time.ParseDuration(r.MaxDelay); err != nil {
+			return nil, fmt.Errorf("invalid retry max delay: %w", err)
+		}
+	}
+
+	if rp.Delay < 0 || rp.MaxDelay < 0 {
+		return nil, fmt.Errorf("invalid retry delay: expecting positive duration")
+	}
+
+	if r.Jitter < 0 || r.Jitter > 1 {
+		return nil, fmt.Errorf("invalid retry jitter: expecting value between 0 and 1")
+	}
+
+	switch r.Backoff {
+	case "", "constant":
+		rp.Backoff = wfexec.RetryBackoffConstant
+	case "linear":
+		rp.Backoff = wfexec.RetryBackoffLinear
+	case "exponential":
+		rp.Backoff = wfexec.RetryBackoffExponential
+	default:
+		return nil, fmt.Errorf("invalid retry backoff %q", r.Backoff)
+	}
+
+	if len(r.RetryOn) == 0 {
+		return
+	}
+
+	checkers := make([]func(error) bool, len(r.RetryOn))
+	for i, k := range r.RetryOn {
+		if checkers[i] = RetryableErrorKinds[k]; checkers[i] == nil {
+			return nil, fmt.Errorf("unknown retryable error kind %q", k)
+		}
+	}
+
+	rp.Retryable = func(err error) bool {
+		for _, c := range checkers {
+			if c(err) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return
+}
+
+// unwrapErrorKind checks the error and all errors it wraps
+//
+// Besides the kind, error type from the meta data is checked as well
+// (service errors, like "workflow not found", are all internal errors
+// with the type set to "notFound")
+func unwrapErrorKind(kind string, is func(error) bool) func(error) bool {
+	return func(err error) bool {
+		for ; err != nil; err = goerrors.Unwrap(err) {
+			if is(err) {
+				return true
+			}
+
+			if e, ok := err.(*errors.Error); ok && e.Meta().AsString("type") == kind {
+				return true
+			}
+		}
+
+		return false
+	}
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/errors"
+	"github.com/cortezaproject/corteza-server/pkg/wfexec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowStep_ExecPolicy(t *testing.T) {
+	t.Run("not set", func(t *testing.T) {
+		req := require.New(t)
+
+		rp, timeout, err := WorkflowStep{}.ExecPolicy()
+		req.NoError(err)
+		req.Nil(rp)
+		req.Zero(timeout)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		req := require.New(t)
+
+		rp, timeout, err := WorkflowStep{
+			Timeout: "30s",
+			Retry: &WorkflowStepRetry{
+				MaxAttempts: 5,
+				Delay:       "1s",
+				MaxDelay:    "1m",
+				Backoff:     "exponential",
+				Jitter:      0.1,
+				RetryOn:     []string{"timeout", "external"},
+			},
+		}.ExecPolicy()
+
+		req.NoError(err)
+		req.Equal(30*time.Second, timeout)
+		req.NotNil(rp)
+		req.Equal(uint(5), rp.MaxAttempts)
+		req.Equal(time.Second, rp.Delay)
+		req.Equal(time.Minute, rp.MaxDelay)
+		req.Equal(wfexec.RetryBackoffExponential, rp.Backoff)
+
+		req.True(rp.Retryable(fmt.Errorf("wrapped: %w", wfexec.ErrStepTimeout)))
+		req.True(rp.Retryable(fmt.Errorf("wrapped: %w", errors.External("remote failed"))))
+		req.False(rp.Retryable(errors.InvalidData("bad input")))
+		req.False(rp.Retryable(fmt.Errorf("plain")))
+	})
+
+	t.Run("other errors", func(t *testing.T) {
+		req := require.New(t)
+
+		rp, _, err := WorkflowStep{Retry: &WorkflowStepRetry{MaxAttempts: 2, RetryOn: []string{"other"}}}.ExecPolicy()
+		req.NoError(err)
+		req.True(rp.Retryable(fmt.Errorf("plain")))
+		req.False(rp.Retryable(errors.NotFound("not found")))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		tcc := []WorkflowStep{
+			{Timeout: "forever"},
+			{Retry: &WorkflowStepRetry{MaxAttempts: 2, Delay: "soon"}},
+			{Retry: &WorkflowStepRetry{MaxAttempts: 2, Backoff: "random"}},
+			{Retry: &WorkflowStepRetry{MaxAttempts: 2, Jitter: 2}},
+			{Retry: &WorkflowStepRetry{MaxAttempts: 2, RetryOn: []string{"cosmicRays"}}},
+		}
+
+		for _, s := range tcc {
+			_, _, err := s.ExecPolicy()
+			require.Error(t, err)
+		}
+	})
+}
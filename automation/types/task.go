@@ -0,0 +1,164 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/cortezaproject/corteza-server/pkg/wfexec"
+)
+
+type (
+	// Task is a persisted prompt of the workflow session
+	//
+	// Task is created for every prompt and can be assigned
+	// to a user or to all members of a role
+	Task struct {
+		ID         uint64 `json:"taskID,string"`
+		SessionID  uint64 `json:"sessionID,string"`
+		StateID    uint64 `json:"stateID,string"`
+		WorkflowID uint64 `json:"workflowID,string"`
+		StepID     uint64 `json:"stepID,string"`
+
+		// Prompt reference and payload
+		Ref     string     `json:"ref"`
+		Payload *expr.Vars `json:"payload"`
+
+		// Owner of the prompt; session is resumed with this identity
+		OwnerID uint64 `json:"ownerID,string"`
+
+		AssignedUserID uint64 `json:"assignedUserID,string,omitempty"`
+		AssignedRoleID uint64 `json:"assignedRoleID,string,omitempty"`
+
+		// Member of the assigned role that claimed the task
+		ClaimedBy uint64 `json:"claimedBy,string,omitempty"`
+
+		// User that delegated the task to the assigned user
+		DelegatedBy uint64 `json:"delegatedBy,string,omitempty"`
+
+		Status TaskStatus `json:"status"`
+
+		// When set, session is resumed down the escalation
+		// path if the task is not completed by then
+		DueAt *time.Time `json:"dueAt,omitempty"`
+
+		CreatedAt   time.Time  `json:"createdAt,omitempty"`
+		UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+		CompletedAt *time.Time `json:"completedAt,omitempty"`
+		CompletedBy uint64     `json:"completedBy,string,omitempty"`
+	}
+
+	TaskFilter struct {
+		TaskID         []uint64 `json:"taskID,string"`
+		SessionID      []uint64 `json:"sessionID,string"`
+		WorkflowID     []uint64 `json:"workflowID,string"`
+		AssignedUserID []uint64 `json:"assignedUserID,string"`
+		AssignedRoleID []uint64 `json:"assignedRoleID,string"`
+		Status         []string `json:"status"`
+
+		Completed filter.State `json:"completed"`
+
+		// Inbox of the user; tasks assigned to the user and tasks of
+		// user's roles that are not claimed by another member of the role
+		InboxUserID uint64   `json:"-"`
+		InboxRoleID []uint64 `json:"-"`
+
+		Limit uint `json:"-"`
+	}
+
+	TaskStatus string
+)
+
+const (
+	TaskPending   TaskStatus = "pending"
+	TaskClaimed   TaskStatus = "claimed"
+	TaskCompleted TaskStatus = "completed"
+	TaskEscalated TaskStatus = "escalated"
+	TaskCanceled  TaskStatus = "canceled"
+)
+
+const (
+	// Prompt arguments that control the task
+	TaskArgAssignUser = "taskAssignUser"
+	TaskArgAssignRole = "taskAssignRole"
+	TaskArgDueAt      = "taskDueAt"
+	TaskArgDueIn      = "taskDueIn"
+
+	// Input variable set when session is resumed
+	// because the task was not completed in time
+	TaskInputEscalated = "escalated"
+)
+
+// MakeTask creates a task from the pending prompt of the session
+//
+// Assignment and deadline are read from the prompt payload; when no
+// user or role is assigned, task is assigned to the owner of the prompt
+func MakeTask(ses *Session, pp *wfexec.PendingPrompt, now time.Time) (t *Task, err error) {
+	t = &Task{
+		SessionID:  ses.ID,
+		StateID:    pp.StateID,
+		WorkflowID: ses.WorkflowID,
+		StepID:     pp.StepID,
+		Ref:        pp.Ref,
+		Payload:    pp.Payload,
+		OwnerID:    pp.OwnerId,
+		Status:     TaskPending,
+		CreatedAt:  now,
+	}
+
+	if pp.Payload == nil {
+		t.AssignedUserID = t.OwnerID
+		return
+	}
+
+	args := pp.Payload.Dict()
+
+	if v, has := args[TaskArgAssignUser]; has {
+		if t.AssignedUserID, err = expr.CastToID(v); err != nil {
+			return nil, fmt.Errorf("invalid %s argument: %w", TaskArgAssignUser, err)
+		}
+	}
+
+	if v, has := args[TaskArgAssignRole]; has {
+		if t.AssignedRoleID, err = expr.CastToID(v); err != nil {
+			return nil, fmt.Errorf("invalid %s argument: %w", TaskArgAssignRole, err)
+		}
+	}
+
+	if t.AssignedUserID == 0 && t.AssignedRoleID == 0 {
+		t.AssignedUserID = t.OwnerID
+	}
+
+	if v, has := args[TaskArgDueAt]; has {
+		if t.DueAt, err = expr.CastToDateTime(v); err != nil {
+			return nil, fmt.Errorf("invalid %s argument: %w", TaskArgDueAt, err)
+		}
+	} else if v, has = args[TaskArgDueIn]; has {
+		var d time.Duration
+		if d, err = expr.CastToDuration(v); err != nil {
+			return nil, fmt.Errorf("invalid %s argument: %w", TaskArgDueIn, err)
+		}
+
+		dueAt := now.Add(d)
+		t.DueAt = &dueAt
+	}
+
+	return
+}
+
+// Open returns true if task is waiting to be completed
+func (t Task) Open() bool {
+	return t.Status == TaskPending || t.Status == TaskClaimed
+}
+
+// Overdue returns true if open task is past its deadline
+func (t Task) Overdue(at time.Time) bool {
+	return t.Open() && t.DueAt != nil && !t.DueAt.After(at)
+}
+
+// AssignedTo returns true if task is assigned to the user directly
+// or claimed by the user as member of the assigned role
+func (t Task) AssignedTo(userID uint64) bool {
+	return userID > 0 && (t.AssignedUserID == userID || t.ClaimedBy == userID)
+}
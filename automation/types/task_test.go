@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/wfexec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeTask(t *testing.T) {
+	var (
+		now = time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+		ses = &Session{ID: 1, WorkflowID: 2}
+
+		prompt = func(args map[string]interface{}) *wfexec.PendingPrompt {
+			pp := &wfexec.PendingPrompt{StateID: 3, StepID: 4, Ref: "approval", OwnerId: 5}
+			if args != nil {
+				pp.Payload, _ = expr.NewVars(args)
+			}
+
+			return pp
+		}
+	)
+
+	t.Run("assigned to owner", func(t *testing.T) {
+		req := require.New(t)
+
+		tsk, err := MakeTask(ses, prompt(nil), now)
+		req.NoError(err)
+		req.Equal(uint64(5), tsk.AssignedUserID)
+		req.Equal(TaskPending, tsk.Status)
+		req.Nil(tsk.DueAt)
+	})
+
+	t.Run("assigned to role with deadline", func(t *testing.T) {
+		req := require.New(t)
+
+		tsk, err := MakeTask(ses, prompt(map[string]interface{}{
+			TaskArgAssignRole: "7",
+			TaskArgDueIn:      "2h",
+		}), now)
+		req.NoError(err)
+		req.Zero(tsk.AssignedUserID)
+		req.Equal(uint64(7), tsk.AssignedRoleID)
+		req.Equal(now.Add(2*time.Hour), *tsk.DueAt)
+		req.False(tsk.Overdue(now))
+		req.True(tsk.Overdue(now.Add(3 * time.Hour)))
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		req := require.New(t)
+
+		_, err := MakeTask(ses, prompt(map[string]interface{}{TaskArgDueAt: "tomorrow"}), now)
+		req.Error(err)
+	})
+}
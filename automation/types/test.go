@@ -2,6 +2,10 @@ package types
 
 import (
 	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
 	"github.com/cortezaproject/corteza-server/pkg/expr"
 )
 
@@ -80,3 +84,22 @@ func (set TestSet) TestAny(ctx context.Context, scope *expr.Vars) (bool, error)
 
 	return false, nil
 }
+
+func (set *TestSet) Scan(value interface{}) error {
+	//lint:ignore S1034 This typecast is intentional, we need to get []byte out of a []uint8
+	switch value.(type) {
+	case nil:
+		*set = TestSet{}
+	case []uint8:
+		b := value.([]byte)
+		if err := json.Unmarshal(b, set); err != nil {
+			return fmt.Errorf("cannot scan '%v' into TestSet: %w", string(b), err)
+		}
+	}
+
+	return nil
+}
+
+func (set TestSet) Value() (driver.Value, error) {
+	return json.Marshal(set)
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/scheduler"
+)
+
+const (
+	// Timezone (IANA name) interval trigger is evaluated in
+	TriggerConstraintTimezone = "timezone"
+
+	// Handles of holiday calendars; interval triggers
+	// skip holidays ("!=" operator) or fire only on holidays ("=" operator)
+	TriggerConstraintCalendar = "calendar"
+
+	triggerEventInterval  = "onInterval"
+	triggerEventTimestamp = "onTimestamp"
+)
+
+// Interval returns true if trigger is fired on crontab-like intervals
+func (t Trigger) Interval() bool {
+	return t.EventType == triggerEventInterval
+}
+
+// Scheduled returns true if trigger is fired by the scheduler (on interval or timestamp)
+func (t Trigger) Scheduled() bool {
+	return t.EventType == triggerEventInterval || t.EventType == triggerEventTimestamp
+}
+
+// Schedule returns schedule of the interval trigger
+//
+// Unnamed constraints hold crontab expressions, timezone
+// and holiday calendars are set with named constraints
+func (t Trigger) Schedule() (*scheduler.Schedule, error) {
+	var (
+		ee []string
+		oo []scheduler.ScheduleOpt
+	)
+
+	if !t.Interval() {
+		return nil, fmt.Errorf("trigger %d is not an interval trigger", t.ID)
+	}
+
+	for _, c := range t.Constraints {
+		switch c.Name {
+		case TriggerConstraintTimezone:
+			if len(c.Values) > 1 {
+				return nil, fmt.Errorf("expecting one timezone, got %d", len(c.Values))
+			}
+
+			oo = append(oo, scheduler.InTimezone(strings.Join(c.Values, "")))
+
+		case TriggerConstraintCalendar:
+			switch strings.ToLower(c.Op) {
+			case "", "eq", "=", "==", "===":
+				oo = append(oo, scheduler.OnlyHolidays(c.Values...))
+			case "not eq", "ne", "!=", "!==":
+				oo = append(oo, scheduler.ExcludeHolidays(c.Values...))
+			default:
+				return nil, fmt.Errorf("unsupported holiday calendar operator %q", c.Op)
+			}
+
+		default:
+			ee = append(ee, c.Values...)
+		}
+	}
+
+	return scheduler.NewSchedule(ee, oo...)
+}
+
+// FireTimes returns up to n times after the given time when the trigger fires
+func (t Trigger) FireTimes(from time.Time, n uint) (tt []time.Time, err error) {
+	switch t.EventType {
+	case triggerEventInterval:
+		s, err := t.Schedule()
+		if err != nil {
+			return nil, err
+		}
+
+		return s.Next(from, n), nil
+
+	case triggerEventTimestamp:
+		for _, c := range t.Constraints {
+			for _, v := range c.Values {
+				ts, err := time.Parse(time.RFC3339, v)
+				if err != nil {
+					return nil, fmt.Errorf("invalid timestamp %q: %w", v, err)
+				}
+
+				if ts.After(from) {
+					tt = append(tt, ts)
+				}
+			}
+		}
+
+		sort.Slice(tt, func(i, j int) bool { return tt[i].Before(tt[j]) })
+		if uint(len(tt)) > n {
+			tt = tt[:n]
+		}
+
+		return
+
+	default:
+		return nil, fmt.Errorf("trigger %d is not fired by the scheduler", t.ID)
+	}
+}
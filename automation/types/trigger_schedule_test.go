@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrigger_FireTimes(t *testing.T) {
+	var (
+		from = time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	t.Run("interval in timezone", func(t *testing.T) {
+		req := require.New(t)
+
+		tt, err := Trigger{
+			EventType: "onInterval",
+			Constraints: TriggerConstraintSet{
+				{Values: []string{"0 9 * * *"}},
+				{Name: TriggerConstraintTimezone, Values: []string{"America/New_York"}},
+			},
+		}.FireTimes(from, 2)
+
+		req.NoError(err)
+		req.Len(tt, 2)
+		req.Equal("2022-06-01T13:00:00Z", tt[0].UTC().Format(time.RFC3339))
+		req.Equal("2022-06-02T13:00:00Z", tt[1].UTC().Format(time.RFC3339))
+	})
+
+	t.Run("timestamps", func(t *testing.T) {
+		req := require.New(t)
+
+		tt, err := Trigger{
+			EventType: "onTimestamp",
+			Constraints: TriggerConstraintSet{
+				{Values: []string{"2022-07-01T00:00:00Z", "2021-01-01T00:00:00Z", "2022-06-15T00:00:00Z"}},
+			},
+		}.FireTimes(from, 10)
+
+		req.NoError(err)
+		req.Len(tt, 2)
+		req.Equal("2022-06-15T00:00:00Z", tt[0].Format(time.RFC3339))
+	})
+
+	t.Run("invalid constraints", func(t *testing.T) {
+		req := require.New(t)
+
+		_, err := Trigger{
+			EventType:   "onInterval",
+			Constraints: TriggerConstraintSet{{Name: TriggerConstraintTimezone, Values: []string{"Nowhere/Foo"}}},
+		}.FireTimes(from, 2)
+		req.Error(err)
+
+		_, err = Trigger{
+			EventType:   "onInterval",
+			Constraints: TriggerConstraintSet{{Name: TriggerConstraintCalendar, Op: "like", Values: []string{"cal"}}},
+		}.FireTimes(from, 2)
+		req.Error(err)
+
+		_, err = Trigger{EventType: "afterCreate"}.FireTimes(from, 2)
+		req.Error(err)
+	})
+}
@@ -10,16 +10,31 @@ package types
 
 type (
 
+	// LeaseSet slice of Lease
+	//
+	// This type is auto-generated.
+	LeaseSet []*Lease
+
 	// SessionSet slice of Session
 	//
 	// This type is auto-generated.
 	SessionSet []*Session
 
+	// SessionResumeSet slice of SessionResume
+	//
+	// This type is auto-generated.
+	SessionResumeSet []*SessionResume
+
 	// StateSet slice of State
 	//
 	// This type is auto-generated.
 	StateSet []*State
 
+	// TaskSet slice of Task
+	//
+	// This type is auto-generated.
+	TaskSet []*Task
+
 	// TriggerSet slice of Trigger
 	//
 	// This type is auto-generated.
@@ -45,12 +60,57 @@ type (
 	// This type is auto-generated.
 	WorkflowPathSet []*WorkflowPath
 
+	// WorkflowRevisionSet slice of WorkflowRevision
+	//
+	// This type is auto-generated.
+	WorkflowRevisionSet []*WorkflowRevision
+
 	// WorkflowStepSet slice of WorkflowStep
 	//
 	// This type is auto-generated.
 	WorkflowStepSet []*WorkflowStep
+
+	// WorkflowTestCaseSet slice of WorkflowTestCase
+	//
+	// This type is auto-generated.
+	WorkflowTestCaseSet []*WorkflowTestCase
+
+	// WorkflowTestMockSet slice of WorkflowTestMock
+	//
+	// This type is auto-generated.
+	WorkflowTestMockSet []*WorkflowTestMock
 )
 
+// Walk iterates through every slice item and calls w(Lease) err
+//
+// This function is auto-generated.
+func (set LeaseSet) Walk(w func(*Lease) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(Lease) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set LeaseSet) Filter(f func(*Lease) (bool, error)) (out LeaseSet, err error) {
+	var ok bool
+	out = LeaseSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
 // Walk iterates through every slice item and calls w(Session) err
 //
 // This function is auto-generated.
@@ -107,6 +167,62 @@ func (set SessionSet) IDs() (IDs []uint64) {
 	return
 }
 
+// Walk iterates through every slice item and calls w(SessionResume) err
+//
+// This function is auto-generated.
+func (set SessionResumeSet) Walk(w func(*SessionResume) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(SessionResume) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set SessionResumeSet) Filter(f func(*SessionResume) (bool, error)) (out SessionResumeSet, err error) {
+	var ok bool
+	out = SessionResumeSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
+// FindByID finds items from slice by its ID property
+//
+// This function is auto-generated.
+func (set SessionResumeSet) FindByID(ID uint64) *SessionResume {
+	for i := range set {
+		if set[i].ID == ID {
+			return set[i]
+		}
+	}
+
+	return nil
+}
+
+// IDs returns a slice of uint64s from all items in the set
+//
+// This function is auto-generated.
+func (set SessionResumeSet) IDs() (IDs []uint64) {
+	IDs = make([]uint64, len(set))
+
+	for i := range set {
+		IDs[i] = set[i].ID
+	}
+
+	return
+}
+
 // Walk iterates through every slice item and calls w(State) err
 //
 // This function is auto-generated.
@@ -163,6 +279,62 @@ func (set StateSet) IDs() (IDs []uint64) {
 	return
 }
 
+// Walk iterates through every slice item and calls w(Task) err
+//
+// This function is auto-generated.
+func (set TaskSet) Walk(w func(*Task) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(Task) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set TaskSet) Filter(f func(*Task) (bool, error)) (out TaskSet, err error) {
+	var ok bool
+	out = TaskSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
+// FindByID finds items from slice by its ID property
+//
+// This function is auto-generated.
+func (set TaskSet) FindByID(ID uint64) *Task {
+	for i := range set {
+		if set[i].ID == ID {
+			return set[i]
+		}
+	}
+
+	return nil
+}
+
+// IDs returns a slice of uint64s from all items in the set
+//
+// This function is auto-generated.
+func (set TaskSet) IDs() (IDs []uint64) {
+	IDs = make([]uint64, len(set))
+
+	for i := range set {
+		IDs[i] = set[i].ID
+	}
+
+	return
+}
+
 // Walk iterates through every slice item and calls w(Trigger) err
 //
 // This function is auto-generated.
@@ -365,6 +537,62 @@ func (set WorkflowPathSet) Filter(f func(*WorkflowPath) (bool, error)) (out Work
 	return
 }
 
+// Walk iterates through every slice item and calls w(WorkflowRevision) err
+//
+// This function is auto-generated.
+func (set WorkflowRevisionSet) Walk(w func(*WorkflowRevision) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(WorkflowRevision) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set WorkflowRevisionSet) Filter(f func(*WorkflowRevision) (bool, error)) (out WorkflowRevisionSet, err error) {
+	var ok bool
+	out = WorkflowRevisionSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
+// FindByID finds items from slice by its ID property
+//
+// This function is auto-generated.
+func (set WorkflowRevisionSet) FindByID(ID uint64) *WorkflowRevision {
+	for i := range set {
+		if set[i].ID == ID {
+			return set[i]
+		}
+	}
+
+	return nil
+}
+
+// IDs returns a slice of uint64s from all items in the set
+//
+// This function is auto-generated.
+func (set WorkflowRevisionSet) IDs() (IDs []uint64) {
+	IDs = make([]uint64, len(set))
+
+	for i := range set {
+		IDs[i] = set[i].ID
+	}
+
+	return
+}
+
 // Walk iterates through every slice item and calls w(WorkflowStep) err
 //
 // This function is auto-generated.
@@ -420,3 +648,89 @@ func (set WorkflowStepSet) IDs() (IDs []uint64) {
 
 	return
 }
+
+// Walk iterates through every slice item and calls w(WorkflowTestCase) err
+//
+// This function is auto-generated.
+func (set WorkflowTestCaseSet) Walk(w func(*WorkflowTestCase) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(WorkflowTestCase) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set WorkflowTestCaseSet) Filter(f func(*WorkflowTestCase) (bool, error)) (out WorkflowTestCaseSet, err error) {
+	var ok bool
+	out = WorkflowTestCaseSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
+// FindByID finds items from slice by its ID property
+//
+// This function is auto-generated.
+func (set WorkflowTestCaseSet) FindByID(ID uint64) *WorkflowTestCase {
+	for i := range set {
+		if set[i].ID == ID {
+			return set[i]
+		}
+	}
+
+	return nil
+}
+
+// IDs returns a slice of uint64s from all items in the set
+//
+// This function is auto-generated.
+func (set WorkflowTestCaseSet) IDs() (IDs []uint64) {
+	IDs = make([]uint64, len(set))
+
+	for i := range set {
+		IDs[i] = set[i].ID
+	}
+
+	return
+}
+
+// Walk iterates through every slice item and calls w(WorkflowTestMock) err
+//
+// This function is auto-generated.
+func (set WorkflowTestMockSet) Walk(w func(*WorkflowTestMock) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(WorkflowTestMock) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set WorkflowTestMockSet) Filter(f func(*WorkflowTestMock) (bool, error)) (out WorkflowTestMockSet, err error) {
+	var ok bool
+	out = WorkflowTestMockSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
@@ -14,6 +14,62 @@ import (
 	"testing"
 )
 
+func TestLeaseSetWalk(t *testing.T) {
+	var (
+		value = make(LeaseSet, 3)
+		req   = require.New(t)
+	)
+
+	// check walk with no errors
+	{
+		err := value.Walk(func(*Lease) error {
+			return nil
+		})
+		req.NoError(err)
+	}
+
+	// check walk with error
+	req.Error(value.Walk(func(*Lease) error { return fmt.Errorf("walk error") }))
+}
+
+func TestLeaseSetFilter(t *testing.T) {
+	var (
+		value = make(LeaseSet, 3)
+		req   = require.New(t)
+	)
+
+	// filter nothing
+	{
+		set, err := value.Filter(func(*Lease) (bool, error) {
+			return true, nil
+		})
+		req.NoError(err)
+		req.Equal(len(set), len(value))
+	}
+
+	// filter one item
+	{
+		found := false
+		set, err := value.Filter(func(*Lease) (bool, error) {
+			if !found {
+				found = true
+				return found, nil
+			}
+			return false, nil
+		})
+		req.NoError(err)
+		req.Len(set, 1)
+	}
+
+	// filter error
+	{
+		_, err := value.Filter(func(*Lease) (bool, error) {
+			return false, fmt.Errorf("filter error")
+		})
+		req.Error(err)
+	}
+}
+
 func TestSessionSetWalk(t *testing.T) {
 	var (
 		value = make(SessionSet, 3)
@@ -104,6 +160,96 @@ func TestSessionSetIDs(t *testing.T) {
 	}
 }
 
+func TestSessionResumeSetWalk(t *testing.T) {
+	var (
+		value = make(SessionResumeSet, 3)
+		req   = require.New(t)
+	)
+
+	// check walk with no errors
+	{
+		err := value.Walk(func(*SessionResume) error {
+			return nil
+		})
+		req.NoError(err)
+	}
+
+	// check walk with error
+	req.Error(value.Walk(func(*SessionResume) error { return fmt.Errorf("walk error") }))
+}
+
+func TestSessionResumeSetFilter(t *testing.T) {
+	var (
+		value = make(SessionResumeSet, 3)
+		req   = require.New(t)
+	)
+
+	// filter nothing
+	{
+		set, err := value.Filter(func(*SessionResume) (bool, error) {
+			return true, nil
+		})
+		req.NoError(err)
+		req.Equal(len(set), len(value))
+	}
+
+	// filter one item
+	{
+		found := false
+		set, err := value.Filter(func(*SessionResume) (bool, error) {
+			if !found {
+				found = true
+				return found, nil
+			}
+			return false, nil
+		})
+		req.NoError(err)
+		req.Len(set, 1)
+	}
+
+	// filter error
+	{
+		_, err := value.Filter(func(*SessionResume) (bool, error) {
+			return false, fmt.Errorf("filter error")
+		})
+		req.Error(err)
+	}
+}
+
+func TestSessionResumeSetIDs(t *testing.T) {
+	var (
+		value = make(SessionResumeSet, 3)
+		req   = require.New(t)
+	)
+
+	// construct objects
+	value[0] = new(SessionResume)
+	value[1] = new(SessionResume)
+	value[2] = new(SessionResume)
+	// set ids
+	value[0].ID = 1
+	value[1].ID = 2
+	value[2].ID = 3
+
+	// Find existing
+	{
+		val := value.FindByID(2)
+		req.Equal(uint64(2), val.ID)
+	}
+
+	// Find non-existing
+	{
+		val := value.FindByID(4)
+		req.Nil(val)
+	}
+
+	// List IDs from set
+	{
+		val := value.IDs()
+		req.Equal(len(val), len(value))
+	}
+}
+
 func TestStateSetWalk(t *testing.T) {
 	var (
 		value = make(StateSet, 3)
@@ -194,6 +340,96 @@ func TestStateSetIDs(t *testing.T) {
 	}
 }
 
+func TestTaskSetWalk(t *testing.T) {
+	var (
+		value = make(TaskSet, 3)
+		req   = require.New(t)
+	)
+
+	// check walk with no errors
+	{
+		err := value.Walk(func(*Task) error {
+			return nil
+		})
+		req.NoError(err)
+	}
+
+	// check walk with error
+	req.Error(value.Walk(func(*Task) error { return fmt.Errorf("walk error") }))
+}
+
+func TestTaskSetFilter(t *testing.T) {
+	var (
+		value = make(TaskSet, 3)
+		req   = require.New(t)
+	)
+
+	// filter nothing
+	{
+		set, err := value.Filter(func(*Task) (bool, error) {
+			return true, nil
+		})
+		req.NoError(err)
+		req.Equal(len(set), len(value))
+	}
+
+	// filter one item
+	{
+		found := false
+		set, err := value.Filter(func(*Task) (bool, error) {
+			if !found {
+				found = true
+				return found, nil
+			}
+			return false, nil
+		})
+		req.NoError(err)
+		req.Len(set, 1)
+	}
+
+	// filter error
+	{
+		_, err := value.Filter(func(*Task) (bool, error) {
+			return false, fmt.Errorf("filter error")
+		})
+		req.Error(err)
+	}
+}
+
+func TestTaskSetIDs(t *testing.T) {
+	var (
+		value = make(TaskSet, 3)
+		req   = require.New(t)
+	)
+
+	// construct objects
+	value[0] = new(Task)
+	value[1] = new(Task)
+	value[2] = new(Task)
+	// set ids
+	value[0].ID = 1
+	value[1].ID = 2
+	value[2].ID = 3
+
+	// Find existing
+	{
+		val := value.FindByID(2)
+		req.Equal(uint64(2), val.ID)
+	}
+
+	// Find non-existing
+	{
+		val := value.FindByID(4)
+		req.Nil(val)
+	}
+
+	// List IDs from set
+	{
+		val := value.IDs()
+		req.Equal(len(val), len(value))
+	}
+}
+
 func TestTriggerSetWalk(t *testing.T) {
 	var (
 		value = make(TriggerSet, 3)
@@ -542,6 +778,96 @@ func TestWorkflowPathSetFilter(t *testing.T) {
 	}
 }
 
+func TestWorkflowRevisionSetWalk(t *testing.T) {
+	var (
+		value = make(WorkflowRevisionSet, 3)
+		req   = require.New(t)
+	)
+
+	// check walk with no errors
+	{
+		err := value.Walk(func(*WorkflowRevision) error {
+			return nil
+		})
+		req.NoError(err)
+	}
+
+	// check walk with error
+	req.Error(value.Walk(func(*WorkflowRevision) error { return fmt.Errorf("walk error") }))
+}
+
+func TestWorkflowRevisionSetFilter(t *testing.T) {
+	var (
+		value = make(WorkflowRevisionSet, 3)
+		req   = require.New(t)
+	)
+
+	// filter nothing
+	{
+		set, err := value.Filter(func(*WorkflowRevision) (bool, error) {
+			return true, nil
+		})
+		req.NoError(err)
+		req.Equal(len(set), len(value))
+	}
+
+	// filter one item
+	{
+		found := false
+		set, err := value.Filter(func(*WorkflowRevision) (bool, error) {
+			if !found {
+				found = true
+				return found, nil
+			}
+			return false, nil
+		})
+		req.NoError(err)
+		req.Len(set, 1)
+	}
+
+	// filter error
+	{
+		_, err := value.Filter(func(*WorkflowRevision) (bool, error) {
+			return false, fmt.Errorf("filter error")
+		})
+		req.Error(err)
+	}
+}
+
+func TestWorkflowRevisionSetIDs(t *testing.T) {
+	var (
+		value = make(WorkflowRevisionSet, 3)
+		req   = require.New(t)
+	)
+
+	// construct objects
+	value[0] = new(WorkflowRevision)
+	value[1] = new(WorkflowRevision)
+	value[2] = new(WorkflowRevision)
+	// set ids
+	value[0].ID = 1
+	value[1].ID = 2
+	value[2].ID = 3
+
+	// Find existing
+	{
+		val := value.FindByID(2)
+		req.Equal(uint64(2), val.ID)
+	}
+
+	// Find non-existing
+	{
+		val := value.FindByID(4)
+		req.Nil(val)
+	}
+
+	// List IDs from set
+	{
+		val := value.IDs()
+		req.Equal(len(val), len(value))
+	}
+}
+
 func TestWorkflowStepSetWalk(t *testing.T) {
 	var (
 		value = make(WorkflowStepSet, 3)
@@ -631,3 +957,149 @@ func TestWorkflowStepSetIDs(t *testing.T) {
 		req.Equal(len(val), len(value))
 	}
 }
+
+func TestWorkflowTestCaseSetWalk(t *testing.T) {
+	var (
+		value = make(WorkflowTestCaseSet, 3)
+		req   = require.New(t)
+	)
+
+	// check walk with no errors
+	{
+		err := value.Walk(func(*WorkflowTestCase) error {
+			return nil
+		})
+		req.NoError(err)
+	}
+
+	// check walk with error
+	req.Error(value.Walk(func(*WorkflowTestCase) error { return fmt.Errorf("walk error") }))
+}
+
+func TestWorkflowTestCaseSetFilter(t *testing.T) {
+	var (
+		value = make(WorkflowTestCaseSet, 3)
+		req   = require.New(t)
+	)
+
+	// filter nothing
+	{
+		set, err := value.Filter(func(*WorkflowTestCase) (bool, error) {
+			return true, nil
+		})
+		req.NoError(err)
+		req.Equal(len(set), len(value))
+	}
+
+	// filter one item
+	{
+		found := false
+		set, err := value.Filter(func(*WorkflowTestCase) (bool, error) {
+			if !found {
+				found = true
+				return found, nil
+			}
+			return false, nil
+		})
+		req.NoError(err)
+		req.Len(set, 1)
+	}
+
+	// filter error
+	{
+		_, err := value.Filter(func(*WorkflowTestCase) (bool, error) {
+			return false, fmt.Errorf("filter error")
+		})
+		req.Error(err)
+	}
+}
+
+func TestWorkflowTestCaseSetIDs(t *testing.T) {
+	var (
+		value = make(WorkflowTestCaseSet, 3)
+		req   = require.New(t)
+	)
+
+	// construct objects
+	value[0] = new(WorkflowTestCase)
+	value[1] = new(WorkflowTestCase)
+	value[2] = new(WorkflowTestCase)
+	// set ids
+	value[0].ID = 1
+	value[1].ID = 2
+	value[2].ID = 3
+
+	// Find existing
+	{
+		val := value.FindByID(2)
+		req.Equal(uint64(2), val.ID)
+	}
+
+	// Find non-existing
+	{
+		val := value.FindByID(4)
+		req.Nil(val)
+	}
+
+	// List IDs from set
+	{
+		val := value.IDs()
+		req.Equal(len(val), len(value))
+	}
+}
+
+func TestWorkflowTestMockSetWalk(t *testing.T) {
+	var (
+		value = make(WorkflowTestMockSet, 3)
+		req   = require.New(t)
+	)
+
+	// check walk with no errors
+	{
+		err := value.Walk(func(*WorkflowTestMock) error {
+			return nil
+		})
+		req.NoError(err)
+	}
+
+	// check walk with error
+	req.Error(value.Walk(func(*WorkflowTestMock) error { return fmt.Errorf("walk error") }))
+}
+
+func TestWorkflowTestMockSetFilter(t *testing.T) {
+	var (
+		value = make(WorkflowTestMockSet, 3)
+		req   = require.New(t)
+	)
+
+	// filter nothing
+	{
+		set, err := value.Filter(func(*WorkflowTestMock) (bool, error) {
+			return true, nil
+		})
+		req.NoError(err)
+		req.Equal(len(set), len(value))
+	}
+
+	// filter one item
+	{
+		found := false
+		set, err := value.Filter(func(*WorkflowTestMock) (bool, error) {
+			if !found {
+				found = true
+				return found, nil
+			}
+			return false, nil
+		})
+		req.NoError(err)
+		req.Len(set, 1)
+	}
+
+	// filter error
+	{
+		_, err := value.Filter(func(*WorkflowTestMock) (bool, error) {
+			return false, fmt.Errorf("filter error")
+		})
+		req.Error(err)
+	}
+}
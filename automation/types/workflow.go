@@ -35,6 +35,12 @@ type (
 
 		RunAs uint64 `json:"runAs,string"`
 
+		// PublishedRevision points to the revision that is bound to triggers
+		// and executed; 0 when workflow was never published.
+		//
+		// Steps, paths, scope and meta on the workflow itself are a draft
+		PublishedRevision uint `json:"publishedRevision"`
+
 		OwnedBy   uint64     `json:"ownedBy,string"`
 		CreatedAt time.Time  `json:"createdAt,omitempty"`
 		CreatedBy uint64     `json:"createdBy,string" `
@@ -103,6 +109,9 @@ type (
 		// Wait for workflow to be executed even if it's deferred
 		Wait bool
 
+		// Steps the debugger pauses the session on
+		Breakpoints []uint64
+
 		Input *expr.Vars
 	}
 )
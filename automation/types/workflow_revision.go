@@ -0,0 +1,178 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+)
+
+type (
+	// WorkflowRevision is an immutable snapshot of the workflow definition
+	//
+	// Revision is created every time workflow draft is published
+	WorkflowRevision struct {
+		ID         uint64 `json:"revisionID,string"`
+		WorkflowID uint64 `json:"workflowID,string"`
+
+		// Revision is sequential number of the revision of the workflow
+		Revision uint `json:"revision"`
+
+		Meta  *WorkflowMeta   `json:"meta,omitempty"`
+		Scope *expr.Vars      `json:"scope"`
+		Steps WorkflowStepSet `json:"steps"`
+		Paths WorkflowPathSet `json:"paths"`
+
+		CreatedAt time.Time `json:"createdAt,omitempty"`
+		CreatedBy uint64    `json:"createdBy,string"`
+	}
+
+	WorkflowRevisionFilter struct {
+		WorkflowID uint64 `json:"workflowID,string"`
+
+		// Standard helpers for paging and sorting
+		filter.Sorting
+		filter.Paging
+	}
+
+	// WorkflowRevisionDiff holds differences between two workflow revisions
+	WorkflowRevisionDiff struct {
+		WorkflowID uint64 `json:"workflowID,string"`
+		From       uint   `json:"from"`
+		To         uint   `json:"to"`
+
+		// Changes holds names of changed workflow properties (meta, scope)
+		Changes []string `json:"changes"`
+
+		AddedSteps   []uint64 `json:"addedSteps"`
+		RemovedSteps []uint64 `json:"removedSteps"`
+		ChangedSteps []uint64 `json:"changedSteps"`
+
+		AddedPaths   WorkflowPathSet `json:"addedPaths"`
+		RemovedPaths WorkflowPathSet `json:"removedPaths"`
+		ChangedPaths WorkflowPathSet `json:"changedPaths"`
+	}
+)
+
+// MakeRevision creates a new revision from the workflow (draft) definition
+func (r Workflow) MakeRevision() *WorkflowRevision {
+	return &WorkflowRevision{
+		WorkflowID: r.ID,
+		Meta:       r.Meta,
+		Scope:      r.Scope,
+		Steps:      r.Steps,
+		Paths:      r.Paths,
+	}
+}
+
+// Published returns a copy of the workflow with the definition from the revision
+func (r Workflow) Published(rev *WorkflowRevision) *Workflow {
+	r.Meta = rev.Meta
+	r.Scope = rev.Scope
+	r.Steps = rev.Steps
+	r.Paths = rev.Paths
+	r.Issues = nil
+	r.PublishedRevision = rev.Revision
+	return &r
+}
+
+// DiffWorkflowRevisions compares definitions of two workflow revisions
+//
+// Steps are matched by their ID and paths by their parent and child step IDs
+func DiffWorkflowRevisions(old, new *WorkflowRevision) *WorkflowRevisionDiff {
+	var (
+		d = &WorkflowRevisionDiff{
+			WorkflowID: new.WorkflowID,
+			From:       old.Revision,
+			To:         new.Revision,
+			Changes:    []string{},
+		}
+
+		oldSteps = make(map[uint64]*WorkflowStep)
+		newSteps = make(map[uint64]*WorkflowStep)
+		oldPaths = make(map[string]*WorkflowPath)
+		newPaths = make(map[string]*WorkflowPath)
+
+		pathKey = func(p *WorkflowPath) string {
+			return fmt.Sprintf("%d-%d", p.ParentID, p.ChildID)
+		}
+	)
+
+	if !equalJSON(old.Meta, new.Meta) {
+		d.Changes = append(d.Changes, "meta")
+	}
+
+	if !equalJSON(old.Scope, new.Scope) {
+		d.Changes = append(d.Changes, "scope")
+	}
+
+	for _, s := range old.Steps {
+		oldSteps[s.ID] = s
+	}
+
+	for _, s := range new.Steps {
+		newSteps[s.ID] = s
+
+		if o, has := oldSteps[s.ID]; !has {
+			d.AddedSteps = append(d.AddedSteps, s.ID)
+		} else if !equalJSON(o, s) {
+			d.ChangedSteps = append(d.ChangedSteps, s.ID)
+		}
+	}
+
+	for _, s := range old.Steps {
+		if _, has := newSteps[s.ID]; !has {
+			d.RemovedSteps = append(d.RemovedSteps, s.ID)
+		}
+	}
+
+	for _, p := range old.Paths {
+		oldPaths[pathKey(p)] = p
+	}
+
+	for _, p := range new.Paths {
+		newPaths[pathKey(p)] = p
+
+		if o, has := oldPaths[pathKey(p)]; !has {
+			d.AddedPaths = append(d.AddedPaths, p)
+		} else if !equalJSON(o, p) {
+			d.ChangedPaths = append(d.ChangedPaths, p)
+		}
+	}
+
+	for _, p := range old.Paths {
+		if _, has := newPaths[pathKey(p)]; !has {
+			d.RemovedPaths = append(d.RemovedPaths, p)
+		}
+	}
+
+	for _, ss := range [][]uint64{d.AddedSteps, d.RemovedSteps, d.ChangedSteps} {
+		sort.Slice(ss, func(i, j int) bool { return ss[i] < ss[j] })
+	}
+
+	return d
+}
+
+// Empty returns true when there are no differences
+func (d WorkflowRevisionDiff) Empty() bool {
+	return len(d.Changes) == 0 &&
+		len(d.AddedSteps) == 0 &&
+		len(d.RemovedSteps) == 0 &&
+		len(d.ChangedSteps) == 0 &&
+		len(d.AddedPaths) == 0 &&
+		len(d.RemovedPaths) == 0 &&
+		len(d.ChangedPaths) == 0
+}
+
+// equalJSON compares JSON encoded values
+//
+// Steps and paths hold unexported (runtime) values
+// that we want to ignore when comparing
+func equalJSON(a, b interface{}) bool {
+	aa, aErr := json.Marshal(a)
+	bb, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && string(aa) == string(bb)
+}
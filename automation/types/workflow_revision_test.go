@@ -0,0 +1,86 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffWorkflowRevisions(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		old = &WorkflowRevision{
+			WorkflowID: 42,
+			Revision:   1,
+			Meta:       &WorkflowMeta{Name: "wf"},
+			Steps: WorkflowStepSet{
+				{ID: 1, Kind: WorkflowStepKindExpressions},
+				{ID: 2, Kind: WorkflowStepKindFunction, Ref: "logInfo"},
+				{ID: 3, Kind: WorkflowStepKindTermination},
+			},
+			Paths: WorkflowPathSet{
+				{ParentID: 1, ChildID: 2},
+				{ParentID: 2, ChildID: 3, Expr: "true"},
+			},
+		}
+
+		new = &WorkflowRevision{
+			WorkflowID: 42,
+			Revision:   2,
+			Meta:       &WorkflowMeta{Name: "wf"},
+			Steps: WorkflowStepSet{
+				{ID: 1, Kind: WorkflowStepKindExpressions},
+				{ID: 2, Kind: WorkflowStepKindFunction, Ref: "logWarn"},
+				{ID: 4, Kind: WorkflowStepKindTermination},
+			},
+			Paths: WorkflowPathSet{
+				{ParentID: 1, ChildID: 2},
+				{ParentID: 2, ChildID: 4},
+			},
+		}
+	)
+
+	req.True(DiffWorkflowRevisions(old, old).Empty())
+
+	d := DiffWorkflowRevisions(old, new)
+	req.False(d.Empty())
+	req.Equal(uint(1), d.From)
+	req.Equal(uint(2), d.To)
+	req.Empty(d.Changes)
+	req.Equal([]uint64{4}, d.AddedSteps)
+	req.Equal([]uint64{3}, d.RemovedSteps)
+	req.Equal([]uint64{2}, d.ChangedSteps)
+	req.Len(d.AddedPaths, 1)
+	req.Len(d.RemovedPaths, 1)
+	req.Empty(d.ChangedPaths)
+
+	new.Meta = &WorkflowMeta{Name: "renamed"}
+	req.Equal([]string{"meta"}, DiffWorkflowRevisions(old, new).Changes)
+}
+
+func TestWorkflow_Published(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		draft = Workflow{
+			ID:                42,
+			Enabled:           true,
+			Steps:             WorkflowStepSet{{ID: 1}, {ID: 2}},
+			Issues:            WorkflowIssueSet{{Description: "draft issue"}},
+			PublishedRevision: 1,
+		}
+
+		rev = &WorkflowRevision{Revision: 3, Steps: WorkflowStepSet{{ID: 1}}}
+	)
+
+	pub := draft.Published(rev)
+	req.True(pub.Enabled)
+	req.Len(pub.Steps, 1)
+	req.Empty(pub.Issues)
+	req.Equal(uint(3), pub.PublishedRevision)
+
+	// draft is not modified
+	req.Len(draft.Steps, 2)
+	req.Equal(uint(1), draft.PublishedRevision)
+}
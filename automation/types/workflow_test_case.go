@@ -0,0 +1,259 @@
+package types
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/cortezaproject/corteza-server/pkg/wfexec"
+)
+
+type (
+	// WorkflowTestCase describes how workflow is executed in a sandbox
+	// and what results are expected
+	//
+	// Functions used by the workflow are intercepted while the test case runs;
+	// mocked functions return configured values and functions that are
+	// neither mocked nor marked as passthrough fail the step.
+	WorkflowTestCase struct {
+		ID         uint64 `json:"testCaseID,string"`
+		WorkflowID uint64 `json:"workflowID,string"`
+
+		Meta *WorkflowTestCaseMeta `json:"meta"`
+
+		// Step to start the workflow on; when not set,
+		// workflow is started on its (only) orphan step
+		StepID uint64 `json:"stepID,string"`
+
+		// Input scope
+		Input *expr.Vars `json:"input"`
+
+		Mocks       WorkflowTestMockSet     `json:"mocks"`
+		Passthrough WorkflowTestPassthrough `json:"passthrough"`
+
+		// Assertions evaluated over the workflow results
+		Assertions TestSet `json:"assertions"`
+
+		// When set, test case expects workflow to fail
+		// with an error that contains this text
+		ExpectError string `json:"expectError"`
+
+		OwnedBy   uint64     `json:"ownedBy,string"`
+		CreatedAt time.Time  `json:"createdAt,omitempty"`
+		CreatedBy uint64     `json:"createdBy,string"`
+		UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+		UpdatedBy uint64     `json:"updatedBy,string,omitempty"`
+		DeletedAt *time.Time `json:"deletedAt,omitempty"`
+		DeletedBy uint64     `json:"deletedBy,string,omitempty"`
+	}
+
+	WorkflowTestCaseMeta struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	// WorkflowTestMock replaces function with the configured results or error
+	WorkflowTestMock struct {
+		// Function reference (e.g. httpRequestSend, composeRecordsCreate)
+		Ref string `json:"ref"`
+
+		// Values returned by the mocked function
+		Results *expr.Vars `json:"results,omitempty"`
+
+		// Items returned by the mocked iterator, one per iteration
+		Items []*expr.Vars `json:"items,omitempty"`
+
+		// When set, mocked function fails with this error
+		Error string `json:"error,omitempty"`
+	}
+
+	// WorkflowTestPassthrough holds references of functions
+	// that are executed without interception
+	WorkflowTestPassthrough []string
+
+	WorkflowTestCaseFilter struct {
+		TestCaseID []uint64 `json:"testCaseID"`
+		WorkflowID []uint64 `json:"workflowID"`
+
+		Deleted filter.State `json:"deleted"`
+
+		// Standard helpers for paging and sorting
+		filter.Sorting
+		filter.Paging
+	}
+
+	// WorkflowTestResult is the outcome of the test case run
+	WorkflowTestResult struct {
+		TestCaseID uint64 `json:"testCaseID,string"`
+		WorkflowID uint64 `json:"workflowID,string"`
+		Name       string `json:"name"`
+
+		Passed bool `json:"passed"`
+
+		// Failed assertions and unmet expectations
+		Failures []string `json:"failures,omitempty"`
+
+		// Error the workflow failed with
+		Error string `json:"error,omitempty"`
+
+		Results *expr.Vars `json:"results,omitempty"`
+		Trace   Stacktrace `json:"trace,omitempty"`
+
+		// Execution time in milliseconds
+		ElapsedTime uint `json:"elapsedTime"`
+	}
+
+	// mockedIterator walks over the items of the mocked iterator
+	mockedIterator struct {
+		items []*expr.Vars
+		ptr   int
+	}
+)
+
+// Function returns mock for the given function reference
+func (set WorkflowTestMockSet) Function(ref string) *WorkflowTestMock {
+	for _, m := range set {
+		if m.Ref == ref {
+			return m
+		}
+	}
+
+	return nil
+}
+
+// Mock returns a copy of function definition with mocked handler
+func (m WorkflowTestMock) Mock(def *Function) *Function {
+	var (
+		mocked = *def
+		err    error
+	)
+
+	if m.Error != "" {
+		err = errors.New(m.Error)
+	}
+
+	mocked.Handler = func(context.Context, *expr.Vars) (*expr.Vars, error) {
+		if err != nil {
+			return nil, err
+		}
+
+		return (&expr.Vars{}).MustMerge(m.Results), nil
+	}
+
+	mocked.Iterator = func(context.Context, *expr.Vars) (wfexec.IteratorHandler, error) {
+		if err != nil {
+			return nil, err
+		}
+
+		return &mockedIterator{items: m.Items}, nil
+	}
+
+	return &mocked
+}
+
+// InterceptFunction returns a copy of function definition that fails when executed
+func InterceptFunction(def *Function) *Function {
+	var (
+		intercepted = *def
+		err         = fmt.Errorf("function %q is neither mocked nor passed through in the test case", def.Ref)
+	)
+
+	intercepted.Handler = func(context.Context, *expr.Vars) (*expr.Vars, error) {
+		return nil, err
+	}
+
+	intercepted.Iterator = func(context.Context, *expr.Vars) (wfexec.IteratorHandler, error) {
+		return nil, err
+	}
+
+	return &intercepted
+}
+
+func (i *mockedIterator) Start(context.Context, *expr.Vars) error { i.ptr = 0; return nil }
+
+func (i *mockedIterator) More(context.Context, *expr.Vars) (bool, error) {
+	return i.ptr < len(i.items), nil
+}
+
+func (i *mockedIterator) Next(context.Context, *expr.Vars) (out *expr.Vars, err error) {
+	out = (&expr.Vars{}).MustMerge(i.items[i.ptr])
+	i.ptr++
+	return
+}
+
+// Has returns true if function is passed through
+func (pt WorkflowTestPassthrough) Has(ref string) bool {
+	for _, r := range pt {
+		if r == ref {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (vv *WorkflowTestCaseMeta) Scan(value interface{}) error {
+	//lint:ignore S1034 This typecast is intentional, we need to get []byte out of a []uint8
+	switch value.(type) {
+	case nil:
+		*vv = WorkflowTestCaseMeta{}
+	case []uint8:
+		b := value.([]byte)
+		if err := json.Unmarshal(b, vv); err != nil {
+			return fmt.Errorf("cannot scan '%v' into WorkflowTestCaseMeta: %w", string(b), err)
+		}
+	}
+
+	return nil
+}
+
+func (vv *WorkflowTestCaseMeta) Value() (driver.Value, error) {
+	if vv == nil {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(vv)
+}
+
+func (set *WorkflowTestMockSet) Scan(value interface{}) error {
+	//lint:ignore S1034 This typecast is intentional, we need to get []byte out of a []uint8
+	switch value.(type) {
+	case nil:
+		*set = WorkflowTestMockSet{}
+	case []uint8:
+		b := value.([]byte)
+		if err := json.Unmarshal(b, set); err != nil {
+			return fmt.Errorf("cannot scan '%v' into WorkflowTestMockSet: %w", string(b), err)
+		}
+	}
+
+	return nil
+}
+
+func (set WorkflowTestMockSet) Value() (driver.Value, error) {
+	return json.Marshal(set)
+}
+
+func (pt *WorkflowTestPassthrough) Scan(value interface{}) error {
+	//lint:ignore S1034 This typecast is intentional, we need to get []byte out of a []uint8
+	switch value.(type) {
+	case nil:
+		*pt = WorkflowTestPassthrough{}
+	case []uint8:
+		b := value.([]byte)
+		if err := json.Unmarshal(b, pt); err != nil {
+			return fmt.Errorf("cannot scan '%v' into WorkflowTestPassthrough: %w", string(b), err)
+		}
+	}
+
+	return nil
+}
+
+func (pt WorkflowTestPassthrough) Value() (driver.Value, error) {
+	return json.Marshal(pt)
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowTestMock(t *testing.T) {
+	var (
+		ctx = context.Background()
+		req = require.New(t)
+		def = &Function{Ref: "fn", Kind: FunctionKindFunction}
+
+		vars = func(kv map[string]interface{}) *expr.Vars {
+			v, err := expr.NewVars(kv)
+			req.NoError(err)
+			return v
+		}
+	)
+
+	t.Run("results", func(t *testing.T) {
+		m := WorkflowTestMock{Ref: "fn", Results: vars(map[string]interface{}{"foo": "bar"})}
+
+		out, err := m.Mock(def).Handler(ctx, nil)
+		req.NoError(err)
+		req.True(out.Has("foo"))
+		req.Nil(def.Handler, "original definition is not modified")
+	})
+
+	t.Run("error", func(t *testing.T) {
+		m := WorkflowTestMock{Ref: "fn", Error: "failed"}
+
+		_, err := m.Mock(def).Handler(ctx, nil)
+		req.EqualError(err, "failed")
+	})
+
+	t.Run("iterator", func(t *testing.T) {
+		var (
+			m = WorkflowTestMock{Ref: "fn", Items: []*expr.Vars{
+				vars(map[string]interface{}{"i": 1}),
+				vars(map[string]interface{}{"i": 2}),
+			}}
+
+			n int
+		)
+
+		ih, err := m.Mock(def).Iterator(ctx, nil)
+		req.NoError(err)
+		req.NoError(ih.Start(ctx, nil))
+
+		for {
+			more, err := ih.More(ctx, nil)
+			req.NoError(err)
+			if !more {
+				break
+			}
+
+			_, err = ih.Next(ctx, nil)
+			req.NoError(err)
+			n++
+		}
+
+		req.Equal(2, n)
+	})
+
+	t.Run("intercepted", func(t *testing.T) {
+		_, err := InterceptFunction(def).Handler(ctx, nil)
+		req.Error(err)
+	})
+}
@@ -17,6 +17,7 @@ workflow: schema.#Resource & {
 		paths: { goType: "types.WorkflowPathSet" }
 		issues: { goType: "types.WorkflowIssueSet" }
 		run_as: { goType: "uint64" }
+		published_revision: { goType: "uint" }
 
 		owned_by: { goType: "uint64" }
 		created_at: schema.SortableTimestampField
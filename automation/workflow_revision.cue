@@ -0,0 +1,54 @@
+package automation
+
+import (
+	"github.com/cortezaproject/corteza-server/codegen/schema"
+)
+
+workflowRevision: schema.#Resource & {
+	features: {
+		labels: false
+		checkFn: false
+	}
+
+	struct: {
+		id:          schema.IdField
+		workflow_id: { goType: "uint64", storeIdent: "rel_workflow", ident: "workflowID" }
+		revision:    { goType: "uint", sortable: true }
+		meta:        { goType: "*types.WorkflowMeta" }
+		scope:       { goType: "*expr.Vars" }
+		steps:       { goType: "types.WorkflowStepSet" }
+		paths:       { goType: "types.WorkflowPathSet" }
+		created_at:  schema.SortableTimestampField
+		created_by:  { goType: "uint64" }
+	}
+
+	filter: {
+		struct: {
+			workflow_id: { goType: "uint64", ident: "workflowID", storeIdent: "rel_workflow" }
+		}
+
+		byValue: ["workflow_id"]
+	}
+
+	store: {
+		ident: "automationWorkflowRevision"
+
+		settings: {
+			rdbms: {
+				table: "automation_workflow_revisions"
+			}
+		}
+
+		api: {
+			lookups: [
+				{ fields: ["id"] },
+				{
+					fields: ["workflow_id", "revision"]
+					description: """
+						searches for workflow revision by workflow ID and revision number
+						"""
+				},
+			]
+		}
+	}
+}
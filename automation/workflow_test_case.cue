@@ -0,0 +1,66 @@
+package automation
+
+import (
+	"github.com/cortezaproject/corteza-server/codegen/schema"
+)
+
+workflowTestCase: schema.#Resource & {
+	features: {
+		labels: false
+		checkFn: false
+	}
+
+	struct: {
+		id:          schema.IdField
+		workflow_id: { goType: "uint64", storeIdent: "rel_workflow", ident: "workflowID" }
+		meta:        { goType: "*types.WorkflowTestCaseMeta" }
+		step_id:     { goType: "uint64", storeIdent: "rel_step", ident: "stepID" }
+		input:       { goType: "*expr.Vars" }
+		mocks:       { goType: "types.WorkflowTestMockSet" }
+		passthrough: { goType: "types.WorkflowTestPassthrough" }
+		assertions:  { goType: "types.TestSet" }
+		expect_error: { goType: "string" }
+
+		owned_by:   { goType: "uint64" }
+		created_at: schema.SortableTimestampField
+		updated_at: schema.SortableTimestampNilField
+		deleted_at: schema.SortableTimestampNilField
+		created_by: { goType: "uint64" }
+		updated_by: { goType: "uint64" }
+		deleted_by: { goType: "uint64" }
+	}
+
+	filter: {
+		struct: {
+			test_case_id: { goType: "[]uint64", ident: "testCaseID", storeIdent: "id" }
+			workflow_id:  { goType: "[]uint64", ident: "workflowID", storeIdent: "rel_workflow" }
+			deleted:      { goType: "filter.State", storeIdent: "deleted_at" }
+		}
+
+		byValue: ["test_case_id", "workflow_id"]
+		byNilState: ["deleted"]
+	}
+
+	store: {
+		ident: "automationWorkflowTestCase"
+
+		settings: {
+			rdbms: {
+				table: "automation_workflow_test_cases"
+			}
+		}
+
+		api: {
+			lookups: [
+				{
+					fields: ["id"]
+					description: """
+						searches for workflow test case by ID
+
+						It returns test case even if deleted
+						"""
+				},
+			]
+		}
+	}
+}
@@ -4,6 +4,7 @@ package store
 
 import (
 	"context"
+	"time"
 	"go.uber.org/zap"
 {{- range $path, $alias :=  .imports }}
     {{ $alias }} {{ printf "%q" $path }}
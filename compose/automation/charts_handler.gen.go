@@ -0,0 +1,465 @@
+package automation
+
+// This file is auto-generated.
+//
+// Changes to this file may cause incorrect behavior and will be lost if
+// the code is regenerated.
+//
+// Definitions file that controls how this file is generated:
+// compose/automation/charts_handler.yaml
+
+import (
+	"context"
+	atypes "github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/wfexec"
+)
+
+var _ wfexec.ExecResponse
+
+type (
+	chartsHandlerRegistry interface {
+		AddFunctions(ff ...*atypes.Function)
+		Type(ref string) expr.Type
+	}
+)
+
+func (h chartsHandler) register() {
+	h.reg.AddFunctions(
+		h.Lookup(),
+		h.Create(),
+		h.Update(),
+		h.Delete(),
+	)
+}
+
+type (
+	chartsLookupArgs struct {
+		hasChart    bool
+		Chart       interface{}
+		chartID     uint64
+		chartHandle string
+		chartRes    *types.Chart
+
+		hasNamespace    bool
+		Namespace       interface{}
+		namespaceID     uint64
+		namespaceHandle string
+		namespaceRes    *types.Namespace
+	}
+
+	chartsLookupResults struct {
+		Chart *types.Chart
+	}
+)
+
+func (a chartsLookupArgs) GetChart() (bool, uint64, string, *types.Chart) {
+	return a.hasChart, a.chartID, a.chartHandle, a.chartRes
+}
+
+func (a chartsLookupArgs) GetNamespace() (bool, uint64, string, *types.Namespace) {
+	return a.hasNamespace, a.namespaceID, a.namespaceHandle, a.namespaceRes
+}
+
+// Lookup function Compose chart lookup
+//
+// expects implementation of lookup function:
+//
+//	func (h chartsHandler) lookup(ctx context.Context, args *chartsLookupArgs) (results *chartsLookupResults, err error) {
+//	   return
+//	}
+func (h chartsHandler) Lookup() *atypes.Function {
+	return &atypes.Function{
+		Ref:    "composeChartsLookup",
+		Kind:   "function",
+		Labels: map[string]string{"chart": "step,workflow", "compose": "step,workflow", "lookup": "step"},
+		Meta: &atypes.FunctionMeta{
+			Short:       "Compose chart lookup",
+			Description: "Find specific chart by ID or handle",
+		},
+
+		Parameters: []*atypes.Param{
+			{
+				Name:  "chart",
+				Types: []string{"ID", "Handle", "ComposeChart"}, Required: true,
+			},
+			{
+				Name:  "namespace",
+				Types: []string{"ID", "Handle", "ComposeNamespace"}, Required: true,
+			},
+		},
+
+		Results: []*atypes.Param{
+
+			{
+				Name:  "chart",
+				Types: []string{"ComposeChart"},
+			},
+		},
+
+		Handler: func(ctx context.Context, in *expr.Vars) (out *expr.Vars, err error) {
+			var (
+				args = &chartsLookupArgs{
+					hasChart:     in.Has("chart"),
+					hasNamespace: in.Has("namespace"),
+				}
+			)
+
+			if err = in.Decode(args); err != nil {
+				return
+			}
+
+			// Converting Chart argument
+			if args.hasChart {
+				aux := expr.Must(expr.Select(in, "chart"))
+				switch aux.Type() {
+				case h.reg.Type("ID").Type():
+					args.chartID = aux.Get().(uint64)
+				case h.reg.Type("Handle").Type():
+					args.chartHandle = aux.Get().(string)
+				case h.reg.Type("ComposeChart").Type():
+					args.chartRes = aux.Get().(*types.Chart)
+				}
+			}
+
+			// Converting Namespace argument
+			if args.hasNamespace {
+				aux := expr.Must(expr.Select(in, "namespace"))
+				switch aux.Type() {
+				case h.reg.Type("ID").Type():
+					args.namespaceID = aux.Get().(uint64)
+				case h.reg.Type("Handle").Type():
+					args.namespaceHandle = aux.Get().(string)
+				case h.reg.Type("ComposeNamespace").Type():
+					args.namespaceRes = aux.Get().(*types.Namespace)
+				}
+			}
+
+			var results *chartsLookupResults
+			if results, err = h.lookup(ctx, args); err != nil {
+				return
+			}
+
+			out = &expr.Vars{}
+
+			{
+				// converting results.Chart (*types.Chart) to ComposeChart
+				var (
+					tval expr.TypedValue
+				)
+
+				if tval, err = h.reg.Type("ComposeChart").Cast(results.Chart); err != nil {
+					return
+				} else if err = expr.Assign(out, "chart", tval); err != nil {
+					return
+				}
+			}
+
+			return
+		},
+	}
+}
+
+type (
+	chartsCreateArgs struct {
+		hasNamespace    bool
+		Namespace       interface{}
+		namespaceID     uint64
+		namespaceHandle string
+		namespaceRes    *types.Namespace
+
+		hasChart bool
+		Chart    *types.Chart
+
+		hasConfig bool
+		Config    interface{}
+	}
+
+	chartsCreateResults struct {
+		Chart *types.Chart
+	}
+)
+
+func (a chartsCreateArgs) GetNamespace() (bool, uint64, string, *types.Namespace) {
+	return a.hasNamespace, a.namespaceID, a.namespaceHandle, a.namespaceRes
+}
+
+// Create function Compose chart create
+//
+// expects implementation of create function:
+//
+//	func (h chartsHandler) create(ctx context.Context, args *chartsCreateArgs) (results *chartsCreateResults, err error) {
+//	   return
+//	}
+func (h chartsHandler) Create() *atypes.Function {
+	return &atypes.Function{
+		Ref:    "composeChartsCreate",
+		Kind:   "function",
+		Labels: map[string]string{"chart": "step,workflow", "compose": "step,workflow", "create": "step"},
+		Meta: &atypes.FunctionMeta{
+			Short: "Compose chart create",
+		},
+
+		Parameters: []*atypes.Param{
+			{
+				Name:  "namespace",
+				Types: []string{"ID", "Handle", "ComposeNamespace"}, Required: true,
+			},
+			{
+				Name:  "chart",
+				Types: []string{"ComposeChart"}, Required: true,
+			},
+			{
+				Name:  "config",
+				Types: []string{"Any"},
+				Meta: &atypes.ParamMeta{
+					Label:       "Chart configuration",
+					Description: "Chart configuration (reports, colorScheme, ...)\nin the same format as used by the API",
+				},
+			},
+		},
+
+		Results: []*atypes.Param{
+
+			{
+				Name:  "chart",
+				Types: []string{"ComposeChart"},
+			},
+		},
+
+		Handler: func(ctx context.Context, in *expr.Vars) (out *expr.Vars, err error) {
+			var (
+				args = &chartsCreateArgs{
+					hasNamespace: in.Has("namespace"),
+					hasChart:     in.Has("chart"),
+					hasConfig:    in.Has("config"),
+				}
+			)
+
+			if err = in.Decode(args); err != nil {
+				return
+			}
+
+			// Converting Namespace argument
+			if args.hasNamespace {
+				aux := expr.Must(expr.Select(in, "namespace"))
+				switch aux.Type() {
+				case h.reg.Type("ID").Type():
+					args.namespaceID = aux.Get().(uint64)
+				case h.reg.Type("Handle").Type():
+					args.namespaceHandle = aux.Get().(string)
+				case h.reg.Type("ComposeNamespace").Type():
+					args.namespaceRes = aux.Get().(*types.Namespace)
+				}
+			}
+
+			var results *chartsCreateResults
+			if results, err = h.create(ctx, args); err != nil {
+				return
+			}
+
+			out = &expr.Vars{}
+
+			{
+				// converting results.Chart (*types.Chart) to ComposeChart
+				var (
+					tval expr.TypedValue
+				)
+
+				if tval, err = h.reg.Type("ComposeChart").Cast(results.Chart); err != nil {
+					return
+				} else if err = expr.Assign(out, "chart", tval); err != nil {
+					return
+				}
+			}
+
+			return
+		},
+	}
+}
+
+type (
+	chartsUpdateArgs struct {
+		hasChart bool
+		Chart    *types.Chart
+
+		hasConfig bool
+		Config    interface{}
+	}
+
+	chartsUpdateResults struct {
+		Chart *types.Chart
+	}
+)
+
+// Update function Compose chart update
+//
+// expects implementation of update function:
+//
+//	func (h chartsHandler) update(ctx context.Context, args *chartsUpdateArgs) (results *chartsUpdateResults, err error) {
+//	   return
+//	}
+func (h chartsHandler) Update() *atypes.Function {
+	return &atypes.Function{
+		Ref:    "composeChartsUpdate",
+		Kind:   "function",
+		Labels: map[string]string{"chart": "step,workflow", "compose": "step,workflow", "update": "step"},
+		Meta: &atypes.FunctionMeta{
+			Short:       "Compose chart update",
+			Description: "Updates chart and, when given, replaces its configuration",
+		},
+
+		Parameters: []*atypes.Param{
+			{
+				Name:  "chart",
+				Types: []string{"ComposeChart"}, Required: true,
+			},
+			{
+				Name:  "config",
+				Types: []string{"Any"},
+				Meta: &atypes.ParamMeta{
+					Label:       "Chart configuration",
+					Description: "Chart configuration (reports, colorScheme, ...)\nin the same format as used by the API",
+				},
+			},
+		},
+
+		Results: []*atypes.Param{
+
+			{
+				Name:  "chart",
+				Types: []string{"ComposeChart"},
+			},
+		},
+
+		Handler: func(ctx context.Context, in *expr.Vars) (out *expr.Vars, err error) {
+			var (
+				args = &chartsUpdateArgs{
+					hasChart:  in.Has("chart"),
+					hasConfig: in.Has("config"),
+				}
+			)
+
+			if err = in.Decode(args); err != nil {
+				return
+			}
+
+			var results *chartsUpdateResults
+			if results, err = h.update(ctx, args); err != nil {
+				return
+			}
+
+			out = &expr.Vars{}
+
+			{
+				// converting results.Chart (*types.Chart) to ComposeChart
+				var (
+					tval expr.TypedValue
+				)
+
+				if tval, err = h.reg.Type("ComposeChart").Cast(results.Chart); err != nil {
+					return
+				} else if err = expr.Assign(out, "chart", tval); err != nil {
+					return
+				}
+			}
+
+			return
+		},
+	}
+}
+
+type (
+	chartsDeleteArgs struct {
+		hasChart    bool
+		Chart       interface{}
+		chartID     uint64
+		chartHandle string
+		chartRes    *types.Chart
+
+		hasNamespace    bool
+		Namespace       interface{}
+		namespaceID     uint64
+		namespaceHandle string
+		namespaceRes    *types.Namespace
+	}
+)
+
+func (a chartsDeleteArgs) GetChart() (bool, uint64, string, *types.Chart) {
+	return a.hasChart, a.chartID, a.chartHandle, a.chartRes
+}
+
+func (a chartsDeleteArgs) GetNamespace() (bool, uint64, string, *types.Namespace) {
+	return a.hasNamespace, a.namespaceID, a.namespaceHandle, a.namespaceRes
+}
+
+// Delete function Compose chart delete
+//
+// expects implementation of delete function:
+//
+//	func (h chartsHandler) delete(ctx context.Context, args *chartsDeleteArgs) (err error) {
+//	   return
+//	}
+func (h chartsHandler) Delete() *atypes.Function {
+	return &atypes.Function{
+		Ref:    "composeChartsDelete",
+		Kind:   "function",
+		Labels: map[string]string{"chart": "step,workflow", "compose": "step,workflow", "delete": "step"},
+		Meta: &atypes.FunctionMeta{
+			Short: "Compose chart delete",
+		},
+
+		Parameters: []*atypes.Param{
+			{
+				Name:  "chart",
+				Types: []string{"ID", "Handle", "ComposeChart"}, Required: true,
+			},
+			{
+				Name:  "namespace",
+				Types: []string{"ID", "Handle", "ComposeNamespace"}, Required: true,
+			},
+		},
+
+		Handler: func(ctx context.Context, in *expr.Vars) (out *expr.Vars, err error) {
+			var (
+				args = &chartsDeleteArgs{
+					hasChart:     in.Has("chart"),
+					hasNamespace: in.Has("namespace"),
+				}
+			)
+
+			if err = in.Decode(args); err != nil {
+				return
+			}
+
+			// Converting Chart argument
+			if args.hasChart {
+				aux := expr.Must(expr.Select(in, "chart"))
+				switch aux.Type() {
+				case h.reg.Type("ID").Type():
+					args.chartID = aux.Get().(uint64)
+				case h.reg.Type("Handle").Type():
+					args.chartHandle = aux.Get().(string)
+				case h.reg.Type("ComposeChart").Type():
+					args.chartRes = aux.Get().(*types.Chart)
+				}
+			}
+
+			// Converting Namespace argument
+			if args.hasNamespace {
+				aux := expr.Must(expr.Select(in, "namespace"))
+				switch aux.Type() {
+				case h.reg.Type("ID").Type():
+					args.namespaceID = aux.Get().(uint64)
+				case h.reg.Type("Handle").Type():
+					args.namespaceHandle = aux.Get().(string)
+				case h.reg.Type("ComposeNamespace").Type():
+					args.namespaceRes = aux.Get().(*types.Namespace)
+				}
+			}
+
+			return out, h.delete(ctx, args)
+		},
+	}
+}
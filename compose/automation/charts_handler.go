@@ -0,0 +1,113 @@
+package automation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+)
+
+type (
+	chartService interface {
+		FindByID(ctx context.Context, namespaceID, chartID uint64) (*types.Chart, error)
+		FindByHandle(ctx context.Context, namespaceID uint64, handle string) (*types.Chart, error)
+
+		Create(ctx context.Context, chart *types.Chart) (*types.Chart, error)
+		Update(ctx context.Context, chart *types.Chart) (*types.Chart, error)
+
+		DeleteByID(ctx context.Context, namespaceID, chartID uint64) error
+	}
+
+	chartsHandler struct {
+		reg   chartsHandlerRegistry
+		ns    namespaceService
+		chart chartService
+	}
+
+	chartLookup interface {
+		GetChart() (bool, uint64, string, *types.Chart)
+	}
+)
+
+func ChartsHandler(reg chartsHandlerRegistry, ns namespaceService, chart chartService) *chartsHandler {
+	h := &chartsHandler{
+		reg:   reg,
+		ns:    ns,
+		chart: chart,
+	}
+
+	h.register()
+	return h
+}
+
+func (h chartsHandler) lookup(ctx context.Context, args *chartsLookupArgs) (results *chartsLookupResults, err error) {
+	results = &chartsLookupResults{}
+	results.Chart, err = lookupChart(ctx, h.ns, h.chart, args)
+	return
+}
+
+func (h chartsHandler) create(ctx context.Context, args *chartsCreateArgs) (results *chartsCreateResults, err error) {
+	var (
+		chart = *args.Chart
+	)
+
+	if chart.NamespaceID, err = getNamespaceID(ctx, h.ns, args); err != nil {
+		return
+	}
+
+	if args.hasConfig {
+		if err = decodeAny(args.Config, &chart.Config); err != nil {
+			return nil, fmt.Errorf("could not decode chart config: %w", err)
+		}
+	}
+
+	results = &chartsCreateResults{}
+	results.Chart, err = h.chart.Create(ctx, &chart)
+	return
+}
+
+func (h chartsHandler) update(ctx context.Context, args *chartsUpdateArgs) (results *chartsUpdateResults, err error) {
+	var (
+		chart = *args.Chart
+	)
+
+	if args.hasConfig {
+		chart.Config = types.ChartConfig{}
+		if err = decodeAny(args.Config, &chart.Config); err != nil {
+			return nil, fmt.Errorf("could not decode chart config: %w", err)
+		}
+	}
+
+	results = &chartsUpdateResults{}
+	results.Chart, err = h.chart.Update(ctx, &chart)
+	return
+}
+
+func (h chartsHandler) delete(ctx context.Context, args *chartsDeleteArgs) error {
+	if chart, err := lookupChart(ctx, h.ns, h.chart, args); err != nil {
+		return err
+	} else {
+		return h.chart.DeleteByID(ctx, chart.NamespaceID, chart.ID)
+	}
+}
+
+func lookupChart(ctx context.Context, nsSvc namespaceService, chartSvc chartService, args chartLookup) (*types.Chart, error) {
+	_, ID, handle, chart := args.GetChart()
+	if chart != nil {
+		return chart, nil
+	}
+
+	namespaceID, err := getNamespaceID(ctx, nsSvc, args.(namespaceLookup))
+	if err != nil {
+		return nil, fmt.Errorf("could not load namespace: %w", err)
+	}
+
+	switch {
+	case ID > 0:
+		return chartSvc.FindByID(ctx, namespaceID, ID)
+	case len(handle) > 0:
+		return chartSvc.FindByHandle(ctx, namespaceID, handle)
+	}
+
+	return nil, fmt.Errorf("empty chart lookup params")
+}
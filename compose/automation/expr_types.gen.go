@@ -220,6 +220,200 @@ func assignToAttachment(res *types.Attachment, k string, val interface{}) error
 	return fmt.Errorf("unknown field '%s'", k)
 }
 
+// ComposeChart is an expression type, wrapper for *types.Chart type
+type ComposeChart struct {
+	value *types.Chart
+	mux   sync.RWMutex
+}
+
+// NewComposeChart creates new instance of ComposeChart expression type
+func NewComposeChart(val interface{}) (*ComposeChart, error) {
+	if c, err := CastToComposeChart(val); err != nil {
+		return nil, fmt.Errorf("unable to create ComposeChart: %w", err)
+	} else {
+		return &ComposeChart{value: c}, nil
+	}
+}
+
+// Get return underlying value on ComposeChart
+func (t *ComposeChart) Get() interface{} {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+	return t.value
+}
+
+// GetValue returns underlying value on ComposeChart
+func (t *ComposeChart) GetValue() *types.Chart {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+	return t.value
+}
+
+// Type return type name
+func (ComposeChart) Type() string { return "ComposeChart" }
+
+// Cast converts value to *types.Chart
+func (ComposeChart) Cast(val interface{}) (TypedValue, error) {
+	return NewComposeChart(val)
+}
+
+// Assign new value to ComposeChart
+//
+// value is first passed through CastToComposeChart
+func (t *ComposeChart) Assign(val interface{}) error {
+	if c, err := CastToComposeChart(val); err != nil {
+		return err
+	} else {
+		t.value = c
+		return nil
+	}
+}
+
+func (t *ComposeChart) AssignFieldValue(key string, val TypedValue) error {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	return assignToComposeChart(t.value, key, val)
+}
+
+// SelectGVal implements gval.Selector requirements
+//
+// It allows gval lib to access ComposeChart's underlying value (*types.Chart)
+// and it's fields
+func (t *ComposeChart) SelectGVal(ctx context.Context, k string) (interface{}, error) {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+	return composeChartGValSelector(t.value, k)
+}
+
+// Select is field accessor for *types.Chart
+//
+// Similar to SelectGVal but returns typed values
+func (t *ComposeChart) Select(k string) (TypedValue, error) {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+	return composeChartTypedValueSelector(t.value, k)
+}
+
+func (t *ComposeChart) Has(k string) bool {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+	switch k {
+	case "ID", "chartID":
+		return true
+	case "namespaceID":
+		return true
+	case "name":
+		return true
+	case "handle":
+		return true
+	case "labels":
+		return true
+	case "createdAt":
+		return true
+	case "updatedAt":
+		return true
+	case "deletedAt":
+		return true
+	}
+	return false
+}
+
+// composeChartGValSelector is field accessor for *types.Chart
+func composeChartGValSelector(res *types.Chart, k string) (interface{}, error) {
+	if res == nil {
+		return nil, nil
+	}
+	switch k {
+	case "ID", "chartID":
+		return res.ID, nil
+	case "namespaceID":
+		return res.NamespaceID, nil
+	case "name":
+		return res.Name, nil
+	case "handle":
+		return res.Handle, nil
+	case "labels":
+		return res.Labels, nil
+	case "createdAt":
+		return res.CreatedAt, nil
+	case "updatedAt":
+		return res.UpdatedAt, nil
+	case "deletedAt":
+		return res.DeletedAt, nil
+	}
+
+	return nil, fmt.Errorf("unknown field '%s'", k)
+}
+
+// composeChartTypedValueSelector is field accessor for *types.Chart
+func composeChartTypedValueSelector(res *types.Chart, k string) (TypedValue, error) {
+	if res == nil {
+		return nil, nil
+	}
+	switch k {
+	case "ID", "chartID":
+		return NewID(res.ID)
+	case "namespaceID":
+		return NewID(res.NamespaceID)
+	case "name":
+		return NewString(res.Name)
+	case "handle":
+		return NewHandle(res.Handle)
+	case "labels":
+		return NewKV(res.Labels)
+	case "createdAt":
+		return NewDateTime(res.CreatedAt)
+	case "updatedAt":
+		return NewDateTime(res.UpdatedAt)
+	case "deletedAt":
+		return NewDateTime(res.DeletedAt)
+	}
+
+	return nil, fmt.Errorf("unknown field '%s'", k)
+}
+
+// assignToComposeChart is field value setter for *types.Chart
+func assignToComposeChart(res *types.Chart, k string, val interface{}) error {
+	switch k {
+	case "ID", "chartID":
+		return fmt.Errorf("field '%s' is read-only", k)
+	case "namespaceID":
+		return fmt.Errorf("field '%s' is read-only", k)
+	case "name":
+		aux, err := CastToString(val)
+		if err != nil {
+			return err
+		}
+
+		res.Name = aux
+		return nil
+	case "handle":
+		aux, err := CastToHandle(val)
+		if err != nil {
+			return err
+		}
+
+		res.Handle = aux
+		return nil
+	case "labels":
+		aux, err := CastToKV(val)
+		if err != nil {
+			return err
+		}
+
+		res.Labels = aux
+		return nil
+	case "createdAt":
+		return fmt.Errorf("field '%s' is read-only", k)
+	case "updatedAt":
+		return fmt.Errorf("field '%s' is read-only", k)
+	case "deletedAt":
+		return fmt.Errorf("field '%s' is read-only", k)
+	}
+
+	return fmt.Errorf("unknown field '%s'", k)
+}
+
 // ComposeModule is an expression type, wrapper for *types.Module type
 type ComposeModule struct {
 	value *types.Module
@@ -500,6 +694,8 @@ func (t *ComposeNamespace) Has(k string) bool {
 		return true
 	case "slug", "handle":
 		return true
+	case "enabled":
+		return true
 	case "labels":
 		return true
 	case "createdAt":
@@ -524,6 +720,8 @@ func composeNamespaceGValSelector(res *types.Namespace, k string) (interface{},
 		return res.Name, nil
 	case "slug", "handle":
 		return res.Slug, nil
+	case "enabled":
+		return res.Enabled, nil
 	case "labels":
 		return res.Labels, nil
 	case "createdAt":
@@ -549,6 +747,8 @@ func composeNamespaceTypedValueSelector(res *types.Namespace, k string) (TypedVa
 		return NewString(res.Name)
 	case "slug", "handle":
 		return NewHandle(res.Slug)
+	case "enabled":
+		return NewBoolean(res.Enabled)
 	case "labels":
 		return NewKV(res.Labels)
 	case "createdAt":
@@ -583,6 +783,264 @@ func assignToComposeNamespace(res *types.Namespace, k string, val interface{}) e
 
 		res.Slug = aux
 		return nil
+	case "enabled":
+		aux, err := CastToBoolean(val)
+		if err != nil {
+			return err
+		}
+
+		res.Enabled = aux
+		return nil
+	case "labels":
+		aux, err := CastToKV(val)
+		if err != nil {
+			return err
+		}
+
+		res.Labels = aux
+		return nil
+	case "createdAt":
+		return fmt.Errorf("field '%s' is read-only", k)
+	case "updatedAt":
+		return fmt.Errorf("field '%s' is read-only", k)
+	case "deletedAt":
+		return fmt.Errorf("field '%s' is read-only", k)
+	}
+
+	return fmt.Errorf("unknown field '%s'", k)
+}
+
+// ComposePage is an expression type, wrapper for *types.Page type
+type ComposePage struct {
+	value *types.Page
+	mux   sync.RWMutex
+}
+
+// NewComposePage creates new instance of ComposePage expression type
+func NewComposePage(val interface{}) (*ComposePage, error) {
+	if c, err := CastToComposePage(val); err != nil {
+		return nil, fmt.Errorf("unable to create ComposePage: %w", err)
+	} else {
+		return &ComposePage{value: c}, nil
+	}
+}
+
+// Get return underlying value on ComposePage
+func (t *ComposePage) Get() interface{} {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+	return t.value
+}
+
+// GetValue returns underlying value on ComposePage
+func (t *ComposePage) GetValue() *types.Page {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+	return t.value
+}
+
+// Type return type name
+func (ComposePage) Type() string { return "ComposePage" }
+
+// Cast converts value to *types.Page
+func (ComposePage) Cast(val interface{}) (TypedValue, error) {
+	return NewComposePage(val)
+}
+
+// Assign new value to ComposePage
+//
+// value is first passed through CastToComposePage
+func (t *ComposePage) Assign(val interface{}) error {
+	if c, err := CastToComposePage(val); err != nil {
+		return err
+	} else {
+		t.value = c
+		return nil
+	}
+}
+
+func (t *ComposePage) AssignFieldValue(key string, val TypedValue) error {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	return assignToComposePage(t.value, key, val)
+}
+
+// SelectGVal implements gval.Selector requirements
+//
+// It allows gval lib to access ComposePage's underlying value (*types.Page)
+// and it's fields
+func (t *ComposePage) SelectGVal(ctx context.Context, k string) (interface{}, error) {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+	return composePageGValSelector(t.value, k)
+}
+
+// Select is field accessor for *types.Page
+//
+// Similar to SelectGVal but returns typed values
+func (t *ComposePage) Select(k string) (TypedValue, error) {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+	return composePageTypedValueSelector(t.value, k)
+}
+
+func (t *ComposePage) Has(k string) bool {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+	switch k {
+	case "ID", "pageID":
+		return true
+	case "namespaceID":
+		return true
+	case "selfID":
+		return true
+	case "moduleID":
+		return true
+	case "handle":
+		return true
+	case "title":
+		return true
+	case "description":
+		return true
+	case "visible":
+		return true
+	case "labels":
+		return true
+	case "createdAt":
+		return true
+	case "updatedAt":
+		return true
+	case "deletedAt":
+		return true
+	}
+	return false
+}
+
+// composePageGValSelector is field accessor for *types.Page
+func composePageGValSelector(res *types.Page, k string) (interface{}, error) {
+	if res == nil {
+		return nil, nil
+	}
+	switch k {
+	case "ID", "pageID":
+		return res.ID, nil
+	case "namespaceID":
+		return res.NamespaceID, nil
+	case "selfID":
+		return res.SelfID, nil
+	case "moduleID":
+		return res.ModuleID, nil
+	case "handle":
+		return res.Handle, nil
+	case "title":
+		return res.Title, nil
+	case "description":
+		return res.Description, nil
+	case "visible":
+		return res.Visible, nil
+	case "labels":
+		return res.Labels, nil
+	case "createdAt":
+		return res.CreatedAt, nil
+	case "updatedAt":
+		return res.UpdatedAt, nil
+	case "deletedAt":
+		return res.DeletedAt, nil
+	}
+
+	return nil, fmt.Errorf("unknown field '%s'", k)
+}
+
+// composePageTypedValueSelector is field accessor for *types.Page
+func composePageTypedValueSelector(res *types.Page, k string) (TypedValue, error) {
+	if res == nil {
+		return nil, nil
+	}
+	switch k {
+	case "ID", "pageID":
+		return NewID(res.ID)
+	case "namespaceID":
+		return NewID(res.NamespaceID)
+	case "selfID":
+		return NewID(res.SelfID)
+	case "moduleID":
+		return NewID(res.ModuleID)
+	case "handle":
+		return NewHandle(res.Handle)
+	case "title":
+		return NewString(res.Title)
+	case "description":
+		return NewString(res.Description)
+	case "visible":
+		return NewBoolean(res.Visible)
+	case "labels":
+		return NewKV(res.Labels)
+	case "createdAt":
+		return NewDateTime(res.CreatedAt)
+	case "updatedAt":
+		return NewDateTime(res.UpdatedAt)
+	case "deletedAt":
+		return NewDateTime(res.DeletedAt)
+	}
+
+	return nil, fmt.Errorf("unknown field '%s'", k)
+}
+
+// assignToComposePage is field value setter for *types.Page
+func assignToComposePage(res *types.Page, k string, val interface{}) error {
+	switch k {
+	case "ID", "pageID":
+		return fmt.Errorf("field '%s' is read-only", k)
+	case "namespaceID":
+		return fmt.Errorf("field '%s' is read-only", k)
+	case "selfID":
+		aux, err := CastToID(val)
+		if err != nil {
+			return err
+		}
+
+		res.SelfID = aux
+		return nil
+	case "moduleID":
+		aux, err := CastToID(val)
+		if err != nil {
+			return err
+		}
+
+		res.ModuleID = aux
+		return nil
+	case "handle":
+		aux, err := CastToHandle(val)
+		if err != nil {
+			return err
+		}
+
+		res.Handle = aux
+		return nil
+	case "title":
+		aux, err := CastToString(val)
+		if err != nil {
+			return err
+		}
+
+		res.Title = aux
+		return nil
+	case "description":
+		aux, err := CastToString(val)
+		if err != nil {
+			return err
+		}
+
+		res.Description = aux
+		return nil
+	case "visible":
+		aux, err := CastToBoolean(val)
+		if err != nil {
+			return err
+		}
+
+		res.Visible = aux
+		return nil
 	case "labels":
 		aux, err := CastToKV(val)
 		if err != nil {
@@ -61,6 +61,52 @@ func CastToComposeModule(val interface{}) (out *types.Module, err error) {
 	}
 }
 
+func CastToComposePage(val interface{}) (out *types.Page, err error) {
+	switch val := val.(type) {
+	case expr.Iterator:
+		out = &types.Page{}
+		return out, val.Each(func(k string, v expr.TypedValue) error {
+			return assignToComposePage(out, k, v)
+		})
+	}
+
+	switch val := expr.UntypedValue(val).(type) {
+	case *types.Page:
+		return val, nil
+	case map[string]interface{}:
+		out = &types.Page{}
+		m, _ := json.Marshal(val)
+		_ = json.Unmarshal(m, out)
+		return
+
+	default:
+		return nil, fmt.Errorf("unable to cast type %T to %T", val, out)
+	}
+}
+
+func CastToComposeChart(val interface{}) (out *types.Chart, err error) {
+	switch val := val.(type) {
+	case expr.Iterator:
+		out = &types.Chart{}
+		return out, val.Each(func(k string, v expr.TypedValue) error {
+			return assignToComposeChart(out, k, v)
+		})
+	}
+
+	switch val := expr.UntypedValue(val).(type) {
+	case *types.Chart:
+		return val, nil
+	case map[string]interface{}:
+		out = &types.Chart{}
+		m, _ := json.Marshal(val)
+		_ = json.Unmarshal(m, out)
+		return
+
+	default:
+		return nil, fmt.Errorf("unable to cast type %T to %T", val, out)
+	}
+}
+
 func CastToComposeRecord(val interface{}) (out *types.Record, err error) {
 	switch val := val.(type) {
 	case expr.Iterator:
@@ -620,3 +666,45 @@ func (t *ComposeRecordValues) Delete(keys ...string) (out expr.TypedValue, err e
 
 	return rv, nil
 }
+
+// decodeAny decodes value of the Any parameter into dst
+//
+// Value can be a JSON string or any expression value that
+// can be encoded to JSON (Array, Vars, KV...)
+func decodeAny(val interface{}, dst interface{}) (err error) {
+	var raw []byte
+
+	switch val := untypedAny(val).(type) {
+	case nil:
+		return nil
+	case string:
+		raw = []byte(val)
+	case []byte:
+		raw = val
+	default:
+		if raw, err = json.Marshal(val); err != nil {
+			return
+		}
+	}
+
+	return json.Unmarshal(raw, dst)
+}
+
+func untypedAny(val interface{}) interface{} {
+	switch val := val.(type) {
+	case expr.Dict:
+		return val.Dict()
+	case expr.Slice:
+		return val.Slice()
+	case expr.TypedValue:
+		return untypedAny(val.Get())
+	case []expr.TypedValue:
+		out := make([]interface{}, len(val))
+		for i := range val {
+			out[i] = untypedAny(val[i])
+		}
+		return out
+	}
+
+	return val
+}
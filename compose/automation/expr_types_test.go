@@ -669,3 +669,32 @@ func TestRecordValues_Omit(t *testing.T) {
 	req.NoError(err)
 	req.Equal(expected, out.Get())
 }
+
+func TestDecodeAny(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		ff types.ModuleFieldSet
+	)
+
+	req.NoError(decodeAny(nil, &ff))
+	req.Nil(ff)
+
+	req.NoError(decodeAny(expr.Must(expr.NewAny(`[{"name":"f1","kind":"String"}]`)), &ff))
+	req.Len(ff, 1)
+	req.Equal("f1", ff[0].Name)
+
+	arr, err := expr.NewArray([]expr.TypedValue{
+		expr.Must(expr.NewVars(map[string]interface{}{"name": "f1", "kind": "String"})),
+		expr.Must(expr.NewKV(map[string]string{"name": "f2", "kind": "Number"})),
+	})
+	req.NoError(err)
+
+	ff = nil
+	req.NoError(decodeAny(expr.Must(expr.NewAny(arr)), &ff))
+	req.Len(ff, 2)
+	req.Equal("f2", ff[1].Name)
+	req.Equal("Number", ff[1].Kind)
+
+	req.Error(decodeAny(expr.Must(expr.NewString("{")), &ff))
+}
@@ -28,6 +28,9 @@ type (
 func (h modulesHandler) register() {
 	h.reg.AddFunctions(
 		h.Lookup(),
+		h.Create(),
+		h.Update(),
+		h.Delete(),
 	)
 }
 
@@ -156,3 +159,306 @@ func (h modulesHandler) Lookup() *atypes.Function {
 		},
 	}
 }
+
+type (
+	modulesCreateArgs struct {
+		hasNamespace    bool
+		Namespace       interface{}
+		namespaceID     uint64
+		namespaceHandle string
+		namespaceRes    *types.Namespace
+
+		hasModule bool
+		Module    *types.Module
+
+		hasFields bool
+		Fields    interface{}
+	}
+
+	modulesCreateResults struct {
+		Module *types.Module
+	}
+)
+
+func (a modulesCreateArgs) GetNamespace() (bool, uint64, string, *types.Namespace) {
+	return a.hasNamespace, a.namespaceID, a.namespaceHandle, a.namespaceRes
+}
+
+// Create function Compose module create
+//
+// expects implementation of create function:
+//
+//	func (h modulesHandler) create(ctx context.Context, args *modulesCreateArgs) (results *modulesCreateResults, err error) {
+//	   return
+//	}
+func (h modulesHandler) Create() *atypes.Function {
+	return &atypes.Function{
+		Ref:    "composeModulesCreate",
+		Kind:   "function",
+		Labels: map[string]string{"compose": "step,workflow", "create": "step", "module": "step,workflow"},
+		Meta: &atypes.FunctionMeta{
+			Short: "Compose module create",
+		},
+
+		Parameters: []*atypes.Param{
+			{
+				Name:  "namespace",
+				Types: []string{"ID", "Handle", "ComposeNamespace"}, Required: true,
+			},
+			{
+				Name:  "module",
+				Types: []string{"ComposeModule"}, Required: true,
+			},
+			{
+				Name:  "fields",
+				Types: []string{"Any"},
+				Meta: &atypes.ParamMeta{
+					Label:       "Module fields",
+					Description: "List of module fields (name, kind, label, options, ...)\nin the same format as used by the API",
+				},
+			},
+		},
+
+		Results: []*atypes.Param{
+
+			{
+				Name:  "module",
+				Types: []string{"ComposeModule"},
+			},
+		},
+
+		Handler: func(ctx context.Context, in *expr.Vars) (out *expr.Vars, err error) {
+			var (
+				args = &modulesCreateArgs{
+					hasNamespace: in.Has("namespace"),
+					hasModule:    in.Has("module"),
+					hasFields:    in.Has("fields"),
+				}
+			)
+
+			if err = in.Decode(args); err != nil {
+				return
+			}
+
+			// Converting Namespace argument
+			if args.hasNamespace {
+				aux := expr.Must(expr.Select(in, "namespace"))
+				switch aux.Type() {
+				case h.reg.Type("ID").Type():
+					args.namespaceID = aux.Get().(uint64)
+				case h.reg.Type("Handle").Type():
+					args.namespaceHandle = aux.Get().(string)
+				case h.reg.Type("ComposeNamespace").Type():
+					args.namespaceRes = aux.Get().(*types.Namespace)
+				}
+			}
+
+			var results *modulesCreateResults
+			if results, err = h.create(ctx, args); err != nil {
+				return
+			}
+
+			out = &expr.Vars{}
+
+			{
+				// converting results.Module (*types.Module) to ComposeModule
+				var (
+					tval expr.TypedValue
+				)
+
+				if tval, err = h.reg.Type("ComposeModule").Cast(results.Module); err != nil {
+					return
+				} else if err = expr.Assign(out, "module", tval); err != nil {
+					return
+				}
+			}
+
+			return
+		},
+	}
+}
+
+type (
+	modulesUpdateArgs struct {
+		hasModule bool
+		Module    *types.Module
+
+		hasFields bool
+		Fields    interface{}
+	}
+
+	modulesUpdateResults struct {
+		Module *types.Module
+	}
+)
+
+// Update function Compose module update
+//
+// expects implementation of update function:
+//
+//	func (h modulesHandler) update(ctx context.Context, args *modulesUpdateArgs) (results *modulesUpdateResults, err error) {
+//	   return
+//	}
+func (h modulesHandler) Update() *atypes.Function {
+	return &atypes.Function{
+		Ref:    "composeModulesUpdate",
+		Kind:   "function",
+		Labels: map[string]string{"compose": "step,workflow", "module": "step,workflow", "update": "step"},
+		Meta: &atypes.FunctionMeta{
+			Short:       "Compose module update",
+			Description: "Updates module and, when given, replaces all of its fields",
+		},
+
+		Parameters: []*atypes.Param{
+			{
+				Name:  "module",
+				Types: []string{"ComposeModule"}, Required: true,
+			},
+			{
+				Name:  "fields",
+				Types: []string{"Any"},
+				Meta: &atypes.ParamMeta{
+					Label:       "Module fields",
+					Description: "List of module fields (name, kind, label, options, ...)\nin the same format as used by the API",
+				},
+			},
+		},
+
+		Results: []*atypes.Param{
+
+			{
+				Name:  "module",
+				Types: []string{"ComposeModule"},
+			},
+		},
+
+		Handler: func(ctx context.Context, in *expr.Vars) (out *expr.Vars, err error) {
+			var (
+				args = &modulesUpdateArgs{
+					hasModule: in.Has("module"),
+					hasFields: in.Has("fields"),
+				}
+			)
+
+			if err = in.Decode(args); err != nil {
+				return
+			}
+
+			var results *modulesUpdateResults
+			if results, err = h.update(ctx, args); err != nil {
+				return
+			}
+
+			out = &expr.Vars{}
+
+			{
+				// converting results.Module (*types.Module) to ComposeModule
+				var (
+					tval expr.TypedValue
+				)
+
+				if tval, err = h.reg.Type("ComposeModule").Cast(results.Module); err != nil {
+					return
+				} else if err = expr.Assign(out, "module", tval); err != nil {
+					return
+				}
+			}
+
+			return
+		},
+	}
+}
+
+type (
+	modulesDeleteArgs struct {
+		hasModule    bool
+		Module       interface{}
+		moduleID     uint64
+		moduleHandle string
+		moduleRes    *types.Module
+
+		hasNamespace    bool
+		Namespace       interface{}
+		namespaceID     uint64
+		namespaceHandle string
+		namespaceRes    *types.Namespace
+	}
+)
+
+func (a modulesDeleteArgs) GetModule() (bool, uint64, string, *types.Module) {
+	return a.hasModule, a.moduleID, a.moduleHandle, a.moduleRes
+}
+
+func (a modulesDeleteArgs) GetNamespace() (bool, uint64, string, *types.Namespace) {
+	return a.hasNamespace, a.namespaceID, a.namespaceHandle, a.namespaceRes
+}
+
+// Delete function Compose module delete
+//
+// expects implementation of delete function:
+//
+//	func (h modulesHandler) delete(ctx context.Context, args *modulesDeleteArgs) (err error) {
+//	   return
+//	}
+func (h modulesHandler) Delete() *atypes.Function {
+	return &atypes.Function{
+		Ref:    "composeModulesDelete",
+		Kind:   "function",
+		Labels: map[string]string{"compose": "step,workflow", "delete": "step", "module": "step,workflow"},
+		Meta: &atypes.FunctionMeta{
+			Short: "Compose module delete",
+		},
+
+		Parameters: []*atypes.Param{
+			{
+				Name:  "module",
+				Types: []string{"ID", "Handle", "ComposeModule"}, Required: true,
+			},
+			{
+				Name:  "namespace",
+				Types: []string{"ID", "Handle", "ComposeNamespace"}, Required: true,
+			},
+		},
+
+		Handler: func(ctx context.Context, in *expr.Vars) (out *expr.Vars, err error) {
+			var (
+				args = &modulesDeleteArgs{
+					hasModule:    in.Has("module"),
+					hasNamespace: in.Has("namespace"),
+				}
+			)
+
+			if err = in.Decode(args); err != nil {
+				return
+			}
+
+			// Converting Module argument
+			if args.hasModule {
+				aux := expr.Must(expr.Select(in, "module"))
+				switch aux.Type() {
+				case h.reg.Type("ID").Type():
+					args.moduleID = aux.Get().(uint64)
+				case h.reg.Type("Handle").Type():
+					args.moduleHandle = aux.Get().(string)
+				case h.reg.Type("ComposeModule").Type():
+					args.moduleRes = aux.Get().(*types.Module)
+				}
+			}
+
+			// Converting Namespace argument
+			if args.hasNamespace {
+				aux := expr.Must(expr.Select(in, "namespace"))
+				switch aux.Type() {
+				case h.reg.Type("ID").Type():
+					args.namespaceID = aux.Get().(uint64)
+				case h.reg.Type("Handle").Type():
+					args.namespaceHandle = aux.Get().(string)
+				case h.reg.Type("ComposeNamespace").Type():
+					args.namespaceRes = aux.Get().(*types.Namespace)
+				}
+			}
+
+			return out, h.delete(ctx, args)
+		},
+	}
+}
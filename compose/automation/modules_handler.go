@@ -46,6 +46,59 @@ func (h modulesHandler) lookup(ctx context.Context, args *modulesLookupArgs) (re
 	return
 }
 
+func (h modulesHandler) create(ctx context.Context, args *modulesCreateArgs) (results *modulesCreateResults, err error) {
+	var (
+		mod = *args.Module
+	)
+
+	if mod.NamespaceID, err = getNamespaceID(ctx, h.ns, args); err != nil {
+		return
+	}
+
+	if args.hasFields {
+		if err = decodeAny(args.Fields, &mod.Fields); err != nil {
+			return nil, fmt.Errorf("could not decode module fields: %w", err)
+		}
+	}
+
+	results = &modulesCreateResults{}
+	results.Module, err = h.mod.Create(ctx, &mod)
+	return
+}
+
+func (h modulesHandler) update(ctx context.Context, args *modulesUpdateArgs) (results *modulesUpdateResults, err error) {
+	var (
+		mod = *args.Module
+		cur *types.Module
+	)
+
+	if args.hasFields {
+		if err = decodeAny(args.Fields, &mod.Fields); err != nil {
+			return nil, fmt.Errorf("could not decode module fields: %w", err)
+		}
+	} else if mod.Fields == nil {
+		// module without fields (constructed from KV, for example)
+		// would remove all existing fields
+		if cur, err = h.mod.FindByID(ctx, mod.NamespaceID, mod.ID); err != nil {
+			return
+		}
+
+		mod.Fields = cur.Fields
+	}
+
+	results = &modulesUpdateResults{}
+	results.Module, err = h.mod.Update(ctx, &mod)
+	return
+}
+
+func (h modulesHandler) delete(ctx context.Context, args *modulesDeleteArgs) error {
+	if namespaceID, moduleID, err := getModuleID(ctx, h.ns, h.mod, args); err != nil {
+		return err
+	} else {
+		return h.mod.DeleteByID(ctx, namespaceID, moduleID)
+	}
+}
+
 func getModuleID(ctx context.Context, nsSvc namespaceService, modSvc moduleService, args moduleLookup) (namespaceID uint64, moduleID uint64, err error) {
 	namespaceID, err = getNamespaceID(ctx, nsSvc, args.(namespaceLookup))
 	if err != nil {
@@ -28,6 +28,10 @@ type (
 func (h namespacesHandler) register() {
 	h.reg.AddFunctions(
 		h.Lookup(),
+		h.Create(),
+		h.Update(),
+		h.Delete(),
+		h.Clone(),
 	)
 }
 
@@ -128,3 +132,358 @@ func (h namespacesHandler) Lookup() *atypes.Function {
 		},
 	}
 }
+
+type (
+	namespacesCreateArgs struct {
+		hasNamespace bool
+		Namespace    *types.Namespace
+	}
+
+	namespacesCreateResults struct {
+		Namespace *types.Namespace
+	}
+)
+
+// Create function Compose namespace create
+//
+// expects implementation of create function:
+//
+//	func (h namespacesHandler) create(ctx context.Context, args *namespacesCreateArgs) (results *namespacesCreateResults, err error) {
+//	   return
+//	}
+func (h namespacesHandler) Create() *atypes.Function {
+	return &atypes.Function{
+		Ref:    "composeNamespacesCreate",
+		Kind:   "function",
+		Labels: map[string]string{"compose": "step,workflow", "create": "step", "namespace": "step,workflow"},
+		Meta: &atypes.FunctionMeta{
+			Short: "Compose namespace create",
+		},
+
+		Parameters: []*atypes.Param{
+			{
+				Name:  "namespace",
+				Types: []string{"ComposeNamespace"}, Required: true,
+			},
+		},
+
+		Results: []*atypes.Param{
+
+			{
+				Name:  "namespace",
+				Types: []string{"ComposeNamespace"},
+			},
+		},
+
+		Handler: func(ctx context.Context, in *expr.Vars) (out *expr.Vars, err error) {
+			var (
+				args = &namespacesCreateArgs{
+					hasNamespace: in.Has("namespace"),
+				}
+			)
+
+			if err = in.Decode(args); err != nil {
+				return
+			}
+
+			var results *namespacesCreateResults
+			if results, err = h.create(ctx, args); err != nil {
+				return
+			}
+
+			out = &expr.Vars{}
+
+			{
+				// converting results.Namespace (*types.Namespace) to ComposeNamespace
+				var (
+					tval expr.TypedValue
+				)
+
+				if tval, err = h.reg.Type("ComposeNamespace").Cast(results.Namespace); err != nil {
+					return
+				} else if err = expr.Assign(out, "namespace", tval); err != nil {
+					return
+				}
+			}
+
+			return
+		},
+	}
+}
+
+type (
+	namespacesUpdateArgs struct {
+		hasNamespace bool
+		Namespace    *types.Namespace
+	}
+
+	namespacesUpdateResults struct {
+		Namespace *types.Namespace
+	}
+)
+
+// Update function Compose namespace update
+//
+// expects implementation of update function:
+//
+//	func (h namespacesHandler) update(ctx context.Context, args *namespacesUpdateArgs) (results *namespacesUpdateResults, err error) {
+//	   return
+//	}
+func (h namespacesHandler) Update() *atypes.Function {
+	return &atypes.Function{
+		Ref:    "composeNamespacesUpdate",
+		Kind:   "function",
+		Labels: map[string]string{"compose": "step,workflow", "namespace": "step,workflow", "update": "step"},
+		Meta: &atypes.FunctionMeta{
+			Short: "Compose namespace update",
+		},
+
+		Parameters: []*atypes.Param{
+			{
+				Name:  "namespace",
+				Types: []string{"ComposeNamespace"}, Required: true,
+			},
+		},
+
+		Results: []*atypes.Param{
+
+			{
+				Name:  "namespace",
+				Types: []string{"ComposeNamespace"},
+			},
+		},
+
+		Handler: func(ctx context.Context, in *expr.Vars) (out *expr.Vars, err error) {
+			var (
+				args = &namespacesUpdateArgs{
+					hasNamespace: in.Has("namespace"),
+				}
+			)
+
+			if err = in.Decode(args); err != nil {
+				return
+			}
+
+			var results *namespacesUpdateResults
+			if results, err = h.update(ctx, args); err != nil {
+				return
+			}
+
+			out = &expr.Vars{}
+
+			{
+				// converting results.Namespace (*types.Namespace) to ComposeNamespace
+				var (
+					tval expr.TypedValue
+				)
+
+				if tval, err = h.reg.Type("ComposeNamespace").Cast(results.Namespace); err != nil {
+					return
+				} else if err = expr.Assign(out, "namespace", tval); err != nil {
+					return
+				}
+			}
+
+			return
+		},
+	}
+}
+
+type (
+	namespacesDeleteArgs struct {
+		hasNamespace    bool
+		Namespace       interface{}
+		namespaceID     uint64
+		namespaceHandle string
+		namespaceRes    *types.Namespace
+	}
+)
+
+func (a namespacesDeleteArgs) GetNamespace() (bool, uint64, string, *types.Namespace) {
+	return a.hasNamespace, a.namespaceID, a.namespaceHandle, a.namespaceRes
+}
+
+// Delete function Compose namespace delete
+//
+// expects implementation of delete function:
+//
+//	func (h namespacesHandler) delete(ctx context.Context, args *namespacesDeleteArgs) (err error) {
+//	   return
+//	}
+func (h namespacesHandler) Delete() *atypes.Function {
+	return &atypes.Function{
+		Ref:    "composeNamespacesDelete",
+		Kind:   "function",
+		Labels: map[string]string{"compose": "step,workflow", "delete": "step", "namespace": "step,workflow"},
+		Meta: &atypes.FunctionMeta{
+			Short: "Compose namespace delete",
+		},
+
+		Parameters: []*atypes.Param{
+			{
+				Name:  "namespace",
+				Types: []string{"ID", "Handle", "ComposeNamespace"}, Required: true,
+			},
+		},
+
+		Handler: func(ctx context.Context, in *expr.Vars) (out *expr.Vars, err error) {
+			var (
+				args = &namespacesDeleteArgs{
+					hasNamespace: in.Has("namespace"),
+				}
+			)
+
+			if err = in.Decode(args); err != nil {
+				return
+			}
+
+			// Converting Namespace argument
+			if args.hasNamespace {
+				aux := expr.Must(expr.Select(in, "namespace"))
+				switch aux.Type() {
+				case h.reg.Type("ID").Type():
+					args.namespaceID = aux.Get().(uint64)
+				case h.reg.Type("Handle").Type():
+					args.namespaceHandle = aux.Get().(string)
+				case h.reg.Type("ComposeNamespace").Type():
+					args.namespaceRes = aux.Get().(*types.Namespace)
+				}
+			}
+
+			return out, h.delete(ctx, args)
+		},
+	}
+}
+
+type (
+	namespacesCloneArgs struct {
+		hasNamespace    bool
+		Namespace       interface{}
+		namespaceID     uint64
+		namespaceHandle string
+		namespaceRes    *types.Namespace
+
+		hasName bool
+		Name    string
+
+		hasSlug bool
+		Slug    string
+
+		hasHandles bool
+		Handles    map[string]string
+	}
+
+	namespacesCloneResults struct {
+		Namespace *types.Namespace
+	}
+)
+
+func (a namespacesCloneArgs) GetNamespace() (bool, uint64, string, *types.Namespace) {
+	return a.hasNamespace, a.namespaceID, a.namespaceHandle, a.namespaceRes
+}
+
+// Clone function Compose namespace clone
+//
+// expects implementation of clone function:
+//
+//	func (h namespacesHandler) clone(ctx context.Context, args *namespacesCloneArgs) (results *namespacesCloneResults, err error) {
+//	   return
+//	}
+func (h namespacesHandler) Clone() *atypes.Function {
+	return &atypes.Function{
+		Ref:    "composeNamespacesClone",
+		Kind:   "function",
+		Labels: map[string]string{"clone": "step", "compose": "step,workflow", "namespace": "step,workflow"},
+		Meta: &atypes.FunctionMeta{
+			Short:       "Compose namespace clone",
+			Description: "Clones namespace with all of its modules, pages and charts",
+		},
+
+		Parameters: []*atypes.Param{
+			{
+				Name:  "namespace",
+				Types: []string{"ID", "Handle", "ComposeNamespace"}, Required: true,
+			},
+			{
+				Name:  "name",
+				Types: []string{"String"}, Required: true,
+				Meta: &atypes.ParamMeta{
+					Label: "Name of the cloned namespace",
+				},
+			},
+			{
+				Name:  "slug",
+				Types: []string{"Handle"}, Required: true,
+				Meta: &atypes.ParamMeta{
+					Label: "Handle of the cloned namespace",
+				},
+			},
+			{
+				Name:  "handles",
+				Types: []string{"KV"},
+				Meta: &atypes.ParamMeta{
+					Label:       "Renamed handles",
+					Description: "Map of module, page and chart handles (old handle => new handle)\nthat are renamed in the cloned namespace",
+				},
+			},
+		},
+
+		Results: []*atypes.Param{
+
+			{
+				Name:  "namespace",
+				Types: []string{"ComposeNamespace"},
+			},
+		},
+
+		Handler: func(ctx context.Context, in *expr.Vars) (out *expr.Vars, err error) {
+			var (
+				args = &namespacesCloneArgs{
+					hasNamespace: in.Has("namespace"),
+					hasName:      in.Has("name"),
+					hasSlug:      in.Has("slug"),
+					hasHandles:   in.Has("handles"),
+				}
+			)
+
+			if err = in.Decode(args); err != nil {
+				return
+			}
+
+			// Converting Namespace argument
+			if args.hasNamespace {
+				aux := expr.Must(expr.Select(in, "namespace"))
+				switch aux.Type() {
+				case h.reg.Type("ID").Type():
+					args.namespaceID = aux.Get().(uint64)
+				case h.reg.Type("Handle").Type():
+					args.namespaceHandle = aux.Get().(string)
+				case h.reg.Type("ComposeNamespace").Type():
+					args.namespaceRes = aux.Get().(*types.Namespace)
+				}
+			}
+
+			var results *namespacesCloneResults
+			if results, err = h.clone(ctx, args); err != nil {
+				return
+			}
+
+			out = &expr.Vars{}
+
+			{
+				// converting results.Namespace (*types.Namespace) to ComposeNamespace
+				var (
+					tval expr.TypedValue
+				)
+
+				if tval, err = h.reg.Type("ComposeNamespace").Cast(results.Namespace); err != nil {
+					return
+				} else if err = expr.Assign(out, "namespace", tval); err != nil {
+					return
+				}
+			}
+
+			return
+		},
+	}
+}
@@ -18,9 +18,14 @@ type (
 		DeleteByID(ctx context.Context, namespaceID uint64) error
 	}
 
+	// namespaceCloner clones namespace with all of its resources
+	// and renames handles (old => new) of the cloned resources
+	namespaceCloner func(ctx context.Context, namespaceID uint64, dup *types.Namespace, handles map[string]string) (*types.Namespace, error)
+
 	namespacesHandler struct {
-		reg namespacesHandlerRegistry
-		ns  namespaceService
+		reg    namespacesHandlerRegistry
+		ns     namespaceService
+		cloner namespaceCloner
 	}
 
 	namespaceLookup interface {
@@ -28,10 +33,11 @@ type (
 	}
 )
 
-func NamespacesHandler(reg namespacesHandlerRegistry, ns namespaceService) *namespacesHandler {
+func NamespacesHandler(reg namespacesHandlerRegistry, ns namespaceService, cloner namespaceCloner) *namespacesHandler {
 	h := &namespacesHandler{
-		reg: reg,
-		ns:  ns,
+		reg:    reg,
+		ns:     ns,
+		cloner: cloner,
 	}
 
 	h.register()
@@ -45,6 +51,46 @@ func (h namespacesHandler) lookup(ctx context.Context, args *namespacesLookupArg
 	return
 }
 
+func (h namespacesHandler) create(ctx context.Context, args *namespacesCreateArgs) (results *namespacesCreateResults, err error) {
+	results = &namespacesCreateResults{}
+	results.Namespace, err = h.ns.Create(ctx, args.Namespace)
+	return
+}
+
+func (h namespacesHandler) update(ctx context.Context, args *namespacesUpdateArgs) (results *namespacesUpdateResults, err error) {
+	results = &namespacesUpdateResults{}
+	results.Namespace, err = h.ns.Update(ctx, args.Namespace)
+	return
+}
+
+func (h namespacesHandler) delete(ctx context.Context, args *namespacesDeleteArgs) error {
+	if namespaceID, err := getNamespaceID(ctx, h.ns, args); err != nil {
+		return err
+	} else {
+		return h.ns.DeleteByID(ctx, namespaceID)
+	}
+}
+
+func (h namespacesHandler) clone(ctx context.Context, args *namespacesCloneArgs) (results *namespacesCloneResults, err error) {
+	if h.cloner == nil {
+		return nil, fmt.Errorf("namespace cloning not supported")
+	}
+
+	namespaceID, err := getNamespaceID(ctx, h.ns, args)
+	if err != nil {
+		return
+	}
+
+	dup := &types.Namespace{
+		Name: args.Name,
+		Slug: args.Slug,
+	}
+
+	results = &namespacesCloneResults{}
+	results.Namespace, err = h.cloner(ctx, namespaceID, dup, args.Handles)
+	return
+}
+
 func getNamespaceID(ctx context.Context, svc namespaceService, args namespaceLookup) (uint64, error) {
 	_, ID, _, _ := args.GetNamespace()
 	if ID > 0 {
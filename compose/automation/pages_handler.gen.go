@@ -0,0 +1,477 @@
+package automation
+
+// This file is auto-generated.
+//
+// Changes to this file may cause incorrect behavior and will be lost if
+// the code is regenerated.
+//
+// Definitions file that controls how this file is generated:
+// compose/automation/pages_handler.yaml
+
+import (
+	"context"
+	atypes "github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/wfexec"
+)
+
+var _ wfexec.ExecResponse
+
+type (
+	pagesHandlerRegistry interface {
+		AddFunctions(ff ...*atypes.Function)
+		Type(ref string) expr.Type
+	}
+)
+
+func (h pagesHandler) register() {
+	h.reg.AddFunctions(
+		h.Lookup(),
+		h.Create(),
+		h.Update(),
+		h.Delete(),
+	)
+}
+
+type (
+	pagesLookupArgs struct {
+		hasPage    bool
+		Page       interface{}
+		pageID     uint64
+		pageHandle string
+		pageRes    *types.Page
+
+		hasNamespace    bool
+		Namespace       interface{}
+		namespaceID     uint64
+		namespaceHandle string
+		namespaceRes    *types.Namespace
+	}
+
+	pagesLookupResults struct {
+		Page *types.Page
+	}
+)
+
+func (a pagesLookupArgs) GetPage() (bool, uint64, string, *types.Page) {
+	return a.hasPage, a.pageID, a.pageHandle, a.pageRes
+}
+
+func (a pagesLookupArgs) GetNamespace() (bool, uint64, string, *types.Namespace) {
+	return a.hasNamespace, a.namespaceID, a.namespaceHandle, a.namespaceRes
+}
+
+// Lookup function Compose page lookup
+//
+// expects implementation of lookup function:
+//
+//	func (h pagesHandler) lookup(ctx context.Context, args *pagesLookupArgs) (results *pagesLookupResults, err error) {
+//	   return
+//	}
+func (h pagesHandler) Lookup() *atypes.Function {
+	return &atypes.Function{
+		Ref:    "composePagesLookup",
+		Kind:   "function",
+		Labels: map[string]string{"compose": "step,workflow", "lookup": "step", "page": "step,workflow"},
+		Meta: &atypes.FunctionMeta{
+			Short:       "Compose page lookup",
+			Description: "Find specific page by ID or handle",
+		},
+
+		Parameters: []*atypes.Param{
+			{
+				Name:  "page",
+				Types: []string{"ID", "Handle", "ComposePage"}, Required: true,
+			},
+			{
+				Name:  "namespace",
+				Types: []string{"ID", "Handle", "ComposeNamespace"}, Required: true,
+			},
+		},
+
+		Results: []*atypes.Param{
+
+			{
+				Name:  "page",
+				Types: []string{"ComposePage"},
+			},
+		},
+
+		Handler: func(ctx context.Context, in *expr.Vars) (out *expr.Vars, err error) {
+			var (
+				args = &pagesLookupArgs{
+					hasPage:      in.Has("page"),
+					hasNamespace: in.Has("namespace"),
+				}
+			)
+
+			if err = in.Decode(args); err != nil {
+				return
+			}
+
+			// Converting Page argument
+			if args.hasPage {
+				aux := expr.Must(expr.Select(in, "page"))
+				switch aux.Type() {
+				case h.reg.Type("ID").Type():
+					args.pageID = aux.Get().(uint64)
+				case h.reg.Type("Handle").Type():
+					args.pageHandle = aux.Get().(string)
+				case h.reg.Type("ComposePage").Type():
+					args.pageRes = aux.Get().(*types.Page)
+				}
+			}
+
+			// Converting Namespace argument
+			if args.hasNamespace {
+				aux := expr.Must(expr.Select(in, "namespace"))
+				switch aux.Type() {
+				case h.reg.Type("ID").Type():
+					args.namespaceID = aux.Get().(uint64)
+				case h.reg.Type("Handle").Type():
+					args.namespaceHandle = aux.Get().(string)
+				case h.reg.Type("ComposeNamespace").Type():
+					args.namespaceRes = aux.Get().(*types.Namespace)
+				}
+			}
+
+			var results *pagesLookupResults
+			if results, err = h.lookup(ctx, args); err != nil {
+				return
+			}
+
+			out = &expr.Vars{}
+
+			{
+				// converting results.Page (*types.Page) to ComposePage
+				var (
+					tval expr.TypedValue
+				)
+
+				if tval, err = h.reg.Type("ComposePage").Cast(results.Page); err != nil {
+					return
+				} else if err = expr.Assign(out, "page", tval); err != nil {
+					return
+				}
+			}
+
+			return
+		},
+	}
+}
+
+type (
+	pagesCreateArgs struct {
+		hasNamespace    bool
+		Namespace       interface{}
+		namespaceID     uint64
+		namespaceHandle string
+		namespaceRes    *types.Namespace
+
+		hasPage bool
+		Page    *types.Page
+
+		hasBlocks bool
+		Blocks    interface{}
+	}
+
+	pagesCreateResults struct {
+		Page *types.Page
+	}
+)
+
+func (a pagesCreateArgs) GetNamespace() (bool, uint64, string, *types.Namespace) {
+	return a.hasNamespace, a.namespaceID, a.namespaceHandle, a.namespaceRes
+}
+
+// Create function Compose page create
+//
+// expects implementation of create function:
+//
+//	func (h pagesHandler) create(ctx context.Context, args *pagesCreateArgs) (results *pagesCreateResults, err error) {
+//	   return
+//	}
+func (h pagesHandler) Create() *atypes.Function {
+	return &atypes.Function{
+		Ref:    "composePagesCreate",
+		Kind:   "function",
+		Labels: map[string]string{"compose": "step,workflow", "create": "step", "page": "step,workflow"},
+		Meta: &atypes.FunctionMeta{
+			Short: "Compose page create",
+		},
+
+		Parameters: []*atypes.Param{
+			{
+				Name:  "namespace",
+				Types: []string{"ID", "Handle", "ComposeNamespace"}, Required: true,
+			},
+			{
+				Name:  "page",
+				Types: []string{"ComposePage"}, Required: true,
+			},
+			{
+				Name:  "blocks",
+				Types: []string{"Any"},
+				Meta: &atypes.ParamMeta{
+					Label:       "Page blocks",
+					Description: "List of page blocks (kind, title, options, ...)\nin the same format as used by the API",
+				},
+			},
+		},
+
+		Results: []*atypes.Param{
+
+			{
+				Name:  "page",
+				Types: []string{"ComposePage"},
+			},
+		},
+
+		Handler: func(ctx context.Context, in *expr.Vars) (out *expr.Vars, err error) {
+			var (
+				args = &pagesCreateArgs{
+					hasNamespace: in.Has("namespace"),
+					hasPage:      in.Has("page"),
+					hasBlocks:    in.Has("blocks"),
+				}
+			)
+
+			if err = in.Decode(args); err != nil {
+				return
+			}
+
+			// Converting Namespace argument
+			if args.hasNamespace {
+				aux := expr.Must(expr.Select(in, "namespace"))
+				switch aux.Type() {
+				case h.reg.Type("ID").Type():
+					args.namespaceID = aux.Get().(uint64)
+				case h.reg.Type("Handle").Type():
+					args.namespaceHandle = aux.Get().(string)
+				case h.reg.Type("ComposeNamespace").Type():
+					args.namespaceRes = aux.Get().(*types.Namespace)
+				}
+			}
+
+			var results *pagesCreateResults
+			if results, err = h.create(ctx, args); err != nil {
+				return
+			}
+
+			out = &expr.Vars{}
+
+			{
+				// converting results.Page (*types.Page) to ComposePage
+				var (
+					tval expr.TypedValue
+				)
+
+				if tval, err = h.reg.Type("ComposePage").Cast(results.Page); err != nil {
+					return
+				} else if err = expr.Assign(out, "page", tval); err != nil {
+					return
+				}
+			}
+
+			return
+		},
+	}
+}
+
+type (
+	pagesUpdateArgs struct {
+		hasPage bool
+		Page    *types.Page
+
+		hasBlocks bool
+		Blocks    interface{}
+	}
+
+	pagesUpdateResults struct {
+		Page *types.Page
+	}
+)
+
+// Update function Compose page update
+//
+// expects implementation of update function:
+//
+//	func (h pagesHandler) update(ctx context.Context, args *pagesUpdateArgs) (results *pagesUpdateResults, err error) {
+//	   return
+//	}
+func (h pagesHandler) Update() *atypes.Function {
+	return &atypes.Function{
+		Ref:    "composePagesUpdate",
+		Kind:   "function",
+		Labels: map[string]string{"compose": "step,workflow", "page": "step,workflow", "update": "step"},
+		Meta: &atypes.FunctionMeta{
+			Short:       "Compose page update",
+			Description: "Updates page and, when given, replaces all of its blocks",
+		},
+
+		Parameters: []*atypes.Param{
+			{
+				Name:  "page",
+				Types: []string{"ComposePage"}, Required: true,
+			},
+			{
+				Name:  "blocks",
+				Types: []string{"Any"},
+				Meta: &atypes.ParamMeta{
+					Label:       "Page blocks",
+					Description: "List of page blocks (kind, title, options, ...)\nin the same format as used by the API",
+				},
+			},
+		},
+
+		Results: []*atypes.Param{
+
+			{
+				Name:  "page",
+				Types: []string{"ComposePage"},
+			},
+		},
+
+		Handler: func(ctx context.Context, in *expr.Vars) (out *expr.Vars, err error) {
+			var (
+				args = &pagesUpdateArgs{
+					hasPage:   in.Has("page"),
+					hasBlocks: in.Has("blocks"),
+				}
+			)
+
+			if err = in.Decode(args); err != nil {
+				return
+			}
+
+			var results *pagesUpdateResults
+			if results, err = h.update(ctx, args); err != nil {
+				return
+			}
+
+			out = &expr.Vars{}
+
+			{
+				// converting results.Page (*types.Page) to ComposePage
+				var (
+					tval expr.TypedValue
+				)
+
+				if tval, err = h.reg.Type("ComposePage").Cast(results.Page); err != nil {
+					return
+				} else if err = expr.Assign(out, "page", tval); err != nil {
+					return
+				}
+			}
+
+			return
+		},
+	}
+}
+
+type (
+	pagesDeleteArgs struct {
+		hasPage    bool
+		Page       interface{}
+		pageID     uint64
+		pageHandle string
+		pageRes    *types.Page
+
+		hasNamespace    bool
+		Namespace       interface{}
+		namespaceID     uint64
+		namespaceHandle string
+		namespaceRes    *types.Namespace
+
+		hasStrategy bool
+		Strategy    string
+	}
+)
+
+func (a pagesDeleteArgs) GetPage() (bool, uint64, string, *types.Page) {
+	return a.hasPage, a.pageID, a.pageHandle, a.pageRes
+}
+
+func (a pagesDeleteArgs) GetNamespace() (bool, uint64, string, *types.Namespace) {
+	return a.hasNamespace, a.namespaceID, a.namespaceHandle, a.namespaceRes
+}
+
+// Delete function Compose page delete
+//
+// expects implementation of delete function:
+//
+//	func (h pagesHandler) delete(ctx context.Context, args *pagesDeleteArgs) (err error) {
+//	   return
+//	}
+func (h pagesHandler) Delete() *atypes.Function {
+	return &atypes.Function{
+		Ref:    "composePagesDelete",
+		Kind:   "function",
+		Labels: map[string]string{"compose": "step,workflow", "delete": "step", "page": "step,workflow"},
+		Meta: &atypes.FunctionMeta{
+			Short: "Compose page delete",
+		},
+
+		Parameters: []*atypes.Param{
+			{
+				Name:  "page",
+				Types: []string{"ID", "Handle", "ComposePage"}, Required: true,
+			},
+			{
+				Name:  "namespace",
+				Types: []string{"ID", "Handle", "ComposeNamespace"}, Required: true,
+			},
+			{
+				Name:  "strategy",
+				Types: []string{"String"},
+				Meta: &atypes.ParamMeta{
+					Label:       "Subpages delete strategy",
+					Description: "What to do with subpages: abort (default), force, rebase or cascade",
+				},
+			},
+		},
+
+		Handler: func(ctx context.Context, in *expr.Vars) (out *expr.Vars, err error) {
+			var (
+				args = &pagesDeleteArgs{
+					hasPage:      in.Has("page"),
+					hasNamespace: in.Has("namespace"),
+					hasStrategy:  in.Has("strategy"),
+				}
+			)
+
+			if err = in.Decode(args); err != nil {
+				return
+			}
+
+			// Converting Page argument
+			if args.hasPage {
+				aux := expr.Must(expr.Select(in, "page"))
+				switch aux.Type() {
+				case h.reg.Type("ID").Type():
+					args.pageID = aux.Get().(uint64)
+				case h.reg.Type("Handle").Type():
+					args.pageHandle = aux.Get().(string)
+				case h.reg.Type("ComposePage").Type():
+					args.pageRes = aux.Get().(*types.Page)
+				}
+			}
+
+			// Converting Namespace argument
+			if args.hasNamespace {
+				aux := expr.Must(expr.Select(in, "namespace"))
+				switch aux.Type() {
+				case h.reg.Type("ID").Type():
+					args.namespaceID = aux.Get().(uint64)
+				case h.reg.Type("Handle").Type():
+					args.namespaceHandle = aux.Get().(string)
+				case h.reg.Type("ComposeNamespace").Type():
+					args.namespaceRes = aux.Get().(*types.Namespace)
+				}
+			}
+
+			return out, h.delete(ctx, args)
+		},
+	}
+}
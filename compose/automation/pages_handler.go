@@ -0,0 +1,132 @@
+package automation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+)
+
+type (
+	pageService interface {
+		FindByID(ctx context.Context, namespaceID, pageID uint64) (*types.Page, error)
+		FindByHandle(ctx context.Context, namespaceID uint64, handle string) (*types.Page, error)
+
+		Create(ctx context.Context, page *types.Page) (*types.Page, error)
+		Update(ctx context.Context, page *types.Page) (*types.Page, error)
+
+		DeleteByID(ctx context.Context, namespaceID, pageID uint64, strategy types.PageChildrenDeleteStrategy) error
+	}
+
+	pagesHandler struct {
+		reg  pagesHandlerRegistry
+		ns   namespaceService
+		page pageService
+	}
+
+	pageLookup interface {
+		GetPage() (bool, uint64, string, *types.Page)
+	}
+)
+
+func PagesHandler(reg pagesHandlerRegistry, ns namespaceService, page pageService) *pagesHandler {
+	h := &pagesHandler{
+		reg:  reg,
+		ns:   ns,
+		page: page,
+	}
+
+	h.register()
+	return h
+}
+
+func (h pagesHandler) lookup(ctx context.Context, args *pagesLookupArgs) (results *pagesLookupResults, err error) {
+	results = &pagesLookupResults{}
+	results.Page, err = lookupPage(ctx, h.ns, h.page, args)
+	return
+}
+
+func (h pagesHandler) create(ctx context.Context, args *pagesCreateArgs) (results *pagesCreateResults, err error) {
+	var (
+		page = *args.Page
+	)
+
+	if page.NamespaceID, err = getNamespaceID(ctx, h.ns, args); err != nil {
+		return
+	}
+
+	if args.hasBlocks {
+		if err = decodeAny(args.Blocks, &page.Blocks); err != nil {
+			return nil, fmt.Errorf("could not decode page blocks: %w", err)
+		}
+	}
+
+	results = &pagesCreateResults{}
+	results.Page, err = h.page.Create(ctx, &page)
+	return
+}
+
+func (h pagesHandler) update(ctx context.Context, args *pagesUpdateArgs) (results *pagesUpdateResults, err error) {
+	var (
+		page = *args.Page
+		cur  *types.Page
+	)
+
+	if args.hasBlocks {
+		if err = decodeAny(args.Blocks, &page.Blocks); err != nil {
+			return nil, fmt.Errorf("could not decode page blocks: %w", err)
+		}
+	} else if page.Blocks == nil {
+		// page without blocks (constructed from KV, for example)
+		// would remove all existing blocks
+		if cur, err = h.page.FindByID(ctx, page.NamespaceID, page.ID); err != nil {
+			return
+		}
+
+		page.Blocks = cur.Blocks
+	}
+
+	results = &pagesUpdateResults{}
+	results.Page, err = h.page.Update(ctx, &page)
+	return
+}
+
+func (h pagesHandler) delete(ctx context.Context, args *pagesDeleteArgs) error {
+	page, err := lookupPage(ctx, h.ns, h.page, args)
+	if err != nil {
+		return err
+	}
+
+	var strategy types.PageChildrenDeleteStrategy
+	switch aux := types.PageChildrenDeleteStrategy(args.Strategy); aux {
+	case types.PageChildrenOnDeleteForce,
+		types.PageChildrenOnDeleteRebase,
+		types.PageChildrenOnDeleteCascade:
+		strategy = aux
+	default:
+		strategy = types.PageChildrenOnDeleteAbort
+	}
+
+	return h.page.DeleteByID(ctx, page.NamespaceID, page.ID, strategy)
+}
+
+func lookupPage(ctx context.Context, nsSvc namespaceService, pageSvc pageService, args pageLookup) (*types.Page, error) {
+	_, ID, handle, page := args.GetPage()
+	if page != nil {
+		return page, nil
+	}
+
+	namespaceID, err := getNamespaceID(ctx, nsSvc, args.(namespaceLookup))
+	if err != nil {
+		return nil, fmt.Errorf("could not load namespace: %w", err)
+	}
+
+	switch {
+	case ID > 0:
+		return pageSvc.FindByID(ctx, namespaceID, ID)
+	case len(handle) > 0:
+		return pageSvc.FindByHandle(ctx, namespaceID, handle)
+	}
+
+	return nil, fmt.Errorf("empty page lookup params")
+}
@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/cortezaproject/corteza-server/compose/service"
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/cli"
+	"github.com/cortezaproject/corteza-server/store"
+	"github.com/spf13/cobra"
+)
+
+type (
+	serviceInitializer interface {
+		InitServices(ctx context.Context) error
+	}
+)
+
+func Records(ctx context.Context, app serviceInitializer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "records",
+		Short: "Compose records management",
+	}
+
+	reencryptCmd := &cobra.Command{
+		Use:   "reencrypt",
+		Short: "Re-encrypt values of encrypted module fields",
+		Long: "Stores values of encrypted module fields encrypted with the current primary key.\n" +
+			"Use it after encryption key is rotated or after existing fields are marked as encrypted.",
+
+		PreRunE: commandPreRunInitService(app),
+		Run: func(cmd *cobra.Command, args []string) {
+			var (
+				nn  types.NamespaceSet
+				mm  types.ModuleSet
+				ff  types.ModuleFieldSet
+				n   uint
+				err error
+			)
+
+			nn, _, err = store.SearchComposeNamespaces(ctx, service.DefaultStore, types.NamespaceFilter{})
+			cli.HandleError(err)
+
+			for _, ns := range nn {
+				mm, _, err = store.SearchComposeModules(ctx, service.DefaultStore, types.ModuleFilter{NamespaceID: ns.ID})
+				cli.HandleError(err)
+
+				for _, m := range mm {
+					ff, _, err = store.SearchComposeModuleFields(ctx, service.DefaultStore, types.ModuleFieldFilter{ModuleID: []uint64{m.ID}})
+					cli.HandleError(err)
+
+					m.Fields = ff
+
+					n, err = service.DefaultRecord.Reencrypt(ctx, m)
+					cli.HandleError(err)
+
+					if n > 0 {
+						cmd.Printf("%s/%s: %d records re-encrypted\n", ns.Slug, m.Handle, n)
+					}
+				}
+			}
+		},
+	}
+
+	cmd.AddCommand(
+		reencryptCmd,
+	)
+
+	return cmd
+}
+
+func commandPreRunInitService(app serviceInitializer) func(*cobra.Command, []string) error {
+	return func(_ *cobra.Command, _ []string) error {
+		return app.InitServices(cli.Context())
+	}
+}
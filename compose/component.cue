@@ -15,6 +15,9 @@ component: schema.#component & {
 		"namespace":           namespace
 		"page":                page
 		"record":              record
+		"record-import-session": recordImportSession
+		"record-import-error":   recordImportError
+		"record-revision":       recordRevision
 		"record-value":        recordValue
 	}
 
@@ -26,6 +26,7 @@ moduleField: schema.#Resource & {
 		multi: { goType: "bool", storeIdent: "is_multi" }
 		default_value: { goType: "types.RecordValueSet" }
 		expressions: { goType: "types.ModuleFieldExpr" }
+		sensitivity_level: { goType: "uint64" }
 		created_at: { goType: "time.Time" }
 		updated_at: { goType: "*time.Time" }
 		deleted_at: { goType: "*time.Time" }
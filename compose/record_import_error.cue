@@ -0,0 +1,48 @@
+package compose
+
+import (
+	"github.com/cortezaproject/corteza-server/codegen/schema"
+)
+
+recordImportError: schema.#Resource & {
+	features: {
+		labels: false
+		paging: false
+		sorting: false
+		checkFn: false
+	}
+
+	struct: {
+		session_id: { primaryKey: true, goType: "uint64", storeIdent: "rel_session", ident: "sessionID" }
+		row:        { primaryKey: true, goType: "uint64", storeIdent: "row_index" }
+		message:    {}
+		created_at: schema.SortableTimestampField
+	}
+
+	filter: {
+		struct: {
+			session_id: { goType: "uint64", ident: "sessionID", storeIdent: "rel_session" }
+		}
+
+		byValue: ["session_id"]
+	}
+
+	store: {
+		ident: "composeRecordImportError"
+
+		settings: {
+			rdbms: {
+				table: "compose_record_import_error"
+			}
+		}
+
+		api: {
+			functions: [
+				{
+					expIdent: "DeleteComposeRecordImportErrorsBySessionID"
+					args: [ { ident: "sessionID", goType: "uint64" } ]
+				},
+			]
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package compose
+
+import (
+	"github.com/cortezaproject/corteza-server/codegen/schema"
+)
+
+recordImportSession: schema.#Resource & {
+	features: {
+		labels: false
+		paging: false
+		sorting: false
+		checkFn: false
+	}
+
+	struct: {
+		id:           schema.IdField
+		name:         {}
+		content_type: {}
+		source:       {}
+		sheet:        {}
+		sheets:       { goType: "types.RecordImportSheetSet" }
+		user_id:      { goType: "uint64", storeIdent: "rel_user", ident: "userID" }
+		namespace_id: { goType: "uint64", storeIdent: "rel_namespace", ident: "namespaceID" }
+		module_id:    { goType: "uint64", storeIdent: "rel_module", ident: "moduleID" }
+		on_error:     {}
+		fields:       { goType: "types.RecordImportFieldMap" }
+		key:          { storeIdent: "key_field" }
+		progress:     { goType: "types.RecordImportProgress" }
+		created_at:   schema.SortableTimestampField
+		updated_at:   schema.SortableTimestampField
+	}
+
+	filter: {
+		struct: {
+			user_id: { goType: "uint64", ident: "userID", storeIdent: "rel_user" }
+		}
+
+		byValue: ["user_id"]
+	}
+
+	store: {
+		ident: "composeRecordImportSession"
+
+		settings: {
+			rdbms: {
+				table: "compose_record_import_session"
+			}
+		}
+
+		api: {
+			lookups: [
+				{ fields: ["id"] },
+			]
+
+			functions: [
+				{
+					expIdent: "ClaimComposeRecordImportSession"
+					description: """
+						updates the import session unless it was updated after the given time
+
+						Returns true when the session is claimed; when the session
+						was updated in the meantime (by another node), false is returned
+						"""
+					args: [
+						{ ident: "session", goType: "*types.RecordImportSession" },
+						{ ident: "updatedBefore", goType: "time.Time" },
+					]
+					return: [ "bool" ]
+				},
+				{
+					expIdent: "StartComposeRecordImportSession"
+					description: """
+						updates the import session unless it was updated after it was loaded
+
+						Returns true when the session is updated; when the session
+						was updated in the meantime (started by another request), false is returned
+						"""
+					args: [
+						{ ident: "session", goType: "*types.RecordImportSession" },
+						{ ident: "loadedAt", goType: "time.Time" },
+					]
+					return: [ "bool" ]
+				},
+			]
+		}
+	}
+}
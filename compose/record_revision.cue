@@ -0,0 +1,71 @@
+package compose
+
+import (
+	"github.com/cortezaproject/corteza-server/codegen/schema"
+)
+
+recordRevision: schema.#Resource & {
+	features: {
+		labels: false
+		checkFn: false
+	}
+
+	struct: {
+		id:         schema.IdField
+		record_id:  { goType: "uint64", storeIdent: "rel_record", ident: "recordID" }
+		module_id:  { goType: "uint64", storeIdent: "rel_module", ident: "moduleID" }
+		revision:   { goType: "uint", sortable: true }
+		operation:  { goType: "types.RecordRevisionOperation" }
+		user_id:    { goType: "uint64", storeIdent: "rel_user", ident: "userID" }
+		session_id: { goType: "uint64", storeIdent: "rel_session", ident: "sessionID" }
+		values:     { goType: "types.RecordValueSet" }
+		changes:    { goType: "types.RecordRevisionChangeSet" }
+		created_at: schema.SortableTimestampField
+	}
+
+	filter: {
+		struct: {
+			record_id: { goType: "uint64", ident: "recordID", storeIdent: "rel_record" }
+			module_id: { goType: "uint64", ident: "moduleID", storeIdent: "rel_module" }
+		}
+
+		byValue: ["record_id", "module_id"]
+	}
+
+	store: {
+		ident: "composeRecordRevision"
+
+		settings: {
+			rdbms: {
+				table: "compose_record_revision"
+			}
+		}
+
+		api: {
+			lookups: [
+				{ fields: ["id"] },
+				{
+					fields: ["record_id", "revision"]
+					description: """
+						searches for record revision by record ID and revision number
+						"""
+				},
+			]
+
+			functions: [
+				{
+					expIdent: "CreateUniqueComposeRecordRevision"
+					description: """
+						creates the record revision unless the revision number is already taken
+
+						Returns false when the record already has the revision with the same number
+						"""
+					args: [
+						{ ident: "rev", goType: "*types.RecordRevision" },
+					]
+					return: [ "bool" ]
+				},
+			]
+		}
+	}
+}
@@ -202,36 +202,13 @@ func (ctrl Namespace) Clone(ctx context.Context, r *request.NamespaceClone) (int
 		Slug: r.Slug,
 	}
 
-	// prepare filters
-	df := envoyStore.NewDecodeFilter()
-
-	// - compose resources
-	df = df.ComposeNamespace(&types.NamespaceFilter{
-		NamespaceID: []uint64{r.NamespaceID},
-	}).
-		ComposeModule(&types.ModuleFilter{}).
-		ComposePage(&types.PageFilter{}).
-		ComposeChart(&types.ChartFilter{})
-
-	// - workflow
-	// @todo how do we want to handle these ones?
-	//       do we handle these ones?
-
 	decoder := func() (resource.InterfaceSet, error) {
 		// get from store
-		return envoyStore.Decoder().Decode(ctx, service.DefaultStore, df)
+		return envoyStore.DecodeNamespace(ctx, service.DefaultStore, r.NamespaceID)
 	}
 
 	encoder := func(nn resource.InterfaceSet) error {
-		// prepare for encoding
-		se := envoyStore.NewStoreEncoder(service.DefaultStore, &envoyStore.EncoderConfig{})
-		bld := envoy.NewBuilder(se)
-		g, err := bld.Build(ctx, nn...)
-		if err != nil {
-			return err
-		}
-
-		return envoy.Encode(ctx, g, se)
+		return envoyStore.EncodeResources(ctx, service.DefaultStore, nn)
 	}
 
 	ns, err := ctrl.namespace.Clone(ctx, r.NamespaceID, dup, decoder, encoder)
@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -9,7 +10,6 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/cortezaproject/corteza-server/compose/rest/request"
 	"github.com/cortezaproject/corteza-server/compose/service"
@@ -19,8 +19,8 @@ import (
 	"github.com/cortezaproject/corteza-server/pkg/envoy"
 	"github.com/cortezaproject/corteza-server/pkg/envoy/csv"
 	ejson "github.com/cortezaproject/corteza-server/pkg/envoy/json"
-	"github.com/cortezaproject/corteza-server/pkg/envoy/resource"
 	estore "github.com/cortezaproject/corteza-server/pkg/envoy/store"
+	"github.com/cortezaproject/corteza-server/pkg/envoy/xlsx"
 	"github.com/cortezaproject/corteza-server/pkg/filter"
 	"github.com/cortezaproject/corteza-server/pkg/payload"
 	"github.com/cortezaproject/corteza-server/store"
@@ -43,6 +43,11 @@ type (
 		Set    []*recordPayload    `json:"set"`
 	}
 
+	recordRevisionSetPayload struct {
+		Filter types.RecordRevisionFilter `json:"filter"`
+		Set    types.RecordRevisionSet    `json:"set"`
+	}
+
 	Record struct {
 		importSession service.ImportSessionService
 		record        service.RecordService
@@ -253,6 +258,51 @@ func (ctrl *Record) Delete(ctx context.Context, r *request.RecordDelete) (interf
 	return api.OK(), ctrl.record.DeleteByID(ctx, r.NamespaceID, r.ModuleID, r.RecordID)
 }
 
+func (ctrl *Record) Revisions(ctx context.Context, r *request.RecordRevisions) (interface{}, error) {
+	var (
+		err error
+		f   = types.RecordRevisionFilter{}
+	)
+
+	if f.Paging, err = filter.NewPaging(r.Limit, r.PageCursor); err != nil {
+		return nil, err
+	}
+
+	if f.Sorting, err = filter.NewSorting(r.Sort); err != nil {
+		return nil, err
+	}
+
+	set, f, err := ctrl.record.SearchRevisions(ctx, r.NamespaceID, r.ModuleID, r.RecordID, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recordRevisionSetPayload{Filter: f, Set: set}, nil
+}
+
+func (ctrl *Record) RevisionsDiff(ctx context.Context, r *request.RecordRevisionsDiff) (interface{}, error) {
+	return ctrl.record.DiffRevisions(ctx, r.NamespaceID, r.ModuleID, r.RecordID, r.From, r.To)
+}
+
+func (ctrl *Record) RevisionRestore(ctx context.Context, r *request.RecordRevisionRestore) (interface{}, error) {
+	var (
+		m   *types.Module
+		err error
+	)
+
+	if m, err = ctrl.module.FindByID(ctx, r.NamespaceID, r.ModuleID); err != nil {
+		return nil, err
+	}
+
+	record, err := ctrl.record.RestoreRevision(ctx, r.NamespaceID, r.ModuleID, r.RecordID, r.Revision)
+
+	if rve := types.IsRecordValueErrorSet(err); rve != nil {
+		return ctrl.handleValidationError(rve), nil
+	}
+
+	return ctrl.makePayload(ctx, m, record, err)
+}
+
 func (ctrl *Record) BulkDelete(ctx context.Context, r *request.RecordBulkDelete) (interface{}, error) {
 	if r.Truncate {
 		return nil, fmt.Errorf("pending implementation")
@@ -306,7 +356,8 @@ func (ctrl *Record) ImportInit(ctx context.Context, r *request.RecordImportInit)
 
 func (ctrl *Record) ImportRun(ctx context.Context, r *request.RecordImportRun) (interface{}, error) {
 	var (
-		err error
+		err    error
+		fields = make(map[string]string)
 	)
 
 	// Access control.
@@ -314,102 +365,16 @@ func (ctrl *Record) ImportRun(ctx context.Context, r *request.RecordImportRun) (
 		return nil, err
 	}
 
-	// Check if session ok
-	ses, err := ctrl.importSession.FindByID(ctx, r.SessionID)
-	if err != nil {
+	if err = json.Unmarshal(r.Fields, &fields); err != nil {
 		return nil, err
 	}
 
-	ses.Fields = make(map[string]string)
-	err = json.Unmarshal(r.Fields, &ses.Fields)
-	if err != nil {
+	// Errors are presented in the session
+	ses, err := ctrl.importSession.Run(ctx, r.SessionID, r.Sheet, fields, r.OnError)
+	if ses == nil {
 		return nil, err
 	}
 
-	ses.OnError = r.OnError
-
-	// Errors are presented in the session
-	err = func() (err error) {
-		if ses.Progress.StartedAt != nil {
-			return fmt.Errorf("unable to start import: import session already active")
-		}
-
-		sa := time.Now()
-		ses.Progress.StartedAt = &sa
-
-		// Prepare additional metadata
-		tpl := resource.NewComposeRecordTemplate(
-			strconv.FormatUint(ses.ModuleID, 10),
-			strconv.FormatUint(ses.NamespaceID, 10),
-			ses.Name,
-			false,
-			resource.MapToMappingTplSet(ses.Fields),
-			ses.Key,
-		)
-
-		// Shape the data
-		ses.Resources = append(ses.Resources, tpl)
-		rt := resource.ComposeRecordShaper()
-		ses.Resources, err = resource.Shape(ses.Resources, rt)
-
-		// Build
-		cfg := &estore.EncoderConfig{
-			// For now the identifier is ignored, so this will never occur
-			OnExisting: resource.Skip,
-			DeferOk: func() {
-				ses.Progress.Completed++
-			},
-		}
-		cfg.DeferNok = func(err error) error {
-			ses.Progress.Failed++
-
-			if ses.Progress.FailLog == nil {
-				ses.Progress.FailLog = &service.FailLog{
-					Errors: make(service.ErrorIndex),
-				}
-			}
-
-			if rve, is := err.(*types.RecordValueErrorSet); is {
-				for _, ve := range rve.Set {
-					for k, v := range ve.Meta {
-						ses.Progress.FailLog.Errors.Add(fmt.Sprintf("%s %s %v", ve.Kind, k, v))
-					}
-				}
-			} else {
-				ses.Progress.FailLog.Errors.Add(err.Error())
-			}
-
-			if len(ses.Progress.FailLog.Records) < service.IMPORT_ERROR_MAX_INDEX_COUNT {
-				// +1 because we indexed them with 1 before
-				ses.Progress.FailLog.Records = append(ses.Progress.FailLog.Records, int(ses.Progress.Completed)+1)
-			} else {
-				ses.Progress.FailLog.RecordsTruncated = true
-			}
-
-			if ses.OnError == service.IMPORT_ON_ERROR_SKIP {
-				return nil
-			}
-			return err
-		}
-		se := estore.NewStoreEncoder(service.DefaultStore, cfg)
-		bld := envoy.NewBuilder(se)
-		g, err := bld.Build(ctx, ses.Resources...)
-		if err != nil {
-			return err
-		}
-
-		// Encode
-		err = envoy.Encode(ctx, g, se)
-		now := time.Now()
-		ses.Progress.FinishedAt = &now
-		if err != nil {
-			ses.Progress.FailReason = err.Error()
-			return err
-		}
-
-		return
-	}()
-
 	return ses, ctrl.record.RecordImport(ctx, err)
 }
 
@@ -420,7 +385,23 @@ func (ctrl *Record) ImportProgress(ctx context.Context, r *request.RecordImportP
 		return nil, err
 	}
 
-	return ses, nil
+	if r.Format != "csv" {
+		return ses, nil
+	}
+
+	// Failed rows of the import source
+	//
+	// Rows are buffered so errors are reported before the body is written
+	buf := &bytes.Buffer{}
+	if err = ctrl.importSession.FailedRows(ctx, ses.ID, buf); err != nil {
+		return nil, err
+	}
+
+	return func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%d-failed.csv", ses.ID))
+		_, _ = buf.WriteTo(w)
+	}, nil
 }
 
 func (ctrl *Record) Export(ctx context.Context, r *request.RecordExport) (interface{}, error) {
@@ -438,10 +419,11 @@ func (ctrl *Record) Export(ctx context.Context, r *request.RecordExport) (interf
 			ComposeRecord(rf)
 
 		contentType string
+		m           *types.Module
 	)
 
 	// Access control
-	if _, err = ctrl.module.FindByID(ctx, r.NamespaceID, r.ModuleID); err != nil {
+	if m, err = ctrl.module.FindByID(ctx, r.NamespaceID, r.ModuleID); err != nil {
 		return nil, err
 	}
 
@@ -449,6 +431,9 @@ func (ctrl *Record) Export(ctx context.Context, r *request.RecordExport) (interf
 		r.Fields = strings.Split(r.Fields[0], ",")
 	}
 
+	// Sensitive fields are never exported
+	r.Fields = ctrl.record.RedactExportFields(m, r.Fields)
+
 	return func(w http.ResponseWriter, req *http.Request) {
 		if len(r.Fields) == 0 {
 			http.Error(w, "no record value fields provided", http.StatusBadRequest)
@@ -482,6 +467,13 @@ func (ctrl *Record) Export(ctx context.Context, r *request.RecordExport) (interf
 				Timezone: r.Timezone,
 			})
 
+		case "xlsx":
+			contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+			encoder = xlsx.NewBulkRecordEncoder(&xlsx.EncoderConfig{
+				Fields:   fx,
+				Timezone: r.Timezone,
+			})
+
 		default:
 			http.Error(w, "unsupported format ("+r.Ext+")", http.StatusBadRequest)
 			return
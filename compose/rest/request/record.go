@@ -156,6 +156,11 @@ type (
 		//
 		// What happens if record fails to import
 		OnError string
+
+		// Sheet POST parameter
+		//
+		// Sheet to import records from; only for sources with multiple sheets
+		Sheet string
 	}
 
 	RecordImportProgress struct {
@@ -173,6 +178,11 @@ type (
 		//
 		// Import session
 		SessionID uint64 `json:",string"`
+
+		// Format GET parameter
+		//
+		// Response format; use csv to download the failed rows
+		Format string
 	}
 
 	RecordExport struct {
@@ -349,6 +359,87 @@ type (
 		RecordID uint64 `json:",string"`
 	}
 
+	RecordRevisions struct {
+		// NamespaceID PATH parameter
+		//
+		// Namespace ID
+		NamespaceID uint64 `json:",string"`
+
+		// ModuleID PATH parameter
+		//
+		// Module ID
+		ModuleID uint64 `json:",string"`
+
+		// RecordID PATH parameter
+		//
+		// Record ID
+		RecordID uint64 `json:",string"`
+
+		// Limit GET parameter
+		//
+		// Limit
+		Limit uint
+
+		// PageCursor GET parameter
+		//
+		// Page cursor
+		PageCursor string
+
+		// Sort GET parameter
+		//
+		// Sort items
+		Sort string
+	}
+
+	RecordRevisionsDiff struct {
+		// NamespaceID PATH parameter
+		//
+		// Namespace ID
+		NamespaceID uint64 `json:",string"`
+
+		// ModuleID PATH parameter
+		//
+		// Module ID
+		ModuleID uint64 `json:",string"`
+
+		// RecordID PATH parameter
+		//
+		// Record ID
+		RecordID uint64 `json:",string"`
+
+		// From GET parameter
+		//
+		// Revision to compare from; 0 compares against the empty record
+		From uint
+
+		// To GET parameter
+		//
+		// Revision to compare to
+		To uint
+	}
+
+	RecordRevisionRestore struct {
+		// NamespaceID PATH parameter
+		//
+		// Namespace ID
+		NamespaceID uint64 `json:",string"`
+
+		// ModuleID PATH parameter
+		//
+		// Module ID
+		ModuleID uint64 `json:",string"`
+
+		// RecordID PATH parameter
+		//
+		// Record ID
+		RecordID uint64 `json:",string"`
+
+		// Revision PATH parameter
+		//
+		// Revision
+		Revision uint
+	}
+
 	RecordUpload struct {
 		// NamespaceID PATH parameter
 		//
@@ -770,6 +861,7 @@ func (r RecordImportRun) Auditable() map[string]interface{} {
 		"sessionID":   r.SessionID,
 		"fields":      r.Fields,
 		"onError":     r.OnError,
+		"sheet":       r.Sheet,
 	}
 }
 
@@ -798,6 +890,11 @@ func (r RecordImportRun) GetOnError() string {
 	return r.OnError
 }
 
+// Auditable returns all auditable/loggable parameters
+func (r RecordImportRun) GetSheet() string {
+	return r.Sheet
+}
+
 // Fill processes request and fills internal variables
 func (r *RecordImportRun) Fill(req *http.Request) (err error) {
 
@@ -832,6 +929,13 @@ func (r *RecordImportRun) Fill(req *http.Request) (err error) {
 					return err
 				}
 			}
+
+			if val, ok := req.MultipartForm.Value["sheet"]; ok && len(val) > 0 {
+				r.Sheet, err = val[0], nil
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 
@@ -855,6 +959,13 @@ func (r *RecordImportRun) Fill(req *http.Request) (err error) {
 				return err
 			}
 		}
+
+		if val, ok := req.Form["sheet"]; ok && len(val) > 0 {
+			r.Sheet, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	{
@@ -895,6 +1006,7 @@ func (r RecordImportProgress) Auditable() map[string]interface{} {
 		"namespaceID": r.NamespaceID,
 		"moduleID":    r.ModuleID,
 		"sessionID":   r.SessionID,
+		"format":      r.Format,
 	}
 }
 
@@ -913,9 +1025,26 @@ func (r RecordImportProgress) GetSessionID() uint64 {
 	return r.SessionID
 }
 
+// Auditable returns all auditable/loggable parameters
+func (r RecordImportProgress) GetFormat() string {
+	return r.Format
+}
+
 // Fill processes request and fills internal variables
 func (r *RecordImportProgress) Fill(req *http.Request) (err error) {
 
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["format"]; ok && len(val) > 0 {
+			r.Format, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	{
 		var val string
 		// path params
@@ -1662,6 +1791,267 @@ func (r *RecordDelete) Fill(req *http.Request) (err error) {
 	return err
 }
 
+// NewRecordRevisions request
+func NewRecordRevisions() *RecordRevisions {
+	return &RecordRevisions{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordRevisions) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"namespaceID": r.NamespaceID,
+		"moduleID":    r.ModuleID,
+		"recordID":    r.RecordID,
+		"limit":       r.Limit,
+		"pageCursor":  r.PageCursor,
+		"sort":        r.Sort,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordRevisions) GetNamespaceID() uint64 {
+	return r.NamespaceID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordRevisions) GetModuleID() uint64 {
+	return r.ModuleID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordRevisions) GetRecordID() uint64 {
+	return r.RecordID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordRevisions) GetLimit() uint {
+	return r.Limit
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordRevisions) GetPageCursor() string {
+	return r.PageCursor
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordRevisions) GetSort() string {
+	return r.Sort
+}
+
+// Fill processes request and fills internal variables
+func (r *RecordRevisions) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["limit"]; ok && len(val) > 0 {
+			r.Limit, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["pageCursor"]; ok && len(val) > 0 {
+			r.PageCursor, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["sort"]; ok && len(val) > 0 {
+			r.Sort, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "namespaceID")
+		r.NamespaceID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "moduleID")
+		r.ModuleID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "recordID")
+		r.RecordID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewRecordRevisionsDiff request
+func NewRecordRevisionsDiff() *RecordRevisionsDiff {
+	return &RecordRevisionsDiff{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordRevisionsDiff) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"namespaceID": r.NamespaceID,
+		"moduleID":    r.ModuleID,
+		"recordID":    r.RecordID,
+		"from":        r.From,
+		"to":          r.To,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordRevisionsDiff) GetNamespaceID() uint64 {
+	return r.NamespaceID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordRevisionsDiff) GetModuleID() uint64 {
+	return r.ModuleID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordRevisionsDiff) GetRecordID() uint64 {
+	return r.RecordID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordRevisionsDiff) GetFrom() uint {
+	return r.From
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordRevisionsDiff) GetTo() uint {
+	return r.To
+}
+
+// Fill processes request and fills internal variables
+func (r *RecordRevisionsDiff) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["from"]; ok && len(val) > 0 {
+			r.From, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["to"]; ok && len(val) > 0 {
+			r.To, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "namespaceID")
+		r.NamespaceID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "moduleID")
+		r.ModuleID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "recordID")
+		r.RecordID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewRecordRevisionRestore request
+func NewRecordRevisionRestore() *RecordRevisionRestore {
+	return &RecordRevisionRestore{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordRevisionRestore) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"namespaceID": r.NamespaceID,
+		"moduleID":    r.ModuleID,
+		"recordID":    r.RecordID,
+		"revision":    r.Revision,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordRevisionRestore) GetNamespaceID() uint64 {
+	return r.NamespaceID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordRevisionRestore) GetModuleID() uint64 {
+	return r.ModuleID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordRevisionRestore) GetRecordID() uint64 {
+	return r.RecordID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r RecordRevisionRestore) GetRevision() uint {
+	return r.Revision
+}
+
+// Fill processes request and fills internal variables
+func (r *RecordRevisionRestore) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "namespaceID")
+		r.NamespaceID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "moduleID")
+		r.ModuleID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "recordID")
+		r.RecordID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+		val = chi.URLParam(req, "revision")
+		r.Revision, err = payload.ParseUint(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
 // NewRecordUpload request
 func NewRecordUpload() *RecordUpload {
 	return &RecordUpload{}
@@ -2,82 +2,745 @@ package service
 
 import (
 	"context"
+	"encoding/csv"
 	"fmt"
 	"io"
+	"path"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/cortezaproject/corteza-server/compose/types"
 	"github.com/cortezaproject/corteza-server/pkg/auth"
 	"github.com/cortezaproject/corteza-server/pkg/envoy"
-	"github.com/cortezaproject/corteza-server/pkg/envoy/csv"
+	ecsv "github.com/cortezaproject/corteza-server/pkg/envoy/csv"
 	"github.com/cortezaproject/corteza-server/pkg/envoy/json"
 	"github.com/cortezaproject/corteza-server/pkg/envoy/resource"
+	"github.com/cortezaproject/corteza-server/pkg/envoy/xlsx"
+	"github.com/cortezaproject/corteza-server/pkg/objstore"
+	"github.com/cortezaproject/corteza-server/pkg/sentry"
+	"github.com/cortezaproject/corteza-server/store"
+	systemTypes "github.com/cortezaproject/corteza-server/system/types"
+	"go.uber.org/zap"
+)
+
+const (
+	// import sessions that were not updated for this long are removed
+	importSessionMaxAge = time.Hour * 24 * 3
+
+	// running import sessions that were not updated for this long
+	// were interrupted and are resumed
+	importSessionStaleAfter = time.Minute
+
+	// running import sessions are touched in this interval
+	// so they are not considered stale
+	importSessionHeartbeat = time.Second * 15
+
+	recordImportSessionNotFound = "compose.service.RecordImportSessionNotFound"
 )
 
 type (
-	recordSet []*recordImportSession
+	// recordImporter encodes the shaped import resources into the store
+	//
+	// ok and nok are called after each record is encoded;
+	// when nok returns an error, the import is stopped.
+	recordImporter func(ctx context.Context, s store.Storer, ok func(), nok func(error) error, rr ...resource.Interface) error
 
 	importSession struct {
+		store    store.Storer
+		objects  objstore.Store
+		importer recordImporter
+		log      *zap.Logger
+
+		// sessions that are being imported by this node
 		l       sync.Mutex
-		records recordSet
+		running map[uint64]bool
+	}
+
+	// importRun holds the state of the session while it is imported
+	importRun struct {
+		l   sync.Mutex
+		ses *types.RecordImportSession
+
+		// set when the progress was committed with the written record
+		written bool
+	}
+
+	// importStore writes each imported record in a transaction
+	// together with the progress of the import session so that
+	// the record is not imported again when the import is resumed
+	importStore struct {
+		store.Storer
+		r *importRun
+	}
+
+	// importOffsetProvider skips the already processed rows of the dataset
+	importOffsetProvider struct {
+		provider
+		offset uint64
+	}
+
+	// sheetProvider is implemented by the datasets of the sources with sheets
+	sheetProvider interface {
+		Sheet() string
+	}
+
+	provider interface {
+		Fields() []string
+		Count() uint64
+		Reset() error
+		Next() (map[string]string, error)
 	}
 
 	ImportSessionService interface {
-		Create(ctx context.Context, f io.ReadSeeker, name, contentType string, namespaceID, moduleID uint64) (*recordImportSession, error)
-		FindByID(ctx context.Context, sessionID uint64) (*recordImportSession, error)
+		Create(ctx context.Context, f io.ReadSeeker, name, contentType string, namespaceID, moduleID uint64) (*types.RecordImportSession, error)
+		FindByID(ctx context.Context, sessionID uint64) (*types.RecordImportSession, error)
 		DeleteByID(ctx context.Context, sessionID uint64) error
+
+		Run(ctx context.Context, sessionID uint64, sheet string, fields map[string]string, onError string) (*types.RecordImportSession, error)
+		FailedRows(ctx context.Context, sessionID uint64, w io.Writer) error
+
+		Watch(ctx context.Context)
 	}
 )
 
-func ImportSession() *importSession {
+func ImportSession(objects objstore.Store, importer recordImporter) *importSession {
 	return &importSession{
-		records: recordSet{},
+		store:    DefaultStore,
+		objects:  objects,
+		importer: importer,
+		log:      DefaultLogger.Named("import-session"),
+		running:  make(map[uint64]bool),
 	}
 }
 
-func (svc *importSession) indexOf(userID, sessionID uint64) int {
-	for i, r := range svc.records {
-		if r.SessionID == sessionID && r.UserID == userID {
-			return i
-		}
-	}
-
-	return -1
-}
-
-func (svc *importSession) Create(ctx context.Context, f io.ReadSeeker, name, contentType string, namespaceID, moduleID uint64) (*recordImportSession, error) {
-	svc.l.Lock()
-	defer svc.l.Unlock()
-
+func (svc *importSession) Create(ctx context.Context, f io.ReadSeeker, name, contentType string, namespaceID, moduleID uint64) (*types.RecordImportSession, error) {
 	// Prepare the session
-	sh := &recordImportSession{
+	sh := &types.RecordImportSession{
+		ID:          nextID(),
 		Name:        name,
-		SessionID:   nextID(),
+		ContentType: contentType,
 		UserID:      auth.GetIdentityFromContext(ctx).Identity(),
 		NamespaceID: namespaceID,
 		ModuleID:    moduleID,
 
-		OnError:  IMPORT_ON_ERROR_FAIL,
-		Fields:   make(map[string]string),
-		Progress: &RecordImportProgress{},
+		OnError: IMPORT_ON_ERROR_FAIL,
+		Fields:  make(types.RecordImportFieldMap),
 
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
-	// Decoders; We only need to do csv & yaml here
-	cd := csv.Decoder()
+	dd, err := svc.decode(ctx, f, name, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sources with sheets let the user pick the one to import;
+	// the first one is used by default
+	for _, d := range dd {
+		if s, ok := d.P.(sheetProvider); ok {
+			sh.Sheets = append(sh.Sheets, &types.RecordImportSheet{
+				Name:       s.Sheet(),
+				Fields:     d.P.Fields(),
+				EntryCount: d.P.Count(),
+			})
+		}
+	}
+
+	if len(sh.Sheets) > 0 {
+		sh.Sheet = sh.Sheets[0].Name
+	}
+
+	n := dd[0]
+	sh.Progress.EntryCount = n.P.Count()
+	for _, f := range n.P.Fields() {
+		sh.Fields[f] = ""
+	}
+	sh.Key = importKey(n.P.Fields())
+
+	// Keep the source so the import can be resumed on any node
+	if svc.objects == nil {
+		return nil, fmt.Errorf("cannot create import session: store handler not set")
+	}
+
+	sh.Source = svc.objects.Original(sh.ID, strings.Trim(path.Ext(strings.Trim(name, ".")), "."))
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if err = svc.objects.Save(sh.Source, f); err != nil {
+		return nil, err
+	}
+
+	// Create it
+	if err = store.CreateComposeRecordImportSession(ctx, svc.store, sh); err != nil {
+		return nil, err
+	}
+
+	return sh, nil
+}
+
+func (svc *importSession) FindByID(ctx context.Context, sessionID uint64) (*types.RecordImportSession, error) {
+	ses, err := svc.lookup(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return ses, svc.loadFailLog(ctx, ses)
+}
+
+func (svc *importSession) DeleteByID(ctx context.Context, sessionID uint64) error {
+	ses, err := svc.lookup(ctx, sessionID)
+	if err != nil {
+		if err.Error() == recordImportSessionNotFound {
+			return nil
+		}
+		return err
+	}
+
+	return svc.delete(ctx, ses)
+}
+
+// Run imports the records from the session source
+//
+// Progress is committed with each imported row so the import can be resumed
+// when interrupted; errors are presented in the session.
+func (svc *importSession) Run(ctx context.Context, sessionID uint64, sheet string, fields map[string]string, onError string) (*types.RecordImportSession, error) {
+	ses, err := svc.lookup(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ses.Progress.StartedAt != nil {
+		return ses, fmt.Errorf("unable to start import: import session already active")
+	}
+
+	if sheet != "" && sheet != ses.Sheet {
+		sh := ses.Sheets.FindByName(sheet)
+		if sh == nil {
+			return ses, fmt.Errorf("unable to start import: unknown sheet %s", sheet)
+		}
+
+		ses.Sheet = sh.Name
+		ses.Key = importKey(sh.Fields)
+		ses.Progress.EntryCount = sh.EntryCount
+	}
+
+	ses.Fields = fields
+	ses.OnError = onError
+
+	var (
+		sa       = time.Now()
+		loadedAt = ses.UpdatedAt
+		ok       bool
+	)
+
+	ses.Progress.StartedAt = &sa
+	ses.UpdatedAt = sa
+
+	// claim the session so it is not started by concurrent requests
+	if ok, err = store.StartComposeRecordImportSession(ctx, svc.store, ses, loadedAt); err != nil {
+		return ses, err
+	} else if !ok {
+		return ses, fmt.Errorf("unable to start import: import session already active")
+	}
+
+	return ses, svc.run(ctx, ses)
+}
+
+// FailedRows writes the source rows that failed to import as CSV
+//
+// The reason for the failure is added as the last column.
+func (svc *importSession) FailedRows(ctx context.Context, sessionID uint64, w io.Writer) error {
+	ses, err := svc.lookup(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	ee, err := svc.failed(ctx, ses.ID)
+	if err != nil {
+		return err
+	}
+
+	n, err := svc.source(ctx, ses)
+	if err != nil {
+		return err
+	}
+
+	var (
+		cw     = csv.NewWriter(w)
+		fields = n.P.Fields()
+		row    uint64
+	)
+
+	if err = cw.Write(append(append([]string{}, fields...), "error")); err != nil {
+		return err
+	}
+
+	if err = n.P.Reset(); err != nil {
+		return err
+	}
+
+	for len(ee) > 0 {
+		vv, err := n.P.Next()
+		if err != nil {
+			return err
+		}
+		if vv == nil {
+			break
+		}
+
+		row++
+		if ee[0].Row != row {
+			continue
+		}
+
+		out := make([]string, 0, len(fields)+1)
+		for _, f := range fields {
+			out = append(out, vv[f])
+		}
+
+		if err = cw.Write(append(out, ee[0].Message)); err != nil {
+			return err
+		}
+
+		ee = ee[1:]
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// Watch resumes interrupted imports and removes old sessions
+func (svc *importSession) Watch(ctx context.Context) {
+	ticker := time.NewTicker(importSessionStaleAfter)
+
+	go func() {
+		defer sentry.Recover()
+		defer ticker.Stop()
+		defer svc.log.Info("stopped")
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				svc.watch(ctx)
+			}
+		}
+	}()
+
+	svc.log.Debug("watcher initialized")
+}
+
+func (svc *importSession) watch(ctx context.Context) {
+	set, _, err := store.SearchComposeRecordImportSessions(ctx, svc.store, types.RecordImportSessionFilter{})
+	if err != nil {
+		svc.log.Error("failed to load import sessions", zap.Error(err))
+		return
+	}
+
+	for _, ses := range set {
+		log := svc.log.With(zap.Uint64("sessionID", ses.ID))
+
+		switch {
+		case time.Now().After(ses.UpdatedAt.Add(importSessionMaxAge)):
+			if err = svc.delete(ctx, ses); err != nil {
+				log.Error("failed to remove import session", zap.Error(err))
+			}
+
+		case ses.Running() && time.Now().After(ses.UpdatedAt.Add(importSessionStaleAfter)) && !svc.isRunning(ses.ID):
+			if err = svc.resume(ctx, ses); err != nil {
+				log.Error("failed to resume import", zap.Error(err))
+			}
+		}
+	}
+}
+
+// resume continues the interrupted import in the background
+//
+// Session is claimed before the import is resumed so that
+// it is resumed by a single node only
+func (svc *importSession) resume(ctx context.Context, ses *types.RecordImportSession) (err error) {
+	var (
+		log = svc.log.With(zap.Uint64("sessionID", ses.ID))
+		now = time.Now()
+		ok  bool
+	)
+
+	rctx, err := svc.runnerCtx(ctx, ses.UserID)
+	if err != nil {
+		return
+	}
+
+	if err = svc.loadFailLog(ctx, ses); err != nil {
+		return
+	}
+
+	ses.UpdatedAt = now
+	if ok, err = store.ClaimComposeRecordImportSession(ctx, svc.store, ses, now.Add(-importSessionStaleAfter)); err != nil || !ok {
+		return
+	}
+
+	log.Info("resuming interrupted import", zap.Uint64("processed", ses.Progress.Processed()))
+
+	go func() {
+		defer sentry.Recover()
+
+		if err := svc.run(rctx, ses); err != nil {
+			log.Warn("import failed", zap.Error(err))
+		}
+	}()
+
+	return nil
+}
+
+// run imports the rows of the session source that were not yet processed
+func (svc *importSession) run(ctx context.Context, ses *types.RecordImportSession) (err error) {
+	if svc.importer == nil {
+		return fmt.Errorf("unable to start import: record importer not set")
+	}
+
+	if !svc.claim(ses.ID) {
+		return fmt.Errorf("unable to start import: import session already active")
+	}
+	defer svc.release(ses.ID)
+
+	var (
+		r = &importRun{ses: ses}
+
+		hbCtx, cancel = context.WithCancel(ctx)
+	)
+
+	// keep the session from being considered as stale
+	// while the import is being prepared
+	go func() {
+		defer sentry.Recover()
+
+		t := time.NewTicker(importSessionHeartbeat)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-hbCtx.Done():
+				return
+			case <-t.C:
+				r.l.Lock()
+				svc.flush(hbCtx, ses)
+				r.l.Unlock()
+			}
+		}
+	}()
+
+	err = func() error {
+		n, err := svc.source(ctx, ses)
+		if err != nil {
+			return err
+		}
+
+		// Skip rows that were processed before the interruption
+		n.P = &importOffsetProvider{provider: n.P, offset: ses.Progress.Processed()}
+
+		// Prepare additional metadata
+		tpl := resource.NewComposeRecordTemplate(
+			strconv.FormatUint(ses.ModuleID, 10),
+			strconv.FormatUint(ses.NamespaceID, 10),
+			ses.Name,
+			false,
+			resource.MapToMappingTplSet(ses.Fields),
+			ses.Key,
+		)
+
+		// Shape the data
+		rr, err := resource.Shape([]resource.Interface{n, tpl}, resource.ComposeRecordShaper())
+		if err != nil {
+			return err
+		}
+
+		return svc.importer(ctx, importStore{Storer: svc.store, r: r}, r.ok(ctx, svc), r.nok(ctx, svc), rr...)
+	}()
+
+	cancel()
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	if ctx.Err() != nil {
+		// Interrupted; leave the session running so it is resumed
+		return err
+	}
+
+	now := time.Now()
+	ses.Progress.FinishedAt = &now
+	if err != nil {
+		ses.Progress.FailReason = err.Error()
+	}
+
+	if uErr := svc.update(context.Background(), ses); uErr != nil {
+		svc.log.Error("failed to update import session", zap.Uint64("sessionID", ses.ID), zap.Error(uErr))
+	}
+
+	return err
+}
+
+// ok is called after the record is imported
+func (r *importRun) ok(ctx context.Context, svc *importSession) func() {
+	return func() {
+		r.l.Lock()
+		defer r.l.Unlock()
+
+		if r.written {
+			// progress was committed with the record
+			r.written = false
+			return
+		}
+
+		r.ses.Progress.Completed++
+		svc.flush(ctx, r.ses)
+	}
+}
+
+// write runs the record write and commits the progress
+// of the session that includes the written record
+func (r *importRun) write(ctx context.Context, s store.Storer, fn func(context.Context, store.Storer) error) error {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	ses := *r.ses
+	ses.Progress.Completed++
+	ses.UpdatedAt = time.Now()
+
+	err := store.Tx(ctx, s, func(ctx context.Context, s store.Storer) error {
+		if err := fn(ctx, s); err != nil {
+			return err
+		}
+
+		return store.UpdateComposeRecordImportSession(ctx, s, &ses)
+	})
+	if err != nil {
+		return err
+	}
+
+	*r.ses = ses
+	r.written = true
+	return nil
+}
+
+// Tx runs the given function without the transaction;
+// each of the records is written in its own transaction
+func (s importStore) Tx(ctx context.Context, fn func(context.Context, store.Storer) error) error {
+	return fn(ctx, s)
+}
+
+func (s importStore) CreateComposeRecord(ctx context.Context, mod *types.Module, rr ...*types.Record) error {
+	return s.r.write(ctx, s.Storer, func(ctx context.Context, tx store.Storer) error {
+		return store.CreateComposeRecord(ctx, tx, mod, rr...)
+	})
+}
+
+func (s importStore) UpdateComposeRecord(ctx context.Context, mod *types.Module, rr ...*types.Record) error {
+	return s.r.write(ctx, s.Storer, func(ctx context.Context, tx store.Storer) error {
+		return store.UpdateComposeRecord(ctx, tx, mod, rr...)
+	})
+}
+
+// nok is called after the record fails to import
+func (r *importRun) nok(ctx context.Context, svc *importSession) func(error) error {
+	return func(err error) error {
+		r.l.Lock()
+		defer r.l.Unlock()
+
+		var (
+			ses = r.ses
+			msg = err.Error()
+		)
+
+		ses.Progress.Failed++
+
+		if ses.Progress.FailLog == nil {
+			ses.Progress.FailLog = &types.RecordImportFailLog{
+				Errors: make(types.RecordImportErrorIndex),
+			}
+		}
+
+		if rve, is := err.(*types.RecordValueErrorSet); is {
+			mm := make([]string, 0, len(rve.Set))
+			for _, ve := range rve.Set {
+				for k, v := range ve.Meta {
+					m := fmt.Sprintf("%s %s %v", ve.Kind, k, v)
+					ses.Progress.FailLog.Errors.Add(m)
+					mm = append(mm, m)
+				}
+			}
+
+			msg = strings.Join(mm, "; ")
+		} else {
+			ses.Progress.FailLog.Errors.Add(msg)
+		}
+
+		// Processed rows are counted from 1
+		row := ses.Progress.Processed()
+
+		if len(ses.Progress.FailLog.Records) < IMPORT_ERROR_MAX_INDEX_COUNT {
+			ses.Progress.FailLog.Records = append(ses.Progress.FailLog.Records, int(row))
+
+			e := &types.RecordImportError{
+				SessionID: ses.ID,
+				Row:       row,
+				Message:   msg,
+				CreatedAt: time.Now(),
+			}
+
+			if sErr := store.CreateComposeRecordImportError(ctx, svc.store, e); sErr != nil {
+				svc.log.Error("failed to store import error", zap.Uint64("sessionID", ses.ID), zap.Error(sErr))
+			}
+		} else {
+			ses.Progress.FailLog.RecordsTruncated = true
+		}
+
+		svc.flush(ctx, ses)
+
+		if ses.OnError == IMPORT_ON_ERROR_SKIP {
+			return nil
+		}
+		return err
+	}
+}
+
+// flush commits the progress of the running import
+func (svc *importSession) flush(ctx context.Context, ses *types.RecordImportSession) {
+	if err := svc.update(ctx, ses); err != nil {
+		svc.log.Error("failed to update import session", zap.Uint64("sessionID", ses.ID), zap.Error(err))
+	}
+}
+
+func (svc *importSession) update(ctx context.Context, ses *types.RecordImportSession) error {
+	ses.UpdatedAt = time.Now()
+	return store.UpdateComposeRecordImportSession(ctx, svc.store, ses)
+}
+
+func (svc *importSession) delete(ctx context.Context, ses *types.RecordImportSession) error {
+	if svc.isRunning(ses.ID) {
+		return fmt.Errorf("unable to remove import session: import session active")
+	}
+
+	if ses.Source != "" && svc.objects != nil {
+		if err := svc.objects.Remove(ses.Source); err != nil {
+			svc.log.Warn("failed to remove import source", zap.Uint64("sessionID", ses.ID), zap.Error(err))
+		}
+	}
+
+	return store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) error {
+		if err := store.DeleteComposeRecordImportErrorsBySessionID(ctx, s, ses.ID); err != nil {
+			return err
+		}
+
+		return store.DeleteComposeRecordImportSessionByID(ctx, s, ses.ID)
+	})
+}
+
+// lookup returns the import session of the current user
+func (svc *importSession) lookup(ctx context.Context, sessionID uint64) (*types.RecordImportSession, error) {
+	ses, err := store.LookupComposeRecordImportSessionByID(ctx, svc.store, sessionID)
+	if err != nil && err != store.ErrNotFound {
+		return nil, err
+	}
+
+	if ses == nil || ses.UserID != auth.GetIdentityFromContext(ctx).Identity() {
+		return nil, fmt.Errorf(recordImportSessionNotFound)
+	}
+
+	return ses, nil
+}
+
+// loadFailLog loads indexes of the failed records; they are stored separately
+func (svc *importSession) loadFailLog(ctx context.Context, ses *types.RecordImportSession) error {
+	if ses.Progress.FailLog == nil {
+		return nil
+	}
+
+	ee, err := svc.failed(ctx, ses.ID)
+	if err != nil {
+		return err
+	}
+
+	ses.Progress.FailLog.Records = make(types.RecordImportIndex, len(ee))
+	for i, e := range ee {
+		ses.Progress.FailLog.Records[i] = int(e.Row)
+	}
+
+	return nil
+}
+
+// failed returns the import errors ordered by row
+func (svc *importSession) failed(ctx context.Context, sessionID uint64) (types.RecordImportErrorSet, error) {
+	ee, _, err := store.SearchComposeRecordImportErrors(ctx, svc.store, types.RecordImportErrorFilter{SessionID: sessionID})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(ee, func(i, j int) bool {
+		return ee[i].Row < ee[j].Row
+	})
+
+	return ee, nil
+}
+
+// source decodes the stored import source
+func (svc *importSession) source(ctx context.Context, ses *types.RecordImportSession) (*resource.ResourceDataset, error) {
+	if svc.objects == nil {
+		return nil, fmt.Errorf("cannot open import source: store handler not set")
+	}
+
+	f, err := svc.objects.Open(ses.Source)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open import source: %w", err)
+	}
+
+	if c, ok := f.(io.Closer); ok {
+		defer c.Close()
+	}
+
+	dd, err := svc.decode(ctx, f, ses.Name, ses.ContentType)
+	if err != nil {
+		return nil, err
+	}
+
+	if ses.Sheet == "" {
+		return dd[0], nil
+	}
+
+	for _, d := range dd {
+		if s, ok := d.P.(sheetProvider); ok && s.Sheet() == ses.Sheet {
+			return d, nil
+		}
+	}
+
+	return nil, fmt.Errorf("cannot open import source: sheet %s not found", ses.Sheet)
+}
+
+// decode decodes the import source into datasets
+//
+// Sources with multiple sheets produce a dataset for each sheet.
+func (svc *importSession) decode(ctx context.Context, f io.ReadSeeker, name, contentType string) ([]*resource.ResourceDataset, error) {
+	// Decoders; We only need to do csv, json & xlsx here
+	cd := ecsv.Decoder()
 	jd := json.Decoder()
+	xd := xlsx.Decoder()
 
-	// This will really be at most 1
-	var err error
 	do := &envoy.DecoderOpts{
 		Name: name,
 		Path: "",
 	}
 
-	sh.Resources, err = func() ([]resource.Interface, error) {
+	rr, err := func() ([]resource.Interface, error) {
+		if xd.CanDecodeFile(f) || xd.CanDecodeMime(contentType) {
+			f.Seek(0, 0)
+			return xd.Decode(ctx, f, do)
+		}
+
+		f.Seek(0, 0)
 		if cd.CanDecodeFile(f) || cd.CanDecodeMime(contentType) {
 			f.Seek(0, 0)
 			return cd.Decode(ctx, f, do)
@@ -97,72 +760,89 @@ func (svc *importSession) Create(ctx context.Context, f io.ReadSeeker, name, con
 	}
 
 	// Get some metadata
-	n, ok := (sh.Resources[0]).(*resource.ResourceDataset)
-	if !ok {
-		// @todo move this logic to service and use action/error pattern
-		return nil, fmt.Errorf("compose.service.RecordImportFormatNotSupported")
+	dd := make([]*resource.ResourceDataset, 0, len(rr))
+	for _, r := range rr {
+		n, ok := r.(*resource.ResourceDataset)
+		if !ok {
+			// @todo move this logic to service and use action/error pattern
+			return nil, fmt.Errorf("compose.service.RecordImportFormatNotSupported")
+		}
+
+		dd = append(dd, n)
 	}
 
-	prepKey := func(k string) string {
-		return strings.TrimSpace(strings.ToLower(k))
+	if len(dd) == 0 {
+		return nil, fmt.Errorf("compose.service.RecordImportFormatNotSupported")
 	}
 
-	sh.Progress.EntryCount = n.P.Count()
-	for _, f := range n.P.Fields() {
-		sh.Fields[f] = ""
+	return dd, nil
+}
 
+// importKey returns the source field holding the record identifier
+func importKey(fields []string) (key string) {
+	for _, f := range fields {
 		// @todo improve this bit
-		k := prepKey(f)
+		k := strings.TrimSpace(strings.ToLower(f))
 		if k == "id" || k == "recordid" {
-			sh.Key = f
+			key = f
 		}
 	}
 
-	// Create it
-	svc.records = append(svc.records, sh)
-	return sh, nil
+	return
 }
 
-func (svc *importSession) FindByID(ctx context.Context, sessionID uint64) (*recordImportSession, error) {
+// runnerCtx returns the context with the identity of the user that started the import
+func (svc *importSession) runnerCtx(ctx context.Context, userID uint64) (context.Context, error) {
+	u, err := store.LookupUserByID(ctx, svc.store, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load import user %d: %w", userID, err)
+	}
+
+	rr, _, err := store.SearchRoles(ctx, svc.store, systemTypes.RoleFilter{MemberID: u.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	u.SetRoles(rr.IDs()...)
+	return auth.SetIdentityToContext(ctx, u), nil
+}
+
+func (svc *importSession) claim(sessionID uint64) bool {
 	svc.l.Lock()
 	defer svc.l.Unlock()
 
-	userID := auth.GetIdentityFromContext(ctx).Identity()
-	i := svc.indexOf(userID, sessionID)
-	if i >= 0 {
-		return svc.records[i], nil
+	if svc.running[sessionID] {
+		return false
 	}
-	return nil, fmt.Errorf("compose.service.RecordImportSessionNotFound")
-}
 
-// https://stackoverflow.com/a/37335777
-func remove(s recordSet, i int) recordSet {
-	s[len(s)-1], s[i] = s[i], s[len(s)-1]
-	return s[:len(s)-1]
+	svc.running[sessionID] = true
+	return true
 }
 
-func (svc *importSession) DeleteByID(ctx context.Context, sessionID uint64) error {
+func (svc *importSession) release(sessionID uint64) {
 	svc.l.Lock()
 	defer svc.l.Unlock()
 
-	userID := auth.GetIdentityFromContext(ctx).Identity()
-	i := svc.indexOf(userID, sessionID)
-
-	if i >= 0 {
-		svc.records = remove(svc.records, i)
-	}
-	return nil
+	delete(svc.running, sessionID)
 }
 
-// @todo run this in some interval
-func (svc *importSession) clean(ctx context.Context) {
+func (svc *importSession) isRunning(sessionID uint64) bool {
 	svc.l.Lock()
 	defer svc.l.Unlock()
 
-	for i := len(svc.records) - 1; i >= 0; i-- {
-		r := svc.records[i]
-		if time.Now().After(r.UpdatedAt.Add(time.Hour * 24 * 3)) {
-			svc.records = remove(svc.records, i)
+	return svc.running[sessionID]
+}
+
+func (p *importOffsetProvider) Reset() error {
+	if err := p.provider.Reset(); err != nil {
+		return err
+	}
+
+	for i := uint64(0); i < p.offset; i++ {
+		if r, err := p.provider.Next(); err != nil || r == nil {
+			return err
 		}
 	}
+
+	return nil
 }
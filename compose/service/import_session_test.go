@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/envoy/resource"
+	"github.com/cortezaproject/corteza-server/pkg/objstore/plain"
+	"github.com/cortezaproject/corteza-server/store"
+	"github.com/cortezaproject/corteza-server/store/adapters/rdbms/drivers/sqlite"
+	systemTypes "github.com/cortezaproject/corteza-server/system/types"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestImportSession_watch(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		ctx    = context.Background()
+		s, err = sqlite.ConnectInMemory(ctx)
+
+		imported int32
+	)
+
+	req.NoError(err)
+	req.NoError(store.Upgrade(ctx, zap.NewNop(), s))
+	req.NoError(store.TruncateComposeRecordImportSessions(ctx, s))
+
+	objects, err := plain.NewWithAfero(afero.NewMemMapFs(), "import")
+	req.NoError(err)
+
+	var (
+		u = &systemTypes.User{ID: nextID(), Email: "import@test.tld", CreatedAt: time.Now()}
+
+		importer = func(ctx context.Context, s store.Storer, ok func(), nok func(error) error, rr ...resource.Interface) error {
+			atomic.AddInt32(&imported, 1)
+			return nil
+		}
+
+		newSvc = func() *importSession {
+			return &importSession{
+				store:    s,
+				objects:  objects,
+				importer: importer,
+				log:      zap.NewNop(),
+				running:  make(map[uint64]bool),
+			}
+		}
+
+		svc = newSvc()
+
+		// creates the import session that was last updated at the given time
+		makeSession = func(updatedAt time.Time, running bool) *types.RecordImportSession {
+			ses, err := svc.Create(auth.SetIdentityToContext(ctx, u), strings.NewReader("name\nfoo\nbar\n"), "import.csv", "text/csv", 1, 2)
+			req.NoError(err)
+
+			if running {
+				startedAt := updatedAt
+				ses.Progress.StartedAt = &startedAt
+			}
+
+			ses.UpdatedAt = updatedAt
+			req.NoError(store.UpdateComposeRecordImportSession(ctx, s, ses))
+			return ses
+		}
+
+		lookup = func(sessionID uint64) *types.RecordImportSession {
+			ses, err := store.LookupComposeRecordImportSessionByID(ctx, s, sessionID)
+			req.NoError(err)
+			return ses
+		}
+
+		finished = func(sessionID uint64) func() bool {
+			return func() bool {
+				return !svc.isRunning(sessionID) && lookup(sessionID).Progress.FinishedAt != nil
+			}
+		}
+	)
+
+	req.NoError(store.CreateUser(ctx, s, u))
+
+	t.Run("stale import is resumed", func(t *testing.T) {
+		atomic.StoreInt32(&imported, 0)
+		ses := makeSession(time.Now().Add(-importSessionStaleAfter*2), true)
+
+		svc.watch(ctx)
+
+		req.Eventually(finished(ses.ID), time.Second, time.Millisecond*10)
+		req.Equal(int32(1), atomic.LoadInt32(&imported))
+	})
+
+	t.Run("running import is not resumed", func(t *testing.T) {
+		ses := makeSession(time.Now(), true)
+
+		svc.watch(ctx)
+
+		req.Nil(lookup(ses.ID).Progress.FinishedAt)
+		req.WithinDuration(ses.UpdatedAt, lookup(ses.ID).UpdatedAt, time.Second)
+		req.False(svc.isRunning(ses.ID))
+	})
+
+	t.Run("stale import is resumed by a single node", func(t *testing.T) {
+		atomic.StoreInt32(&imported, 0)
+
+		var (
+			ses = makeSession(time.Now().Add(-importSessionStaleAfter*2), true)
+
+			// both nodes loaded the session while it was stale
+			a = lookup(ses.ID)
+			b = lookup(ses.ID)
+		)
+
+		req.NoError(svc.resume(ctx, a))
+		req.NoError(newSvc().resume(ctx, b))
+
+		req.Eventually(finished(ses.ID), time.Second, time.Millisecond*10)
+		req.Equal(int32(1), atomic.LoadInt32(&imported))
+	})
+
+	t.Run("import is started by a single request", func(t *testing.T) {
+		ses := makeSession(time.Now(), false)
+
+		// both requests loaded the session before it was started
+		a := lookup(ses.ID)
+		b := lookup(ses.ID)
+
+		start := func(ses *types.RecordImportSession) bool {
+			var (
+				loadedAt = ses.UpdatedAt
+				now      = time.Now()
+			)
+
+			ses.Progress.StartedAt = &now
+			ses.UpdatedAt = now
+
+			ok, err := store.StartComposeRecordImportSession(ctx, s, ses, loadedAt)
+			req.NoError(err)
+			return ok
+		}
+
+		req.True(start(a))
+		req.False(start(b))
+	})
+
+	t.Run("progress is committed with the record", func(t *testing.T) {
+		var (
+			ses = makeSession(time.Now(), true)
+			r   = &importRun{ses: ses}
+			is  = importStore{Storer: s, r: r}
+		)
+
+		req.Error(r.write(ctx, is.Storer, func(ctx context.Context, s store.Storer) error {
+			return fmt.Errorf("failed")
+		}))
+		req.Equal(uint64(0), lookup(ses.ID).Progress.Completed)
+		req.False(r.written)
+
+		req.NoError(r.write(ctx, is.Storer, func(ctx context.Context, s store.Storer) error {
+			return nil
+		}))
+		req.Equal(uint64(1), lookup(ses.ID).Progress.Completed)
+		req.True(r.written)
+
+		// not counted again
+		r.ok(ctx, svc)()
+		req.Equal(uint64(1), ses.Progress.Completed)
+		req.False(r.written)
+	})
+
+	t.Run("old sessions are removed", func(t *testing.T) {
+		ses := makeSession(time.Now().Add(-importSessionMaxAge*2), false)
+
+		svc.watch(ctx)
+
+		_, err := store.LookupComposeRecordImportSessionByID(ctx, s, ses.ID)
+		req.ErrorIs(err, store.ErrNotFound)
+	})
+}
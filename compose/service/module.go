@@ -250,6 +250,11 @@ func (svc module) Create(ctx context.Context, new *types.Module) (*types.Module,
 			if systemFields[f.Name] {
 				return ModuleErrFieldNameReserved()
 			}
+
+			// encrypted values can not be compared
+			if f.Options.IsEncrypted() && f.Options.IsUnique() {
+				return ModuleErrFieldEncryptedUnique()
+			}
 		}
 
 		if err != nil {
@@ -391,6 +396,14 @@ func (svc module) updater(ctx context.Context, namespaceID, moduleID uint64, act
 			return err
 		}
 
+		if m.DeletedAt == nil && changes&(moduleChanged|moduleFieldsChanged) > 0 {
+			// make sure module and fields do not exceed
+			// sensitivity level of the connection
+			if err = svc.validateModuleWithDAL(ctx, ns, m); err != nil {
+				return err
+			}
+		}
+
 		if changes&moduleChanged > 0 {
 			if err = store.UpdateComposeModule(ctx, svc.store, m); err != nil {
 				return err
@@ -549,6 +562,16 @@ func (svc module) handleUpdate(ctx context.Context, upd *types.Module) moduleUpd
 
 		}
 
+		if res.ModelConfig.RecordRevisions != upd.ModelConfig.RecordRevisions {
+			changes |= moduleChanged
+			res.ModelConfig.RecordRevisions = upd.ModelConfig.RecordRevisions
+		}
+
+		if res.ModelConfig.SensitivityLevel != upd.ModelConfig.SensitivityLevel {
+			changes |= moduleChanged
+			res.ModelConfig.SensitivityLevel = upd.ModelConfig.SensitivityLevel
+		}
+
 		// @todo make field-change detection more optimal
 		if !reflect.DeepEqual(res.Fields, upd.Fields) {
 			changes |= moduleFieldsChanged
@@ -641,6 +664,11 @@ func updateModuleFields(ctx context.Context, s store.Storer, new, old *types.Mod
 		if f.ModuleID != new.ID {
 			return fmt.Errorf("module id of field %q does not match the module", f.Name)
 		}
+
+		// encrypted values can not be compared
+		if f.Options.IsEncrypted() && f.Options.IsUnique() {
+			return ModuleErrFieldEncryptedUnique()
+		}
 	}
 
 	// Delete any missing module fields
@@ -678,6 +678,39 @@ func ModuleErrFieldNameReserved(mm ...*moduleActionProps) *errors.Error {
 	return e
 }
 
+// ModuleErrFieldEncryptedUnique returns "compose:module.fieldEncryptedUnique" as *errors.Error
+//
+// This function is auto-generated.
+//
+func ModuleErrFieldEncryptedUnique(mm ...*moduleActionProps) *errors.Error {
+	var p = &moduleActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("values of encrypted fields can not be unique", nil),
+
+		errors.Meta("type", "fieldEncryptedUnique"),
+		errors.Meta("resource", "compose:module"),
+
+		errors.Meta(modulePropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "compose"),
+		errors.Meta(locale.ErrorMetaKey{}, "module.errors.fieldEncryptedUnique"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
 // ModuleErrStaleData returns "compose:module.staleData" as *errors.Error
 //
 //
@@ -19,6 +19,7 @@ type (
 		ReloadModel(ctx context.Context, models ...*dal.Model) (err error)
 		AddModel(ctx context.Context, models ...*dal.Model) (err error)
 		RemoveModel(ctx context.Context, models ...*dal.Model) (err error)
+		ValidateModel(ctx context.Context, models ...*dal.Model) (err error)
 	}
 )
 
@@ -116,6 +117,8 @@ func (svc *module) moduleToModel(ctx context.Context, ns *types.Namespace, mod *
 		ResourceID:   mod.ID,
 		ResourceType: types.ModuleResourceType,
 		Resource:     mod.RbacResource(),
+
+		SensitivityLevel: mod.ModelConfig.SensitivityLevel,
 	}
 
 	// Handle user-defined fields
@@ -277,6 +280,17 @@ func (svc *module) moduleFieldToAttribute(getCodec func(f *types.ModuleField) da
 		return nil, fmt.Errorf("invalid field %s: kind %s not supported", f.Name, f.Kind)
 	}
 
+	out.SensitivityLevel = f.SensitivityLevel
+
+	if f.Options.IsEncrypted() {
+		// Encrypted values are stored as text and
+		// can not be used for filtering or sorting
+		out.Type = &dal.TypeText{}
+		out.Store = &dal.CodecEncrypted{Codec: out.Store}
+		out.Filterable = false
+		out.Sortable = false
+	}
+
 	return
 }
 
@@ -355,3 +369,13 @@ func (svc *module) addModuleToDAL(ctx context.Context, ns *types.Namespace, mod
 
 	return
 }
+
+// validateModuleWithDAL checks if the module can be (re)registered with DAL
+func (svc *module) validateModuleWithDAL(ctx context.Context, ns *types.Namespace, mod *types.Module) (err error) {
+	model, err := svc.moduleToModel(ctx, ns, mod)
+	if err != nil {
+		return
+	}
+
+	return svc.dal.ValidateModel(ctx, model)
+}
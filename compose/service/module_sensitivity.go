@@ -0,0 +1,54 @@
+package service
+
+import (
+	"github.com/cortezaproject/corteza-server/compose/types"
+	systemService "github.com/cortezaproject/corteza-server/system/service"
+)
+
+type (
+	sensitivityLevelChecker interface {
+		WithinSensitivityLevel(level, threshold uint64) bool
+	}
+)
+
+// SensitiveFields returns module fields with sensitivity level above the threshold
+//
+// When the module itself is above the threshold, all of its fields are returned.
+// Zero threshold means that no threshold is configured; no fields are returned.
+func SensitiveFields(sl sensitivityLevelChecker, m *types.Module, threshold uint64) (out types.ModuleFieldSet) {
+	if threshold == 0 {
+		return
+	}
+
+	moduleExceeds := !sl.WithinSensitivityLevel(m.ModelConfig.SensitivityLevel, threshold)
+	for _, f := range m.Fields {
+		if moduleExceeds || !sl.WithinSensitivityLevel(f.SensitivityLevel, threshold) {
+			out = append(out, f)
+		}
+	}
+
+	return
+}
+
+// ExportSensitiveFields returns module fields that are left out from the exports
+//
+// Threshold is configured with the compose.record.export.sensitivity-level setting.
+func ExportSensitiveFields(sl sensitivityLevelChecker, m *types.Module) types.ModuleFieldSet {
+	return SensitiveFields(sl, m, systemService.CurrentSettings.Compose.Record.Export.SensitivityLevel)
+}
+
+// RedactExportedRecords removes values of the fields that are left out from the exports
+//
+// Used by all of the paths records are exported through.
+func RedactExportedRecords(sl sensitivityLevelChecker, m *types.Module, rr ...*types.Record) {
+	ff := ExportSensitiveFields(sl, m)
+	if len(ff) == 0 {
+		return
+	}
+
+	for _, r := range rr {
+		r.Values, _ = r.Values.Filter(func(v *types.RecordValue) (bool, error) {
+			return !ff.HasName(v.Name), nil
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	// levels are compared by their ID
+	sensitivityLevelByID struct{}
+)
+
+func (sensitivityLevelByID) WithinSensitivityLevel(level, threshold uint64) bool {
+	return level <= threshold
+}
+
+func TestSensitiveFields(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		m = &types.Module{
+			Fields: types.ModuleFieldSet{
+				{Name: "name"},
+				{Name: "email", SensitivityLevel: 2},
+				{Name: "ssn", SensitivityLevel: 3},
+			},
+		}
+
+		names = func(ff types.ModuleFieldSet) (out []string) {
+			for _, f := range ff {
+				out = append(out, f.Name)
+			}
+			return
+		}
+	)
+
+	req.Empty(SensitiveFields(sensitivityLevelByID{}, m, 0))
+	req.Equal([]string{"ssn"}, names(SensitiveFields(sensitivityLevelByID{}, m, 2)))
+	req.Equal([]string{"email", "ssn"}, names(SensitiveFields(sensitivityLevelByID{}, m, 1)))
+
+	m.ModelConfig.SensitivityLevel = 3
+	req.Len(SensitiveFields(sensitivityLevelByID{}, m, 2), 3)
+}
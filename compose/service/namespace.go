@@ -76,6 +76,12 @@ type (
 		DeleteByID(ctx context.Context, namespaceID uint64) error
 	}
 
+	// namespaceDecoder decodes namespace resources from the store
+	namespaceDecoder func(ctx context.Context, s store.Storer, namespaceID uint64) (resource.InterfaceSet, error)
+
+	// resourceEncoder encodes the resources into the store
+	resourceEncoder func(ctx context.Context, s store.Storer, nn resource.InterfaceSet) error
+
 	namespaceUpdateHandler func(ctx context.Context, ns *types.Namespace) (namespaceChanges, error)
 	namespaceChanges       uint8
 )
@@ -798,3 +804,60 @@ func toLabeledNamespaces(set []*types.Namespace) []label.LabeledResource {
 
 	return ll
 }
+
+// NamespaceCloner wraps namespace service's Clone with the given decoder and encoder
+//
+// Handles of the cloned modules, pages and charts are renamed
+// according to the handles map (old handle => new handle).
+func NamespaceCloner(svc NamespaceService, s store.Storer, dec namespaceDecoder, enc resourceEncoder) func(ctx context.Context, namespaceID uint64, dup *types.Namespace, handles map[string]string) (*types.Namespace, error) {
+	if dec == nil || enc == nil {
+		// cloning not supported
+		return nil
+	}
+
+	return func(ctx context.Context, namespaceID uint64, dup *types.Namespace, handles map[string]string) (*types.Namespace, error) {
+		var (
+			decoder = func() (nn resource.InterfaceSet, err error) {
+				if nn, err = dec(ctx, s, namespaceID); err != nil {
+					return
+				}
+
+				renameClonedHandles(nn, handles)
+				return
+			}
+
+			encoder = func(nn resource.InterfaceSet) error {
+				return enc(ctx, s, nn)
+			}
+		)
+
+		return svc.Clone(ctx, namespaceID, dup, decoder, encoder)
+	}
+}
+
+// renameClonedHandles changes handles of modules, pages and charts
+//
+// Resource identifiers are kept as they are so references
+// between the cloned resources are still resolved.
+func renameClonedHandles(nn resource.InterfaceSet, handles map[string]string) {
+	if len(handles) == 0 {
+		return
+	}
+
+	rename := func(h *string) {
+		if n, has := handles[*h]; has && *h != "" {
+			*h = n
+		}
+	}
+
+	for _, r := range nn {
+		switch r := r.(type) {
+		case *resource.ComposeModule:
+			rename(&r.Res.Handle)
+		case *resource.ComposePage:
+			rename(&r.Res.Handle)
+		case *resource.ComposeChart:
+			rename(&r.Res.Handle)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/envoy/resource"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenameClonedHandles(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		mod   = resource.NewComposeModule(&types.Module{ID: 1, Handle: "mod"}, "ns")
+		page  = resource.NewComposePage(&types.Page{ID: 2, Handle: "page"}, "ns", "", "")
+		chart = resource.NewComposeChart(&types.Chart{ID: 3, Handle: "chart"}, "ns", nil)
+		other = resource.NewComposeModule(&types.Module{ID: 4}, "ns")
+	)
+
+	renameClonedHandles(resource.InterfaceSet{mod, page, chart, other}, map[string]string{
+		"mod":   "mod_copy",
+		"chart": "chart_copy",
+		"":      "empty",
+	})
+
+	req.Equal("mod_copy", mod.Res.Handle)
+	req.Equal("page", page.Res.Handle)
+	req.Equal("chart_copy", chart.Res.Handle)
+	req.Empty(other.Res.Handle)
+
+	// references are still resolved with the old handle
+	req.True(mod.Identifiers().HasAny(resource.MakeIdentifiers("mod")))
+}
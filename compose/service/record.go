@@ -2,12 +2,9 @@ package service
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"regexp"
-	"sort"
 	"strconv"
-	"time"
 
 	"github.com/cortezaproject/corteza-server/compose/service/event"
 	"github.com/cortezaproject/corteza-server/compose/service/values"
@@ -17,7 +14,6 @@ import (
 	"github.com/cortezaproject/corteza-server/pkg/corredor"
 	"github.com/cortezaproject/corteza-server/pkg/dal"
 	"github.com/cortezaproject/corteza-server/pkg/dal/capabilities"
-	"github.com/cortezaproject/corteza-server/pkg/envoy/resource"
 	"github.com/cortezaproject/corteza-server/pkg/errors"
 	"github.com/cortezaproject/corteza-server/pkg/eventbus"
 	"github.com/cortezaproject/corteza-server/pkg/label"
@@ -88,6 +84,9 @@ type (
 		Report(ctx context.Context, namespaceID, moduleID uint64, metrics, dimensions, filter string) (interface{}, error)
 		Find(ctx context.Context, filter types.RecordFilter) (set types.RecordSet, f types.RecordFilter, err error)
 		RecordExport(context.Context, types.RecordFilter) error
+		RedactExportFields(m *types.Module, fields []string) []string
+
+		Reencrypt(ctx context.Context, m *types.Module) (uint, error)
 		RecordImport(context.Context, error) error
 
 		Datasource(context.Context, *report.LoadStepDefinition) (report.Datasource, error)
@@ -106,48 +105,12 @@ type (
 
 		TriggerScript(ctx context.Context, namespaceID, moduleID, recordID uint64, rvs types.RecordValueSet, script string) (*types.Module, *types.Record, error)
 
-		EventEmitting(enable bool)
-	}
-
-	recordImportSession struct {
-		Name        string `json:"-"`
-		SessionID   uint64 `json:"sessionID,string"`
-		UserID      uint64 `json:"userID,string"`
-		NamespaceID uint64 `json:"namespaceID,string"`
-		ModuleID    uint64 `json:"moduleID,string"`
-
-		OnError  string                `json:"onError"`
-		Fields   map[string]string     `json:"fields"`
-		Key      string                `json:"key"`
-		Progress *RecordImportProgress `json:"progress"`
-
-		CreatedAt time.Time `json:"createdAt"`
-		UpdatedAt time.Time `json:"updatedAt"`
-
-		Resources []resource.Interface `json:"-"`
-	}
-
-	RecordImportProgress struct {
-		StartedAt  *time.Time `json:"startedAt"`
-		FinishedAt *time.Time `json:"finishedAt"`
-		EntryCount uint64     `json:"entryCount"`
-		Completed  uint64     `json:"completed"`
-		Failed     uint64     `json:"failed"`
-		FailReason string     `json:"failReason,omitempty"`
+		SearchRevisions(ctx context.Context, namespaceID, moduleID, recordID uint64, f types.RecordRevisionFilter) (types.RecordRevisionSet, types.RecordRevisionFilter, error)
+		DiffRevisions(ctx context.Context, namespaceID, moduleID, recordID uint64, from, to uint) (*types.RecordRevisionDiff, error)
+		RestoreRevision(ctx context.Context, namespaceID, moduleID, recordID uint64, revision uint) (*types.Record, error)
 
-		FailLog *FailLog `json:"failLog,omitempty"`
-	}
-
-	FailLog struct {
-		// Records holds an array of record indexes
-		Records          RecordIndex `json:"records"`
-		RecordsTruncated bool        `json:"recordsTruncated"`
-		// Errors specifies a map of occurred errors & the number of
-		Errors ErrorIndex `json:"errors"`
+		EventEmitting(enable bool)
 	}
-
-	RecordIndex []int
-	ErrorIndex  map[string]int
 )
 
 func Record(dal dalDML) RecordService {
@@ -368,6 +331,26 @@ func (svc record) RecordExport(ctx context.Context, f types.RecordFilter) (err e
 	return svc.recordAction(ctx, &recordActionProps{filter: &f}, RecordActionExport, err)
 }
 
+// RedactExportFields removes fields with sensitivity level above the export threshold
+func (svc record) RedactExportFields(m *types.Module, fields []string) (out []string) {
+	var (
+		redacted = make(map[string]bool)
+	)
+
+	for _, f := range ExportSensitiveFields(svc.dal, m) {
+		redacted[f.Name] = true
+	}
+
+	out = make([]string, 0, len(fields))
+	for _, f := range fields {
+		if !redacted[f] {
+			out = append(out, f)
+		}
+	}
+
+	return
+}
+
 // Bulk handles provided set of bulk record operations.
 // It's able to create, update or delete records in a single transaction.
 func (svc record) Bulk(ctx context.Context, oo ...*types.RecordBulkOperation) (rr types.RecordSet, err error) {
@@ -536,17 +519,22 @@ func (svc record) create(ctx context.Context, new *types.Record) (rec *types.Rec
 		return nil, RecordErrValueInput().Wrap(rve)
 	}
 
-	err = svc.dal.Create(ctx, m.ModelFilter(), svc.recCreateCapabilities(m), svc.recToGetters(new)...)
-	if err != nil {
-		return
-	}
-
-	if err != nil {
+	if err = svc.dal.Create(ctx, m.ModelFilter(), svc.recCreateCapabilities(m), svc.recToGetters(new)...); err != nil {
 		return nil, err
 	}
 
-	if err = label.Create(ctx, svc.store, new); err != nil {
-		return
+	// DAL does not write over the store transaction;
+	// record is removed when revision or labels can not be stored
+	err = store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) error {
+		if err = svc.storeRevision(ctx, s, m, types.RecordRevisionCreate, nil, new); err != nil {
+			return err
+		}
+
+		return label.Create(ctx, s, new)
+	})
+
+	if err != nil {
+		return nil, svc.revert(err, svc.dal.Delete(ctx, m.ModelFilter(), svc.recDeleteCapabilities(m), new))
 	}
 
 	// ensure module ref is set before running through records workflows and scripts
@@ -720,6 +708,12 @@ func RecordValueDefaults(m *types.Module, vv types.RecordValueSet) (out types.Re
 // Raw update function that is responsible for value validation, event dispatching
 // and update.
 func (svc record) update(ctx context.Context, upd *types.Record) (rec *types.Record, err error) {
+	return svc.updateAs(ctx, upd, types.RecordRevisionUpdate)
+}
+
+// updateAs updates the record and stores the revision
+// with the given operation
+func (svc record) updateAs(ctx context.Context, upd *types.Record, op types.RecordRevisionOperation) (rec *types.Record, err error) {
 	var (
 		aProps    = &recordActionProps{changed: upd}
 		invokerID = auth.GetIdentityFromContext(ctx).Identity()
@@ -786,6 +780,12 @@ func (svc record) update(ctx context.Context, upd *types.Record) (rec *types.Rec
 		return nil, RecordErrValueInput().Wrap(rve)
 	}
 
+	if err = svc.dal.Update(ctx, m.ModelFilter(), svc.recUpdateCapabilities(m), svc.recToGetter(upd)); err != nil {
+		return nil, err
+	}
+
+	// DAL does not write over the store transaction;
+	// previous record is written back when revision or labels can not be stored
 	err = store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) error {
 		if label.Changed(old.Labels, upd.Labels) {
 			if err = label.Update(ctx, s, upd); err != nil {
@@ -793,11 +793,11 @@ func (svc record) update(ctx context.Context, upd *types.Record) (rec *types.Rec
 			}
 		}
 
-		return svc.dal.Update(ctx, m.ModelFilter(), svc.recUpdateCapabilities(m), svc.recToGetter(upd))
+		return svc.storeRevision(ctx, s, m, op, old.Values, upd)
 	})
 
 	if err != nil {
-		return nil, err
+		return nil, svc.revert(err, svc.dal.Update(ctx, m.ModelFilter(), svc.recUpdateCapabilities(m), svc.recToGetter(old)))
 	}
 
 	// ensure module ref is set before running through records workflows and scripts
@@ -979,14 +979,29 @@ func (svc record) delete(ctx context.Context, namespaceID, moduleID, recordID ui
 		}
 	}
 
+	var (
+		deletedAt = del.DeletedAt
+		deletedBy = del.DeletedBy
+	)
+
 	del.DeletedAt = now()
 	del.DeletedBy = invokerID
 
-	err = svc.dal.Update(ctx, m.ModelFilter(), svc.recDeleteCapabilities(m), del)
-	if err != nil {
+	if err = svc.dal.Update(ctx, m.ModelFilter(), svc.recDeleteCapabilities(m), del); err != nil {
 		return nil, err
 	}
 
+	// DAL does not write over the store transaction;
+	// record is restored when revision can not be stored
+	err = store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) error {
+		return svc.storeRevision(ctx, s, m, types.RecordRevisionDelete, del.Values, del)
+	})
+
+	if err != nil {
+		del.DeletedAt, del.DeletedBy = deletedAt, deletedBy
+		return nil, svc.revert(err, svc.dal.Update(ctx, m.ModelFilter(), svc.recDeleteCapabilities(m), del))
+	}
+
 	// ensure module ref is set before running through records workflows and scripts
 	del.SetModule(m)
 
@@ -1462,38 +1477,3 @@ func toLabeledRecords(set []*types.Record) []label.LabeledResource {
 
 	return ll
 }
-
-func (ei ErrorIndex) Add(err string) {
-	if _, has := ei[err]; has {
-		ei[err]++
-	} else {
-		ei[err] = 1
-	}
-}
-
-func (ri RecordIndex) MarshalJSON() ([]byte, error) {
-	sort.Ints(ri)
-
-	rr := make([][]int, 0, len(ri))
-	start := -1
-	crt := -1
-
-	for i := 0; i < len(ri); i++ {
-		if start == -1 {
-			start = ri[i]
-			crt = ri[i]
-			continue
-		}
-
-		// If the index increases for more then 1, the set is complete
-		if ri[i]-crt > 1 {
-			rr = append(rr, []int{start, crt})
-			start = ri[i]
-		}
-
-		crt = ri[i]
-	}
-
-	rr = append(rr, []int{start, crt})
-	return json.Marshal(rr)
-}
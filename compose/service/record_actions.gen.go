@@ -29,6 +29,7 @@ type (
 		bulkOperation string
 		field         string
 		value         string
+		revision      uint
 		valueErrors   *types.RecordValueErrorSet
 	}
 
@@ -145,6 +146,17 @@ func (p *recordActionProps) setValue(value string) *recordActionProps {
 	return p
 }
 
+// setRevision updates recordActionProps's revision
+//
+// Allows method chaining
+//
+// This function is auto-generated.
+//
+func (p *recordActionProps) setRevision(revision uint) *recordActionProps {
+	p.revision = revision
+	return p
+}
+
 // setValueErrors updates recordActionProps's valueErrors
 //
 // Allows method chaining
@@ -199,6 +211,7 @@ func (p recordActionProps) Serialize() actionlog.Meta {
 	m.Set("bulkOperation", p.bulkOperation, true)
 	m.Set("field", p.field, true)
 	m.Set("value", p.value, true)
+	m.Set("revision", p.revision, true)
 	if p.valueErrors != nil {
 		m.Set("valueErrors.set", p.valueErrors.Set, true)
 	}
@@ -325,6 +338,7 @@ func (p recordActionProps) Format(in string, err error) string {
 	pairs = append(pairs, "{{bulkOperation}}", fns(p.bulkOperation))
 	pairs = append(pairs, "{{field}}", fns(p.field))
 	pairs = append(pairs, "{{value}}", fns(p.value))
+	pairs = append(pairs, "{{revision}}", fns(p.revision))
 
 	if p.valueErrors != nil {
 		// replacement for "{{valueErrors}}" (in order how fields are defined)
@@ -532,6 +546,66 @@ func RecordActionUndelete(props ...*recordActionProps) *recordAction {
 	return a
 }
 
+// RecordActionSearchRevisions returns "compose:record.searchRevisions" action
+//
+// This function is auto-generated.
+//
+func RecordActionSearchRevisions(props ...*recordActionProps) *recordAction {
+	a := &recordAction{
+		timestamp: time.Now(),
+		resource:  "compose:record",
+		action:    "searchRevisions",
+		log:       "searched for revisions of {{record}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// RecordActionDiffRevisions returns "compose:record.diffRevisions" action
+//
+// This function is auto-generated.
+//
+func RecordActionDiffRevisions(props ...*recordActionProps) *recordAction {
+	a := &recordAction{
+		timestamp: time.Now(),
+		resource:  "compose:record",
+		action:    "diffRevisions",
+		log:       "compared revisions of {{record}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// RecordActionRestoreRevision returns "compose:record.restoreRevision" action
+//
+// This function is auto-generated.
+//
+func RecordActionRestoreRevision(props ...*recordActionProps) *recordAction {
+	a := &recordAction{
+		timestamp: time.Now(),
+		resource:  "compose:record",
+		action:    "restoreRevision",
+		log:       "restored {{record}} to revision {{revision}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
 // RecordActionImport returns "compose:record.import" action
 //
 // This function is auto-generated.
@@ -1330,6 +1404,72 @@ func RecordErrNotAllowedToChangeFieldValue(mm ...*recordActionProps) *errors.Err
 	return e
 }
 
+// RecordErrRevisionsDisabled returns "compose:record.revisionsDisabled" as *errors.Error
+//
+// This function is auto-generated.
+//
+func RecordErrRevisionsDisabled(mm ...*recordActionProps) *errors.Error {
+	var p = &recordActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("record revisions are not enabled on module", nil),
+
+		errors.Meta("type", "revisionsDisabled"),
+		errors.Meta("resource", "compose:record"),
+
+		errors.Meta(recordPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "compose"),
+		errors.Meta(locale.ErrorMetaKey{}, "record.errors.revisionsDisabled"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// RecordErrRevisionNotFound returns "compose:record.revisionNotFound" as *errors.Error
+//
+// This function is auto-generated.
+//
+func RecordErrRevisionNotFound(mm ...*recordActionProps) *errors.Error {
+	var p = &recordActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("record revision not found", nil),
+
+		errors.Meta("type", "revisionNotFound"),
+		errors.Meta("resource", "compose:record"),
+
+		errors.Meta(recordPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "compose"),
+		errors.Meta(locale.ErrorMetaKey{}, "record.errors.revisionNotFound"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
 // RecordErrImportSessionAlreadActive returns "compose:record.importSessionAlreadActive" as *errors.Error
 //
 //
@@ -17,6 +17,8 @@ type (
 		Lookup(ctx context.Context, m dal.ModelFilter, capabilities capabilities.Set, lookup dal.ValueGetter, dst dal.ValueSetter) (err error)
 		Delete(ctx context.Context, m dal.ModelFilter, capabilities capabilities.Set, pkv dal.ValueGetter) (err error)
 		Truncate(ctx context.Context, m dal.ModelFilter, capabilities capabilities.Set) (err error)
+
+		WithinSensitivityLevel(level, threshold uint64) bool
 	}
 )
 
@@ -141,5 +141,17 @@ func (svc record) Datasource(ctx context.Context, ld *report.LoadStepDefinition)
 		ld.Columns = cols
 	}
 
+	// Sensitive fields are never exported
+	if ff := ExportSensitiveFields(svc.dal, mod); len(ff) > 0 {
+		cols := make(report.FrameColumnSet, 0, len(ld.Columns))
+		for _, c := range ld.Columns {
+			if !ff.HasName(c.Name) {
+				cols = append(cols, c)
+			}
+		}
+
+		ld.Columns = cols
+	}
+
 	return store.ComposeRecordDatasource(ctx, svc.store, mod, ld)
 }
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+)
+
+const (
+	reencryptBatchSize = 500
+)
+
+// Reencrypt stores all records of the module again so that values
+// of the encrypted fields are encrypted with the current primary key
+//
+// Values stored before the field was marked as encrypted are encrypted as well.
+// Access control is not checked; function is intended to be used
+// from the command line.
+func (svc record) Reencrypt(ctx context.Context, m *types.Module) (n uint, err error) {
+	var (
+		encrypted bool
+		set       types.RecordSet
+
+		f = types.RecordFilter{
+			ModuleID:    m.ID,
+			NamespaceID: m.NamespaceID,
+			Deleted:     filter.StateInclusive,
+		}
+	)
+
+	for _, fld := range m.Fields {
+		encrypted = encrypted || fld.Options.IsEncrypted()
+	}
+
+	if !encrypted {
+		return
+	}
+
+	f.Limit = reencryptBatchSize
+
+	for {
+		if set, f, err = svc.reencryptBatch(ctx, m, f); err != nil {
+			return
+		}
+
+		n += uint(len(set))
+
+		if len(set) < reencryptBatchSize {
+			return
+		}
+
+		f.PageCursor = f.NextPage
+	}
+}
+
+func (svc record) reencryptBatch(ctx context.Context, m *types.Module, f types.RecordFilter) (set types.RecordSet, _ types.RecordFilter, err error) {
+	dalFilter := f.ToFilter()
+	if m.ModelConfig.Partitioned {
+		dalFilter = f.ToConstraintedFilter(m.ModelConfig.Constraints)
+	}
+
+	iter, err := svc.dal.Search(ctx, m.ModelFilter(), svc.recSearchCapabilities(m, f), dalFilter)
+	if err != nil {
+		return
+	}
+
+	defer iter.Close()
+
+	if set, f, err = svc.drainIterator(ctx, iter, f, m); err != nil {
+		return
+	}
+
+	for _, r := range set {
+		// values are decrypted when fetched and
+		// encrypted with the primary key when stored
+		if err = svc.dal.Update(ctx, m.ModelFilter(), svc.recUpdateCapabilities(m), r); err != nil {
+			return
+		}
+	}
+
+	return set, f, nil
+}
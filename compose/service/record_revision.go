@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/errors"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/cortezaproject/corteza-server/pkg/wfexec"
+	"github.com/cortezaproject/corteza-server/store"
+)
+
+const (
+	// how many times is the next revision number tried
+	// when the record is changed concurrently
+	recordRevisionMaxTries = 10
+)
+
+// SearchRevisions returns revisions of the record
+//
+// Values and changes are filtered by field-level access control
+func (svc record) SearchRevisions(ctx context.Context, namespaceID, moduleID, recordID uint64, f types.RecordRevisionFilter) (rr types.RecordRevisionSet, _ types.RecordRevisionFilter, err error) {
+	var (
+		aProps = &recordActionProps{record: &types.Record{ID: recordID, NamespaceID: namespaceID, ModuleID: moduleID}}
+	)
+
+	err = func() error {
+		m, _, err := svc.loadRevisioned(ctx, aProps, namespaceID, moduleID, recordID)
+		if err != nil {
+			return err
+		}
+
+		f.RecordID = recordID
+		f.ModuleID = moduleID
+
+		if len(f.Sort) == 0 {
+			f.Sort = filter.SortExprSet{&filter.SortExpr{Column: "revision", Descending: true}}
+		}
+
+		if rr, f, err = store.SearchComposeRecordRevisions(ctx, svc.store, f); err != nil {
+			return err
+		}
+
+		recordRevisionFilterAC(ctx, svc.ac, m, rr...)
+		return nil
+	}()
+
+	return rr, f, svc.recordAction(ctx, aProps, RecordActionSearchRevisions, err)
+}
+
+// DiffRevisions compares values of the two record revisions
+//
+// Only differences of readable fields are returned
+func (svc record) DiffRevisions(ctx context.Context, namespaceID, moduleID, recordID uint64, from, to uint) (d *types.RecordRevisionDiff, err error) {
+	var (
+		aProps = &recordActionProps{record: &types.Record{ID: recordID, NamespaceID: namespaceID, ModuleID: moduleID}}
+	)
+
+	err = func() error {
+		m, _, err := svc.loadRevisioned(ctx, aProps, namespaceID, moduleID, recordID)
+		if err != nil {
+			return err
+		}
+
+		var (
+			old, new = &types.RecordRevision{}, &types.RecordRevision{}
+		)
+
+		// revision 0 is used to compare against the empty record
+		if from > 0 {
+			if old, err = svc.lookupRevision(ctx, recordID, from); err != nil {
+				return err
+			}
+		}
+
+		if new, err = svc.lookupRevision(ctx, recordID, to); err != nil {
+			return err
+		}
+
+		recordRevisionFilterAC(ctx, svc.ac, m, old, new)
+
+		d = &types.RecordRevisionDiff{
+			RecordID: recordID,
+			From:     from,
+			To:       to,
+			Fields:   types.DiffRecordValues(old.Values, new.Values),
+		}
+
+		return nil
+	}()
+
+	return d, svc.recordAction(ctx, aProps, RecordActionDiffRevisions, err)
+}
+
+// RestoreRevision updates the record with values from the given revision
+//
+// Only values of the fields that the user can read and update are restored;
+// values of all other fields, including the encrypted ones
+// (not stored in revisions), are kept as they are.
+func (svc record) RestoreRevision(ctx context.Context, namespaceID, moduleID, recordID uint64, revision uint) (rec *types.Record, err error) {
+	var (
+		aProps = &recordActionProps{record: &types.Record{ID: recordID, NamespaceID: namespaceID, ModuleID: moduleID}, revision: revision}
+	)
+
+	err = func() error {
+		m, old, err := svc.loadRevisioned(ctx, aProps, namespaceID, moduleID, recordID)
+		if err != nil {
+			return err
+		}
+
+		rev, err := svc.lookupRevision(ctx, recordID, revision)
+		if err != nil {
+			return err
+		}
+
+		upd := &types.Record{
+			ID:          old.ID,
+			ModuleID:    old.ModuleID,
+			NamespaceID: old.NamespaceID,
+			OwnedBy:     old.OwnedBy,
+			Labels:      old.Labels,
+			UpdatedAt:   old.UpdatedAt,
+			Values:      types.RecordValueSet{},
+		}
+
+		for _, f := range m.Fields {
+			src := old.Values
+			if svc.ac.CanReadRecordValueOnModuleField(ctx, f) && svc.ac.CanUpdateRecordValueOnModuleField(ctx, f) && !f.Options.IsEncrypted() {
+				src = rev.Values
+			}
+
+			upd.Values = append(upd.Values, src.FilterByName(f.Name).GetClean()...)
+		}
+
+		if rec, err = svc.updateAs(ctx, upd, types.RecordRevisionRestore); err != nil {
+			return err
+		}
+
+		aProps.setChanged(rec)
+		ComposeRecordFilterAC(ctx, svc.ac, m, rec)
+		return nil
+	}()
+
+	return rec, svc.recordAction(ctx, aProps, RecordActionRestoreRevision, err)
+}
+
+// loadRevisioned loads module and record and verifies
+// that the revisions are enabled and the record can be read
+func (svc record) loadRevisioned(ctx context.Context, aProps *recordActionProps, namespaceID, moduleID, recordID uint64) (m *types.Module, r *types.Record, err error) {
+	var (
+		ns *types.Namespace
+	)
+
+	if ns, m, r, err = loadRecordCombo(ctx, svc.store, namespaceID, moduleID, recordID); errors.IsNotFound(err) {
+		return nil, nil, RecordErrNotFound()
+	} else if err != nil {
+		return
+	}
+
+	aProps.setNamespace(ns)
+	aProps.setModule(m)
+	aProps.setRecord(r)
+
+	if !m.ModelConfig.RecordRevisions.Enabled {
+		return nil, nil, RecordErrRevisionsDisabled()
+	}
+
+	if !svc.ac.CanReadRecord(ctx, r) {
+		return nil, nil, RecordErrNotAllowedToRead()
+	}
+
+	return
+}
+
+func (svc record) lookupRevision(ctx context.Context, recordID uint64, revision uint) (rev *types.RecordRevision, err error) {
+	if rev, err = store.LookupComposeRecordRevisionByRecordIDRevision(ctx, svc.store, recordID, revision); errors.IsNotFound(err) {
+		return nil, RecordErrRevisionNotFound()
+	}
+
+	return
+}
+
+// storeRevision stores the new revision of the record
+//
+// Revisions are stored only for the modules with revisions enabled.
+// Changes are calculated from the previous record values.
+//
+// Revision number is taken from the latest revision; when the number
+// is taken by the concurrently stored revision, the next one is used.
+//
+// Values of the encrypted fields are not stored, only the changes are recorded
+//
+// Record is written over the DAL and not over the store transaction
+// the revision is stored in; when the revision can not be stored, callers
+// revert the record write (see revert). Interruption between the two writes
+// can still leave the record change without the revision.
+func (svc record) storeRevision(ctx context.Context, s store.Storer, m *types.Module, op types.RecordRevisionOperation, prev types.RecordValueSet, r *types.Record) (err error) {
+	if !m.ModelConfig.RecordRevisions.Enabled {
+		return
+	}
+
+	var (
+		rev = &types.RecordRevision{
+			ID:        nextID(),
+			RecordID:  r.ID,
+			ModuleID:  m.ID,
+			Revision:  1,
+			Operation: op,
+			UserID:    auth.GetIdentityFromContext(ctx).Identity(),
+			Values:    r.Values.GetClean(),
+			CreatedAt: *now(),
+		}
+
+		last types.RecordRevisionSet
+	)
+
+	// set when record is changed by the workflow
+	rev.SessionID = wfexec.GetContextCaller(ctx).SessionID
+
+	last, _, err = store.SearchComposeRecordRevisions(ctx, s, types.RecordRevisionFilter{
+		RecordID: r.ID,
+		Sorting:  filter.Sorting{Sort: filter.SortExprSet{&filter.SortExpr{Column: "revision", Descending: true}}},
+		Paging:   filter.Paging{Limit: 1},
+	})
+	if err != nil {
+		return
+	}
+
+	if len(last) > 0 {
+		rev.Revision = last[0].Revision + 1
+	}
+
+	for _, d := range types.DiffRecordValues(prev, rev.Values) {
+		rev.Changes = append(rev.Changes, d.Name)
+	}
+
+	// revisions are kept outside of the DAL so the values would be stored in plain text
+	rev.Values, _ = rev.Values.Filter(func(v *types.RecordValue) (bool, error) {
+		f := m.Fields.FindByName(v.Name)
+		return f == nil || !f.Options.IsEncrypted(), nil
+	})
+
+	for try := 0; try < recordRevisionMaxTries; try++ {
+		if ok, err := store.CreateUniqueComposeRecordRevision(ctx, s, rev); err != nil || ok {
+			return err
+		}
+
+		rev.Revision++
+	}
+
+	return fmt.Errorf("could not store revision of record %d: revision numbers taken", r.ID)
+}
+
+// recordRevisionFilterAC removes values and changes of the fields
+// that the user is not allowed to read
+func recordRevisionFilterAC(ctx context.Context, ac recordValueAccessController, m *types.Module, rr ...*types.RecordRevision) {
+	var (
+		readableFields = map[string]bool{}
+	)
+
+	for _, f := range m.Fields {
+		readableFields[f.Name] = ac.CanReadRecordValueOnModuleField(ctx, f)
+	}
+
+	for _, r := range rr {
+		r.Values, _ = r.Values.Filter(func(v *types.RecordValue) (bool, error) {
+			return readableFields[v.Name], nil
+		})
+
+		cc := make(types.RecordRevisionChangeSet, 0, len(r.Changes))
+		for _, c := range r.Changes {
+			if readableFields[c] {
+				cc = append(cc, c)
+			}
+		}
+
+		r.Changes = cc
+	}
+}
+
+// revert returns the error of the failed revision write,
+// extended with the error of reverting the record write
+func (svc record) revert(err, rErr error) error {
+	if rErr == nil {
+		return err
+	}
+
+	return fmt.Errorf("%w (could not revert record write: %v)", err, rErr)
+}
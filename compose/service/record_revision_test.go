@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/wfexec"
+	"github.com/cortezaproject/corteza-server/store"
+	"github.com/cortezaproject/corteza-server/store/adapters/rdbms/drivers/sqlite"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type (
+	revisionFieldAC map[string]bool
+
+	// staleRevisionStore does not find any revisions
+	// as if they were stored concurrently
+	staleRevisionStore struct {
+		store.Storer
+	}
+)
+
+func (staleRevisionStore) SearchComposeRecordRevisions(context.Context, types.RecordRevisionFilter) (types.RecordRevisionSet, types.RecordRevisionFilter, error) {
+	return nil, types.RecordRevisionFilter{}, nil
+}
+
+func (ac revisionFieldAC) CanReadRecordValueOnModuleField(_ context.Context, f *types.ModuleField) bool {
+	return ac[f.Name]
+}
+
+func (ac revisionFieldAC) CanUpdateRecordValueOnModuleField(_ context.Context, f *types.ModuleField) bool {
+	return ac[f.Name]
+}
+
+func TestRecord_storeRevision(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		ctx    = context.Background()
+		s, err = sqlite.ConnectInMemory(ctx)
+	)
+
+	req.NoError(err)
+	req.NoError(store.Upgrade(ctx, zap.NewNop(), s))
+	req.NoError(store.TruncateComposeRecordRevisions(ctx, s))
+
+	var (
+		svc = &record{store: s}
+
+		mod = &types.Module{
+			ID: nextID(),
+			Fields: types.ModuleFieldSet{
+				&types.ModuleField{Name: "name"},
+				&types.ModuleField{Name: "secret"},
+			},
+		}
+
+		rec = &types.Record{
+			ID:     nextID(),
+			Values: types.RecordValueSet{{Name: "name", Value: "n1"}, {Name: "secret", Value: "s1"}},
+		}
+
+		rr types.RecordRevisionSet
+	)
+
+	// revisions disabled
+	req.NoError(svc.storeRevision(ctx, s, mod, types.RecordRevisionCreate, nil, rec))
+	rr, _, err = store.SearchComposeRecordRevisions(ctx, s, types.RecordRevisionFilter{RecordID: rec.ID})
+	req.NoError(err)
+	req.Empty(rr)
+
+	mod.ModelConfig.RecordRevisions.Enabled = true
+	req.NoError(svc.storeRevision(ctx, s, mod, types.RecordRevisionCreate, nil, rec))
+
+	prev := rec.Values
+	rec.Values = types.RecordValueSet{{Name: "name", Value: "n2"}, {Name: "secret", Value: "s1"}}
+	req.NoError(svc.storeRevision(ctx, s, mod, types.RecordRevisionUpdate, prev, rec))
+
+	rr, _, err = store.SearchComposeRecordRevisions(ctx, s, types.RecordRevisionFilter{RecordID: rec.ID})
+	req.NoError(err)
+	req.Len(rr, 2)
+	req.Equal(uint(1), rr[0].Revision)
+	req.Equal(types.RecordRevisionChangeSet{"name", "secret"}, rr[0].Changes)
+	req.Equal(uint(2), rr[1].Revision)
+	req.Equal(types.RecordRevisionUpdate, rr[1].Operation)
+	req.Equal(types.RecordRevisionChangeSet{"name"}, rr[1].Changes)
+
+	recordRevisionFilterAC(ctx, revisionFieldAC{"name": true}, mod, rr...)
+	req.Len(rr[0].Values, 1)
+	req.Equal("n1", rr[0].Values.Get("name", 0).Value)
+	req.Equal(types.RecordRevisionChangeSet{"name"}, rr[0].Changes)
+
+	// revision numbers taken in the meantime are skipped
+	wfCtx := wfexec.SetContextCaller(ctx, wfexec.Caller{WorkflowID: 1, SessionID: 2})
+	req.NoError(svc.storeRevision(wfCtx, staleRevisionStore{s}, mod, types.RecordRevisionUpdate, prev, rec))
+
+	rr, _, err = store.SearchComposeRecordRevisions(ctx, s, types.RecordRevisionFilter{RecordID: rec.ID})
+	req.NoError(err)
+	req.Len(rr, 3)
+	req.Equal(uint(3), rr[2].Revision)
+	req.Equal(uint64(2), rr[2].SessionID)
+
+	// values of the encrypted fields are not stored
+	mod.Fields.FindByName("secret").Options = types.ModuleFieldOptions{}
+	mod.Fields.FindByName("secret").Options.SetIsEncrypted(true)
+
+	prev = rec.Values
+	rec.Values = types.RecordValueSet{{Name: "name", Value: "n2"}, {Name: "secret", Value: "s2"}}
+	req.NoError(svc.storeRevision(ctx, s, mod, types.RecordRevisionUpdate, prev, rec))
+
+	rr, _, err = store.SearchComposeRecordRevisions(ctx, s, types.RecordRevisionFilter{RecordID: rec.ID})
+	req.NoError(err)
+	req.Len(rr, 4)
+	req.Equal(types.RecordRevisionChangeSet{"secret"}, rr[3].Changes)
+	req.Len(rr[3].Values, 1)
+	req.Nil(rr[3].Values.Get("secret", 0))
+}
+
+func TestRecord_revert(t *testing.T) {
+	var (
+		req = require.New(t)
+		svc = record{}
+
+		err = errors.New("revision failed")
+	)
+
+	req.Equal(err, svc.revert(err, nil))
+
+	rErr := svc.revert(err, errors.New("delete failed"))
+	req.ErrorIs(rErr, err)
+	req.EqualError(rErr, "revision failed (could not revert record write: delete failed)")
+}
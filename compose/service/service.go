@@ -39,6 +39,14 @@ type (
 		Discovery  options.DiscoveryOpt
 		Storage    options.ObjectStoreOpt
 		UserFinder userFinder
+
+		// RecordImporter encodes imported records into the store
+		RecordImporter recordImporter
+
+		// NamespaceDecoder and ResourceEncoder are used
+		// when namespaces are cloned from workflows
+		NamespaceDecoder namespaceDecoder
+		ResourceEncoder  resourceEncoder
 	}
 
 	eventDispatcher interface {
@@ -173,7 +181,7 @@ func Initialize(ctx context.Context, log *zap.Logger, s store.Storer, c Config)
 		return
 	}
 
-	DefaultImportSession = ImportSession()
+	DefaultImportSession = ImportSession(DefaultObjectStore, c.RecordImporter)
 	DefaultRecord = Record(dal.Service())
 	DefaultPage = Page()
 	DefaultChart = Chart()
@@ -185,6 +193,8 @@ func Initialize(ctx context.Context, log *zap.Logger, s store.Storer, c Config)
 	automationService.Registry().AddTypes(
 		automation.ComposeNamespace{},
 		automation.ComposeModule{},
+		automation.ComposePage{},
+		automation.ComposeChart{},
 		automation.ComposeRecord{},
 		automation.ComposeRecordValues{},
 		automation.Attachment{},
@@ -206,6 +216,19 @@ func Initialize(ctx context.Context, log *zap.Logger, s store.Storer, c Config)
 	automation.NamespacesHandler(
 		automationService.Registry(),
 		DefaultNamespace,
+		NamespaceCloner(DefaultNamespace, DefaultStore, c.NamespaceDecoder, c.ResourceEncoder),
+	)
+
+	automation.PagesHandler(
+		automationService.Registry(),
+		DefaultNamespace,
+		DefaultPage,
+	)
+
+	automation.ChartsHandler(
+		automationService.Registry(),
+		DefaultNamespace,
+		DefaultChart,
 	)
 
 	automation.AttachmentHandler(
@@ -221,7 +244,7 @@ func Activate(ctx context.Context) (err error) {
 }
 
 func Watchers(ctx context.Context) {
-	//
+	DefaultImportSession.Watch(ctx)
 }
 
 func RegisterIteratorProviders() {
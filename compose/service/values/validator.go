@@ -259,6 +259,11 @@ fields:
 			continue
 		}
 
+		if f.Options.IsEncrypted() {
+			// Encrypted values can not be compared in the store
+			continue
+		}
+
 		if vldtr.uniqueCheckerFn == nil {
 			return nil
 		}
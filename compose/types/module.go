@@ -25,6 +25,17 @@ type (
 		PartitionFormat string `json:"partitionFormat"`
 
 		SystemFieldEncoding SystemFieldEncoding `json:"systemFieldEncoding"`
+
+		RecordRevisions RecordRevisionsConfig `json:"recordRevisions"`
+
+		// SensitivityLevel of the module's records;
+		// must not exceed the sensitivity level of the connection
+		SensitivityLevel uint64 `json:"sensitivityLevel,string"`
+	}
+
+	// RecordRevisionsConfig controls the revision log of module records
+	RecordRevisionsConfig struct {
+		Enabled bool `json:"enabled"`
 	}
 
 	Module struct {
@@ -36,6 +36,10 @@ type (
 
 		Expressions ModuleFieldExpr `json:"expressions"`
 
+		// SensitivityLevel of the field values;
+		// must not exceed the sensitivity level of the connection
+		SensitivityLevel uint64 `json:"sensitivityLevel,string"`
+
 		Labels map[string]string `json:"labels,omitempty"`
 
 		CreatedAt time.Time  `json:"createdAt,omitempty"`
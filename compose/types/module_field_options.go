@@ -35,6 +35,7 @@ const (
 	moduleFieldOptionIsUnique           = "isUnique"
 	moduleFieldOptionIsUniqueMultiValue = "isUniqueMultiValue"
 	moduleFieldOptionIndex              = "index"
+	moduleFieldOptionIsEncrypted        = "isEncrypted"
 
 	moduleFieldOptionOptions = "options"
 
@@ -174,6 +175,16 @@ func (opt ModuleFieldOptions) SetIsUniqueMultiValue(value bool) {
 	opt[moduleFieldOptionIsUniqueMultiValue] = value
 }
 
+// IsEncrypted - should value in this field be encrypted at rest?
+func (opt ModuleFieldOptions) IsEncrypted() bool {
+	return opt.Bool(moduleFieldOptionIsEncrypted)
+}
+
+// SetIsEncrypted - should value in this field be encrypted at rest?
+func (opt ModuleFieldOptions) SetIsEncrypted(value bool) {
+	opt[moduleFieldOptionIsEncrypted] = value
+}
+
 func (opt ModuleFieldOptions) Precision() (p uint) {
 	p = uint(opt.Int64(moduleFieldNumberOptionPrecision))
 
@@ -0,0 +1,245 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// RecordImportSession holds the state of the record import
+	//
+	// Sessions are persisted so that the import can be resumed
+	// and its progress observed from any node
+	RecordImportSession struct {
+		ID          uint64 `json:"sessionID,string"`
+		Name        string `json:"-"`
+		ContentType string `json:"-"`
+
+		// Source holds the name of the uploaded file in the object store
+		Source string `json:"-"`
+
+		// Sheet holds the name of the sheet the records are imported from
+		//
+		// Only set for the sources with multiple sheets (xlsx)
+		Sheet  string               `json:"sheet,omitempty"`
+		Sheets RecordImportSheetSet `json:"sheets,omitempty"`
+
+		UserID      uint64 `json:"userID,string"`
+		NamespaceID uint64 `json:"namespaceID,string"`
+		ModuleID    uint64 `json:"moduleID,string"`
+
+		OnError  string               `json:"onError"`
+		Fields   RecordImportFieldMap `json:"fields"`
+		Key      string               `json:"key"`
+		Progress RecordImportProgress `json:"progress"`
+
+		CreatedAt time.Time `json:"createdAt"`
+		UpdatedAt time.Time `json:"updatedAt"`
+	}
+
+	RecordImportSessionFilter struct {
+		UserID uint64 `json:"userID,string"`
+
+		Limit uint `json:"limit"`
+	}
+
+	// RecordImportFieldMap maps source columns to module fields
+	RecordImportFieldMap map[string]string
+
+	// RecordImportSheet describes the sheet of the import source
+	RecordImportSheet struct {
+		Name       string   `json:"name"`
+		Fields     []string `json:"fields"`
+		EntryCount uint64   `json:"entryCount"`
+	}
+
+	RecordImportSheetSet []*RecordImportSheet
+
+	RecordImportProgress struct {
+		StartedAt  *time.Time `json:"startedAt"`
+		FinishedAt *time.Time `json:"finishedAt"`
+		EntryCount uint64     `json:"entryCount"`
+		Completed  uint64     `json:"completed"`
+		Failed     uint64     `json:"failed"`
+		FailReason string     `json:"failReason,omitempty"`
+
+		FailLog *RecordImportFailLog `json:"failLog,omitempty"`
+	}
+
+	RecordImportFailLog struct {
+		// Records holds an array of record indexes
+		Records          RecordImportIndex `json:"records"`
+		RecordsTruncated bool              `json:"recordsTruncated"`
+		// Errors specifies a map of occurred errors & the number of
+		Errors RecordImportErrorIndex `json:"errors"`
+	}
+
+	RecordImportIndex      []int
+	RecordImportErrorIndex map[string]int
+
+	// RecordImportError holds the reason why the row of the import source failed
+	RecordImportError struct {
+		SessionID uint64 `json:"sessionID,string"`
+
+		// Row is the 1-based index of the row in the import source
+		Row     uint64 `json:"row"`
+		Message string `json:"message"`
+
+		CreatedAt time.Time `json:"createdAt"`
+	}
+
+	RecordImportErrorFilter struct {
+		SessionID uint64 `json:"sessionID,string"`
+
+		Limit uint `json:"limit"`
+	}
+)
+
+// Running returns true if the import was started but not yet finished
+func (s RecordImportSession) Running() bool {
+	return s.Progress.StartedAt != nil && s.Progress.FinishedAt == nil
+}
+
+// Processed returns the number of processed source rows
+func (p RecordImportProgress) Processed() uint64 {
+	return p.Completed + p.Failed
+}
+
+func (fm *RecordImportFieldMap) Scan(value interface{}) error {
+	//lint:ignore S1034 This typecast is intentional, we need to get []byte out of a []uint8
+	switch value.(type) {
+	case nil:
+		*fm = RecordImportFieldMap{}
+	case []uint8:
+		if err := json.Unmarshal(value.([]byte), fm); err != nil {
+			return errors.Wrapf(err, "cannot scan '%v' into RecordImportFieldMap", value)
+		}
+	}
+
+	return nil
+}
+
+func (fm RecordImportFieldMap) Value() (driver.Value, error) {
+	return json.Marshal(fm)
+}
+
+// FindByName returns the sheet with the given name
+func (set RecordImportSheetSet) FindByName(name string) *RecordImportSheet {
+	for _, s := range set {
+		if s.Name == name {
+			return s
+		}
+	}
+
+	return nil
+}
+
+func (set *RecordImportSheetSet) Scan(value interface{}) error {
+	//lint:ignore S1034 This typecast is intentional, we need to get []byte out of a []uint8
+	switch value.(type) {
+	case nil:
+		*set = RecordImportSheetSet{}
+	case []uint8:
+		if err := json.Unmarshal(value.([]byte), set); err != nil {
+			return errors.Wrapf(err, "cannot scan '%v' into RecordImportSheetSet", value)
+		}
+	}
+
+	return nil
+}
+
+func (set RecordImportSheetSet) Value() (driver.Value, error) {
+	return json.Marshal(set)
+}
+
+func (p *RecordImportProgress) Scan(value interface{}) error {
+	//lint:ignore S1034 This typecast is intentional, we need to get []byte out of a []uint8
+	switch value.(type) {
+	case nil:
+		*p = RecordImportProgress{}
+	case []uint8:
+		if err := json.Unmarshal(value.([]byte), p); err != nil {
+			return errors.Wrapf(err, "cannot scan '%v' into RecordImportProgress", value)
+		}
+	}
+
+	return nil
+}
+
+// Value encodes the progress
+//
+// Indexes of the failed records are omitted;
+// they are stored as RecordImportError
+func (p RecordImportProgress) Value() (driver.Value, error) {
+	if p.FailLog != nil {
+		fl := *p.FailLog
+		fl.Records = nil
+		p.FailLog = &fl
+	}
+
+	return json.Marshal(p)
+}
+
+func (ei RecordImportErrorIndex) Add(err string) {
+	if _, has := ei[err]; has {
+		ei[err]++
+	} else {
+		ei[err] = 1
+	}
+}
+
+func (ri RecordImportIndex) MarshalJSON() ([]byte, error) {
+	if len(ri) == 0 {
+		return []byte("[]"), nil
+	}
+
+	sort.Ints(ri)
+
+	rr := make([][]int, 0, len(ri))
+	start := -1
+	crt := -1
+
+	for i := 0; i < len(ri); i++ {
+		if start == -1 {
+			start = ri[i]
+			crt = ri[i]
+			continue
+		}
+
+		// If the index increases for more then 1, the set is complete
+		if ri[i]-crt > 1 {
+			rr = append(rr, []int{start, crt})
+			start = ri[i]
+		}
+
+		crt = ri[i]
+	}
+
+	rr = append(rr, []int{start, crt})
+	return json.Marshal(rr)
+}
+
+// UnmarshalJSON expands the index ranges
+func (ri *RecordImportIndex) UnmarshalJSON(data []byte) error {
+	var rr [][]int
+	if err := json.Unmarshal(data, &rr); err != nil {
+		return err
+	}
+
+	*ri = nil
+	for _, r := range rr {
+		if len(r) != 2 {
+			return errors.Errorf("invalid record index range: %v", r)
+		}
+
+		for i := r[0]; i <= r[1]; i++ {
+			*ri = append(*ri, i)
+		}
+	}
+
+	return nil
+}
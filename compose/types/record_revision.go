@@ -0,0 +1,164 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/pkg/errors"
+)
+
+type (
+	// RecordRevision holds a snapshot of record values
+	// after each create, update, delete or restore operation
+	RecordRevision struct {
+		ID       uint64 `json:"revisionID,string"`
+		RecordID uint64 `json:"recordID,string"`
+		ModuleID uint64 `json:"moduleID,string"`
+
+		// Revision is sequential number of the revision of the record
+		Revision  uint                    `json:"revision"`
+		Operation RecordRevisionOperation `json:"operation"`
+
+		// UserID holds the ID of the user that caused the change
+		UserID uint64 `json:"userID,string"`
+
+		// SessionID holds the ID of the workflow session
+		// that caused the change (if any)
+		SessionID uint64 `json:"sessionID,string,omitempty"`
+
+		Values RecordValueSet `json:"values"`
+
+		// Changes holds names of the fields
+		// that were changed in this revision
+		Changes RecordRevisionChangeSet `json:"changes"`
+
+		CreatedAt time.Time `json:"createdAt"`
+	}
+
+	RecordRevisionFilter struct {
+		RecordID uint64 `json:"recordID,string"`
+		ModuleID uint64 `json:"moduleID,string"`
+
+		// Standard helpers for paging and sorting
+		filter.Sorting
+		filter.Paging
+	}
+
+	RecordRevisionOperation string
+
+	RecordRevisionChangeSet []string
+
+	// RecordRevisionDiff holds field-level differences between two revisions
+	RecordRevisionDiff struct {
+		RecordID uint64 `json:"recordID,string"`
+		From     uint   `json:"from"`
+		To       uint   `json:"to"`
+
+		Fields []*RecordRevisionFieldDiff `json:"fields"`
+	}
+
+	RecordRevisionFieldDiff struct {
+		Name string   `json:"name"`
+		Old  []string `json:"old"`
+		New  []string `json:"new"`
+	}
+)
+
+const (
+	RecordRevisionCreate  RecordRevisionOperation = "create"
+	RecordRevisionUpdate  RecordRevisionOperation = "update"
+	RecordRevisionDelete  RecordRevisionOperation = "delete"
+	RecordRevisionRestore RecordRevisionOperation = "restore"
+)
+
+// DiffRecordValues compares two record value sets field by field
+//
+// Deleted values are ignored; returned field diffs are sorted by field name
+func DiffRecordValues(old, new RecordValueSet) (out []*RecordRevisionFieldDiff) {
+	var (
+		oo = recordValuesByName(old)
+		nn = recordValuesByName(new)
+
+		names = make(map[string]bool)
+	)
+
+	for n := range oo {
+		names[n] = true
+	}
+
+	for n := range nn {
+		names[n] = true
+	}
+
+	out = make([]*RecordRevisionFieldDiff, 0, len(names))
+	for n := range names {
+		if equalStrings(oo[n], nn[n]) {
+			continue
+		}
+
+		out = append(out, &RecordRevisionFieldDiff{Name: n, Old: oo[n], New: nn[n]})
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return
+}
+
+func (set *RecordRevisionChangeSet) Scan(value interface{}) error {
+	//lint:ignore S1034 This typecast is intentional, we need to get []byte out of a []uint8
+	switch value.(type) {
+	case nil:
+		*set = RecordRevisionChangeSet{}
+	case []uint8:
+		if err := json.Unmarshal(value.([]byte), set); err != nil {
+			return errors.Wrapf(err, "cannot scan '%v' into RecordRevisionChangeSet", value)
+		}
+	}
+
+	return nil
+}
+
+func (set RecordRevisionChangeSet) Value() (driver.Value, error) {
+	if set == nil {
+		set = RecordRevisionChangeSet{}
+	}
+
+	return json.Marshal(set)
+}
+
+// recordValuesByName groups values by field name ordered by place
+func recordValuesByName(set RecordValueSet) map[string][]string {
+	var (
+		out = make(map[string][]string)
+		vv  = make(RecordValueSet, 0, len(set))
+	)
+
+	for _, v := range set {
+		if !v.IsDeleted() {
+			vv = append(vv, v)
+		}
+	}
+
+	sort.SliceStable(vv, func(i, j int) bool { return vv[i].Place < vv[j].Place })
+	for _, v := range vv {
+		out[v.Name] = append(out[v.Name], v.Value)
+	}
+
+	return out
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffRecordValues(t *testing.T) {
+	var (
+		req = require.New(t)
+		now = time.Now()
+
+		old = RecordValueSet{
+			{Name: "same", Value: "v"},
+			{Name: "changed", Value: "v1"},
+			{Name: "removed", Value: "v"},
+			{Name: "multi", Value: "a", Place: 0},
+			{Name: "multi", Value: "b", Place: 1},
+		}
+
+		new = RecordValueSet{
+			{Name: "same", Value: "v"},
+			{Name: "changed", Value: "v2"},
+			{Name: "removed", Value: "v", DeletedAt: &now},
+			{Name: "multi", Value: "b", Place: 1},
+			{Name: "multi", Value: "a", Place: 0},
+			{Name: "added", Value: "v"},
+		}
+	)
+
+	dd := DiffRecordValues(old, new)
+	req.Len(dd, 3)
+
+	req.Equal("added", dd[0].Name)
+	req.Nil(dd[0].Old)
+	req.Equal([]string{"v"}, dd[0].New)
+
+	req.Equal("changed", dd[1].Name)
+	req.Equal([]string{"v1"}, dd[1].Old)
+	req.Equal([]string{"v2"}, dd[1].New)
+
+	req.Equal("removed", dd[2].Name)
+	req.Equal([]string{"v"}, dd[2].Old)
+	req.Nil(dd[2].New)
+
+	req.Empty(DiffRecordValues(nil, nil))
+}
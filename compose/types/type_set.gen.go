@@ -45,6 +45,21 @@ type (
 	// This type is auto-generated.
 	RecordSet []*Record
 
+	// RecordImportErrorSet slice of RecordImportError
+	//
+	// This type is auto-generated.
+	RecordImportErrorSet []*RecordImportError
+
+	// RecordImportSessionSet slice of RecordImportSession
+	//
+	// This type is auto-generated.
+	RecordImportSessionSet []*RecordImportSession
+
+	// RecordRevisionSet slice of RecordRevision
+	//
+	// This type is auto-generated.
+	RecordRevisionSet []*RecordRevision
+
 	// RecordValueSet slice of RecordValue
 	//
 	// This type is auto-generated.
@@ -443,6 +458,148 @@ func (set RecordSet) IDs() (IDs []uint64) {
 	return
 }
 
+// Walk iterates through every slice item and calls w(RecordImportError) err
+//
+// This function is auto-generated.
+func (set RecordImportErrorSet) Walk(w func(*RecordImportError) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(RecordImportError) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set RecordImportErrorSet) Filter(f func(*RecordImportError) (bool, error)) (out RecordImportErrorSet, err error) {
+	var ok bool
+	out = RecordImportErrorSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
+// Walk iterates through every slice item and calls w(RecordImportSession) err
+//
+// This function is auto-generated.
+func (set RecordImportSessionSet) Walk(w func(*RecordImportSession) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(RecordImportSession) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set RecordImportSessionSet) Filter(f func(*RecordImportSession) (bool, error)) (out RecordImportSessionSet, err error) {
+	var ok bool
+	out = RecordImportSessionSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
+// FindByID finds items from slice by its ID property
+//
+// This function is auto-generated.
+func (set RecordImportSessionSet) FindByID(ID uint64) *RecordImportSession {
+	for i := range set {
+		if set[i].ID == ID {
+			return set[i]
+		}
+	}
+
+	return nil
+}
+
+// IDs returns a slice of uint64s from all items in the set
+//
+// This function is auto-generated.
+func (set RecordImportSessionSet) IDs() (IDs []uint64) {
+	IDs = make([]uint64, len(set))
+
+	for i := range set {
+		IDs[i] = set[i].ID
+	}
+
+	return
+}
+
+// Walk iterates through every slice item and calls w(RecordRevision) err
+//
+// This function is auto-generated.
+func (set RecordRevisionSet) Walk(w func(*RecordRevision) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(RecordRevision) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set RecordRevisionSet) Filter(f func(*RecordRevision) (bool, error)) (out RecordRevisionSet, err error) {
+	var ok bool
+	out = RecordRevisionSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
+// FindByID finds items from slice by its ID property
+//
+// This function is auto-generated.
+func (set RecordRevisionSet) FindByID(ID uint64) *RecordRevision {
+	for i := range set {
+		if set[i].ID == ID {
+			return set[i]
+		}
+	}
+
+	return nil
+}
+
+// IDs returns a slice of uint64s from all items in the set
+//
+// This function is auto-generated.
+func (set RecordRevisionSet) IDs() (IDs []uint64) {
+	IDs = make([]uint64, len(set))
+
+	for i := range set {
+		IDs[i] = set[i].ID
+	}
+
+	return
+}
+
 // Walk iterates through every slice item and calls w(RecordValue) err
 //
 // This function is auto-generated.
@@ -100,6 +100,16 @@ type (
 		//
 		// Sort items
 		Sort string
+
+		// RecordID GET parameter
+		//
+		// Return only records with the given IDs
+		RecordID []string
+
+		// Deleted GET parameter
+		//
+		// Exclude (0), include (1, default) or return only (2) deleted records
+		Deleted string
 	}
 
 	SyncDataReadExposedSocial struct {
@@ -137,6 +147,16 @@ type (
 		//
 		// Sort items
 		Sort string
+
+		// RecordID GET parameter
+		//
+		// Return only records with the given IDs
+		RecordID []string
+
+		// Deleted GET parameter
+		//
+		// Exclude (0), include (1, default) or return only (2) deleted records
+		Deleted string
 	}
 )
 
@@ -256,6 +276,8 @@ func (r SyncDataReadExposedInternal) Auditable() map[string]interface{} {
 		"limit":      r.Limit,
 		"pageCursor": r.PageCursor,
 		"sort":       r.Sort,
+		"recordID":   r.RecordID,
+		"deleted":    r.Deleted,
 	}
 }
 
@@ -294,6 +316,16 @@ func (r SyncDataReadExposedInternal) GetSort() string {
 	return r.Sort
 }
 
+// Auditable returns all auditable/loggable parameters
+func (r SyncDataReadExposedInternal) GetRecordID() []string {
+	return r.RecordID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SyncDataReadExposedInternal) GetDeleted() string {
+	return r.Deleted
+}
+
 // Fill processes request and fills internal variables
 func (r *SyncDataReadExposedInternal) Fill(req *http.Request) (err error) {
 
@@ -331,6 +363,23 @@ func (r *SyncDataReadExposedInternal) Fill(req *http.Request) (err error) {
 				return err
 			}
 		}
+		if val, ok := tmp["recordID[]"]; ok {
+			r.RecordID, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["recordID"]; ok {
+			r.RecordID, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["deleted"]; ok && len(val) > 0 {
+			r.Deleted, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	{
@@ -369,6 +418,8 @@ func (r SyncDataReadExposedSocial) Auditable() map[string]interface{} {
 		"limit":      r.Limit,
 		"pageCursor": r.PageCursor,
 		"sort":       r.Sort,
+		"recordID":   r.RecordID,
+		"deleted":    r.Deleted,
 	}
 }
 
@@ -407,6 +458,16 @@ func (r SyncDataReadExposedSocial) GetSort() string {
 	return r.Sort
 }
 
+// Auditable returns all auditable/loggable parameters
+func (r SyncDataReadExposedSocial) GetRecordID() []string {
+	return r.RecordID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r SyncDataReadExposedSocial) GetDeleted() string {
+	return r.Deleted
+}
+
 // Fill processes request and fills internal variables
 func (r *SyncDataReadExposedSocial) Fill(req *http.Request) (err error) {
 
@@ -444,6 +505,23 @@ func (r *SyncDataReadExposedSocial) Fill(req *http.Request) (err error) {
 				return err
 			}
 		}
+		if val, ok := tmp["recordID[]"]; ok {
+			r.RecordID, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["recordID"]; ok {
+			r.RecordID, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["deleted"]; ok && len(val) > 0 {
+			r.Deleted, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	{
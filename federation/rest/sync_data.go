@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/cortezaproject/corteza-server/pkg/errors"
 	"github.com/cortezaproject/corteza-server/pkg/federation"
 	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/cortezaproject/corteza-server/pkg/payload"
 	ss "github.com/cortezaproject/corteza-server/system/service"
 	st "github.com/cortezaproject/corteza-server/system/types"
 )
@@ -149,6 +151,8 @@ func (ctrl SyncData) ReadExposedSocial(ctx context.Context, r *request.SyncDataR
 			Limit:      r.Limit,
 			PageCursor: r.PageCursor,
 			Sort:       r.Sort,
+			RecordID:   r.RecordID,
+			Deleted:    r.Deleted,
 		}
 
 		payload, err := ctrl.readExposed(ctx, &rr)
@@ -174,10 +178,11 @@ func (ctrl SyncData) ReadExposedSocial(ctx context.Context, r *request.SyncDataR
 // readExposed fetches all the data - records (with paging) for an exposed module in an internal format
 func (ctrl SyncData) readExposed(ctx context.Context, r *request.SyncDataReadExposedInternal) (interface{}, error) {
 	var (
-		err   error
-		em    *types.ExposedModule
-		users st.UserSet
-		node  *types.Node
+		err       error
+		em        *types.ExposedModule
+		users     st.UserSet
+		node      *types.Node
+		sensitive ct.ModuleFieldSet
 	)
 
 	if node, err = service.DefaultNode.FindBySharedNodeID(ctx, r.NodeID); err != nil {
@@ -188,6 +193,11 @@ func (ctrl SyncData) readExposed(ctx context.Context, r *request.SyncDataReadExp
 		return nil, err
 	}
 
+	// fields might have become too sensitive after they were exposed
+	if sensitive, err = service.DefaultExposedModule.SensitiveFields(ctx, em); err != nil {
+		return nil, err
+	}
+
 	if users, _, err = ss.DefaultUser.Find(ctx, st.UserFilter{}); err != nil {
 		return nil, err
 	}
@@ -215,6 +225,21 @@ func (ctrl SyncData) readExposed(ctx context.Context, r *request.SyncDataReadExp
 		Deleted:  filter.StateInclusive,
 	}
 
+	// deleted records are included by default
+	// so that the sync worker can remove them
+	if r.Deleted != "" {
+		var d uint64
+		if d, err = strconv.ParseUint(r.Deleted, 10, 8); err != nil || d > uint64(filter.StateExclusive) {
+			return nil, fmt.Errorf("invalid deleted state: %q", r.Deleted)
+		}
+
+		f.Deleted = filter.State(d)
+	}
+
+	if len(r.RecordID) > 0 {
+		f.LabeledIDs = payload.ParseUint64s(r.RecordID)
+	}
+
 	if f.Paging, err = filter.NewPaging(r.Limit, r.PageCursor); err != nil {
 		return nil, err
 	}
@@ -231,7 +256,7 @@ func (ctrl SyncData) readExposed(ctx context.Context, r *request.SyncDataReadExp
 	}
 
 	// do the actual field filtering
-	err = list.Walk(filterExposedFields(em))
+	err = list.Walk(filterExposedFields(em, sensitive))
 
 	if err != nil {
 		return nil, err
@@ -282,11 +307,15 @@ func buildIgnoredUsersQuery(users st.UserSet) string {
 }
 
 // filterExposedFields omits the fields that are not exposed as defined
-// in the exposed module definition in store
-func filterExposedFields(em *types.ExposedModule) func(r *ct.Record) error {
+// in the exposed module definition in store and the sensitive fields
+func filterExposedFields(em *types.ExposedModule, sensitive ct.ModuleFieldSet) func(r *ct.Record) error {
 	return func(r *ct.Record) error {
 		var err error
 		r.Values, err = r.Values.Filter(func(rv *ct.RecordValue) (bool, error) {
+			if sensitive.FindByName(rv.Name) != nil {
+				return false, nil
+			}
+
 			return em.Fields.HasField(rv.Name)
 		})
 
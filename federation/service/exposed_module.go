@@ -9,6 +9,7 @@ import (
 	"github.com/cortezaproject/corteza-server/federation/types"
 	"github.com/cortezaproject/corteza-server/pkg/actionlog"
 	"github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/dal"
 	"github.com/cortezaproject/corteza-server/pkg/rbac"
 	"github.com/cortezaproject/corteza-server/store"
 	ss "github.com/cortezaproject/corteza-server/system/service"
@@ -24,6 +25,11 @@ type (
 		role      ss.RoleService
 		store     store.Storer
 		actionlog actionlog.Recorder
+		dal       sensitivityLevelChecker
+	}
+
+	sensitivityLevelChecker interface {
+		WithinSensitivityLevel(level, threshold uint64) bool
 	}
 
 	exposedModuleAccessController interface {
@@ -37,6 +43,7 @@ type (
 		Find(ctx context.Context, filter types.ExposedModuleFilter) (types.ExposedModuleSet, types.ExposedModuleFilter, error)
 		FindByID(ctx context.Context, nodeID uint64, moduleID uint64) (*types.ExposedModule, error)
 		DeleteByID(ctx context.Context, nodeID, moduleID uint64) (*types.ExposedModule, error)
+		SensitiveFields(ctx context.Context, em *types.ExposedModule) (ct.ModuleFieldSet, error)
 	}
 
 	moduleUpdateHandler func(ctx context.Context, ns *types.Node, c *types.ExposedModule) (bool, bool, error)
@@ -51,6 +58,7 @@ func ExposedModule() *exposedModule {
 		namespace: cs.DefaultNamespace,
 		store:     DefaultStore,
 		actionlog: DefaultActionlog,
+		dal:       dal.Service(),
 	}
 }
 
@@ -119,6 +127,10 @@ func (svc exposedModule) Update(ctx context.Context, updated *types.ExposedModul
 			return ExposedModuleErrComposeModuleNotFound()
 		}
 
+		if err = svc.checkSensitivity(m, updated); err != nil {
+			return err
+		}
+
 		if old, err = svc.FindByID(ctx, updated.NodeID, updated.ID); err != nil {
 			return ExposedModuleErrNotFound()
 		}
@@ -260,6 +272,10 @@ func (svc exposedModule) Create(ctx context.Context, new *types.ExposedModule) (
 			return ExposedModuleErrComposeModuleNotFound()
 		}
 
+		if err = svc.checkSensitivity(m, new); err != nil {
+			return err
+		}
+
 		if err = svc.uniqueCheck(ctx, new); err != nil {
 			return err
 		}
@@ -299,6 +315,29 @@ func (svc exposedModule) Create(ctx context.Context, new *types.ExposedModule) (
 	return new, svc.recordAction(ctx, aProps, ExposedModuleActionCreate, err)
 }
 
+// SensitiveFields returns compose module fields with sensitivity level
+// above the federation threshold
+func (svc exposedModule) SensitiveFields(ctx context.Context, em *types.ExposedModule) (ct.ModuleFieldSet, error) {
+	m, err := svc.module.FindByID(ctx, em.ComposeNamespaceID, em.ComposeModuleID)
+	if err != nil {
+		return nil, ExposedModuleErrComposeModuleNotFound()
+	}
+
+	return cs.SensitiveFields(svc.dal, m, ss.CurrentSettings.Federation.SensitivityLevel), nil
+}
+
+// checkSensitivity refuses exposure of fields with sensitivity level
+// above the federation threshold
+func (svc exposedModule) checkSensitivity(m *ct.Module, em *types.ExposedModule) error {
+	for _, f := range cs.SensitiveFields(svc.dal, m, ss.CurrentSettings.Federation.SensitivityLevel) {
+		if has, _ := em.Fields.HasField(f.Name); has {
+			return ExposedModuleErrSensitiveField(&exposedModuleActionProps{field: f.Name})
+		}
+	}
+
+	return nil
+}
+
 func (svc exposedModule) uniqueCheck(ctx context.Context, m *types.ExposedModule) (err error) {
 	f := types.ExposedModuleFilter{
 		NodeID:             m.NodeID,
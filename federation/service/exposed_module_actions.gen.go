@@ -27,6 +27,7 @@ type (
 		delete *types.ExposedModule
 		filter *types.ExposedModuleFilter
 		node   *types.Node
+		field  string
 	}
 
 	exposedModuleAction struct {
@@ -120,6 +121,17 @@ func (p *exposedModuleActionProps) setNode(node *types.Node) *exposedModuleActio
 	return p
 }
 
+// setField updates exposedModuleActionProps's field
+//
+// Allows method chaining
+//
+// This function is auto-generated.
+//
+func (p *exposedModuleActionProps) setField(field string) *exposedModuleActionProps {
+	p.field = field
+	return p
+}
+
 // Serialize converts exposedModuleActionProps to actionlog.Meta
 //
 // This function is auto-generated.
@@ -162,6 +174,7 @@ func (p exposedModuleActionProps) Serialize() actionlog.Meta {
 		m.Set("node.ID", p.node.ID, true)
 		m.Set("node.Name", p.node.Name, true)
 	}
+	m.Set("field", p.field, true)
 
 	return m
 }
@@ -292,6 +305,7 @@ func (p exposedModuleActionProps) Format(in string, err error) string {
 		pairs = append(pairs, "{{node.ID}}", fns(p.node.ID))
 		pairs = append(pairs, "{{node.Name}}", fns(p.node.Name))
 	}
+	pairs = append(pairs, "{{field}}", fns(p.field))
 	return strings.NewReplacer(pairs...).Replace(in)
 }
 
@@ -693,6 +707,39 @@ func ExposedModuleErrComposeModuleNotFound(mm ...*exposedModuleActionProps) *err
 	return e
 }
 
+// ExposedModuleErrSensitiveField returns "federation:exposed_module.sensitiveField" as *errors.Error
+//
+// This function is auto-generated.
+//
+func ExposedModuleErrSensitiveField(mm ...*exposedModuleActionProps) *errors.Error {
+	var p = &exposedModuleActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("field {{field}} is too sensitive to be exposed", nil),
+
+		errors.Meta("type", "sensitiveField"),
+		errors.Meta("resource", "federation:exposed_module"),
+
+		errors.Meta(exposedModulePropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "federation"),
+		errors.Meta(locale.ErrorMetaKey{}, "exposedModule.errors.sensitiveField"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
 // ExposedModuleErrComposeNamespaceNotFound returns "federation:exposed_module.composeNamespaceNotFound" as *errors.Error
 //
 //
@@ -41,6 +41,9 @@ type (
 		handshaker nodeHandshaker
 
 		ac nodeAccessController
+
+		// paired nodes are registered as DAL connections
+		dal nodeDalManager
 	}
 
 	nodeAccessController interface {
@@ -78,6 +81,10 @@ func (svc *node) SetHandshaker(h nodeHandshaker) {
 	svc.handshaker = h
 }
 
+func (svc *node) SetDAL(d nodeDalManager) {
+	svc.dal = d
+}
+
 func (svc node) Search(ctx context.Context, filter types.NodeFilter) (set types.NodeSet, f types.NodeFilter, err error) {
 	var (
 		aProps = &nodeActionProps{filter: &filter}
@@ -248,7 +255,7 @@ func (svc node) Update(ctx context.Context, upd *types.Node) (*types.Node, error
 		n.UpdatedAt = now()
 
 		return nil
-	}, nil)
+	}, svc.addDalConnection)
 }
 
 func (svc node) DeleteByID(ctx context.Context, ID uint64) error {
@@ -257,7 +264,7 @@ func (svc node) DeleteByID(ctx context.Context, ID uint64) error {
 		n.DeletedBy = auth.GetIdentityFromContext(ctx).Identity()
 
 		return nil
-	}, nil)
+	}, svc.removeDalConnection)
 	return err
 }
 
@@ -267,7 +274,7 @@ func (svc node) UndeleteByID(ctx context.Context, ID uint64) error {
 		n.DeletedBy = 0
 
 		return nil
-	}, nil)
+	}, svc.addDalConnection)
 	return err
 }
 
@@ -378,7 +385,7 @@ func (svc node) HandshakeConfirm(ctx context.Context, nodeID uint64) error {
 
 		n.Status = types.NodeStatusPaired
 		return nil
-	}, nil)
+	}, svc.addDalConnection)
 	return err
 }
 
@@ -404,7 +411,7 @@ func (svc node) HandshakeComplete(ctx context.Context, sharedNodeID uint64, toke
 		n.UpdatedAt = now()
 
 		return nil
-	}, nil)
+	}, svc.addDalConnection)
 
 	return err
 }
@@ -0,0 +1,607 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ct "github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/federation/types"
+	"github.com/cortezaproject/corteza-server/pkg/dal"
+	"github.com/cortezaproject/corteza-server/pkg/dal/capabilities"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/cortezaproject/corteza-server/store"
+	"github.com/spf13/cast"
+)
+
+type (
+	nodeDalManager interface {
+		AddConnection(ctx context.Context, connectionID uint64, cp dal.ConnectionParams, dft dal.ConnectionDefaults, capabilities ...capabilities.Capability) (err error)
+		RemoveConnection(ctx context.Context, connectionID uint64) (err error)
+	}
+
+	// nodeModule describes what the remote node exposes for the local module
+	nodeModule struct {
+		// ID of the exposed module on the remote node
+		exposedModuleID uint64
+
+		// local namespace the mapped module belongs to
+		namespaceID uint64
+
+		// exposed (remote) field names mapped to local field names
+		fields map[string]string
+	}
+
+	nodeModuleResolver func(ctx context.Context, nodeID, composeModuleID uint64) (*nodeModule, error)
+
+	nodeSharedModulesLoader func(ctx context.Context, nodeID uint64) (types.SharedModuleSet, error)
+
+	// nodeConnection is a read-only DAL connection that reads records
+	// directly from the module exposed by the paired node
+	nodeConnection struct {
+		nodeID       uint64
+		sharedNodeID uint64
+		baseURL      string
+		authToken    string
+
+		// capabilities requested for the connection
+		requested capabilities.Set
+
+		// capabilities derived from the modules the node shares,
+		// reloaded when older than nodeCapabilitiesTTL
+		mux          sync.Mutex
+		capabilities capabilities.Set
+		checkedAt    time.Time
+
+		client  *http.Client
+		resolve nodeModuleResolver
+		shared  nodeSharedModulesLoader
+	}
+
+	nodeIterator struct {
+		model  *dal.Model
+		module *nodeModule
+
+		set ct.RecordSet
+		pos int
+
+		// record ID constraint and deleted state are sent to the
+		// remote node and checked again in case the node ignores them
+		ids     map[uint64]bool
+		deleted filter.State
+
+		nextPage *filter.PagingCursor
+		prevPage *filter.PagingCursor
+	}
+
+	nodeRecordsResponse struct {
+		Response struct {
+			Filter struct {
+				NextPage *filter.PagingCursor `json:"nextPage"`
+				PrevPage *filter.PagingCursor `json:"prevPage"`
+			} `json:"filter"`
+
+			Set ct.RecordSet `json:"set"`
+		} `json:"response"`
+	}
+)
+
+const (
+	// how long are the capabilities derived from shared modules kept
+	nodeCapabilitiesTTL = time.Minute
+)
+
+var (
+	nodeDalCapabilities = capabilities.Set{
+		capabilities.Search,
+		capabilities.Lookup,
+		capabilities.Paging,
+		capabilities.Sorting,
+		capabilities.RBAC,
+	}
+
+	// system fields that are passed to the remote node as they are
+	nodeSysFields = map[string]bool{
+		"ID":        true,
+		"createdAt": true,
+		"updatedAt": true,
+		"deletedAt": true,
+	}
+)
+
+func init() {
+	dal.RegisterParamsConnector(nodeDalConnector, dal.ConnectionTypeFederatedNode)
+	dal.RegisterDriver(dal.Driver{
+		Type:         "corteza::dal:driver:federated-node",
+		Capabilities: nodeDalCapabilities,
+		Connection:   dal.NewFederatedNodeDriverConnectionConfig(),
+	})
+}
+
+// nodeDalConnector opens a new connection to the paired federation node
+func nodeDalConnector(_ context.Context, params map[string]any, cc ...capabilities.Capability) (_ dal.Connection, err error) {
+	c := &nodeConnection{
+		client: http.DefaultClient,
+		resolve: func(ctx context.Context, nodeID, composeModuleID uint64) (*nodeModule, error) {
+			return resolveNodeModule(ctx, DefaultStore, nodeID, composeModuleID)
+		},
+		shared: func(ctx context.Context, nodeID uint64) (set types.SharedModuleSet, err error) {
+			set, _, err = store.SearchFederationSharedModules(ctx, DefaultStore, types.SharedModuleFilter{NodeID: nodeID})
+			return
+		},
+	}
+
+	if c.nodeID, err = cast.ToUint64E(params["nodeID"]); err != nil || c.nodeID == 0 {
+		return nil, fmt.Errorf("cannot open federated node connection: invalid node ID")
+	}
+
+	if c.sharedNodeID, err = cast.ToUint64E(params["sharedNodeID"]); err != nil || c.sharedNodeID == 0 {
+		return nil, fmt.Errorf("cannot open federated node connection: invalid shared node ID")
+	}
+
+	c.baseURL = strings.TrimRight(cast.ToString(params["baseURL"]), "/")
+	c.authToken = cast.ToString(params["authToken"])
+
+	if c.baseURL == "" || c.authToken == "" {
+		return nil, fmt.Errorf("cannot open federated node connection: node not paired")
+	}
+
+	c.requested = cc
+	return c, nil
+}
+
+// nodeCapabilities returns the capabilities of the connection
+// based on the modules the remote node exposes
+//
+// Records can only be read when at least one
+// of the shared modules exposes any fields
+func nodeCapabilities(mm types.SharedModuleSet, requested capabilities.Set) capabilities.Set {
+	out := capabilities.Set{capabilities.RBAC}
+
+	for _, m := range mm {
+		if m.DeletedAt == nil && len(m.Fields) > 0 {
+			out = nodeDalCapabilities
+			break
+		}
+	}
+
+	if len(requested) > 0 {
+		return out.Intersect(requested)
+	}
+
+	return out
+}
+
+// resolveNodeModule uses module mapping and shared module to find
+// the remote exposed module and fields the local module can read
+func resolveNodeModule(ctx context.Context, s store.Storer, nodeID, composeModuleID uint64) (*nodeModule, error) {
+	mm, _, err := store.SearchFederationModuleMappings(ctx, s, types.ModuleMappingFilter{
+		NodeID:          nodeID,
+		ComposeModuleID: composeModuleID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(mm) == 0 {
+		return nil, fmt.Errorf("module %d is not mapped to any module shared by node %d", composeModuleID, nodeID)
+	}
+
+	sm, err := store.LookupFederationSharedModuleByID(ctx, s, mm[0].FederationModuleID)
+	if err != nil {
+		return nil, fmt.Errorf("could not load shared module: %w", err)
+	}
+
+	out := &nodeModule{
+		exposedModuleID: sm.ExternalFederationModuleID,
+		namespaceID:     mm[0].ComposeNamespaceID,
+		fields:          make(map[string]string),
+	}
+
+	for _, fm := range mm[0].FieldMapping {
+		// only fields still exposed by the remote node can be read
+		if ok, _ := sm.Fields.HasField(fm.Origin.Name); ok {
+			out.fields[fm.Origin.Name] = fm.Destination.Name
+		}
+	}
+
+	return out, nil
+}
+
+// ReloadDALConnections registers all paired nodes as DAL connections
+//
+// Called before compose services are initialized so that modules
+// using paired nodes as their connections can be added to the DAL
+func ReloadDALConnections(ctx context.Context, s store.Storer, d nodeDalManager) (err error) {
+	nn, _, err := store.SearchFederationNodes(ctx, s, types.NodeFilter{Status: types.NodeStatusPaired})
+	if err != nil {
+		return
+	}
+
+	for _, n := range nn {
+		if err = addNodeDalConnection(ctx, d, n); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func addNodeDalConnection(ctx context.Context, d nodeDalManager, n *types.Node) error {
+	if n.Status != types.NodeStatusPaired || n.DeletedAt != nil {
+		return nil
+	}
+
+	return d.AddConnection(
+		ctx,
+		n.ID,
+		dal.NewFederatedNodeCOnnection(n.ID, n.SharedNodeID, n.BaseURL, n.PairToken, n.AuthToken),
+		dal.ConnectionDefaults{},
+	)
+}
+
+func (svc node) addDalConnection(ctx context.Context, n *types.Node) error {
+	if svc.dal == nil {
+		return nil
+	}
+
+	return addNodeDalConnection(ctx, svc.dal, n)
+}
+
+func (svc node) removeDalConnection(ctx context.Context, n *types.Node) error {
+	if svc.dal == nil || n.Status != types.NodeStatusPaired {
+		return nil
+	}
+
+	return svc.dal.RemoveConnection(ctx, n.ID)
+}
+
+// Capabilities are derived from the modules shared by the node
+//
+// Previously derived capabilities are kept when shared modules can not be loaded
+func (c *nodeConnection) Capabilities() capabilities.Set {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if time.Since(c.checkedAt) < nodeCapabilitiesTTL {
+		return c.capabilities
+	}
+
+	mm, err := c.shared(context.Background(), c.nodeID)
+	if err != nil {
+		return c.capabilities
+	}
+
+	c.capabilities = nodeCapabilities(mm, c.requested)
+	c.checkedAt = time.Now()
+	return c.capabilities
+}
+
+func (c *nodeConnection) Can(capabilities ...capabilities.Capability) bool {
+	return c.Capabilities().IsSuperset(capabilities...)
+}
+
+func (c *nodeConnection) Models(ctx context.Context) (dal.ModelSet, error) {
+	return nil, nil
+}
+
+// CreateModel is a no-op
+//
+// Module mapping can be defined after the module is created so
+// the remote module is resolved when records are accessed
+func (c *nodeConnection) CreateModel(ctx context.Context, m *dal.Model, mm ...*dal.Model) error {
+	return nil
+}
+
+func (c *nodeConnection) Search(ctx context.Context, m *dal.Model, f filter.Filter) (_ dal.Iterator, err error) {
+	var (
+		mod  *nodeModule
+		sort filter.SortExprSet
+		rsp  *nodeRecordsResponse
+		q    = url.Values{}
+	)
+
+	if f.Expression() != "" {
+		return nil, fmt.Errorf("cannot search federated node: query expressions are not supported")
+	}
+
+	if mod, err = c.resolve(ctx, c.nodeID, m.ResourceID); err != nil {
+		return
+	}
+
+	iter := &nodeIterator{
+		model:   m,
+		module:  mod,
+		deleted: f.StateConstraints()["deletedAt"],
+	}
+
+	for ident, vv := range f.Constraints() {
+		switch strings.ToLower(ident) {
+		case "moduleid", "namespaceid":
+			// remote records are always presented as
+			// records of the local module
+
+		case "id":
+			iter.ids = make(map[uint64]bool)
+			for _, v := range vv {
+				iter.ids[cast.ToUint64(v)] = true
+				q.Add("recordID", cast.ToString(v))
+			}
+
+		default:
+			return nil, fmt.Errorf("cannot search federated node: unsupported constraint %q", ident)
+		}
+	}
+
+	// remote node includes deleted records by default
+	q.Set("deleted", strconv.Itoa(int(iter.deleted)))
+
+	if sort, err = c.remoteSort(mod, f.OrderBy()); err != nil {
+		return
+	}
+
+	if len(sort) > 0 {
+		q.Set("sort", sort.String())
+	}
+
+	if f.Limit() > 0 {
+		q.Set("limit", strconv.FormatUint(uint64(f.Limit()), 10))
+	}
+
+	if cur := f.Cursor(); cur != nil {
+		// cursors are issued by the remote node and passed back as they are
+		q.Set("pageCursor", strings.Trim(cur.Encode(), `"`))
+	}
+
+	if rsp, err = c.fetch(ctx, mod, q); err != nil {
+		return
+	}
+
+	iter.set = rsp.Response.Set
+	iter.nextPage = rsp.Response.Filter.NextPage
+	iter.prevPage = rsp.Response.Filter.PrevPage
+
+	return iter, nil
+}
+
+// Lookup reads a single record from the remote module
+//
+// Exposed modules do not provide single record endpoint,
+// records are listed with the record ID constraint
+func (c *nodeConnection) Lookup(ctx context.Context, m *dal.Model, pkv dal.ValueGetter, r dal.ValueSetter) (err error) {
+	var (
+		mod *nodeModule
+		rsp *nodeRecordsResponse
+		id  uint64
+		raw any
+	)
+
+	for key := range pkv.CountValues() {
+		if strings.EqualFold(key, "ID") {
+			if raw, err = pkv.GetValue(key, 0); err != nil {
+				return
+			}
+
+			id = cast.ToUint64(raw)
+		}
+	}
+
+	if id == 0 {
+		return fmt.Errorf("cannot lookup federated record: missing record ID")
+	}
+
+	if mod, err = c.resolve(ctx, c.nodeID, m.ResourceID); err != nil {
+		return
+	}
+
+	q := url.Values{
+		"recordID": {strconv.FormatUint(id, 10)},
+		"limit":    {"1"},
+	}
+
+	if rsp, err = c.fetch(ctx, mod, q); err != nil {
+		return
+	}
+
+	for _, rec := range rsp.Response.Set {
+		if rec.ID == id {
+			return nodeDecodeRecord(m, mod, rec, r)
+		}
+	}
+
+	return sql.ErrNoRows
+}
+
+func (c *nodeConnection) Create(ctx context.Context, m *dal.Model, rr ...dal.ValueGetter) error {
+	return fmt.Errorf("cannot create records: federated node connection is read-only")
+}
+
+func (c *nodeConnection) Update(ctx context.Context, m *dal.Model, r dal.ValueGetter) error {
+	return fmt.Errorf("cannot update records: federated node connection is read-only")
+}
+
+func (c *nodeConnection) Delete(ctx context.Context, m *dal.Model, pkv dal.ValueGetter) error {
+	return fmt.Errorf("cannot delete records: federated node connection is read-only")
+}
+
+func (c *nodeConnection) Truncate(ctx context.Context, m *dal.Model) error {
+	return fmt.Errorf("cannot truncate records: federated node connection is read-only")
+}
+
+func (c *nodeConnection) DeleteModel(ctx context.Context, m *dal.Model, mm ...*dal.Model) error {
+	return fmt.Errorf("cannot delete model: not supported by federated node connection")
+}
+
+func (c *nodeConnection) UpdateModel(ctx context.Context, old *dal.Model, new *dal.Model) error {
+	return fmt.Errorf("cannot update model: not supported by federated node connection")
+}
+
+func (c *nodeConnection) UpdateModelAttribute(ctx context.Context, sch *dal.Model, old dal.Attribute, new dal.Attribute, trans ...dal.TransformationFunction) error {
+	return fmt.Errorf("cannot update model attribute: not supported by federated node connection")
+}
+
+// remoteSort translates local sort columns into remote field names
+func (c *nodeConnection) remoteSort(mod *nodeModule, sort filter.SortExprSet) (out filter.SortExprSet, err error) {
+	for _, s := range sort {
+		col := ""
+		if nodeSysFields[s.Column] {
+			col = s.Column
+		} else {
+			for remote, local := range mod.fields {
+				if local == s.Column {
+					col = remote
+					break
+				}
+			}
+		}
+
+		if col == "" {
+			return nil, fmt.Errorf("cannot sort by %q: field not exposed by federated node", s.Column)
+		}
+
+		out = append(out, &filter.SortExpr{Column: col, Descending: s.Descending})
+	}
+
+	return
+}
+
+// fetch reads a page of records from the exposed module on the remote node
+//
+// Uses the same endpoint and auth token as the data sync worker
+func (c *nodeConnection) fetch(ctx context.Context, mod *nodeModule, q url.Values) (out *nodeRecordsResponse, err error) {
+	var (
+		req *http.Request
+		rsp *http.Response
+
+		endpoint = fmt.Sprintf("%s/nodes/%d/modules/%d/records/", c.baseURL, c.sharedNodeID, mod.exposedModuleID)
+	)
+
+	if len(q) > 0 {
+		endpoint += "?" + q.Encode()
+	}
+
+	if req, err = http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil); err != nil {
+		return
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.authToken)
+
+	if rsp, err = c.client.Do(req); err != nil {
+		return nil, fmt.Errorf("could not fetch records from federated node: %w", err)
+	}
+
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not fetch records from federated node: invalid return status: %d", rsp.StatusCode)
+	}
+
+	out = &nodeRecordsResponse{}
+	if err = json.NewDecoder(rsp.Body).Decode(out); err != nil {
+		return nil, fmt.Errorf("could not decode records from federated node: %w", err)
+	}
+
+	return
+}
+
+func (i *nodeIterator) Next(ctx context.Context) bool {
+	for i.pos < len(i.set) {
+		rec := i.set[i.pos]
+		i.pos++
+
+		if i.ids != nil && !i.ids[rec.ID] {
+			continue
+		}
+
+		switch {
+		case i.deleted == filter.StateExcluded && rec.DeletedAt != nil:
+			continue
+		case i.deleted == filter.StateExclusive && rec.DeletedAt == nil:
+			continue
+		}
+
+		return true
+	}
+
+	return false
+}
+
+func (i *nodeIterator) Err() error {
+	return nil
+}
+
+func (i *nodeIterator) Scan(r dal.ValueSetter) error {
+	if i.pos == 0 {
+		return fmt.Errorf("cannot scan: call Next first")
+	}
+
+	return nodeDecodeRecord(i.model, i.module, i.set[i.pos-1], r)
+}
+
+func (i *nodeIterator) Close() error {
+	i.set = nil
+	return nil
+}
+
+// BackCursor returns the cursor issued by the remote node
+func (i *nodeIterator) BackCursor(dal.ValueGetter) (*filter.PagingCursor, error) {
+	return i.prevPage, nil
+}
+
+// ForwardCursor returns the cursor issued by the remote node
+func (i *nodeIterator) ForwardCursor(dal.ValueGetter) (*filter.PagingCursor, error) {
+	return i.nextPage, nil
+}
+
+// nodeDecodeRecord sets remote record values as values of the local module
+//
+// Remote user references are omitted since they are meaningless on this node
+func nodeDecodeRecord(m *dal.Model, mod *nodeModule, rec *ct.Record, r dal.ValueSetter) (err error) {
+	var (
+		sys = []struct {
+			ident string
+			value any
+		}{
+			{"ID", rec.ID},
+			{"moduleID", m.ResourceID},
+			{"namespaceID", mod.namespaceID},
+			{"createdAt", rec.CreatedAt},
+			{"updatedAt", rec.UpdatedAt},
+			{"deletedAt", rec.DeletedAt},
+		}
+
+		places = make(map[string]uint)
+	)
+
+	for _, s := range sys {
+		if !m.HasAttribute(s.ident) {
+			continue
+		}
+
+		if err = r.SetValue(s.ident, 0, s.value); err != nil {
+			return
+		}
+	}
+
+	for _, rv := range rec.Values {
+		local := mod.fields[rv.Name]
+		if local == "" || rv.DeletedAt != nil || !m.HasAttribute(local) {
+			continue
+		}
+
+		if err = r.SetValue(local, places[local], rv.Value); err != nil {
+			return
+		}
+
+		places[local]++
+	}
+
+	return
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ct "github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/federation/types"
+	"github.com/cortezaproject/corteza-server/pkg/dal"
+	"github.com/cortezaproject/corteza-server/pkg/dal/capabilities"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeConnection(t *testing.T) {
+	var (
+		ctx = context.Background()
+		req = require.New(t)
+
+		model = &dal.Model{
+			ResourceID: 300,
+			Attributes: dal.AttributeSet{
+				dal.PrimaryAttribute("ID", &dal.CodecPlain{}),
+				dal.FullAttribute("moduleID", &dal.TypeID{}, &dal.CodecPlain{}),
+				dal.FullAttribute("namespaceID", &dal.TypeID{}, &dal.CodecPlain{}),
+				dal.FullAttribute("name", &dal.TypeText{}, &dal.CodecPlain{}),
+			},
+		}
+
+		page = func(w http.ResponseWriter, next *filter.PagingCursor, rr ...*ct.Record) {
+			rsp := nodeRecordsResponse{}
+			rsp.Response.Set = rr
+			rsp.Response.Filter.NextPage = next
+			req.NoError(json.NewEncoder(w).Encode(rsp))
+		}
+
+		cursor = &filter.PagingCursor{}
+
+		queries []string
+	)
+
+	cursor.Set("ID", 2, false)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req.Equal("Bearer auth-token", r.Header.Get("Authorization"))
+		req.Equal("/federation/nodes/42/modules/100/records/", r.URL.Path)
+		queries = append(queries, r.URL.RawQuery)
+
+		if id := r.URL.Query().Get("recordID"); id != "" {
+			if id == "3" {
+				page(w, nil, &ct.Record{ID: 3, Values: ct.RecordValueSet{{Name: "remoteName", Value: "three"}}})
+			} else {
+				page(w, nil)
+			}
+
+			return
+		}
+
+		if r.URL.Query().Get("pageCursor") == "" {
+			page(w, cursor,
+				&ct.Record{ID: 1, Values: ct.RecordValueSet{{Name: "remoteName", Value: "one"}, {Name: "secret", Value: "x"}}},
+				&ct.Record{ID: 2, Values: ct.RecordValueSet{{Name: "remoteName", Value: "two"}}},
+			)
+			return
+		}
+
+		page(w, nil, &ct.Record{ID: 3, Values: ct.RecordValueSet{{Name: "remoteName", Value: "three"}}})
+	}))
+	defer srv.Close()
+
+	conn, err := nodeDalConnector(ctx, dal.NewFederatedNodeCOnnection(1, 42, srv.URL+"/federation", "pair-token", "auth-token").Params)
+	req.NoError(err)
+
+	conn.(*nodeConnection).shared = func(_ context.Context, nodeID uint64) (types.SharedModuleSet, error) {
+		req.Equal(uint64(1), nodeID)
+		return types.SharedModuleSet{{ID: 100, Fields: types.ModuleFieldSet{{Name: "remoteName"}}}}, nil
+	}
+
+	req.True(conn.Can(capabilities.Search, capabilities.Lookup, capabilities.Paging, capabilities.Sorting))
+	req.False(conn.Can(capabilities.Create))
+
+	conn.(*nodeConnection).resolve = func(_ context.Context, nodeID, composeModuleID uint64) (*nodeModule, error) {
+		req.Equal(uint64(1), nodeID)
+		req.Equal(uint64(300), composeModuleID)
+		return &nodeModule{exposedModuleID: 100, namespaceID: 200, fields: map[string]string{"remoteName": "name"}}, nil
+	}
+
+	t.Run("search", func(t *testing.T) {
+		queries = nil
+
+		f := ct.RecordFilter{ModuleID: 300, NamespaceID: 200}
+		f.Limit = 2
+		f.Sort = filter.SortExprSet{{Column: "name", Descending: true}}
+
+		iter, err := conn.Search(ctx, model, f.ToFilter())
+		req.NoError(err)
+		req.Equal([]string{"deleted=0&limit=2&sort=remoteName+DESC"}, queries)
+
+		var set ct.RecordSet
+		for iter.Next(ctx) {
+			rec := &ct.Record{}
+			req.NoError(iter.Scan(rec))
+			set = append(set, rec)
+		}
+
+		req.Len(set, 2)
+		req.Equal(uint64(300), set[0].ModuleID)
+		req.Equal(uint64(200), set[0].NamespaceID)
+		req.Equal("one", set[0].Values.Get("name", 0).Value)
+		req.Nil(set[0].Values.Get("secret", 0))
+
+		next, err := iter.ForwardCursor(set[1])
+		req.NoError(err)
+		req.Equal(fmt.Sprint(cursor), fmt.Sprint(next))
+	})
+
+	t.Run("sort by field not exposed", func(t *testing.T) {
+		f := ct.RecordFilter{}
+		f.Sort = filter.SortExprSet{{Column: "secret"}}
+
+		_, err := conn.Search(ctx, model, f.ToFilter())
+		req.Error(err)
+	})
+
+	t.Run("search by ID", func(t *testing.T) {
+		queries = nil
+
+		f := ct.RecordFilter{LabeledIDs: []uint64{3}}
+		f.Deleted = filter.StateInclusive
+
+		iter, err := conn.Search(ctx, model, f.ToFilter())
+		req.NoError(err)
+		req.Equal([]string{"deleted=1&recordID=3"}, queries)
+		req.True(iter.Next(ctx))
+		req.False(iter.Next(ctx))
+	})
+
+	t.Run("lookup", func(t *testing.T) {
+		queries = nil
+
+		rec := &ct.Record{}
+		req.NoError(conn.Lookup(ctx, model, dal.PKValues{"id": uint64(3)}, rec))
+		req.Equal(uint64(3), rec.ID)
+		req.Equal("three", rec.Values.Get("name", 0).Value)
+		req.Equal([]string{"limit=1&recordID=3"}, queries)
+
+		req.ErrorIs(conn.Lookup(ctx, model, dal.PKValues{"id": uint64(4)}, &ct.Record{}), sql.ErrNoRows)
+	})
+
+	t.Run("capabilities of node without shared modules", func(t *testing.T) {
+		req.Equal(capabilities.Set{capabilities.RBAC}, nodeCapabilities(nil, nil))
+		req.Equal(capabilities.Set{capabilities.Search}, nodeCapabilities(
+			types.SharedModuleSet{{Fields: types.ModuleFieldSet{{Name: "f"}}}},
+			capabilities.Set{capabilities.Search, capabilities.Create},
+		))
+	})
+
+	t.Run("read-only", func(t *testing.T) {
+		req.Error(conn.Create(ctx, model, &ct.Record{}))
+		req.Error(conn.Update(ctx, model, &ct.Record{}))
+		req.Error(conn.Delete(ctx, model, dal.PKValues{"id": uint64(1)}))
+	})
+}
@@ -9,6 +9,7 @@ import (
 	cs "github.com/cortezaproject/corteza-server/compose/service"
 	"github.com/cortezaproject/corteza-server/pkg/actionlog"
 	"github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/dal"
 	"github.com/cortezaproject/corteza-server/pkg/id"
 	"github.com/cortezaproject/corteza-server/pkg/label"
 	"github.com/cortezaproject/corteza-server/pkg/options"
@@ -103,6 +104,9 @@ func Initialize(_ context.Context, log *zap.Logger, s store.Storer, c Config) (e
 		c.Server,
 		DefaultAccessControl,
 	)
+	// Keep DAL connections of paired nodes up to date
+	DefaultNode.SetDAL(dal.Service())
+
 	DefaultNodeSync = NodeSync()
 	DefaultExposedModule = ExposedModule()
 	DefaultSharedModule = SharedModule()
@@ -10,6 +10,7 @@ import (
 type (
 	scopeCtxKey    struct{}
 	profilerCtxKey struct{}
+	routeCtxKey    struct{}
 )
 
 func ScopeToContext(ctx context.Context, s *types.Scp) context.Context {
@@ -39,3 +40,15 @@ func ProfilerFromContext(ctx context.Context) (h *profiler.Hit) {
 
 	return hh.(*profiler.Hit)
 }
+
+func RouteToContext(ctx context.Context, routeID uint64) context.Context {
+	return context.WithValue(ctx, routeCtxKey{}, routeID)
+}
+
+func RouteFromContext(ctx context.Context) uint64 {
+	if r, ok := ctx.Value(routeCtxKey{}).(uint64); ok {
+		return r
+	}
+
+	return 0
+}
@@ -0,0 +1,273 @@
+package filter
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	agctx "github.com/cortezaproject/corteza-server/pkg/apigw/ctx"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+	st "github.com/cortezaproject/corteza-server/system/types"
+)
+
+const (
+	JwtSourceCorteza = "corteza"
+	JwtSourceJwks    = "jwks"
+	JwtSourceSecret  = "secret"
+
+	SignatureFormatGithub = "github"
+	SignatureFormatStripe = "stripe"
+	SignatureFormatPlain  = "plain"
+
+	// ApiKeyCredentialsKind is the kind of credentials
+	// API keys are kept in; key is owned by the user it authenticates
+	ApiKeyCredentialsKind = "apigw-api-key"
+
+	// API key = <32 random chars><credentials-id>
+	apiKeySecretLength = 32
+
+	// how often is the last use of the API key recorded
+	apiKeyLastUsedInterval = time.Minute
+
+	// how often are JWKS refreshed when
+	// the response does not say otherwise
+	jwksMinRefreshInterval = time.Minute * 15
+)
+
+type (
+	// AuthStorer is used by the auth prefilters to
+	// resolve the identity of the caller
+	AuthStorer interface {
+		LookupUserByID(ctx context.Context, id uint64) (*st.User, error)
+		LookupUserByEmail(ctx context.Context, email string) (*st.User, error)
+		SearchRoles(ctx context.Context, f st.RoleFilter) (st.RoleSet, st.RoleFilter, error)
+		LookupCredentialByID(ctx context.Context, id uint64) (*st.Credential, error)
+		UpdateCredential(ctx context.Context, rr ...*st.Credential) error
+	}
+)
+
+// MakeApiKey generates new API key for the credentials
+//
+// Key is returned to the owner (only once); hashed secret
+// is kept in the credentials
+func MakeApiKey(credentialsID uint64) (key, hashed string, err error) {
+	// secret is hex encoded
+	buf := make([]byte, apiKeySecretLength/2)
+	if _, err = rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("could not generate API key: %w", err)
+	}
+
+	secret := hex.EncodeToString(buf)
+	return secret + strconv.FormatUint(credentialsID, 10), hashApiKeySecret(secret), nil
+}
+
+// parseApiKey splits the API key to credentials ID and secret
+func parseApiKey(key string) (ID uint64, secret string) {
+	if len(key) <= apiKeySecretLength {
+		return
+	}
+
+	if ID, _ = strconv.ParseUint(key[apiKeySecretLength:], 10, 64); ID == 0 {
+		return
+	}
+
+	return ID, key[:apiKeySecretLength]
+}
+
+func hashApiKeySecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// verifyApiKey checks the key against the stored credentials
+func verifyApiKey(c *st.Credential, secret string) bool {
+	return c != nil &&
+		c.Kind == ApiKeyCredentialsKind &&
+		c.Valid() &&
+		subtle.ConstantTimeCompare([]byte(c.Credentials), []byte(hashApiKeySecret(secret))) == 1
+}
+
+// authUser resolves user from the ID or email
+func authUser(ctx context.Context, s AuthStorer, value string) (*st.User, error) {
+	if value == "" {
+		return nil, fmt.Errorf("user not set")
+	}
+
+	if ID, err := strconv.ParseUint(value, 10, 64); err == nil {
+		return s.LookupUserByID(ctx, ID)
+	}
+
+	if strings.Contains(value, "@") {
+		return s.LookupUserByEmail(ctx, value)
+	}
+
+	return nil, fmt.Errorf("could not resolve user from %q", value)
+}
+
+// authIdentity makes identity (with role memberships) of the valid user
+func authIdentity(ctx context.Context, s AuthStorer, u *st.User) (auth.Identifiable, error) {
+	if u == nil || !u.Valid() {
+		return nil, fmt.Errorf("invalid user")
+	}
+
+	rr, _, err := s.SearchRoles(ctx, st.RoleFilter{MemberID: u.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.Authenticated(u.ID, rr.IDs()...), nil
+}
+
+// setScopeIdentity puts the identity of the caller into the pipeline scope
+//
+// Processers (workflow) are executed with that identity
+func setScopeIdentity(r *http.Request, i auth.Identifiable) {
+	agctx.ScopeFromContext(r.Context()).Set("identity", i)
+}
+
+// bearerToken reads the token from the header
+//
+// Bearer prefix is optional
+func bearerToken(r *http.Request, header string) string {
+	var (
+		v = strings.TrimSpace(r.Header.Get(header))
+	)
+
+	if len(v) > 7 && strings.EqualFold(v[:7], "bearer ") {
+		return strings.TrimSpace(v[7:])
+	}
+
+	return v
+}
+
+// requestBody reads the (buffered) body of the request
+//
+// Body kept in the scope is rewound after it is read so it can
+// be read again by the filters and processers down the pipeline
+func requestBody(r *http.Request) ([]byte, error) {
+	if ar := agctx.ScopeFromContext(r.Context()).Request(); ar != nil {
+		return ioutil.ReadAll(ar.Body)
+	}
+
+	return nil, errors.New("request not in scope")
+}
+
+func signatureHash(algorithm string) (func() hash.Hash, error) {
+	switch algorithm {
+	case "", "sha256":
+		return sha256.New, nil
+	case "sha1":
+		return sha1.New, nil
+	case "sha512":
+		return sha512.New, nil
+	default:
+		return nil, fmt.Errorf("unknown algorithm %q", algorithm)
+	}
+}
+
+func signature(fn func() hash.Hash, secret string, payload ...[]byte) []byte {
+	mac := hmac.New(fn, []byte(secret))
+	for _, p := range payload {
+		mac.Write(p)
+	}
+
+	return mac.Sum(nil)
+}
+
+// verifyGithubSignature verifies <algorithm>=<hex digest> signature
+func verifyGithubSignature(header, algorithm, secret string, body []byte) bool {
+	fn, err := signatureHash(algorithm)
+	if err != nil {
+		return false
+	}
+
+	if algorithm == "" {
+		algorithm = "sha256"
+	}
+
+	digest, err := hex.DecodeString(strings.TrimPrefix(header, algorithm+"="))
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal(digest, signature(fn, secret, body))
+}
+
+// verifyStripeSignature verifies t=<timestamp>,v1=<hex digest> signature
+//
+// Digest is calculated from <timestamp>.<body>; signatures
+// with timestamps outside of tolerance are rejected
+func verifyStripeSignature(header, secret string, body []byte, now time.Time, tolerance time.Duration) bool {
+	var (
+		ts      string
+		digests [][]byte
+	)
+
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+
+		switch kv[0] {
+		case "t":
+			ts = kv[1]
+		case "v1":
+			if d, err := hex.DecodeString(kv[1]); err == nil {
+				digests = append(digests, d)
+			}
+		}
+	}
+
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	if d := now.Sub(time.Unix(unix, 0)); d > tolerance || d < -tolerance {
+		return false
+	}
+
+	expected := signature(sha256.New, secret, []byte(ts), []byte("."), body)
+	for _, d := range digests {
+		if hmac.Equal(d, expected) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// verifyPlainSignature verifies hex or base64 encoded digest
+func verifyPlainSignature(header, algorithm, secret string, body []byte) bool {
+	fn, err := signatureHash(algorithm)
+	if err != nil {
+		return false
+	}
+
+	expected := signature(fn, secret, body)
+
+	if digest, err := hex.DecodeString(header); err == nil {
+		return hmac.Equal(digest, expected)
+	}
+
+	if digest, err := base64.StdEncoding.DecodeString(header); err == nil {
+		return hmac.Equal(digest, expected)
+	}
+
+	return false
+}
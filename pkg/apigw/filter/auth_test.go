@@ -0,0 +1,135 @@
+package filter
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/store"
+	st "github.com/cortezaproject/corteza-server/system/types"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	mockAuthStorer struct {
+		users       st.UserSet
+		roles       map[uint64]st.RoleSet
+		credentials st.CredentialSet
+	}
+)
+
+func (s *mockAuthStorer) LookupUserByID(_ context.Context, id uint64) (*st.User, error) {
+	if u := s.users.FindByID(id); u != nil {
+		return u, nil
+	}
+
+	return nil, store.ErrNotFound
+}
+
+func (s *mockAuthStorer) LookupUserByEmail(_ context.Context, email string) (*st.User, error) {
+	for _, u := range s.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+
+	return nil, store.ErrNotFound
+}
+
+func (s *mockAuthStorer) SearchRoles(_ context.Context, f st.RoleFilter) (st.RoleSet, st.RoleFilter, error) {
+	return s.roles[f.MemberID], f, nil
+}
+
+func (s *mockAuthStorer) LookupCredentialByID(_ context.Context, id uint64) (*st.Credential, error) {
+	if c := s.credentials.FindByID(id); c != nil {
+		return c, nil
+	}
+
+	return nil, store.ErrNotFound
+}
+
+func (s *mockAuthStorer) UpdateCredential(context.Context, ...*st.Credential) error {
+	return nil
+}
+
+func Test_apiKey(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		key, hashed, err = MakeApiKey(42)
+		c                = &st.Credential{ID: 42, Kind: ApiKeyCredentialsKind, Credentials: hashed}
+	)
+
+	req.NoError(err)
+	req.NotContains(key, hashed)
+
+	ID, secret := parseApiKey(key)
+	req.Equal(uint64(42), ID)
+	req.True(verifyApiKey(c, secret))
+
+	// other secret
+	_, other, err := MakeApiKey(42)
+	req.NoError(err)
+	req.False(verifyApiKey(c, other[:apiKeySecretLength]))
+
+	// too short
+	ID, _ = parseApiKey(secret)
+	req.Zero(ID)
+
+	// other kind of credentials
+	c.Kind = "password"
+	req.False(verifyApiKey(c, secret))
+}
+
+func Test_verifySignature(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		now    = time.Now()
+		body   = []byte(`{"foo":"bar"}`)
+		secret = "s3cr3t"
+
+		sign = func(fn func() []byte) string {
+			return hex.EncodeToString(fn())
+		}
+
+		sha256sum = func(pp ...[]byte) []byte {
+			mac := hmac.New(sha256.New, []byte(secret))
+			for _, p := range pp {
+				mac.Write(p)
+			}
+			return mac.Sum(nil)
+		}
+
+		sha1sum = func() []byte {
+			mac := hmac.New(sha1.New, []byte(secret))
+			mac.Write(body)
+			return mac.Sum(nil)
+		}
+
+		stripe = func(ts time.Time) string {
+			t := fmt.Sprintf("%d", ts.Unix())
+			return fmt.Sprintf("t=%s,v1=%s,v0=foo", t, hex.EncodeToString(sha256sum([]byte(t), []byte("."), body)))
+		}
+	)
+
+	req.True(verifyGithubSignature("sha256="+sign(func() []byte { return sha256sum(body) }), "", secret, body))
+	req.True(verifyGithubSignature("sha1="+sign(sha1sum), "sha1", secret, body))
+	req.False(verifyGithubSignature("sha256="+sign(sha1sum), "", secret, body))
+	req.False(verifyGithubSignature("sha256="+sign(func() []byte { return sha256sum(body) }), "", "other", body))
+
+	req.True(verifyStripeSignature(stripe(now), secret, body, now, time.Minute))
+	req.False(verifyStripeSignature(stripe(now.Add(-time.Hour)), secret, body, now, time.Minute))
+	req.False(verifyStripeSignature(stripe(now), secret, []byte("{}"), now, time.Minute))
+	req.False(verifyStripeSignature("v1=foo", secret, body, now, time.Minute))
+
+	req.True(verifyPlainSignature(sign(func() []byte { return sha256sum(body) }), "", secret, body))
+	req.True(verifyPlainSignature(base64.StdEncoding.EncodeToString(sha256sum(body)), "sha256", secret, body))
+	req.False(verifyPlainSignature("foo", "", secret, body))
+}
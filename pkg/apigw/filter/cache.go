@@ -0,0 +1,296 @@
+package filter
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	CacheHit  = "hit"
+	CacheMiss = "miss"
+
+	// responses with larger bodies are not cached
+	cacheMaxBodySize = 1 << 20
+
+	// max number of cached responses (all routes)
+	cacheMaxEntries = 10000
+
+	// how often are expired responses removed
+	cacheSweepInterval = time.Minute
+)
+
+type (
+	// ResponseCache keeps cached responses of the routes in memory
+	//
+	// Cache is not shared between server instances
+	ResponseCache struct {
+		mux     sync.RWMutex
+		entries map[string]*cacheEntry
+		swept   time.Time
+	}
+
+	cacheEntry struct {
+		route     uint64
+		status    int
+		header    http.Header
+		body      []byte
+		etag      string
+		storedAt  time.Time
+		expiresAt time.Time
+	}
+
+	// cacheRecorder buffers the response so that it can be
+	// stored and written with the ETag of the cache entry
+	//
+	// Response that can not be cached (ie. too large or streamed)
+	// is passed through
+	cacheRecorder struct {
+		http.ResponseWriter
+
+		status      int
+		body        bytes.Buffer
+		passthrough bool
+	}
+
+	// cacheControl holds the directives of the Cache-Control header
+	cacheControl map[string]string
+)
+
+func NewResponseCache() *ResponseCache {
+	return &ResponseCache{
+		entries: make(map[string]*cacheEntry),
+	}
+}
+
+func (c *ResponseCache) get(key string, now time.Time) *cacheEntry {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	if e, ok := c.entries[key]; ok && e.expiresAt.After(now) {
+		return e
+	}
+
+	return nil
+}
+
+func (c *ResponseCache) set(key string, e *cacheEntry) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if e.storedAt.Sub(c.swept) > cacheSweepInterval {
+		c.swept = e.storedAt
+		c.sweep(e.storedAt)
+	}
+
+	if _, has := c.entries[key]; !has && len(c.entries) >= cacheMaxEntries {
+		// cache is full
+		return
+	}
+
+	c.entries[key] = e
+}
+
+// Purge removes cached responses of the route
+//
+// All cached responses are removed when route is not set
+func (c *ResponseCache) Purge(routeID uint64) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	for k, e := range c.entries {
+		if routeID == 0 || e.route == routeID {
+			delete(c.entries, k)
+		}
+	}
+}
+
+func (c *ResponseCache) sweep(now time.Time) {
+	for k, e := range c.entries {
+		if !e.expiresAt.After(now) {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// write writes the cached response
+//
+// Not-modified is written when one of the request's
+// If-None-Match values matches the entry's ETag
+func (e *cacheEntry) write(rw http.ResponseWriter, r *http.Request, now time.Time) {
+	h := rw.Header()
+	for k, vv := range e.header {
+		h[k] = append([]string(nil), vv...)
+	}
+
+	h.Set("ETag", e.etag)
+	h.Set("Age", strconv.FormatInt(int64(now.Sub(e.storedAt)/time.Second), 10))
+
+	if etagMatch(r.Header.Get("If-None-Match"), e.etag) {
+		rw.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	rw.WriteHeader(e.status)
+
+	if r.Method != http.MethodHead {
+		_, _ = rw.Write(e.body)
+	}
+}
+
+func (rec *cacheRecorder) WriteHeader(status int) {
+	if rec.passthrough {
+		rec.ResponseWriter.WriteHeader(status)
+		return
+	}
+
+	if rec.status == 0 {
+		rec.status = status
+	}
+
+	if rec.status != http.StatusOK {
+		// only successful responses are cached
+		rec.bypass()
+	}
+}
+
+func (rec *cacheRecorder) Write(b []byte) (int, error) {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+
+	if !rec.passthrough && rec.body.Len()+len(b) > cacheMaxBodySize {
+		rec.bypass()
+	}
+
+	if rec.passthrough {
+		return rec.ResponseWriter.Write(b)
+	}
+
+	return rec.body.Write(b)
+}
+
+func (rec *cacheRecorder) Flush() {
+	rec.bypass()
+
+	if f, ok := rec.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// bypass writes the buffered response and passes the rest of it through
+func (rec *cacheRecorder) bypass() {
+	if rec.passthrough {
+		return
+	}
+
+	rec.passthrough = true
+
+	if rec.status != 0 {
+		rec.ResponseWriter.WriteHeader(rec.status)
+	}
+
+	if rec.body.Len() > 0 {
+		_, _ = rec.ResponseWriter.Write(rec.body.Bytes())
+		rec.body.Reset()
+	}
+}
+
+// parseCacheControl parses directives of the Cache-Control header
+func parseCacheControl(v string) cacheControl {
+	cc := cacheControl{}
+
+	for _, d := range strings.Split(v, ",") {
+		d = strings.TrimSpace(d)
+		if d == "" {
+			continue
+		}
+
+		kv := strings.SplitN(d, "=", 2)
+		if len(kv) == 2 {
+			cc[strings.ToLower(kv[0])] = strings.Trim(kv[1], `"`)
+		} else {
+			cc[strings.ToLower(kv[0])] = ""
+		}
+	}
+
+	return cc
+}
+
+func (cc cacheControl) has(d string) bool {
+	_, has := cc[d]
+	return has
+}
+
+// maxAge returns s-maxage or max-age in seconds
+func (cc cacheControl) maxAge() (time.Duration, bool) {
+	for _, d := range []string{"s-maxage", "max-age"} {
+		if v, has := cc[d]; has {
+			if s, err := strconv.ParseUint(v, 10, 32); err == nil {
+				return time.Duration(s) * time.Second, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// etagMatch checks if any of the (If-None-Match) values matches the ETag
+//
+// Weak comparison is used as defined in RFC 7232
+func etagMatch(values, etag string) bool {
+	if values == "" || etag == "" {
+		return false
+	}
+
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, v := range strings.Split(values, ",") {
+		v = strings.TrimSpace(v)
+		if v == "*" || strings.TrimPrefix(v, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cacheKey assembles the key of the cached response from the
+// request method, path, selected query params, headers and the identity
+//
+// Query params and headers are expected to be sorted;
+// all query params are used when query is set to "*"
+func cacheKey(routeID uint64, r *http.Request, query, headers []string, identity uint64) string {
+	var (
+		b bytes.Buffer
+		q = r.URL.Query()
+	)
+
+	fmt.Fprintf(&b, "%s\n%s\n", r.Method, r.URL.Path)
+
+	if len(query) == 1 && query[0] == "*" {
+		query = make([]string, 0, len(q))
+		for p := range q {
+			query = append(query, p)
+		}
+
+		sort.Strings(query)
+	}
+
+	for _, p := range query {
+		fmt.Fprintf(&b, "q:%s=%s\n", p, strings.Join(q[p], ","))
+	}
+
+	for _, h := range headers {
+		fmt.Fprintf(&b, "h:%s=%s\n", strings.ToLower(h), strings.Join(r.Header.Values(h), ","))
+	}
+
+	fmt.Fprintf(&b, "i:%d", identity)
+
+	return fmt.Sprintf("route:%d:%x", routeID, sha1.Sum(b.Bytes()))
+}
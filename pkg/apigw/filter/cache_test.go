@@ -0,0 +1,266 @@
+package filter
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	agctx "github.com/cortezaproject/corteza-server/pkg/apigw/ctx"
+	prf "github.com/cortezaproject/corteza-server/pkg/apigw/profiler"
+	"github.com/cortezaproject/corteza-server/pkg/apigw/types"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/options"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_cacheKey(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		mk = func(target, lang string) *http.Request {
+			r := httptest.NewRequest(http.MethodGet, target, http.NoBody)
+			r.Header.Set("Accept-Language", lang)
+			return r
+		}
+
+		base = cacheKey(1, mk("/foo?a=1&b=2", "en"), []string{"a"}, []string{"Accept-Language"}, 0)
+	)
+
+	// params not used in the key
+	req.Equal(base, cacheKey(1, mk("/foo?a=1&b=3", "en"), []string{"a"}, []string{"Accept-Language"}, 0))
+
+	req.NotEqual(base, cacheKey(2, mk("/foo?a=1&b=2", "en"), []string{"a"}, []string{"Accept-Language"}, 0))
+	req.NotEqual(base, cacheKey(1, mk("/foo?a=2&b=2", "en"), []string{"a"}, []string{"Accept-Language"}, 0))
+	req.NotEqual(base, cacheKey(1, mk("/foo?a=1&b=2", "de"), []string{"a"}, []string{"Accept-Language"}, 0))
+	req.NotEqual(base, cacheKey(1, mk("/foo?a=1&b=2", "en"), []string{"a"}, []string{"Accept-Language"}, 42))
+	req.NotEqual(base, cacheKey(1, mk("/bar?a=1&b=2", "en"), []string{"a"}, []string{"Accept-Language"}, 0))
+
+	// all params
+	all := []string{"*"}
+	req.Equal(
+		cacheKey(1, mk("/foo?a=1&b=2", "en"), all, nil, 0),
+		cacheKey(1, mk("/foo?b=2&a=1", "en"), all, nil, 0),
+	)
+	req.NotEqual(
+		cacheKey(1, mk("/foo?a=1&b=2", "en"), all, nil, 0),
+		cacheKey(1, mk("/foo?a=1&b=3", "en"), all, nil, 0),
+	)
+	req.Equal([]string{"*"}, all)
+}
+
+func Test_cacheControl(t *testing.T) {
+	var (
+		req = require.New(t)
+		cc  = parseCacheControl(`public, Max-Age=60, s-maxage="30"`)
+	)
+
+	req.True(cc.has("public"))
+	req.False(cc.has("no-store"))
+
+	d, ok := cc.maxAge()
+	req.True(ok)
+	req.Equal(30*time.Second, d)
+
+	_, ok = parseCacheControl("no-cache").maxAge()
+	req.False(ok)
+}
+
+func Test_etagMatch(t *testing.T) {
+	req := require.New(t)
+
+	req.True(etagMatch(`"a", "b"`, `"b"`))
+	req.True(etagMatch(`W/"a"`, `"a"`))
+	req.True(etagMatch(`*`, `"a"`))
+	req.False(etagMatch(`"a"`, `"b"`))
+	req.False(etagMatch(``, `"b"`))
+}
+
+func Test_responseCache(t *testing.T) {
+	var (
+		req = require.New(t)
+		rc  = NewResponseCache()
+		now = time.Now()
+	)
+
+	rc.set("a", &cacheEntry{route: 1, storedAt: now, expiresAt: now.Add(time.Minute)})
+	rc.set("b", &cacheEntry{route: 2, storedAt: now, expiresAt: now.Add(time.Minute)})
+
+	req.NotNil(rc.get("a", now))
+	req.Nil(rc.get("a", now.Add(time.Hour)))
+
+	rc.Purge(1)
+	req.Nil(rc.get("a", now))
+	req.NotNil(rc.get("b", now))
+
+	rc.Purge(0)
+	req.Nil(rc.get("b", now))
+}
+
+func Test_cacheMerge(t *testing.T) {
+	type (
+		tf struct {
+			name   string
+			params string
+			err    string
+		}
+	)
+
+	var (
+		tcc = []tf{
+			{name: "defaults", params: `{}`},
+			{name: "ttl", params: `{"ttl":"5m","query":["b","a"],"headers":["Accept"],"public":true}`},
+			{name: "invalid ttl", params: `{"ttl":"foo"}`, err: `could not validate cache parameters: time: invalid duration "foo"`},
+			{name: "negative ttl", params: `{"ttl":"-1m"}`, err: "could not validate cache parameters: ttl must be positive"},
+		}
+	)
+
+	for _, tc := range tcc {
+		t.Run(tc.name, func(t *testing.T) {
+			req := require.New(t)
+
+			_, err := NewCache(options.ApigwOpt{}, NewResponseCache()).Merge([]byte(tc.params))
+
+			if tc.err != "" {
+				req.EqualError(err, tc.err)
+			} else {
+				req.NoError(err)
+			}
+		})
+	}
+}
+
+func Test_cacheMiddleware(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		calls   int
+		header  http.Header
+		handler = http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			calls++
+			for k, vv := range header {
+				rw.Header()[k] = vv
+			}
+			fmt.Fprintf(rw, "call %d", calls)
+		})
+
+		c, err = NewCache(options.ApigwOpt{}, NewResponseCache()).Merge([]byte(`{"ttl":"1m"}`))
+		mw     = c.(*cache).Middleware()(handler)
+
+		call = func(method string, hdr ...string) (*httptest.ResponseRecorder, *prf.Hit) {
+			var (
+				r   = httptest.NewRequest(method, "/foo", http.NoBody)
+				rw  = httptest.NewRecorder()
+				hit = &prf.Hit{}
+			)
+
+			for i := 0; i < len(hdr); i += 2 {
+				r.Header.Set(hdr[i], hdr[i+1])
+			}
+
+			r = r.WithContext(agctx.RouteToContext(r.Context(), 1))
+			r = r.WithContext(agctx.ProfilerToContext(r.Context(), hit))
+
+			mw.ServeHTTP(rw, r)
+			return rw, hit
+		}
+	)
+
+	req.NoError(err)
+
+	rw, hit := call(http.MethodGet)
+	req.Equal("call 1", rw.Body.String())
+	req.Equal("MISS", rw.Header().Get("X-Cache"))
+	req.Equal(CacheMiss, hit.Cache)
+
+	etag := rw.Header().Get("ETag")
+	req.NotEmpty(etag)
+
+	rw, hit = call(http.MethodGet)
+	req.Equal("call 1", rw.Body.String())
+	req.Equal("HIT", rw.Header().Get("X-Cache"))
+	req.Equal(CacheHit, hit.Cache)
+	req.Equal(etag, rw.Header().Get("ETag"))
+
+	// conditional request
+	rw, _ = call(http.MethodGet, "If-None-Match", rw.Header().Get("ETag"))
+	req.Equal(http.StatusNotModified, rw.Code)
+	req.Empty(rw.Body.String())
+
+	// lookup skipped, response is stored again
+	rw, _ = call(http.MethodGet, "Cache-Control", "no-cache")
+	req.Equal("call 2", rw.Body.String())
+
+	rw, _ = call(http.MethodGet)
+	req.Equal("call 2", rw.Body.String())
+
+	// only GET and HEAD are cached
+	rw, _ = call(http.MethodPost)
+	req.Equal("call 3", rw.Body.String())
+	req.Empty(rw.Header().Get("X-Cache"))
+
+	// response not to be stored
+	c.(*cache).rc.Purge(0)
+	header = http.Header{"Cache-Control": []string{"no-store"}}
+
+	call(http.MethodGet)
+	rw, _ = call(http.MethodGet)
+	req.Equal("call 5", rw.Body.String())
+	req.Equal("MISS", rw.Header().Get("X-Cache"))
+}
+
+func Test_cacheMiddlewareIdentity(t *testing.T) {
+	var (
+		calls   int
+		handler = http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			calls++
+			fmt.Fprintf(rw, "call %d", calls)
+		})
+
+		call = func(mw http.Handler, userID uint64) string {
+			var (
+				r   = httptest.NewRequest(http.MethodGet, "/foo", http.NoBody)
+				rw  = httptest.NewRecorder()
+				scp = &types.Scp{}
+			)
+
+			if userID > 0 {
+				scp.Set("identity", auth.Authenticated(userID))
+			}
+
+			r = r.WithContext(agctx.RouteToContext(r.Context(), 1))
+			r = r.WithContext(agctx.ScopeToContext(r.Context(), scp))
+
+			mw.ServeHTTP(rw, r)
+			return rw.Body.String()
+		}
+	)
+
+	t.Run("keyed by identity", func(t *testing.T) {
+		req := require.New(t)
+		calls = 0
+
+		c, err := NewCache(options.ApigwOpt{}, NewResponseCache()).Merge([]byte(`{}`))
+		req.NoError(err)
+		mw := c.(*cache).Middleware()(handler)
+
+		req.Equal("call 1", call(mw, 42))
+		req.Equal("call 2", call(mw, 43))
+		req.Equal("call 3", call(mw, 0))
+		req.Equal("call 1", call(mw, 42))
+	})
+
+	t.Run("public", func(t *testing.T) {
+		req := require.New(t)
+		calls = 0
+
+		c, err := NewCache(options.ApigwOpt{}, NewResponseCache()).Merge([]byte(`{"public":true}`))
+		req.NoError(err)
+		mw := c.(*cache).Middleware()(handler)
+
+		req.Equal("call 1", call(mw, 42))
+		req.Equal("call 1", call(mw, 43))
+		req.Equal("call 1", call(mw, 0))
+	})
+}
@@ -2,10 +2,13 @@ package filter
 
 import (
 	"bytes"
+	"crypto/sha1"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
+	"time"
 
 	atypes "github.com/cortezaproject/corteza-server/automation/types"
 	agctx "github.com/cortezaproject/corteza-server/pkg/apigw/ctx"
@@ -54,6 +57,27 @@ type (
 	defaultJsonResponse struct {
 		types.FilterMeta
 	}
+
+	// cache serves cached responses of the route
+	//
+	// Filter wraps processers and the rest of the postfilters
+	// so the cached responses are served before the processers run
+	cache struct {
+		types.FilterMeta
+
+		rc  *ResponseCache
+		ttl time.Duration
+
+		params struct {
+			TTL     string   `json:"ttl"`
+			Query   []string `json:"query"`
+			Headers []string `json:"headers"`
+
+			// responses are shared between all callers
+			// and are not keyed by the caller's identity
+			Public bool `json:"public"`
+		}
+	}
 )
 
 func NewRedirection(opts options.ApigwOpt) (e *redirection) {
@@ -287,3 +311,209 @@ func (j jsonResponse) Handler() types.HandlerFunc {
 		return
 	}
 }
+
+// NewCache caches the responses of the route
+//
+// Only successful responses to GET and HEAD requests are cached;
+// responses are cached per identity of the caller unless the route is public
+func NewCache(opts options.ApigwOpt, rc *ResponseCache) (c *cache) {
+	c = &cache{rc: rc}
+
+	c.Name = "cache"
+	c.Label = "Response cache"
+	c.Kind = types.PostFilter
+
+	c.Args = []*types.FilterMetaArg{
+		{
+			Type:    "text",
+			Label:   "ttl",
+			Example: "1m",
+			Options: map[string]interface{}{},
+		},
+		{
+			Type:    "list",
+			Label:   "query",
+			Example: "*",
+			Options: map[string]interface{}{},
+		},
+		{
+			Type:    "list",
+			Label:   "headers",
+			Options: map[string]interface{}{},
+		},
+		{
+			Type:    "bool",
+			Label:   "public",
+			Options: map[string]interface{}{},
+		},
+	}
+
+	return
+}
+
+func (c cache) New(opts options.ApigwOpt) types.Handler {
+	return NewCache(opts, c.rc)
+}
+
+func (c cache) Enabled() bool {
+	return true
+}
+
+func (c cache) String() string {
+	return fmt.Sprintf("apigw filter %s (%s)", c.Name, c.Label)
+}
+
+func (c cache) Meta() types.FilterMeta {
+	return c.FilterMeta
+}
+
+func (c *cache) Merge(params []byte) (types.Handler, error) {
+	err := json.NewDecoder(bytes.NewBuffer(params)).Decode(&c.params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if c.rc == nil {
+		return nil, fmt.Errorf("could not validate cache parameters: cache not initialized")
+	}
+
+	c.ttl = time.Minute
+	if c.params.TTL != "" {
+		if c.ttl, err = time.ParseDuration(c.params.TTL); err != nil {
+			return nil, fmt.Errorf("could not validate cache parameters: %s", err)
+		}
+
+		if c.ttl <= 0 {
+			return nil, fmt.Errorf("could not validate cache parameters: ttl must be positive")
+		}
+	}
+
+	sort.Strings(c.params.Query)
+	sort.Strings(c.params.Headers)
+
+	return c, err
+}
+
+// Handler is a noop; caching is done by the middleware
+func (c cache) Handler() types.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) error {
+		return nil
+	}
+}
+
+// Middleware serves the cached response or
+// records the response of the wrapped handlers
+func (c *cache) Middleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				next.ServeHTTP(rw, r)
+				return
+			}
+
+			var (
+				ctx   = r.Context()
+				now   = time.Now()
+				reqCC = parseCacheControl(r.Header.Get("Cache-Control"))
+				route = agctx.RouteFromContext(ctx)
+				hit   = agctx.ProfilerFromContext(ctx)
+
+				identity uint64
+			)
+
+			if reqCC.has("no-store") {
+				next.ServeHTTP(rw, r)
+				return
+			}
+
+			if !c.params.Public {
+				identity = requestIdentity(r)
+			}
+
+			key := cacheKey(route, r, c.params.Query, c.params.Headers, identity)
+
+			if !reqCC.has("no-cache") {
+				if e := c.rc.get(key, now); e != nil {
+					if hit != nil {
+						hit.Cache = CacheHit
+					}
+
+					rw.Header().Set("X-Cache", "HIT")
+					e.write(rw, r, now)
+					return
+				}
+			}
+
+			if hit != nil {
+				hit.Cache = CacheMiss
+			}
+
+			rw.Header().Set("X-Cache", "MISS")
+
+			rec := &cacheRecorder{ResponseWriter: rw}
+			next.ServeHTTP(rec, r)
+
+			if rec.passthrough {
+				return
+			}
+
+			e := c.entry(rec, route, now)
+			if e == nil {
+				rec.bypass()
+				return
+			}
+
+			c.rc.set(key, e)
+
+			// written with the ETag of the stored entry
+			e.write(rw, r, now)
+		})
+	}
+}
+
+// entry makes cache entry from the recorded response
+//
+// Nil is returned when response should not be cached
+func (c *cache) entry(rec *cacheRecorder, route uint64, now time.Time) *cacheEntry {
+	var (
+		h   = rec.Header().Clone()
+		cc  = parseCacheControl(h.Get("Cache-Control"))
+		ttl = c.ttl
+	)
+
+	switch {
+	case rec.status != http.StatusOK,
+		h.Get("Set-Cookie") != "",
+		cc.has("no-store"),
+		cc.has("no-cache"),
+		cc.has("private") && c.params.Public:
+		return nil
+	}
+
+	if maxAge, ok := cc.maxAge(); ok {
+		if ttl = maxAge; ttl == 0 {
+			return nil
+		}
+	}
+
+	e := &cacheEntry{
+		route:     route,
+		status:    rec.status,
+		body:      append([]byte(nil), rec.body.Bytes()...),
+		etag:      h.Get("ETag"),
+		storedAt:  now,
+		expiresAt: now.Add(ttl),
+	}
+
+	if e.etag == "" {
+		e.etag = fmt.Sprintf(`"%x"`, sha1.Sum(e.body))
+	}
+
+	// set per-response
+	h.Del("X-Cache")
+	h.Del("Age")
+	e.header = h
+
+	return e
+}
@@ -6,13 +6,22 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	agctx "github.com/cortezaproject/corteza-server/pkg/apigw/ctx"
 	"github.com/cortezaproject/corteza-server/pkg/apigw/types"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
 	pe "github.com/cortezaproject/corteza-server/pkg/errors"
 	"github.com/cortezaproject/corteza-server/pkg/expr"
 	"github.com/cortezaproject/corteza-server/pkg/options"
+	"github.com/cortezaproject/corteza-server/store"
+	st "github.com/cortezaproject/corteza-server/system/types"
+	"github.com/go-chi/jwtauth"
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/lestrrat-go/jwx/jwt"
 )
 
 type (
@@ -44,6 +53,82 @@ type (
 		opts options.ApigwOpt
 		types.FilterMeta
 	}
+
+	rateLimit struct {
+		types.FilterMeta
+
+		memory RateLimitCounters
+		store  RateLimitCounters
+
+		counters RateLimitCounters
+		eval     expr.Evaluable
+		window   time.Duration
+
+		params struct {
+			Key     string `json:"key"`
+			Header  string `json:"header"`
+			Expr    string `json:"expr"`
+			Policy  string `json:"policy"`
+			Limit   uint64 `json:"limit"`
+			Burst   uint64 `json:"burst"`
+			Window  string `json:"window"`
+			Storage string `json:"storage"`
+		}
+	}
+
+	jwtAuth struct {
+		types.FilterMeta
+
+		store AuthStorer
+		jwks  *jwk.AutoRefresh
+
+		params struct {
+			Source    string `json:"source"`
+			Jwks      string `json:"jwks"`
+			Secret    string `json:"secret"`
+			Algorithm string `json:"algorithm"`
+			Issuer    string `json:"issuer"`
+			Audience  string `json:"audience"`
+			Scope     string `json:"scope"`
+			Claim     string `json:"claim"`
+			Header    string `json:"header"`
+		}
+	}
+
+	apiKey struct {
+		types.FilterMeta
+
+		store AuthStorer
+
+		params struct {
+			Header string `json:"header"`
+			Query  string `json:"query"`
+		}
+	}
+
+	hmacSignature struct {
+		types.FilterMeta
+
+		store     AuthStorer
+		tolerance time.Duration
+
+		params struct {
+			Secret    string `json:"secret"`
+			Format    string `json:"format"`
+			Header    string `json:"header"`
+			Algorithm string `json:"algorithm"`
+			Tolerance string `json:"tolerance"`
+			User      string `json:"user"`
+		}
+	}
+
+	// validateRequest validates the request
+	// against the schema of the route
+	validateRequest struct {
+		types.FilterMeta
+
+		sv *schemaValidator
+	}
 )
 
 func NewHeader(opts options.ApigwOpt) (v *header) {
@@ -283,3 +368,839 @@ func (pr *profiler) Handler() types.HandlerFunc {
 		return
 	}
 }
+
+// NewRateLimit limits the number of requests on the route
+//
+// Store counters are optional; without them
+// limits can only be kept in memory
+func NewRateLimit(opts options.ApigwOpt, memory, store RateLimitCounters) (v *rateLimit) {
+	v = &rateLimit{
+		memory: memory,
+		store:  store,
+	}
+
+	v.Name = "rateLimit"
+	v.Label = "Rate limit"
+	v.Kind = types.PreFilter
+
+	v.Args = []*types.FilterMetaArg{
+		{
+			Type:    "text",
+			Label:   "key",
+			Example: RateLimitKeyIP,
+			Options: map[string]interface{}{
+				"values": []string{RateLimitKeyIP, RateLimitKeyIdentity, RateLimitKeyHeader, RateLimitKeyExpr},
+			},
+		},
+		{
+			Type:    "text",
+			Label:   "header",
+			Options: map[string]interface{}{},
+		},
+		{
+			Type:    "expr",
+			Label:   "expr",
+			Options: map[string]interface{}{},
+		},
+		{
+			Type:    "text",
+			Label:   "policy",
+			Example: RateLimitTokenBucket,
+			Options: map[string]interface{}{
+				"values": []string{RateLimitTokenBucket, RateLimitFixedWindow},
+			},
+		},
+		{
+			Type:    "number",
+			Label:   "limit",
+			Options: map[string]interface{}{},
+		},
+		{
+			Type:    "number",
+			Label:   "burst",
+			Options: map[string]interface{}{},
+		},
+		{
+			Type:    "text",
+			Label:   "window",
+			Example: "1m",
+			Options: map[string]interface{}{},
+		},
+		{
+			Type:    "text",
+			Label:   "storage",
+			Example: RateLimitStorageMemory,
+			Options: map[string]interface{}{
+				"values": []string{RateLimitStorageMemory, RateLimitStorageStore},
+			},
+		},
+	}
+
+	return
+}
+
+func (rl rateLimit) New(opts options.ApigwOpt) types.Handler {
+	return NewRateLimit(opts, rl.memory, rl.store)
+}
+
+func (rl rateLimit) Enabled() bool {
+	return true
+}
+
+func (rl rateLimit) String() string {
+	return fmt.Sprintf("apigw filter %s (%s)", rl.Name, rl.Label)
+}
+
+func (rl rateLimit) Meta() types.FilterMeta {
+	return rl.FilterMeta
+}
+
+func (rl *rateLimit) Merge(params []byte) (types.Handler, error) {
+	err := json.NewDecoder(bytes.NewBuffer(params)).Decode(&rl.params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if rl.params.Limit == 0 {
+		return nil, fmt.Errorf("could not validate rate limit parameters: limit not set")
+	}
+
+	switch rl.params.Key {
+	case "":
+		rl.params.Key = RateLimitKeyIP
+	case RateLimitKeyIP, RateLimitKeyIdentity:
+	case RateLimitKeyHeader:
+		if rl.params.Header == "" {
+			return nil, fmt.Errorf("could not validate rate limit parameters: header not set")
+		}
+	case RateLimitKeyExpr:
+		if rl.eval, err = expr.NewParser().Parse(rl.params.Expr); err != nil {
+			return nil, fmt.Errorf("could not validate rate limit parameters: %s", err)
+		}
+	default:
+		return nil, fmt.Errorf("could not validate rate limit parameters: unknown key %q", rl.params.Key)
+	}
+
+	switch rl.params.Policy {
+	case "":
+		rl.params.Policy = RateLimitTokenBucket
+	case RateLimitTokenBucket, RateLimitFixedWindow:
+	default:
+		return nil, fmt.Errorf("could not validate rate limit parameters: unknown policy %q", rl.params.Policy)
+	}
+
+	if rl.params.Burst == 0 {
+		rl.params.Burst = rl.params.Limit
+	}
+
+	rl.window = time.Minute
+	if rl.params.Window != "" {
+		if rl.window, err = time.ParseDuration(rl.params.Window); err != nil {
+			return nil, fmt.Errorf("could not validate rate limit parameters: %s", err)
+		}
+
+		if rl.window < time.Duration(rl.params.Limit) {
+			return nil, fmt.Errorf("could not validate rate limit parameters: window too short")
+		}
+	}
+
+	switch rl.params.Storage {
+	case "", RateLimitStorageMemory:
+		rl.counters = rl.memory
+	case RateLimitStorageStore:
+		if rl.store == nil {
+			return nil, fmt.Errorf("could not validate rate limit parameters: store storage not supported")
+		}
+
+		rl.counters = rl.store
+	default:
+		return nil, fmt.Errorf("could not validate rate limit parameters: unknown storage %q", rl.params.Storage)
+	}
+
+	return rl, err
+}
+
+func (rl *rateLimit) Handler() types.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) error {
+		var (
+			ctx = r.Context()
+			now = time.Now()
+			res rateLimitResult
+			ttl = rl.window
+		)
+
+		value, err := rl.keyValue(r)
+		if err != nil {
+			return pe.Internal("could not resolve rate limit key: %v", err)
+		}
+
+		if rl.params.Policy == RateLimitTokenBucket && rl.params.Burst > rl.params.Limit {
+			// bucket needs more than a window to refill
+			ttl = rl.window / time.Duration(rl.params.Limit) * time.Duration(rl.params.Burst)
+		}
+
+		err = rl.counters.Update(ctx, rateLimitKey(agctx.RouteFromContext(ctx), rl.params.Key, value), ttl, func(s RateLimitState) RateLimitState {
+			if rl.params.Policy == RateLimitFixedWindow {
+				s, res = takeFixedWindow(s, now, rl.params.Limit, rl.window)
+			} else {
+				s, res = takeTokenBucket(s, now, rl.params.Limit, rl.params.Burst, rl.window)
+			}
+
+			return s
+		})
+
+		if err != nil {
+			return pe.Internal("could not update rate limit: %v", err)
+		}
+
+		res.headers(rw.Header(), now)
+
+		if !res.allowed {
+			return pe.TooManyRequests("rate limit exceeded")
+		}
+
+		return nil
+	}
+}
+
+// keyValue resolves the value the requests are limited by
+//
+// Requests of anonymous users are limited by
+// client IP when limiting by identity
+func (rl rateLimit) keyValue(r *http.Request) (string, error) {
+	switch rl.params.Key {
+	case RateLimitKeyIdentity:
+		if id := requestIdentity(r); id > 0 {
+			return strconv.FormatUint(id, 10), nil
+		}
+
+		return requestIP(r), nil
+
+	case RateLimitKeyHeader:
+		return r.Header.Get(rl.params.Header), nil
+
+	case RateLimitKeyExpr:
+		headers := map[string]interface{}{}
+		for k, v := range r.Header {
+			headers[k] = v[0]
+		}
+
+		params := map[string]interface{}{}
+		for k, v := range r.URL.Query() {
+			params[k] = v[0]
+		}
+
+		vars, err := expr.NewVars(map[string]interface{}{
+			"ip":       requestIP(r),
+			"identity": requestIdentity(r),
+			"method":   r.Method,
+			"path":     r.URL.Path,
+			"headers":  headers,
+			"params":   params,
+		})
+
+		if err != nil {
+			return "", err
+		}
+
+		v, err := rl.eval.Eval(r.Context(), vars)
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("%v", v), nil
+
+	default:
+		return requestIP(r), nil
+	}
+}
+
+// NewJwtAuth authenticates requests with JWT
+//
+// Tokens are issued by Corteza or by an external identity provider;
+// tokens of the external providers are verified with the keys from the
+// JWKS endpoint or with the shared secret
+func NewJwtAuth(opts options.ApigwOpt, s AuthStorer, jwks *jwk.AutoRefresh) (v *jwtAuth) {
+	v = &jwtAuth{
+		store: s,
+		jwks:  jwks,
+	}
+
+	v.Name = "jwtAuth"
+	v.Label = "JWT authentication"
+	v.Kind = types.PreFilter
+
+	v.Args = []*types.FilterMetaArg{
+		{
+			Type:    "text",
+			Label:   "source",
+			Example: JwtSourceCorteza,
+			Options: map[string]interface{}{
+				"values": []string{JwtSourceCorteza, JwtSourceJwks, JwtSourceSecret},
+			},
+		},
+		{
+			Type:    "text",
+			Label:   "jwks",
+			Example: "https://example.tld/.well-known/jwks.json",
+			Options: map[string]interface{}{},
+		},
+		{
+			Type:    "text",
+			Label:   "secret",
+			Options: map[string]interface{}{},
+		},
+		{
+			Type:    "text",
+			Label:   "algorithm",
+			Example: jwa.HS256.String(),
+			Options: map[string]interface{}{
+				"values": []string{jwa.HS256.String(), jwa.HS384.String(), jwa.HS512.String()},
+			},
+		},
+		{
+			Type:    "text",
+			Label:   "issuer",
+			Options: map[string]interface{}{},
+		},
+		{
+			Type:    "text",
+			Label:   "audience",
+			Options: map[string]interface{}{},
+		},
+		{
+			Type:    "text",
+			Label:   "scope",
+			Example: "api",
+			Options: map[string]interface{}{},
+		},
+		{
+			Type:    "text",
+			Label:   "claim",
+			Example: jwt.SubjectKey,
+			Options: map[string]interface{}{},
+		},
+		{
+			Type:    "text",
+			Label:   "header",
+			Example: "Authorization",
+			Options: map[string]interface{}{},
+		},
+	}
+
+	return
+}
+
+func (j jwtAuth) New(opts options.ApigwOpt) types.Handler {
+	return NewJwtAuth(opts, j.store, j.jwks)
+}
+
+func (j jwtAuth) Enabled() bool {
+	return true
+}
+
+func (j jwtAuth) String() string {
+	return fmt.Sprintf("apigw filter %s (%s)", j.Name, j.Label)
+}
+
+func (j jwtAuth) Meta() types.FilterMeta {
+	return j.FilterMeta
+}
+
+func (j *jwtAuth) Merge(params []byte) (types.Handler, error) {
+	err := json.NewDecoder(bytes.NewBuffer(params)).Decode(&j.params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if j.params.Claim == "" {
+		j.params.Claim = jwt.SubjectKey
+	}
+
+	if j.params.Header == "" {
+		j.params.Header = "Authorization"
+	}
+
+	switch j.params.Source {
+	case "":
+		j.params.Source = JwtSourceCorteza
+	case JwtSourceCorteza:
+	case JwtSourceJwks:
+		if j.params.Jwks == "" {
+			return nil, fmt.Errorf("could not validate jwt auth parameters: jwks not set")
+		}
+
+		if j.jwks == nil {
+			return nil, fmt.Errorf("could not validate jwt auth parameters: jwks not supported")
+		}
+
+		j.jwks.Configure(j.params.Jwks, jwk.WithMinRefreshInterval(jwksMinRefreshInterval))
+	case JwtSourceSecret:
+		if j.params.Secret == "" {
+			return nil, fmt.Errorf("could not validate jwt auth parameters: secret not set")
+		}
+
+		switch jwa.SignatureAlgorithm(j.params.Algorithm) {
+		case "":
+			j.params.Algorithm = jwa.HS256.String()
+		case jwa.HS256, jwa.HS384, jwa.HS512:
+		default:
+			return nil, fmt.Errorf("could not validate jwt auth parameters: unknown algorithm %q", j.params.Algorithm)
+		}
+	default:
+		return nil, fmt.Errorf("could not validate jwt auth parameters: unknown source %q", j.params.Source)
+	}
+
+	if j.params.Source != JwtSourceCorteza && j.store == nil {
+		return nil, fmt.Errorf("could not validate jwt auth parameters: store not set")
+	}
+
+	return j, err
+}
+
+func (j jwtAuth) Handler() types.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) error {
+		i, err := j.identity(r)
+
+		if err != nil {
+			rw.Header().Set("WWW-Authenticate", "Bearer")
+			return pe.Unauthorized("could not authenticate request: %v", err)
+		}
+
+		setScopeIdentity(r, i)
+		return nil
+	}
+}
+
+// identity verifies the token and resolves the caller
+//
+// Identity is taken from Corteza tokens as is; value of the claim
+// of the external tokens is an ID or an email of the existing user
+func (j jwtAuth) identity(r *http.Request) (auth.Identifiable, error) {
+	var (
+		ctx = r.Context()
+		vv  []jwt.ValidateOption
+	)
+
+	if j.params.Issuer != "" {
+		vv = append(vv, jwt.WithIssuer(j.params.Issuer))
+	}
+
+	if j.params.Audience != "" {
+		vv = append(vv, jwt.WithAudience(j.params.Audience))
+	}
+
+	if j.params.Source == JwtSourceCorteza {
+		// signature and access token are verified by
+		// the token verifier before the route is served
+		token, _, err := jwtauth.FromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if token == nil {
+			return nil, jwtauth.ErrNoTokenFound
+		}
+
+		if err = jwt.Validate(token, vv...); err != nil {
+			return nil, err
+		}
+
+		if j.params.Scope != "" && !auth.CheckJwtScope(token, strings.Fields(j.params.Scope)...) {
+			return nil, fmt.Errorf("token scope not allowed")
+		}
+
+		i := auth.IdentityFromToken(token)
+		if !i.Valid() {
+			return nil, fmt.Errorf("invalid identity")
+		}
+
+		return i, nil
+	}
+
+	raw := bearerToken(r, j.params.Header)
+	if raw == "" {
+		return nil, jwtauth.ErrNoTokenFound
+	}
+
+	pp := []jwt.ParseOption{jwt.WithValidate(true)}
+
+	if j.params.Source == JwtSourceJwks {
+		set, err := j.jwks.Fetch(ctx, j.params.Jwks)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch jwks: %w", err)
+		}
+
+		pp = append(pp, jwt.WithKeySet(set), jwt.UseDefaultKey(true), jwt.InferAlgorithmFromKey(true))
+	} else {
+		pp = append(pp, jwt.WithVerify(jwa.SignatureAlgorithm(j.params.Algorithm), []byte(j.params.Secret)))
+	}
+
+	for _, v := range vv {
+		pp = append(pp, v)
+	}
+
+	token, err := jwt.ParseString(raw, pp...)
+	if err != nil {
+		return nil, err
+	}
+
+	claim, has := token.Get(j.params.Claim)
+	if !has {
+		return nil, fmt.Errorf("claim %s not set", j.params.Claim)
+	}
+
+	u, err := authUser(ctx, j.store, fmt.Sprintf("%v", claim))
+	if err != nil {
+		return nil, err
+	}
+
+	return authIdentity(ctx, j.store, u)
+}
+
+// NewApiKey authenticates requests with API keys
+//
+// Keys are managed by the users they belong to
+func NewApiKey(opts options.ApigwOpt, s AuthStorer) (v *apiKey) {
+	v = &apiKey{
+		store: s,
+	}
+
+	v.Name = "apiKey"
+	v.Label = "API key authentication"
+	v.Kind = types.PreFilter
+
+	v.Args = []*types.FilterMetaArg{
+		{
+			Type:    "text",
+			Label:   "header",
+			Example: "X-API-Key",
+			Options: map[string]interface{}{},
+		},
+		{
+			Type:    "text",
+			Label:   "query",
+			Options: map[string]interface{}{},
+		},
+	}
+
+	return
+}
+
+func (k apiKey) New(opts options.ApigwOpt) types.Handler {
+	return NewApiKey(opts, k.store)
+}
+
+func (k apiKey) Enabled() bool {
+	return true
+}
+
+func (k apiKey) String() string {
+	return fmt.Sprintf("apigw filter %s (%s)", k.Name, k.Label)
+}
+
+func (k apiKey) Meta() types.FilterMeta {
+	return k.FilterMeta
+}
+
+func (k *apiKey) Merge(params []byte) (types.Handler, error) {
+	err := json.NewDecoder(bytes.NewBuffer(params)).Decode(&k.params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if k.store == nil {
+		return nil, fmt.Errorf("could not validate api key parameters: store not set")
+	}
+
+	if k.params.Header == "" {
+		k.params.Header = "X-API-Key"
+	}
+
+	return k, err
+}
+
+func (k apiKey) Handler() types.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) error {
+		var (
+			ctx = r.Context()
+			key = r.Header.Get(k.params.Header)
+		)
+
+		if key == "" && k.params.Query != "" {
+			key = r.URL.Query().Get(k.params.Query)
+		}
+
+		credentialsID, secret := parseApiKey(key)
+		if credentialsID == 0 {
+			return pe.Unauthorized("invalid api key")
+		}
+
+		c, err := k.store.LookupCredentialByID(ctx, credentialsID)
+		if err != nil && !errors.Is(err, store.ErrNotFound) {
+			return pe.Internal("could not verify api key: %v", err)
+		}
+
+		if !verifyApiKey(c, secret) {
+			return pe.Unauthorized("invalid api key")
+		}
+
+		u, err := k.store.LookupUserByID(ctx, c.OwnerID)
+		if err != nil {
+			return pe.Unauthorized("invalid api key")
+		}
+
+		i, err := authIdentity(ctx, k.store, u)
+		if err != nil {
+			return pe.Unauthorized("invalid api key")
+		}
+
+		if now := time.Now(); c.LastUsedAt == nil || now.Sub(*c.LastUsedAt) > apiKeyLastUsedInterval {
+			c.LastUsedAt = &now
+			_ = k.store.UpdateCredential(ctx, c)
+		}
+
+		setScopeIdentity(r, i)
+		return nil
+	}
+}
+
+// NewHmacSignature verifies signatures of the (webhook) requests
+//
+// Requests are signed with the shared secret; when user is
+// set, request is processed with the identity of that user
+func NewHmacSignature(opts options.ApigwOpt, s AuthStorer) (v *hmacSignature) {
+	v = &hmacSignature{
+		store: s,
+	}
+
+	v.Name = "hmacSignature"
+	v.Label = "HMAC signature"
+	v.Kind = types.PreFilter
+
+	v.Args = []*types.FilterMetaArg{
+		{
+			Type:    "text",
+			Label:   "secret",
+			Options: map[string]interface{}{},
+		},
+		{
+			Type:    "text",
+			Label:   "format",
+			Example: SignatureFormatGithub,
+			Options: map[string]interface{}{
+				"values": []string{SignatureFormatGithub, SignatureFormatStripe, SignatureFormatPlain},
+			},
+		},
+		{
+			Type:    "text",
+			Label:   "header",
+			Example: "X-Hub-Signature-256",
+			Options: map[string]interface{}{},
+		},
+		{
+			Type:    "text",
+			Label:   "algorithm",
+			Example: "sha256",
+			Options: map[string]interface{}{
+				"values": []string{"sha256", "sha1", "sha512"},
+			},
+		},
+		{
+			Type:    "text",
+			Label:   "tolerance",
+			Example: "5m",
+			Options: map[string]interface{}{},
+		},
+		{
+			Type:    "text",
+			Label:   "user",
+			Options: map[string]interface{}{},
+		},
+	}
+
+	return
+}
+
+func (hs hmacSignature) New(opts options.ApigwOpt) types.Handler {
+	return NewHmacSignature(opts, hs.store)
+}
+
+func (hs hmacSignature) Enabled() bool {
+	return true
+}
+
+func (hs hmacSignature) String() string {
+	return fmt.Sprintf("apigw filter %s (%s)", hs.Name, hs.Label)
+}
+
+func (hs hmacSignature) Meta() types.FilterMeta {
+	return hs.FilterMeta
+}
+
+func (hs *hmacSignature) Merge(params []byte) (types.Handler, error) {
+	err := json.NewDecoder(bytes.NewBuffer(params)).Decode(&hs.params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if hs.params.Secret == "" {
+		return nil, fmt.Errorf("could not validate hmac signature parameters: secret not set")
+	}
+
+	if _, err = signatureHash(hs.params.Algorithm); err != nil {
+		return nil, fmt.Errorf("could not validate hmac signature parameters: %s", err)
+	}
+
+	switch hs.params.Format {
+	case "", SignatureFormatGithub:
+		hs.params.Format = SignatureFormatGithub
+		if hs.params.Header == "" {
+			hs.params.Header = "X-Hub-Signature-256"
+		}
+	case SignatureFormatStripe:
+		if hs.params.Header == "" {
+			hs.params.Header = "Stripe-Signature"
+		}
+	case SignatureFormatPlain:
+		if hs.params.Header == "" {
+			hs.params.Header = "X-Signature"
+		}
+	default:
+		return nil, fmt.Errorf("could not validate hmac signature parameters: unknown format %q", hs.params.Format)
+	}
+
+	hs.tolerance = time.Minute * 5
+	if hs.params.Tolerance != "" {
+		if hs.tolerance, err = time.ParseDuration(hs.params.Tolerance); err != nil {
+			return nil, fmt.Errorf("could not validate hmac signature parameters: %s", err)
+		}
+	}
+
+	if hs.params.User != "" && hs.store == nil {
+		return nil, fmt.Errorf("could not validate hmac signature parameters: store not set")
+	}
+
+	return hs, err
+}
+
+func (hs hmacSignature) Handler() types.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) error {
+		var (
+			ctx   = r.Context()
+			valid bool
+			sig   = r.Header.Get(hs.params.Header)
+		)
+
+		if sig == "" {
+			return pe.Unauthorized("signature not set")
+		}
+
+		body, err := requestBody(r)
+		if err != nil {
+			return pe.Internal("could not read request body: %v", err)
+		}
+
+		switch hs.params.Format {
+		case SignatureFormatStripe:
+			valid = verifyStripeSignature(sig, hs.params.Secret, body, time.Now(), hs.tolerance)
+		case SignatureFormatPlain:
+			valid = verifyPlainSignature(sig, hs.params.Algorithm, hs.params.Secret, body)
+		default:
+			valid = verifyGithubSignature(sig, hs.params.Algorithm, hs.params.Secret, body)
+		}
+
+		if !valid {
+			return pe.Unauthorized("invalid signature")
+		}
+
+		if hs.params.User == "" {
+			return nil
+		}
+
+		u, err := authUser(ctx, hs.store, hs.params.User)
+		if err != nil {
+			return pe.Internal("could not resolve signature user: %v", err)
+		}
+
+		i, err := authIdentity(ctx, hs.store, u)
+		if err != nil {
+			return pe.Internal("could not resolve signature user: %v", err)
+		}
+
+		setScopeIdentity(r, i)
+		return nil
+	}
+}
+
+// NewValidateRequest validates query, headers, path
+// parameters and JSON body against the route schema
+func NewValidateRequest(opts options.ApigwOpt) (v *validateRequest) {
+	v = &validateRequest{}
+
+	v.Name = "validateRequest"
+	v.Label = "Validate request"
+	v.Kind = types.PreFilter
+
+	return
+}
+
+func (v validateRequest) New(opts options.ApigwOpt) types.Handler {
+	return NewValidateRequest(opts)
+}
+
+func (v validateRequest) Enabled() bool {
+	return true
+}
+
+func (v validateRequest) String() string {
+	return fmt.Sprintf("apigw filter %s (%s)", v.Name, v.Label)
+}
+
+func (v validateRequest) Meta() types.FilterMeta {
+	return v.FilterMeta
+}
+
+func (v *validateRequest) Merge(params []byte) (types.Handler, error) {
+	return v, nil
+}
+
+// RouteSchema prepares the validator for the route schema
+func (v *validateRequest) RouteSchema(rs *st.ApigwRouteSchema) (err error) {
+	if rs == nil {
+		return fmt.Errorf("could not validate request: route schema not set")
+	}
+
+	if v.sv, err = newSchemaValidator(rs); err != nil {
+		return fmt.Errorf("could not validate request: %s", err)
+	}
+
+	return
+}
+
+func (v *validateRequest) Handler() types.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) (err error) {
+		var body []byte
+
+		if v.sv == nil {
+			return pe.Internal("could not validate request: route schema not set")
+		}
+
+		if v.sv.rs.Body != nil {
+			if body, err = requestBody(r); err != nil {
+				return pe.Internal("could not read request body: %v", err)
+			}
+		}
+
+		if ee := v.sv.validateRequest(r, body); len(ee) > 0 {
+			return pe.Plain(pe.KindInvalidData, "request validation failed").
+				Apply(pe.Meta(types.ErrorMetaValidation, ee))
+		}
+
+		return nil
+	}
+}
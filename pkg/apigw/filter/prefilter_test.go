@@ -1,15 +1,25 @@
 package filter
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	agctx "github.com/cortezaproject/corteza-server/pkg/apigw/ctx"
 	prf "github.com/cortezaproject/corteza-server/pkg/apigw/profiler"
 	"github.com/cortezaproject/corteza-server/pkg/apigw/types"
 	h "github.com/cortezaproject/corteza-server/pkg/http"
 	"github.com/cortezaproject/corteza-server/pkg/options"
+	st "github.com/cortezaproject/corteza-server/system/types"
+	"github.com/go-chi/jwtauth"
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwt"
 	"github.com/stretchr/testify/require"
 )
 
@@ -205,6 +215,76 @@ func Test_profilerHandle_profilerGlobal(t *testing.T) {
 	}
 }
 
+func Test_rateLimitMerge(t *testing.T) {
+	var (
+		tcc = []tf{
+			{
+				name: "defaults",
+				expr: `{"limit":10}`,
+			},
+			{
+				name: "missing limit",
+				expr: `{"key":"ip"}`,
+				err:  "could not validate rate limit parameters: limit not set",
+			},
+			{
+				name: "missing header",
+				expr: `{"key":"header","limit":10}`,
+				err:  "could not validate rate limit parameters: header not set",
+			},
+			{
+				name: "unknown policy",
+				expr: `{"policy":"leakyBucket","limit":10}`,
+				err:  "could not validate rate limit parameters: unknown policy \"leakyBucket\"",
+			},
+			{
+				name: "store not supported",
+				expr: `{"storage":"store","limit":10}`,
+				err:  "could not validate rate limit parameters: store storage not supported",
+			},
+		}
+	)
+
+	for _, tc := range tcc {
+		t.Run(tc.name, testMerge(NewRateLimit(options.ApigwOpt{}, NewMemoryRateLimitCounters(), nil), tc))
+	}
+}
+
+func Test_rateLimitHandle(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		rl, err = NewRateLimit(options.ApigwOpt{}, NewMemoryRateLimitCounters(), nil).
+			Merge([]byte(`{"key":"header","header":"X-Api-Key","policy":"fixedWindow","limit":2,"window":"1h"}`))
+
+		call = func(key string) (*httptest.ResponseRecorder, error) {
+			r := httptest.NewRequest(http.MethodGet, "/foo", http.NoBody)
+			r.Header.Set("X-Api-Key", key)
+			rw := httptest.NewRecorder()
+			return rw, rl.Handler()(rw, r)
+		}
+	)
+
+	req.NoError(err)
+
+	rw, err := call("a")
+	req.NoError(err)
+	req.Equal("2", rw.Header().Get("X-RateLimit-Limit"))
+	req.Equal("1", rw.Header().Get("X-RateLimit-Remaining"))
+
+	_, err = call("a")
+	req.NoError(err)
+
+	rw, err = call("a")
+	req.EqualError(err, "rate limit exceeded")
+	req.Equal("0", rw.Header().Get("X-RateLimit-Remaining"))
+	req.NotEmpty(rw.Header().Get("Retry-After"))
+
+	// limited by key
+	_, err = call("b")
+	req.NoError(err)
+}
+
 func createRequest(r *http.Request) (hr *h.Request) {
 	hr, _ = h.NewRequest(r)
 	return
@@ -247,3 +327,219 @@ func testHandle(h types.Handler, r *http.Request, tc tf) func(t *testing.T) {
 		}
 	}
 }
+
+func Test_jwtAuthMerge(t *testing.T) {
+	var (
+		tcc = []tf{
+			{
+				name: "defaults",
+				expr: `{}`,
+			},
+			{
+				name: "missing jwks",
+				expr: `{"source":"jwks"}`,
+				err:  "could not validate jwt auth parameters: jwks not set",
+			},
+			{
+				name: "missing secret",
+				expr: `{"source":"secret"}`,
+				err:  "could not validate jwt auth parameters: secret not set",
+			},
+			{
+				name: "unknown algorithm",
+				expr: `{"source":"secret","secret":"s3cr3t","algorithm":"RS256"}`,
+				err:  "could not validate jwt auth parameters: unknown algorithm \"RS256\"",
+			},
+			{
+				name: "unknown source",
+				expr: `{"source":"ldap"}`,
+				err:  "could not validate jwt auth parameters: unknown source \"ldap\"",
+			},
+		}
+	)
+
+	for _, tc := range tcc {
+		t.Run(tc.name, testMerge(NewJwtAuth(options.ApigwOpt{}, &mockAuthStorer{}, nil), tc))
+	}
+}
+
+func Test_jwtAuthHandle(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		s = &mockAuthStorer{
+			users: st.UserSet{{ID: 42, Email: "foo@example.tld"}},
+			roles: map[uint64]st.RoleSet{42: {{ID: 7}}},
+		}
+
+		sign = func(claims map[string]interface{}) string {
+			token := jwt.New()
+			for k, v := range claims {
+				req.NoError(token.Set(k, v))
+			}
+
+			signed, err := jwt.Sign(token, jwa.HS256, []byte("s3cr3t"))
+			req.NoError(err)
+			return string(signed)
+		}
+
+		call = func(params string, r *http.Request) (*types.Scp, error) {
+			j, err := NewJwtAuth(options.ApigwOpt{}, s, nil).Merge([]byte(params))
+			req.NoError(err)
+
+			scope := &types.Scp{}
+			r = r.WithContext(agctx.ScopeToContext(r.Context(), scope))
+			return scope, j.Handler()(httptest.NewRecorder(), r)
+		}
+
+		bearer = func(token string) *http.Request {
+			r := httptest.NewRequest(http.MethodGet, "/foo", http.NoBody)
+			r.Header.Set("Authorization", "Bearer "+token)
+			return r
+		}
+	)
+
+	t.Run("secret", func(t *testing.T) {
+		params := `{"source":"secret","secret":"s3cr3t","issuer":"idp"}`
+
+		scope, err := call(params, bearer(sign(map[string]interface{}{"sub": "42", "iss": "idp"})))
+		req.NoError(err)
+		req.Equal(uint64(42), scope.Identity().Identity())
+		req.Contains(scope.Identity().Roles(), uint64(7))
+
+		_, err = call(params, bearer(sign(map[string]interface{}{"sub": "42", "iss": "other"})))
+		req.Error(err)
+
+		_, err = call(params, bearer(sign(map[string]interface{}{"sub": "43", "iss": "idp"})))
+		req.Error(err)
+
+		_, err = call(params, httptest.NewRequest(http.MethodGet, "/foo", http.NoBody))
+		req.Error(err)
+	})
+
+	t.Run("email claim", func(t *testing.T) {
+		scope, err := call(
+			`{"source":"secret","secret":"s3cr3t","claim":"email"}`,
+			bearer(sign(map[string]interface{}{"email": "foo@example.tld"})),
+		)
+
+		req.NoError(err)
+		req.Equal(uint64(42), scope.Identity().Identity())
+	})
+
+	t.Run("corteza", func(t *testing.T) {
+		token := jwt.New()
+		req.NoError(token.Set(jwt.SubjectKey, "42"))
+		req.NoError(token.Set("scope", "api"))
+
+		r := httptest.NewRequest(http.MethodGet, "/foo", http.NoBody)
+		r = r.WithContext(jwtauth.NewContext(r.Context(), token, nil))
+
+		scope, err := call(`{"scope":"api"}`, r)
+		req.NoError(err)
+		req.Equal(uint64(42), scope.Identity().Identity())
+
+		_, err = call(`{"scope":"profile"}`, r)
+		req.Error(err)
+
+		_, err = call(`{}`, httptest.NewRequest(http.MethodGet, "/foo", http.NoBody))
+		req.Error(err)
+	})
+}
+
+func Test_apiKeyHandle(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		key, hashed, _ = MakeApiKey(1)
+		expired, _, _  = MakeApiKey(2)
+		exp            = time.Now().Add(-time.Hour)
+
+		s = &mockAuthStorer{
+			users: st.UserSet{{ID: 42}},
+			credentials: st.CredentialSet{
+				{ID: 1, OwnerID: 42, Kind: ApiKeyCredentialsKind, Credentials: hashed},
+				{ID: 2, OwnerID: 42, Kind: ApiKeyCredentialsKind, Credentials: hashed, ExpiresAt: &exp},
+			},
+		}
+
+		ak, err = NewApiKey(options.ApigwOpt{}, s).Merge([]byte(`{"query":"key"}`))
+
+		call = func(r *http.Request) (*types.Scp, error) {
+			scope := &types.Scp{}
+			r = r.WithContext(agctx.ScopeToContext(r.Context(), scope))
+			return scope, ak.Handler()(httptest.NewRecorder(), r)
+		}
+	)
+
+	req.NoError(err)
+
+	r := httptest.NewRequest(http.MethodGet, "/foo", http.NoBody)
+	r.Header.Set("X-API-Key", key)
+	scope, err := call(r)
+	req.NoError(err)
+	req.Equal(uint64(42), scope.Identity().Identity())
+
+	scope, err = call(httptest.NewRequest(http.MethodGet, "/foo?key="+key, http.NoBody))
+	req.NoError(err)
+	req.Equal(uint64(42), scope.Identity().Identity())
+
+	_, err = call(httptest.NewRequest(http.MethodGet, "/foo?key="+expired, http.NoBody))
+	req.EqualError(err, "invalid api key")
+
+	_, err = call(httptest.NewRequest(http.MethodGet, "/foo", http.NoBody))
+	req.EqualError(err, "invalid api key")
+
+	_, err = NewApiKey(options.ApigwOpt{}, nil).Merge([]byte(`{}`))
+	req.EqualError(err, "could not validate api key parameters: store not set")
+}
+
+func Test_hmacSignatureHandle(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		s = &mockAuthStorer{
+			users: st.UserSet{{ID: 42}},
+		}
+
+		body = `{"foo":"bar"}`
+		mac  = hmac.New(sha256.New, []byte("s3cr3t"))
+
+		call = func(params, signature string) (*types.Scp, error) {
+			hs, err := NewHmacSignature(options.ApigwOpt{}, s).Merge([]byte(params))
+			req.NoError(err)
+
+			r := httptest.NewRequest(http.MethodPost, "/foo", strings.NewReader(body))
+			r.Header.Set("X-Hub-Signature-256", signature)
+
+			scope := &types.Scp{"request": createRequest(r)}
+			r = r.WithContext(agctx.ScopeToContext(r.Context(), scope))
+			return scope, hs.Handler()(httptest.NewRecorder(), r)
+		}
+	)
+
+	mac.Write([]byte(body))
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	scope, err := call(`{"secret":"s3cr3t"}`, signature)
+	req.NoError(err)
+	req.Nil(scope.Identity())
+
+	scope, err = call(`{"secret":"s3cr3t","user":"42"}`, signature)
+	req.NoError(err)
+	req.Equal(uint64(42), scope.Identity().Identity())
+
+	// body can still be read down the pipeline
+	b, err := ioutil.ReadAll(scope.Request().Body)
+	req.NoError(err)
+	req.Equal(body, string(b))
+
+	_, err = call(`{"secret":"other"}`, signature)
+	req.EqualError(err, "invalid signature")
+
+	_, err = call(`{"secret":"s3cr3t"}`, "")
+	req.EqualError(err, "signature not set")
+
+	_, err = NewHmacSignature(options.ApigwOpt{}, s).Merge([]byte(`{"secret":"s3cr3t","format":"slack"}`))
+	req.EqualError(err, "could not validate hmac signature parameters: unknown format \"slack\"")
+}
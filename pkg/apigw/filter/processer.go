@@ -12,6 +12,7 @@ import (
 	atypes "github.com/cortezaproject/corteza-server/automation/types"
 	agctx "github.com/cortezaproject/corteza-server/pkg/apigw/ctx"
 	"github.com/cortezaproject/corteza-server/pkg/apigw/types"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
 	pe "github.com/cortezaproject/corteza-server/pkg/errors"
 	"github.com/cortezaproject/corteza-server/pkg/expr"
 	"github.com/cortezaproject/corteza-server/pkg/jsenv"
@@ -102,8 +103,13 @@ func (h workflow) Handler() types.HandlerFunc {
 			ctx   = r.Context()
 			scope = agctx.ScopeFromContext(ctx)
 		)
+		if i := scope.Identity(); i != nil {
+			// run workflow as the caller
+			ctx = auth.SetIdentityToContext(ctx, i)
+		}
+
 		// cleanup scope for wf
-		scp := filterScope(scope, "opts")
+		scp := filterScope(scope, "opts", "identity")
 
 		in, err := expr.NewVars(scp.Dict())
 
@@ -13,6 +13,7 @@ import (
 	atypes "github.com/cortezaproject/corteza-server/automation/types"
 	agctx "github.com/cortezaproject/corteza-server/pkg/apigw/ctx"
 	"github.com/cortezaproject/corteza-server/pkg/apigw/types"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
 	"github.com/cortezaproject/corteza-server/pkg/expr"
 	h "github.com/cortezaproject/corteza-server/pkg/http"
 	"github.com/cortezaproject/corteza-server/pkg/options"
@@ -105,6 +106,41 @@ func Test_processerWorkflow(t *testing.T) {
 	}
 }
 
+func Test_processerWorkflowIdentity(t *testing.T) {
+	var (
+		req     = require.New(t)
+		rq, _   = http.NewRequest("POST", "/foo", http.NoBody)
+		ar, err = h.NewRequest(rq)
+		invoker uint64
+
+		pp = NewWorkflow(options.ApigwOpt{}, wfServicer{
+			load: func(ctx context.Context) error {
+				return nil
+			},
+			exec: func(ctx context.Context, workflowID uint64, p atypes.WorkflowExecParams) (*expr.Vars, atypes.Stacktrace, error) {
+				invoker = auth.GetIdentityFromContext(ctx).Identity()
+				req.False(p.Input.Has("identity"))
+				return &expr.Vars{}, nil, nil
+			},
+		})
+	)
+
+	req.NoError(err)
+
+	_, err = pp.Merge([]byte(`{"workflow":"1"}`))
+	req.NoError(err)
+
+	// identity resolved by the auth prefilter
+	scope := &types.Scp{
+		"request":  ar,
+		"identity": auth.Authenticated(42),
+	}
+
+	rq = rq.WithContext(agctx.ScopeToContext(context.Background(), scope))
+	req.NoError(pp.Handler()(httptest.NewRecorder(), rq))
+	req.Equal(uint64(42), invoker)
+}
+
 func Test_processerPayload(t *testing.T) {
 	type (
 		tf struct {
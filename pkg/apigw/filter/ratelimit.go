@@ -0,0 +1,313 @@
+package filter
+
+import (
+	"context"
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	agctx "github.com/cortezaproject/corteza-server/pkg/apigw/ctx"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/store"
+	st "github.com/cortezaproject/corteza-server/system/types"
+	"github.com/go-chi/jwtauth"
+)
+
+const (
+	RateLimitKeyIP       = "ip"
+	RateLimitKeyIdentity = "identity"
+	RateLimitKeyHeader   = "header"
+	RateLimitKeyExpr     = "expr"
+
+	RateLimitTokenBucket = "tokenBucket"
+	RateLimitFixedWindow = "fixedWindow"
+
+	RateLimitStorageMemory = "memory"
+	RateLimitStorageStore  = "store"
+
+	// how many times store-backed counter update is retried
+	// when counter is concurrently updated by another instance
+	rateLimitMaxRetries = 10
+
+	// how often are expired counters removed
+	rateLimitSweepInterval = time.Minute
+
+	// limit key values longer than this are hashed
+	rateLimitMaxKeyValue = 64
+)
+
+type (
+	// RateLimitState is the state of the single rate limit counter
+	RateLimitState struct {
+		// Number of requests in the window (fixed window policy)
+		Count uint64
+
+		// Start of the window (fixed window policy) or theoretical
+		// arrival time of the next request (token bucket policy)
+		Stamp time.Time
+	}
+
+	// RateLimitCounters keeps state of the rate limit counters
+	//
+	// Update applies fn to the state of the counter; fn can be called
+	// more than once when the counter is updated concurrently.
+	// Counter can be removed when it is not updated for ttl.
+	RateLimitCounters interface {
+		Update(ctx context.Context, key string, ttl time.Duration, fn func(RateLimitState) RateLimitState) error
+	}
+
+	RateLimitStorer interface {
+		LookupApigwRateLimitByName(ctx context.Context, name string) (*st.ApigwRateLimit, error)
+		SaveApigwRateLimit(ctx context.Context, counter *st.ApigwRateLimit) (bool, error)
+		DeleteExpiredApigwRateLimits(ctx context.Context) error
+	}
+
+	memoryRateLimitCounters struct {
+		mux    sync.Mutex
+		states map[string]memoryRateLimitState
+		swept  time.Time
+	}
+
+	memoryRateLimitState struct {
+		RateLimitState
+		expiresAt time.Time
+	}
+
+	storeRateLimitCounters struct {
+		store RateLimitStorer
+
+		mux   sync.Mutex
+		swept time.Time
+	}
+
+	rateLimitResult struct {
+		allowed    bool
+		limit      uint64
+		remaining  uint64
+		reset      time.Time
+		retryAfter time.Duration
+	}
+)
+
+// NewMemoryRateLimitCounters keeps rate limit counters in memory
+//
+// Limits are not shared between server instances
+func NewMemoryRateLimitCounters() *memoryRateLimitCounters {
+	return &memoryRateLimitCounters{
+		states: make(map[string]memoryRateLimitState),
+	}
+}
+
+func (c *memoryRateLimitCounters) Update(_ context.Context, key string, ttl time.Duration, fn func(RateLimitState) RateLimitState) error {
+	var (
+		now = time.Now()
+	)
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if now.Sub(c.swept) > rateLimitSweepInterval {
+		c.swept = now
+		for k, s := range c.states {
+			if s.expiresAt.Before(now) {
+				delete(c.states, k)
+			}
+		}
+	}
+
+	c.states[key] = memoryRateLimitState{
+		RateLimitState: fn(c.states[key].RateLimitState),
+		expiresAt:      now.Add(ttl),
+	}
+
+	return nil
+}
+
+// NewStoreRateLimitCounters keeps rate limit counters in the store
+//
+// Limits are shared between all server instances using the same store
+func NewStoreRateLimitCounters(s RateLimitStorer) *storeRateLimitCounters {
+	return &storeRateLimitCounters{
+		store: s,
+	}
+}
+
+func (c *storeRateLimitCounters) Update(ctx context.Context, key string, ttl time.Duration, fn func(RateLimitState) RateLimitState) error {
+	c.sweep()
+
+	for i := 0; i < rateLimitMaxRetries; i++ {
+		var (
+			now = time.Now()
+			cur RateLimitState
+		)
+
+		counter, err := c.store.LookupApigwRateLimitByName(ctx, key)
+		if errors.Is(err, store.ErrNotFound) {
+			counter = &st.ApigwRateLimit{Name: key}
+		} else if err != nil {
+			return err
+		} else {
+			cur = RateLimitState{Count: counter.Count, Stamp: time.Unix(0, counter.Stamp)}
+		}
+
+		upd := fn(cur)
+		if counter.Version > 0 && upd.Count == cur.Count && upd.Stamp.Equal(cur.Stamp) {
+			// nothing to save (request was not allowed)
+			return nil
+		}
+
+		counter.Count = upd.Count
+		counter.Stamp = upd.Stamp.UnixNano()
+		counter.ExpiresAt = now.Add(ttl)
+		counter.Version++
+
+		if ok, err := c.store.SaveApigwRateLimit(ctx, counter); err != nil {
+			return err
+		} else if ok {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("could not update rate limit counter %s: too many concurrent updates", key)
+}
+
+// sweep removes expired counters from the store
+func (c *storeRateLimitCounters) sweep() {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if time.Since(c.swept) < rateLimitSweepInterval {
+		return
+	}
+
+	c.swept = time.Now()
+	go func() {
+		_ = c.store.DeleteExpiredApigwRateLimits(context.Background())
+	}()
+}
+
+// takeFixedWindow allows limit requests in every window
+//
+// Windows are aligned to the zero time so that they
+// are the same for all server instances
+func takeFixedWindow(s RateLimitState, now time.Time, limit uint64, window time.Duration) (RateLimitState, rateLimitResult) {
+	var (
+		start = now.Truncate(window)
+		res   = rateLimitResult{limit: limit, reset: start.Add(window)}
+	)
+
+	if !s.Stamp.Equal(start) {
+		// new window
+		s = RateLimitState{Stamp: start}
+	}
+
+	if s.Count >= limit {
+		res.retryAfter = res.reset.Sub(now)
+		return s, res
+	}
+
+	s.Count++
+	res.allowed = true
+	res.remaining = limit - s.Count
+	return s, res
+}
+
+// takeTokenBucket allows bursts of up to burst requests,
+// refilling the bucket with limit tokens per window
+//
+// It is implemented as generic cell rate algorithm where the
+// state of the bucket is theoretical arrival time of the next request
+func takeTokenBucket(s RateLimitState, now time.Time, limit, burst uint64, window time.Duration) (RateLimitState, rateLimitResult) {
+	var (
+		interval  = window / time.Duration(limit)
+		tolerance = interval * time.Duration(burst)
+
+		tat = s.Stamp
+		res = rateLimitResult{limit: burst}
+	)
+
+	if tat.Before(now) {
+		tat = now
+	}
+
+	var (
+		next    = tat.Add(interval)
+		allowAt = next.Add(-tolerance)
+	)
+
+	if now.Before(allowAt) {
+		res.reset = tat
+		res.retryAfter = allowAt.Sub(now)
+		return s, res
+	}
+
+	s.Stamp = next
+	res.allowed = true
+	res.remaining = uint64(now.Sub(allowAt) / interval)
+	res.reset = next
+	return s, res
+}
+
+// headers sets rate limit headers to the response
+//
+// Reset and Retry-After are in seconds
+func (res rateLimitResult) headers(h http.Header, now time.Time) {
+	h.Set("X-RateLimit-Limit", strconv.FormatUint(res.limit, 10))
+	h.Set("X-RateLimit-Remaining", strconv.FormatUint(res.remaining, 10))
+	h.Set("X-RateLimit-Reset", strconv.FormatInt(ceilSeconds(res.reset.Sub(now)), 10))
+
+	if !res.allowed {
+		h.Set("Retry-After", strconv.FormatInt(ceilSeconds(res.retryAfter), 10))
+	}
+}
+
+func ceilSeconds(d time.Duration) int64 {
+	if d <= 0 {
+		return 0
+	}
+
+	return int64((d + time.Second - 1) / time.Second)
+}
+
+// requestIP returns IP address of the client
+//
+// X-Forwarded-For and X-Real-IP headers are already
+// handled by the (real IP) middleware
+func requestIP(r *http.Request) string {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+
+	return r.RemoteAddr
+}
+
+// requestIdentity returns ID of the authenticated user that made the request
+//
+// Identity in the context is replaced with the service user
+// when route is served so the identity is read from the scope
+// (set by the auth prefilters) or from the request token
+func requestIdentity(r *http.Request) uint64 {
+	if i := agctx.ScopeFromContext(r.Context()).Identity(); i != nil {
+		return i.Identity()
+	}
+
+	if token, _, err := jwtauth.FromContext(r.Context()); err == nil && token != nil {
+		return auth.IdentityFromToken(token).Identity()
+	}
+
+	return 0
+}
+
+// rateLimitKey assembles the name of the counter
+func rateLimitKey(routeID uint64, keyBy, value string) string {
+	if len(value) > rateLimitMaxKeyValue {
+		value = fmt.Sprintf("%x", sha1.Sum([]byte(value)))
+	}
+
+	return fmt.Sprintf("route:%d:%s:%s", routeID, keyBy, value)
+}
@@ -0,0 +1,132 @@
+package filter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/store"
+	st "github.com/cortezaproject/corteza-server/system/types"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	mockRateLimitStorer struct {
+		counters map[string]st.ApigwRateLimit
+
+		// simulates concurrent updates
+		conflicts int
+	}
+)
+
+func (s *mockRateLimitStorer) LookupApigwRateLimitByName(_ context.Context, name string) (*st.ApigwRateLimit, error) {
+	if c, ok := s.counters[name]; ok {
+		return &c, nil
+	}
+
+	return nil, store.ErrNotFound
+}
+
+func (s *mockRateLimitStorer) SaveApigwRateLimit(_ context.Context, c *st.ApigwRateLimit) (bool, error) {
+	if s.conflicts > 0 {
+		s.conflicts--
+		return false, nil
+	}
+
+	if s.counters[c.Name].Version != c.Version-1 {
+		return false, nil
+	}
+
+	s.counters[c.Name] = *c
+	return true, nil
+}
+
+func (s *mockRateLimitStorer) DeleteExpiredApigwRateLimits(context.Context) error {
+	return nil
+}
+
+func Test_takeFixedWindow(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		now = time.Date(2021, 1, 1, 10, 0, 30, 0, time.UTC)
+		s   RateLimitState
+		res rateLimitResult
+	)
+
+	for i := uint64(1); i <= 3; i++ {
+		s, res = takeFixedWindow(s, now, 3, time.Minute)
+		req.True(res.allowed)
+		req.Equal(3-i, res.remaining)
+	}
+
+	s, res = takeFixedWindow(s, now, 3, time.Minute)
+	req.False(res.allowed)
+	req.Equal(30*time.Second, res.retryAfter)
+	req.Equal(uint64(3), s.Count)
+
+	// next window
+	s, res = takeFixedWindow(s, now.Add(30*time.Second), 3, time.Minute)
+	req.True(res.allowed)
+	req.Equal(uint64(1), s.Count)
+}
+
+func Test_takeTokenBucket(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		now = time.Now()
+		s   RateLimitState
+		res rateLimitResult
+	)
+
+	// burst of 2 requests, refilled with 6 tokens per minute
+	for i := uint64(1); i <= 2; i++ {
+		s, res = takeTokenBucket(s, now, 6, 2, time.Minute)
+		req.True(res.allowed)
+		req.Equal(2-i, res.remaining)
+	}
+
+	_, res = takeTokenBucket(s, now, 6, 2, time.Minute)
+	req.False(res.allowed)
+	req.Equal(10*time.Second, res.retryAfter)
+
+	// one token refilled
+	s, res = takeTokenBucket(s, now.Add(10*time.Second), 6, 2, time.Minute)
+	req.True(res.allowed)
+	req.Equal(uint64(0), res.remaining)
+
+	// bucket refilled
+	_, res = takeTokenBucket(s, now.Add(time.Hour), 6, 2, time.Minute)
+	req.True(res.allowed)
+	req.Equal(uint64(1), res.remaining)
+}
+
+func Test_storeRateLimitCounters(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+
+		s = &mockRateLimitStorer{counters: map[string]st.ApigwRateLimit{}}
+		c = NewStoreRateLimitCounters(s)
+
+		inc = func(s RateLimitState) RateLimitState {
+			s.Count++
+			return s
+		}
+	)
+
+	c.swept = time.Now()
+
+	req.NoError(c.Update(ctx, "k", time.Minute, inc))
+	req.Equal(uint64(1), s.counters["k"].Count)
+
+	// retried on conflicts
+	s.conflicts = 3
+	req.NoError(c.Update(ctx, "k", time.Minute, inc))
+	req.Equal(uint64(2), s.counters["k"].Count)
+	req.Equal(uint64(2), s.counters["k"].Version)
+
+	s.conflicts = rateLimitMaxRetries
+	req.Error(c.Update(ctx, "k", time.Minute, inc))
+}
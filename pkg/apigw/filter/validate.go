@@ -0,0 +1,345 @@
+package filter
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	st "github.com/cortezaproject/corteza-server/system/types"
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	validateInBody = "body"
+)
+
+type (
+	// validationError describes the invalid field of the request
+	validationError struct {
+		In      string `json:"in"`
+		Name    string `json:"name,omitempty"`
+		Message string `json:"message"`
+	}
+
+	// schemaValidator validates the request against the route schema
+	//
+	// Patterns are compiled when validator is made
+	schemaValidator struct {
+		rs       *st.ApigwRouteSchema
+		patterns map[*st.ApigwSchema]*regexp.Regexp
+	}
+)
+
+// ValidateRouteSchema checks if the route schema can be used for request validation
+func ValidateRouteSchema(rs *st.ApigwRouteSchema) (err error) {
+	_, err = newSchemaValidator(rs)
+	return
+}
+
+func newSchemaValidator(rs *st.ApigwRouteSchema) (sv *schemaValidator, err error) {
+	sv = &schemaValidator{
+		rs:       rs,
+		patterns: make(map[*st.ApigwSchema]*regexp.Regexp),
+	}
+
+	for _, p := range rs.Parameters {
+		switch p.In {
+		case st.ApigwSchemaInQuery, st.ApigwSchemaInHeader, st.ApigwSchemaInPath:
+		default:
+			return nil, fmt.Errorf("unknown location %q of parameter %q", p.In, p.Name)
+		}
+
+		if p.Schema != nil {
+			switch p.Schema.Type {
+			case "object":
+				return nil, fmt.Errorf("unsupported type %q of parameter %q", p.Schema.Type, p.Name)
+			}
+		}
+
+		if err = sv.compile(p.Schema); err != nil {
+			return nil, fmt.Errorf("invalid schema of parameter %q: %s", p.Name, err)
+		}
+	}
+
+	if err = sv.compile(rs.Body); err != nil {
+		return nil, fmt.Errorf("invalid schema of body: %s", err)
+	}
+
+	return sv, nil
+}
+
+// compile checks the types and compiles the patterns of the schema
+func (sv *schemaValidator) compile(s *st.ApigwSchema) (err error) {
+	if s == nil {
+		return
+	}
+
+	switch s.Type {
+	case "", "string", "number", "integer", "boolean", "array", "object":
+	default:
+		return fmt.Errorf("unknown type %q", s.Type)
+	}
+
+	if s.Pattern != "" {
+		if sv.patterns[s], err = regexp.Compile(s.Pattern); err != nil {
+			return
+		}
+	}
+
+	if err = sv.compile(s.Items); err != nil {
+		return
+	}
+
+	for _, p := range s.Properties {
+		if err = sv.compile(p); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// validateRequest validates parameters and body of the request
+func (sv *schemaValidator) validateRequest(r *http.Request, body []byte) (ee []validationError) {
+	for _, p := range sv.rs.Parameters {
+		var vv []string
+
+		switch p.In {
+		case st.ApigwSchemaInQuery:
+			vv = r.URL.Query()[p.Name]
+		case st.ApigwSchemaInHeader:
+			vv = r.Header.Values(p.Name)
+		case st.ApigwSchemaInPath:
+			if v := chi.URLParam(r, p.Name); v != "" {
+				vv = []string{v}
+			}
+		}
+
+		if len(vv) == 0 {
+			if p.Required {
+				ee = append(ee, validationError{In: p.In, Name: p.Name, Message: "required"})
+			}
+
+			continue
+		}
+
+		v, err := paramValue(p.Schema, vv)
+		if err != nil {
+			ee = append(ee, validationError{In: p.In, Name: p.Name, Message: err.Error()})
+			continue
+		}
+
+		ee = append(ee, sv.validate(p.Schema, v, p.In, p.Name)...)
+	}
+
+	if sv.rs.Body == nil {
+		return
+	}
+
+	if len(body) == 0 {
+		return append(ee, validationError{In: validateInBody, Message: "required"})
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return append(ee, validationError{In: validateInBody, Message: "invalid JSON"})
+	}
+
+	return append(ee, sv.validate(sv.rs.Body, v, validateInBody, "")...)
+}
+
+// validate validates (decoded JSON) value against the schema
+func (sv *schemaValidator) validate(s *st.ApigwSchema, v interface{}, in, name string) (ee []validationError) {
+	if s == nil {
+		return
+	}
+
+	invalid := func(m string, a ...interface{}) []validationError {
+		return append(ee, validationError{In: in, Name: name, Message: fmt.Sprintf(m, a...)})
+	}
+
+	if v == nil {
+		if s.Type != "" {
+			return invalid("expected %s", s.Type)
+		}
+
+		return
+	}
+
+	if len(s.Enum) > 0 && !enumContains(s.Enum, v) {
+		return invalid("not one of the allowed values")
+	}
+
+	switch c := v.(type) {
+	case string:
+		if s.Type != "" && s.Type != "string" {
+			return invalid("expected %s", s.Type)
+		}
+
+		l := uint64(utf8.RuneCountInString(c))
+		if s.MinLength != nil && l < *s.MinLength {
+			return invalid("at least %d characters expected", *s.MinLength)
+		}
+
+		if s.MaxLength != nil && l > *s.MaxLength {
+			return invalid("at most %d characters expected", *s.MaxLength)
+		}
+
+		if p := sv.patterns[s]; p != nil && !p.MatchString(c) {
+			return invalid("does not match pattern %s", s.Pattern)
+		}
+
+	case float64:
+		switch s.Type {
+		case "", "number":
+		case "integer":
+			if c != math.Trunc(c) {
+				return invalid("expected integer")
+			}
+		default:
+			return invalid("expected %s", s.Type)
+		}
+
+		if s.Minimum != nil && c < *s.Minimum {
+			return invalid("must be greater than or equal to %v", *s.Minimum)
+		}
+
+		if s.Maximum != nil && c > *s.Maximum {
+			return invalid("must be less than or equal to %v", *s.Maximum)
+		}
+
+	case bool:
+		if s.Type != "" && s.Type != "boolean" {
+			return invalid("expected %s", s.Type)
+		}
+
+	case []interface{}:
+		if s.Type != "" && s.Type != "array" {
+			return invalid("expected %s", s.Type)
+		}
+
+		l := uint64(len(c))
+		if s.MinItems != nil && l < *s.MinItems {
+			return invalid("at least %d items expected", *s.MinItems)
+		}
+
+		if s.MaxItems != nil && l > *s.MaxItems {
+			return invalid("at most %d items expected", *s.MaxItems)
+		}
+
+		for i, item := range c {
+			ee = append(ee, sv.validate(s.Items, item, in, fmt.Sprintf("%s[%d]", name, i))...)
+		}
+
+	case map[string]interface{}:
+		if s.Type != "" && s.Type != "object" {
+			return invalid("expected %s", s.Type)
+		}
+
+		for _, p := range s.Required {
+			if _, has := c[p]; !has {
+				ee = append(ee, validationError{In: in, Name: propertyName(name, p), Message: "required"})
+			}
+		}
+
+		// sorted for stable output
+		pp := make([]string, 0, len(c))
+		for p := range c {
+			pp = append(pp, p)
+		}
+
+		sort.Strings(pp)
+
+		for _, p := range pp {
+			ps, has := s.Properties[p]
+			if !has {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					ee = append(ee, validationError{In: in, Name: propertyName(name, p), Message: "unknown property"})
+				}
+
+				continue
+			}
+
+			ee = append(ee, sv.validate(ps, c[p], in, propertyName(name, p))...)
+		}
+	}
+
+	return
+}
+
+// paramValue converts values of the query, header or path
+// parameter to the type of the parameter schema
+//
+// Multiple values are expected for arrays
+func paramValue(s *st.ApigwSchema, vv []string) (interface{}, error) {
+	if s == nil {
+		return vv[0], nil
+	}
+
+	switch s.Type {
+	case "array":
+		out := make([]interface{}, len(vv))
+		for i, v := range vv {
+			item, err := paramValue(s.Items, []string{v})
+			if err != nil {
+				return nil, err
+			}
+
+			out[i] = item
+		}
+
+		return out, nil
+
+	case "integer":
+		i, err := strconv.ParseInt(vv[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected integer")
+		}
+
+		return float64(i), nil
+
+	case "number":
+		f, err := strconv.ParseFloat(vv[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected number")
+		}
+
+		return f, nil
+
+	case "boolean":
+		b, err := strconv.ParseBool(vv[0])
+		if err != nil {
+			return nil, fmt.Errorf("expected boolean")
+		}
+
+		return b, nil
+
+	default:
+		return vv[0], nil
+	}
+}
+
+func enumContains(enum []interface{}, v interface{}) bool {
+	for _, e := range enum {
+		if reflect.DeepEqual(e, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func propertyName(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+
+	return strings.Join([]string{parent, name}, ".")
+}
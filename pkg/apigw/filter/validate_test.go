@@ -0,0 +1,164 @@
+package filter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	agctx "github.com/cortezaproject/corteza-server/pkg/apigw/ctx"
+	"github.com/cortezaproject/corteza-server/pkg/apigw/types"
+	pe "github.com/cortezaproject/corteza-server/pkg/errors"
+	"github.com/cortezaproject/corteza-server/pkg/options"
+	st "github.com/cortezaproject/corteza-server/system/types"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const (
+	testRouteSchema = `{
+		"parameters": [
+			{ "name": "limit", "in": "query", "schema": { "type": "integer", "minimum": 1, "maximum": 100 } },
+			{ "name": "tag", "in": "query", "schema": { "type": "array", "items": { "type": "string", "enum": ["a", "b"] } } },
+			{ "name": "X-Tenant", "in": "header", "required": true },
+			{ "name": "id", "in": "path", "required": true, "schema": { "type": "string", "pattern": "^[0-9]+$" } }
+		],
+		"body": {
+			"type": "object",
+			"required": ["name"],
+			"additionalProperties": false,
+			"properties": {
+				"name": { "type": "string", "minLength": 2 },
+				"items": { "type": "array", "maxItems": 2, "items": { "type": "object", "properties": { "qty": { "type": "integer" } } } }
+			}
+		}
+	}`
+)
+
+func Test_validateRequestSchema(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		schema = func(s string) *st.ApigwRouteSchema {
+			rs := &st.ApigwRouteSchema{}
+			req.NoError(json.Unmarshal([]byte(s), rs))
+			return rs
+		}
+
+		vr = NewValidateRequest(options.ApigwOpt{})
+	)
+
+	req.EqualError(vr.RouteSchema(nil), "could not validate request: route schema not set")
+	req.NoError(vr.RouteSchema(schema(testRouteSchema)))
+
+	req.EqualError(
+		vr.RouteSchema(schema(`{"parameters":[{"name":"foo","in":"cookie"}]}`)),
+		`could not validate request: unknown location "cookie" of parameter "foo"`,
+	)
+
+	req.EqualError(
+		vr.RouteSchema(schema(`{"body":{"type":"str"}}`)),
+		`could not validate request: invalid schema of body: unknown type "str"`,
+	)
+
+	req.EqualError(
+		vr.RouteSchema(schema(`{"body":{"properties":{"foo":{"pattern":"("}}}}`)),
+		"could not validate request: invalid schema of body: error parsing regexp: missing closing ): `(`",
+	)
+}
+
+func Test_validateRequestHandle(t *testing.T) {
+	var (
+		req = require.New(t)
+		rs  = &st.ApigwRouteSchema{}
+		vr  = NewValidateRequest(options.ApigwOpt{})
+
+		call = func(target, id, body string, hdr bool) error {
+			r := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+			if hdr {
+				r.Header.Set("X-Tenant", "foo")
+			}
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("id", id)
+
+			scope := &types.Scp{"request": createRequest(r)}
+			r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+			r = r.WithContext(agctx.ScopeToContext(r.Context(), scope))
+			return vr.Handler()(httptest.NewRecorder(), r)
+		}
+
+		invalid = func(err error) []validationError {
+			req.Error(err)
+			req.True(types.IsValidationError(err))
+			return err.(*pe.Error).Meta()[types.ErrorMetaValidation].([]validationError)
+		}
+	)
+
+	req.NoError(json.Unmarshal([]byte(testRouteSchema), rs))
+	req.NoError(vr.RouteSchema(rs))
+
+	req.NoError(call("/foo/42?limit=10&tag=a&tag=b", "42", `{"name":"foo","items":[{"qty":1}]}`, true))
+
+	req.Equal(
+		[]validationError{
+			{In: "query", Name: "limit", Message: "must be less than or equal to 100"},
+			{In: "query", Name: "tag[1]", Message: "not one of the allowed values"},
+			{In: "header", Name: "X-Tenant", Message: "required"},
+			{In: "path", Name: "id", Message: "does not match pattern ^[0-9]+$"},
+			{In: "body", Name: "name", Message: "required"},
+		},
+		invalid(call("/foo/bar?limit=500&tag=a&tag=c", "bar", `{}`, false)),
+	)
+
+	req.Equal(
+		[]validationError{
+			{In: "query", Name: "limit", Message: "expected integer"},
+			{In: "body", Name: "extra", Message: "unknown property"},
+			{In: "body", Name: "items[0].qty", Message: "expected integer"},
+			{In: "body", Name: "name", Message: "at least 2 characters expected"},
+		},
+		invalid(call("/foo/42?limit=foo", "42", `{"name":"f","extra":true,"items":[{"qty":1.5}]}`, true)),
+	)
+
+	req.Equal(
+		[]validationError{{In: "body", Message: "invalid JSON"}},
+		invalid(call("/foo/42", "42", `{`, true)),
+	)
+
+	req.Equal(
+		[]validationError{{In: "body", Message: "required"}},
+		invalid(call("/foo/42", "42", ``, true)),
+	)
+}
+
+func Test_validationErrorServed(t *testing.T) {
+	var (
+		req = require.New(t)
+		rw  = httptest.NewRecorder()
+
+		err = pe.InvalidData("invalid request").
+			Apply(pe.Meta(types.ErrorMetaValidation, []validationError{{In: "query", Name: "limit", Message: "required"}}))
+
+		out struct {
+			Error struct {
+				Message string
+				Meta    map[string]interface{}
+				Stack   interface{}
+			}
+		}
+	)
+
+	// served as JSON even when request does not accept it
+	types.NewDefaultErrorHandler(zap.NewNop()).Handler()(rw, httptest.NewRequest(http.MethodGet, "/foo", http.NoBody), err)
+
+	req.Equal(http.StatusBadRequest, rw.Code)
+	req.Equal("application/json", rw.Header().Get("Content-Type"))
+	req.NoError(json.Unmarshal(rw.Body.Bytes(), &out))
+	req.Equal("invalid request", out.Error.Message)
+	req.NotNil(out.Error.Meta[types.ErrorMetaValidation])
+	req.Nil(out.Error.Stack)
+}
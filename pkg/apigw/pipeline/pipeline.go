@@ -20,6 +20,10 @@ type (
 		Name    string
 		Type    types.FilterKind
 		Handler func(rw http.ResponseWriter, r *http.Request) error
+
+		// Middleware, when set, is used instead of the handler
+		// and wraps all the workers that follow
+		Middleware func(http.Handler) http.Handler
 	}
 
 	workerSet []*Worker
@@ -76,6 +80,11 @@ func (pp *Pl) Handler() http.Handler {
 // it is used in chaining
 func (pp *Pl) makeMiddleware(hh ...*Worker) (middleware []func(http.Handler) http.Handler) {
 	for _, wrker := range hh {
+		if wrker.Middleware != nil {
+			middleware = append(middleware, wrker.Middleware)
+			continue
+		}
+
 		middleware = append(middleware, pp.makeHandler(*wrker))
 	}
 
@@ -95,7 +104,7 @@ func (pp *Pl) makeHandler(hh Worker) func(next http.Handler) http.Handler {
 
 			log.Debug("started processing", zap.Bool("async", hh.Async))
 
-			fn := func() (err error) {
+			fn := func(r *http.Request) (err error) {
 				err = hh.Handler(rw, r)
 				log.Debug("finished processing", zap.Duration("duration", time.Since(start)))
 				return
@@ -113,16 +122,19 @@ func (pp *Pl) makeHandler(hh Worker) func(next http.Handler) http.Handler {
 					newCtx = actx.ScopeToContext(context.Background(), scope)
 					newCtx = auth.SetIdentityToContext(newCtx, ident)
 
-					r = r.WithContext(newCtx)
-
 					// only log error, do not call error handler,
 					// since we do not reply back the response (it was already sent)
-					if err = fn(); err != nil {
+					if err := fn(r.WithContext(newCtx)); err != nil {
 						log.Error(err.Error())
 					}
 				}()
 			} else {
-				err = fn()
+				err = fn(r)
+
+				// time spent in the filter is added to the profiled hit
+				if hit := actx.ProfilerFromContext(r.Context()); hit != nil {
+					hit.AddFilter(hh.Name, string(hh.Type), start, time.Since(start), err)
+				}
 			}
 
 			if err != nil {
@@ -6,7 +6,9 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	actx "github.com/cortezaproject/corteza-server/pkg/apigw/ctx"
 	"github.com/cortezaproject/corteza-server/pkg/apigw/pipeline/chain"
+	"github.com/cortezaproject/corteza-server/pkg/apigw/profiler"
 	"github.com/cortezaproject/corteza-server/pkg/apigw/types"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -124,3 +126,60 @@ func Test_pipelineExecErr(t *testing.T) {
 	}
 
 }
+
+func Test_pipelineMiddleware(t *testing.T) {
+	var (
+		req = require.New(t)
+		rr  = httptest.NewRecorder()
+		p   = NewPl()
+
+		handler = func(s string) func(rw http.ResponseWriter, r *http.Request) error {
+			return func(rw http.ResponseWriter, r *http.Request) error {
+				rw.Write([]byte(s))
+				return nil
+			}
+		}
+	)
+
+	p.Add(&Worker{Handler: handler("first"), Weight: 0})
+	p.Add(&Worker{Handler: handler("third"), Weight: 10})
+	p.Add(&Worker{
+		Weight: 5,
+		Middleware: func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				rw.Write([]byte("("))
+				next.ServeHTTP(rw, r)
+				rw.Write([]byte(")"))
+			})
+		},
+	})
+
+	p.Handler().ServeHTTP(rr, &http.Request{})
+
+	req.Equal(`first(third)`, rr.Body.String())
+}
+
+func Test_pipelineFilterTimings(t *testing.T) {
+	var (
+		req = require.New(t)
+		rr  = httptest.NewRecorder()
+		p   = NewPl()
+		hit = &profiler.Hit{}
+
+		r = httptest.NewRequest(http.MethodGet, "/foo", http.NoBody)
+	)
+
+	p.Add(&Worker{Name: "header", Type: types.PreFilter, Handler: mockEmptyHandler, Weight: 0})
+	p.Add(&Worker{Name: "proxy", Type: types.Processer, Handler: mockEmptyHandler, Weight: 10})
+	p.Add(&Worker{Name: "async", Type: types.Processer, Handler: mockEmptyHandler, Weight: 20, Async: true})
+
+	r = r.WithContext(actx.ProfilerToContext(r.Context(), hit))
+	p.Handler().ServeHTTP(rr, r)
+
+	// async filters are not timed
+	req.Len(hit.Filters, 2)
+	req.Equal("header", hit.Filters[0].Name)
+	req.Equal("prefilter", hit.Filters[0].Kind)
+	req.Equal("proxy", hit.Filters[1].Name)
+	req.Empty(hit.Filters[1].Error)
+}
@@ -0,0 +1,111 @@
+package profiler
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	st "github.com/cortezaproject/corteza-server/system/types"
+)
+
+type (
+	// Aggregation holds the stats of the hits of the same path
+	Aggregation struct {
+		Path  string
+		Route uint64
+		Count uint64
+
+		Tmin, Tmax, Tsum       time.Duration
+		Tp50, Tp90, Tp95, Tp99 time.Duration
+
+		Smin, Smax, Ssum int64
+	}
+
+	Aggregations []*Aggregation
+)
+
+// aggregate calculates the stats of the hits per path
+func aggregate(list Hits) (out Aggregations) {
+	for p, v := range list {
+		var (
+			a  = &Aggregation{Path: p, Route: v[len(v)-1].Route, Tmin: time.Hour, Smin: math.MaxInt64}
+			dd = make([]time.Duration, 0, len(v))
+		)
+
+		for _, vv := range v {
+			var (
+				d = *vv.D
+				s = vv.R.ContentLength
+			)
+
+			dd = append(dd, d)
+
+			if d < a.Tmin {
+				a.Tmin = d
+			}
+
+			if d > a.Tmax {
+				a.Tmax = d
+			}
+
+			if s < a.Smin {
+				a.Smin = s
+			}
+
+			if s > a.Smax {
+				a.Smax = s
+			}
+
+			a.Tsum += d
+			a.Ssum += s
+			a.Count++
+		}
+
+		sort.Slice(dd, func(i, j int) bool { return dd[i] < dd[j] })
+
+		a.Tp50 = percentile(dd, 50)
+		a.Tp90 = percentile(dd, 90)
+		a.Tp95 = percentile(dd, 95)
+		a.Tp99 = percentile(dd, 99)
+
+		out = append(out, a)
+	}
+
+	return
+}
+
+func fromRecordAggregate(r *st.ApigwProfilerRecordAggregate) *Aggregation {
+	return &Aggregation{
+		Path:  r.Path,
+		Route: r.Route,
+		Count: r.Count,
+
+		Tmin: time.Duration(r.DurationMin),
+		Tmax: time.Duration(r.DurationMax),
+		Tsum: time.Duration(r.DurationSum),
+
+		Tp50: time.Duration(r.DurationP50),
+		Tp90: time.Duration(r.DurationP90),
+		Tp95: time.Duration(r.DurationP95),
+		Tp99: time.Duration(r.DurationP99),
+
+		Smin: r.ContentLengthMin,
+		Smax: r.ContentLengthMax,
+		Ssum: r.ContentLengthSum,
+	}
+}
+
+// percentile returns the value at the given percentile
+// of the sorted durations, using the nearest-rank method
+func percentile(dd []time.Duration, p float64) time.Duration {
+	if len(dd) == 0 {
+		return 0
+	}
+
+	i := int(math.Ceil(p/100*float64(len(dd)))) - 1
+	if i < 0 {
+		i = 0
+	}
+
+	return dd[i]
+}
@@ -0,0 +1,93 @@
+package profiler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	h "github.com/cortezaproject/corteza-server/pkg/http"
+	"github.com/stretchr/testify/require"
+)
+
+func testExportHit(t *testing.T) *Hit {
+	var (
+		ts = time.Date(2022, time.March, 1, 1, 1, 1, 0, time.UTC)
+		tf = ts.Add(20 * time.Millisecond)
+		d  = tf.Sub(ts)
+
+		r = httptest.NewRequest("POST", "http://example.tld/foo?bar=baz&access_token=foo", strings.NewReader(`{"foo":"bar"}`))
+	)
+
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("Authorization", "Bearer foo")
+
+	hr, err := h.NewRequest(r)
+	require.NoError(t, err)
+
+	hit := &Hit{ID: "foo", Route: 42, Status: http.StatusBadGateway, R: hr, Ts: &ts, Tf: &tf, D: &d}
+	hit.AddFilter("header", "prefilter", ts, time.Millisecond, nil)
+	hit.AddFilter("proxy", "processer", ts.Add(time.Millisecond), 15*time.Millisecond, errors.New("upstream failed"))
+
+	return hit
+}
+
+func Test_ApigwProfiler_exportHAR(t *testing.T) {
+	var (
+		req = require.New(t)
+		har = ExportHAR([]*Hit{testExportHit(t)})
+	)
+
+	req.Equal("1.2", har.Log.Version)
+	req.Len(har.Log.Entries, 1)
+
+	e := har.Log.Entries[0]
+	req.Equal(20.0, e.Time)
+	req.Equal("POST", e.Request.Method)
+	req.Equal("http://example.tld/foo?bar=baz&access_token=***", e.Request.URL)
+	req.Equal([]HARNameValue{{Name: "access_token", Value: "***"}, {Name: "bar", Value: "baz"}}, e.Request.QueryString)
+	req.Contains(e.Request.Headers, HARNameValue{Name: "Authorization", Value: "***"})
+	req.NotNil(e.Request.PostData)
+	req.Equal(`{"foo":"bar"}`, e.Request.PostData.Text)
+	req.Equal(http.StatusBadGateway, e.Response.Status)
+	req.Equal(uint64(42), e.Route)
+	req.Len(e.Filters, 2)
+	req.Equal("upstream failed", e.Filters[1].Error)
+}
+
+func Test_ApigwProfiler_exportSpans(t *testing.T) {
+	var (
+		req = require.New(t)
+		tt  = ExportSpans([]*Hit{testExportHit(t)})
+	)
+
+	req.Len(tt.ResourceSpans, 1)
+	req.Len(tt.ResourceSpans[0].ScopeSpans, 1)
+
+	ss := tt.ResourceSpans[0].ScopeSpans[0].Spans
+	req.Len(ss, 3)
+
+	root := ss[0]
+	req.Len(root.TraceID, 32)
+	req.Len(root.SpanID, 16)
+	req.Empty(root.ParentSpanID)
+	req.Equal("POST /foo", root.Name)
+	req.Equal(otelStatusError, root.Status.Code)
+	req.Contains(root.Attributes, otelString("http.target", "/foo?bar=baz&access_token=***"))
+	req.Contains(root.Attributes, otelString("http.url", "http://example.tld/foo?bar=baz&access_token=***"))
+
+	for _, s := range ss[1:] {
+		req.Equal(root.TraceID, s.TraceID)
+		req.Equal(root.SpanID, s.ParentSpanID)
+		req.NotEqual(root.SpanID, s.SpanID)
+	}
+
+	req.Equal("header", ss[1].Name)
+	req.Equal(otelStatusOk, ss[1].Status.Code)
+	req.Equal("upstream failed", ss[2].Status.Message)
+
+	// same IDs on repeated export
+	req.Equal(root.TraceID, ExportSpans([]*Hit{testExportHit(t)}).ResourceSpans[0].ScopeSpans[0].Spans[0].TraceID)
+}
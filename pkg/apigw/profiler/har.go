@@ -0,0 +1,243 @@
+package profiler
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+)
+
+const (
+	harVersion = "1.2"
+	harCreator = "Corteza Integration Gateway profiler"
+)
+
+type (
+	// HAR is the HTTP Archive (v1.2) of the profiled requests
+	//
+	// Responses are not recorded by the profiler, only the status is set;
+	// route ID and filter timings are added as custom fields
+	HAR struct {
+		Log HARLog `json:"log"`
+	}
+
+	HARLog struct {
+		Version string     `json:"version"`
+		Creator HARCreator `json:"creator"`
+		Entries []HAREntry `json:"entries"`
+	}
+
+	HARCreator struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+
+	HAREntry struct {
+		StartedDateTime time.Time   `json:"startedDateTime"`
+		Time            float64     `json:"time"`
+		Request         HARRequest  `json:"request"`
+		Response        HARResponse `json:"response"`
+		Cache           struct{}    `json:"cache"`
+		Timings         HARTimings  `json:"timings"`
+
+		Route   uint64      `json:"_route,string"`
+		Filters []HARFilter `json:"_filters,omitempty"`
+	}
+
+	HARRequest struct {
+		Method      string         `json:"method"`
+		URL         string         `json:"url"`
+		HTTPVersion string         `json:"httpVersion"`
+		Cookies     []HARNameValue `json:"cookies"`
+		Headers     []HARNameValue `json:"headers"`
+		QueryString []HARNameValue `json:"queryString"`
+		PostData    *HARPostData   `json:"postData,omitempty"`
+		HeadersSize int64          `json:"headersSize"`
+		BodySize    int64          `json:"bodySize"`
+	}
+
+	HARResponse struct {
+		Status      int            `json:"status"`
+		StatusText  string         `json:"statusText"`
+		HTTPVersion string         `json:"httpVersion"`
+		Cookies     []HARNameValue `json:"cookies"`
+		Headers     []HARNameValue `json:"headers"`
+		Content     HARContent     `json:"content"`
+		RedirectURL string         `json:"redirectURL"`
+		HeadersSize int64          `json:"headersSize"`
+		BodySize    int64          `json:"bodySize"`
+	}
+
+	HARNameValue struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+
+	HARPostData struct {
+		MimeType string `json:"mimeType"`
+		Text     string `json:"text"`
+	}
+
+	HARContent struct {
+		Size     int64  `json:"size"`
+		MimeType string `json:"mimeType"`
+	}
+
+	HARTimings struct {
+		Send    float64 `json:"send"`
+		Wait    float64 `json:"wait"`
+		Receive float64 `json:"receive"`
+	}
+
+	HARFilter struct {
+		Name  string    `json:"name"`
+		Kind  string    `json:"kind"`
+		Start time.Time `json:"startedDateTime"`
+		Time  float64   `json:"time"`
+		Error string    `json:"error,omitempty"`
+	}
+)
+
+// ExportHAR converts hits to the HTTP Archive
+//
+// Sensitive request headers are masked
+func ExportHAR(hh []*Hit) *HAR {
+	har := &HAR{
+		Log: HARLog{
+			Version: harVersion,
+			Creator: HARCreator{Name: harCreator, Version: harVersion},
+			Entries: make([]HAREntry, 0, len(hh)),
+		},
+	}
+
+	for _, hit := range hh {
+		har.Log.Entries = append(har.Log.Entries, harEntry(hit))
+	}
+
+	return har
+}
+
+func harEntry(hit *Hit) HAREntry {
+	var (
+		r = hit.R
+		d = milliseconds(*hit.D)
+
+		e = HAREntry{
+			StartedDateTime: *hit.Ts,
+			Time:            d,
+			Request: HARRequest{
+				Method:      r.Method,
+				URL:         requestURL(r.Request),
+				HTTPVersion: r.Proto,
+				Cookies:     []HARNameValue{},
+				Headers:     harNameValues(MaskHeader(r.Header)),
+				QueryString: harNameValues(maskedQuery(r.URL)),
+				HeadersSize: -1,
+				BodySize:    r.ContentLength,
+			},
+			Response: HARResponse{
+				Status:      hit.Status,
+				StatusText:  http.StatusText(hit.Status),
+				HTTPVersion: r.Proto,
+				Cookies:     []HARNameValue{},
+				Headers:     []HARNameValue{},
+				HeadersSize: -1,
+				BodySize:    -1,
+			},
+			Timings: HARTimings{Wait: d},
+			Route:   hit.Route,
+		}
+	)
+
+	if e.Request.HTTPVersion == "" {
+		e.Request.HTTPVersion = "HTTP/1.1"
+		e.Response.HTTPVersion = "HTTP/1.1"
+	}
+
+	if r.Body != nil {
+		if body, _ := ioutil.ReadAll(r.Body); len(body) > 0 {
+			e.Request.PostData = &HARPostData{
+				MimeType: r.Header.Get("Content-Type"),
+				Text:     string(body),
+			}
+		}
+	}
+
+	for _, f := range hit.Filters {
+		e.Filters = append(e.Filters, HARFilter{
+			Name:  f.Name,
+			Kind:  f.Kind,
+			Start: f.Ts,
+			Time:  milliseconds(f.D),
+			Error: f.Error,
+		})
+	}
+
+	return e
+}
+
+// harNameValues converts headers or query params
+// to the list of name-value pairs, sorted by name
+func harNameValues(vv map[string][]string) []HARNameValue {
+	out := make([]HARNameValue, 0, len(vv))
+
+	for k, v := range vv {
+		for _, value := range v {
+			out = append(out, HARNameValue{Name: k, Value: value})
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Name < out[j].Name
+	})
+
+	return out
+}
+
+// maskedQuery returns the parsed query with the sensitive params masked
+func maskedQuery(u *url.URL) url.Values {
+	q, _ := url.ParseQuery(MaskQuery(u.RawQuery))
+	return q
+}
+
+// requestTarget returns the path with the query
+// where the sensitive params are masked
+func requestTarget(u *url.URL) string {
+	m := *u
+	m.RawQuery = MaskQuery(m.RawQuery)
+	return m.RequestURI()
+}
+
+// requestURL returns the absolute URL of the request
+// or just the path with the query when host is not known
+func requestURL(r *http.Request) string {
+	u := *r.URL
+	u.RawQuery = MaskQuery(u.RawQuery)
+
+	if u.Host == "" {
+		u.Host = r.Host
+	}
+
+	if u.Host == "" {
+		return u.RequestURI()
+	}
+
+	if u.Scheme == "" {
+		u.Scheme = "http"
+
+		if r.TLS != nil {
+			u.Scheme = "https"
+		}
+
+		if p := r.Header.Get("X-Forwarded-Proto"); p != "" {
+			u.Scheme = p
+		}
+	}
+
+	return u.String()
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
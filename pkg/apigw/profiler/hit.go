@@ -3,9 +3,11 @@ package profiler
 import (
 	"encoding/base64"
 	"fmt"
+	"sort"
 	"time"
 
 	h "github.com/cortezaproject/corteza-server/pkg/http"
+	st "github.com/cortezaproject/corteza-server/system/types"
 )
 
 type (
@@ -14,11 +16,17 @@ type (
 		Status int
 		Route  uint64
 
+		// cache hit or miss when route is cached
+		Cache string
+
 		R *h.Request
 
 		Ts *time.Time
 		Tf *time.Time
 		D  *time.Duration
+
+		// time spent in each of the (sync) filters of the route
+		Filters []*st.ApigwProfilerFilterTiming
 	}
 
 	Hits map[string][]*Hit
@@ -28,6 +36,23 @@ func (h *Hit) generateID() {
 	h.ID = base64.URLEncoding.EncodeToString([]byte(fmt.Sprintf("%s_%d", h.R.URL.Path, h.Ts.UnixNano())))
 }
 
+// AddFilter records the time spent in the filter
+func (h *Hit) AddFilter(name, kind string, ts time.Time, d time.Duration, err error) {
+	f := &st.ApigwProfilerFilterTiming{
+		Name: name,
+		Kind: kind,
+		Ts:   ts,
+		D:    d,
+		Dr:   float64(d.Microseconds()) / 1000,
+	}
+
+	if err != nil {
+		f.Error = err.Error()
+	}
+
+	h.Filters = append(h.Filters, f)
+}
+
 func (s Hits) Filter(fn func(k string, v *Hit) bool) Hits {
 	ss := Hits{}
 
@@ -44,6 +69,19 @@ func (s Hits) Filter(fn func(k string, v *Hit) bool) Hits {
 	return ss
 }
 
+// List returns all hits, ordered by start time
+func (s Hits) List() (out []*Hit) {
+	for _, v := range s {
+		out = append(out, v...)
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Ts.Before(*out[j].Ts)
+	})
+
+	return
+}
+
 func (h Hits) Len() int {
 	return h.Len()
 }
@@ -0,0 +1,178 @@
+package profiler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	otelServiceName = "corteza-server"
+	otelScopeName   = "github.com/cortezaproject/corteza-server/pkg/apigw"
+
+	// span kinds and status codes as defined by OTLP
+	otelSpanKindInternal = 1
+	otelSpanKindServer   = 2
+
+	otelStatusOk    = 1
+	otelStatusError = 2
+)
+
+type (
+	// Traces holds the profiled requests as OpenTelemetry spans,
+	// encoded as the OTLP/JSON export request
+	//
+	// Each hit is a trace with the route span and
+	// the child span for each of the filters
+	Traces struct {
+		ResourceSpans []OtelResourceSpans `json:"resourceSpans"`
+	}
+
+	OtelResourceSpans struct {
+		Resource   OtelResource     `json:"resource"`
+		ScopeSpans []OtelScopeSpans `json:"scopeSpans"`
+	}
+
+	OtelResource struct {
+		Attributes []OtelAttribute `json:"attributes"`
+	}
+
+	OtelScopeSpans struct {
+		Scope OtelScope  `json:"scope"`
+		Spans []OtelSpan `json:"spans"`
+	}
+
+	OtelScope struct {
+		Name string `json:"name"`
+	}
+
+	OtelSpan struct {
+		TraceID           string          `json:"traceId"`
+		SpanID            string          `json:"spanId"`
+		ParentSpanID      string          `json:"parentSpanId,omitempty"`
+		Name              string          `json:"name"`
+		Kind              int             `json:"kind"`
+		StartTimeUnixNano string          `json:"startTimeUnixNano"`
+		EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+		Attributes        []OtelAttribute `json:"attributes"`
+		Status            OtelStatus      `json:"status"`
+	}
+
+	OtelAttribute struct {
+		Key   string    `json:"key"`
+		Value OtelValue `json:"value"`
+	}
+
+	// OtelValue holds one of the values;
+	// 64bit integers are encoded as strings
+	OtelValue struct {
+		StringValue *string `json:"stringValue,omitempty"`
+		IntValue    *string `json:"intValue,omitempty"`
+	}
+
+	OtelStatus struct {
+		Code    int    `json:"code"`
+		Message string `json:"message,omitempty"`
+	}
+)
+
+// ExportSpans converts hits to the OpenTelemetry spans
+//
+// Trace and span IDs are derived from the hit ID,
+// so repeated exports of the same hits match
+func ExportSpans(hh []*Hit) *Traces {
+	ss := OtelScopeSpans{
+		Scope: OtelScope{Name: otelScopeName},
+		Spans: make([]OtelSpan, 0, len(hh)),
+	}
+
+	for _, hit := range hh {
+		ss.Spans = append(ss.Spans, otelSpans(hit)...)
+	}
+
+	return &Traces{
+		ResourceSpans: []OtelResourceSpans{{
+			Resource: OtelResource{
+				Attributes: []OtelAttribute{otelString("service.name", otelServiceName)},
+			},
+			ScopeSpans: []OtelScopeSpans{ss},
+		}},
+	}
+}
+
+func otelSpans(hit *Hit) (out []OtelSpan) {
+	var (
+		sum     = sha256.Sum256([]byte(hit.ID))
+		traceID = hex.EncodeToString(sum[:16])
+		spanID  = hex.EncodeToString(sum[16:24])
+
+		root = OtelSpan{
+			TraceID:           traceID,
+			SpanID:            spanID,
+			Name:              fmt.Sprintf("%s %s", hit.R.Method, hit.R.URL.Path),
+			Kind:              otelSpanKindServer,
+			StartTimeUnixNano: otelTime(*hit.Ts),
+			EndTimeUnixNano:   otelTime(*hit.Tf),
+			Attributes: []OtelAttribute{
+				otelString("http.method", hit.R.Method),
+				otelString("http.target", requestTarget(hit.R.URL)),
+				otelString("http.url", requestURL(hit.R.Request)),
+				otelInt("http.status_code", int64(hit.Status)),
+				otelInt("http.request_content_length", hit.R.ContentLength),
+				otelString("apigw.route", strconv.FormatUint(hit.Route, 10)),
+				otelString("apigw.hit", hit.ID),
+			},
+			Status: OtelStatus{Code: otelStatusOk},
+		}
+	)
+
+	if hit.Cache != "" {
+		root.Attributes = append(root.Attributes, otelString("apigw.cache", hit.Cache))
+	}
+
+	if hit.Status >= http.StatusInternalServerError {
+		root.Status = OtelStatus{Code: otelStatusError, Message: http.StatusText(hit.Status)}
+	}
+
+	out = append(out, root)
+
+	for i, f := range hit.Filters {
+		sum = sha256.Sum256([]byte(fmt.Sprintf("%s/%d", hit.ID, i)))
+
+		s := OtelSpan{
+			TraceID:           traceID,
+			SpanID:            hex.EncodeToString(sum[:8]),
+			ParentSpanID:      spanID,
+			Name:              f.Name,
+			Kind:              otelSpanKindInternal,
+			StartTimeUnixNano: otelTime(f.Ts),
+			EndTimeUnixNano:   otelTime(f.Ts.Add(f.D)),
+			Attributes:        []OtelAttribute{otelString("apigw.filter.kind", f.Kind)},
+			Status:            OtelStatus{Code: otelStatusOk},
+		}
+
+		if f.Error != "" {
+			s.Status = OtelStatus{Code: otelStatusError, Message: f.Error}
+		}
+
+		out = append(out, s)
+	}
+
+	return
+}
+
+func otelTime(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func otelString(k, v string) OtelAttribute {
+	return OtelAttribute{Key: k, Value: OtelValue{StringValue: &v}}
+}
+
+func otelInt(k string, v int64) OtelAttribute {
+	s := strconv.FormatInt(v, 10)
+	return OtelAttribute{Key: k, Value: OtelValue{IntValue: &s}}
+}
@@ -1,20 +1,98 @@
 package profiler
 
 import (
+	"context"
+	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	h "github.com/cortezaproject/corteza-server/pkg/http"
+	st "github.com/cortezaproject/corteza-server/system/types"
+	"go.uber.org/zap"
+)
+
+const (
+	// max number of hits waiting to be stored,
+	// hits over that are not stored
+	storeQueueSize = 1000
+
+	// number of records read from store at once
+	storePageSize = 1000
+
+	// max number of records read from store
+	// when the number of the newest hits is not limited
+	storeMaxRecords = 10000
+
+	// max number of paths aggregated in store
+	storeMaxAggregated = 100
+
+	// how often are expired records removed from store
+	storeSweepInterval = time.Minute
 )
 
 type (
+	// Storer keeps profiled requests so they are kept
+	// after restart and shared between server instances
+	Storer interface {
+		CreateApigwProfilerRecord(ctx context.Context, rr ...*st.ApigwProfilerRecord) error
+		SearchApigwProfilerRecords(ctx context.Context, f st.ApigwProfilerRecordFilter) (st.ApigwProfilerRecordSet, st.ApigwProfilerRecordFilter, error)
+		DeleteExpiredApigwProfilerRecords(ctx context.Context, before time.Time) error
+		AggregateApigwProfilerRecords(ctx context.Context, f st.ApigwProfilerRecordFilter) (st.ApigwProfilerRecordAggregateSet, error)
+	}
+
 	Profiler struct {
+		mux sync.RWMutex
+
 		l Hits
+
+		// all hits in the order they were pushed,
+		// used to remove the oldest hits first
+		q []*Hit
+
+		max       int
+		retention time.Duration
+
+		s   Storer
+		log *zap.Logger
+		rr  chan *st.ApigwProfilerRecord
 	}
 )
 
+// New creates a profiler without limits, see Limit
 func New() *Profiler {
-	return &Profiler{make(Hits)}
+	return &Profiler{l: make(Hits)}
+}
+
+// Limit sets the max number of hits kept in memory
+// and the time the hits are kept for (in memory and in store)
+//
+// Zero values remove the limit
+func (p *Profiler) Limit(max int, retention time.Duration) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	p.max, p.retention = max, retention
+	p.evict(time.Now())
+}
+
+// Store enables keeping the hits in store
+//
+// Hits are written in the background, expired records
+// are removed periodically
+func (p *Profiler) Store(s Storer, log *zap.Logger) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	if p.s != nil {
+		return
+	}
+
+	p.s = s
+	p.log = log
+	p.rr = make(chan *st.ApigwProfilerRecord, storeQueueSize)
+
+	go p.write(context.Background())
 }
 
 func (p *Profiler) Hit(r *h.Request) (h *Hit) {
@@ -22,7 +100,7 @@ func (p *Profiler) Hit(r *h.Request) (h *Hit) {
 		n = time.Now()
 	)
 
-	h = &Hit{"", http.StatusOK, 0, r, &n, nil, nil}
+	h = &Hit{ID: "", Status: http.StatusOK, R: r, Ts: &n}
 	h.generateID()
 
 	return
@@ -40,29 +118,228 @@ func (p *Profiler) Push(h *Hit) (id string) {
 	h.generateID()
 
 	id = p.id(h.R)
+
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
 	p.l[id] = append(p.l[id], h)
+	p.q = append(p.q, h)
+	p.evict(time.Now())
+
+	if p.s != nil {
+		select {
+		case p.rr <- record(h):
+		default:
+			p.log.Warn("profiler store queue is full, hit not stored", zap.String("hit", h.ID))
+		}
+	}
 
 	return
 }
 
-func (p *Profiler) Hits(s Sort) Hits {
-	ll := p.l.Filter(func(k string, v *Hit) bool {
-		var b bool = true
+// Hits returns the hits, filtered by path or hit ID
+//
+// When store is used, hits are read from it, paging through
+// the records in the retention window or just the newest ones up to the limit;
+// without the limit, an error is returned when there are too many of them.
+// hit with the given ID is looked up in memory first since
+// request bodies are not stored
+func (p *Profiler) Hits(ctx context.Context, s Sort) (Hits, error) {
+	p.mux.RLock()
+	var (
+		ll = p.l.Filter(func(k string, v *Hit) bool {
+			var b bool = true
+
+			if s.Path != "" && v.R.URL.Path != s.Path {
+				b = false
+			}
+
+			if s.Hit != "" && v.ID != s.Hit {
+				b = false
+			}
 
-		if s.Path != "" && v.R.URL.Path != s.Path {
-			b = false
+			return b
+		})
+
+		store     = p.s
+		retention = p.retention
+	)
+	p.mux.RUnlock()
+
+	if store == nil || (s.Hit != "" && len(ll) > 0) {
+		return ll, nil
+	}
+
+	f := st.ApigwProfilerRecordFilter{
+		Hit:  s.Hit,
+		Path: s.Path,
+	}
+
+	if retention > 0 {
+		from := time.Now().Add(-retention)
+		f.FromTimestamp = &from
+	}
+
+	rr, err := storedRecords(ctx, store, f, s.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	ll = Hits{}
+
+	// records are sorted from the newest one
+	for i := len(rr) - 1; i >= 0; i-- {
+		hit, err := fromRecord(ctx, rr[i])
+		if err != nil {
+			return nil, err
 		}
 
-		if s.Hit != "" && v.ID != s.Hit {
-			b = false
+		ll[rr[i].Path] = append(ll[rr[i].Path], hit)
+	}
+
+	return ll, nil
+}
+
+// storedRecords reads the records from the newest one, page by page,
+// until all matching records or the given number of them are read
+//
+// Without the limit, reading stops with an error after storeMaxRecords
+func storedRecords(ctx context.Context, s Storer, f st.ApigwProfilerRecordFilter, limit uint) (out st.ApigwProfilerRecordSet, err error) {
+	var rr st.ApigwProfilerRecordSet
+
+	for {
+		f.Limit = storePageSize
+		if limit > 0 && limit-uint(len(out)) < f.Limit {
+			f.Limit = limit - uint(len(out))
 		}
 
-		return b
-	})
+		if rr, _, err = s.SearchApigwProfilerRecords(ctx, f); err != nil {
+			return nil, err
+		}
+
+		out = append(out, rr...)
+
+		if uint(len(rr)) < f.Limit || (limit > 0 && uint(len(out)) >= limit) {
+			return
+		}
+
+		if limit == 0 && len(out) >= storeMaxRecords {
+			return nil, fmt.Errorf("too many profiled requests (over %d), set the limit", storeMaxRecords)
+		}
 
-	return ll
+		f.BeforeID = rr[len(rr)-1].ID
+	}
+}
+
+// Aggregated returns the stats of the hits per path,
+// of all paths or just the given one
+//
+// When store is used, hits are aggregated in it; an error
+// is returned when there are too many paths to aggregate
+func (p *Profiler) Aggregated(ctx context.Context, path string) (Aggregations, error) {
+	p.mux.RLock()
+	var (
+		store     = p.s
+		retention = p.retention
+	)
+	p.mux.RUnlock()
+
+	if store == nil {
+		list, err := p.Hits(ctx, Sort{Path: path})
+		if err != nil {
+			return nil, err
+		}
+
+		return aggregate(list), nil
+	}
+
+	f := st.ApigwProfilerRecordFilter{
+		Path: path,
+
+		// one over the max to know when there are too many
+		Limit: storeMaxAggregated + 1,
+	}
+
+	if retention > 0 {
+		from := time.Now().Add(-retention)
+		f.FromTimestamp = &from
+	}
+
+	rr, err := store.AggregateApigwProfilerRecords(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rr) > storeMaxAggregated {
+		return nil, fmt.Errorf("too many profiled paths (over %d), filter by path", storeMaxAggregated)
+	}
+
+	out := make(Aggregations, 0, len(rr))
+	for _, r := range rr {
+		out = append(out, fromRecordAggregate(r))
+	}
+
+	return out, nil
 }
 
 func (p *Profiler) id(r *h.Request) string {
 	return r.URL.Path
 }
+
+// evict removes the oldest hits over the limit and the expired ones
+//
+// Expects the lock to be held
+func (p *Profiler) evict(now time.Time) {
+	for len(p.q) > 0 {
+		var (
+			oldest = p.q[0]
+			over   = p.max > 0 && len(p.q) > p.max
+			exp    = p.retention > 0 && oldest.Ts.Before(now.Add(-p.retention))
+		)
+
+		if !over && !exp {
+			return
+		}
+
+		k := p.id(oldest.R)
+
+		// hits of the path are also in the push order
+		if p.l[k] = p.l[k][1:]; len(p.l[k]) == 0 {
+			delete(p.l, k)
+		}
+
+		p.q[0] = nil
+		p.q = p.q[1:]
+	}
+}
+
+// write stores the pushed hits and removes the expired records
+func (p *Profiler) write(ctx context.Context) {
+	t := time.NewTicker(storeSweepInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case r := <-p.rr:
+			if err := p.s.CreateApigwProfilerRecord(ctx, r); err != nil {
+				p.log.Error("could not store profiler hit", zap.String("hit", r.Hit), zap.Error(err))
+			}
+
+		case <-t.C:
+			p.mux.RLock()
+			retention := p.retention
+			p.mux.RUnlock()
+
+			if retention == 0 {
+				continue
+			}
+
+			if err := p.s.DeleteExpiredApigwProfilerRecords(ctx, time.Now().Add(-retention)); err != nil {
+				p.log.Error("could not remove expired profiler records", zap.Error(err))
+			}
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package profiler
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -8,7 +10,9 @@ import (
 	"time"
 
 	h "github.com/cortezaproject/corteza-server/pkg/http"
+	st "github.com/cortezaproject/corteza-server/system/types"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 )
 
 const (
@@ -67,12 +71,13 @@ func Test_ApigwProfiler_filterPath(t *testing.T) {
 	pp.Push(&Hit{R: hr2, Ts: &now})
 	pp.Push(&Hit{R: hr3, Ts: &later})
 
-	list := pp.Hits(Sort{
+	list, err := pp.Hits(context.Background(), Sort{
 		Path: hr3.RequestURI,
 	})
 
 	_, found := list[hr3.RequestURI]
 
+	req.NoError(err)
 	req.True(found)
 	req.Len(list[hr3.RequestURI], 1)
 }
@@ -102,12 +107,309 @@ func Test_ApigwProfiler_filterHit(t *testing.T) {
 
 	id := pp.Push(h)
 
-	list := pp.Hits(Sort{
+	list, err := pp.Hits(context.Background(), Sort{
 		Hit: h.ID,
 	})
 
 	_, found := list[id]
 
+	req.NoError(err)
 	req.True(found)
 	req.Len(list[id], 1)
 }
+
+func Test_ApigwProfiler_limit(t *testing.T) {
+	var (
+		pp  = New()
+		req = require.New(t)
+
+		now  = time.Now()
+		then = now.Add(-2 * day)
+
+		hr, _  = h.NewRequest(httptest.NewRequest("POST", "/foo", strings.NewReader(`foo`)))
+		hr2, _ = h.NewRequest(httptest.NewRequest("GET", "/bar", strings.NewReader(`foo`)))
+	)
+
+	pp.Limit(3, day)
+
+	// expired
+	pp.Push(&Hit{R: hr, Ts: &then})
+	req.Empty(pp.l)
+
+	pp.Push(&Hit{R: hr, Ts: &now})
+	pp.Push(&Hit{R: hr2, Ts: &now})
+	pp.Push(&Hit{R: hr, Ts: &now})
+	pp.Push(&Hit{R: hr2, Ts: &now})
+
+	// oldest one removed
+	req.Len(pp.q, 3)
+	req.Len(pp.l["/foo"], 1)
+	req.Len(pp.l["/bar"], 2)
+
+	pp.Limit(1, 0)
+	req.Len(pp.q, 1)
+	req.Len(pp.l["/bar"], 1)
+	req.NotContains(pp.l, "/foo")
+}
+
+func Test_ApigwProfiler_store(t *testing.T) {
+	var (
+		pp  = New()
+		req = require.New(t)
+		s   = &mockStorer{created: make(chan *st.ApigwProfilerRecord, 1)}
+
+		hr, _ = h.NewRequest(httptest.NewRequest("POST", "/foo?bar=baz&api_key=foo", strings.NewReader(`foo`)))
+	)
+
+	hr.Header.Set("Authorization", "Bearer foo")
+	hr.Header.Set("Content-Type", "application/json")
+
+	pp.Store(s, zap.NewNop())
+
+	hit := pp.Hit(hr)
+	hit.Route = 42
+	hit.AddFilter("header", "prefilter", *hit.Ts, time.Millisecond, nil)
+	pp.Push(hit)
+
+	r := <-s.created
+	req.Equal(hit.ID, r.Hit)
+	req.Equal(uint64(42), r.Route)
+	req.Equal("/foo", r.Path)
+	req.Equal("bar=baz&api_key=***", r.Query)
+	req.Equal("***", r.Meta.Header.Get("Authorization"))
+	req.Equal("application/json", r.Meta.Header.Get("Content-Type"))
+	req.Len(r.Meta.Filters, 1)
+
+	s.rr = st.ApigwProfilerRecordSet{r}
+
+	// hit from memory, with body
+	list, err := pp.Hits(context.Background(), Sort{Hit: hit.ID})
+	req.NoError(err)
+	req.Len(list["/foo"], 1)
+	req.Same(hit, list["/foo"][0])
+
+	// hits from store
+	list, err = pp.Hits(context.Background(), Sort{Path: "/foo"})
+	req.NoError(err)
+	req.Len(list["/foo"], 1)
+
+	stored := list["/foo"][0]
+	req.Equal(hit.ID, stored.ID)
+	req.Equal(http.MethodPost, stored.R.Method)
+	req.Equal("baz", stored.R.URL.Query().Get("bar"))
+	req.Equal(*hit.D, *stored.D)
+	req.Len(stored.Filters, 1)
+}
+
+func Test_ApigwProfiler_storePaging(t *testing.T) {
+	var (
+		pp  = New()
+		req = require.New(t)
+		s   = &mockStorer{}
+		ctx = context.Background()
+	)
+
+	// records are kept from the newest one
+	for i := storePageSize*2 + 10; i > 0; i-- {
+		s.rr = append(s.rr, &st.ApigwProfilerRecord{ID: uint64(i), Hit: "hit", Path: "/foo"})
+	}
+
+	pp.Store(s, zap.NewNop())
+
+	list, err := pp.Hits(ctx, Sort{Path: "/foo"})
+	req.NoError(err)
+	req.Len(list["/foo"], storePageSize*2+10)
+	req.Equal(3, s.searches)
+
+	// only the newest ones
+	s.searches = 0
+	list, err = pp.Hits(ctx, Sort{Path: "/foo", Limit: 5})
+	req.NoError(err)
+	req.Len(list["/foo"], 5)
+	req.Equal(1, s.searches)
+
+	// too many to read them all
+	for i := uint64(storePageSize*2 + 10); i < storeMaxRecords+10; i++ {
+		s.rr = append(st.ApigwProfilerRecordSet{{ID: i + 1, Hit: "hit", Path: "/foo"}}, s.rr...)
+	}
+
+	_, err = pp.Hits(ctx, Sort{Path: "/foo"})
+	req.Error(err)
+}
+
+func Test_ApigwProfiler_aggregated(t *testing.T) {
+	var (
+		pp  = New()
+		req = require.New(t)
+		ctx = context.Background()
+
+		hr, _  = h.NewRequest(httptest.NewRequest("POST", "/foo", strings.NewReader(`foo`)))
+		hr2, _ = h.NewRequest(httptest.NewRequest("GET", "/bar", nil))
+	)
+
+	for i := 1; i <= 4; i++ {
+		var (
+			ts = time.Now()
+			d  = time.Duration(i) * time.Millisecond
+			tf = ts.Add(d)
+		)
+
+		pp.Push(&Hit{R: hr, Ts: &ts, Tf: &tf, D: &d})
+	}
+
+	pp.Push(pp.Hit(hr2))
+
+	list, err := pp.Aggregated(ctx, "/foo")
+	req.NoError(err)
+	req.Len(list, 1)
+	req.Equal("/foo", list[0].Path)
+	req.Equal(uint64(4), list[0].Count)
+	req.Equal(time.Millisecond, list[0].Tmin)
+	req.Equal(4*time.Millisecond, list[0].Tmax)
+	req.Equal(10*time.Millisecond, list[0].Tsum)
+	req.Equal(2*time.Millisecond, list[0].Tp50)
+	req.Equal(4*time.Millisecond, list[0].Tp99)
+	req.Equal(int64(3), list[0].Smax)
+
+	list, err = pp.Aggregated(ctx, "")
+	req.NoError(err)
+	req.Len(list, 2)
+}
+
+func Test_ApigwProfiler_storeAggregated(t *testing.T) {
+	var (
+		pp  = New()
+		req = require.New(t)
+		s   = &mockStorer{}
+		ctx = context.Background()
+	)
+
+	pp.Limit(0, day)
+	pp.Store(s, zap.NewNop())
+
+	s.aa = st.ApigwProfilerRecordAggregateSet{
+		{Path: "/foo", Route: 42, Count: 2, DurationMax: int64(time.Millisecond), DurationP50: int64(time.Microsecond)},
+	}
+
+	list, err := pp.Aggregated(ctx, "/foo")
+	req.NoError(err)
+	req.Len(list, 1)
+	req.Equal(uint64(42), list[0].Route)
+	req.Equal(time.Millisecond, list[0].Tmax)
+	req.Equal(time.Microsecond, list[0].Tp50)
+
+	// aggregated in store, within the retention window
+	req.Equal("/foo", s.aggregated.Path)
+	req.NotNil(s.aggregated.FromTimestamp)
+	req.Equal(uint(storeMaxAggregated+1), s.aggregated.Limit)
+
+	// too many paths
+	for i := 0; i < storeMaxAggregated; i++ {
+		s.aa = append(s.aa, &st.ApigwProfilerRecordAggregate{Path: fmt.Sprintf("/foo/%d", i)})
+	}
+
+	_, err = pp.Aggregated(ctx, "")
+	req.Error(err)
+}
+
+func Test_ApigwProfiler_percentile(t *testing.T) {
+	var (
+		req = require.New(t)
+		dd  = make([]time.Duration, 100)
+	)
+
+	for i := range dd {
+		dd[i] = time.Duration(i+1) * time.Millisecond
+	}
+
+	req.Equal(50*time.Millisecond, percentile(dd, 50))
+	req.Equal(95*time.Millisecond, percentile(dd, 95))
+	req.Equal(100*time.Millisecond, percentile(dd, 100))
+	req.Equal(time.Millisecond, percentile(dd, 0))
+
+	req.Equal(7*time.Millisecond, percentile([]time.Duration{7 * time.Millisecond}, 99))
+	req.Zero(percentile(nil, 50))
+}
+
+func Test_ApigwProfiler_maskQuery(t *testing.T) {
+	var (
+		req = require.New(t)
+	)
+
+	req.Equal("", MaskQuery(""))
+	req.Equal("foo=bar&baz", MaskQuery("foo=bar&baz"))
+	req.Equal("foo=bar&access_token=***&api%5Fkey=***&Password=***", MaskQuery("foo=bar&access_token=t&api%5Fkey=k&Password"))
+}
+
+func Test_ApigwProfiler_maskHeader(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		hh = MaskHeader(http.Header{
+			"Accept":              []string{"application/json"},
+			"Cookie":              []string{"foo=bar"},
+			"X-Api-Key":           []string{"foo"},
+			"X-Hub-Signature-256": []string{"sha256=foo"},
+		})
+	)
+
+	req.Equal("application/json", hh.Get("Accept"))
+	req.Equal("***", hh.Get("Cookie"))
+	req.Equal("***", hh.Get("X-Api-Key"))
+	req.Equal("***", hh.Get("X-Hub-Signature-256"))
+}
+
+type (
+	mockStorer struct {
+		created  chan *st.ApigwProfilerRecord
+		rr       st.ApigwProfilerRecordSet
+		searches int
+
+		aa         st.ApigwProfilerRecordAggregateSet
+		aggregated st.ApigwProfilerRecordFilter
+	}
+)
+
+func (s *mockStorer) CreateApigwProfilerRecord(_ context.Context, rr ...*st.ApigwProfilerRecord) error {
+	for _, r := range rr {
+		s.created <- r
+	}
+
+	return nil
+}
+
+func (s *mockStorer) SearchApigwProfilerRecords(_ context.Context, f st.ApigwProfilerRecordFilter) (out st.ApigwProfilerRecordSet, _ st.ApigwProfilerRecordFilter, _ error) {
+	s.searches++
+
+	for _, r := range s.rr {
+		if f.BeforeID > 0 && r.ID >= f.BeforeID {
+			continue
+		}
+
+		if f.Limit > 0 && uint(len(out)) == f.Limit {
+			break
+		}
+
+		out = append(out, r)
+	}
+
+	return out, f, nil
+}
+
+func (s *mockStorer) DeleteExpiredApigwProfilerRecords(context.Context, time.Time) error {
+	return nil
+}
+
+func (s *mockStorer) AggregateApigwProfilerRecords(_ context.Context, f st.ApigwProfilerRecordFilter) (out st.ApigwProfilerRecordAggregateSet, _ error) {
+	s.aggregated = f
+
+	for _, a := range s.aa {
+		if f.Limit > 0 && uint(len(out)) == f.Limit {
+			break
+		}
+
+		out = append(out, a)
+	}
+
+	return out, nil
+}
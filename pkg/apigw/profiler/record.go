@@ -0,0 +1,161 @@
+package profiler
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	h "github.com/cortezaproject/corteza-server/pkg/http"
+	"github.com/cortezaproject/corteza-server/pkg/id"
+	st "github.com/cortezaproject/corteza-server/system/types"
+)
+
+const (
+	maskedHeaderValue = "***"
+)
+
+var (
+	// headers with credentials
+	sensitiveHeaders = map[string]bool{
+		"Authorization":       true,
+		"Proxy-Authorization": true,
+		"Cookie":              true,
+		"Set-Cookie":          true,
+	}
+
+	// parts of the header and query param names that hint credentials,
+	// like X-Api-Key, X-Hub-Signature-256 or access_token
+	sensitiveHeaderParts = []string{"key", "token", "secret", "signature", "password"}
+)
+
+// record converts hit to the store record
+func record(hit *Hit) *st.ApigwProfilerRecord {
+	return &st.ApigwProfilerRecord{
+		ID:            id.Next(),
+		Hit:           hit.ID,
+		Route:         hit.Route,
+		Method:        hit.R.Method,
+		Path:          hit.R.URL.Path,
+		Query:         MaskQuery(hit.R.URL.RawQuery),
+		Status:        hit.Status,
+		Cache:         hit.Cache,
+		ContentLength: hit.R.ContentLength,
+		Duration:      int64(*hit.D),
+		StartedAt:     *hit.Ts,
+		Meta: st.ApigwProfilerRecordMeta{
+			Host:    hit.R.Host,
+			Header:  MaskHeader(hit.R.Header),
+			Filters: hit.Filters,
+		},
+	}
+}
+
+// fromRecord converts the store record back to hit
+//
+// Request has no body since bodies are not stored
+func fromRecord(ctx context.Context, r *st.ApigwProfilerRecord) (hit *Hit, err error) {
+	var (
+		u  = &url.URL{Path: r.Path, RawQuery: r.Query}
+		hr *http.Request
+
+		ts = r.StartedAt
+		d  = time.Duration(r.Duration)
+		tf = ts.Add(d)
+	)
+
+	if hr, err = http.NewRequestWithContext(ctx, r.Method, u.String(), http.NoBody); err != nil {
+		return
+	}
+
+	hr.Host = r.Meta.Host
+	hr.RequestURI = u.RequestURI()
+	hr.ContentLength = r.ContentLength
+
+	if r.Meta.Header != nil {
+		hr.Header = r.Meta.Header
+	}
+
+	hit = &Hit{
+		ID:      r.Hit,
+		Status:  r.Status,
+		Route:   r.Route,
+		Cache:   r.Cache,
+		Ts:      &ts,
+		Tf:      &tf,
+		D:       &d,
+		Filters: r.Meta.Filters,
+	}
+
+	hit.R, err = h.NewRequest(hr)
+	return
+}
+
+// MaskHeader returns a copy of the request headers
+// with the values of the sensitive ones masked
+func MaskHeader(hh http.Header) http.Header {
+	out := make(http.Header, len(hh))
+
+	for k, vv := range hh {
+		if !sensitiveHeader(k) {
+			out[k] = append([]string(nil), vv...)
+			continue
+		}
+
+		out[k] = make([]string, len(vv))
+		for i := range vv {
+			out[k][i] = maskedHeaderValue
+		}
+	}
+
+	return out
+}
+
+// MaskQuery returns the raw query with the values
+// of the sensitive params masked
+//
+// Order of the params and encoding of the rest is kept
+func MaskQuery(raw string) string {
+	if raw == "" {
+		return raw
+	}
+
+	pp := strings.Split(raw, "&")
+	for i, p := range pp {
+		name := p
+		if at := strings.Index(p, "="); at >= 0 {
+			name = p[:at]
+		}
+
+		key := name
+		if n, err := url.QueryUnescape(name); err == nil {
+			key = n
+		}
+
+		if sensitiveName(key) {
+			pp[i] = name + "=" + maskedHeaderValue
+		}
+	}
+
+	return strings.Join(pp, "&")
+}
+
+func sensitiveHeader(name string) bool {
+	if sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+		return true
+	}
+
+	return sensitiveName(name)
+}
+
+func sensitiveName(name string) bool {
+	name = strings.ToLower(name)
+	for _, p := range sensitiveHeaderParts {
+		if strings.Contains(name, p) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -4,5 +4,9 @@ type (
 	Sort struct {
 		Hit  string
 		Path string
+
+		// max number of the newest hits read from store,
+		// all hits in the retention window when zero
+		Limit uint
 	}
 )
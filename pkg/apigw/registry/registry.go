@@ -1,6 +1,7 @@
 package registry
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -9,12 +10,24 @@ import (
 	"github.com/cortezaproject/corteza-server/pkg/apigw/filter/proxy"
 	"github.com/cortezaproject/corteza-server/pkg/apigw/types"
 	"github.com/cortezaproject/corteza-server/pkg/options"
+	"github.com/lestrrat-go/jwx/jwk"
 )
 
 type (
 	Registry struct {
 		opts options.ApigwOpt
 		h    map[string]types.Handler
+
+		// rate limit counters, shared by all routes
+		rlMemory filter.RateLimitCounters
+		rlStore  filter.RateLimitCounters
+
+		// store and JWKS cache used by the auth prefilters
+		authStore filter.AuthStorer
+		jwks      *jwk.AutoRefresh
+
+		// cached responses, shared by all routes
+		cache *filter.ResponseCache
 	}
 
 	secureStorageTodo struct{}
@@ -24,9 +37,33 @@ func NewRegistry(opts options.ApigwOpt) *Registry {
 	return &Registry{
 		h:    map[string]types.Handler{},
 		opts: opts,
+
+		rlMemory: filter.NewMemoryRateLimitCounters(),
+		jwks:     jwk.NewAutoRefresh(context.Background()),
+		cache:    filter.NewResponseCache(),
 	}
 }
 
+// RateLimitStore enables store-backed rate limit counters
+//
+// Needs to be called before Preload
+func (r *Registry) RateLimitStore(s filter.RateLimitStorer) {
+	r.rlStore = filter.NewStoreRateLimitCounters(s)
+}
+
+// AuthStore sets the store the auth prefilters
+// resolve the identity of the caller from
+//
+// Needs to be called before Preload
+func (r *Registry) AuthStore(s filter.AuthStorer) {
+	r.authStore = s
+}
+
+// Cache returns cached responses of the routes
+func (r *Registry) Cache() *filter.ResponseCache {
+	return r.cache
+}
+
 func (r *Registry) Add(n string, h types.Handler) {
 	r.h[n] = h
 }
@@ -67,6 +104,11 @@ func (r *Registry) Preload() {
 	r.Add("queryParam", filter.NewQueryParam(r.opts))
 	r.Add("header", filter.NewHeader(r.opts))
 	r.Add("profiler", filter.NewProfiler(r.opts))
+	r.Add("rateLimit", filter.NewRateLimit(r.opts, r.rlMemory, r.rlStore))
+	r.Add("jwtAuth", filter.NewJwtAuth(r.opts, r.authStore, r.jwks))
+	r.Add("apiKey", filter.NewApiKey(r.opts, r.authStore))
+	r.Add("hmacSignature", filter.NewHmacSignature(r.opts, r.authStore))
+	r.Add("validateRequest", filter.NewValidateRequest(r.opts))
 
 	// processers
 	r.Add("workflow", filter.NewWorkflow(r.opts, NewWorkflow()))
@@ -77,6 +119,7 @@ func (r *Registry) Preload() {
 	r.Add("redirection", filter.NewRedirection(r.opts))
 	r.Add("jsonResponse", filter.NewJsonResponse(r.opts, service.Registry()))
 	r.Add("defaultJsonResponse", filter.NewDefaultJsonResponse(r.opts))
+	r.Add("cache", filter.NewCache(r.opts, r.cache))
 }
 
 func NewWorkflow() (wf filter.WfExecer) {
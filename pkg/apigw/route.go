@@ -11,6 +11,7 @@ import (
 	"github.com/cortezaproject/corteza-server/pkg/auth"
 	h "github.com/cortezaproject/corteza-server/pkg/http"
 	"github.com/cortezaproject/corteza-server/pkg/options"
+	st "github.com/cortezaproject/corteza-server/system/types"
 	"go.uber.org/zap"
 )
 
@@ -29,9 +30,16 @@ type (
 		errHandler types.ErrorHandlerFunc
 	}
 
+	// statusWriter keeps the response status for the profiler
+	statusWriter struct {
+		http.ResponseWriter
+		status int
+	}
+
 	routeMeta struct {
-		debug bool
-		async bool
+		debug  bool
+		async  bool
+		schema *st.ApigwRouteSchema
 	}
 )
 
@@ -62,9 +70,11 @@ func (r route) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	}
 
 	req = req.WithContext(actx.ScopeToContext(ctx, &scope))
+	req = req.WithContext(actx.RouteToContext(req.Context(), r.ID))
 	req = req.WithContext(actx.ProfilerToContext(req.Context(), hit))
 
-	r.handler.ServeHTTP(w, req)
+	sw := &statusWriter{ResponseWriter: w}
+	r.handler.ServeHTTP(sw, req)
 
 	r.log.Debug("finished serving route",
 		zap.Duration("duration", time.Since(start)),
@@ -75,6 +85,7 @@ func (r route) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	}
 
 	if r.opts.ProfilerGlobal {
+		hit.Status = sw.Status()
 		r.pr.Push(hit)
 		return
 	}
@@ -83,7 +94,7 @@ func (r route) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		// updated hit from a possible prefilter
 		// we need to push route ID even if the profiler is disabled
 		hit.Route = r.ID
-		hit.Status = http.StatusOK
+		hit.Status = sw.Status()
 
 		r.pr.Push(hit)
 		r.log.Debug("pushing request to profiler")
@@ -93,3 +104,35 @@ func (r route) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 func (r route) String() string {
 	return fmt.Sprintf("%s %s", r.method, r.endpoint)
 }
+
+func (sw *statusWriter) WriteHeader(status int) {
+	if sw.status == 0 {
+		sw.status = status
+	}
+
+	sw.ResponseWriter.WriteHeader(status)
+}
+
+func (sw *statusWriter) Write(b []byte) (int, error) {
+	if sw.status == 0 {
+		sw.status = http.StatusOK
+	}
+
+	return sw.ResponseWriter.Write(b)
+}
+
+func (sw *statusWriter) Flush() {
+	if f, ok := sw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Status returns the status of the response,
+// OK when nothing was written
+func (sw *statusWriter) Status() int {
+	if sw.status == 0 {
+		return http.StatusOK
+	}
+
+	return sw.status
+}
@@ -41,6 +41,15 @@ type (
 var (
 	// global service
 	apiGw *apigw
+
+	// filters that guard the route; route is not
+	// mounted when any of them can not be registered
+	guardFilters = map[string]bool{
+		"rateLimit":     true,
+		"jwtAuth":       true,
+		"apiKey":        true,
+		"hmacSignature": true,
+	}
 )
 
 func Service() *apigw {
@@ -60,13 +69,31 @@ func New(opts options.ApigwOpt, logger *zap.Logger, storer storer) *apigw {
 	var (
 		pr  = profiler.New()
 		reg = registry.NewRegistry(opts)
+		log = logger.Named("http.apigw")
 	)
 
+	pr.Limit(opts.ProfilerMaxHits, opts.ProfilerRetention)
+
+	if ps, ok := storer.(profiler.Storer); ok && opts.ProfilerEnabled && opts.ProfilerStore {
+		// profiled requests can be kept in store
+		pr.Store(ps, log)
+	}
+
+	if rls, ok := storer.(filter.RateLimitStorer); ok {
+		// rate limits can be kept in store
+		reg.RateLimitStore(rls)
+	}
+
+	if as, ok := storer.(filter.AuthStorer); ok {
+		// auth prefilters resolve callers from store
+		reg.AuthStore(as)
+	}
+
 	reg.Preload()
 
 	return &apigw{
 		opts:   opts,
-		log:    logger.Named("http.apigw"),
+		log:    log,
 		storer: storer,
 		reg:    reg,
 		pr:     pr,
@@ -111,6 +138,10 @@ func (s *apigw) Reload(ctx context.Context) (err error) {
 
 	s.Init(ctx, routes...)
 
+	// cached responses might not match
+	// the reloaded routes anymore
+	s.reg.Cache().Purge(0)
+
 	// Rebuild the mux
 	s.mx = chi.NewMux()
 
@@ -140,10 +171,10 @@ func (s *apigw) Init(ctx context.Context, routes ...*route) {
 		defaultPostFilter types.Handler
 	)
 
-	s.routes = routes
+	s.routes = make([]*route, 0, len(routes))
 
 	s.loadInfo()
-	s.log.Debug("registering routes", zap.Int("count", len(s.routes)))
+	s.log.Debug("registering routes", zap.Int("count", len(routes)))
 
 	defaultPostFilter, err := s.reg.Get("defaultJsonResponse")
 
@@ -151,10 +182,14 @@ func (s *apigw) Init(ctx context.Context, routes ...*route) {
 		s.log.Error("could not register default filter", zap.Error(err))
 	}
 
-	for _, r := range s.routes {
+	for _, r := range routes {
 		var (
 			log  = s.log.With(zap.String("route", r.String()))
 			pipe = pipeline.NewPipeline(log, chain.NewDefault())
+
+			// route is not mounted when requests can
+			// not be validated, authenticated or limited
+			unguarded bool
 		)
 
 		// pipeline needs to know how to handle
@@ -184,6 +219,12 @@ func (s *apigw) Init(ctx context.Context, routes ...*route) {
 
 			ff, err := s.registerFilter(rf, r)
 
+			if err != nil && s.guards(rf) {
+				flog.Error("could not register guarding filter, route not mounted", zap.Error(err))
+				unguarded = true
+				break
+			}
+
 			if err != nil {
 				flog.Error("could not register filter", zap.Error(err))
 				continue
@@ -194,6 +235,10 @@ func (s *apigw) Init(ctx context.Context, routes ...*route) {
 			flog.Debug("registered filter")
 		}
 
+		if unguarded {
+			continue
+		}
+
 		// add default postfilter on async
 		// routes if not present
 		if r.meta.async {
@@ -210,10 +255,50 @@ func (s *apigw) Init(ctx context.Context, routes ...*route) {
 		r.handler = pipe.Handler()
 		r.errHandler = pipe.Error()
 
+		s.routes = append(s.routes, r)
+
 		log.Debug("successfully registered route")
 	}
 }
 
+// guards checks if the route must not be served without the filter
+func (s *apigw) guards(f *st.ApigwFilter) bool {
+	return guardFilters[f.Ref] || s.validates(f)
+}
+
+// ValidateFilter checks params of the filter that guards the route
+//
+// Used when filter is saved; route with invalid guarding filter is not mounted
+func (s *apigw) ValidateFilter(f *st.ApigwFilter) (err error) {
+	if !guardFilters[f.Ref] {
+		return
+	}
+
+	handler, err := s.reg.Get(f.Ref)
+	if err != nil {
+		return
+	}
+
+	enc, err := json.Marshal(f.Params)
+	if err != nil {
+		return fmt.Errorf("could not load params for filter: %s", err)
+	}
+
+	_, err = s.reg.Merge(handler, enc)
+	return
+}
+
+// validates checks if the filter validates the requests against the route schema
+func (s *apigw) validates(f *st.ApigwFilter) bool {
+	h, err := s.reg.Get(f.Ref)
+	if err != nil {
+		return false
+	}
+
+	_, ok := h.(types.RouteSchemaHandler)
+	return ok
+}
+
 func (s *apigw) registerFilter(f *st.ApigwFilter, r *route) (ff *pipeline.Worker, err error) {
 	handler, err := s.reg.Get(f.Ref)
 
@@ -235,6 +320,13 @@ func (s *apigw) registerFilter(f *st.ApigwFilter, r *route) (ff *pipeline.Worker
 		return
 	}
 
+	if sh, ok := handler.(types.RouteSchemaHandler); ok {
+		if err = sh.RouteSchema(r.meta.schema); err != nil {
+			err = fmt.Errorf("could not set route schema to handler: %s", err)
+			return
+		}
+	}
+
 	ff = &pipeline.Worker{
 		Async:   r.meta.async && f.Kind == string(types.Processer),
 		Handler: handler.Handler(),
@@ -243,6 +335,12 @@ func (s *apigw) registerFilter(f *st.ApigwFilter, r *route) (ff *pipeline.Worker
 		Weight:  filter.FilterWeight(int(f.Weight), types.FilterKind(f.Kind)),
 	}
 
+	if mw, ok := handler.(types.MiddlewareHandler); ok {
+		// wraps processers and postfilters
+		ff.Middleware = mw.Middleware()
+		ff.Weight = filter.FilterWeight(0, types.Processer) - 1
+	}
+
 	return
 }
 
@@ -279,8 +377,9 @@ func (s *apigw) loadRoutes(ctx context.Context) (rr []*route, err error) {
 			endpoint: r.Endpoint,
 			method:   r.Method,
 			meta: routeMeta{
-				debug: r.Meta.Debug,
-				async: r.Meta.Async,
+				debug:  r.Meta.Debug,
+				async:  r.Meta.Async,
+				schema: r.Meta.Schema,
 			},
 		}
 
@@ -322,9 +421,22 @@ func (s *apigw) loadInfo() {
 				zap.Bool("APIGW_PROFILER_ENABLED", s.opts.ProfilerEnabled),
 				zap.Bool("APIGW_PROFILER_GLOBAL", s.opts.ProfilerGlobal))
 		}
+
+		if s.opts.ProfilerStore {
+			s.log.Warn("profiler store is enabled, but profiler disabled, no requests will be stored",
+				zap.Bool("APIGW_PROFILER_ENABLED", s.opts.ProfilerEnabled),
+				zap.Bool("APIGW_PROFILER_STORE", s.opts.ProfilerStore))
+		}
 	}
 }
 
+// PurgeCache removes cached responses of the route
+//
+// Cached responses of all routes are removed when route is not set
+func (s *apigw) PurgeCache(routeID uint64) {
+	s.reg.Cache().Purge(routeID)
+}
+
 func (s *apigw) Profiler() *profiler.Profiler {
 	return s.pr
 }
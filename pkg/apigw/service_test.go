@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/cortezaproject/corteza-server/pkg/apigw/filter"
 	"github.com/cortezaproject/corteza-server/pkg/apigw/registry"
 	"github.com/cortezaproject/corteza-server/pkg/apigw/types"
 	"github.com/cortezaproject/corteza-server/pkg/options"
@@ -183,6 +184,148 @@ func Test_serviceInit(t *testing.T) {
 
 }
 
+func Test_serviceInitUnvalidated(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+		reg = registry.NewRegistry(options.ApigwOpt{})
+
+		mockStorer = types.MockStorer{
+			R: func(c context.Context, arf st.ApigwRouteFilter) (s st.ApigwRouteSet, f st.ApigwRouteFilter, err error) {
+				s = st.ApigwRouteSet{
+					// route without schema
+					{ID: 1, Endpoint: "/endpoint", Method: "GET", Enabled: true},
+					{ID: 2, Endpoint: "/endpoint2", Method: "GET", Enabled: true, Meta: st.ApigwRouteMeta{Schema: &st.ApigwRouteSchema{}}},
+				}
+				return
+			},
+			F: func(c context.Context, aff st.ApigwFilterFilter) (s st.ApigwFilterSet, f st.ApigwFilterFilter, err error) {
+				s = st.ApigwFilterSet{
+					{ID: aff.RouteID, Route: aff.RouteID, Ref: "validateRequest", Kind: string(types.PreFilter)},
+				}
+				return
+			},
+		}
+
+		service = &apigw{
+			log:    zap.NewNop(),
+			storer: mockStorer,
+			reg:    reg,
+		}
+	)
+
+	reg.Add("validateRequest", filter.NewValidateRequest(options.ApigwOpt{}))
+
+	rr, err := service.loadRoutes(ctx)
+	req.NoError(err)
+
+	service.Init(ctx, rr...)
+
+	// requests of the route without schema can not be validated
+	req.Len(service.routes, 1)
+	req.Equal(uint64(2), service.routes[0].ID)
+}
+
+func Test_serviceInitUnguarded(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+		reg = registry.NewRegistry(options.ApigwOpt{})
+
+		params = map[uint64]st.ApigwFilterParams{
+			// rate limit without the limit
+			1: {"window": "1m"},
+			2: {"limit": 10, "window": "1m"},
+		}
+
+		mockStorer = types.MockStorer{
+			R: func(c context.Context, arf st.ApigwRouteFilter) (s st.ApigwRouteSet, f st.ApigwRouteFilter, err error) {
+				s = st.ApigwRouteSet{
+					{ID: 1, Endpoint: "/endpoint", Method: "GET", Enabled: true},
+					{ID: 2, Endpoint: "/endpoint2", Method: "GET", Enabled: true},
+				}
+				return
+			},
+			F: func(c context.Context, aff st.ApigwFilterFilter) (s st.ApigwFilterSet, f st.ApigwFilterFilter, err error) {
+				s = st.ApigwFilterSet{
+					{ID: aff.RouteID, Route: aff.RouteID, Ref: "rateLimit", Kind: string(types.PreFilter), Params: params[aff.RouteID]},
+				}
+				return
+			},
+		}
+
+		service = &apigw{
+			log:    zap.NewNop(),
+			storer: mockStorer,
+			reg:    reg,
+		}
+	)
+
+	reg.Add("rateLimit", filter.NewRateLimit(options.ApigwOpt{}, filter.NewMemoryRateLimitCounters(), nil))
+
+	rr, err := service.loadRoutes(ctx)
+	req.NoError(err)
+
+	service.Init(ctx, rr...)
+
+	// requests of the route with invalid rate limit can not be limited
+	req.Len(service.routes, 1)
+	req.Equal(uint64(2), service.routes[0].ID)
+
+	req.Error(service.ValidateFilter(&st.ApigwFilter{Ref: "rateLimit", Params: params[1]}))
+	req.NoError(service.ValidateFilter(&st.ApigwFilter{Ref: "rateLimit", Params: params[2]}))
+}
+
+func Test_serviceInitUnauthenticated(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+		reg = registry.NewRegistry(options.ApigwOpt{})
+
+		params = map[uint64]st.ApigwFilterParams{
+			// signature without the secret
+			1: {"format": "plain"},
+			2: {"format": "plain", "secret": "s3cr3t"},
+		}
+
+		mockStorer = types.MockStorer{
+			R: func(c context.Context, arf st.ApigwRouteFilter) (s st.ApigwRouteSet, f st.ApigwRouteFilter, err error) {
+				s = st.ApigwRouteSet{
+					{ID: 1, Endpoint: "/endpoint", Method: "GET", Enabled: true},
+					{ID: 2, Endpoint: "/endpoint2", Method: "GET", Enabled: true},
+				}
+				return
+			},
+			F: func(c context.Context, aff st.ApigwFilterFilter) (s st.ApigwFilterSet, f st.ApigwFilterFilter, err error) {
+				s = st.ApigwFilterSet{
+					{ID: aff.RouteID, Route: aff.RouteID, Ref: "hmacSignature", Kind: string(types.PreFilter), Params: params[aff.RouteID]},
+				}
+				return
+			},
+		}
+
+		service = &apigw{
+			log:    zap.NewNop(),
+			storer: mockStorer,
+			reg:    reg,
+		}
+	)
+
+	reg.Add("hmacSignature", filter.NewHmacSignature(options.ApigwOpt{}, nil))
+
+	rr, err := service.loadRoutes(ctx)
+	req.NoError(err)
+
+	service.Init(ctx, rr...)
+
+	// requests of the route with invalid signature check can not be authenticated
+	req.Len(service.routes, 1)
+	req.Equal(uint64(2), service.routes[0].ID)
+
+	req.Error(service.ValidateFilter(&st.ApigwFilter{Ref: "hmacSignature", Params: params[1]}))
+	req.NoError(service.ValidateFilter(&st.ApigwFilter{Ref: "hmacSignature", Params: params[2]}))
+}
+
 func (h mockExistingHandler) Merge(params []byte) (types.Handler, error) {
 	return h.merge(params)
 }
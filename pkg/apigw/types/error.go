@@ -1,12 +1,18 @@
 package types
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/cortezaproject/corteza-server/pkg/errors"
 	"go.uber.org/zap"
 )
 
+const (
+	// ErrorMetaValidation holds the list of invalid request fields
+	ErrorMetaValidation = "validation"
+)
+
 type (
 	DefaultErrorHandler struct {
 		log *zap.Logger
@@ -21,7 +27,40 @@ func NewDefaultErrorHandler(log *zap.Logger) DefaultErrorHandler {
 
 func (h DefaultErrorHandler) Handler() ErrorHandlerFunc {
 	return func(rw http.ResponseWriter, r *http.Request, err error) {
-		errors.ProperlyServeHTTP(rw, r, err, true)
+		if IsValidationError(err) {
+			serveValidationError(rw, err.(*errors.Error))
+		} else {
+			errors.ProperlyServeHTTP(rw, r, err, true)
+		}
+
 		h.log.Error(err.Error())
 	}
 }
+
+// serveValidationError serves the validation error as JSON
+// with the details of the invalid request fields
+//
+// Rest of the error details (ie. stack) are not served
+func serveValidationError(rw http.ResponseWriter, e *errors.Error) {
+	var (
+		out = struct {
+			Error struct {
+				Message string                 `json:"message"`
+				Meta    map[string]interface{} `json:"meta"`
+			} `json:"error"`
+		}{}
+	)
+
+	out.Error.Message = e.Error()
+	out.Error.Meta = map[string]interface{}{ErrorMetaValidation: e.Meta()[ErrorMetaValidation]}
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(rw).Encode(out)
+}
+
+// IsValidationError checks if the error holds the list of invalid request fields
+func IsValidationError(err error) bool {
+	e, is := err.(*errors.Error)
+	return is && errors.IsInvalidData(e) && e.Meta()[ErrorMetaValidation] != nil
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/cortezaproject/corteza-server/pkg/options"
+	st "github.com/cortezaproject/corteza-server/system/types"
 )
 
 type (
@@ -26,6 +27,18 @@ type (
 		Enabled() bool
 	}
 
+	// MiddlewareHandler is implemented by the filters
+	// that wrap processers and postfilters of the route
+	MiddlewareHandler interface {
+		Middleware() func(http.Handler) http.Handler
+	}
+
+	// RouteSchemaHandler is implemented by the filters
+	// that need the schema of the route
+	RouteSchemaHandler interface {
+		RouteSchema(*st.ApigwRouteSchema) error
+	}
+
 	HandlerFunc      func(rw http.ResponseWriter, r *http.Request) error
 	ErrorHandlerFunc func(rw http.ResponseWriter, r *http.Request, err error)
 )
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/cortezaproject/corteza-server/pkg/auth"
 	h "github.com/cortezaproject/corteza-server/pkg/http"
 	"github.com/cortezaproject/corteza-server/pkg/options"
 )
@@ -44,6 +45,16 @@ func (s Scp) Opts() *options.ApigwOpt {
 	return nil
 }
 
+// Identity returns the identity of the caller
+// resolved by one of the auth prefilters
+func (s Scp) Identity() auth.Identifiable {
+	if i, ok := s["identity"].(auth.Identifiable); ok {
+		return i
+	}
+
+	return nil
+}
+
 func (s Scp) Set(k string, v interface{}) {
 	s[k] = v
 }
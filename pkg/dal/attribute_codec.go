@@ -49,10 +49,35 @@ type (
 	CodecAlias struct {
 		Ident string
 	}
+
+	// CodecEncrypted defines that values are encrypted before they are stored
+	// and decrypted after they are fetched
+	//
+	// Encrypted values are stored according to the wrapped codec
+	//
+	// Attribute{Ident: "foo", Store: CodecEncrypted{ Codec: CodecRecordValueSetJSON{ Ident: "bar" } }
+	// => "bar"->'foo'->0 holds the encrypted value
+	CodecEncrypted struct {
+		Codec Codec
+	}
 )
 
+// BaseCodec returns the codec that defines where the value is stored
+//
+// Wrapping codecs (like CodecEncrypted) are unwrapped
+func BaseCodec(c Codec) Codec {
+	if enc, ok := c.(*CodecEncrypted); ok {
+		return BaseCodec(enc.Codec)
+	}
+
+	return c
+}
+
 func (*CodecPlain) Type() AttributeCodecType { return "corteza::dal:attribute-codec:plain" }
 func (*CodecRecordValueSetJSON) Type() AttributeCodecType {
 	return "corteza::dal:attribute-codec:record-value-set-json"
 }
 func (*CodecAlias) Type() AttributeCodecType { return "corteza::dal:attribute-codec:alias" }
+func (*CodecEncrypted) Type() AttributeCodecType {
+	return "corteza::dal:attribute-codec:encrypted"
+}
@@ -0,0 +1,677 @@
+package dal
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/dal/capabilities"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+)
+
+type (
+	// httpEndpoint describes where and how a DML operation is sent
+	//
+	// Path can contain placeholders; {model} is replaced with the model ident,
+	// any other {ident} with the value of the matching attribute.
+	httpEndpoint struct {
+		Method string
+		Path   string
+	}
+
+	// httpConnection is a DAL connection backed by an external REST/JSON service
+	//
+	// Records are exchanged as JSON objects where the keys are attribute idents
+	// (or alias idents when CodecAlias is used) and multi-value attributes are
+	// encoded as JSON arrays.
+	httpConnection struct {
+		baseURL *url.URL
+		headers http.Header
+		query   url.Values
+
+		// dot separated path to the relevant part of the response body
+		responsePath []string
+
+		endpoints    map[string]httpEndpoint
+		capabilities capabilities.Set
+
+		client *http.Client
+	}
+
+	httpIterator struct {
+		rows  []map[string]any
+		model *Model
+		limit uint
+
+		pos int
+		err error
+	}
+)
+
+const (
+	httpOpSearch = "search"
+	httpOpLookup = "lookup"
+	httpOpCreate = "create"
+	httpOpUpdate = "update"
+	httpOpDelete = "delete"
+)
+
+var (
+	httpPlaceholder = regexp.MustCompile(`\{([^{}]+)\}`)
+
+	// conventional REST layout used when connection does not define endpoints
+	httpDefaultEndpoints = map[string]httpEndpoint{
+		httpOpSearch: {Method: http.MethodGet, Path: "/{model}"},
+		httpOpLookup: {Method: http.MethodGet, Path: "/{model}/{ID}"},
+		httpOpCreate: {Method: http.MethodPost, Path: "/{model}"},
+		httpOpUpdate: {Method: http.MethodPut, Path: "/{model}/{ID}"},
+		httpOpDelete: {Method: http.MethodDelete, Path: "/{model}/{ID}"},
+	}
+
+	httpOpCapabilities = map[string]capabilities.Capability{
+		httpOpSearch: capabilities.Search,
+		httpOpLookup: capabilities.Lookup,
+		httpOpCreate: capabilities.Create,
+		httpOpUpdate: capabilities.Update,
+		httpOpDelete: capabilities.Delete,
+	}
+)
+
+func init() {
+	RegisterParamsConnector(httpConnector, ConnectionTypeHTTP)
+	RegisterDriver(Driver{
+		Type: "corteza::dal:driver:http",
+		Capabilities: capabilities.Set{
+			capabilities.Create,
+			capabilities.Update,
+			capabilities.Delete,
+			capabilities.Search,
+			capabilities.Lookup,
+			capabilities.RBAC,
+		},
+		Connection: NewHTTPDriverConnectionConfig(),
+	})
+}
+
+// httpConnector opens a new connection to an external REST/JSON service
+//
+// Supported params:
+//   - url: base URL of the service
+//   - headers, query: sent with every request
+//   - endpoints: map of operation (search, lookup, create, update, delete)
+//     to "METHOD /path/{ID}"; when omitted conventional REST endpoints are used
+//   - responsePath: dot separated path to the result inside the response body
+func httpConnector(_ context.Context, params map[string]any, cc ...capabilities.Capability) (_ Connection, err error) {
+	var (
+		c = &httpConnection{
+			client:    http.DefaultClient,
+			endpoints: make(map[string]httpEndpoint),
+		}
+
+		rawURL, _ = params["url"].(string)
+	)
+
+	if rawURL == "" {
+		return nil, fmt.Errorf("cannot open HTTP connection: url not defined")
+	}
+
+	if c.baseURL, err = url.Parse(rawURL); err != nil {
+		return nil, fmt.Errorf("cannot open HTTP connection: %w", err)
+	}
+
+	if c.headers, err = httpParamValues(params["headers"]); err != nil {
+		return nil, fmt.Errorf("cannot open HTTP connection: invalid headers: %w", err)
+	}
+
+	if c.query, err = httpParamValues(params["query"]); err != nil {
+		return nil, fmt.Errorf("cannot open HTTP connection: invalid query: %w", err)
+	}
+
+	if rp, _ := params["responsePath"].(string); rp != "" {
+		c.responsePath = strings.Split(rp, ".")
+	}
+
+	if c.endpoints, err = httpParamEndpoints(params["endpoints"]); err != nil {
+		return nil, fmt.Errorf("cannot open HTTP connection: %w", err)
+	}
+
+	// Access control is done by Corteza and does not depend on the service
+	supported := capabilities.Set{capabilities.RBAC}
+	for op := range httpDefaultEndpoints {
+		if _, has := c.endpoints[op]; has {
+			supported = append(supported, httpOpCapabilities[op])
+		}
+	}
+
+	if len(cc) > 0 {
+		c.capabilities = supported.Intersect(cc)
+	} else {
+		c.capabilities = supported
+	}
+
+	return c, nil
+}
+
+func (c *httpConnection) Capabilities() capabilities.Set {
+	return c.capabilities
+}
+
+func (c *httpConnection) Can(capabilities ...capabilities.Capability) bool {
+	return c.capabilities.IsSuperset(capabilities...)
+}
+
+func (c *httpConnection) Create(ctx context.Context, m *Model, rr ...ValueGetter) (err error) {
+	var body map[string]any
+	for _, r := range rr {
+		if body, err = httpEncodeValues(m, r); err != nil {
+			return
+		}
+
+		if _, err = c.do(ctx, httpOpCreate, m, r, body); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func (c *httpConnection) Update(ctx context.Context, m *Model, r ValueGetter) (err error) {
+	body, err := httpEncodeValues(m, r)
+	if err != nil {
+		return
+	}
+
+	_, err = c.do(ctx, httpOpUpdate, m, r, body)
+	return
+}
+
+func (c *httpConnection) Lookup(ctx context.Context, m *Model, pkv ValueGetter, r ValueSetter) (err error) {
+	rsp, err := c.do(ctx, httpOpLookup, m, pkv, nil)
+	if err != nil {
+		return
+	}
+
+	row, ok := rsp.(map[string]any)
+	if !ok {
+		return fmt.Errorf("unexpected lookup response: expecting object, got %T", rsp)
+	}
+
+	return httpDecodeValues(m, row, r)
+}
+
+func (c *httpConnection) Search(ctx context.Context, m *Model, f filter.Filter) (_ Iterator, err error) {
+	if f.Expression() != "" {
+		return nil, fmt.Errorf("cannot search HTTP connection: query expressions are not supported")
+	}
+
+	// Constraints are passed on to the service as query parameters;
+	// unknown attributes are rejected since ignoring them would widen the results
+	q := url.Values{}
+	for ident, vv := range f.Constraints() {
+		attr := m.Attributes.FindByIdent(ident)
+		if attr == nil {
+			return nil, fmt.Errorf("cannot search HTTP connection: unknown attribute %q", ident)
+		}
+
+		for _, v := range vv {
+			q.Add(httpAttributeKey(attr), fmt.Sprintf("%v", v))
+		}
+	}
+
+	rsp, err := c.doQuery(ctx, httpOpSearch, m, nil, nil, q)
+	if err != nil {
+		return
+	}
+
+	aux, ok := rsp.([]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected search response: expecting array, got %T", rsp)
+	}
+
+	iter := &httpIterator{
+		model: m,
+		limit: f.Limit(),
+		rows:  make([]map[string]any, 0, len(aux)),
+	}
+
+	for _, a := range aux {
+		row, ok := a.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("unexpected search response: expecting array of objects, got %T", a)
+		}
+
+		iter.rows = append(iter.rows, row)
+	}
+
+	return iter, nil
+}
+
+func (c *httpConnection) Delete(ctx context.Context, m *Model, pkv ValueGetter) (err error) {
+	_, err = c.do(ctx, httpOpDelete, m, pkv, nil)
+	return
+}
+
+func (c *httpConnection) Truncate(ctx context.Context, m *Model) error {
+	return fmt.Errorf("cannot truncate %s: not supported by HTTP connection", m.Ident)
+}
+
+// Models returns nothing; the service does not expose its structure
+func (c *httpConnection) Models(ctx context.Context) (ModelSet, error) {
+	return nil, nil
+}
+
+// CreateModel is a no-op; the service is expected to already handle the model
+func (c *httpConnection) CreateModel(ctx context.Context, model *Model, model2 ...*Model) error {
+	return nil
+}
+
+func (c *httpConnection) DeleteModel(ctx context.Context, model *Model, model2 ...*Model) error {
+	return fmt.Errorf("cannot delete model: not supported by HTTP connection")
+}
+
+func (c *httpConnection) UpdateModel(ctx context.Context, old *Model, new *Model) error {
+	return fmt.Errorf("cannot update model: not supported by HTTP connection")
+}
+
+func (c *httpConnection) UpdateModelAttribute(ctx context.Context, sch *Model, old Attribute, new Attribute, trans ...TransformationFunction) error {
+	return fmt.Errorf("cannot update model attribute: not supported by HTTP connection")
+}
+
+func (c *httpConnection) do(ctx context.Context, op string, m *Model, vg ValueGetter, body any) (any, error) {
+	return c.doQuery(ctx, op, m, vg, body, nil)
+}
+
+// doQuery sends the request for the given operation and decodes the response
+//
+// Returns sql.ErrNoRows when service responds with 404 to lookups
+func (c *httpConnection) doQuery(ctx context.Context, op string, m *Model, vg ValueGetter, body any, q url.Values) (out any, err error) {
+	var (
+		ep, has = c.endpoints[op]
+
+		req  *http.Request
+		rsp  *http.Response
+		path string
+		buf  io.Reader
+	)
+
+	if !has {
+		return nil, fmt.Errorf("cannot %s %s: operation not supported by HTTP connection", op, m.Ident)
+	}
+
+	if path, err = httpResolvePath(ep.Path, m, vg); err != nil {
+		return nil, fmt.Errorf("cannot %s %s: %w", op, m.Ident, err)
+	}
+
+	u := *c.baseURL
+	u.Path = strings.TrimRight(u.Path, "/") + "/" + strings.TrimLeft(path, "/")
+
+	query := u.Query()
+	for k, vv := range c.query {
+		query[k] = append(query[k], vv...)
+	}
+	for k, vv := range q {
+		query[k] = append(query[k], vv...)
+	}
+	u.RawQuery = query.Encode()
+
+	if body != nil {
+		enc, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+
+		buf = bytes.NewReader(enc)
+	}
+
+	if req, err = http.NewRequestWithContext(ctx, ep.Method, u.String(), buf); err != nil {
+		return
+	}
+
+	for k, vv := range c.headers {
+		req.Header[http.CanonicalHeaderKey(k)] = vv
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if rsp, err = c.client.Do(req); err != nil {
+		return nil, fmt.Errorf("cannot %s %s: %w", op, m.Ident, err)
+	}
+
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode == http.StatusNotFound && op == httpOpLookup {
+		return nil, sql.ErrNoRows
+	}
+
+	if rsp.StatusCode < 200 || rsp.StatusCode >= 300 {
+		return nil, fmt.Errorf("cannot %s %s: unexpected response status %s", op, m.Ident, rsp.Status)
+	}
+
+	if op != httpOpSearch && op != httpOpLookup {
+		// response bodies of write operations are ignored
+		_, err = io.Copy(io.Discard, rsp.Body)
+		return
+	}
+
+	dec := json.NewDecoder(rsp.Body)
+	dec.UseNumber()
+	if err = dec.Decode(&out); err != nil {
+		return nil, fmt.Errorf("cannot decode %s response: %w", op, err)
+	}
+
+	for _, key := range c.responsePath {
+		aux, ok := out.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("cannot decode %s response: %q not found", op, strings.Join(c.responsePath, "."))
+		}
+
+		out = aux[key]
+	}
+
+	return
+}
+
+func (i *httpIterator) Next(ctx context.Context) bool {
+	if i.err != nil || i.pos >= len(i.rows) {
+		return false
+	}
+
+	if i.limit > 0 && uint(i.pos) >= i.limit {
+		return false
+	}
+
+	i.pos++
+	return true
+}
+
+func (i *httpIterator) Err() error {
+	return i.err
+}
+
+func (i *httpIterator) Scan(r ValueSetter) error {
+	if i.pos == 0 {
+		return fmt.Errorf("cannot scan: call Next first")
+	}
+
+	return httpDecodeValues(i.model, i.rows[i.pos-1], r)
+}
+
+func (i *httpIterator) Close() error {
+	i.rows = nil
+	return nil
+}
+
+// BackCursor returns nil; HTTP connection does not support paging
+func (i *httpIterator) BackCursor(ValueGetter) (*filter.PagingCursor, error) {
+	return nil, nil
+}
+
+// ForwardCursor returns nil; HTTP connection does not support paging
+func (i *httpIterator) ForwardCursor(ValueGetter) (*filter.PagingCursor, error) {
+	return nil, nil
+}
+
+// httpAttributeKey returns the key the attribute value is stored under
+func httpAttributeKey(attr *Attribute) string {
+	if alias, ok := BaseCodec(attr.Store).(*CodecAlias); ok && alias.Ident != "" {
+		return alias.Ident
+	}
+
+	return attr.Ident
+}
+
+// httpResolvePath replaces path placeholders with model ident and values
+func httpResolvePath(path string, m *Model, vg ValueGetter) (out string, err error) {
+	out = httpPlaceholder.ReplaceAllStringFunc(path, func(ph string) string {
+		if err != nil {
+			return ""
+		}
+
+		ident := ph[1 : len(ph)-1]
+		if ident == "model" {
+			return url.PathEscape(m.Ident)
+		}
+
+		if vg == nil {
+			err = fmt.Errorf("cannot resolve %s: no values", ph)
+			return ""
+		}
+
+		// value getters are not consistent with the ident casing
+		// (PKValues{"id": ...} vs. "ID" attribute)
+		for key := range vg.CountValues() {
+			if !strings.EqualFold(key, ident) {
+				continue
+			}
+
+			var v any
+			if v, err = vg.GetValue(key, 0); err != nil {
+				return ""
+			}
+
+			return url.PathEscape(fmt.Sprintf("%v", httpEncodeValue(m.Attributes.FindByIdent(ident), v)))
+		}
+
+		err = fmt.Errorf("cannot resolve %s: value not found", ph)
+		return ""
+	})
+
+	return
+}
+
+// httpEncodeValues converts model attribute values into a JSON friendly map
+func httpEncodeValues(m *Model, vg ValueGetter) (out map[string]any, err error) {
+	var (
+		v      any
+		counts = vg.CountValues()
+	)
+
+	out = make(map[string]any)
+	for _, attr := range m.Attributes {
+		if attr.MultiValue {
+			vv := make([]any, 0, counts[attr.Ident])
+			for p := uint(0); p < counts[attr.Ident]; p++ {
+				if v, err = vg.GetValue(attr.Ident, p); err != nil {
+					return
+				}
+
+				vv = append(vv, httpEncodeValue(attr, v))
+			}
+
+			out[httpAttributeKey(attr)] = vv
+			continue
+		}
+
+		if v, err = vg.GetValue(attr.Ident, 0); err != nil {
+			return
+		}
+
+		out[httpAttributeKey(attr)] = httpEncodeValue(attr, v)
+	}
+
+	return
+}
+
+func httpEncodeValue(attr *Attribute, v any) any {
+	if attr == nil {
+		return v
+	}
+
+	switch attr.Type.(type) {
+	case *TypeID, *TypeRef:
+		// IDs are encoded as strings to avoid precision loss
+		switch id := v.(type) {
+		case uint64:
+			return strconv.FormatUint(id, 10)
+		}
+
+	case *TypeTimestamp:
+		switch t := v.(type) {
+		case *time.Time:
+			if t == nil {
+				return nil
+			}
+
+			return t.Format(time.RFC3339)
+
+		case time.Time:
+			return t.Format(time.RFC3339)
+		}
+	}
+
+	return v
+}
+
+// httpDecodeValues sets decoded JSON values on the value setter
+func httpDecodeValues(m *Model, row map[string]any, r ValueSetter) (err error) {
+	var v any
+	for _, attr := range m.Attributes {
+		raw, has := row[httpAttributeKey(attr)]
+		if !has || raw == nil {
+			continue
+		}
+
+		if vv, is := raw.([]any); is && attr.MultiValue {
+			for p, aux := range vv {
+				if v, err = httpDecodeValue(attr, aux); err != nil {
+					return
+				}
+
+				if err = r.SetValue(attr.Ident, uint(p), v); err != nil {
+					return
+				}
+			}
+
+			continue
+		}
+
+		if v, err = httpDecodeValue(attr, raw); err != nil {
+			return
+		}
+
+		if err = r.SetValue(attr.Ident, 0, v); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func httpDecodeValue(attr *Attribute, v any) (any, error) {
+	switch attr.Type.(type) {
+	case *TypeID, *TypeRef:
+		id, err := strconv.ParseUint(fmt.Sprintf("%v", v), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode %s: %w", attr.Ident, err)
+		}
+
+		return id, nil
+
+	case *TypeJSON:
+		if _, is := v.(string); !is {
+			enc, err := json.Marshal(v)
+			return string(enc), err
+		}
+	}
+
+	switch aux := v.(type) {
+	case json.Number:
+		return aux.String(), nil
+	}
+
+	return v, nil
+}
+
+// httpParamValues converts header and query params
+//
+// Params are either map[string][]string when constructed with NewHTTPConnection
+// or map[string]any when decoded from the stored connection config
+func httpParamValues(raw any) (out map[string][]string, err error) {
+	out = make(map[string][]string)
+
+	switch aux := raw.(type) {
+	case nil:
+		return
+
+	case map[string][]string:
+		for k, vv := range aux {
+			out[k] = append([]string{}, vv...)
+		}
+
+	case map[string]string:
+		for k, v := range aux {
+			out[k] = []string{v}
+		}
+
+	case map[string]any:
+		for k, v := range aux {
+			switch vv := v.(type) {
+			case string:
+				out[k] = []string{vv}
+			case []string:
+				out[k] = append([]string{}, vv...)
+			case []any:
+				for _, s := range vv {
+					out[k] = append(out[k], fmt.Sprintf("%v", s))
+				}
+			default:
+				return nil, fmt.Errorf("unsupported value type %T for %q", v, k)
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported type %T", raw)
+	}
+
+	return
+}
+
+// httpParamEndpoints parses endpoint definitions in "METHOD /path" format
+func httpParamEndpoints(raw any) (out map[string]httpEndpoint, err error) {
+	var defs map[string][]string
+
+	if raw == nil {
+		out = make(map[string]httpEndpoint)
+		for op, ep := range httpDefaultEndpoints {
+			out[op] = ep
+		}
+
+		return
+	}
+
+	if defs, err = httpParamValues(raw); err != nil {
+		return nil, fmt.Errorf("invalid endpoints: %w", err)
+	}
+
+	out = make(map[string]httpEndpoint)
+	for op, dd := range defs {
+		if _, known := httpDefaultEndpoints[op]; !known {
+			return nil, fmt.Errorf("invalid endpoints: unknown operation %q", op)
+		}
+
+		if len(dd) != 1 {
+			return nil, fmt.Errorf("invalid endpoints: expecting exactly one endpoint for %q", op)
+		}
+
+		parts := strings.Fields(dd[0])
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid endpoint %q for %q: expecting \"METHOD /path\"", dd[0], op)
+		}
+
+		out[op] = httpEndpoint{Method: strings.ToUpper(parts[0]), Path: parts[1]}
+	}
+
+	return
+}
@@ -0,0 +1,217 @@
+package dal
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cortezaproject/corteza-server/pkg/dal/capabilities"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	testHttpRow map[string][]any
+
+	testHttpFilter struct {
+		constraints map[string][]any
+		expression  string
+		limit       uint
+	}
+)
+
+func (r testHttpRow) CountValues() map[string]uint {
+	out := make(map[string]uint)
+	for k, vv := range r {
+		out[k] = uint(len(vv))
+	}
+	return out
+}
+
+func (r testHttpRow) GetValue(k string, p uint) (any, error) {
+	if int(p) < len(r[k]) {
+		return r[k][p], nil
+	}
+	return nil, nil
+}
+
+func (r testHttpRow) SetValue(k string, p uint, v any) error {
+	for uint(len(r[k])) <= p {
+		r[k] = append(r[k], nil)
+	}
+	r[k][p] = v
+	return nil
+}
+
+func (f testHttpFilter) Constraints() map[string][]any             { return f.constraints }
+func (f testHttpFilter) StateConstraints() map[string]filter.State { return nil }
+func (f testHttpFilter) Expression() string                        { return f.expression }
+func (f testHttpFilter) OrderBy() filter.SortExprSet               { return nil }
+func (f testHttpFilter) Limit() uint                               { return f.limit }
+func (f testHttpFilter) Cursor() *filter.PagingCursor              { return nil }
+
+func testHttpModel() *Model {
+	return &Model{
+		Ident: "contacts",
+		Attributes: AttributeSet{
+			PrimaryAttribute("ID", &CodecPlain{}),
+			FullAttribute("name", &TypeText{}, &CodecAlias{Ident: "fullName"}),
+			FullAttribute("tags", &TypeText{}, &CodecPlain{}).WithMultiValue(),
+		},
+	}
+}
+
+func TestHttpConnection(t *testing.T) {
+	var (
+		ctx = context.Background()
+		req = require.New(t)
+
+		m = testHttpModel()
+
+		stored = map[string]map[string]any{
+			"42": {"ID": "42", "fullName": "Jane", "tags": []any{"a", "b"}},
+		}
+
+		requests []string
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path+"?"+r.URL.RawQuery)
+		req.Equal("secret", r.Header.Get("X-Api-Key"))
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/contacts":
+			out := make([]any, 0)
+			for _, c := range stored {
+				out = append(out, c)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": out})
+
+		case r.Method == http.MethodGet && r.URL.Path == "/api/contacts/42":
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": stored["42"]})
+
+		case r.Method == http.MethodPost && r.URL.Path == "/api/contacts":
+			aux := make(map[string]any)
+			req.NoError(json.NewDecoder(r.Body).Decode(&aux))
+			stored[aux["ID"].(string)] = aux
+			w.WriteHeader(http.StatusCreated)
+
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/contacts/43":
+			delete(stored, "43")
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	conn, err := connect(ctx, nil, false, ConnectionParams{
+		Type: ConnectionTypeHTTP,
+		Params: map[string]any{
+			"url":          srv.URL + "/api",
+			"headers":      map[string]any{"x-api-key": "secret"},
+			"query":        map[string][]string{"tenant": {"t1"}},
+			"responsePath": "data",
+		},
+	})
+	req.NoError(err)
+	req.True(conn.Can(capabilities.Search, capabilities.Lookup, capabilities.Create, capabilities.Update, capabilities.Delete))
+	req.False(conn.Can(capabilities.Paging))
+
+	t.Run("lookup", func(t *testing.T) {
+		row := testHttpRow{}
+		req.NoError(conn.Lookup(ctx, m, PKValues{"id": uint64(42)}, row))
+		req.Equal(uint64(42), row["ID"][0])
+		req.Equal("Jane", row["name"][0])
+		req.Equal([]any{"a", "b"}, row["tags"])
+	})
+
+	t.Run("lookup missing", func(t *testing.T) {
+		req.ErrorIs(conn.Lookup(ctx, m, PKValues{"id": uint64(1)}, testHttpRow{}), sql.ErrNoRows)
+	})
+
+	t.Run("create and search", func(t *testing.T) {
+		req.NoError(conn.Create(ctx, m, testHttpRow{"ID": {uint64(43)}, "name": {"John"}, "tags": {"c"}}))
+		req.Equal([]any{"c"}, stored["43"]["tags"])
+
+		iter, err := conn.Search(ctx, m, testHttpFilter{constraints: map[string][]any{"name": {"John"}}})
+		req.NoError(err)
+
+		count := 0
+		for iter.Next(ctx) {
+			row := testHttpRow{}
+			req.NoError(iter.Scan(row))
+			count++
+		}
+		req.NoError(iter.Err())
+		req.Equal(2, count)
+		req.Contains(requests, "GET /api/contacts?fullName=John&tenant=t1")
+	})
+
+	t.Run("search with unknown attribute", func(t *testing.T) {
+		n := len(requests)
+		_, err := conn.Search(ctx, m, testHttpFilter{constraints: map[string][]any{"name": {"John"}, "unknown": {1}}})
+		req.EqualError(err, `cannot search HTTP connection: unknown attribute "unknown"`)
+		req.Len(requests, n)
+	})
+
+	t.Run("search with limit", func(t *testing.T) {
+		iter, err := conn.Search(ctx, m, testHttpFilter{limit: 1})
+		req.NoError(err)
+
+		count := 0
+		for iter.Next(ctx) {
+			count++
+		}
+		req.Equal(1, count)
+	})
+
+	t.Run("search with expression", func(t *testing.T) {
+		_, err := conn.Search(ctx, m, testHttpFilter{expression: "name = 'x'"})
+		req.Error(err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		req.NoError(conn.Delete(ctx, m, PKValues{"id": uint64(43)}))
+		req.NotContains(stored, "43")
+	})
+}
+
+func TestHttpConnectionEndpoints(t *testing.T) {
+	var (
+		ctx = context.Background()
+		req = require.New(t)
+		m   = testHttpModel()
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req.Equal(http.MethodPost, r.Method)
+		req.Equal("/legacy/contacts/find", r.URL.Path)
+		_, _ = w.Write([]byte(`[{"ID": "1", "fullName": "Jane"}]`))
+	}))
+	defer srv.Close()
+
+	conn, err := connect(ctx, nil, false, ConnectionParams{
+		Type: ConnectionTypeHTTP,
+		Params: map[string]any{
+			"url":       srv.URL + "/legacy",
+			"endpoints": map[string]any{"search": "post /{model}/find"},
+		},
+	})
+	req.NoError(err)
+	req.True(conn.Can(capabilities.Search))
+	req.False(conn.Can(capabilities.Create))
+	req.Error(conn.Create(ctx, m, testHttpRow{}))
+
+	iter, err := conn.Search(ctx, m, testHttpFilter{})
+	req.NoError(err)
+	req.True(iter.Next(ctx))
+
+	row := testHttpRow{}
+	req.NoError(iter.Scan(row))
+	req.Equal("Jane", row["name"][0])
+	req.False(iter.Next(ctx))
+}
@@ -119,6 +119,9 @@ type (
 
 	ConnectorFn func(ctx context.Context, dsn string, cc ...capabilities.Capability) (Connection, error)
 
+	// ParamsConnectorFn opens connections that are not described with a DSN
+	ParamsConnectorFn func(ctx context.Context, params map[string]any, cc ...capabilities.Capability) (Connection, error)
+
 	DriverConnectionParam struct {
 		Key        string `json:"key"`
 		ValueType  string `json:"valueType"`
@@ -137,9 +140,17 @@ type (
 	}
 )
 
+const (
+	ConnectionTypeDSN  = "corteza::dal:connection:dsn"
+	ConnectionTypeHTTP = "corteza::dal:connection:http"
+
+	ConnectionTypeFederatedNode = "corteza::dal:connection:federated-node"
+)
+
 var (
-	registeredConnectors = make(map[string]ConnectorFn)
-	registeredDrivers    = make(map[string]Driver)
+	registeredConnectors       = make(map[string]ConnectorFn)
+	registeredParamsConnectors = make(map[string]ParamsConnectorFn)
+	registeredDrivers          = make(map[string]Driver)
 )
 
 func (pkv PKValues) CountValues() map[string]uint {
@@ -168,16 +179,30 @@ func RegisterConnector(fn ConnectorFn, tt ...string) {
 	}
 }
 
+// RegisterParamsConnector registers a new connector for the given connection type
+//
+// In case of a duplicate type the latter overwrites the prior
+func RegisterParamsConnector(fn ParamsConnectorFn, tt ...string) {
+	for _, t := range tt {
+		registeredParamsConnectors[t] = fn
+	}
+}
+
 func RegisterDriver(d Driver) {
 	registeredDrivers[d.Type] = d
 }
 
 // connect opens a new StoreConnection for the given CRS
 func connect(ctx context.Context, log *zap.Logger, isDevelopment bool, cp ConnectionParams, capabilities ...capabilities.Capability) (Connection, error) {
-	if cp.Type != "corteza::dal:connection:dsn" {
-		return nil, fmt.Errorf("cannot open connection: only DSN connections supported")
+	if cp.Type != ConnectionTypeDSN {
+		if conn, ok := registeredParamsConnectors[cp.Type]; ok {
+			return conn(ctx, cp.Params, capabilities...)
+		}
+
+		return nil, fmt.Errorf("cannot open connection: unsupported connection type %q", cp.Type)
 	}
-	dsn := cp.Params["dsn"].(string)
+
+	dsn, _ := cp.Params["dsn"].(string)
 
 	if isDevelopment {
 		if strings.Contains(dsn, "{version}") {
@@ -205,31 +230,43 @@ func connect(ctx context.Context, log *zap.Logger, isDevelopment bool, cp Connec
 
 func NewDSNDriverConnectionConfig() DriverConnectionConfig {
 	return DriverConnectionConfig{
-		Type: "corteza::dal:connection:dsn",
+		Type: ConnectionTypeDSN,
 		Params: []DriverConnectionParam{{
 			Key:       "dsn",
 			ValueType: "string",
 		}},
 	}
 }
+
 func NewHTTPDriverConnectionConfig() DriverConnectionConfig {
-	panic("not implemented NewHTTPDriverConnectionConfig")
 	return DriverConnectionConfig{
-		Type:   "corteza::dal:connection:http",
-		Params: []DriverConnectionParam{{}},
+		Type: ConnectionTypeHTTP,
+		Params: []DriverConnectionParam{
+			{Key: "url", ValueType: "string"},
+			{Key: "headers", ValueType: "string", MultiValue: true},
+			{Key: "query", ValueType: "string", MultiValue: true},
+			{Key: "endpoints", ValueType: "string", MultiValue: true},
+			{Key: "responsePath", ValueType: "string"},
+		},
 	}
 }
+
 func NewFederatedNodeDriverConnectionConfig() DriverConnectionConfig {
-	panic("not implemented NewFederatedNodeDriverConnectionConfig")
 	return DriverConnectionConfig{
-		Type:   "corteza::dal:connection:federated-node",
-		Params: []DriverConnectionParam{{}},
+		Type: ConnectionTypeFederatedNode,
+		Params: []DriverConnectionParam{
+			{Key: "nodeID", ValueType: "string"},
+			{Key: "sharedNodeID", ValueType: "string"},
+			{Key: "baseURL", ValueType: "string"},
+			{Key: "pairToken", ValueType: "string"},
+			{Key: "authToken", ValueType: "string"},
+		},
 	}
 }
 
 func NewDSNConnection(dsn string) ConnectionParams {
 	return ConnectionParams{
-		Type: "corteza::dal:connection:dsn",
+		Type: ConnectionTypeDSN,
 		Params: map[string]any{
 			"dsn": dsn,
 		},
@@ -238,7 +275,7 @@ func NewDSNConnection(dsn string) ConnectionParams {
 
 func NewHTTPConnection(url string, headers, query map[string][]string) ConnectionParams {
 	return ConnectionParams{
-		Type: "corteza::dal:connection:http",
+		Type: ConnectionTypeHTTP,
 		Params: map[string]any{
 			"url":     url,
 			"headers": headers,
@@ -247,13 +284,15 @@ func NewHTTPConnection(url string, headers, query map[string][]string) Connectio
 	}
 }
 
-func NewFederatedNodeCOnnection(url string, pairToken, authToken string) ConnectionParams {
+func NewFederatedNodeCOnnection(nodeID, sharedNodeID uint64, url string, pairToken, authToken string) ConnectionParams {
 	return ConnectionParams{
-		Type: "corteza::dal:connection:federation-node",
+		Type: ConnectionTypeFederatedNode,
 		Params: map[string]any{
-			"baseURL":   url,
-			"pairToken": pairToken,
-			"authToken": authToken,
+			"nodeID":       nodeID,
+			"sharedNodeID": sharedNodeID,
+			"baseURL":      url,
+			"pairToken":    pairToken,
+			"authToken":    authToken,
 		},
 	}
 }
@@ -0,0 +1,318 @@
+package dal
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/modern-go/reflect2"
+	"github.com/spf13/cast"
+)
+
+type (
+	// EncryptionKey is a key-encryption key
+	//
+	// Every value is encrypted with its own random data key;
+	// data key is then encrypted (wrapped) with the encryption key
+	// and stored alongside the value.
+	EncryptionKey struct {
+		ID  string
+		Key []byte
+	}
+
+	keyring struct {
+		// primary key is used for encryption
+		primary *EncryptionKey
+
+		// all keys are used for decryption
+		keys map[string]*EncryptionKey
+	}
+
+	encryptingGetter struct {
+		ValueGetter
+		attributes map[string]bool
+		kr         *keyring
+	}
+
+	decryptingSetter struct {
+		ValueSetter
+		attributes map[string]bool
+		kr         *keyring
+	}
+
+	decryptingIterator struct {
+		Iterator
+		attributes map[string]bool
+		kr         *keyring
+	}
+)
+
+const (
+	encryptedValuePrefix = "enc:v1:"
+
+	encryptionKeySize = 32
+)
+
+// ParseEncryptionKeys parses comma separated list of encryption keys
+//
+// Each key is formatted as <id>:<base64 encoded 32 byte key>;
+// first key is used as the primary key.
+func ParseEncryptionKeys(s string) (kk []EncryptionKey, err error) {
+	for _, raw := range strings.Split(s, ",") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+
+		parts := strings.SplitN(raw, ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid encryption key format, expecting <id>:<key>")
+		}
+
+		k := EncryptionKey{ID: parts[0]}
+		if k.Key, err = base64.StdEncoding.DecodeString(parts[1]); err != nil {
+			return nil, fmt.Errorf("invalid encryption key %s: %w", k.ID, err)
+		}
+
+		if len(k.Key) != encryptionKeySize {
+			return nil, fmt.Errorf("invalid encryption key %s: expecting %d bytes long key", k.ID, encryptionKeySize)
+		}
+
+		kk = append(kk, k)
+	}
+
+	return
+}
+
+// SetEncryptionKeys configures keys used for encryption of attribute values
+//
+// First key is used to encrypt values, all keys are used to decrypt them
+// so the keys can be rotated.
+func (svc *service) SetEncryptionKeys(kk ...EncryptionKey) (err error) {
+	if len(kk) == 0 {
+		svc.keys = nil
+		return
+	}
+
+	kr := &keyring{
+		keys: make(map[string]*EncryptionKey, len(kk)),
+	}
+
+	for i := range kk {
+		k := kk[i]
+		if _, has := kr.keys[k.ID]; has {
+			return fmt.Errorf("duplicate encryption key %s", k.ID)
+		}
+
+		kr.keys[k.ID] = &k
+		if kr.primary == nil {
+			kr.primary = &k
+		}
+	}
+
+	svc.keys = kr
+	return
+}
+
+// encryptedAttributes returns idents of the model attributes that are encrypted
+func (svc *service) encryptedAttributes(model *Model) (out map[string]bool, err error) {
+	for _, attr := range model.Attributes {
+		if _, ok := attr.Store.(*CodecEncrypted); !ok {
+			continue
+		}
+
+		if out == nil {
+			out = make(map[string]bool)
+		}
+
+		out[attr.Ident] = true
+	}
+
+	if len(out) > 0 && svc.keys == nil {
+		return nil, fmt.Errorf("model %s has encrypted attributes but encryption keys are not configured", model.Resource)
+	}
+
+	return
+}
+
+func (kr *keyring) encrypt(plain string) (_ string, err error) {
+	var (
+		dk = make([]byte, encryptionKeySize)
+
+		wrapped, sealed []byte
+	)
+
+	if _, err = io.ReadFull(rand.Reader, dk); err != nil {
+		return
+	}
+
+	if wrapped, err = seal(kr.primary.Key, dk); err != nil {
+		return
+	}
+
+	if sealed, err = seal(dk, []byte(plain)); err != nil {
+		return
+	}
+
+	return encryptedValuePrefix +
+		kr.primary.ID + ":" +
+		base64.RawStdEncoding.EncodeToString(wrapped) + ":" +
+		base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// decrypt decrypts the value
+//
+// Values that are not encrypted are returned as they are;
+// this way values stored before encryption was enabled can still be read.
+func (kr *keyring) decrypt(value string) (_ string, err error) {
+	if !strings.HasPrefix(value, encryptedValuePrefix) {
+		return value, nil
+	}
+
+	var (
+		parts = strings.Split(strings.TrimPrefix(value, encryptedValuePrefix), ":")
+
+		k               *EncryptionKey
+		wrapped, sealed []byte
+		dk, plain       []byte
+	)
+
+	if len(parts) != 3 {
+		return "", fmt.Errorf("malformed encrypted value")
+	}
+
+	if k = kr.keys[parts[0]]; k == nil {
+		return "", fmt.Errorf("encryption key %s not configured", parts[0])
+	}
+
+	if wrapped, err = base64.RawStdEncoding.DecodeString(parts[1]); err != nil {
+		return
+	}
+
+	if sealed, err = base64.RawStdEncoding.DecodeString(parts[2]); err != nil {
+		return
+	}
+
+	if dk, err = open(k.Key, wrapped); err != nil {
+		return
+	}
+
+	if plain, err = open(dk, sealed); err != nil {
+		return
+	}
+
+	return string(plain), nil
+}
+
+// seal encrypts data with AES-GCM and prepends the nonce
+func seal(key, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, data, nil), nil
+}
+
+// open decrypts data sealed with seal()
+func open(key, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return nil, fmt.Errorf("malformed encrypted value")
+	}
+
+	return gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func (g *encryptingGetter) GetValue(name string, pos uint) (any, error) {
+	val, err := g.ValueGetter.GetValue(name, pos)
+	if err != nil || !g.attributes[name] || reflect2.IsNil(val) {
+		return val, err
+	}
+
+	plain, err := cast.ToStringE(val)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.kr.encrypt(plain)
+}
+
+func (s *decryptingSetter) SetValue(name string, pos uint, val any) error {
+	if !s.attributes[name] || reflect2.IsNil(val) {
+		return s.ValueSetter.SetValue(name, pos, val)
+	}
+
+	enc, err := cast.ToStringE(val)
+	if err != nil {
+		return err
+	}
+
+	plain, err := s.kr.decrypt(enc)
+	if err != nil {
+		return fmt.Errorf("could not decrypt value of %s: %w", name, err)
+	}
+
+	return s.ValueSetter.SetValue(name, pos, plain)
+}
+
+func (i *decryptingIterator) Scan(dst ValueSetter) error {
+	return i.Iterator.Scan(&decryptingSetter{ValueSetter: dst, attributes: i.attributes, kr: i.kr})
+}
+
+// encryptGetters wraps getters so values of the encrypted attributes are encrypted
+func (svc *service) encryptGetters(model *Model, rr ...ValueGetter) (out []ValueGetter, err error) {
+	attributes, err := svc.encryptedAttributes(model)
+	if err != nil || len(attributes) == 0 {
+		return rr, err
+	}
+
+	out = make([]ValueGetter, len(rr))
+	for i := range rr {
+		out[i] = &encryptingGetter{ValueGetter: rr[i], attributes: attributes, kr: svc.keys}
+	}
+
+	return
+}
+
+// decryptSetter wraps setter so values of the encrypted attributes are decrypted
+func (svc *service) decryptSetter(model *Model, dst ValueSetter) (_ ValueSetter, err error) {
+	attributes, err := svc.encryptedAttributes(model)
+	if err != nil || len(attributes) == 0 {
+		return dst, err
+	}
+
+	return &decryptingSetter{ValueSetter: dst, attributes: attributes, kr: svc.keys}, nil
+}
+
+// decryptIterator wraps iterator so values of the encrypted attributes are decrypted when scanned
+func (svc *service) decryptIterator(model *Model, iter Iterator) (_ Iterator, err error) {
+	attributes, err := svc.encryptedAttributes(model)
+	if err != nil || len(attributes) == 0 {
+		return iter, err
+	}
+
+	return &decryptingIterator{Iterator: iter, attributes: attributes, kr: svc.keys}, nil
+}
@@ -0,0 +1,90 @@
+package dal
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEncryptionKeys(t *testing.T) {
+	var (
+		req = require.New(t)
+		key = base64.StdEncoding.EncodeToString(make([]byte, encryptionKeySize))
+	)
+
+	kk, err := ParseEncryptionKeys("")
+	req.NoError(err)
+	req.Empty(kk)
+
+	kk, err = ParseEncryptionKeys("k2:" + key + ", k1:" + key)
+	req.NoError(err)
+	req.Len(kk, 2)
+	req.Equal("k2", kk[0].ID)
+	req.Len(kk[1].Key, encryptionKeySize)
+
+	_, err = ParseEncryptionKeys(key)
+	req.Error(err)
+
+	_, err = ParseEncryptionKeys("k1:" + base64.StdEncoding.EncodeToString([]byte("short")))
+	req.Error(err)
+}
+
+func TestService_encryption(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		k1 = EncryptionKey{ID: "k1", Key: []byte(strings.Repeat("1", encryptionKeySize))}
+		k2 = EncryptionKey{ID: "k2", Key: []byte(strings.Repeat("2", encryptionKeySize))}
+
+		model = &Model{
+			Resource: "test",
+			Attributes: AttributeSet{
+				{Ident: "name", Store: &CodecPlain{}},
+				{Ident: "ssn", Store: &CodecEncrypted{Codec: &CodecPlain{}}},
+			},
+		}
+
+		svc = &service{}
+	)
+
+	_, err := svc.encryptedAttributes(model)
+	req.Error(err, "keys not configured")
+
+	req.Error(svc.SetEncryptionKeys(k1, k1))
+	req.NoError(svc.SetEncryptionKeys(k1))
+
+	enc, err := svc.keys.encrypt("secret")
+	req.NoError(err)
+	req.True(strings.HasPrefix(enc, encryptedValuePrefix+"k1:"))
+
+	enc2, err := svc.keys.encrypt("secret")
+	req.NoError(err)
+	req.NotEqual(enc, enc2)
+
+	// rotation; old key is still used for decryption
+	req.NoError(svc.SetEncryptionKeys(k2, k1))
+
+	plain, err := svc.keys.decrypt(enc)
+	req.NoError(err)
+	req.Equal("secret", plain)
+
+	enc, err = svc.keys.encrypt("secret")
+	req.NoError(err)
+	req.True(strings.HasPrefix(enc, encryptedValuePrefix+"k2:"))
+
+	// unencrypted values are passed through
+	plain, err = svc.keys.decrypt("legacy")
+	req.NoError(err)
+	req.Equal("legacy", plain)
+
+	// removed key
+	req.NoError(svc.SetEncryptionKeys(k2))
+	_, err = svc.keys.decrypt(enc2)
+	req.Error(err)
+
+	attrs, err := svc.encryptedAttributes(model)
+	req.NoError(err)
+	req.Equal(map[string]bool{"ssn": true}, attrs)
+}
@@ -28,6 +28,10 @@ type (
 		ResourceID   uint64
 		ResourceType string
 
+		// SensitivityLevel of the model; must not exceed
+		// the sensitivity level of the connection
+		SensitivityLevel uint64
+
 		Attributes AttributeSet
 	}
 	ModelSet []*Model
@@ -58,6 +62,10 @@ type (
 		// Type describes what the value represents and how it should be
 		// encoded/decoded
 		Type Type
+
+		// SensitivityLevel of the attribute; must not exceed
+		// the sensitivity level of the connection
+		SensitivityLevel uint64
 	}
 
 	AttributeSet []*Attribute
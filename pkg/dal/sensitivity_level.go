@@ -0,0 +1,190 @@
+package dal
+
+import (
+	"fmt"
+)
+
+type (
+	// SensitivityLevel describes how sensitive the data is
+	//
+	// Connections, models and attributes reference sensitivity levels by ID;
+	// ID 0 denotes no sensitivity level and is lower than any defined level.
+	SensitivityLevel struct {
+		ID     uint64
+		Handle string
+		Level  int
+	}
+	SensitivityLevelSet []SensitivityLevel
+
+	sensitivityLevelIndex struct {
+		set map[uint64]SensitivityLevel
+	}
+)
+
+// SensitivityLevelIndex indexes the given sensitivity levels by their ID
+func SensitivityLevelIndex(levels ...SensitivityLevel) *sensitivityLevelIndex {
+	out := &sensitivityLevelIndex{
+		set: make(map[uint64]SensitivityLevel, len(levels)),
+	}
+
+	for _, l := range levels {
+		out.set[l.ID] = l
+	}
+
+	return out
+}
+
+// includes checks if the sensitivity level is known
+//
+// No sensitivity level (ID 0) is always included.
+func (sli *sensitivityLevelIndex) includes(l uint64) bool {
+	if l == 0 {
+		return true
+	}
+
+	_, ok := sli.set[l]
+	return ok
+}
+
+// isSubset checks if sensitivity level a is lower or equal to sensitivity level b
+func (sli *sensitivityLevelIndex) isSubset(a, b uint64) bool {
+	if a == 0 {
+		return true
+	}
+
+	if b == 0 || !sli.includes(a) || !sli.includes(b) {
+		return false
+	}
+
+	return sli.set[a].Level <= sli.set[b].Level
+}
+
+// clone returns a copy of the index so it can be modified without
+// affecting the original
+func (sli *sensitivityLevelIndex) clone() *sensitivityLevelIndex {
+	out := &sensitivityLevelIndex{
+		set: make(map[uint64]SensitivityLevel, len(sli.set)),
+	}
+
+	for id, l := range sli.set {
+		out.set[id] = l
+	}
+
+	return out
+}
+
+// // // // // // // // // // // // // // // // // // // // // // // // //
+// Sensitivity level management
+
+// ReplaceSensitivityLevel adds new or updates existing sensitivity levels
+//
+// Changes that would leave registered connections or models with
+// invalid sensitivity levels are refused.
+func (svc *service) ReplaceSensitivityLevel(levels ...SensitivityLevel) (err error) {
+	svc.slMux.Lock()
+	defer svc.slMux.Unlock()
+
+	sli := svc.sensitivityLevels.clone()
+	for _, l := range levels {
+		sli.set[l.ID] = l
+	}
+
+	if err = svc.validateSensitivityLevels(sli); err != nil {
+		return
+	}
+
+	svc.sensitivityLevels = sli
+	return
+}
+
+// RemoveSensitivityLevel removes the given sensitivity levels
+//
+// Sensitivity levels that are still used by registered connections
+// or models can not be removed.
+func (svc *service) RemoveSensitivityLevel(levelIDs ...uint64) (err error) {
+	svc.slMux.Lock()
+	defer svc.slMux.Unlock()
+
+	sli := svc.sensitivityLevels.clone()
+	for _, id := range levelIDs {
+		delete(sli.set, id)
+	}
+
+	if err = svc.validateSensitivityLevels(sli); err != nil {
+		return
+	}
+
+	svc.sensitivityLevels = sli
+	return
+}
+
+// WithinSensitivityLevel checks if the sensitivity level does not exceed the threshold
+//
+// Unknown sensitivity levels are never within the threshold.
+func (svc *service) WithinSensitivityLevel(level, threshold uint64) bool {
+	return svc.levels().isSubset(level, threshold)
+}
+
+// levels returns the current sensitivity level index
+func (svc *service) levels() *sensitivityLevelIndex {
+	svc.slMux.RLock()
+	defer svc.slMux.RUnlock()
+
+	return svc.sensitivityLevels
+}
+
+// validateSensitivityLevels checks all registered connections and models
+// against the given sensitivity level index
+func (svc *service) validateSensitivityLevels(sli *sensitivityLevelIndex) (err error) {
+	var cw *connectionWrap
+
+	for connectionID, models := range svc.models {
+		if connectionID == DefaultConnectionID {
+			cw = svc.primary
+		} else {
+			cw = svc.connections[connectionID]
+		}
+
+		if cw == nil {
+			continue
+		}
+
+		for _, model := range models {
+			if err = validateModelSensitivity(sli, cw.Defaults.SensitivityLevel, model); err != nil {
+				return
+			}
+		}
+	}
+
+	for connectionID, cw := range svc.connections {
+		if !sli.includes(cw.Defaults.SensitivityLevel) {
+			return fmt.Errorf("connection %d: sensitivity level %d does not exist", connectionID, cw.Defaults.SensitivityLevel)
+		}
+	}
+
+	return
+}
+
+// validateModelSensitivity checks if the model and its attributes
+// can be stored on the connection with the given sensitivity level
+func validateModelSensitivity(sli *sensitivityLevelIndex, connectionLevel uint64, model *Model) error {
+	if !sli.includes(model.SensitivityLevel) {
+		return fmt.Errorf("model %s: sensitivity level %d does not exist", model.Resource, model.SensitivityLevel)
+	}
+
+	if !sli.isSubset(model.SensitivityLevel, connectionLevel) {
+		return fmt.Errorf("model %s: sensitivity level %d exceeds connection sensitivity level %d", model.Resource, model.SensitivityLevel, connectionLevel)
+	}
+
+	for _, attr := range model.Attributes {
+		if !sli.includes(attr.SensitivityLevel) {
+			return fmt.Errorf("model %s attribute %s: sensitivity level %d does not exist", model.Resource, attr.Ident, attr.SensitivityLevel)
+		}
+
+		if !sli.isSubset(attr.SensitivityLevel, connectionLevel) {
+			return fmt.Errorf("model %s attribute %s: sensitivity level %d exceeds connection sensitivity level %d", model.Resource, attr.Ident, attr.SensitivityLevel, connectionLevel)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package dal
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSensitivityLevelIndex_isSubset(t *testing.T) {
+	var (
+		sli = SensitivityLevelIndex(
+			SensitivityLevel{ID: 1, Handle: "public", Level: 1},
+			SensitivityLevel{ID: 2, Handle: "internal", Level: 2},
+			SensitivityLevel{ID: 3, Handle: "secret", Level: 3},
+		)
+	)
+
+	tcc := []struct {
+		name string
+		a, b uint64
+		ok   bool
+	}{
+		{"no level within no level", 0, 0, true},
+		{"no level within any level", 0, 3, true},
+		{"level not within no level", 1, 0, false},
+		{"lower within higher", 1, 2, true},
+		{"same within same", 2, 2, true},
+		{"higher not within lower", 3, 2, false},
+		{"unknown not within any", 42, 3, false},
+		{"nothing within unknown", 1, 42, false},
+	}
+
+	for _, tc := range tcc {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.ok, sli.isSubset(tc.a, tc.b))
+		})
+	}
+}
+
+func TestService_sensitivityLevels(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		model = &Model{
+			ConnectionID:     10,
+			Resource:         "test",
+			SensitivityLevel: 1,
+			Attributes: AttributeSet{
+				{Ident: "name"},
+				{Ident: "ssn", SensitivityLevel: 2},
+			},
+		}
+
+		svc = &service{
+			connections: map[uint64]*connectionWrap{
+				10: {Defaults: ConnectionDefaults{SensitivityLevel: 2}},
+			},
+			models:            map[uint64]ModelSet{10: {model}},
+			sensitivityLevels: SensitivityLevelIndex(),
+		}
+	)
+
+	req.NoError(svc.ReplaceSensitivityLevel(
+		SensitivityLevel{ID: 1, Level: 1},
+		SensitivityLevel{ID: 2, Level: 2},
+	))
+
+	req.True(svc.WithinSensitivityLevel(1, 2))
+	req.False(svc.WithinSensitivityLevel(2, 1))
+
+	// attribute on the connection
+	req.Error(svc.RemoveSensitivityLevel(2))
+
+	// attribute would exceed the connection
+	req.Error(svc.ReplaceSensitivityLevel(SensitivityLevel{ID: 1, Level: 3}))
+	req.Error(validateModelSensitivity(svc.sensitivityLevels, 1, model))
+
+	// unused level
+	req.NoError(svc.ReplaceSensitivityLevel(SensitivityLevel{ID: 3, Level: 3}))
+	req.NoError(svc.RemoveSensitivityLevel(3))
+	req.False(svc.sensitivityLevels.includes(3))
+
+	// levels are replaced while being read
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			_ = svc.ReplaceSensitivityLevel(SensitivityLevel{ID: 3, Level: 3})
+		}
+	}()
+
+	for i := 0; i < 100; i++ {
+		req.True(svc.WithinSensitivityLevel(1, 2))
+	}
+
+	wg.Wait()
+}
@@ -3,6 +3,7 @@ package dal
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/cortezaproject/corteza-server/pkg/dal/capabilities"
 	"github.com/cortezaproject/corteza-server/pkg/filter"
@@ -20,6 +21,10 @@ type (
 		AttributeIdent string
 
 		PartitionFormat string
+
+		// SensitivityLevel is the highest sensitivity level
+		// of the models the connection can store
+		SensitivityLevel uint64
 	}
 
 	service struct {
@@ -29,6 +34,13 @@ type (
 		// Indexed by corresponding storeID
 		models map[uint64]ModelSet
 
+		// replaced (not modified) when changed; guarded by slMux
+		sensitivityLevels *sensitivityLevelIndex
+		slMux             sync.RWMutex
+
+		// keys used to encrypt values of encrypted attributes
+		keys *keyring
+
 		logger *zap.Logger
 		inDev  bool
 	}
@@ -50,6 +62,8 @@ func InitGlobalService(ctx context.Context, log *zap.Logger, inDev bool, cp Conn
 			models:      make(map[uint64]ModelSet),
 			primary:     nil,
 
+			sensitivityLevels: SensitivityLevelIndex(),
+
 			logger: log,
 			inDev:  inDev,
 		}
@@ -98,6 +112,10 @@ func (svc *service) Drivers() (drivers []Driver) {
 
 // AddConnection adds a new connection to the DAL
 func (svc *service) AddConnection(ctx context.Context, connectionID uint64, cp ConnectionParams, dft ConnectionDefaults, capabilities ...capabilities.Capability) (err error) {
+	if !svc.levels().includes(dft.SensitivityLevel) {
+		return fmt.Errorf("can not add connection %d: sensitivity level %d does not exist", connectionID, dft.SensitivityLevel)
+	}
+
 	cw := &connectionWrap{
 		Defaults: dft,
 	}
@@ -131,8 +149,21 @@ func (svc *service) RemoveConnection(ctx context.Context, connectionID uint64) (
 
 // UpdateConnection updates the given connection
 //
+// Update is refused when models registered under the connection
+// would exceed the connection's new sensitivity level.
+//
 // @todo make this better; for now remove + add
 func (svc *service) UpdateConnection(ctx context.Context, connectionID uint64, cp ConnectionParams, dft ConnectionDefaults, capabilities ...capabilities.Capability) (err error) {
+	if !svc.levels().includes(dft.SensitivityLevel) {
+		return fmt.Errorf("can not update connection %d: sensitivity level %d does not exist", connectionID, dft.SensitivityLevel)
+	}
+
+	for _, model := range svc.models[connectionID] {
+		if err = validateModelSensitivity(svc.levels(), dft.SensitivityLevel, model); err != nil {
+			return fmt.Errorf("can not update connection %d: %v", connectionID, err)
+		}
+	}
+
 	if err = svc.RemoveConnection(ctx, connectionID); err != nil {
 		return
 	}
@@ -161,6 +192,10 @@ func (svc *service) Create(ctx context.Context, mf ModelFilter, capabilities cap
 		return
 	}
 
+	if rr, err = svc.encryptGetters(model, rr...); err != nil {
+		return
+	}
+
 	return cw.connection.Create(ctx, model, rr...)
 }
 
@@ -170,7 +205,12 @@ func (svc *service) Update(ctx context.Context, mf ModelFilter, capabilities cap
 		return
 	}
 
-	return cw.connection.Update(ctx, model, r)
+	rr, err := svc.encryptGetters(model, r)
+	if err != nil {
+		return
+	}
+
+	return cw.connection.Update(ctx, model, rr[0])
 }
 
 func (svc *service) Search(ctx context.Context, mf ModelFilter, capabilities capabilities.Set, f filter.Filter) (iter Iterator, err error) {
@@ -179,7 +219,11 @@ func (svc *service) Search(ctx context.Context, mf ModelFilter, capabilities cap
 		return
 	}
 
-	return cw.connection.Search(ctx, model, f)
+	if iter, err = cw.connection.Search(ctx, model, f); err != nil {
+		return
+	}
+
+	return svc.decryptIterator(model, iter)
 }
 
 func (svc *service) Lookup(ctx context.Context, mf ModelFilter, capabilities capabilities.Set, lookup ValueGetter, dst ValueSetter) (err error) {
@@ -187,6 +231,11 @@ func (svc *service) Lookup(ctx context.Context, mf ModelFilter, capabilities cap
 	if err != nil {
 		return
 	}
+
+	if dst, err = svc.decryptSetter(model, dst); err != nil {
+		return
+	}
+
 	return cw.connection.Lookup(ctx, model, lookup, dst)
 }
 
@@ -247,7 +296,7 @@ func (svc *service) AddModel(ctx context.Context, models ...*Model) (err error)
 			return err
 		}
 
-		err = svc.registerModel(ctx, cw.connection, connectionID, models)
+		err = svc.registerModel(ctx, cw, connectionID, models)
 		if err != nil {
 			return
 		}
@@ -256,6 +305,28 @@ func (svc *service) AddModel(ctx context.Context, models ...*Model) (err error)
 	return
 }
 
+// ValidateModel checks if the models can be registered under their connections
+//
+// Sensitivity levels of the models and their attributes
+// must not exceed the sensitivity level of the connection.
+func (svc *service) ValidateModel(ctx context.Context, models ...*Model) (err error) {
+	var (
+		cw *connectionWrap
+	)
+
+	for _, model := range models {
+		if cw, _, err = svc.getConnection(ctx, model.ConnectionID); err != nil {
+			return
+		}
+
+		if err = validateModelSensitivity(svc.levels(), cw.Defaults.SensitivityLevel, model); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
 // RemoveModel removes support for the given model
 func (svc *service) RemoveModel(ctx context.Context, models ...*Model) (err error) {
 	// validation
@@ -359,14 +430,18 @@ func (svc *service) modelByConnection(models ModelSet) (out map[uint64]ModelSet)
 	return
 }
 
-func (svc *service) registerModel(ctx context.Context, s Connection, storeID uint64, models ModelSet) (err error) {
+func (svc *service) registerModel(ctx context.Context, cw *connectionWrap, storeID uint64, models ModelSet) (err error) {
 	for _, model := range models {
 		existing := svc.GetModelByResource(storeID, model.ResourceType, model.Resource)
 		if existing != nil {
 			return fmt.Errorf("cannot add model %s to store %d: already exists", model.Resource, storeID)
 		}
 
-		err = svc.registerModelToConnection(ctx, s, model)
+		if err = validateModelSensitivity(svc.levels(), cw.Defaults.SensitivityLevel, model); err != nil {
+			return fmt.Errorf("cannot add model %s to store %d: %v", model.Resource, storeID, err)
+		}
+
+		err = svc.registerModelToConnection(ctx, cw.connection, model)
 		if err != nil {
 			return
 		}
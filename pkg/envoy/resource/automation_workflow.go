@@ -12,9 +12,10 @@ type (
 		*base
 		Res *types.Workflow
 
-		Triggers []*AutomationTrigger
-		Steps    []*AutomationWorkflowStep
-		Paths    []*AutomationWorkflowPath
+		Triggers  []*AutomationTrigger
+		Steps     []*AutomationWorkflowStep
+		Paths     []*AutomationWorkflowPath
+		Revisions []*AutomationWorkflowRevision
 	}
 
 	AutomationTrigger struct {
@@ -27,6 +28,11 @@ type (
 		Res *types.WorkflowStep
 	}
 
+	AutomationWorkflowRevision struct {
+		*base
+		Res *types.WorkflowRevision
+	}
+
 	AutomationWorkflowPath struct {
 		*base
 		Res *types.WorkflowPath
@@ -123,6 +129,21 @@ func (r *AutomationWorkflow) AddAutomationWorkflowPath(res *types.WorkflowPath)
 	return p
 }
 
+func (r *AutomationWorkflow) AddAutomationWorkflowRevision(res *types.WorkflowRevision) *AutomationWorkflowRevision {
+	rv := &AutomationWorkflowRevision{
+		base: &base{},
+	}
+
+	rv.Res = res
+
+	if r.Revisions == nil {
+		r.Revisions = make([]*AutomationWorkflowRevision, 0, 10)
+	}
+	r.Revisions = append(r.Revisions, rv)
+
+	return rv
+}
+
 func (r *AutomationWorkflow) SysID() uint64 {
 	return r.Res.ID
 }
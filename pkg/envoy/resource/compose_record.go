@@ -22,6 +22,11 @@ type (
 		ID     string
 		Values map[string]string
 
+		// MultiValues holds all of the values of the multi-value fields
+		//
+		// Values only hold the last value of the multi-value field.
+		MultiValues map[string][]string
+
 		Ts *Timestamps
 		Us *Userstamps
 
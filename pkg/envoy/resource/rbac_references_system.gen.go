@@ -152,3 +152,16 @@ func SystemDalSensitivityLevelRbacReferences(dalSensitivityLevel string) (res *R
 
 	return
 }
+
+// SystemHolidayCalendarRbacReferences generates RBAC references
+//
+// Resources with "envoy: false" are skipped
+//
+// This function is auto-generated
+func SystemHolidayCalendarRbacReferences(holidayCalendar string) (res *Ref, pp []*Ref, err error) {
+	if holidayCalendar != "*" {
+		res = &Ref{ResourceType: types.HolidayCalendarResourceType, Identifiers: MakeIdentifiers(holidayCalendar)}
+	}
+
+	return
+}
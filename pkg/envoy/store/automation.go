@@ -16,6 +16,7 @@ type (
 
 	automationStore interface {
 		store.AutomationWorkflows
+		store.AutomationWorkflowRevisions
 		store.AutomationTriggers
 	}
 
@@ -101,7 +102,16 @@ func (d *automationDecoder) decodeWorkflows(ctx context.Context, s automationSto
 					}
 				}
 
-				mm = append(mm, newAutomationWorkflow(n, tt, d.ux))
+				rr, _, err := s.SearchAutomationWorkflowRevisions(ctx, types.WorkflowRevisionFilter{
+					WorkflowID: n.ID,
+				})
+				if err != nil {
+					return &auxRsp{
+						err: err,
+					}
+				}
+
+				mm = append(mm, newAutomationWorkflow(n, tt, rr, d.ux))
 				d.resourceID = append(d.resourceID, n.ID)
 			}
 
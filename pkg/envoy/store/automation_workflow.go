@@ -16,6 +16,7 @@ type (
 		res *resource.AutomationWorkflow
 		wf  *types.Workflow
 		tt  types.TriggerSet
+		rr  types.WorkflowRevisionSet
 
 		ux *userIndex
 	}
@@ -54,6 +55,9 @@ func mergeAutomationWorkflows(a, b *types.Workflow) *types.Workflow {
 	if c.RunAs == 0 {
 		c.RunAs = b.RunAs
 	}
+	if c.PublishedRevision == 0 {
+		c.PublishedRevision = b.PublishedRevision
+	}
 	if c.OwnedBy == 0 {
 		c.OwnedBy = b.OwnedBy
 	}
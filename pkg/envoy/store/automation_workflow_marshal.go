@@ -38,6 +38,13 @@ func (n *automationWorkflow) prepareWorkflows(ctx context.Context, pl *payload)
 
 	if n.wf != nil {
 		n.res.Res.ID = n.wf.ID
+
+		n.rr, _, err = store.SearchAutomationWorkflowRevisions(ctx, pl.s, types.WorkflowRevisionFilter{
+			WorkflowID: n.wf.ID,
+		})
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -148,6 +155,10 @@ func (n *automationWorkflow) encodeWorkflow(ctx context.Context, pl *payload) (e
 
 	// Create a fresh workflow
 	if !exists {
+		if err = n.encodeRevisions(ctx, pl, res); err != nil {
+			return err
+		}
+
 		return store.CreateAutomationWorkflow(ctx, pl.s, res)
 	}
 
@@ -157,10 +168,22 @@ func (n *automationWorkflow) encodeWorkflow(ctx context.Context, pl *payload) (e
 		return nil
 
 	case resource.MergeLeft:
+		// existing revisions are kept
 		res = mergeAutomationWorkflows(n.wf, res)
 
-	case resource.MergeRight:
-		res = mergeAutomationWorkflows(res, n.wf)
+	default:
+		// existing revisions are replaced with the imported ones
+		if err = store.DeleteAutomationWorkflowRevision(ctx, pl.s, n.rr...); err != nil {
+			return err
+		}
+
+		if err = n.encodeRevisions(ctx, pl, res); err != nil {
+			return err
+		}
+
+		if n.cfg.OnExisting == resource.MergeRight {
+			res = mergeAutomationWorkflows(res, n.wf)
+		}
 	}
 
 	err = store.UpdateAutomationWorkflow(ctx, pl.s, res)
@@ -172,6 +195,49 @@ func (n *automationWorkflow) encodeWorkflow(ctx context.Context, pl *payload) (e
 	return nil
 }
 
+// encodeRevisions stores imported workflow revisions
+//
+// When no revisions are imported, workflow definition is stored as the first revision.
+// Published revision falls back to the latest one when it is not one of the imported revisions.
+func (n *automationWorkflow) encodeRevisions(ctx context.Context, pl *payload, wf *types.Workflow) (err error) {
+	var (
+		rr        = make(types.WorkflowRevisionSet, 0, len(n.res.Revisions))
+		latest    uint
+		published bool
+	)
+
+	for _, rres := range n.res.Revisions {
+		rr = append(rr, rres.Res)
+	}
+
+	if len(rr) == 0 {
+		rv := wf.MakeRevision()
+		rv.Revision = 1
+		rr = append(rr, rv)
+	}
+
+	for _, rv := range rr {
+		rv.ID = NextID()
+		rv.WorkflowID = wf.ID
+		rv.CreatedBy = pl.invokerID
+		if rv.CreatedAt.IsZero() {
+			rv.CreatedAt = *now()
+		}
+
+		if rv.Revision > latest {
+			latest = rv.Revision
+		}
+
+		published = published || rv.Revision == wf.PublishedRevision
+	}
+
+	if !published {
+		wf.PublishedRevision = latest
+	}
+
+	return store.CreateAutomationWorkflowRevision(ctx, pl.s, rr...)
+}
+
 func (n *automationWorkflow) encodeTriggers(ctx context.Context, pl *payload) (err error) {
 	exists := len(n.tt) > 0
 	rr := make([]*types.Trigger, 0, len(n.res.Triggers))
@@ -6,10 +6,11 @@ import (
 	"github.com/cortezaproject/corteza-server/pkg/envoy/resource"
 )
 
-func newAutomationWorkflow(wf *types.Workflow, tt types.TriggerSet, ux *userIndex) *automationWorkflow {
+func newAutomationWorkflow(wf *types.Workflow, tt types.TriggerSet, rr types.WorkflowRevisionSet, ux *userIndex) *automationWorkflow {
 	return &automationWorkflow{
 		wf: wf,
 		tt: tt,
+		rr: rr,
 
 		ux: ux,
 	}
@@ -32,6 +33,10 @@ func (awf *automationWorkflow) MarshalEnvoy() ([]resource.Interface, error) {
 		rs.AddAutomationWorkflowPath(p)
 	}
 
+	for _, rv := range awf.rr {
+		rs.AddAutomationWorkflowRevision(rv)
+	}
+
 	return envoy.CollectNodes(
 		rs,
 	)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cortezaproject/corteza-server/compose/service"
 	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/dal"
 	"github.com/cortezaproject/corteza-server/pkg/envoy"
 	"github.com/cortezaproject/corteza-server/pkg/envoy/resource"
 	"github.com/cortezaproject/corteza-server/pkg/filter"
@@ -212,6 +213,17 @@ func (d *composeDecoder) decodeComposeRecord(ctx context.Context, s store.Storer
 		return rr
 	}
 
+	mapMultiValues := func(mod *types.Module, r *types.Record) map[string][]string {
+		rr := make(map[string][]string)
+		for _, v := range r.Values {
+			if f := mod.Fields.FindByName(v.Name); f != nil && f.Multi {
+				rr[v.Name] = append(rr[v.Name], v.Value)
+			}
+		}
+
+		return rr
+	}
+
 	// Prepare a series of resource.ComposeRecord instances; one for each provided filter
 	for _, f := range ff {
 		aux := *f
@@ -258,14 +270,16 @@ func (d *composeDecoder) decodeComposeRecord(ctx context.Context, s store.Storer
 				}
 
 				service.ComposeRecordFilterAC(ctx, ac, mod, rr...)
+				service.RedactExportedRecords(dal.Service(), mod, rr...)
 
 				for _, n := range rr {
 					// Create a raw record
 					r := &resource.ComposeRecordRaw{
-						ID:     strconv.FormatUint(n.ID, 10),
-						Values: mapValues(n),
-						Ts:     resource.MakeTimestampsCUDA(&n.CreatedAt, n.UpdatedAt, n.DeletedAt, nil),
-						Us:     resource.MakeUserstampsCUDO(n.CreatedBy, n.UpdatedBy, n.DeletedBy, n.OwnedBy),
+						ID:          strconv.FormatUint(n.ID, 10),
+						Values:      mapValues(n),
+						MultiValues: mapMultiValues(mod, n),
+						Ts:          resource.MakeTimestampsCUDA(&n.CreatedAt, n.UpdatedAt, n.DeletedAt, nil),
+						Us:          resource.MakeUserstampsCUDO(n.CreatedBy, n.UpdatedBy, n.DeletedBy, n.OwnedBy),
 					}
 
 					err = cb(r)
@@ -0,0 +1,40 @@
+package store
+
+import (
+	"context"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/envoy"
+	"github.com/cortezaproject/corteza-server/pkg/envoy/resource"
+	"github.com/cortezaproject/corteza-server/store"
+)
+
+// DecodeNamespace decodes the namespace with all of its modules, pages and charts
+//
+// Used when cloning namespaces.
+func DecodeNamespace(ctx context.Context, s store.Storer, namespaceID uint64) (resource.InterfaceSet, error) {
+	df := NewDecodeFilter().
+		ComposeNamespace(&types.NamespaceFilter{
+			NamespaceID: []uint64{namespaceID},
+		}).
+		ComposeModule(&types.ModuleFilter{}).
+		ComposePage(&types.PageFilter{}).
+		ComposeChart(&types.ChartFilter{})
+
+	// @todo how do we want to handle workflows?
+	return Decoder().Decode(ctx, s, df)
+}
+
+// EncodeResources encodes the given resources into the store
+//
+// Used when cloning namespaces.
+func EncodeResources(ctx context.Context, s store.Storer, nn resource.InterfaceSet) error {
+	se := NewStoreEncoder(s, &EncoderConfig{})
+
+	g, err := envoy.NewBuilder(se).Build(ctx, nn...)
+	if err != nil {
+		return err
+	}
+
+	return envoy.Encode(ctx, g, se)
+}
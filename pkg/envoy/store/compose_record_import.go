@@ -0,0 +1,29 @@
+package store
+
+import (
+	"context"
+
+	"github.com/cortezaproject/corteza-server/pkg/envoy"
+	"github.com/cortezaproject/corteza-server/pkg/envoy/resource"
+	"github.com/cortezaproject/corteza-server/store"
+)
+
+// ImportRecords encodes the shaped compose record resources into the store
+//
+// ok and nok are called after each record is encoded; see EncoderConfig.
+// Used by the compose record import sessions.
+func ImportRecords(ctx context.Context, s store.Storer, ok func(), nok func(error) error, rr ...resource.Interface) error {
+	se := NewStoreEncoder(s, &EncoderConfig{
+		// For now the identifier is ignored, so this will never occur
+		OnExisting: resource.Skip,
+		DeferOk:    ok,
+		DeferNok:   nok,
+	})
+
+	g, err := envoy.NewBuilder(se).Build(ctx, rr...)
+	if err != nil {
+		return err
+	}
+
+	return envoy.Encode(ctx, g, se)
+}
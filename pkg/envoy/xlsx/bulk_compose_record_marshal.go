@@ -0,0 +1,315 @@
+package xlsx
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/envoy"
+	"github.com/cortezaproject/corteza-server/pkg/envoy/resource"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	// multiValueDelimiter joins values of the multi-value fields
+	multiValueDelimiter = "\n"
+
+	dateTimeFormat = "yyyy-mm-dd hh:mm:ss"
+	dateFormat     = "yyyy-mm-dd"
+	timeFormat     = "hh:mm:ss"
+
+	sheetNameMaxLength = 31
+)
+
+type (
+	bulkComposeRecordEncoder struct {
+		encoderConfig *EncoderConfig
+
+		res *resource.ComposeRecord
+	}
+
+	// cellStyles holds styles of the typed cells
+	cellStyles struct {
+		header   int
+		dateTime int
+		date     int
+		time     int
+	}
+)
+
+func bulkComposeRecordEncoderFromResource(rec *resource.ComposeRecord, cfg *EncoderConfig) *bulkComposeRecordEncoder {
+	return &bulkComposeRecordEncoder{
+		encoderConfig: cfg,
+
+		res: rec,
+	}
+}
+
+// Prepare prepares the composeRecord to be encoded
+//
+// Any validation, additional constraining should be performed here.
+func (n *bulkComposeRecordEncoder) Prepare(ctx context.Context, state *envoy.ResourceState) (err error) {
+	_, ok := state.Res.(*resource.ComposeRecord)
+	if !ok {
+		return encoderErrInvalidResource(types.RecordResourceType, state.Res.ResourceType())
+	}
+
+	return nil
+}
+
+// Encode encodes the composeRecord to the workbook
+//
+// Record values are written as typed cells based on the module field kind;
+// values of multi-value fields are joined.
+func (n *bulkComposeRecordEncoder) Encode(ctx context.Context, w io.Writer, state *envoy.ResourceState) (err error) {
+	var (
+		all = len(n.encoderConfig.Fields) == 0
+		tz  = time.UTC
+
+		f     = excelize.NewFile()
+		sheet = sheetName(n.res.RelMod)
+	)
+	defer f.Close()
+
+	if n.encoderConfig.Timezone != "" {
+		if tz, err = time.LoadLocation(n.encoderConfig.Timezone); err != nil {
+			return err
+		}
+	}
+
+	f.SetSheetName(f.GetSheetName(0), sheet)
+	sw, err := f.NewStreamWriter(sheet)
+	if err != nil {
+		return err
+	}
+
+	ss, err := prepareStyles(f)
+	if err != nil {
+		return err
+	}
+
+	// Generate header & cell index
+	hh := make([]interface{}, 0, 100)
+	hh = append(hh, excelize.Cell{StyleID: ss.header, Value: "id"})
+
+	fx := make(map[string]int)
+	// 1 sys fields
+	sysFields := []string{"ownedBy", "createdAt", "createdBy", "updatedAt", "updatedBy", "deletedAt", "deletedBy"}
+	for _, sf := range sysFields {
+		if all || n.encoderConfig.Fields[sf] {
+			fx[sf] = len(hh)
+			hh = append(hh, excelize.Cell{StyleID: ss.header, Value: sf})
+		}
+	}
+
+	// 2 module fields
+	for _, mf := range n.res.RelMod.Fields {
+		if all || n.encoderConfig.Fields[mf.Name] {
+			fx[mf.Name] = len(hh)
+			hh = append(hh, excelize.Cell{StyleID: ss.header, Value: mf.Name})
+		}
+	}
+
+	if err = sw.SetRow("A1", hh); err != nil {
+		return err
+	}
+
+	rowIndex := 1
+	err = n.res.Walker(func(r *resource.ComposeRecordRaw) error {
+		row := make([]interface{}, len(hh))
+
+		var err error
+		row[0] = r.ID
+
+		userCell := func(f string, us *resource.Userstamp) error {
+			if us == nil || !(all || n.encoderConfig.Fields[f]) {
+				return nil
+			}
+
+			row[fx[f]], err = n.res.UserFlakes.GetByStamp(us).Model()
+			return err
+		}
+
+		timeCell := func(f string, ts *resource.Timestamp) {
+			if ts == nil || ts.T == nil || !(all || n.encoderConfig.Fields[f]) {
+				return
+			}
+
+			row[fx[f]] = excelize.Cell{StyleID: ss.dateTime, Value: ts.T.In(tz)}
+		}
+
+		if r.Us != nil {
+			for f, us := range map[string]*resource.Userstamp{
+				"ownedBy":   r.Us.OwnedBy,
+				"createdBy": r.Us.CreatedBy,
+				"updatedBy": r.Us.UpdatedBy,
+				"deletedBy": r.Us.DeletedBy,
+			} {
+				if err = userCell(f, us); err != nil {
+					return err
+				}
+			}
+		}
+
+		if r.Ts != nil {
+			timeCell("createdAt", r.Ts.CreatedAt)
+			timeCell("updatedAt", r.Ts.UpdatedAt)
+			timeCell("deletedAt", r.Ts.DeletedAt)
+		}
+
+		for k, v := range r.Values {
+			// Skip fields we don't want
+			if !all && !n.encoderConfig.Fields[k] {
+				continue
+			}
+
+			cell, has := fx[k]
+			if !has {
+				return fmt.Errorf("unknown cell %s", k)
+			}
+			mf := n.res.RelMod.Fields.FindByName(k)
+			if mf == nil {
+				return fmt.Errorf("field %s not found", k)
+			}
+
+			if vv, has := r.MultiValues[k]; has && mf.Multi {
+				row[cell], err = n.multiValueCell(mf, vv)
+			} else {
+				row[cell], err = n.valueCell(mf, ss, tz, v)
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+
+		rowIndex++
+		axis, err := excelize.CoordinatesToCellName(1, rowIndex)
+		if err != nil {
+			return err
+		}
+
+		return sw.SetRow(axis, row)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = sw.Flush(); err != nil {
+		return err
+	}
+
+	return f.Write(w)
+}
+
+// valueCell converts the record value to the typed cell
+//
+// Values that can not be converted are written as strings.
+func (n *bulkComposeRecordEncoder) valueCell(f *types.ModuleField, ss *cellStyles, tz *time.Location, v string) (interface{}, error) {
+	if v == "" {
+		return nil, nil
+	}
+
+	switch {
+	case f.Kind == "User":
+		return n.res.UserFlakes.GetByKey(v).Model()
+
+	case f.Kind == "Number":
+		if num, err := strconv.ParseFloat(v, 64); err == nil {
+			return num, nil
+		}
+
+	case f.Kind == "Bool":
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b, nil
+		}
+
+	case f.IsDateOnly():
+		if t, err := time.Parse("2006-01-02", v); err == nil {
+			return excelize.Cell{StyleID: ss.date, Value: t}, nil
+		}
+
+	case f.IsTimeOnly():
+		if t, err := time.Parse("15:04:05", v); err == nil {
+			d := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+			return excelize.Cell{StyleID: ss.time, Value: d}, nil
+		}
+
+	case f.IsDateTime():
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return excelize.Cell{StyleID: ss.dateTime, Value: t.In(tz)}, nil
+		}
+	}
+
+	return v, nil
+}
+
+// multiValueCell joins the values of the multi-value field
+func (n *bulkComposeRecordEncoder) multiValueCell(f *types.ModuleField, vv []string) (interface{}, error) {
+	out := make([]string, 0, len(vv))
+
+	for _, v := range vv {
+		if f.Kind == "User" {
+			u, err := n.res.UserFlakes.GetByKey(v).Model()
+			if err != nil {
+				return nil, err
+			}
+			v = u
+		}
+
+		out = append(out, v)
+	}
+
+	return strings.Join(out, multiValueDelimiter), nil
+}
+
+// prepareStyles registers styles of the typed cells to the workbook
+func prepareStyles(f *excelize.File) (ss *cellStyles, err error) {
+	ss = &cellStyles{}
+
+	if ss.header, err = f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}}); err != nil {
+		return
+	}
+
+	for _, s := range []struct {
+		id     *int
+		format string
+	}{
+		{&ss.dateTime, dateTimeFormat},
+		{&ss.date, dateFormat},
+		{&ss.time, timeFormat},
+	} {
+		format := s.format
+		if *s.id, err = f.NewStyle(&excelize.Style{CustomNumFmt: &format}); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// sheetName returns a valid sheet name for the module
+func sheetName(mod *types.Module) string {
+	name := "Sheet1"
+	if mod != nil && mod.Handle != "" {
+		name = mod.Handle
+	}
+
+	// Sheet names can not contain any of the following characters
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`:\/?*[]`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+
+	if r := []rune(name); len(r) > sheetNameMaxLength {
+		name = string(r[:sheetNameMaxLength])
+	}
+
+	return name
+}
@@ -0,0 +1,81 @@
+package xlsx
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/envoy/resource"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestBulkComposeRecordEncoder(t *testing.T) {
+	var (
+		ctx = context.Background()
+		req = require.New(t)
+
+		createdAt = time.Date(2022, 3, 4, 5, 6, 7, 0, time.UTC)
+
+		mod = &types.Module{
+			Handle: "mod:records",
+			Fields: types.ModuleFieldSet{
+				{Name: "str", Kind: "String"},
+				{Name: "num", Kind: "Number"},
+				{Name: "bool", Kind: "Bool"},
+				{Name: "dt", Kind: "DateTime"},
+				{Name: "date", Kind: "DateTime", Options: types.ModuleFieldOptions{"onlyDate": true}},
+				{Name: "multi", Kind: "String", Multi: true},
+			},
+		}
+	)
+
+	rec := resource.NewComposeRecordSet(func(f func(r *resource.ComposeRecordRaw) error) error {
+		return f(&resource.ComposeRecordRaw{
+			ID: "42",
+			Values: map[string]string{
+				"str":   "value",
+				"num":   "12.5",
+				"bool":  "1",
+				"dt":    "2022-03-04T05:06:07Z",
+				"date":  "2022-03-05",
+				"multi": "b",
+			},
+			MultiValues: map[string][]string{
+				"multi": {"a", "b"},
+			},
+			Ts: resource.MakeTimestampsCUDA(&createdAt, nil, nil, nil),
+		})
+	}, "ns", "mod")
+	rec.RelMod = mod
+
+	buf := &bytes.Buffer{}
+	enc := bulkComposeRecordEncoderFromResource(rec, &EncoderConfig{
+		Fields:   map[string]bool{"createdAt": true, "str": true, "num": true, "bool": true, "dt": true, "date": true, "multi": true},
+		Timezone: "Europe/Ljubljana",
+	})
+	req.NoError(enc.Encode(ctx, buf, nil))
+
+	f, err := excelize.OpenReader(buf)
+	req.NoError(err)
+
+	req.Equal([]string{"mod_records"}, f.GetSheetList())
+
+	rows, err := f.GetRows("mod_records")
+	req.NoError(err)
+	req.Len(rows, 2)
+	req.Equal([]string{"id", "createdAt", "str", "num", "bool", "dt", "date", "multi"}, rows[0])
+	req.Equal([]string{"42", "2022-03-04 06:06:07", "value", "12.5", "TRUE", "2022-03-04 06:06:07", "2022-03-05", "a\nb"}, rows[1])
+
+	// typed cells
+	ct, err := f.GetCellType("mod_records", "E2")
+	req.NoError(err)
+	req.Equal(excelize.CellTypeBool, ct)
+
+	raw, err := f.GetRows("mod_records", excelize.Options{RawCellValue: true})
+	req.NoError(err)
+	req.Equal("12.5", raw[1][3])
+	req.Equal("44624.25424768519", raw[1][5])
+}
@@ -0,0 +1,239 @@
+package xlsx
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/envoy"
+	"github.com/cortezaproject/corteza-server/pkg/envoy/resource"
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	mimeType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+type (
+	// wrapper struct for xlsx related methods
+	decoder struct{}
+
+	// xlsx sheet reader
+	reader struct {
+		sheet  string
+		header []string
+		count  uint64
+
+		cache      []map[string]string
+		cacheIndex int
+	}
+)
+
+var (
+	ErrNoSheets = errors.New("no sheets with data")
+)
+
+// Decoder initializes and returns a fresh XLSX decoder
+func Decoder() *decoder {
+	return &decoder{}
+}
+
+// CanDecodeFile determines if the file can be determined by this decoder
+func (y *decoder) CanDecodeFile(f io.Reader) bool {
+	m, err := mimetype.DetectReader(f)
+	if err != nil {
+		return false
+	}
+
+	return y.CanDecodeExt(m.Extension())
+}
+
+func (y *decoder) CanDecodeMime(m string) bool {
+	return m == mimeType
+}
+
+func (y *decoder) CanDecodeExt(ext string) bool {
+	pt := strings.Split(ext, ".")
+	return strings.TrimSpace(pt[len(pt)-1]) == "xlsx"
+}
+
+// Decode decodes the given io.Reader into a set of generic resource datasets
+//
+// Each sheet with data produces a dataset; the first row of the sheet
+// is used as the header.
+// Datasets are identified by the document name and the sheet name.
+func (c *decoder) Decode(ctx context.Context, r io.Reader, do *envoy.DecoderOpts) ([]resource.Interface, error) {
+	f, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rr := make([]resource.Interface, 0, f.SheetCount)
+	for _, sheet := range f.GetSheetList() {
+		xr := &reader{
+			sheet: sheet,
+			cache: make([]map[string]string, 0, 1000),
+		}
+
+		if err = xr.prepare(f); err != nil {
+			return nil, err
+		}
+
+		// Skip sheets without the header
+		if len(xr.header) == 0 {
+			continue
+		}
+
+		ds := resource.NewResourceDataset(do.Name, xr)
+		ds.AddIdentifier(sheet)
+		rr = append(rr, ds)
+	}
+
+	if len(rr) == 0 {
+		return nil, ErrNoSheets
+	}
+
+	return rr, nil
+}
+
+// The prepare step caches all of the rows into a cache array that will be used
+// to access already visited rows.
+//
+// Cells are read raw so the values do not depend on the cell formatting;
+// numbers formatted as dates are converted to RFC3339 timestamps.
+func (xr *reader) prepare(f *excelize.File) (err error) {
+	rows, err := f.GetRows(xr.sheet, excelize.Options{RawCellValue: true})
+	if err != nil {
+		return err
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	// Header
+	for _, h := range rows[0] {
+		xr.header = append(xr.header, strings.TrimSpace(h))
+	}
+
+	// Trailing header cells may be empty
+	for len(xr.header) > 0 && xr.header[len(xr.header)-1] == "" {
+		xr.header = xr.header[:len(xr.header)-1]
+	}
+
+	dates := make(map[int]bool)
+
+	for r, cc := range rows[1:] {
+		aux := make(map[string]string)
+		empty := true
+
+		for i, h := range xr.header {
+			if h == "" || i >= len(cc) {
+				continue
+			}
+
+			v := cc[i]
+			if v != "" {
+				empty = false
+			}
+
+			if v, err = xr.cellValue(f, dates, i, r+2, v); err != nil {
+				return err
+			}
+
+			aux[h] = v
+		}
+
+		// Sheets often have formatted rows without any values; skip them
+		if empty {
+			continue
+		}
+
+		// Entry count
+		xr.count++
+
+		// Cache entry
+		xr.cache = append(xr.cache, aux)
+	}
+
+	return nil
+}
+
+// cellValue converts numbers formatted as dates to RFC3339 timestamps
+//
+// dates caches date formats of the cell styles.
+func (xr *reader) cellValue(f *excelize.File, dates map[int]bool, col, row int, v string) (string, error) {
+	n, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return v, nil
+	}
+
+	axis, err := excelize.CoordinatesToCellName(col+1, row)
+	if err != nil {
+		return "", err
+	}
+
+	style, err := f.GetCellStyle(xr.sheet, axis)
+	if err != nil {
+		return "", err
+	}
+
+	isDate, ok := dates[style]
+	if !ok {
+		// Styles are loaded lazily, when a styled cell is formatted
+		if f.Styles == nil {
+			if _, err = f.GetCellValue(xr.sheet, axis); err != nil {
+				return "", err
+			}
+		}
+
+		isDate = isDateStyle(f, style)
+		dates[style] = isDate
+	}
+
+	if !isDate {
+		return v, nil
+	}
+
+	t, err := excelize.ExcelDateToTime(n, false)
+	if err != nil {
+		return "", err
+	}
+
+	return t.Format(time.RFC3339), nil
+}
+
+// Fields returns every available field in this dataset
+func (xr *reader) Fields() []string {
+	return xr.header
+}
+
+// Sheet returns the name of the sheet the dataset was read from
+func (xr *reader) Sheet() string {
+	return xr.sheet
+}
+
+func (xr *reader) Reset() error {
+	xr.cacheIndex = 0
+	return nil
+}
+
+// Next returns the field: value mapping for the next row
+func (xr *reader) Next() (map[string]string, error) {
+	if xr.cacheIndex >= len(xr.cache) {
+		return nil, nil
+	}
+
+	mr := xr.cache[xr.cacheIndex]
+	xr.cacheIndex++
+	return mr, nil
+}
+
+func (xr *reader) Count() uint64 {
+	return xr.count
+}
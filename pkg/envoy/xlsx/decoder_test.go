@@ -0,0 +1,122 @@
+package xlsx
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/envoy"
+	"github.com/cortezaproject/corteza-server/pkg/envoy/resource"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func makeDocument(t *testing.T) *bytes.Buffer {
+	req := require.New(t)
+	f := excelize.NewFile()
+
+	// Sheet 1; simple values
+	req.NoError(f.SetSheetRow("Sheet1", "A1", &[]interface{}{"id", "c1", "c2"}))
+	req.NoError(f.SetSheetRow("Sheet1", "A2", &[]interface{}{1, "v1", true}))
+	req.NoError(f.SetSheetRow("Sheet1", "A3", &[]interface{}{2, "v2", false}))
+
+	// Sheet 2; dates
+	f.NewSheet("dates")
+	req.NoError(f.SetSheetRow("dates", "A1", &[]interface{}{"name", "at", "amount"}))
+	req.NoError(f.SetSheetRow("dates", "A2", &[]interface{}{"d1", time.Date(2022, 3, 4, 5, 6, 7, 0, time.UTC), 42.5}))
+
+	fmt := "yyyy-mm-dd"
+	style, err := f.NewStyle(&excelize.Style{CustomNumFmt: &fmt})
+	req.NoError(err)
+	req.NoError(f.SetCellValue("dates", "B3", time.Date(2022, 3, 5, 0, 0, 0, 0, time.UTC)))
+	req.NoError(f.SetCellStyle("dates", "B3", "B3", style))
+	req.NoError(f.SetCellValue("dates", "A3", "d2"))
+
+	// Sheet 3; empty
+	f.NewSheet("empty")
+
+	buf, err := f.WriteToBuffer()
+	req.NoError(err)
+	return buf
+}
+
+func TestDecoder(t *testing.T) {
+	var (
+		ctx = context.Background()
+		req = require.New(t)
+	)
+
+	doc := makeDocument(t)
+	d := Decoder()
+	req.True(d.CanDecodeFile(bytes.NewReader(doc.Bytes())))
+
+	ii, err := d.Decode(ctx, doc, &envoy.DecoderOpts{
+		Name: "records",
+	})
+	req.NoError(err)
+	req.Len(ii, 2)
+
+	t.Run("simple sheet", func(t *testing.T) {
+		req := require.New(t)
+
+		ds, _ := ii[0].(*resource.ResourceDataset)
+		req.NotNil(ds)
+		req.True(ds.Identifiers().HasAny(resource.MakeIdentifiers("records", "Sheet1")))
+		req.Equal("Sheet1", ds.P.(*reader).Sheet())
+		req.Equal([]string{"id", "c1", "c2"}, ds.P.Fields())
+		req.Equal(uint64(2), ds.P.Count())
+
+		n, err := ds.P.Next()
+		req.NoError(err)
+		req.Equal(map[string]string{"id": "1", "c1": "v1", "c2": "1"}, n)
+
+		n, err = ds.P.Next()
+		req.NoError(err)
+		req.Equal(map[string]string{"id": "2", "c1": "v2", "c2": "0"}, n)
+
+		n, err = ds.P.Next()
+		req.Nil(n)
+		req.Nil(err)
+	})
+
+	t.Run("dates", func(t *testing.T) {
+		req := require.New(t)
+
+		ds, _ := ii[1].(*resource.ResourceDataset)
+		req.NotNil(ds)
+		req.Equal("dates", ds.P.(*reader).Sheet())
+		req.Equal(uint64(2), ds.P.Count())
+
+		n, err := ds.P.Next()
+		req.NoError(err)
+		req.Equal("2022-03-04T05:06:07Z", n["at"])
+		req.Equal("42.5", n["amount"])
+
+		n, err = ds.P.Next()
+		req.NoError(err)
+		req.Equal("2022-03-05T00:00:00Z", n["at"])
+	})
+}
+
+func TestIsDateFormat(t *testing.T) {
+	tcc := []struct {
+		code string
+		is   bool
+	}{
+		{"General", false},
+		{"0.00", false},
+		{"#,##0", false},
+		{"yyyy-mm-dd", true},
+		{"hh:mm:ss", true},
+		{`"day"0`, false},
+		{`[Red]0.00`, false},
+		{`0\d`, false},
+	}
+
+	for _, tc := range tcc {
+		t.Run(tc.code, func(t *testing.T) {
+			require.Equal(t, tc.is, isDateFormat(tc.code))
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package xlsx
+
+import (
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// isDateStyle determines if the cell style formats numbers as dates or times
+func isDateStyle(f *excelize.File, style int) bool {
+	if style == 0 || f.Styles == nil || f.Styles.CellXfs == nil || style >= len(f.Styles.CellXfs.Xf) {
+		return false
+	}
+
+	xf := f.Styles.CellXfs.Xf[style]
+	if xf.NumFmtID == nil {
+		return false
+	}
+
+	id := *xf.NumFmtID
+	switch {
+	case id >= 14 && id <= 22, id >= 45 && id <= 47:
+		// Built-in date & time formats
+		return true
+
+	case id < 164:
+		return false
+	}
+
+	if f.Styles.NumFmts == nil {
+		return false
+	}
+
+	for _, nf := range f.Styles.NumFmts.NumFmt {
+		if nf.NumFmtID == id {
+			return isDateFormat(nf.FormatCode)
+		}
+	}
+
+	return false
+}
+
+// isDateFormat determines if the custom number format is a date or time format
+//
+// Quoted & escaped literals and bracketed sections (colors, locales) are ignored.
+func isDateFormat(code string) bool {
+	var (
+		quoted, bracketed, escaped bool
+	)
+
+	for _, c := range strings.ToLower(code) {
+		switch {
+		case escaped:
+			escaped = false
+		case quoted:
+			quoted = c != '"'
+		case bracketed:
+			bracketed = c != ']'
+		case c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = true
+		case c == '[':
+			bracketed = true
+		case c == 'y', c == 'd', c == 'h', c == 's':
+			return true
+		}
+	}
+
+	return false
+}
@@ -7,10 +7,11 @@ import (
 
 type (
 	automationWorkflow struct {
-		res      *types.Workflow
-		triggers automationTriggerSet
-		steps    automationWorkflowStepSet
-		paths    automationWorkflowPathSet
+		res       *types.Workflow
+		triggers  automationTriggerSet
+		steps     automationWorkflowStepSet
+		paths     automationWorkflowPathSet
+		revisions automationWorkflowRevisionSet
 
 		ts *resource.Timestamps
 		us *resource.Userstamps
@@ -54,6 +55,14 @@ type (
 		encoderConfig *EncoderConfig
 	}
 	automationWorkflowPathSet []*automationWorkflowPath
+
+	automationWorkflowRevision struct {
+		res *types.WorkflowRevision
+
+		steps automationWorkflowStepSet
+		paths automationWorkflowPathSet
+	}
+	automationWorkflowRevisionSet []*automationWorkflowRevision
 )
 
 func (nn automationWorkflowSet) configureEncoder(cfg *EncoderConfig) {
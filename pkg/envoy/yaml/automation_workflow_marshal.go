@@ -33,11 +33,19 @@ func automationWorkflowFromResource(r *resource.AutomationWorkflow, cfg *Encoder
 		}
 	}
 
+	rr := make(automationWorkflowRevisionSet, len(r.Revisions))
+	for i, rv := range r.Revisions {
+		rr[i] = &automationWorkflowRevision{
+			res: rv.Res,
+		}
+	}
+
 	return &automationWorkflow{
-		res:      r.Res,
-		triggers: tt,
-		steps:    ss,
-		paths:    pp,
+		res:       r.Res,
+		triggers:  tt,
+		steps:     ss,
+		paths:     pp,
+		revisions: rr,
 
 		encoderConfig: cfg,
 	}
@@ -78,7 +86,15 @@ func (n *automationWorkflow) Encode(ctx context.Context, doc *Document, state *e
 }
 
 func (wf *automationWorkflow) MarshalYAML() (interface{}, error) {
-	var err error
+	var (
+		err error
+
+		publishedRevision interface{}
+	)
+
+	if wf.res.PublishedRevision > 0 {
+		publishedRevision = wf.res.PublishedRevision
+	}
 
 	nn, err := makeMap(
 		"handle", wf.res.Handle,
@@ -93,6 +109,9 @@ func (wf *automationWorkflow) MarshalYAML() (interface{}, error) {
 		"steps", wf.res.Steps,
 		"paths", wf.res.Paths,
 
+		"publishedRevision", publishedRevision,
+		"revisions", wf.revisions,
+
 		// "issues", wf.res.Issues,
 		"labels", wf.res.Labels,
 	)
@@ -145,3 +164,14 @@ func (t *automationTrigger) MarshalYAML() (interface{}, error) {
 
 	return nn, nil
 }
+
+func (rv *automationWorkflowRevision) MarshalYAML() (interface{}, error) {
+	return makeMap(
+		"revision", rv.res.Revision,
+		"meta", rv.res.Meta,
+		"scope", rv.res.Scope,
+		"steps", rv.res.Steps,
+		"paths", rv.res.Paths,
+		"createdAt", rv.res.CreatedAt,
+	)
+}
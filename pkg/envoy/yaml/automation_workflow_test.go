@@ -36,4 +36,27 @@ func TestAutomationWorkflow_UnmarshalYAML(t *testing.T) {
 		req.Len(doc.automation.Workflows[0].steps, 1)
 		req.Len(doc.automation.Workflows[0].paths, 1)
 	})
+
+	t.Run("workflow revisions", func(t *testing.T) {
+		req := require.New(t)
+
+		doc, err := parseDocument("workflow_revisions")
+		req.NoError(err)
+		req.Len(doc.automation.Workflows, 1)
+
+		wf := doc.automation.Workflows[0]
+		req.Equal(uint(1), wf.res.PublishedRevision)
+		req.Len(wf.steps, 2)
+		req.Len(wf.revisions, 2)
+
+		req.Equal(uint(1), wf.revisions[0].res.Revision)
+		req.False(wf.revisions[0].res.CreatedAt.IsZero())
+		req.Len(wf.revisions[0].res.Steps, 1)
+		req.Len(wf.revisions[0].res.Paths, 0)
+
+		req.Equal(uint(2), wf.revisions[1].res.Revision)
+		req.Len(wf.revisions[1].res.Steps, 2)
+		req.Len(wf.revisions[1].res.Paths, 1)
+		req.Equal(uint64(102), wf.revisions[1].res.Paths[0].ChildID)
+	})
 }
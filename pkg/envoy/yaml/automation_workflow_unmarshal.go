@@ -104,12 +104,68 @@ func (wrap *automationWorkflow) UnmarshalYAML(n *yaml.Node) (err error) {
 			if err != nil {
 				return err
 			}
+
+		case "publishedRevision":
+			return y7s.DecodeScalar(v, "workflow publishedRevision", &wrap.res.PublishedRevision)
+
+		case "revisions":
+			wrap.revisions = make(automationWorkflowRevisionSet, 0, 10)
+
+			err = v.Decode(&wrap.revisions)
+			if err != nil {
+				return err
+			}
 		}
 
 		return nil
 	})
 }
 
+func (wrap *automationWorkflowRevision) UnmarshalYAML(n *yaml.Node) (err error) {
+	if wrap.res == nil {
+		wrap.res = &types.WorkflowRevision{}
+	}
+
+	err = y7s.EachMap(n, func(k, v *yaml.Node) (err error) {
+		switch k.Value {
+		case "revision":
+			return y7s.DecodeScalar(v, "workflow revision", &wrap.res.Revision)
+		case "meta":
+			return v.Decode(&wrap.res.Meta)
+		case "scope":
+			return v.Decode(&wrap.res.Scope)
+		case "createdAt":
+			return v.Decode(&wrap.res.CreatedAt)
+
+		case "steps":
+			wrap.steps = make(automationWorkflowStepSet, 0, 100)
+			return v.Decode(&wrap.steps)
+
+		case "paths":
+			wrap.paths = make(automationWorkflowPathSet, 0, 100)
+			return v.Decode(&wrap.paths)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return
+	}
+
+	wrap.res.Steps = make(types.WorkflowStepSet, 0, len(wrap.steps))
+	for _, s := range wrap.steps {
+		wrap.res.Steps = append(wrap.res.Steps, s.res)
+	}
+
+	wrap.res.Paths = make(types.WorkflowPathSet, 0, len(wrap.paths))
+	for _, p := range wrap.paths {
+		wrap.res.Paths = append(wrap.res.Paths, p.res)
+	}
+
+	return
+}
+
 func (wrap *automationTrigger) UnmarshalYAML(n *yaml.Node) (err error) {
 	if wrap.res == nil {
 		wrap.res = &types.Trigger{}
@@ -177,6 +233,12 @@ func (wrap *automationWorkflowStep) UnmarshalYAML(n *yaml.Node) (err error) {
 		case "results":
 			wrap.res.Results, err = unmarshalExprSet(v)
 			return err
+		case "retry":
+			return v.Decode(&wrap.res.Retry)
+		case "timeout":
+			return y7s.DecodeScalar(v, "step timeout", &wrap.res.Timeout)
+		case "concurrency":
+			return y7s.DecodeScalar(v, "step concurrency", &wrap.res.Concurrency)
 		case "meta":
 			return v.Decode(&wrap.res.Meta)
 		}
@@ -290,6 +352,10 @@ func (wrap automationWorkflow) MarshalEnvoy() ([]resource.Interface, error) {
 		rs.AddAutomationWorkflowPath(p.res)
 	}
 
+	for _, rv := range wrap.revisions {
+		rs.AddAutomationWorkflowRevision(rv.res)
+	}
+
 	return envoy.CollectNodes(
 		rs,
 		wrap.rbac.bindResource(rs),
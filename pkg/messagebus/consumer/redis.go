@@ -0,0 +1,300 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/messagebus/types"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	redisDefaultGroup     = "corteza"
+	redisDefaultStart     = "0"
+	redisDefaultPollDelay = time.Second
+	redisReadCount        = 100
+	redisPayloadKey       = "payload"
+
+	// time after the unacknowledged messages of other consumers
+	// (like the ones of the crashed instances) are claimed
+	redisDefaultClaimIdle = time.Minute * 5
+)
+
+type (
+	// RedisConsumer writes queue messages to a Redis stream
+	//
+	// It doubles as a producer; messages are read through a
+	// consumer group so that multiple Corteza instances (or
+	// external workers in the same group) share the load
+	RedisConsumer struct {
+		queue  string
+		handle types.ConsumerType
+		client redis.UniversalClient
+
+		stream   string
+		group    string
+		start    string
+		consumer string
+
+		poll  time.Duration
+		claim time.Duration
+	}
+)
+
+// NewRedisConsumer connects to redis and creates the stream
+// and the consumer group unless they already exist
+func NewRedisConsumer(ctx context.Context, q string, meta types.QueueMeta) (*RedisConsumer, error) {
+	if meta.RedisURL == "" {
+		return nil, fmt.Errorf("redis url for queue %s not set", q)
+	}
+
+	opt, err := redis.ParseURL(meta.RedisURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis url for queue %s: %w", q, err)
+	}
+
+	cq := newRedisConsumer(q, redis.NewClient(opt), meta)
+
+	if err = cq.initGroup(ctx); err != nil {
+		_ = cq.Close()
+		return nil, fmt.Errorf("could not create redis consumer group for queue %s: %w", q, err)
+	}
+
+	return cq, nil
+}
+
+func newRedisConsumer(q string, client redis.UniversalClient, meta types.QueueMeta) *RedisConsumer {
+	h := &RedisConsumer{
+		queue:    q,
+		handle:   types.ConsumerRedis,
+		client:   client,
+		stream:   q,
+		group:    redisDefaultGroup,
+		start:    redisDefaultStart,
+		consumer: redisConsumerName(),
+		poll:     redisDefaultPollDelay,
+		claim:    redisDefaultClaimIdle,
+	}
+
+	if meta.RedisStream != "" {
+		h.stream = meta.RedisStream
+	}
+
+	if meta.RedisGroup != "" {
+		h.group = meta.RedisGroup
+	}
+
+	if meta.RedisStart != "" {
+		h.start = meta.RedisStart
+	}
+
+	if meta.PollDelay != nil && *meta.PollDelay > 0 {
+		h.poll = *meta.PollDelay
+	}
+
+	return h
+}
+
+// Close closes the connection to redis
+func (cq *RedisConsumer) Close() error {
+	return cq.client.Close()
+}
+
+// Write appends the payload to the stream
+func (cq *RedisConsumer) Write(ctx context.Context, p []byte) error {
+	return cq.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: cq.stream,
+		Values: map[string]interface{}{redisPayloadKey: p},
+	}).Err()
+}
+
+// Read returns all new messages of the consumer group and the ones
+// left unacknowledged for too long
+//
+// Messages stay pending until they are acknowledged with Ack
+func (cq *RedisConsumer) Read(ctx context.Context) ([]types.QueueMessage, error) {
+	return cq.read(ctx, -1)
+}
+
+// Ack acknowledges the processed messages
+func (cq *RedisConsumer) Ack(ctx context.Context, mm ...types.QueueMessage) error {
+	ids := make([]string, 0, len(mm))
+	for _, m := range mm {
+		if m.ID != "" {
+			ids = append(ids, m.ID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return cq.client.XAck(ctx, cq.stream, cq.group, ids...).Err()
+}
+
+// Subscribe blocks on the stream and sends all new messages
+// to the returned channel until the context is canceled
+func (cq *RedisConsumer) Subscribe(ctx context.Context) <-chan interface{} {
+	ch := make(chan interface{})
+
+	go func() {
+		defer close(ch)
+
+		for {
+			mm, err := cq.read(ctx, cq.poll)
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			if err != nil {
+				// redis is not reachable; wait a bit and try again
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(cq.poll):
+				}
+				continue
+			}
+
+			for _, m := range mm {
+				select {
+				case ch <- m:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Poll ticks in the interval set by the queue's poll delay
+func (cq *RedisConsumer) Poll(ctx context.Context) <-chan time.Time {
+	ch := make(chan time.Time)
+
+	go func() {
+		defer close(ch)
+
+		t := time.NewTicker(cq.poll)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case tick := <-t.C:
+				select {
+				case ch <- tick:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch
+}
+
+func (cq *RedisConsumer) read(ctx context.Context, block time.Duration) (out []types.QueueMessage, err error) {
+	if out, err = cq.reclaim(ctx); err != nil || len(out) > 0 {
+		return
+	}
+
+	ss, err := cq.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    cq.group,
+		Consumer: cq.consumer,
+		Streams:  []string{cq.stream, ">"},
+		Count:    redisReadCount,
+		Block:    block,
+	}).Result()
+
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return
+	}
+
+	for _, s := range ss {
+		out = append(out, cq.messages(s.Messages)...)
+	}
+
+	return
+}
+
+// reclaim takes over the messages that were delivered
+// but not acknowledged in time
+func (cq *RedisConsumer) reclaim(ctx context.Context) ([]types.QueueMessage, error) {
+	mm, _, err := cq.client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   cq.stream,
+		Group:    cq.group,
+		Consumer: cq.consumer,
+		MinIdle:  cq.claim,
+		Start:    "0-0",
+		Count:    redisReadCount,
+	}).Result()
+
+	if err != nil && strings.HasPrefix(err.Error(), "NOGROUP") {
+		// stream or group was removed after the consumer was created
+		return nil, cq.initGroup(ctx)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cq.messages(mm), nil
+}
+
+func (cq *RedisConsumer) messages(mm []redis.XMessage) (out []types.QueueMessage) {
+	for _, m := range mm {
+		out = append(out, types.QueueMessage{
+			ID:      m.ID,
+			Queue:   cq.queue,
+			Payload: redisPayload(m.Values[redisPayloadKey]),
+		})
+	}
+
+	return
+}
+
+// initGroup creates the stream and the consumer group
+// unless they already exist
+//
+// New group starts reading at the configured message ID
+func (cq *RedisConsumer) initGroup(ctx context.Context) error {
+	err := cq.client.XGroupCreateMkStream(ctx, cq.stream, cq.group, cq.start).Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return err
+	}
+
+	return nil
+}
+
+func redisPayload(v interface{}) []byte {
+	switch c := v.(type) {
+	case string:
+		return []byte(c)
+	case []byte:
+		return c
+	default:
+		return nil
+	}
+}
+
+// redisConsumerName identifies this instance inside the consumer group
+func redisConsumerName() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "corteza"
+	}
+
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
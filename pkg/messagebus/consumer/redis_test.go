@@ -0,0 +1,161 @@
+package consumer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/cortezaproject/corteza-server/pkg/messagebus/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_redisConsumer(t *testing.T) {
+	var (
+		ctx = context.Background()
+		req = require.New(t)
+		srv = miniredis.RunT(t)
+
+		delay = time.Millisecond * 10
+	)
+
+	_, err := NewRedisConsumer(ctx, "foobar", types.QueueMeta{})
+	req.Error(err)
+
+	newConsumer := func() *RedisConsumer {
+		c, err := NewRedisConsumer(ctx, "foobar", types.QueueMeta{
+			RedisURL:   "redis://" + srv.Addr(),
+			RedisGroup: "workers",
+			PollDelay:  &delay,
+		})
+		req.NoError(err)
+		return c
+	}
+
+	t.Run("write and read", func(t *testing.T) {
+		_, err := srv.XAdd("foobar", "*", []string{redisPayloadKey, "foo"})
+		req.NoError(err)
+
+		// consumer group is created with the consumer and
+		// reads messages written before that
+		c := newConsumer()
+		req.NoError(c.Write(ctx, []byte("bar")))
+
+		mm, err := c.Read(ctx)
+		req.NoError(err)
+		req.Equal([][]byte{[]byte("foo"), []byte("bar")}, redisTestPayloads(mm))
+		req.NoError(c.Ack(ctx, mm...))
+
+		// messages were acknowledged
+		mm, err = c.Read(ctx)
+		req.NoError(err)
+		req.Empty(mm)
+	})
+
+	t.Run("unacknowledged are claimed", func(t *testing.T) {
+		var (
+			c = newConsumer()
+			o = newConsumer()
+		)
+
+		o.consumer = "crashed"
+		req.NoError(c.Write(ctx, []byte("lost")))
+
+		mm, err := o.Read(ctx)
+		req.NoError(err)
+		req.Equal([][]byte{[]byte("lost")}, redisTestPayloads(mm))
+
+		// not idle long enough
+		mm, err = c.Read(ctx)
+		req.NoError(err)
+		req.Empty(mm)
+
+		c.claim = 0
+		mm, err = c.Read(ctx)
+		req.NoError(err)
+		req.Equal([][]byte{[]byte("lost")}, redisTestPayloads(mm))
+		req.NoError(c.Ack(ctx, mm...))
+
+		mm, err = c.Read(ctx)
+		req.NoError(err)
+		req.Empty(mm)
+	})
+
+	t.Run("start with new messages", func(t *testing.T) {
+		_, err := srv.XAdd("foobar", "*", []string{redisPayloadKey, "old"})
+		req.NoError(err)
+
+		c, err := NewRedisConsumer(ctx, "foobar", types.QueueMeta{
+			RedisURL:   "redis://" + srv.Addr(),
+			RedisGroup: "latest",
+			RedisStart: "$",
+		})
+		req.NoError(err)
+		defer c.Close()
+
+		req.NoError(c.Write(ctx, []byte("new")))
+
+		mm, err := c.Read(ctx)
+		req.NoError(err)
+		req.Equal([][]byte{[]byte("new")}, redisTestPayloads(mm))
+	})
+
+	t.Run("group removed", func(t *testing.T) {
+		c := newConsumer()
+		srv.Del("foobar")
+
+		mm, err := c.Read(ctx)
+		req.NoError(err)
+		req.Empty(mm)
+
+		req.NoError(c.Write(ctx, []byte("again")))
+
+		mm, err = c.Read(ctx)
+		req.NoError(err)
+		req.Equal([][]byte{[]byte("again")}, redisTestPayloads(mm))
+	})
+
+	t.Run("subscribe", func(t *testing.T) {
+		var (
+			c            = newConsumer()
+			sctx, cancel = context.WithCancel(ctx)
+		)
+		defer cancel()
+
+		sub := c.Subscribe(sctx)
+		req.NoError(c.Write(ctx, []byte("baz")))
+
+		select {
+		case m := <-sub:
+			req.Equal("foobar", m.(types.QueueMessage).Queue)
+			req.Equal([]byte("baz"), m.(types.QueueMessage).Payload)
+			req.NotEmpty(m.(types.QueueMessage).ID)
+		case <-time.After(time.Second):
+			req.FailNow("message not received")
+		}
+	})
+
+	t.Run("poll", func(t *testing.T) {
+		var (
+			c            = newConsumer()
+			pctx, cancel = context.WithCancel(ctx)
+		)
+
+		poll := c.Poll(pctx)
+		<-poll
+		cancel()
+
+		_, open := <-poll
+		for open {
+			_, open = <-poll
+		}
+	})
+}
+
+func redisTestPayloads(mm []types.QueueMessage) (out [][]byte) {
+	for _, m := range mm {
+		out = append(out, m.Payload)
+	}
+
+	return
+}
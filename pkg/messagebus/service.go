@@ -3,8 +3,10 @@ package messagebus
 import (
 	"context"
 	"fmt"
+	"io"
 	"sync"
 
+	"github.com/cortezaproject/corteza-server/pkg/eventbus"
 	"github.com/cortezaproject/corteza-server/pkg/messagebus/consumer"
 	"github.com/cortezaproject/corteza-server/pkg/messagebus/store"
 	"github.com/cortezaproject/corteza-server/pkg/messagebus/types"
@@ -28,6 +30,9 @@ type (
 		in     chan types.Message
 		quit   chan bool
 		reload chan bool
+
+		// cancels subscriptions of the currently loaded queues
+		cancel context.CancelFunc
 	}
 )
 
@@ -177,14 +182,31 @@ func (mb *messageBus) initQueues(ctx context.Context) error {
 	mb.mutex.Lock()
 	defer mb.mutex.Unlock()
 
+	// stop subscriptions from previously loaded queues
+	if mb.cancel != nil {
+		mb.cancel()
+	}
+
+	// release connections of previously loaded consumers
+	for _, q := range mb.queues {
+		if c, is := q.Consumer.(io.Closer); is {
+			if err = c.Close(); err != nil {
+				mb.logger.Warn("could not close queue consumer", zap.String("queue", q.Name), zap.Error(err))
+			}
+		}
+	}
+
 	// empty first
 	mb.queues = make(types.QueueSet)
 
+	sctx, cancel := context.WithCancel(ctx)
+	mb.cancel = cancel
+
 	// add to list of consumers
 	for _, q := range list {
 		mb.logger.Debug("initializing queue", zap.String("queue", q.Queue))
 
-		c, err := mb.initConsumer(ctx, q.Queue, q.Consumer)
+		c, err := mb.initConsumer(ctx, q.Queue, q.Consumer, q.Meta)
 
 		if err != nil {
 			mb.logger.Warn("could not init consumer for queue", zap.String("queue", q.Queue), zap.Error(err))
@@ -200,13 +222,49 @@ func (mb *messageBus) initQueues(ctx context.Context) error {
 			Name:     q.Queue,
 			Meta:     types.QueueMeta(q.Meta),
 		})
+
+		if s, is := c.(types.Subscriber); is && q.Meta.DispatchEvents {
+			mb.subscribe(sctx, q.Queue, s)
+		}
 	}
 
 	return nil
 }
 
+// subscribe dispatches queue events for all messages
+// received from the queue producer
+func (mb *messageBus) subscribe(ctx context.Context, q string, s types.Subscriber) {
+	go func() {
+		for m := range s.Subscribe(ctx) {
+			qm, ok := m.(types.QueueMessage)
+			if !ok {
+				continue
+			}
+
+			mb.logger.Debug("dispatching queue message", zap.String("queue", q))
+
+			a, is := s.(types.Acker)
+			if !is {
+				eventbus.Service().Dispatch(ctx, mb.qservicer.CreateQueueEvent(q, qm.Payload))
+				continue
+			}
+
+			// message is acknowledged only when handled,
+			// otherwise it is delivered again
+			if err := eventbus.Service().WaitFor(ctx, mb.qservicer.CreateQueueEvent(q, qm.Payload)); err != nil {
+				mb.logger.Warn("could not handle queue message", zap.String("queue", q), zap.Error(err))
+				continue
+			}
+
+			if err := a.Ack(ctx, qm); err != nil {
+				mb.logger.Warn("could not acknowledge queue message", zap.String("queue", q), zap.Error(err))
+			}
+		}
+	}()
+}
+
 // initHandler returns a new instance for a specific handler
-func (mb *messageBus) initConsumer(ctx context.Context, q string, c string) (cns types.Consumer, err error) {
+func (mb *messageBus) initConsumer(ctx context.Context, q string, c string, meta types.QueueMeta) (cns types.Consumer, err error) {
 	switch c {
 	case string(types.ConsumerEventbus):
 		cns = consumer.NewEventbusConsumer(q, mb.qservicer)
@@ -216,6 +274,15 @@ func (mb *messageBus) initConsumer(ctx context.Context, q string, c string) (cns
 		cns = consumer.NewStoreConsumer(q, mb.qservicer)
 		return
 
+	case string(types.ConsumerRedis):
+		var rc *consumer.RedisConsumer
+		if rc, err = consumer.NewRedisConsumer(ctx, q, meta); err != nil {
+			return
+		}
+
+		cns = rc
+		return
+
 	default:
 		err = fmt.Errorf("message queue consumer %s not implemented", c)
 		return
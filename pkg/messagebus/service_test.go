@@ -35,6 +35,16 @@ type (
 		write        func(ctx context.Context, p []byte) error
 		setStore     func(qs types.QueueStorer)
 	}
+
+	mockClosingHandler struct {
+		mockQueueHandler
+		closed bool
+	}
+
+	mockQueueServicer struct {
+		types.QueueServicer
+		queues []types.QueueDb
+	}
 )
 
 var (
@@ -88,6 +98,32 @@ func Test_consume(t *testing.T) {
 	req.Equal(expectedDb, mockDb)
 }
 
+func Test_initQueuesClosesConsumers(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+
+		mb  = New(mOptions, logger)
+		old = &mockClosingHandler{}
+	)
+
+	mb.qservicer = &mockQueueServicer{}
+	mb.queues["foobar"] = &types.Queue{Name: "foobar", Consumer: old}
+
+	req.NoError(mb.initQueues(ctx))
+	req.True(old.closed)
+	req.Empty(mb.queues)
+}
+
+func (mh *mockClosingHandler) Close() error {
+	mh.closed = true
+	return nil
+}
+
+func (ms *mockQueueServicer) SearchQueues(context.Context, types.QueueFilter) ([]types.QueueDb, types.QueueFilter, error) {
+	return ms.queues, types.QueueFilter{}, nil
+}
+
 func (mh *mockQueueHandler) Notification(ctx context.Context) <-chan interface{} {
 	return mh.notification(ctx)
 }
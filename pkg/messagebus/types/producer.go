@@ -23,4 +23,10 @@ type (
 	Reader interface {
 		Read(ctx context.Context) ([]QueueMessage, error)
 	}
+
+	// Acker is implemented by the producers that keep the messages
+	// until they are acknowledged (processed)
+	Acker interface {
+		Ack(ctx context.Context, mm ...QueueMessage) error
+	}
 )
@@ -14,7 +14,8 @@ import (
 
 type (
 	DBOpt struct {
-		DSN string `env:"DB_DSN"`
+		DSN            string `env:"DB_DSN"`
+		EncryptionKeys string `env:"DB_ENCRYPTION_KEYS"`
 	}
 
 	HTTPClientOpt struct {
@@ -87,6 +88,9 @@ type (
 		LogEnabled           bool          `env:"APIGW_LOG_ENABLED"`
 		ProfilerEnabled      bool          `env:"APIGW_PROFILER_ENABLED"`
 		ProfilerGlobal       bool          `env:"APIGW_PROFILER_GLOBAL"`
+		ProfilerMaxHits      int           `env:"APIGW_PROFILER_MAX_HITS"`
+		ProfilerRetention    time.Duration `env:"APIGW_PROFILER_RETENTION"`
+		ProfilerStore        bool          `env:"APIGW_PROFILER_STORE"`
 		LogRequestBody       bool          `env:"APIGW_LOG_REQUEST_BODY"`
 		ProxyEnableDebugLog  bool          `env:"APIGW_PROXY_ENABLE_DEBUG_LOG"`
 		ProxyFollowRedirects bool          `env:"APIGW_PROXY_FOLLOW_REDIRECTS"`
@@ -251,10 +255,13 @@ type (
 	}
 
 	WorkflowOpt struct {
-		Register          bool `env:"WORKFLOW_REGISTER"`
-		ExecDebug         bool `env:"WORKFLOW_EXEC_DEBUG"`
-		CallStackSize     int  `env:"WORKFLOW_CALL_STACK_SIZE"`
-		StackTraceEnabled bool `env:"WORKFLOW_STACK_TRACE_ENABLED"`
+		Register          bool          `env:"WORKFLOW_REGISTER"`
+		ExecDebug         bool          `env:"WORKFLOW_EXEC_DEBUG"`
+		CallStackSize     int           `env:"WORKFLOW_CALL_STACK_SIZE"`
+		StackTraceEnabled bool          `env:"WORKFLOW_STACK_TRACE_ENABLED"`
+		ClusterEnabled    bool          `env:"WORKFLOW_CLUSTER_ENABLED"`
+		ClusterNode       string        `env:"WORKFLOW_CLUSTER_NODE"`
+		ClusterLeaseTtl   time.Duration `env:"WORKFLOW_CLUSTER_LEASE_TTL"`
 	}
 
 	DiscoveryOpt struct {
@@ -482,6 +489,9 @@ func Apigw() (o *ApigwOpt) {
 		Enabled:              true,
 		ProfilerEnabled:      true,
 		ProfilerGlobal:       false,
+		ProfilerMaxHits:      1000,
+		ProfilerRetention:    time.Hour * 24,
+		ProfilerStore:        false,
 		ProxyFollowRedirects: true,
 		ProxyOutboundTimeout: time.Second * 30,
 	}
@@ -1068,6 +1078,7 @@ func Workflow() (o *WorkflowOpt) {
 		Register:          true,
 		CallStackSize:     16,
 		StackTraceEnabled: true,
+		ClusterLeaseTtl:   time.Second * 30,
 	}
 
 	// Custom defaults
@@ -1,6 +1,8 @@
 package report
 
 import (
+	"fmt"
+
 	"github.com/cortezaproject/corteza-server/pkg/expr"
 	"github.com/cortezaproject/corteza-server/pkg/ql"
 )
@@ -26,8 +28,15 @@ var (
 		"eq": makeCmpHandler(0),
 		"lt": makeCmpHandler(-1),
 		"gt": makeCmpHandler(1),
+		"ne": makeCmpFnHandler(func(c int) bool { return c != 0 }),
+		"le": makeCmpFnHandler(func(c int) bool { return c <= 0 }),
+		"ge": makeCmpFnHandler(func(c int) bool { return c >= 0 }),
+
+		"is":  existenceHandler,
+		"nis": negate(existenceHandler),
+		"not": negate(groupHandler),
 
-		"is": existenceHandler,
+		"group": groupHandler,
 
 		// generic stuff
 		"null":  nullHandler,
@@ -39,14 +48,20 @@ var (
 //
 // This is a simplified bool only implementation as nothing else is needed for now.
 func (d *joinedDataset) eval(n *ql.ASTNode, row FrameRow, cc FrameColumnSet) bool {
-	if v, ok := d.evalRec(n, true, row, cc).(*expr.Boolean); !ok {
+	return evalBool(n, row, cc)
+}
+
+// evalBool evaluates the given AST over the provided frame row
+// and reports if the row matches
+func evalBool(n *ql.ASTNode, row FrameRow, cc FrameColumnSet) bool {
+	if v, ok := evalRec(n, true, row, cc).(*expr.Boolean); !ok {
 		return false
 	} else {
 		return v.GetValue()
 	}
 }
 
-func (d *joinedDataset) evalRec(n *ql.ASTNode, isRoot bool, row FrameRow, cc FrameColumnSet) expr.TypedValue {
+func evalRec(n *ql.ASTNode, isRoot bool, row FrameRow, cc FrameColumnSet) expr.TypedValue {
 	// Leaf edge-cases
 	switch {
 	case n.Symbol != "":
@@ -58,7 +73,7 @@ func (d *joinedDataset) evalRec(n *ql.ASTNode, isRoot bool, row FrameRow, cc Fra
 	// Process arguments for the op.
 	args := make([]expr.TypedValue, len(n.Args))
 	for i, a := range n.Args {
-		s := d.evalRec(a, false, row, cc)
+		s := evalRec(a, false, row, cc)
 		args[i] = s
 	}
 
@@ -66,6 +81,25 @@ func (d *joinedDataset) evalRec(n *ql.ASTNode, isRoot bool, row FrameRow, cc Fra
 	return handlers[n.Ref](args...)
 }
 
+// evalSupported checks if the given AST can be evaluated over the provided columns
+func evalSupported(n *ql.ASTNode, cc FrameColumnSet) (err error) {
+	return n.Traverse(func(n *ql.ASTNode) (bool, *ql.ASTNode, error) {
+		switch {
+		case n.Symbol != "":
+			if cc.Find(n.Symbol) < 0 {
+				return false, n, fmt.Errorf("unknown column: %s", n.Symbol)
+			}
+		case n.Value != nil:
+		default:
+			if _, ok := handlers[n.Ref]; !ok {
+				return false, n, fmt.Errorf("unsupported operation: %s", n.Ref)
+			}
+		}
+
+		return true, n, nil
+	})
+}
+
 func andHandler(aa ...expr.TypedValue) expr.TypedValue {
 	for _, a := range aa {
 		if v, ok := a.(*expr.Boolean); !ok || !v.GetValue() {
@@ -85,12 +119,16 @@ func orHandler(aa ...expr.TypedValue) expr.TypedValue {
 }
 
 func makeCmpHandler(val int) HandlerSig {
+	return makeCmpFnHandler(func(c int) bool { return c == val })
+}
+
+func makeCmpFnHandler(fn func(c int) bool) HandlerSig {
 	return func(aa ...expr.TypedValue) expr.TypedValue {
 		a := aa[0].(expr.Comparable)
 		b := aa[1]
 
 		c, _ := a.Compare(b)
-		return expr.Must(expr.NewBoolean(c == val))
+		return expr.Must(expr.NewBoolean(fn(c)))
 	}
 }
 
@@ -108,3 +146,18 @@ func existenceHandler(aa ...expr.TypedValue) expr.TypedValue {
 
 	return expr.Must(expr.NewBoolean(a == b))
 }
+
+func groupHandler(aa ...expr.TypedValue) expr.TypedValue {
+	if len(aa) == 0 {
+		return nil
+	}
+	return aa[0]
+}
+
+// negate inverts the boolean result of the given handler
+func negate(h HandlerSig) HandlerSig {
+	return func(aa ...expr.TypedValue) expr.TypedValue {
+		v, ok := h(aa...).(*expr.Boolean)
+		return expr.Must(expr.NewBoolean(!ok || !v.GetValue()))
+	}
+}
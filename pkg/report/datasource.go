@@ -36,8 +36,6 @@ type (
 		Datasource
 		Partition(partitionSize uint, partitionCol string) (bool, error)
 	}
-
-	// @todo TransformableDatasource
 )
 
 const (
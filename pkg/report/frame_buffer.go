@@ -0,0 +1,401 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/spf13/cast"
+)
+
+// Utilities for steps that can not offload the work to the underlying
+// datasource and need to process the rows in memory.
+
+const (
+	frameBufferChunkSize = 500
+
+	// max number of rows loaded into memory from a single datasource
+	frameBufferMaxRows = 100000
+)
+
+var (
+	errRowLimitExceeded = fmt.Errorf("exceeds the limit of %d rows", frameBufferMaxRows)
+)
+
+type (
+	// frameBuffer holds the processed rows and hands them out in chunks
+	frameBuffer struct {
+		meta *Frame
+		rows FrameRowSet
+		sort filter.SortExprSet
+
+		// hasPrev is set when rows before the buffered ones exist
+		hasPrev bool
+		// reversed is set when the buffer holds rows before the page cursor
+		reversed bool
+		// loaded is set after the first chunk is provided
+		loaded bool
+	}
+)
+
+// loadAllRows pulls all of the rows from the given datasource
+//
+// The datasource must provide a single frame; rows of all of the pulled
+// chunks are merged. Loading fails when the datasource provides more
+// than max rows.
+func loadAllRows(ctx context.Context, ds Datasource, def *FrameDefinition, max int) (cc FrameColumnSet, rr FrameRowSet, err error) {
+	l, c, err := ds.Load(ctx, def)
+	if err != nil {
+		return
+	}
+	if c != nil {
+		defer c()
+	}
+
+	var ff []*Frame
+	for {
+		ff, err = l(frameBufferChunkSize, false)
+		if err != nil {
+			return
+		}
+
+		size := 0
+		for _, f := range ff {
+			if cc == nil {
+				cc = f.Columns
+			}
+
+			size += f.Size()
+			rr = append(rr, f.Rows...)
+		}
+
+		if size == 0 {
+			return
+		}
+
+		if len(rr) > max {
+			return nil, nil, fmt.Errorf("datasource %s %w", ds.Name(), errRowLimitExceeded)
+		}
+	}
+}
+
+// uniqueSort assures the sort contains a unique column so the rows
+// can be unambiguously paged
+func uniqueSort(ss filter.SortExprSet, cc FrameColumnSet) filter.SortExprSet {
+	ss = ss.Clone()
+
+	for _, c := range cc {
+		if !(c.Primary || c.Unique) {
+			continue
+		}
+
+		if ss.Get(c.Name) == nil {
+			ss = append(ss, &filter.SortExpr{Column: c.Name, Descending: ss.LastDescending()})
+		}
+		break
+	}
+
+	return ss
+}
+
+// sortRows sorts the rows by the given sort expressions
+func sortRows(rr FrameRowSet, cc FrameColumnSet, ss filter.SortExprSet) error {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	ixx := make([]int, len(ss))
+	for i, s := range ss {
+		ixx[i] = cc.Find(s.Column)
+		if ixx[i] < 0 {
+			return fmt.Errorf("unable to sort by unknown column: %s", s.Column)
+		}
+	}
+
+	sort.SliceStable(rr, func(i, j int) bool {
+		for k, s := range ss {
+			c := compareCells(rr[i][ixx[k]], rr[j][ixx[k]])
+			if c == 0 {
+				continue
+			}
+
+			if s.Descending {
+				return c > 0
+			}
+			return c < 0
+		}
+
+		return false
+	})
+
+	return nil
+}
+
+// compareCells compares the two cells; null values come first
+func compareCells(a, b expr.TypedValue) int {
+	switch {
+	case isNil(a) && isNil(b):
+		return 0
+	case isNil(a):
+		return -1
+	case isNil(b):
+		return 1
+	}
+
+	// time is compared directly as the DateTime comparator doesn't take
+	// the entire value into account
+	if at, ok := cellTime(a); ok {
+		if bt, ok := cellTime(b); ok {
+			switch {
+			case at.Before(bt):
+				return -1
+			case at.After(bt):
+				return 1
+			default:
+				return 0
+			}
+		}
+	}
+
+	if ac, ok := a.(expr.Comparable); ok {
+		if c, err := ac.Compare(b); err == nil {
+			return c
+		}
+	}
+
+	return strings.Compare(cast.ToString(a.Get()), cast.ToString(b.Get()))
+}
+
+func cellTime(v expr.TypedValue) (time.Time, bool) {
+	switch c := v.Get().(type) {
+	case time.Time:
+		return c, true
+	case *time.Time:
+		if c == nil {
+			return time.Time{}, false
+		}
+		return *c, true
+	}
+
+	return time.Time{}, false
+}
+
+// newFrameBuffer prepares the buffer for the given sorted rows
+//
+// When the page cursor is provided, only the rows after it (or before it,
+// when navigating to the previous page) are buffered.
+func newFrameBuffer(meta *Frame, rr FrameRowSet, ss filter.SortExprSet, cur *filter.PagingCursor) (b *frameBuffer, err error) {
+	b = &frameBuffer{
+		meta: meta,
+		rows: rr,
+		sort: ss,
+	}
+
+	if cur == nil || len(cur.Keys()) == 0 {
+		return
+	}
+
+	// cast cursor values so they can be compared with the cells
+	var (
+		keys   = cur.Keys()
+		values = cur.Values()
+		desc   = cur.Desc()
+
+		ixx = make([]int, len(keys))
+		cvv = make([]expr.TypedValue, len(keys))
+	)
+
+	for i, k := range keys {
+		ixx[i] = meta.Columns.Find(k)
+		if ixx[i] < 0 {
+			return nil, fmt.Errorf("invalid page cursor: unknown column: %s", k)
+		}
+
+		if values[i] == nil {
+			continue
+		}
+
+		c := meta.Columns[ixx[i]]
+		if c.Caster == nil {
+			cvv[i], err = expr.NewAny(values[i])
+		} else {
+			cvv[i], err = c.Caster(values[i])
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid page cursor: %w", err)
+		}
+	}
+
+	// cmp tells where the row is positioned relative to the cursor
+	cmp := func(r FrameRow) int {
+		for k := range keys {
+			c := compareCells(r[ixx[k]], cvv[k])
+			if c == 0 {
+				continue
+			}
+
+			if desc[k] {
+				return -c
+			}
+			return c
+		}
+
+		return 0
+	}
+
+	b.hasPrev = true
+	if cur.IsROrder() {
+		b.reversed = true
+		b.rows = rr[:sort.Search(len(rr), func(i int) bool { return cmp(rr[i]) >= 0 })]
+	} else {
+		b.rows = rr[sort.Search(len(rr), func(i int) bool { return cmp(rr[i]) > 0 }):]
+	}
+
+	return
+}
+
+// loader returns the Loader over the buffered rows
+func (b *frameBuffer) loader() Loader {
+	return func(cap int, processed bool) ([]*Frame, error) {
+		if len(b.rows) == 0 {
+			if b.loaded {
+				return nil, nil
+			}
+
+			// empty response still describes the frame
+			b.loaded = true
+			return []*Frame{b.meta.CloneMeta()}, nil
+		}
+		b.loaded = true
+
+		if cap <= 0 || cap > len(b.rows) {
+			cap = len(b.rows)
+		}
+
+		var (
+			out = b.meta.CloneMeta()
+
+			hasPrev, hasNext bool
+		)
+
+		out.Sort = b.sort
+		if b.reversed {
+			// the rows closest to the cursor make up the previous page
+			out.Rows = b.rows[len(b.rows)-cap:]
+			hasPrev = len(b.rows) > cap
+			hasNext = true
+			b.rows = nil
+		} else {
+			out.Rows = b.rows[:cap]
+			b.rows = b.rows[cap:]
+			hasPrev = b.hasPrev
+			hasNext = len(b.rows) > 0
+			b.hasPrev = true
+		}
+
+		if !processed || len(b.sort) == 0 {
+			return []*Frame{out}, nil
+		}
+
+		if out.Paging == nil {
+			out.Paging = &filter.Paging{}
+		}
+		out.Paging.PrevPage = nil
+		out.Paging.NextPage = nil
+
+		if hasPrev {
+			out.Paging.PrevPage = out.CollectCursorValues(out.FirstRow(), b.sort...)
+			out.Paging.PrevPage.ROrder = true
+			out.Paging.PrevPage.LThen = !b.sort.Reversed()
+		}
+
+		if hasNext {
+			out.Paging.NextPage = out.CollectCursorValues(out.LastRow(), b.sort...)
+			out.Paging.NextPage.LThen = b.sort.Reversed()
+		}
+
+		return []*Frame{out}, nil
+	}
+}
+
+// filterRows omits the rows not matching the filter
+func filterRows(f *Filter, cc FrameColumnSet, rr FrameRowSet) (FrameRowSet, error) {
+	if f == nil || f.ASTNode == nil {
+		return rr, nil
+	}
+
+	if f.Error != "" {
+		return nil, fmt.Errorf("invalid filter: %s", f.Error)
+	}
+
+	if err := evalSupported(f.ASTNode, cc); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+
+	out := make(FrameRowSet, 0, len(rr))
+	for _, r := range rr {
+		if evalBool(f.ASTNode, r, cc) {
+			out = append(out, r)
+		}
+	}
+
+	return out, nil
+}
+
+// requestedColumns returns the columns the frame definition asks for
+//
+// All of the columns are returned when the definition doesn't specify any.
+func requestedColumns(def *FrameDefinition, cc FrameColumnSet) (FrameColumnSet, error) {
+	if len(def.Columns) == 0 {
+		return nil, nil
+	}
+
+	out := make(FrameColumnSet, 0, len(def.Columns))
+	for _, c := range def.Columns {
+		i := cc.Find(c.Name)
+		if i < 0 {
+			return nil, fmt.Errorf("unknown column: %s", c.Name)
+		}
+		out = append(out, cc[i])
+	}
+
+	return out, nil
+}
+
+// selectColumns wraps the loader to only provide the requested columns
+//
+// The loader is returned as is when no columns are requested.
+func selectColumns(l Loader, cc FrameColumnSet) Loader {
+	if len(cc) == 0 {
+		return l
+	}
+
+	return func(cap int, processed bool) ([]*Frame, error) {
+		ff, err := l(cap, processed)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, f := range ff {
+			ixx := make([]int, len(cc))
+			for i, c := range cc {
+				ixx[i] = f.Columns.Find(c.Name)
+			}
+
+			for i, r := range f.Rows {
+				row := make(FrameRow, len(cc))
+				for j, ix := range ixx {
+					row[j] = r[ix]
+				}
+				f.Rows[i] = row
+			}
+			f.Columns = cc
+		}
+
+		return ff, nil
+	}
+}
@@ -37,10 +37,11 @@ type (
 	StepDefinition    struct {
 		Kind string `json:"kind,omitempty"`
 
-		Load  *LoadStepDefinition  `json:"load,omitempty"`
-		Join  *JoinStepDefinition  `json:"join,omitempty"`
-		Group *GroupStepDefinition `json:"group,omitempty"`
-		// @todo Transform
+		Load      *LoadStepDefinition      `json:"load,omitempty"`
+		Join      *JoinStepDefinition      `json:"join,omitempty"`
+		Group     *GroupStepDefinition     `json:"group,omitempty"`
+		Transform *TransformStepDefinition `json:"transform,omitempty"`
+		Union     *UnionStepDefinition     `json:"union,omitempty"`
 	}
 )
 
@@ -67,7 +68,11 @@ func Model(ctx context.Context, sources map[string]DatasourceProvider, dd ...*St
 			case d.Group != nil:
 				steps = append(steps, &stepGroup{def: d.Group})
 
-			// @todo Transform
+			case d.Transform != nil:
+				steps = append(steps, &stepTransform{def: d.Transform})
+
+			case d.Union != nil:
+				steps = append(steps, &stepUnion{def: d.Union})
 
 			default:
 				return fmt.Errorf("malformed step definition: unsupported step kind")
@@ -95,6 +100,8 @@ func (ss StepDefinitionSet) Validate() error {
 			f = s.Join.Filter
 		case s.Group != nil:
 			f = s.Group.Filter
+		case s.Transform != nil:
+			f = s.Transform.Filter
 		}
 		if f != nil && f.Error != "" {
 			return errors.InvalidData(f.Error)
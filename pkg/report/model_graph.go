@@ -108,7 +108,7 @@ func (m *model) reduceBranch(ctx context.Context, n *modelGraphNode) (out Dataso
 		}
 	}
 
-	// for now only the join step expects multiple inputs; it can't be reduced
+	// steps with multiple inputs (join, union) can't be reduced
 	if len(auxO) > 1 {
 		return n.step.Run(ctx, auxO...)
 	}
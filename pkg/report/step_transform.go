@@ -0,0 +1,409 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+)
+
+type (
+	stepTransform struct {
+		def *TransformStepDefinition
+	}
+
+	transformedDataset struct {
+		def *TransformStepDefinition
+		ds  Datasource
+
+		// evaluables for the derived columns; same order as def.Columns
+		evals []expr.Evaluable
+	}
+
+	// TransformStepDefinition produces a new datasource from the source one
+	//
+	// The transformations are applied in the following order:
+	//   . derived columns are computed; later columns may use earlier ones
+	//   . rows are filtered
+	//   . columns are renamed
+	//   . columns are dropped
+	//   . rows are sorted
+	TransformStepDefinition struct {
+		Name    string             `json:"name"`
+		Source  string             `json:"source"`
+		Columns []*TransformColumn `json:"columns,omitempty"`
+		Filter  *Filter            `json:"filter,omitempty"`
+		Rename  map[string]string  `json:"rename,omitempty"`
+		Drop    []string           `json:"drop,omitempty"`
+		Sort    filter.SortExprSet `json:"sort,omitempty"`
+	}
+
+	// TransformColumn defines a derived column
+	TransformColumn struct {
+		Name  string `json:"name"`
+		Label string `json:"label"`
+		Kind  string `json:"kind"`
+
+		// Expr is evaluated over the source row; columns are available as variables
+		Expr string `json:"expr"`
+	}
+)
+
+func (j *stepTransform) Run(ctx context.Context, dd ...Datasource) (Datasource, error) {
+	if len(dd) == 0 {
+		return nil, fmt.Errorf("unknown transform dimension: %s", j.def.Source)
+	}
+
+	if len(dd[0].Describe()) != 1 {
+		return nil, fmt.Errorf("unable to transform a multi-frame source: %s", dd[0].Name())
+	}
+
+	evals, err := j.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	ds := &transformedDataset{
+		def:   j.def,
+		ds:    dd[0],
+		evals: evals,
+	}
+
+	if err = ds.validateColumns(ds.derivedColumns(dd[0].Describe()[0].Columns)); err != nil {
+		return nil, fmt.Errorf("invalid transform step: %w", err)
+	}
+
+	return ds, nil
+}
+
+func (j *stepTransform) Validate() error {
+	pfx := "invalid transform step: "
+
+	// base things...
+	switch {
+	case j.def.Name == "":
+		return errors.New(pfx + "dimension name not defined")
+
+	case j.def.Source == "":
+		return errors.New(pfx + "transform dimension not defined")
+	}
+
+	// columns...
+	for i, c := range j.def.Columns {
+		if c.Name == "" {
+			return fmt.Errorf("%scolumn key alias missing for column: %d", pfx, i)
+		}
+		if c.Expr == "" {
+			return fmt.Errorf("%scolumn expression missing for column: %s", pfx, c.Name)
+		}
+	}
+
+	renamed := make(map[string]string, len(j.def.Rename))
+	for from, to := range j.def.Rename {
+		if to == "" {
+			return fmt.Errorf("%scolumn rename target missing for column: %s", pfx, from)
+		}
+		if other, ok := renamed[to]; ok {
+			return fmt.Errorf("%scolumns %s and %s renamed to the same column: %s", pfx, other, from, to)
+		}
+		renamed[to] = from
+	}
+
+	if _, err := j.parse(); err != nil {
+		return fmt.Errorf("%s%w", pfx, err)
+	}
+
+	return nil
+}
+
+func (d *stepTransform) Name() string {
+	return d.def.Name
+}
+
+func (d *stepTransform) Source() []string {
+	return []string{d.def.Source}
+}
+
+func (d *stepTransform) Def() *StepDefinition {
+	return &StepDefinition{Transform: d.def}
+}
+
+func (d *stepTransform) parse() (out []expr.Evaluable, err error) {
+	p := expr.NewParser()
+	out = make([]expr.Evaluable, len(d.def.Columns))
+
+	for i, c := range d.def.Columns {
+		if out[i], err = p.Parse(c.Expr); err != nil {
+			return nil, fmt.Errorf("invalid expression for column %s: %w", c.Name, err)
+		}
+	}
+
+	return
+}
+
+// // // //
+
+func (d *transformedDataset) Name() string {
+	return d.def.Name
+}
+
+func (d *transformedDataset) Describe() FrameDescriptionSet {
+	dscr := d.ds.Describe()
+	if len(dscr) == 0 {
+		return nil
+	}
+
+	return FrameDescriptionSet{
+		&FrameDescription{
+			Source:  d.Name(),
+			Columns: d.outputColumns(d.derivedColumns(dscr[0].Columns)),
+		},
+	}
+}
+
+func (d *transformedDataset) Load(ctx context.Context, dd ...*FrameDefinition) (l Loader, c Closer, err error) {
+	if len(dd) == 0 {
+		return nil, nil, errors.New("transforming requires at least one frame definition")
+	}
+	def := dd[0]
+
+	// Source data
+	//
+	// Nothing can be offloaded to the source since all of the definitions
+	// may reference derived columns.
+	cc, rr, err := loadAllRows(ctx, d.ds, &FrameDefinition{
+		Name:   def.Name,
+		Source: d.def.Source,
+		Paging: &filter.Paging{},
+		Sort:   filter.SortExprSet{},
+	}, frameBufferMaxRows)
+	if err != nil {
+		return
+	}
+	if cc == nil {
+		cc = d.ds.Describe()[0].Columns
+	}
+
+	// . derived columns
+	cc = d.derivedColumns(cc)
+	if rr, err = d.derive(ctx, cc, rr); err != nil {
+		return
+	}
+
+	// . filtering
+	if rr, err = filterRows(d.def.Filter, cc, rr); err != nil {
+		return
+	}
+
+	// . rename & drop
+	occ := d.outputColumns(cc)
+	if rr, err = d.project(cc, occ, rr); err != nil {
+		return
+	}
+	cc = occ
+
+	// Frame definition
+	//
+	// Apply the requested filter & sort over the output columns.
+	if rr, err = filterRows(def.Filter, cc, rr); err != nil {
+		return
+	}
+
+	ss := def.Sort
+	if len(ss) == 0 {
+		ss = d.def.Sort
+	}
+	ss = uniqueSort(ss, cc)
+	if err = sortRows(rr, cc, ss); err != nil {
+		return
+	}
+
+	// only return the requested columns
+	if occ, err = requestedColumns(def, cc); err != nil {
+		return
+	}
+
+	meta := &Frame{
+		Name:    def.Name,
+		Source:  d.Name(),
+		Ref:     def.Ref,
+		Columns: cc,
+		Paging:  def.Paging.Clone(),
+		Filter:  def.Filter.Clone(),
+	}
+
+	var cur *filter.PagingCursor
+	if def.Paging != nil {
+		cur = def.Paging.PageCursor
+	}
+
+	b, err := newFrameBuffer(meta, rr, ss, cur)
+	if err != nil {
+		return
+	}
+
+	// cut the columns after paging cursors are resolved
+	return selectColumns(b.loader(), occ), func() {}, nil
+}
+
+// derivedColumns returns the source columns extended with derived ones
+//
+// Derived columns with the same name replace the source ones.
+func (d *transformedDataset) derivedColumns(cc FrameColumnSet) FrameColumnSet {
+	out := cc.Clone()
+
+	for _, tc := range d.def.Columns {
+		kind := tc.Kind
+		if kind == "" {
+			kind = "String"
+		}
+
+		c := MakeColumnOfKind(kind)
+		c.Name = tc.Name
+		c.Label = tc.Label
+		if c.Label == "" {
+			c.Label = c.Name
+		}
+
+		if i := out.Find(c.Name); i > -1 {
+			out[i] = c
+		} else {
+			out = append(out, c)
+		}
+	}
+
+	return out
+}
+
+// validateColumns checks that the renamed and dropped columns
+// exist in the source or are derived by the step
+func (d *transformedDataset) validateColumns(cc FrameColumnSet) error {
+	for from, to := range d.def.Rename {
+		if cc.Find(from) < 0 {
+			return fmt.Errorf("unknown column renamed to %s: %s", to, from)
+		}
+
+		if _, ok := d.def.Rename[to]; !ok && cc.Find(to) > -1 {
+			return fmt.Errorf("column %s renamed to existing column: %s", from, to)
+		}
+	}
+
+	for _, n := range d.def.Drop {
+		if cc.Find(n) > -1 {
+			continue
+		}
+
+		var renamed bool
+		for _, to := range d.def.Rename {
+			renamed = renamed || to == n
+		}
+
+		if !renamed {
+			return fmt.Errorf("unknown column dropped: %s", n)
+		}
+	}
+
+	return nil
+}
+
+// outputColumns applies renames and drops to the columns
+func (d *transformedDataset) outputColumns(cc FrameColumnSet) FrameColumnSet {
+	drop := make(map[string]bool, len(d.def.Drop))
+	for _, n := range d.def.Drop {
+		drop[n] = true
+	}
+
+	out := make(FrameColumnSet, 0, len(cc))
+	for _, c := range cc.Clone() {
+		if drop[c.Name] {
+			continue
+		}
+
+		if to, ok := d.def.Rename[c.Name]; ok {
+			c.Name = to
+			if drop[c.Name] {
+				continue
+			}
+		}
+
+		out = append(out, c)
+	}
+
+	return out
+}
+
+// derive computes the derived columns for each row
+func (d *transformedDataset) derive(ctx context.Context, cc FrameColumnSet, rr FrameRowSet) (FrameRowSet, error) {
+	if len(d.def.Columns) == 0 {
+		return rr, nil
+	}
+
+	var (
+		out = make(FrameRowSet, len(rr))
+		ixx = make([]int, len(d.def.Columns))
+	)
+
+	for i, c := range d.def.Columns {
+		ixx[i] = cc.Find(c.Name)
+	}
+
+	for i, r := range rr {
+		row := make(FrameRow, len(cc))
+		copy(row, r)
+
+		vars, err := row.ToVars(cc)
+		if err != nil {
+			return nil, err
+		}
+
+		for j, e := range d.evals {
+			v, err := e.Eval(ctx, vars)
+			if err != nil || v == nil {
+				// rows with values that can't be processed (missing values,
+				// division by zero, ...) are given an empty cell
+				row[ixx[j]] = nil
+			} else if row[ixx[j]], err = cc[ixx[j]].Caster(v); err != nil {
+				row[ixx[j]] = nil
+			}
+
+			_ = vars.AssignFieldValue(cc[ixx[j]].Name, row[ixx[j]])
+		}
+
+		out[i] = row
+	}
+
+	return out, nil
+}
+
+// project rearranges the row cells to match the output columns
+func (d *transformedDataset) project(cc, occ FrameColumnSet, rr FrameRowSet) (FrameRowSet, error) {
+	rename := make(map[string]string, len(d.def.Rename))
+	for from, to := range d.def.Rename {
+		rename[to] = from
+	}
+
+	ixx := make([]int, len(occ))
+	for i, c := range occ {
+		n := c.Name
+		if from, ok := rename[n]; ok {
+			n = from
+		}
+		if ixx[i] = cc.Find(n); ixx[i] < 0 {
+			return nil, fmt.Errorf("unknown source column for column: %s", c.Name)
+		}
+	}
+
+	for i, r := range rr {
+		row := make(FrameRow, len(occ))
+		for j, ix := range ixx {
+			if ix < len(r) {
+				row[j] = r[ix]
+			}
+		}
+		rr[i] = row
+	}
+
+	return rr, nil
+}
@@ -0,0 +1,274 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+)
+
+type (
+	stepUnion struct {
+		def *UnionStepDefinition
+	}
+
+	unionedDataset struct {
+		def *UnionStepDefinition
+		dd  []Datasource
+	}
+
+	// UnionStepDefinition stacks rows of the source datasources
+	//
+	// Columns are aligned by name; columns that are not defined by all
+	// sources are left empty for the rows of the sources that omit them.
+	UnionStepDefinition struct {
+		Name    string   `json:"name"`
+		Sources []string `json:"sources"`
+
+		// SourceColumn, when set, adds a column with the name of
+		// the datasource the row originates from
+		SourceColumn string `json:"sourceColumn,omitempty"`
+	}
+)
+
+func (j *stepUnion) Run(ctx context.Context, dd ...Datasource) (Datasource, error) {
+	if len(dd) != len(j.def.Sources) {
+		return nil, fmt.Errorf("unknown union datasources")
+	}
+
+	for _, d := range dd {
+		if len(d.Describe()) != 1 {
+			return nil, fmt.Errorf("unable to union a multi-frame source: %s", d.Name())
+		}
+	}
+
+	ds := &unionedDataset{
+		def: j.def,
+		dd:  dd,
+	}
+
+	if _, err := ds.columns(); err != nil {
+		return nil, err
+	}
+
+	return ds, nil
+}
+
+func (j *stepUnion) Validate() error {
+	pfx := "invalid union step: "
+
+	switch {
+	case j.def.Name == "":
+		return errors.New(pfx + "dimension name not defined")
+
+	case len(j.def.Sources) < 2:
+		return errors.New(pfx + "at least two dimensions must be defined")
+	}
+
+	seen := make(map[string]bool, len(j.def.Sources))
+	for _, s := range j.def.Sources {
+		if s == "" {
+			return errors.New(pfx + "dimension not defined")
+		}
+		if seen[s] {
+			return fmt.Errorf("%sduplicated dimension: %s", pfx, s)
+		}
+		seen[s] = true
+	}
+
+	return nil
+}
+
+func (d *stepUnion) Name() string {
+	return d.def.Name
+}
+
+func (d *stepUnion) Source() []string {
+	return d.def.Sources
+}
+
+func (d *stepUnion) Def() *StepDefinition {
+	return &StepDefinition{Union: d.def}
+}
+
+// // // //
+
+func (d *unionedDataset) Name() string {
+	return d.def.Name
+}
+
+func (d *unionedDataset) Describe() FrameDescriptionSet {
+	cc, err := d.columns()
+	if err != nil {
+		return nil
+	}
+
+	return FrameDescriptionSet{
+		&FrameDescription{
+			Source:  d.Name(),
+			Columns: cc,
+		},
+	}
+}
+
+func (d *unionedDataset) Load(ctx context.Context, dd ...*FrameDefinition) (l Loader, c Closer, err error) {
+	if len(dd) == 0 {
+		return nil, nil, errors.New("union requires at least one frame definition")
+	}
+	def := dd[0]
+
+	cc, err := d.columns()
+	if err != nil {
+		return
+	}
+
+	// Stack the rows of all of the sources
+	//
+	// Rows are sorted and paged over the combined result so
+	// nothing can be offloaded to the sources; all of the sources
+	// together are bound by the same row limit as a single one.
+	var rr FrameRowSet
+	for _, ds := range d.dd {
+		var (
+			scc FrameColumnSet
+			srr FrameRowSet
+		)
+
+		scc, srr, err = loadAllRows(ctx, ds, &FrameDefinition{
+			Name:   def.Name,
+			Source: ds.Name(),
+			Paging: &filter.Paging{},
+			Sort:   filter.SortExprSet{},
+		}, frameBufferMaxRows-len(rr))
+		if errors.Is(err, errRowLimitExceeded) {
+			return nil, nil, fmt.Errorf("union %s %w", d.Name(), errRowLimitExceeded)
+		}
+		if err != nil {
+			return
+		}
+
+		if rr, err = d.alignRows(rr, ds.Name(), cc, scc, srr); err != nil {
+			return
+		}
+	}
+
+	// Frame definition
+	if rr, err = filterRows(def.Filter, cc, rr); err != nil {
+		return
+	}
+
+	ss := uniqueSort(def.Sort, cc)
+	if err = sortRows(rr, cc, ss); err != nil {
+		return
+	}
+
+	occ, err := requestedColumns(def, cc)
+	if err != nil {
+		return
+	}
+
+	var cur *filter.PagingCursor
+	if def.Paging != nil {
+		cur = def.Paging.PageCursor
+	}
+
+	b, err := newFrameBuffer(&Frame{
+		Name:    def.Name,
+		Source:  d.Name(),
+		Ref:     def.Ref,
+		Columns: cc,
+		Paging:  def.Paging.Clone(),
+		Filter:  def.Filter.Clone(),
+	}, rr, ss, cur)
+	if err != nil {
+		return
+	}
+
+	// cut the columns after paging cursors are resolved
+	return selectColumns(b.loader(), occ), func() {}, nil
+}
+
+// columns aligns the columns of all of the sources
+//
+// Columns with the same name must be of the same kind; a column is
+// primary (or unique) only when it is such in all of the sources.
+func (d *unionedDataset) columns() (out FrameColumnSet, err error) {
+	count := make(map[string]int)
+
+	for _, ds := range d.dd {
+		for _, c := range ds.Describe()[0].Columns {
+			i := out.Find(c.Name)
+			if i < 0 {
+				out = append(out, FrameColumnSet{c}.Clone()...)
+				count[c.Name]++
+				continue
+			}
+
+			if out[i].Kind != c.Kind {
+				return nil, fmt.Errorf("unable to union column %s: kind mismatch: %s, %s", c.Name, out[i].Kind, c.Kind)
+			}
+
+			out[i].Primary = out[i].Primary && c.Primary
+			out[i].Unique = out[i].Unique && c.Unique
+			count[c.Name]++
+		}
+	}
+
+	// columns missing from some sources can't identify the row
+	for _, c := range out {
+		if count[c.Name] != len(d.dd) {
+			c.Primary = false
+			c.Unique = false
+		}
+	}
+
+	if d.def.SourceColumn != "" {
+		if out.Find(d.def.SourceColumn) > -1 {
+			return nil, fmt.Errorf("unable to add source column %s: column already defined", d.def.SourceColumn)
+		}
+
+		c := MakeColumnOfKind("String")
+		c.Name = d.def.SourceColumn
+		c.Label = d.def.SourceColumn
+		out = append(out, c)
+	}
+
+	return
+}
+
+// alignRows appends the source rows to the output rows
+func (d *unionedDataset) alignRows(rr FrameRowSet, source string, cc, scc FrameColumnSet, srr FrameRowSet) (FrameRowSet, error) {
+	ixx := make([]int, len(cc))
+	for i, c := range cc {
+		ixx[i] = scc.Find(c.Name)
+	}
+
+	var (
+		src expr.TypedValue
+		err error
+	)
+	if d.def.SourceColumn != "" {
+		if src, err = expr.NewString(source); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, r := range srr {
+		row := make(FrameRow, len(cc))
+		for i, ix := range ixx {
+			if ix > -1 && ix < len(r) {
+				row[i] = r[ix]
+			}
+		}
+
+		if src != nil {
+			row[len(row)-1] = src
+		}
+
+		rr = append(rr, row)
+	}
+
+	return rr, nil
+}
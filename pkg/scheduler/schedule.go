@@ -0,0 +1,232 @@
+package scheduler
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/eventbus"
+	"github.com/gorhill/cronexpr"
+
+	// timezones are embedded so that schedules can be
+	// evaluated on systems without the timezone database
+	_ "time/tzdata"
+)
+
+type (
+	// Calendar reports if the given day is a holiday
+	Calendar interface {
+		IsHoliday(day time.Time) bool
+	}
+
+	// Schedule is a set of crontab expressions evaluated in
+	// the given timezone with optional holiday calendars
+	//
+	// Schedule is used as eventbus constraint matcher for interval events
+	Schedule struct {
+		expressions []*cronexpr.Expression
+		values      []string
+
+		location *time.Location
+
+		// holiday calendars
+		calendars []string
+
+		// when true, schedule matches only on holidays,
+		// otherwise holidays are skipped
+		onHolidays bool
+	}
+
+	ScheduleOpt func(s *Schedule) error
+)
+
+const (
+	// how far in the future are fire times searched
+	scheduleHorizon = time.Hour * 24 * 366
+
+	// how many fire times can be skipped (due to holidays)
+	// before the search is stopped
+	scheduleMaxSkipped = 10000
+)
+
+var (
+	calendars = struct {
+		sync.RWMutex
+		set map[string]Calendar
+	}{set: make(map[string]Calendar)}
+)
+
+// SetCalendars replaces all known holiday calendars
+func SetCalendars(cc map[string]Calendar) {
+	calendars.Lock()
+	defer calendars.Unlock()
+	calendars.set = cc
+}
+
+// isHoliday returns true if day is a holiday in any of the given calendars
+//
+// Unknown calendars are ignored
+func isHoliday(day time.Time, hh ...string) bool {
+	calendars.RLock()
+	defer calendars.RUnlock()
+
+	for _, h := range hh {
+		if c, has := calendars.set[h]; has && c.IsHoliday(day) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewSchedule parses crontab expressions and returns schedule
+func NewSchedule(ee []string, oo ...ScheduleOpt) (s *Schedule, err error) {
+	s = &Schedule{location: time.Local}
+
+	for _, e := range ee {
+		if e = strings.TrimSpace(e); len(e) == 0 {
+			// skip empty values
+			continue
+		}
+
+		exp, err := cronexpr.Parse(e)
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval %q: %w", e, err)
+		}
+
+		s.values = append(s.values, e)
+		s.expressions = append(s.expressions, exp)
+	}
+
+	for _, o := range oo {
+		if err = o(s); err != nil {
+			return nil, err
+		}
+	}
+
+	return
+}
+
+// InTimezone sets the timezone schedule is evaluated in
+//
+// Expects IANA timezone name (e.g. Europe/Ljubljana); server-local timezone is used when empty
+func InTimezone(tz string) ScheduleOpt {
+	return func(s *Schedule) (err error) {
+		if tz = strings.TrimSpace(tz); tz == "" {
+			return
+		}
+
+		if s.location, err = time.LoadLocation(tz); err != nil {
+			return fmt.Errorf("invalid timezone %q: %w", tz, err)
+		}
+
+		return
+	}
+}
+
+// ExcludeHolidays skips fire times that fall on holidays of the given calendars
+func ExcludeHolidays(hh ...string) ScheduleOpt {
+	return func(s *Schedule) error {
+		s.calendars, s.onHolidays = hh, false
+		return nil
+	}
+}
+
+// OnlyHolidays limits fire times to holidays of the given calendars
+func OnlyHolidays(hh ...string) ScheduleOpt {
+	return func(s *Schedule) error {
+		s.calendars, s.onHolidays = hh, true
+		return nil
+	}
+}
+
+// Name returns empty name as interval constraints are unnamed
+func (s Schedule) Name() string { return "" }
+
+// Values returns crontab expressions
+func (s Schedule) Values() []string { return s.values }
+
+// Match is here only to satisfy eventbus.ConstraintMatcher interface
+//
+// Schedule is matched against the current time with MatchInterval
+func (s Schedule) Match(string) bool { return false }
+
+// Matches returns true when any of the expressions matches the given time
+func (s Schedule) Matches(t time.Time) bool {
+	var (
+		// Round it up to the smallest unit that cronexpr package supports
+		currTime = t.Truncate(time.Second).In(s.location)
+
+		// For cron expression reference we need to subtract 1ns
+		// this will cause Next() fn to include next nanosecond (if it matches)
+		cronRef = currTime.Add(-time.Nanosecond)
+	)
+
+	if !s.onDay(currTime) {
+		return false
+	}
+
+	for _, exp := range s.expressions {
+		if currTime.Equal(exp.Next(cronRef)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Next returns up to n fire times after the given time
+func (s Schedule) Next(from time.Time, n uint) (tt []time.Time) {
+	var (
+		next    time.Time
+		horizon = from.Add(scheduleHorizon)
+		skipped = 0
+	)
+
+	from = from.In(s.location)
+	tt = make([]time.Time, 0, n)
+
+	for uint(len(tt)) < n {
+		next = time.Time{}
+		for _, exp := range s.expressions {
+			if nxt := exp.Next(from); !nxt.IsZero() && (next.IsZero() || nxt.Before(next)) {
+				next = nxt
+			}
+		}
+
+		if next.IsZero() || next.After(horizon) {
+			return
+		}
+
+		if s.onDay(next) {
+			tt = append(tt, next)
+		} else if skipped++; skipped > scheduleMaxSkipped {
+			return
+		}
+
+		from = next
+	}
+
+	return
+}
+
+// onDay checks the day against holiday calendars
+func (s Schedule) onDay(t time.Time) bool {
+	if len(s.calendars) == 0 {
+		return true
+	}
+
+	return isHoliday(t, s.calendars...) == s.onHolidays
+}
+
+// MatchInterval checks if interval constraint matches current time
+//
+// Constraint is either a Schedule or a plain set of crontab expressions
+func MatchInterval(c eventbus.ConstraintMatcher) bool {
+	if s, is := c.(*Schedule); is {
+		return s.Matches(now())
+	}
+
+	return OnInterval(c.Values()...)
+}
@@ -0,0 +1,94 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	testCalendar []string
+)
+
+func (c testCalendar) IsHoliday(day time.Time) bool {
+	for _, d := range c {
+		if day.Format("2006-01-02") == d {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestSchedule_Matches(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		mustParse = func(ts string) time.Time {
+			tm, err := time.Parse(time.RFC3339, ts)
+			req.NoError(err)
+			return tm
+		}
+	)
+
+	SetCalendars(map[string]Calendar{"test": testCalendar{"2022-04-18"}})
+	defer SetCalendars(nil)
+
+	t.Run("timezone", func(t *testing.T) {
+		req := require.New(t)
+
+		s, err := NewSchedule([]string{"0 9 * * *"}, InTimezone("Europe/Ljubljana"))
+		req.NoError(err)
+
+		// 09:00 in Ljubljana is 07:00 UTC in summer
+		req.True(s.Matches(mustParse("2022-06-01T07:00:00Z")))
+		req.False(s.Matches(mustParse("2022-06-01T09:00:00Z")))
+
+		// and 08:00 UTC in winter
+		req.True(s.Matches(mustParse("2022-12-01T08:00:00Z")))
+	})
+
+	t.Run("invalid timezone", func(t *testing.T) {
+		_, err := NewSchedule([]string{"0 9 * * *"}, InTimezone("Mars/Olympus"))
+		require.Error(t, err)
+	})
+
+	t.Run("exclude holidays", func(t *testing.T) {
+		req := require.New(t)
+
+		s, err := NewSchedule([]string{"0 9 * * *"}, InTimezone("UTC"), ExcludeHolidays("test"))
+		req.NoError(err)
+		req.False(s.Matches(mustParse("2022-04-18T09:00:00Z")))
+		req.True(s.Matches(mustParse("2022-04-19T09:00:00Z")))
+	})
+
+	t.Run("only holidays", func(t *testing.T) {
+		req := require.New(t)
+
+		s, err := NewSchedule([]string{"0 9 * * *"}, InTimezone("UTC"), OnlyHolidays("test"))
+		req.NoError(err)
+		req.True(s.Matches(mustParse("2022-04-18T09:00:00Z")))
+		req.False(s.Matches(mustParse("2022-04-19T09:00:00Z")))
+	})
+
+	t.Run("next", func(t *testing.T) {
+		req := require.New(t)
+
+		s, err := NewSchedule([]string{"0 9 * * *"}, InTimezone("UTC"), ExcludeHolidays("test"))
+		req.NoError(err)
+
+		tt := s.Next(mustParse("2022-04-17T10:00:00Z"), 2)
+		req.Len(tt, 2)
+		req.Equal("2022-04-19T09:00:00Z", tt[0].Format(time.RFC3339))
+		req.Equal("2022-04-20T09:00:00Z", tt[1].Format(time.RFC3339))
+	})
+
+	t.Run("next without holidays", func(t *testing.T) {
+		req := require.New(t)
+
+		s, err := NewSchedule([]string{"0 9 * * *"}, InTimezone("UTC"), OnlyHolidays("unknown"))
+		req.NoError(err)
+		req.Empty(s.Next(mustParse("2022-04-17T10:00:00Z"), 2))
+	})
+}
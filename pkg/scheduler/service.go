@@ -18,6 +18,10 @@ type (
 		interval   time.Duration
 		dispatcher dispatcher
 
+		// when set, only the instance that acquires the
+		// lease for the tick dispatches the events
+		leaser leaser
+
 		// Read & write locking
 		l sync.RWMutex
 
@@ -28,6 +32,10 @@ type (
 	dispatcher interface {
 		WaitFor(ctx context.Context, ev eventbus.Event) error
 	}
+
+	leaser interface {
+		Acquire(ctx context.Context, name string, ttl time.Duration) (bool, error)
+	}
 )
 
 const (
@@ -82,6 +90,16 @@ func (svc *service) OnTick(events ...eventbus.Event) {
 	svc.events = append(svc.events, events...)
 }
 
+// SetLeaser configures leasing of ticks
+//
+// Used when multiple instances share the same database
+// to prevent events from being dispatched more than once
+func (svc *service) SetLeaser(l leaser) {
+	svc.l.Lock()
+	defer svc.l.Unlock()
+	svc.leaser = l
+}
+
 func (svc *service) Stop() {
 	svc.l.Lock()
 	defer svc.l.Unlock()
@@ -167,6 +185,19 @@ func (svc *service) dispatch(ctx context.Context) {
 	svc.l.RLock()
 	defer svc.l.RUnlock()
 
+	if svc.leaser != nil {
+		acquired, err := AcquireTick(ctx, svc.leaser, "scheduler", svc.interval)
+		if err != nil {
+			svc.log.Warn("failed to acquire scheduler tick lease", zap.Error(err))
+			return
+		}
+
+		if !acquired {
+			svc.log.Debug("tick dispatched by another instance")
+			return
+		}
+	}
+
 	ee := make([]eventbus.Event, len(svc.events))
 	for e := range svc.events {
 		ee[e] = svc.events[e]
@@ -181,3 +212,12 @@ func (svc *service) dispatch(ctx context.Context) {
 		}(ev)
 	}
 }
+
+// AcquireTick leases the current tick of the given interval
+//
+// Ticks are rounded (and not truncated) so that instances
+// with slightly different clocks lease the same tick
+func AcquireTick(ctx context.Context, l leaser, name string, interval time.Duration) (bool, error) {
+	tick := now().Round(interval)
+	return l.Acquire(ctx, name+":"+tick.UTC().Format(time.RFC3339), interval*2)
+}
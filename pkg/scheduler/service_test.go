@@ -2,6 +2,7 @@ package scheduler
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -17,8 +18,43 @@ type (
 		eType string
 		match func(matcher eventbus.ConstraintMatcher) bool
 	}
+
+	mockDispatcher struct {
+		mux        sync.Mutex
+		dispatched int
+	}
+
+	mockLeaser struct {
+		mux    sync.Mutex
+		leased map[string]bool
+	}
 )
 
+func (d *mockDispatcher) WaitFor(context.Context, eventbus.Event) error {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	d.dispatched++
+	return nil
+}
+
+func (d *mockDispatcher) count() int {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	return d.dispatched
+}
+
+func (l *mockLeaser) Acquire(_ context.Context, name string, _ time.Duration) (bool, error) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	if l.leased[name] {
+		return false, nil
+	}
+
+	l.leased[name] = true
+	return true, nil
+}
+
 func (e mockEvent) ResourceType() string {
 	return e.rType
 }
@@ -60,3 +96,28 @@ func TestMainServiceFunctions(t *testing.T) {
 	time.Sleep(actionWait)
 	r.False(gScheduler.Started())
 }
+
+func TestLeasedDispatch(t *testing.T) {
+	var (
+		r   = require.New(t)
+		ctx = context.Background()
+
+		d = &mockDispatcher{}
+		l = &mockLeaser{leased: make(map[string]bool)}
+
+		// two instances with the same tick
+		s1 = NewService(zap.NewNop(), d, time.Minute)
+		s2 = NewService(zap.NewNop(), d, time.Minute)
+	)
+
+	for _, s := range []*service{s1, s2} {
+		s.SetLeaser(l)
+		s.OnTick(&mockEvent{})
+		s.dispatch(ctx)
+	}
+
+	r.Eventually(func() bool { return d.count() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	r.Equal(1, d.count())
+	r.Len(l.leased, 1)
+}
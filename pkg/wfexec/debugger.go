@@ -0,0 +1,173 @@
+package wfexec
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+)
+
+type (
+	// PausedState is a copy of the state paused by the debugger
+	PausedState struct {
+		StateID   uint64     `json:"stateID,string"`
+		StepID    uint64     `json:"stepID,string"`
+		ParentID  uint64     `json:"parentID,string,omitempty"`
+		CreatedAt time.Time  `json:"createdAt"`
+		Scope     *expr.Vars `json:"scope"`
+	}
+)
+
+// SetBreakpoints sets steps that pause session's execution
+//
+// Paused states are inspected and resumed with Step and Continue fn
+func SetBreakpoints(stepID ...uint64) SessionOpt {
+	return func(s *Session) {
+		for _, ID := range stepID {
+			s.breakpoints[ID] = true
+		}
+	}
+}
+
+// Breakpoints returns IDs of the steps session pauses on
+func (s *Session) Breakpoints() (bb []uint64) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	bb = make([]uint64, 0, len(s.breakpoints))
+	for ID := range s.breakpoints {
+		bb = append(bb, ID)
+	}
+
+	sort.Slice(bb, func(i, j int) bool { return bb[i] < bb[j] })
+	return
+}
+
+// UpdateBreakpoints replaces breakpoints on a running session
+//
+// States that are already paused stay paused
+func (s *Session) UpdateBreakpoints(stepID ...uint64) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	bb := make(map[uint64]bool, len(stepID))
+	for _, ID := range stepID {
+		if s.g.StepByID(ID) == nil {
+			return fmt.Errorf("can not set breakpoint, step %d does not exist", ID)
+		}
+
+		bb[ID] = true
+	}
+
+	s.breakpoints = bb
+	return nil
+}
+
+// PausedStates returns copies of all states paused by the debugger
+func (s *Session) PausedStates() (pp []*PausedState) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	pp = make([]*PausedState, 0, len(s.paused))
+	for _, st := range s.paused {
+		p := &PausedState{
+			StateID:   st.stateId,
+			StepID:    st.step.ID(),
+			CreatedAt: st.created,
+			Scope:     (&expr.Vars{}).MustMerge(st.scope),
+		}
+
+		if st.parent != nil {
+			p.ParentID = st.parent.ID()
+		}
+
+		pp = append(pp, p)
+	}
+
+	sort.Slice(pp, func(i, j int) bool { return pp[i].CreatedAt.Before(pp[j].CreatedAt) })
+	return
+}
+
+// SetStateScope merges variables into the scope of the paused state
+//
+// Modified scope is used when the state is stepped over or continued
+func (s *Session) SetStateScope(stateID uint64, vars *expr.Vars) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	st, has := s.paused[stateID]
+	if !has {
+		return fmt.Errorf("state %d is not paused", stateID)
+	}
+
+	st.scope = (&expr.Vars{}).MustMerge(st.scope, vars)
+	return nil
+}
+
+// Step executes the paused state and pauses on the states that follow
+func (s *Session) Step(ctx context.Context, stateID uint64) error {
+	return s.unpause(ctx, stateID, true)
+}
+
+// Continue executes the paused state and continues until the next breakpoint
+func (s *Session) Continue(ctx context.Context, stateID uint64) error {
+	return s.unpause(ctx, stateID, false)
+}
+
+// Replay executes session on any step of the graph with the given scope
+//
+// Unlike Exec, step can have parents. Loops and error handlers
+// that were active when the step was originally executed are not restored.
+func (s *Session) Replay(ctx context.Context, step Step, scope *expr.Vars) error {
+	if s.g.StepByID(step.ID()) == nil {
+		err := fmt.Errorf("can not replay, step %d does not exist", step.ID())
+		s.qErr <- err
+		return err
+	}
+
+	if scope == nil {
+		scope, _ = expr.NewVars(nil)
+	}
+
+	return s.enqueue(ctx, NewState(s, auth.GetIdentityFromContext(ctx), nil, step, scope))
+}
+
+func (s *Session) unpause(ctx context.Context, stateID uint64, stepping bool) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	st, has := s.paused[stateID]
+	if !has {
+		return fmt.Errorf("state %d is not paused", stateID)
+	}
+
+	delete(s.paused, stateID)
+	st.stepping = stepping
+
+	return s.enqueue(ctx, st)
+}
+
+// pause checks state against breakpoints and pauses it when needed
+//
+// State is checked only once so that states resumed after
+// a delay, a prompt or the debugger are not paused again
+func (s *Session) pause(st *State) bool {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if st.breakChecked {
+		return false
+	}
+
+	st.breakChecked = true
+	if !st.stepping && !s.breakpoints[st.step.ID()] {
+		return false
+	}
+
+	st.action = "paused"
+	s.paused[st.stateId] = st
+	return true
+}
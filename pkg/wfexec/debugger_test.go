@@ -0,0 +1,110 @@
+package wfexec
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSession_Debugger(t *testing.T) {
+	var (
+		ctx = context.Background()
+		req = require.New(t)
+
+		unit = time.Millisecond
+
+		build = func() (*Graph, Step) {
+			var (
+				wf = NewGraph()
+				s1 = &sesTestStep{name: "s1"}
+				s2 = &sesTestStep{name: "s2"}
+				s3 = &sesTestStep{name: "s3"}
+			)
+
+			s1.SetID(1)
+			s2.SetID(2)
+			s3.SetID(3)
+
+			wf.AddStep(s1, s2)
+			wf.AddStep(s2, s3)
+			return wf, s1
+		}
+
+		paused = func(ses *Session) *PausedState {
+			pp := ses.PausedStates()
+			req.Len(pp, 1)
+			return pp[0]
+		}
+	)
+
+	ctx, cancelFn := context.WithTimeout(ctx, time.Second*5)
+	defer cancelFn()
+
+	t.Run("break, inspect, modify and continue", func(t *testing.T) {
+		wf, start := build()
+		ses := NewSession(ctx, wf, SetWorkerInterval(unit), SetBreakpoints(2))
+		req.Equal([]uint64{2}, ses.Breakpoints())
+
+		req.NoError(ses.Exec(ctx, start, nil))
+		req.NoError(ses.WaitUntil(ctx, SessionPaused))
+
+		p := paused(ses)
+		req.Equal(uint64(2), p.StepID)
+		req.Equal(uint64(1), p.ParentID)
+		req.Equal("/s1", expr.Must(expr.Select(p.Scope, "path")).Get())
+
+		vars, _ := expr.NewVars(map[string]interface{}{"path": "/edited"})
+		req.NoError(ses.SetStateScope(p.StateID, vars))
+		req.NoError(ses.Continue(ctx, p.StateID))
+
+		req.NoError(ses.WaitUntil(ctx, SessionCompleted))
+		req.Equal("/edited/s2/s3", expr.Must(expr.Select(ses.Result(), "path")).Get())
+	})
+
+	t.Run("step", func(t *testing.T) {
+		wf, start := build()
+		ses := NewSession(ctx, wf, SetWorkerInterval(unit), SetBreakpoints(1))
+
+		req.NoError(ses.Exec(ctx, start, nil))
+		req.NoError(ses.WaitUntil(ctx, SessionPaused))
+		req.Equal(uint64(1), paused(ses).StepID)
+
+		req.NoError(ses.Step(ctx, paused(ses).StateID))
+		req.NoError(ses.WaitUntil(ctx, SessionPaused))
+		req.Equal(uint64(2), paused(ses).StepID)
+
+		// breakpoints removed, stepping is still in effect
+		req.NoError(ses.UpdateBreakpoints())
+		req.Empty(ses.Breakpoints())
+
+		req.NoError(ses.Step(ctx, paused(ses).StateID))
+		req.NoError(ses.WaitUntil(ctx, SessionPaused))
+		req.Equal(uint64(3), paused(ses).StepID)
+
+		req.NoError(ses.Continue(ctx, paused(ses).StateID))
+		req.NoError(ses.WaitUntil(ctx, SessionCompleted))
+		req.Equal("/s1/s2/s3", expr.Must(expr.Select(ses.Result(), "path")).Get())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		wf, _ := build()
+		ses := NewSession(ctx, wf, SetWorkerInterval(unit))
+
+		req.Error(ses.UpdateBreakpoints(42))
+		req.Error(ses.Continue(ctx, 42))
+		req.Error(ses.SetStateScope(42, nil))
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		wf, _ := build()
+		ses := NewSession(ctx, wf, SetWorkerInterval(unit))
+
+		scope, _ := expr.NewVars(map[string]interface{}{"path": "/replay"})
+		req.NoError(ses.Replay(ctx, wf.StepByID(2), scope))
+		req.NoError(ses.WaitUntil(ctx, SessionCompleted))
+		req.Equal("/replay/s2/s3", expr.Must(expr.Select(ses.Result(), "path")).Get())
+	})
+}
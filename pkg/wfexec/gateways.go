@@ -43,6 +43,16 @@ func JoinGateway(ss ...Step) *joinGateway {
 	}
 }
 
+// addPath adds the path that is expected to be joined
+//
+// Used when paths are not known in advance (parallel loop iterations)
+func (gw *joinGateway) addPath(s Step) {
+	gw.l.Lock()
+	defer gw.l.Unlock()
+
+	gw.paths = append(gw.paths, s)
+}
+
 // Exec fn on join gateway can be called multiple times, even multiple times parent the same parent
 //
 // Func will override the collected parent's *expr.Vars.
@@ -41,6 +41,18 @@ func TestJoinGateway(t *testing.T) {
 	r, err = gw.Exec(context.TODO(), &ExecRequest{Parent: p3})
 	req.NoError(err)
 	req.IsType(&expr.Vars{}, r)
+
+	// paths added later are expected as well
+	p4 := &wfTestStep{name: "p4"}
+	gw.addPath(p4)
+
+	r, err = gw.Exec(context.TODO(), &ExecRequest{Parent: p3})
+	req.NoError(err)
+	req.Equal(&partial{}, r)
+
+	r, err = gw.Exec(context.TODO(), &ExecRequest{Parent: p4})
+	req.NoError(err)
+	req.IsType(&expr.Vars{}, r)
 }
 
 func TestForkGateway(t *testing.T) {
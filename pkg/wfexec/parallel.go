@@ -0,0 +1,244 @@
+package wfexec
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+)
+
+type (
+	// parallelLoop runs iterations of the iterator concurrently
+	//
+	// Each iteration runs with its own copy of the scope. Iterations are
+	// the paths of the join gateway; when all iterations are done, their
+	// scopes are merged in the order of iterations and the session continues
+	// with the iterator's exit step.
+	parallelLoop struct {
+		mux sync.Mutex
+
+		iter        Iterator
+		concurrency uint
+
+		// scope, loop stack and error handler of the state that started the loop
+		scope      *expr.Vars
+		loops      []Iterator
+		errHandler Step
+
+		// number of started and running iterations
+		started uint
+		running uint
+
+		// set when iterator is exhausted, loop is broken or any of the iterations fails;
+		// no new iterations are started after that
+		stopped bool
+
+		// joins scopes of the iterations, iteration
+		// paths are added as iterations are started
+		join   *joinGateway
+		joined *expr.Vars
+
+		errors map[uint]error
+	}
+
+	// parallelIteration is pushed on the loop stack of the iteration's states
+	parallelIteration struct {
+		*parallelLoop
+		index uint
+
+		// iteration's path in the join gateway, never executed
+		path Step
+	}
+
+	concurrentStep interface {
+		Concurrency() uint
+	}
+)
+
+func (pl *parallelLoop) Is(s Step) bool                          { return pl.iter.Is(s) }
+func (pl *parallelLoop) Start(context.Context, *expr.Vars) error { return nil }
+func (pl *parallelLoop) Break() Step                             { return pl.iter.Break() }
+func (pl *parallelLoop) Iterator() Step                          { return pl.iter.Iterator() }
+func (pl *parallelLoop) Next(context.Context, *expr.Vars) (Step, *expr.Vars, error) {
+	return nil, nil, fmt.Errorf("parallel loop iterations are started by the session")
+}
+
+// stepConcurrency returns number of iterations that can run at the same time
+func stepConcurrency(s Step) uint {
+	if cs, ok := s.(concurrentStep); ok {
+		return cs.Concurrency()
+	}
+
+	return 0
+}
+
+// startParallel starts the first batch of parallel iterations
+func startParallel(ctx context.Context, st *State, iter Iterator, concurrency uint, scope *expr.Vars) ([]*State, error) {
+	pl := &parallelLoop{
+		iter:        iter,
+		concurrency: concurrency,
+
+		scope:      scope,
+		loops:      st.loops,
+		errHandler: st.errHandler,
+
+		join:   JoinGateway(),
+		errors: make(map[uint]error),
+	}
+
+	return pl.advance(ctx, st, nil, nil)
+}
+
+// fail records error of the failed iteration and stops the loop
+func (pi *parallelIteration) fail(err error) {
+	pi.mux.Lock()
+	defer pi.mux.Unlock()
+
+	pi.errors[pi.index] = err
+	pi.stopped = true
+}
+
+// stop stops the loop, running iterations are completed
+func (pi *parallelIteration) stop() {
+	pi.mux.Lock()
+	defer pi.mux.Unlock()
+
+	pi.stopped = true
+}
+
+// advance is called when loop is started and whenever iteration is done
+//
+// It starts new iterations until concurrency limit is reached. When there are
+// no more iterations to start and none are running, loop is completed and
+// session continues with the exit step (or with the error handler).
+//
+// Returns nil when there is nothing to do until the next iteration is done.
+func (pl *parallelLoop) advance(ctx context.Context, st *State, done *parallelIteration, scope *expr.Vars) (nxt []*State, err error) {
+	pl.mux.Lock()
+	defer pl.mux.Unlock()
+
+	if done != nil {
+		pl.running--
+		if _, failed := pl.errors[done.index]; failed {
+			// scope of the failed iteration is not merged
+			scope = nil
+		}
+
+		// join gateway merges the scopes when all started iterations are done
+		var res ExecResponse
+		if res, err = pl.join.Exec(ctx, &ExecRequest{Parent: done.path, Scope: scope}); err != nil {
+			return nil, err
+		}
+
+		if merged, ok := res.(*expr.Vars); ok {
+			pl.joined = merged
+		}
+	}
+
+	for !pl.stopped && pl.running < pl.concurrency {
+		var (
+			n   Step
+			out *expr.Vars
+		)
+
+		if n, out, err = pl.iter.Next(ctx, pl.scope); err != nil {
+			return nil, err
+		}
+
+		if n == nil {
+			pl.stopped = true
+			break
+		}
+
+		is := st.Next(n, pl.scope.MustMerge(out))
+
+		// each iteration gets its own loop stack and
+		// starts without the error handler (errors are
+		// collected and handled when loop is completed)
+		is.loops = append(make([]Iterator, 0, len(pl.loops)+4), pl.loops...)
+		pi := &parallelIteration{parallelLoop: pl, index: pl.started, path: &genericStep{}}
+		pl.join.addPath(pi.path)
+
+		is.loops = append(is.loops, pi)
+		is.errHandler = nil
+
+		pl.started++
+		pl.running++
+
+		nxt = append(nxt, is)
+		st.next = append(st.next, n)
+	}
+
+	if len(nxt) > 0 {
+		st.action = "parallel iterations started"
+		return
+	}
+
+	if pl.running > 0 {
+		st.action = "parallel iteration done"
+		return
+	}
+
+	// all iterations are done and joined
+	merged := (&expr.Vars{}).MustMerge(pl.scope)
+	if pl.joined != nil {
+		merged = merged.MustMerge(pl.joined)
+	}
+
+	st.action = "parallel loop completed"
+	st.loops = pl.loops
+	st.errHandler = pl.errHandler
+
+	if st.err = pl.err(); st.err != nil {
+		if st.errHandler == nil {
+			return nil, st.err
+		}
+
+		_ = expr.Assign(merged, "error", expr.Must(expr.NewString(st.err.Error())))
+
+		eh := st.errHandler
+		st.errHandler = nil
+		st.errHandled = true
+		return []*State{st.Next(eh, merged)}, nil
+	}
+
+	st.next = Steps{pl.Break()}
+	return []*State{st.Next(pl.Break(), merged)}, nil
+}
+
+// err reports error of the first failed iteration
+func (pl *parallelLoop) err() error {
+	if len(pl.errors) == 0 {
+		return nil
+	}
+
+	var first uint
+	for i := range pl.errors {
+		if first == 0 || i+1 < first {
+			first = i + 1
+		}
+	}
+
+	return fmt.Errorf(
+		"%d of %d parallel iterations failed, iteration %d: %w",
+		len(pl.errors),
+		pl.started,
+		first-1,
+		pl.errors[first-1],
+	)
+}
+
+// closestParallelIteration returns the closest parallel iteration from the loop stack
+//
+// Loops started inside the iteration are removed from the stack
+func (s *State) closestParallelIteration() *parallelIteration {
+	for l := len(s.loops) - 1; l >= 0; l-- {
+		if pi, is := s.loops[l].(*parallelIteration); is {
+			s.loops = s.loops[:l+1]
+			return pi
+		}
+	}
+
+	return nil
+}
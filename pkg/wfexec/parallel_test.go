@@ -0,0 +1,192 @@
+package wfexec
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+type (
+	sesTestCounter struct {
+		total, curr int64
+	}
+
+	sesTestIterStep struct {
+		sesTestPolicyStep
+	}
+)
+
+func (s *sesTestIterStep) EvalResults(_ context.Context, results *expr.Vars) (*expr.Vars, error) {
+	return results, nil
+}
+
+func (c *sesTestCounter) Start(context.Context, *expr.Vars) error { c.curr = 0; return nil }
+
+func (c *sesTestCounter) More(context.Context, *expr.Vars) (bool, error) {
+	return c.curr < c.total, nil
+}
+
+func (c *sesTestCounter) Next(context.Context, *expr.Vars) (*expr.Vars, error) {
+	c.curr++
+	return expr.NewVars(map[string]interface{}{"i": c.curr - 1})
+}
+
+func TestSession_ParallelIterator(t *testing.T) {
+	var (
+		ctx = context.Background()
+		req = require.New(t)
+
+		unit = time.Millisecond
+
+		// builds iterator with body step that executes the given fn
+		// and exit step that marks the end of the loop
+		build = func(total int64, concurrency uint, body func(i int64) (ExecResponse, error)) (*Graph, Step) {
+			var (
+				wf   = NewGraph()
+				iter = &sesTestIterStep{}
+				exit = &sesTestStep{name: "exit"}
+				loop = &sesTestStep{}
+			)
+
+			iter.SetID(1)
+			loop.SetID(2)
+			exit.SetID(3)
+
+			iter.SetConcurrency(concurrency)
+			iter.exec = func(context.Context, *ExecRequest) (ExecResponse, error) {
+				return GenericIterator(iter, loop, exit, &sesTestCounter{total: total}), nil
+			}
+
+			loop.exec = func(_ context.Context, r *ExecRequest) (ExecResponse, error) {
+				args := &struct{ I int64 }{}
+				if err := r.Scope.Decode(args); err != nil {
+					return nil, err
+				}
+
+				return body(args.I)
+			}
+
+			wf.AddStep(iter, loop, exit)
+			return wf, iter
+		}
+
+		run = func(wf *Graph, s Step, eh Step) *Session {
+			ses := NewSession(ctx, wf, SetWorkerInterval(unit))
+
+			if eh != nil {
+				// start with a step that sets the error handler
+				init := &sesTestStep{}
+				init.SetID(10)
+				init.exec = func(context.Context, *ExecRequest) (ExecResponse, error) {
+					return ErrorHandler(eh), nil
+				}
+
+				wf.AddStep(init, s, eh)
+				s = init
+			}
+
+			req.NoError(ses.Exec(ctx, s, nil))
+
+			_ = ses.WaitUntil(ctx, SessionFailed, SessionCompleted)
+			return ses
+		}
+	)
+
+	ctx, cancelFn := context.WithTimeout(ctx, time.Second*5)
+	defer cancelFn()
+
+	t.Run("bounded concurrency and ordered merge", func(t *testing.T) {
+		var (
+			running = atomic.NewInt32(0)
+			maxRun  = atomic.NewInt32(0)
+		)
+
+		wf, iter := build(6, 3, func(i int64) (ExecResponse, error) {
+			r := running.Inc()
+			if r > maxRun.Load() {
+				maxRun.Store(r)
+			}
+
+			defer running.Dec()
+
+			// later iterations complete first
+			time.Sleep(time.Duration(6-i) * 5 * unit)
+			return expr.NewVars(map[string]interface{}{
+				"last":                i,
+				fmt.Sprintf("r%d", i): true,
+			})
+		})
+
+		ses := run(wf, iter, nil)
+		req.NoError(ses.Error())
+		req.LessOrEqual(maxRun.Load(), int32(3))
+		req.Greater(maxRun.Load(), int32(1))
+
+		res := ses.Result().Dict()
+		req.Equal(int64(5), res["last"])
+		req.Contains(res, "exit")
+		for i := 0; i < 6; i++ {
+			req.Contains(res, fmt.Sprintf("r%d", i))
+		}
+	})
+
+	t.Run("break", func(t *testing.T) {
+		var (
+			started = atomic.NewInt32(0)
+		)
+
+		wf, iter := build(100, 2, func(i int64) (ExecResponse, error) {
+			started.Inc()
+			if i == 3 {
+				return LoopBreak(), nil
+			}
+
+			return expr.NewVars(map[string]interface{}{fmt.Sprintf("r%d", i): true})
+		})
+
+		ses := run(wf, iter, nil)
+		req.NoError(ses.Error())
+		req.Less(started.Load(), int32(100))
+		req.Contains(ses.Result().Dict(), "exit")
+	})
+
+	t.Run("failed iteration", func(t *testing.T) {
+		wf, iter := build(4, 2, func(i int64) (ExecResponse, error) {
+			if i == 1 {
+				return nil, fmt.Errorf("failed %d", i)
+			}
+
+			return expr.NewVars(map[string]interface{}{fmt.Sprintf("r%d", i): true})
+		})
+
+		ses := run(wf, iter, nil)
+		req.Error(ses.Error())
+		req.Contains(ses.Error().Error(), "iteration 1: failed 1")
+	})
+
+	t.Run("failed iteration with error handler", func(t *testing.T) {
+		wf, iter := build(4, 2, func(i int64) (ExecResponse, error) {
+			if i == 1 {
+				return nil, fmt.Errorf("failed %d", i)
+			}
+
+			return expr.NewVars(map[string]interface{}{fmt.Sprintf("r%d", i): true})
+		})
+
+		eh := &sesTestStep{name: "handled"}
+		eh.SetID(11)
+
+		ses := run(wf, iter, eh)
+		req.NoError(ses.Error())
+
+		res := ses.Result().Dict()
+		req.Contains(res, "handled")
+		req.NotContains(res, "exit")
+		req.Contains(res["error"], "iteration 1: failed 1")
+	})
+}
@@ -29,6 +29,7 @@ type (
 		SessionID uint64     `json:"sessionID,string"`
 		CreatedAt time.Time  `json:"createdAt"`
 		StateID   uint64     `json:"stateID,string"`
+		StepID    uint64     `json:"stepID,string"`
 		Payload   *expr.Vars `json:"payload"`
 		OwnerId   uint64     `json:"-"`
 
@@ -50,6 +51,7 @@ func (p *prompted) toPending() *PendingPrompt {
 		Ref:       p.ref,
 		CreatedAt: p.state.created,
 		StateID:   p.state.stateId,
+		StepID:    p.state.step.ID(),
 		Payload:   p.payload,
 		OwnerId:   p.ownerId,
 		Original:  p,
@@ -20,6 +20,10 @@ type (
 
 		// state to be resumed
 		state *State
+
+		// failed state waiting to be executed again;
+		// input of the state is kept
+		retry bool
 	}
 
 	// when session is resumed from a delay we'll replace
@@ -0,0 +1,218 @@
+package wfexec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+)
+
+type (
+	// RetryPolicy configures re-execution of failed steps
+	//
+	// Failed step is not executed again right away; state is delayed
+	// (the same way as with delay steps) and executed when the time comes.
+	RetryPolicy struct {
+		// Max number of attempts, including the first one
+		MaxAttempts uint
+
+		// Wait time before the first retry
+		Delay time.Duration
+
+		// Upper limit for the wait time, no limit when 0
+		MaxDelay time.Duration
+
+		// How wait time grows with each retry
+		Backoff RetryBackoff
+
+		// Random variation of the wait time (0-1)
+		//
+		// With jitter set to 0.2, wait time of 10s
+		// becomes anything between 8s and 12s
+		Jitter float64
+
+		// Returns true when step failed with error that can be retried
+		//
+		// All errors are retried when nil
+		Retryable func(error) bool
+	}
+
+	RetryBackoff int
+
+	// StepExecPolicy can be embedded into a step to support
+	// retries, execution time limit and parallel iterations
+	StepExecPolicy struct {
+		retry       *RetryPolicy
+		timeout     time.Duration
+		concurrency uint
+	}
+
+	retryableStep interface {
+		RetryPolicy() *RetryPolicy
+	}
+
+	timedStep interface {
+		Timeout() time.Duration
+	}
+
+	// timedIterator wraps iterator returned by the step with time limit
+	// and cancels step's context when the loop ends
+	timedIterator struct {
+		iter  Iterator
+		abort context.CancelFunc
+	}
+
+	// stepTimeoutError is returned when step does not complete in the configured time
+	stepTimeoutError struct {
+		timeout time.Duration
+	}
+)
+
+const (
+	RetryBackoffConstant RetryBackoff = iota
+	RetryBackoffLinear
+	RetryBackoffExponential
+)
+
+var (
+	// ErrStepTimeout is returned when step does not complete in the configured time
+	ErrStepTimeout = errors.New("step execution timed out")
+
+	// wrapper around rand.Float64() that will aid testing
+	jitterRand = rand.Float64
+)
+
+func (p *StepExecPolicy) SetRetryPolicy(r *RetryPolicy) { p.retry = r }
+func (p StepExecPolicy) RetryPolicy() *RetryPolicy      { return p.retry }
+func (p *StepExecPolicy) SetTimeout(t time.Duration)    { p.timeout = t }
+func (p StepExecPolicy) Timeout() time.Duration         { return p.timeout }
+func (p *StepExecPolicy) SetConcurrency(c uint)         { p.concurrency = c }
+func (p StepExecPolicy) Concurrency() uint              { return p.concurrency }
+
+// CanRetry returns true if step that failed with an error can be executed again
+//
+// Attempt is number of already made attempts
+func (p RetryPolicy) CanRetry(attempt uint, err error) bool {
+	if err == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+
+	return p.Retryable == nil || p.Retryable(err)
+}
+
+// Wait calculates time to wait before the next attempt
+//
+// Attempt is number of already made attempts
+func (p RetryPolicy) Wait(attempt uint) (d time.Duration) {
+	if attempt == 0 {
+		attempt = 1
+	}
+
+	switch p.Backoff {
+	case RetryBackoffLinear:
+		d = p.Delay * time.Duration(attempt)
+	case RetryBackoffExponential:
+		d = time.Duration(float64(p.Delay) * math.Pow(2, float64(attempt-1)))
+	default:
+		d = p.Delay
+	}
+
+	if p.MaxDelay > 0 && (d > p.MaxDelay || d < 0) {
+		// d < 0 covers overflows with exponential backoff
+		d = p.MaxDelay
+	}
+
+	if p.Jitter > 0 {
+		d += time.Duration(float64(d) * p.Jitter * (jitterRand()*2 - 1))
+	}
+
+	if d < 0 {
+		return 0
+	}
+
+	return
+}
+
+// retryDelay returns wait time before next attempt of the failed step
+//
+// False is returned when step can not be retried
+func retryDelay(st *State) (time.Duration, bool) {
+	rs, ok := st.step.(retryableStep)
+	if !ok || rs.RetryPolicy() == nil {
+		return 0, false
+	}
+
+	p := rs.RetryPolicy()
+
+	// st.attempt holds number of retries, add the first attempt
+	if !p.CanRetry(st.attempt+1, st.err) {
+		return 0, false
+	}
+
+	return p.Wait(st.attempt + 1), true
+}
+
+// execStep executes the step, enforcing step's time limit (if set)
+//
+// Step that does not complete in time is canceled and the call
+// waits for it to stop; step never outlives the call and
+// can not modify the state after the timeout error is returned
+func execStep(ctx context.Context, st *State) (ExecResponse, error) {
+	ts, ok := st.step.(timedStep)
+	if !ok || ts.Timeout() <= 0 {
+		return st.step.Exec(ctx, st.MakeRequest())
+	}
+
+	var (
+		timeout     = ts.Timeout()
+		tCtx, abort = context.WithCancel(ctx)
+		timer       = time.AfterFunc(timeout, abort)
+	)
+
+	rsp, err := st.step.Exec(tCtx, st.MakeRequest())
+
+	if !timer.Stop() {
+		// timer already fired and canceled the step
+		return nil, &stepTimeoutError{timeout: timeout}
+	}
+
+	if i, isIterator := rsp.(Iterator); isIterator && err == nil {
+		// iterators might hold on to the context
+		// so it is canceled when the loop ends
+		return &timedIterator{iter: i, abort: abort}, nil
+	}
+
+	abort()
+	return rsp, err
+}
+
+func (i *timedIterator) Is(s Step) bool                                { return i.iter.Is(s) }
+func (i *timedIterator) Start(ctx context.Context, s *expr.Vars) error { return i.iter.Start(ctx, s) }
+func (i *timedIterator) Iterator() Step                                { return i.iter.Iterator() }
+
+// Next cancels iterator's context when there are no more iterations
+func (i *timedIterator) Next(ctx context.Context, scope *expr.Vars) (next Step, out *expr.Vars, err error) {
+	if next, out, err = i.iter.Next(ctx, scope); err != nil || next == nil {
+		i.abort()
+	}
+
+	return
+}
+
+// Break cancels iterator's context when loop is broken
+func (i *timedIterator) Break() Step {
+	i.abort()
+	return i.iter.Break()
+}
+
+func (e *stepTimeoutError) Error() string {
+	return fmt.Sprintf("%s after %s", ErrStepTimeout, e.timeout)
+}
+
+func (e *stepTimeoutError) Is(target error) bool {
+	return target == ErrStepTimeout
+}
@@ -0,0 +1,235 @@
+package wfexec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	sesTestPolicyStep struct {
+		sesTestStep
+		StepExecPolicy
+	}
+)
+
+func TestRetryPolicy_Wait(t *testing.T) {
+	defer func(fn func() float64) { jitterRand = fn }(jitterRand)
+
+	tcc := []struct {
+		name    string
+		p       RetryPolicy
+		attempt uint
+		wait    time.Duration
+	}{
+		{"constant", RetryPolicy{Delay: time.Second}, 3, time.Second},
+		{"linear", RetryPolicy{Delay: time.Second, Backoff: RetryBackoffLinear}, 3, 3 * time.Second},
+		{"exponential", RetryPolicy{Delay: time.Second, Backoff: RetryBackoffExponential}, 4, 8 * time.Second},
+		{"capped", RetryPolicy{Delay: time.Second, Backoff: RetryBackoffExponential, MaxDelay: 5 * time.Second}, 4, 5 * time.Second},
+		{"jitter", RetryPolicy{Delay: 10 * time.Second, Jitter: 0.2}, 1, 12 * time.Second},
+	}
+
+	jitterRand = func() float64 { return 1 }
+
+	for _, tc := range tcc {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.wait, tc.p.Wait(tc.attempt))
+		})
+	}
+}
+
+func TestRetryPolicy_CanRetry(t *testing.T) {
+	var (
+		req  = require.New(t)
+		errA = fmt.Errorf("a")
+		errB = fmt.Errorf("b")
+
+		p = RetryPolicy{
+			MaxAttempts: 3,
+			Retryable:   func(err error) bool { return errors.Is(err, errA) },
+		}
+	)
+
+	req.True(p.CanRetry(1, errA))
+	req.True(p.CanRetry(2, errA))
+	req.False(p.CanRetry(3, errA))
+	req.False(p.CanRetry(1, errB))
+	req.False(p.CanRetry(1, nil))
+}
+
+func TestSession_Retry(t *testing.T) {
+	var (
+		ctx = context.Background()
+		req = require.New(t)
+
+		unit = time.Millisecond
+
+		run = func(s Step) *Session {
+			wf := NewGraph()
+			wf.AddStep(s)
+
+			ses := NewSession(ctx, wf, SetWorkerInterval(unit))
+			req.NoError(ses.Exec(ctx, s, nil))
+
+			_ = ses.WaitUntil(ctx, SessionFailed, SessionCompleted)
+			return ses
+		}
+
+		failing = func(failures int) *sesTestPolicyStep {
+			s := &sesTestPolicyStep{}
+			s.exec = func(ctx context.Context, r *ExecRequest) (ExecResponse, error) {
+				if failures > 0 {
+					failures--
+					return nil, fmt.Errorf("failed")
+				}
+
+				return expr.NewVars(map[string]interface{}{"done": true})
+			}
+
+			return s
+		}
+	)
+
+	ctx, cancelFn := context.WithTimeout(ctx, time.Second*5)
+	defer cancelFn()
+
+	t.Run("succeeds on retry", func(t *testing.T) {
+		s := failing(2)
+		s.SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, Delay: unit})
+
+		ses := run(s)
+		req.NoError(ses.Error())
+		req.Contains(ses.Result().Dict(), "done")
+	})
+
+	t.Run("gives up", func(t *testing.T) {
+		s := failing(3)
+		s.SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, Delay: unit})
+
+		ses := run(s)
+		req.Error(ses.Error())
+		req.Contains(ses.Error().Error(), "gave up after 3 attempts")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		s := &sesTestPolicyStep{}
+		s.SetTimeout(unit)
+		s.exec = func(ctx context.Context, r *ExecRequest) (ExecResponse, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+
+		ses := run(s)
+		req.Error(ses.Error())
+		req.True(errors.Is(ses.Error(), ErrStepTimeout))
+	})
+
+	t.Run("input kept on retry", func(t *testing.T) {
+		var (
+			s     = failing(1)
+			exec  = s.exec
+			input []*expr.Vars
+		)
+
+		s.SetRetryPolicy(&RetryPolicy{MaxAttempts: 2, Delay: unit})
+		s.exec = func(ctx context.Context, r *ExecRequest) (ExecResponse, error) {
+			input = append(input, r.Input)
+			return exec(ctx, r)
+		}
+
+		ses := run(s)
+		req.NoError(ses.Error())
+		req.Len(input, 2)
+		req.Equal(input[0], input[1])
+	})
+
+	t.Run("timed out step is retried after it stops", func(t *testing.T) {
+		var (
+			s       = &sesTestPolicyStep{}
+			running int32
+			calls   int32
+		)
+
+		s.SetTimeout(unit)
+		s.SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, Delay: unit})
+		s.exec = func(ctx context.Context, r *ExecRequest) (ExecResponse, error) {
+			req.Equal(int32(1), atomic.AddInt32(&running, 1), "step executed concurrently")
+			defer atomic.AddInt32(&running, -1)
+			atomic.AddInt32(&calls, 1)
+
+			<-ctx.Done()
+			time.Sleep(unit * 5)
+			return nil, ctx.Err()
+		}
+
+		ses := run(s)
+		req.Error(ses.Error())
+		req.True(errors.Is(ses.Error(), ErrStepTimeout))
+		req.Equal(int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("timed out step is waited for", func(t *testing.T) {
+		var (
+			s       = &sesTestPolicyStep{}
+			stopped int32
+		)
+
+		s.SetTimeout(unit)
+		s.exec = func(ctx context.Context, r *ExecRequest) (ExecResponse, error) {
+			<-ctx.Done()
+			time.Sleep(unit * 5)
+			atomic.StoreInt32(&stopped, 1)
+			return nil, ctx.Err()
+		}
+
+		ses := run(s)
+		req.True(errors.Is(ses.Error(), ErrStepTimeout))
+		req.Equal(int32(1), atomic.LoadInt32(&stopped))
+	})
+
+	t.Run("iterator context is canceled when loop ends", func(t *testing.T) {
+		var (
+			wf   = NewGraph()
+			iter = &sesTestIterStep{}
+			exit = &sesTestStep{name: "exit"}
+			loop = &sesTestStep{}
+
+			iterCtx context.Context
+			alive   int32
+		)
+
+		iter.SetID(1)
+		loop.SetID(2)
+		exit.SetID(3)
+
+		iter.SetTimeout(time.Second)
+		iter.exec = func(ctx context.Context, _ *ExecRequest) (ExecResponse, error) {
+			iterCtx = ctx
+			return GenericIterator(iter, loop, exit, &sesTestCounter{total: 3}), nil
+		}
+
+		loop.exec = func(context.Context, *ExecRequest) (ExecResponse, error) {
+			if iterCtx.Err() == nil {
+				atomic.AddInt32(&alive, 1)
+			}
+
+			return &expr.Vars{}, nil
+		}
+
+		wf.AddStep(iter, loop, exit)
+
+		ses := NewSession(ctx, wf, SetWorkerInterval(unit))
+		req.NoError(ses.Exec(ctx, iter, nil))
+		_ = ses.WaitUntil(ctx, SessionFailed, SessionCompleted)
+
+		req.NoError(ses.Error())
+		req.Equal(int32(3), atomic.LoadInt32(&alive))
+		req.Error(iterCtx.Err())
+	})
+}
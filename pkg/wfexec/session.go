@@ -41,6 +41,12 @@ type (
 		// prompted
 		prompted map[uint64]*prompted
 
+		// states paused by the debugger
+		paused map[uint64]*State
+
+		// steps debugger pauses the execution on
+		breakpoints map[uint64]bool
+
 		// how often we check for delayed states and how often idle stat is checked in Wait()
 		workerInterval time.Duration
 
@@ -60,6 +66,9 @@ type (
 
 		dumpStacktraceOnPanic bool
 
+		// delayed states are resumed right away
+		skipDelays bool
+
 		eventHandler StateChangeHandler
 
 		callStack []uint64
@@ -88,6 +97,9 @@ type (
 
 		Action string `json:"action,omitempty"`
 		Error  string `json:"error,omitempty"`
+
+		// Number of retries of the failed step
+		Attempt uint `json:"attempt,omitempty"`
 	}
 
 	// ExecRequest is passed to Exec() functions and contains all information
@@ -109,7 +121,18 @@ type (
 
 	SessionStatus int
 
+	// Caller holds workflow, session and step that are executing the current step
+	//
+	// Steps that start other workflows (sub-workflows) use it to link the new
+	// session with the one that invoked it
+	Caller struct {
+		WorkflowID uint64
+		SessionID  uint64
+		StepID     uint64
+	}
+
 	callStackCtxKey struct{}
+	callerCtxKey    struct{}
 )
 
 const (
@@ -123,9 +146,13 @@ const (
 	SessionDelayed
 	SessionFailed
 	SessionCompleted
+	SessionPaused
 )
 
 var (
+	// ErrUnexistingState is returned when resumed state is not prompted
+	ErrUnexistingState = fmt.Errorf("unexisting state")
+
 	// wrapper around nextID that will aid service testing
 	nextID = func() uint64 {
 		return id.Next()
@@ -150,6 +177,8 @@ func (s SessionStatus) String() string {
 		return "failed"
 	case SessionCompleted:
 		return "completed"
+	case SessionPaused:
+		return "paused"
 	}
 
 	return "UNKNOWN-SESSION-STATUS"
@@ -166,6 +195,9 @@ func NewSession(ctx context.Context, g *Graph, oo ...SessionOpt) *Session {
 		delayed:  make(map[uint64]*delayed),
 		prompted: make(map[uint64]*prompted),
 
+		paused:      make(map[uint64]*State),
+		breakpoints: make(map[uint64]bool),
+
 		//workerInterval: time.Millisecond,
 		workerInterval: time.Millisecond * 250, // debug mode rate
 		workerLock:     make(chan struct{}, 1),
@@ -199,6 +231,9 @@ func (s *Session) Status() SessionStatus {
 	case s.err != nil:
 		return SessionFailed
 
+	case len(s.paused) > 0:
+		return SessionPaused
+
 	case len(s.prompted) > 0:
 		return SessionPrompted
 
@@ -334,7 +369,7 @@ func (s *Session) Resume(ctx context.Context, stateId uint64, input *expr.Vars)
 		p, has = s.prompted[stateId]
 	)
 	if !has {
-		return nil, fmt.Errorf("unexisting state")
+		return nil, ErrUnexistingState
 	}
 
 	if i == nil || p.ownerId != i.Identity() {
@@ -461,9 +496,9 @@ func (s *Session) worker(ctx context.Context) {
 
 			s.log.Debug("pulled state from queue", zap.Uint64("stateID", st.stateId))
 			if st.step == nil {
-				// We should not terminate if the session contains any delayed or prompted steps.
+				// We should not terminate if the session contains any delayed, prompted or paused steps.
 				status := s.Status()
-				if status == SessionPrompted || status == SessionDelayed {
+				if status == SessionPrompted || status == SessionDelayed || status == SessionPaused {
 					break
 				}
 
@@ -485,6 +520,12 @@ func (s *Session) worker(ctx context.Context) {
 				return
 			}
 
+			if s.pause(st) {
+				s.log.Debug("paused on breakpoint", zap.Uint64("stateID", st.stateId))
+				s.eventHandler(SessionPaused, st, s)
+				break
+			}
+
 			// add empty struct to chan to lock and to have control over number of concurrent go processes
 			// this will block if number of items in execLock chan reached value of sessionConcurrentExec
 			s.execLock <- struct{}{}
@@ -574,7 +615,12 @@ func (s *Session) worker(ctx context.Context) {
 
 func (s *Session) Cancel() {
 	s.log.Debug("canceling")
-	s.qErr <- fmt.Errorf("canceled")
+
+	select {
+	case s.qErr <- fmt.Errorf("canceled"):
+	default:
+		// worker is already stopping
+	}
 }
 
 func (s *Session) Stop() {
@@ -593,16 +639,19 @@ func (s *Session) queueScheduledSuspended() {
 	s.mux.Lock()
 
 	for id, sus := range s.delayed {
-		if !sus.resumeAt.IsZero() && sus.resumeAt.After(*now()) {
+		if !s.skipDelays && !sus.resumeAt.IsZero() && sus.resumeAt.After(*now()) {
 			continue
 		}
 
 		delete(s.delayed, id)
 
-		// Set state input when step is resumed
-		sus.state.input = &expr.Vars{}
-		sus.state.input.Set("resumed", true)
-		sus.state.input.Set("resumeAt", sus.resumeAt)
+		if !sus.retry {
+			// Set state input when step is resumed
+			sus.state.input = &expr.Vars{}
+			sus.state.input.Set("resumed", true)
+			sus.state.input.Set("resumeAt", sus.resumeAt)
+		}
+
 		s.qState <- sus.state
 	}
 }
@@ -610,6 +659,8 @@ func (s *Session) queueScheduledSuspended() {
 // executes single step, resolves response and schedule following steps for execution
 func (s *Session) exec(ctx context.Context, log *zap.Logger, st *State) (nxt []*State, err error) {
 	st.created = *now()
+	st.action = ""
+	st.retryErr = nil
 
 	defer func() {
 		reason := recover()
@@ -648,26 +699,73 @@ func (s *Session) exec(ctx context.Context, log *zap.Logger, st *State) (nxt []*
 
 	{
 		if currLoop != nil && currLoop.Is(st.step) {
+			if pi, is := currLoop.(*parallelIteration); is {
+				// parallel iteration is done
+				return pi.advance(ctx, st, pi, scope)
+			}
+
 			result = currLoop
 		} else {
 			// push logger to context but raise the stacktrace level to panic
 			// to prevent overly verbose traces
 			ctx = logger.ContextWithValue(ctx, log)
 			stepCtx := SetContextCallStack(ctx, s.callStack)
+			stepCtx = SetContextCaller(stepCtx, Caller{
+				WorkflowID: s.workflowID,
+				SessionID:  s.id,
+				StepID:     st.step.ID(),
+			})
 
-			result, st.err = st.step.Exec(stepCtx, st.MakeRequest())
+			result, st.err = execStep(stepCtx, st)
 
 			if iterator, isIterator := result.(Iterator); isIterator && st.err == nil {
-				// Exec fn returned an iterator, adding loop to stack
-				st.newLoop(iterator)
 				if err = iterator.Start(ctx, scope); err != nil {
 					return
 				}
+
+				if c := stepConcurrency(st.step); c > 1 {
+					// iterations are executed in parallel
+					return startParallel(ctx, st, iterator, c, scope)
+				}
+
+				// Exec fn returned an iterator, adding loop to stack
+				st.newLoop(iterator)
 			}
 		}
 
 		if st.err != nil {
+			if wait, retry := retryDelay(st); retry {
+				// step failed but it can be retried;
+				// state is delayed and executed again when the time comes
+				st.attempt++
+				st.retryErr, st.err = st.err, nil
+				st.action = "retry scheduled"
+
+				log.Warn("step execution failed, retrying",
+					zap.Uint("attempt", st.attempt),
+					zap.Duration("wait", wait),
+					zap.Error(st.retryErr),
+				)
+
+				s.mux.Lock()
+				s.delayed[st.stateId] = &delayed{resumeAt: now().Add(wait), state: st, retry: true}
+				s.mux.Unlock()
+				return
+			}
+
+			if st.attempt > 0 {
+				st.err = fmt.Errorf("%w (gave up after %d attempts)", st.err, st.attempt+1)
+			}
+
 			if st.errHandler == nil {
+				if pi := st.closestParallelIteration(); pi != nil {
+					// failed parallel iteration; error is collected and
+					// handled when all iterations are done
+					pi.fail(st.err)
+					st.errHandled = true
+					return []*State{st.Next(pi.Iterator(), scope)}, nil
+				}
+
 				// no error handler set
 				return nil, st.err
 			}
@@ -737,6 +835,14 @@ func (s *Session) exec(ctx context.Context, log *zap.Logger, st *State) (nxt []*
 				return nil, fmt.Errorf("break step not inside a loop")
 			}
 
+			if pi, is := currLoop.(*parallelIteration); is {
+				// no new iterations are started, running
+				// iterations are completed
+				pi.stop()
+				st.next = Steps{pi.Iterator()}
+				break
+			}
+
 			// jump out of the loop
 			st.next = st.loopEnd()
 			log.Debug("breaking from iterator")
@@ -763,6 +869,7 @@ func (s *Session) exec(ctx context.Context, log *zap.Logger, st *State) (nxt []*
 			log.Debug("termination", zap.Int("delayed", len(s.delayed)))
 			s.mux.Lock()
 			s.delayed = nil
+			s.paused = make(map[uint64]*State)
 			s.mux.Unlock()
 			return []*State{FinalState(s, scope)}, nil
 
@@ -880,6 +987,14 @@ func SetDumpStacktraceOnPanic(dump bool) SessionOpt {
 	}
 }
 
+// SetSkipDelays resumes delayed states (delays and retries)
+// without waiting
+func SetSkipDelays(skip bool) SessionOpt {
+	return func(s *Session) {
+		s.skipDelays = skip
+	}
+}
+
 func SetCallStack(id ...uint64) SessionOpt {
 	return func(s *Session) {
 		s.callStack = id
@@ -931,3 +1046,12 @@ func GetContextCallStack(ctx context.Context) []uint64 {
 
 	return v.([]uint64)
 }
+
+func SetContextCaller(ctx context.Context, c Caller) context.Context {
+	return context.WithValue(ctx, callerCtxKey{}, c)
+}
+
+func GetContextCaller(ctx context.Context) (c Caller) {
+	c, _ = ctx.Value(callerCtxKey{}).(Caller)
+	return
+}
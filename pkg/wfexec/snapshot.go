@@ -0,0 +1,213 @@
+package wfexec
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+)
+
+type (
+	// SuspendedState is a serializable copy of delayed or prompted state
+	//
+	// Suspended states are stored and used to restore the session
+	// in another process (see Session.Snapshot and Session.Restore)
+	SuspendedState struct {
+		StateID      uint64     `json:"stateID,string"`
+		StepID       uint64     `json:"stepID,string"`
+		ParentID     uint64     `json:"parentID,string,omitempty"`
+		ErrHandlerID uint64     `json:"errHandlerID,string,omitempty"`
+		CreatedAt    time.Time  `json:"createdAt"`
+		Scope        *expr.Vars `json:"scope"`
+
+		// Number of retries of the failed step
+		Attempt uint `json:"attempt,omitempty"`
+
+		// Set when failed state is waiting to be executed again
+		Retry bool       `json:"retry,omitempty"`
+		Input *expr.Vars `json:"input,omitempty"`
+
+		// Set when state is delayed
+		ResumeAt *time.Time `json:"resumeAt,omitempty"`
+
+		// Set when state is waiting for the input
+		Prompt *SuspendedPrompt `json:"prompt,omitempty"`
+	}
+
+	SuspendedPrompt struct {
+		OwnerID uint64     `json:"ownerID,string"`
+		Ref     string     `json:"ref"`
+		Payload *expr.Vars `json:"payload"`
+	}
+)
+
+// SetSessionID overrides generated session ID
+//
+// Used when session is restored
+func SetSessionID(sessionID uint64) SessionOpt {
+	return func(s *Session) {
+		s.id = sessionID
+	}
+}
+
+// Snapshot returns copies of all delayed and prompted states
+//
+// States inside loops hold iterators that can not be serialized;
+// error is returned if any of the suspended states is inside a loop.
+func (s *Session) Snapshot() (ss []*SuspendedState, err error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	ss = make([]*SuspendedState, 0, len(s.delayed)+len(s.prompted))
+
+	for _, d := range s.delayed {
+		resumeAt := d.resumeAt
+
+		if err = d.state.suspendable(); err != nil {
+			return nil, err
+		}
+
+		sus := d.state.suspended()
+		sus.ResumeAt = &resumeAt
+
+		if d.retry {
+			sus.Retry = true
+			sus.Input = d.state.input
+		}
+
+		ss = append(ss, sus)
+	}
+
+	for _, p := range s.prompted {
+		if err = p.state.suspendable(); err != nil {
+			return nil, err
+		}
+
+		sus := p.state.suspended()
+		sus.Prompt = &SuspendedPrompt{
+			OwnerID: p.ownerId,
+			Ref:     p.ref,
+			Payload: p.payload,
+		}
+
+		ss = append(ss, sus)
+	}
+
+	return
+}
+
+// Restore adds suspended states to the session
+//
+// Restored prompts are considered as already sent to their owners.
+func (s *Session) Restore(ss ...*SuspendedState) (err error) {
+	var (
+		step = func(ID uint64) (Step, error) {
+			if ID == 0 {
+				return nil, nil
+			}
+
+			if st := s.g.StepByID(ID); st != nil {
+				return st, nil
+			}
+
+			return nil, fmt.Errorf("can not restore state, step %d does not exist", ID)
+		}
+	)
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for _, sus := range ss {
+		st := &State{
+			stateId:   sus.StateID,
+			sessionId: s.id,
+			created:   sus.CreatedAt,
+			scope:     sus.Scope,
+			attempt:   sus.Attempt,
+			loops:     make([]Iterator, 0, 4),
+		}
+
+		if st.step, err = step(sus.StepID); err != nil {
+			return
+		}
+
+		if st.step == nil {
+			return fmt.Errorf("can not restore state %d without a step", sus.StateID)
+		}
+
+		if st.parent, err = step(sus.ParentID); err != nil {
+			return
+		}
+
+		if st.errHandler, err = step(sus.ErrHandlerID); err != nil {
+			return
+		}
+
+		switch {
+		case sus.Prompt != nil:
+			s.prompted[st.stateId] = &prompted{
+				payload: sus.Prompt.Payload,
+				ownerId: sus.Prompt.OwnerID,
+				ref:     sus.Prompt.Ref,
+				state:   st,
+				sent:    true,
+			}
+
+		case sus.ResumeAt != nil:
+			st.input = sus.Input
+			s.delayed[st.stateId] = &delayed{resumeAt: *sus.ResumeAt, state: st, retry: sus.Retry}
+
+		default:
+			return fmt.Errorf("can not restore state %d, state is neither delayed nor prompted", sus.StateID)
+		}
+	}
+
+	return
+}
+
+// suspendable returns error if state can not be serialized
+func (s *State) suspendable() error {
+	if len(s.loops) > 0 {
+		return fmt.Errorf("can not suspend state %d inside a loop", s.stateId)
+	}
+
+	return nil
+}
+
+func (s *State) suspended() *SuspendedState {
+	sus := &SuspendedState{
+		StateID:   s.stateId,
+		StepID:    s.step.ID(),
+		CreatedAt: s.created,
+		Scope:     s.scope,
+		Attempt:   s.attempt,
+	}
+
+	if s.parent != nil {
+		sus.ParentID = s.parent.ID()
+	}
+
+	if s.errHandler != nil {
+		sus.ErrHandlerID = s.errHandler.ID()
+	}
+
+	return sus
+}
+
+// PendingPrompt returns pending prompt of the suspended state
+//
+// Nil is returned if the state is not prompted
+func (sus SuspendedState) PendingPrompt(sessionID uint64) *PendingPrompt {
+	if sus.Prompt == nil {
+		return nil
+	}
+
+	return &PendingPrompt{
+		Ref:       sus.Prompt.Ref,
+		SessionID: sessionID,
+		CreatedAt: sus.CreatedAt,
+		StateID:   sus.StateID,
+		Payload:   sus.Prompt.Payload,
+		OwnerId:   sus.Prompt.OwnerID,
+	}
+}
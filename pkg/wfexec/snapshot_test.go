@@ -0,0 +1,132 @@
+package wfexec
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSession_SnapshotRestore(t *testing.T) {
+	var (
+		ctx = context.Background()
+		req = require.New(t)
+
+		unit = time.Millisecond
+
+		build = func() (*Graph, Step) {
+			var (
+				wf     = NewGraph()
+				start  = &sesTestStep{name: "start"}
+				prompt = &sesTestStep{name: "prompt"}
+				end    = &sesTestStep{name: "end"}
+			)
+
+			start.SetID(1)
+			prompt.SetID(2)
+			end.SetID(3)
+
+			prompt.exec = func(_ context.Context, r *ExecRequest) (ExecResponse, error) {
+				if !r.Input.Has("answer") {
+					return Prompt(42, "test", nil), nil
+				}
+
+				return r.Input, nil
+			}
+
+			wf.AddStep(start, prompt)
+			wf.AddStep(prompt, end)
+			return wf, start
+		}
+	)
+
+	ctx, cancelFn := context.WithTimeout(ctx, time.Second*5)
+	defer cancelFn()
+
+	wf, start := build()
+	ses := NewSession(ctx, wf, SetWorkerInterval(unit))
+	req.NoError(ses.Exec(ctx, start, nil))
+	req.NoError(ses.WaitUntil(ctx, SessionPrompted))
+
+	ss, err := ses.Snapshot()
+	req.NoError(err)
+	req.Len(ss, 1)
+	req.NotNil(ss[0].Prompt)
+	req.Equal(uint64(42), ss[0].Prompt.OwnerID)
+	req.Equal(uint64(2), ss[0].StepID)
+	req.Equal(uint64(1), ss[0].ParentID)
+
+	// states are serialized and restored
+	// in a new session on a fresh graph
+	enc, err := json.Marshal(ss)
+	req.NoError(err)
+
+	var restored []*SuspendedState
+	req.NoError(json.Unmarshal(enc, &restored))
+	req.NoError(restored[0].Scope.ResolveTypes(func(typ string) expr.Type {
+		switch typ {
+		case "Integer":
+			return &expr.Integer{}
+		default:
+			return &expr.String{}
+		}
+	}))
+
+	wf, _ = build()
+	res := NewSession(ctx, wf, SetWorkerInterval(unit), SetSessionID(ses.ID()))
+	req.NoError(res.Restore(restored...))
+	req.Equal(ses.ID(), res.ID())
+	req.Equal(SessionPrompted, res.Status())
+
+	pp := res.UserPendingPrompts(42)
+	req.Len(pp, 1)
+	req.Equal(ss[0].StateID, pp[0].StateID)
+	req.Empty(res.UnsentPendingPrompts())
+
+	input, err := expr.NewVars(map[string]interface{}{"answer": "yes"})
+	req.NoError(err)
+
+	_, err = res.Resume(auth.SetIdentityToContext(ctx, auth.Authenticated(42)), ss[0].StateID, input)
+	req.NoError(err)
+	req.NoError(res.WaitUntil(ctx, SessionCompleted))
+
+	result := res.Result().Dict()
+	req.Equal("yes", result["answer"])
+	req.Contains(result, "end")
+}
+
+func TestSession_SnapshotRestoreFailures(t *testing.T) {
+	var (
+		req = require.New(t)
+		wf  = NewGraph()
+		ses = NewSession(context.Background(), wf)
+	)
+
+	defer ses.Stop()
+
+	req.EqualError(
+		ses.Restore(&SuspendedState{StateID: 1, StepID: 2}),
+		"can not restore state, step 2 does not exist",
+	)
+
+	s := &sesTestStep{}
+	s.SetID(2)
+	wf.AddStep(s)
+
+	req.EqualError(
+		ses.Restore(&SuspendedState{StateID: 1, StepID: 2}),
+		"can not restore state 1, state is neither delayed nor prompted",
+	)
+
+	st := NewState(ses, nil, nil, s, nil)
+	st.stateId = 5
+	st.newLoop(&parallelLoop{})
+	ses.prompted[st.stateId] = &prompted{state: st, ownerId: 1}
+
+	_, err := ses.Snapshot()
+	req.EqualError(err, "can not suspend state 5 inside a loop")
+}
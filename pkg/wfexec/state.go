@@ -51,9 +51,22 @@ type (
 		// error handled flag, this gets restarted on every new state!
 		errHandled bool
 
+		// number of times failed step was retried
+		attempt uint
+
+		// error from the failed attempt, kept for the stacktrace
+		// while the step is waiting to be retried
+		retryErr error
+
 		loops []Iterator
 
 		action string
+
+		// set when state was checked against debugger breakpoints
+		breakChecked bool
+
+		// when set, debugger pauses on the following states
+		stepping bool
 	}
 )
 
@@ -89,6 +102,7 @@ func (s State) Next(current Step, scope *expr.Vars) *State {
 		parent:     s.step,
 		errHandler: s.errHandler,
 		loops:      s.loops,
+		stepping:   s.stepping,
 
 		step:  current,
 		scope: scope,
@@ -151,10 +165,13 @@ func (s State) MakeFrame() *Frame {
 		Results:   unref(s.results),
 		NextSteps: s.next.IDs(),
 		Action:    s.action,
+		Attempt:   s.attempt,
 	}
 
 	if s.err != nil {
 		f.Error = s.err.Error()
+	} else if s.retryErr != nil {
+		f.Error = s.retryErr.Error()
 	}
 
 	if s.step != nil {
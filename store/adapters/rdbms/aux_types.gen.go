@@ -56,6 +56,31 @@ type (
 		DeletedBy uint64                       `db:"deleted_by"`
 	}
 
+	// auxApigwProfilerRecord is an auxiliary structure used for transporting to/from RDBMS store
+	auxApigwProfilerRecord struct {
+		ID            uint64                             `db:"id"`
+		Hit           string                             `db:"hit"`
+		Route         uint64                             `db:"route"`
+		Method        string                             `db:"method"`
+		Path          string                             `db:"path"`
+		Query         string                             `db:"query"`
+		Status        int                                `db:"status"`
+		Cache         string                             `db:"cache"`
+		ContentLength int64                              `db:"content_length"`
+		Duration      int64                              `db:"duration"`
+		Meta          systemType.ApigwProfilerRecordMeta `db:"meta"`
+		StartedAt     time.Time                          `db:"started_at"`
+	}
+
+	// auxApigwRateLimit is an auxiliary structure used for transporting to/from RDBMS store
+	auxApigwRateLimit struct {
+		Name      string    `db:"name"`
+		Count     uint64    `db:"count"`
+		Stamp     int64     `db:"stamp"`
+		Version   uint64    `db:"version"`
+		ExpiresAt time.Time `db:"expires_at"`
+	}
+
 	// auxApigwRoute is an auxiliary structure used for transporting to/from RDBMS store
 	auxApigwRoute struct {
 		ID        uint64                    `db:"id"`
@@ -155,22 +180,67 @@ type (
 		UserAgent  string    `db:"user_agent"`
 	}
 
+	// auxAutomationLease is an auxiliary structure used for transporting to/from RDBMS store
+	auxAutomationLease struct {
+		Name      string     `db:"name"`
+		Owner     string     `db:"owner"`
+		ExpiresAt time.Time  `db:"expires_at"`
+		CreatedAt time.Time  `db:"created_at"`
+		UpdatedAt *time.Time `db:"updated_at"`
+	}
+
 	// auxAutomationSession is an auxiliary structure used for transporting to/from RDBMS store
 	auxAutomationSession struct {
-		ID           uint64                       `db:"id"`
-		WorkflowID   uint64                       `db:"workflow_id"`
-		EventType    string                       `db:"event_type"`
-		ResourceType string                       `db:"resource_type"`
-		Status       automationType.SessionStatus `db:"status"`
-		Input        *expr.Vars                   `db:"input"`
-		Output       *expr.Vars                   `db:"output"`
-		Stacktrace   automationType.Stacktrace    `db:"stacktrace"`
-		CreatedBy    uint64                       `db:"created_by"`
-		CreatedAt    time.Time                    `db:"created_at"`
-		PurgeAt      *time.Time                   `db:"purge_at"`
-		CompletedAt  *time.Time                   `db:"completed_at"`
-		SuspendedAt  *time.Time                   `db:"suspended_at"`
-		Error        string                       `db:"error"`
+		ID               uint64                          `db:"id"`
+		WorkflowID       uint64                          `db:"workflow_id"`
+		WorkflowRevision uint                            `db:"workflow_revision"`
+		CallerSessionID  uint64                          `db:"caller_session_id"`
+		CallerStepID     uint64                          `db:"caller_step_id"`
+		EventType        string                          `db:"event_type"`
+		ResourceType     string                          `db:"resource_type"`
+		Status           automationType.SessionStatus    `db:"status"`
+		Input            *expr.Vars                      `db:"input"`
+		Output           *expr.Vars                      `db:"output"`
+		Stacktrace       automationType.Stacktrace       `db:"stacktrace"`
+		Snapshot         *automationType.SessionSnapshot `db:"snapshot"`
+		CreatedBy        uint64                          `db:"created_by"`
+		CreatedAt        time.Time                       `db:"created_at"`
+		PurgeAt          *time.Time                      `db:"purge_at"`
+		CompletedAt      *time.Time                      `db:"completed_at"`
+		SuspendedAt      *time.Time                      `db:"suspended_at"`
+		Error            string                          `db:"error"`
+	}
+
+	// auxAutomationSessionResume is an auxiliary structure used for transporting to/from RDBMS store
+	auxAutomationSessionResume struct {
+		ID        uint64     `db:"id"`
+		SessionID uint64     `db:"session_id"`
+		StateID   uint64     `db:"state_id"`
+		Input     *expr.Vars `db:"input"`
+		CreatedBy uint64     `db:"created_by"`
+		CreatedAt time.Time  `db:"created_at"`
+	}
+
+	// auxAutomationTask is an auxiliary structure used for transporting to/from RDBMS store
+	auxAutomationTask struct {
+		ID             uint64                    `db:"id"`
+		SessionID      uint64                    `db:"session_id"`
+		StateID        uint64                    `db:"state_id"`
+		WorkflowID     uint64                    `db:"workflow_id"`
+		StepID         uint64                    `db:"step_id"`
+		Ref            string                    `db:"ref"`
+		Payload        *expr.Vars                `db:"payload"`
+		OwnerID        uint64                    `db:"owner_id"`
+		AssignedUserID uint64                    `db:"assigned_user_id"`
+		AssignedRoleID uint64                    `db:"assigned_role_id"`
+		ClaimedBy      uint64                    `db:"claimed_by"`
+		DelegatedBy    uint64                    `db:"delegated_by"`
+		Status         automationType.TaskStatus `db:"status"`
+		DueAt          *time.Time                `db:"due_at"`
+		CreatedAt      time.Time                 `db:"created_at"`
+		UpdatedAt      *time.Time                `db:"updated_at"`
+		CompletedAt    *time.Time                `db:"completed_at"`
+		CompletedBy    uint64                    `db:"completed_by"`
 	}
 
 	// auxAutomationTrigger is an auxiliary structure used for transporting to/from RDBMS store
@@ -195,24 +265,58 @@ type (
 
 	// auxAutomationWorkflow is an auxiliary structure used for transporting to/from RDBMS store
 	auxAutomationWorkflow struct {
-		ID           uint64                          `db:"id"`
-		Handle       string                          `db:"handle"`
-		Meta         *automationType.WorkflowMeta    `db:"meta"`
-		Enabled      bool                            `db:"enabled"`
-		Trace        bool                            `db:"trace"`
-		KeepSessions int                             `db:"keep_sessions"`
-		Scope        *expr.Vars                      `db:"scope"`
-		Steps        automationType.WorkflowStepSet  `db:"steps"`
-		Paths        automationType.WorkflowPathSet  `db:"paths"`
-		Issues       automationType.WorkflowIssueSet `db:"issues"`
-		RunAs        uint64                          `db:"run_as"`
-		OwnedBy      uint64                          `db:"owned_by"`
-		CreatedAt    time.Time                       `db:"created_at"`
-		UpdatedAt    *time.Time                      `db:"updated_at"`
-		DeletedAt    *time.Time                      `db:"deleted_at"`
-		CreatedBy    uint64                          `db:"created_by"`
-		UpdatedBy    uint64                          `db:"updated_by"`
-		DeletedBy    uint64                          `db:"deleted_by"`
+		ID                uint64                          `db:"id"`
+		Handle            string                          `db:"handle"`
+		Meta              *automationType.WorkflowMeta    `db:"meta"`
+		Enabled           bool                            `db:"enabled"`
+		Trace             bool                            `db:"trace"`
+		KeepSessions      int                             `db:"keep_sessions"`
+		Scope             *expr.Vars                      `db:"scope"`
+		Steps             automationType.WorkflowStepSet  `db:"steps"`
+		Paths             automationType.WorkflowPathSet  `db:"paths"`
+		Issues            automationType.WorkflowIssueSet `db:"issues"`
+		RunAs             uint64                          `db:"run_as"`
+		PublishedRevision uint                            `db:"published_revision"`
+		OwnedBy           uint64                          `db:"owned_by"`
+		CreatedAt         time.Time                       `db:"created_at"`
+		UpdatedAt         *time.Time                      `db:"updated_at"`
+		DeletedAt         *time.Time                      `db:"deleted_at"`
+		CreatedBy         uint64                          `db:"created_by"`
+		UpdatedBy         uint64                          `db:"updated_by"`
+		DeletedBy         uint64                          `db:"deleted_by"`
+	}
+
+	// auxAutomationWorkflowRevision is an auxiliary structure used for transporting to/from RDBMS store
+	auxAutomationWorkflowRevision struct {
+		ID         uint64                         `db:"id"`
+		WorkflowID uint64                         `db:"workflow_id"`
+		Revision   uint                           `db:"revision"`
+		Meta       *automationType.WorkflowMeta   `db:"meta"`
+		Scope      *expr.Vars                     `db:"scope"`
+		Steps      automationType.WorkflowStepSet `db:"steps"`
+		Paths      automationType.WorkflowPathSet `db:"paths"`
+		CreatedAt  time.Time                      `db:"created_at"`
+		CreatedBy  uint64                         `db:"created_by"`
+	}
+
+	// auxAutomationWorkflowTestCase is an auxiliary structure used for transporting to/from RDBMS store
+	auxAutomationWorkflowTestCase struct {
+		ID          uint64                                 `db:"id"`
+		WorkflowID  uint64                                 `db:"workflow_id"`
+		Meta        *automationType.WorkflowTestCaseMeta   `db:"meta"`
+		StepID      uint64                                 `db:"step_id"`
+		Input       *expr.Vars                             `db:"input"`
+		Mocks       automationType.WorkflowTestMockSet     `db:"mocks"`
+		Passthrough automationType.WorkflowTestPassthrough `db:"passthrough"`
+		Assertions  automationType.TestSet                 `db:"assertions"`
+		ExpectError string                                 `db:"expect_error"`
+		OwnedBy     uint64                                 `db:"owned_by"`
+		CreatedAt   time.Time                              `db:"created_at"`
+		UpdatedAt   *time.Time                             `db:"updated_at"`
+		DeletedAt   *time.Time                             `db:"deleted_at"`
+		CreatedBy   uint64                                 `db:"created_by"`
+		UpdatedBy   uint64                                 `db:"updated_by"`
+		DeletedBy   uint64                                 `db:"deleted_by"`
 	}
 
 	// auxComposeAttachment is an auxiliary structure used for transporting to/from RDBMS store
@@ -271,6 +375,7 @@ type (
 		Multi            bool                           `db:"multi"`
 		DefaultValue     composeType.RecordValueSet     `db:"default_value"`
 		Expressions      composeType.ModuleFieldExpr    `db:"expressions"`
+		SensitivityLevel uint64                         `db:"sensitivity_level"`
 		CreatedAt        time.Time                      `db:"created_at"`
 		UpdatedAt        *time.Time                     `db:"updated_at"`
 		DeletedAt        *time.Time                     `db:"deleted_at"`
@@ -320,6 +425,47 @@ type (
 		DeletedBy   uint64     `db:"deleted_by"`
 	}
 
+	// auxComposeRecordImportError is an auxiliary structure used for transporting to/from RDBMS store
+	auxComposeRecordImportError struct {
+		SessionID uint64    `db:"session_id"`
+		Row       uint64    `db:"row"`
+		Message   string    `db:"message"`
+		CreatedAt time.Time `db:"created_at"`
+	}
+
+	// auxComposeRecordImportSession is an auxiliary structure used for transporting to/from RDBMS store
+	auxComposeRecordImportSession struct {
+		ID          uint64                           `db:"id"`
+		Name        string                           `db:"name"`
+		ContentType string                           `db:"content_type"`
+		Source      string                           `db:"source"`
+		Sheet       string                           `db:"sheet"`
+		Sheets      composeType.RecordImportSheetSet `db:"sheets"`
+		UserID      uint64                           `db:"user_id"`
+		NamespaceID uint64                           `db:"namespace_id"`
+		ModuleID    uint64                           `db:"module_id"`
+		OnError     string                           `db:"on_error"`
+		Fields      composeType.RecordImportFieldMap `db:"fields"`
+		Key         string                           `db:"key"`
+		Progress    composeType.RecordImportProgress `db:"progress"`
+		CreatedAt   time.Time                        `db:"created_at"`
+		UpdatedAt   time.Time                        `db:"updated_at"`
+	}
+
+	// auxComposeRecordRevision is an auxiliary structure used for transporting to/from RDBMS store
+	auxComposeRecordRevision struct {
+		ID        uint64                              `db:"id"`
+		RecordID  uint64                              `db:"record_id"`
+		ModuleID  uint64                              `db:"module_id"`
+		Revision  uint                                `db:"revision"`
+		Operation composeType.RecordRevisionOperation `db:"operation"`
+		UserID    uint64                              `db:"user_id"`
+		SessionID uint64                              `db:"session_id"`
+		Values    composeType.RecordValueSet          `db:"values"`
+		Changes   composeType.RecordRevisionChangeSet `db:"changes"`
+		CreatedAt time.Time                           `db:"created_at"`
+	}
+
 	// auxComposeRecordValue is an auxiliary structure used for transporting to/from RDBMS store
 	auxComposeRecordValue struct {
 		RecordID  uint64     `db:"record_id"`
@@ -456,6 +602,20 @@ type (
 		Active     bool   `db:"active"`
 	}
 
+	// auxHolidayCalendar is an auxiliary structure used for transporting to/from RDBMS store
+	auxHolidayCalendar struct {
+		ID        uint64                         `db:"id"`
+		Handle    string                         `db:"handle"`
+		Meta      systemType.HolidayCalendarMeta `db:"meta"`
+		Holidays  systemType.HolidaySet          `db:"holidays"`
+		CreatedAt time.Time                      `db:"created_at"`
+		UpdatedAt *time.Time                     `db:"updated_at"`
+		DeletedAt *time.Time                     `db:"deleted_at"`
+		CreatedBy uint64                         `db:"created_by"`
+		UpdatedBy uint64                         `db:"updated_by"`
+		DeletedBy uint64                         `db:"deleted_by"`
+	}
+
 	// auxLabel is an auxiliary structure used for transporting to/from RDBMS store
 	auxLabel struct {
 		Kind       string `db:"kind"`
@@ -736,6 +896,103 @@ func (aux *auxApigwFilter) scan(row scanner) error {
 	)
 }
 
+// encodes ApigwProfilerRecord to auxApigwProfilerRecord
+//
+// This function is auto-generated
+func (aux *auxApigwProfilerRecord) encode(res *systemType.ApigwProfilerRecord) (_ error) {
+	aux.ID = res.ID
+	aux.Hit = res.Hit
+	aux.Route = res.Route
+	aux.Method = res.Method
+	aux.Path = res.Path
+	aux.Query = res.Query
+	aux.Status = res.Status
+	aux.Cache = res.Cache
+	aux.ContentLength = res.ContentLength
+	aux.Duration = res.Duration
+	aux.Meta = res.Meta
+	aux.StartedAt = res.StartedAt
+	return
+}
+
+// decodes ApigwProfilerRecord from auxApigwProfilerRecord
+//
+// This function is auto-generated
+func (aux auxApigwProfilerRecord) decode() (res *systemType.ApigwProfilerRecord, _ error) {
+	res = new(systemType.ApigwProfilerRecord)
+	res.ID = aux.ID
+	res.Hit = aux.Hit
+	res.Route = aux.Route
+	res.Method = aux.Method
+	res.Path = aux.Path
+	res.Query = aux.Query
+	res.Status = aux.Status
+	res.Cache = aux.Cache
+	res.ContentLength = aux.ContentLength
+	res.Duration = aux.Duration
+	res.Meta = aux.Meta
+	res.StartedAt = aux.StartedAt
+	return
+}
+
+// scans row and fills auxApigwProfilerRecord fields
+//
+// This function is auto-generated
+func (aux *auxApigwProfilerRecord) scan(row scanner) error {
+	return row.Scan(
+		&aux.ID,
+		&aux.Hit,
+		&aux.Route,
+		&aux.Method,
+		&aux.Path,
+		&aux.Query,
+		&aux.Status,
+		&aux.Cache,
+		&aux.ContentLength,
+		&aux.Duration,
+		&aux.Meta,
+		&aux.StartedAt,
+	)
+}
+
+// encodes ApigwRateLimit to auxApigwRateLimit
+//
+// This function is auto-generated
+func (aux *auxApigwRateLimit) encode(res *systemType.ApigwRateLimit) (_ error) {
+	aux.Name = res.Name
+	aux.Count = res.Count
+	aux.Stamp = res.Stamp
+	aux.Version = res.Version
+	aux.ExpiresAt = res.ExpiresAt
+	return
+}
+
+// decodes ApigwRateLimit from auxApigwRateLimit
+//
+// This function is auto-generated
+func (aux auxApigwRateLimit) decode() (res *systemType.ApigwRateLimit, _ error) {
+	res = new(systemType.ApigwRateLimit)
+	res.Name = aux.Name
+	res.Count = aux.Count
+	res.Stamp = aux.Stamp
+	res.Version = aux.Version
+	res.ExpiresAt = aux.ExpiresAt
+	return
+}
+
+// scans row and fills auxApigwRateLimit fields
+//
+// This function is auto-generated
+func (aux *auxApigwRateLimit) scan(row scanner) error {
+	return row.Scan(
+		&aux.Name,
+		&aux.Count,
+		&aux.Stamp,
+		&aux.Version,
+		&aux.ExpiresAt,
+	)
+}
+
 // encodes ApigwRoute to auxApigwRoute
 //
 // This function is auto-generated
@@ -1110,18 +1367,60 @@ func (aux *auxAuthSession) scan(row scanner) error {
 	)
 }
 
+// encodes AutomationLease to auxAutomationLease
+//
+// This function is auto-generated
+func (aux *auxAutomationLease) encode(res *automationType.Lease) (_ error) {
+	aux.Name = res.Name
+	aux.Owner = res.Owner
+	aux.ExpiresAt = res.ExpiresAt
+	aux.CreatedAt = res.CreatedAt
+	aux.UpdatedAt = res.UpdatedAt
+	return
+}
+
+// decodes AutomationLease from auxAutomationLease
+//
+// This function is auto-generated
+func (aux auxAutomationLease) decode() (res *automationType.Lease, _ error) {
+	res = new(automationType.Lease)
+	res.Name = aux.Name
+	res.Owner = aux.Owner
+	res.ExpiresAt = aux.ExpiresAt
+	res.CreatedAt = aux.CreatedAt
+	res.UpdatedAt = aux.UpdatedAt
+	return
+}
+
+// scans row and fills auxAutomationLease fields
+//
+// This function is auto-generated
+func (aux *auxAutomationLease) scan(row scanner) error {
+	return row.Scan(
+		&aux.Name,
+		&aux.Owner,
+		&aux.ExpiresAt,
+		&aux.CreatedAt,
+		&aux.UpdatedAt,
+	)
+}
+
 // encodes AutomationSession to auxAutomationSession
 //
 // This function is auto-generated
 func (aux *auxAutomationSession) encode(res *automationType.Session) (_ error) {
 	aux.ID = res.ID
 	aux.WorkflowID = res.WorkflowID
+	aux.WorkflowRevision = res.WorkflowRevision
+	aux.CallerSessionID = res.CallerSessionID
+	aux.CallerStepID = res.CallerStepID
 	aux.EventType = res.EventType
 	aux.ResourceType = res.ResourceType
 	aux.Status = res.Status
 	aux.Input = res.Input
 	aux.Output = res.Output
 	aux.Stacktrace = res.Stacktrace
+	aux.Snapshot = res.Snapshot
 	aux.CreatedBy = res.CreatedBy
 	aux.CreatedAt = res.CreatedAt
 	aux.PurgeAt = res.PurgeAt
@@ -1138,12 +1437,16 @@ func (aux auxAutomationSession) decode() (res *automationType.Session, _ error)
 	res = new(automationType.Session)
 	res.ID = aux.ID
 	res.WorkflowID = aux.WorkflowID
+	res.WorkflowRevision = aux.WorkflowRevision
+	res.CallerSessionID = aux.CallerSessionID
+	res.CallerStepID = aux.CallerStepID
 	res.EventType = aux.EventType
 	res.ResourceType = aux.ResourceType
 	res.Status = aux.Status
 	res.Input = aux.Input
 	res.Output = aux.Output
 	res.Stacktrace = aux.Stacktrace
+	res.Snapshot = aux.Snapshot
 	res.CreatedBy = aux.CreatedBy
 	res.CreatedAt = aux.CreatedAt
 	res.PurgeAt = aux.PurgeAt
@@ -1160,12 +1463,16 @@ func (aux *auxAutomationSession) scan(row scanner) error {
 	return row.Scan(
 		&aux.ID,
 		&aux.WorkflowID,
+		&aux.WorkflowRevision,
+		&aux.CallerSessionID,
+		&aux.CallerStepID,
 		&aux.EventType,
 		&aux.ResourceType,
 		&aux.Status,
 		&aux.Input,
 		&aux.Output,
 		&aux.Stacktrace,
+		&aux.Snapshot,
 		&aux.CreatedBy,
 		&aux.CreatedAt,
 		&aux.PurgeAt,
@@ -1175,6 +1482,124 @@ func (aux *auxAutomationSession) scan(row scanner) error {
 	)
 }
 
+// encodes AutomationSessionResume to auxAutomationSessionResume
+//
+// This function is auto-generated
+func (aux *auxAutomationSessionResume) encode(res *automationType.SessionResume) (_ error) {
+	aux.ID = res.ID
+	aux.SessionID = res.SessionID
+	aux.StateID = res.StateID
+	aux.Input = res.Input
+	aux.CreatedBy = res.CreatedBy
+	aux.CreatedAt = res.CreatedAt
+	return
+}
+
+// decodes AutomationSessionResume from auxAutomationSessionResume
+//
+// This function is auto-generated
+func (aux auxAutomationSessionResume) decode() (res *automationType.SessionResume, _ error) {
+	res = new(automationType.SessionResume)
+	res.ID = aux.ID
+	res.SessionID = aux.SessionID
+	res.StateID = aux.StateID
+	res.Input = aux.Input
+	res.CreatedBy = aux.CreatedBy
+	res.CreatedAt = aux.CreatedAt
+	return
+}
+
+// scans row and fills auxAutomationSessionResume fields
+//
+// This function is auto-generated
+func (aux *auxAutomationSessionResume) scan(row scanner) error {
+	return row.Scan(
+		&aux.ID,
+		&aux.SessionID,
+		&aux.StateID,
+		&aux.Input,
+		&aux.CreatedBy,
+		&aux.CreatedAt,
+	)
+}
+
+// encodes AutomationTask to auxAutomationTask
+//
+// This function is auto-generated
+func (aux *auxAutomationTask) encode(res *automationType.Task) (_ error) {
+	aux.ID = res.ID
+	aux.SessionID = res.SessionID
+	aux.StateID = res.StateID
+	aux.WorkflowID = res.WorkflowID
+	aux.StepID = res.StepID
+	aux.Ref = res.Ref
+	aux.Payload = res.Payload
+	aux.OwnerID = res.OwnerID
+	aux.AssignedUserID = res.AssignedUserID
+	aux.AssignedRoleID = res.AssignedRoleID
+	aux.ClaimedBy = res.ClaimedBy
+	aux.DelegatedBy = res.DelegatedBy
+	aux.Status = res.Status
+	aux.DueAt = res.DueAt
+	aux.CreatedAt = res.CreatedAt
+	aux.UpdatedAt = res.UpdatedAt
+	aux.CompletedAt = res.CompletedAt
+	aux.CompletedBy = res.CompletedBy
+	return
+}
+
+// decodes AutomationTask from auxAutomationTask
+//
+// This function is auto-generated
+func (aux auxAutomationTask) decode() (res *automationType.Task, _ error) {
+	res = new(automationType.Task)
+	res.ID = aux.ID
+	res.SessionID = aux.SessionID
+	res.StateID = aux.StateID
+	res.WorkflowID = aux.WorkflowID
+	res.StepID = aux.StepID
+	res.Ref = aux.Ref
+	res.Payload = aux.Payload
+	res.OwnerID = aux.OwnerID
+	res.AssignedUserID = aux.AssignedUserID
+	res.AssignedRoleID = aux.AssignedRoleID
+	res.ClaimedBy = aux.ClaimedBy
+	res.DelegatedBy = aux.DelegatedBy
+	res.Status = aux.Status
+	res.DueAt = aux.DueAt
+	res.CreatedAt = aux.CreatedAt
+	res.UpdatedAt = aux.UpdatedAt
+	res.CompletedAt = aux.CompletedAt
+	res.CompletedBy = aux.CompletedBy
+	return
+}
+
+// scans row and fills auxAutomationTask fields
+//
+// This function is auto-generated
+func (aux *auxAutomationTask) scan(row scanner) error {
+	return row.Scan(
+		&aux.ID,
+		&aux.SessionID,
+		&aux.StateID,
+		&aux.WorkflowID,
+		&aux.StepID,
+		&aux.Ref,
+		&aux.Payload,
+		&aux.OwnerID,
+		&aux.AssignedUserID,
+		&aux.AssignedRoleID,
+		&aux.ClaimedBy,
+		&aux.DelegatedBy,
+		&aux.Status,
+		&aux.DueAt,
+		&aux.CreatedAt,
+		&aux.UpdatedAt,
+		&aux.CompletedAt,
+		&aux.CompletedBy,
+	)
+}
+
 // encodes AutomationTrigger to auxAutomationTrigger
 //
 // This function is auto-generated
@@ -1261,6 +1686,7 @@ func (aux *auxAutomationWorkflow) encode(res *automationType.Workflow) (_ error)
 	aux.Paths = res.Paths
 	aux.Issues = res.Issues
 	aux.RunAs = res.RunAs
+	aux.PublishedRevision = res.PublishedRevision
 	aux.OwnedBy = res.OwnedBy
 	aux.CreatedAt = res.CreatedAt
 	aux.UpdatedAt = res.UpdatedAt
@@ -1287,6 +1713,7 @@ func (aux auxAutomationWorkflow) decode() (res *automationType.Workflow, _ error
 	res.Paths = aux.Paths
 	res.Issues = aux.Issues
 	res.RunAs = aux.RunAs
+	res.PublishedRevision = aux.PublishedRevision
 	res.OwnedBy = aux.OwnedBy
 	res.CreatedAt = aux.CreatedAt
 	res.UpdatedAt = aux.UpdatedAt
@@ -1313,6 +1740,128 @@ func (aux *auxAutomationWorkflow) scan(row scanner) error {
 		&aux.Paths,
 		&aux.Issues,
 		&aux.RunAs,
+		&aux.PublishedRevision,
+		&aux.OwnedBy,
+		&aux.CreatedAt,
+		&aux.UpdatedAt,
+		&aux.DeletedAt,
+		&aux.CreatedBy,
+		&aux.UpdatedBy,
+		&aux.DeletedBy,
+	)
+}
+
+// encodes AutomationWorkflowRevision to auxAutomationWorkflowRevision
+//
+// This function is auto-generated
+func (aux *auxAutomationWorkflowRevision) encode(res *automationType.WorkflowRevision) (_ error) {
+	aux.ID = res.ID
+	aux.WorkflowID = res.WorkflowID
+	aux.Revision = res.Revision
+	aux.Meta = res.Meta
+	aux.Scope = res.Scope
+	aux.Steps = res.Steps
+	aux.Paths = res.Paths
+	aux.CreatedAt = res.CreatedAt
+	aux.CreatedBy = res.CreatedBy
+	return
+}
+
+// decodes AutomationWorkflowRevision from auxAutomationWorkflowRevision
+//
+// This function is auto-generated
+func (aux auxAutomationWorkflowRevision) decode() (res *automationType.WorkflowRevision, _ error) {
+	res = new(automationType.WorkflowRevision)
+	res.ID = aux.ID
+	res.WorkflowID = aux.WorkflowID
+	res.Revision = aux.Revision
+	res.Meta = aux.Meta
+	res.Scope = aux.Scope
+	res.Steps = aux.Steps
+	res.Paths = aux.Paths
+	res.CreatedAt = aux.CreatedAt
+	res.CreatedBy = aux.CreatedBy
+	return
+}
+
+// scans row and fills auxAutomationWorkflowRevision fields
+//
+// This function is auto-generated
+func (aux *auxAutomationWorkflowRevision) scan(row scanner) error {
+	return row.Scan(
+		&aux.ID,
+		&aux.WorkflowID,
+		&aux.Revision,
+		&aux.Meta,
+		&aux.Scope,
+		&aux.Steps,
+		&aux.Paths,
+		&aux.CreatedAt,
+		&aux.CreatedBy,
+	)
+}
+
+// encodes AutomationWorkflowTestCase to auxAutomationWorkflowTestCase
+//
+// This function is auto-generated
+func (aux *auxAutomationWorkflowTestCase) encode(res *automationType.WorkflowTestCase) (_ error) {
+	aux.ID = res.ID
+	aux.WorkflowID = res.WorkflowID
+	aux.Meta = res.Meta
+	aux.StepID = res.StepID
+	aux.Input = res.Input
+	aux.Mocks = res.Mocks
+	aux.Passthrough = res.Passthrough
+	aux.Assertions = res.Assertions
+	aux.ExpectError = res.ExpectError
+	aux.OwnedBy = res.OwnedBy
+	aux.CreatedAt = res.CreatedAt
+	aux.UpdatedAt = res.UpdatedAt
+	aux.DeletedAt = res.DeletedAt
+	aux.CreatedBy = res.CreatedBy
+	aux.UpdatedBy = res.UpdatedBy
+	aux.DeletedBy = res.DeletedBy
+	return
+}
+
+// decodes AutomationWorkflowTestCase from auxAutomationWorkflowTestCase
+//
+// This function is auto-generated
+func (aux auxAutomationWorkflowTestCase) decode() (res *automationType.WorkflowTestCase, _ error) {
+	res = new(automationType.WorkflowTestCase)
+	res.ID = aux.ID
+	res.WorkflowID = aux.WorkflowID
+	res.Meta = aux.Meta
+	res.StepID = aux.StepID
+	res.Input = aux.Input
+	res.Mocks = aux.Mocks
+	res.Passthrough = aux.Passthrough
+	res.Assertions = aux.Assertions
+	res.ExpectError = aux.ExpectError
+	res.OwnedBy = aux.OwnedBy
+	res.CreatedAt = aux.CreatedAt
+	res.UpdatedAt = aux.UpdatedAt
+	res.DeletedAt = aux.DeletedAt
+	res.CreatedBy = aux.CreatedBy
+	res.UpdatedBy = aux.UpdatedBy
+	res.DeletedBy = aux.DeletedBy
+	return
+}
+
+// scans row and fills auxAutomationWorkflowTestCase fields
+//
+// This function is auto-generated
+func (aux *auxAutomationWorkflowTestCase) scan(row scanner) error {
+	return row.Scan(
+		&aux.ID,
+		&aux.WorkflowID,
+		&aux.Meta,
+		&aux.StepID,
+		&aux.Input,
+		&aux.Mocks,
+		&aux.Passthrough,
+		&aux.Assertions,
+		&aux.ExpectError,
 		&aux.OwnedBy,
 		&aux.CreatedAt,
 		&aux.UpdatedAt,
@@ -1494,6 +2043,7 @@ func (aux *auxComposeModuleField) encode(res *composeType.ModuleField) (_ error)
 	aux.Multi = res.Multi
 	aux.DefaultValue = res.DefaultValue
 	aux.Expressions = res.Expressions
+	aux.SensitivityLevel = res.SensitivityLevel
 	aux.CreatedAt = res.CreatedAt
 	aux.UpdatedAt = res.UpdatedAt
 	aux.DeletedAt = res.DeletedAt
@@ -1519,6 +2069,7 @@ func (aux auxComposeModuleField) decode() (res *composeType.ModuleField, _ error
 	res.Multi = aux.Multi
 	res.DefaultValue = aux.DefaultValue
 	res.Expressions = aux.Expressions
+	res.SensitivityLevel = aux.SensitivityLevel
 	res.CreatedAt = aux.CreatedAt
 	res.UpdatedAt = aux.UpdatedAt
 	res.DeletedAt = aux.DeletedAt
@@ -1544,6 +2095,7 @@ func (aux *auxComposeModuleField) scan(row scanner) error {
 		&aux.Multi,
 		&aux.DefaultValue,
 		&aux.Expressions,
+		&aux.SensitivityLevel,
 		&aux.CreatedAt,
 		&aux.UpdatedAt,
 		&aux.DeletedAt,
@@ -1715,6 +2267,162 @@ func (aux *auxComposeRecord) scan(row scanner) error {
 	)
 }
 
+// encodes ComposeRecordImportError to auxComposeRecordImportError
+//
+// This function is auto-generated
+func (aux *auxComposeRecordImportError) encode(res *composeType.RecordImportError) (_ error) {
+	aux.SessionID = res.SessionID
+	aux.Row = res.Row
+	aux.Message = res.Message
+	aux.CreatedAt = res.CreatedAt
+	return
+}
+
+// decodes ComposeRecordImportError from auxComposeRecordImportError
+//
+// This function is auto-generated
+func (aux auxComposeRecordImportError) decode() (res *composeType.RecordImportError, _ error) {
+	res = new(composeType.RecordImportError)
+	res.SessionID = aux.SessionID
+	res.Row = aux.Row
+	res.Message = aux.Message
+	res.CreatedAt = aux.CreatedAt
+	return
+}
+
+// scans row and fills auxComposeRecordImportError fields
+//
+// This function is auto-generated
+func (aux *auxComposeRecordImportError) scan(row scanner) error {
+	return row.Scan(
+		&aux.SessionID,
+		&aux.Row,
+		&aux.Message,
+		&aux.CreatedAt,
+	)
+}
+
+// encodes ComposeRecordImportSession to auxComposeRecordImportSession
+//
+// This function is auto-generated
+func (aux *auxComposeRecordImportSession) encode(res *composeType.RecordImportSession) (_ error) {
+	aux.ID = res.ID
+	aux.Name = res.Name
+	aux.ContentType = res.ContentType
+	aux.Source = res.Source
+	aux.Sheet = res.Sheet
+	aux.Sheets = res.Sheets
+	aux.UserID = res.UserID
+	aux.NamespaceID = res.NamespaceID
+	aux.ModuleID = res.ModuleID
+	aux.OnError = res.OnError
+	aux.Fields = res.Fields
+	aux.Key = res.Key
+	aux.Progress = res.Progress
+	aux.CreatedAt = res.CreatedAt
+	aux.UpdatedAt = res.UpdatedAt
+	return
+}
+
+// decodes ComposeRecordImportSession from auxComposeRecordImportSession
+//
+// This function is auto-generated
+func (aux auxComposeRecordImportSession) decode() (res *composeType.RecordImportSession, _ error) {
+	res = new(composeType.RecordImportSession)
+	res.ID = aux.ID
+	res.Name = aux.Name
+	res.ContentType = aux.ContentType
+	res.Source = aux.Source
+	res.Sheet = aux.Sheet
+	res.Sheets = aux.Sheets
+	res.UserID = aux.UserID
+	res.NamespaceID = aux.NamespaceID
+	res.ModuleID = aux.ModuleID
+	res.OnError = aux.OnError
+	res.Fields = aux.Fields
+	res.Key = aux.Key
+	res.Progress = aux.Progress
+	res.CreatedAt = aux.CreatedAt
+	res.UpdatedAt = aux.UpdatedAt
+	return
+}
+
+// scans row and fills auxComposeRecordImportSession fields
+//
+// This function is auto-generated
+func (aux *auxComposeRecordImportSession) scan(row scanner) error {
+	return row.Scan(
+		&aux.ID,
+		&aux.Name,
+		&aux.ContentType,
+		&aux.Source,
+		&aux.Sheet,
+		&aux.Sheets,
+		&aux.UserID,
+		&aux.NamespaceID,
+		&aux.ModuleID,
+		&aux.OnError,
+		&aux.Fields,
+		&aux.Key,
+		&aux.Progress,
+		&aux.CreatedAt,
+		&aux.UpdatedAt,
+	)
+}
+
+// encodes ComposeRecordRevision to auxComposeRecordRevision
+//
+// This function is auto-generated
+func (aux *auxComposeRecordRevision) encode(res *composeType.RecordRevision) (_ error) {
+	aux.ID = res.ID
+	aux.RecordID = res.RecordID
+	aux.ModuleID = res.ModuleID
+	aux.Revision = res.Revision
+	aux.Operation = res.Operation
+	aux.UserID = res.UserID
+	aux.SessionID = res.SessionID
+	aux.Values = res.Values
+	aux.Changes = res.Changes
+	aux.CreatedAt = res.CreatedAt
+	return
+}
+
+// decodes ComposeRecordRevision from auxComposeRecordRevision
+//
+// This function is auto-generated
+func (aux auxComposeRecordRevision) decode() (res *composeType.RecordRevision, _ error) {
+	res = new(composeType.RecordRevision)
+	res.ID = aux.ID
+	res.RecordID = aux.RecordID
+	res.ModuleID = aux.ModuleID
+	res.Revision = aux.Revision
+	res.Operation = aux.Operation
+	res.UserID = aux.UserID
+	res.SessionID = aux.SessionID
+	res.Values = aux.Values
+	res.Changes = aux.Changes
+	res.CreatedAt = aux.CreatedAt
+	return
+}
+
+// scans row and fills auxComposeRecordRevision fields
+//
+// This function is auto-generated
+func (aux *auxComposeRecordRevision) scan(row scanner) error {
+	return row.Scan(
+		&aux.ID,
+		&aux.RecordID,
+		&aux.ModuleID,
+		&aux.Revision,
+		&aux.Operation,
+		&aux.UserID,
+		&aux.SessionID,
+		&aux.Values,
+		&aux.Changes,
+		&aux.CreatedAt,
+	)
+}
+
 // encodes ComposeRecordValue to auxComposeRecordValue
 //
 // This function is auto-generated
@@ -2233,6 +2941,59 @@ func (aux *auxFlag) scan(row scanner) error {
 	)
 }
 
+// encodes HolidayCalendar to auxHolidayCalendar
+//
+// This function is auto-generated
+func (aux *auxHolidayCalendar) encode(res *systemType.HolidayCalendar) (_ error) {
+	aux.ID = res.ID
+	aux.Handle = res.Handle
+	aux.Meta = res.Meta
+	aux.Holidays = res.Holidays
+	aux.CreatedAt = res.CreatedAt
+	aux.UpdatedAt = res.UpdatedAt
+	aux.DeletedAt = res.DeletedAt
+	aux.CreatedBy = res.CreatedBy
+	aux.UpdatedBy = res.UpdatedBy
+	aux.DeletedBy = res.DeletedBy
+	return
+}
+
+// decodes HolidayCalendar from auxHolidayCalendar
+//
+// This function is auto-generated
+func (aux auxHolidayCalendar) decode() (res *systemType.HolidayCalendar, _ error) {
+	res = new(systemType.HolidayCalendar)
+	res.ID = aux.ID
+	res.Handle = aux.Handle
+	res.Meta = aux.Meta
+	res.Holidays = aux.Holidays
+	res.CreatedAt = aux.CreatedAt
+	res.UpdatedAt = aux.UpdatedAt
+	res.DeletedAt = aux.DeletedAt
+	res.CreatedBy = aux.CreatedBy
+	res.UpdatedBy = aux.UpdatedBy
+	res.DeletedBy = aux.DeletedBy
+	return
+}
+
+// scans row and fills auxHolidayCalendar fields
+//
+// This function is auto-generated
+func (aux *auxHolidayCalendar) scan(row scanner) error {
+	return row.Scan(
+		&aux.ID,
+		&aux.Handle,
+		&aux.Meta,
+		&aux.Holidays,
+		&aux.CreatedAt,
+		&aux.UpdatedAt,
+		&aux.DeletedAt,
+		&aux.CreatedBy,
+		&aux.UpdatedBy,
+		&aux.DeletedBy,
+	)
+}
+
 // encodes Label to auxLabel
 //
 // This function is auto-generated
@@ -0,0 +1,145 @@
+package rdbms
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	systemType "github.com/cortezaproject/corteza-server/system/types"
+	"github.com/doug-martin/goqu/v9"
+)
+
+func (s Store) DeleteExpiredApigwProfilerRecords(ctx context.Context, before time.Time) error {
+	return s.Exec(ctx, apigwProfilerRecordDeleteQuery(s.Dialect, goqu.C("started_at").Lt(before)))
+}
+
+// AggregateApigwProfilerRecords groups the records by path
+//
+// Percentiles are read with a query per path and percentile
+// since they can not be calculated the same way on all databases
+func (s Store) AggregateApigwProfilerRecords(ctx context.Context, f systemType.ApigwProfilerRecordFilter) (set systemType.ApigwProfilerRecordAggregateSet, err error) {
+	var (
+		expr []goqu.Expression
+		rows *sql.Rows
+	)
+
+	if s.Filters.ApigwProfilerRecord != nil {
+		expr, f, err = s.Filters.ApigwProfilerRecord(&s, f)
+	} else {
+		expr, f, err = ApigwProfilerRecordFilter(f)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could generate filter expression for ApigwProfilerRecord: %w", err)
+	}
+
+	query := s.Dialect.
+		Select(
+			goqu.C("path"),
+			goqu.MAX("rel_route"),
+			goqu.COUNT(goqu.Star()),
+			goqu.MIN("duration"),
+			goqu.MAX("duration"),
+			goqu.SUM("duration"),
+			goqu.MIN("content_length"),
+			goqu.MAX("content_length"),
+			goqu.SUM("content_length"),
+		).
+		From(apigwProfilerRecordTable).
+		Where(expr...).
+		GroupBy(goqu.C("path")).
+		Order(goqu.C("path").Asc())
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	err = func() error {
+		if rows, err = s.Query(ctx, query); err != nil {
+			return err
+		}
+
+		defer rows.Close()
+		for rows.Next() {
+			a := &systemType.ApigwProfilerRecordAggregate{}
+			err = rows.Scan(
+				&a.Path,
+				&a.Route,
+				&a.Count,
+				&a.DurationMin,
+				&a.DurationMax,
+				&a.DurationSum,
+				&a.ContentLengthMin,
+				&a.ContentLengthMax,
+				&a.ContentLengthSum,
+			)
+
+			if err != nil {
+				return err
+			}
+
+			set = append(set, a)
+		}
+
+		return rows.Err()
+	}()
+
+	if err != nil {
+		return nil, fmt.Errorf("could not aggregate ApigwProfilerRecord: %w", err)
+	}
+
+	for _, a := range set {
+		var (
+			pathExpr = append(expr[:len(expr):len(expr)], goqu.C("path").Eq(a.Path))
+		)
+
+		pp := map[float64]*int64{
+			50: &a.DurationP50,
+			90: &a.DurationP90,
+			95: &a.DurationP95,
+			99: &a.DurationP99,
+		}
+
+		for p, dst := range pp {
+			if *dst, err = s.apigwProfilerRecordDurationAt(ctx, pathExpr, a.Count, p); err != nil {
+				return nil, fmt.Errorf("could not aggregate ApigwProfilerRecord: %w", err)
+			}
+		}
+	}
+
+	return set, nil
+}
+
+// apigwProfilerRecordDurationAt returns the duration at the given percentile
+// of the count matching records, using the nearest-rank method
+func (s Store) apigwProfilerRecordDurationAt(ctx context.Context, expr []goqu.Expression, count uint64, p float64) (d int64, err error) {
+	var (
+		rows *sql.Rows
+		i    = int64(math.Ceil(p/100*float64(count))) - 1
+	)
+
+	if i < 0 {
+		i = 0
+	}
+
+	query := s.Dialect.
+		Select(goqu.C("duration")).
+		From(apigwProfilerRecordTable).
+		Where(expr...).
+		Order(goqu.C("duration").Asc()).
+		Limit(1).
+		Offset(uint(i))
+
+	if rows, err = s.Query(ctx, query); err != nil {
+		return
+	}
+
+	defer rows.Close()
+	if rows.Next() {
+		err = rows.Scan(&d)
+	}
+
+	return
+}
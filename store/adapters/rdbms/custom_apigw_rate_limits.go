@@ -0,0 +1,39 @@
+package rdbms
+
+import (
+	"context"
+	"time"
+
+	systemType "github.com/cortezaproject/corteza-server/system/types"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// SaveApigwRateLimit creates or updates the rate limit counter
+//
+// Counter with version 1 is created (conflicting insert is ignored),
+// counters with higher versions are updated only when the stored
+// version is the previous one.
+func (s Store) SaveApigwRateLimit(ctx context.Context, counter *systemType.ApigwRateLimit) (bool, error) {
+	if counter.Version <= 1 {
+		counter.Version = 1
+		return s.execAffecting(ctx, apigwRateLimitInsertQuery(s.Dialect, counter).OnConflict(goqu.DoNothing()))
+	}
+
+	return s.execAffecting(ctx, s.Dialect.
+		Update(apigwRateLimitTable).
+		Set(goqu.Record{
+			"count":      counter.Count,
+			"stamp":      counter.Stamp,
+			"version":    counter.Version,
+			"expires_at": counter.ExpiresAt,
+		}).
+		Where(
+			goqu.C("name").Eq(counter.Name),
+			goqu.C("version").Eq(counter.Version-1),
+		),
+	)
+}
+
+func (s Store) DeleteExpiredApigwRateLimits(ctx context.Context) error {
+	return s.Exec(ctx, apigwRateLimitDeleteQuery(s.Dialect, goqu.C("expires_at").Lt(time.Now())))
+}
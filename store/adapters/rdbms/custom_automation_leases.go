@@ -0,0 +1,77 @@
+package rdbms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	automationType "github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/store"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// AcquireAutomationLease creates or renews a lease
+//
+// Lease is renewed only when held by the same owner or when it expired;
+// both cases are handled with a single (conditional) update so that
+// concurrent acquisitions can not both succeed.
+func (s Store) AcquireAutomationLease(ctx context.Context, lease *automationType.Lease) (bool, error) {
+	var (
+		now = time.Now()
+
+		upd = s.Dialect.
+			Update(automationLeaseTable).
+			Set(goqu.Record{
+				"owner":      lease.Owner,
+				"expires_at": lease.ExpiresAt,
+				"updated_at": now,
+			}).
+			Where(
+				goqu.C("name").Eq(lease.Name),
+				goqu.Or(
+					goqu.C("owner").Eq(lease.Owner),
+					goqu.C("expires_at").Lt(now),
+				),
+			)
+	)
+
+	if lease.CreatedAt.IsZero() {
+		lease.CreatedAt = now
+	}
+
+	if ok, err := s.execAffecting(ctx, upd); err != nil || ok {
+		return ok, err
+	}
+
+	// lease does not exist yet (or it is held by someone else);
+	// conflicting insert is ignored
+	return s.execAffecting(ctx, automationLeaseInsertQuery(s.Dialect, lease).OnConflict(goqu.DoNothing()))
+}
+
+func (s Store) ReleaseAutomationLease(ctx context.Context, name string, owner string) error {
+	return s.Exec(ctx, automationLeaseDeleteQuery(s.Dialect, goqu.Ex{"name": name, "owner": owner}))
+}
+
+func (s Store) DeleteExpiredAutomationLeases(ctx context.Context) error {
+	return s.Exec(ctx, automationLeaseDeleteQuery(s.Dialect, goqu.C("expires_at").Lt(time.Now())))
+}
+
+// execAffecting executes the query and reports if any of the rows were affected
+func (s Store) execAffecting(ctx context.Context, q sqlizer) (bool, error) {
+	query, args, err := q.ToSQL()
+	if err != nil {
+		return false, fmt.Errorf("could not build query: %w", err)
+	}
+
+	res, err := s.DB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, store.HandleError(err, s.ErrorHandler)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, store.HandleError(err, s.ErrorHandler)
+	}
+
+	return n > 0, nil
+}
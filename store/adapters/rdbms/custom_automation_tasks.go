@@ -0,0 +1,16 @@
+package rdbms
+
+import (
+	"context"
+
+	automationType "github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// TransitionAutomationTask updates the task with a single (conditional)
+// update so that concurrent changes of the task status can not both succeed
+func (s Store) TransitionAutomationTask(ctx context.Context, t *automationType.Task, from automationType.TaskStatus) (bool, error) {
+	return s.execAffecting(ctx, automationTaskUpdateQuery(s.Dialect, t).
+		Where(goqu.C("status").Eq(from)),
+	)
+}
@@ -0,0 +1,11 @@
+package rdbms
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+)
+
+func (s Store) DeleteComposeRecordImportErrorsBySessionID(ctx context.Context, sessionID uint64) error {
+	return s.Exec(ctx, composeRecordImportErrorDeleteQuery(s.Dialect, goqu.C("rel_session").Eq(sessionID)))
+}
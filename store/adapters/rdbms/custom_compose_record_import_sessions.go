@@ -0,0 +1,29 @@
+package rdbms
+
+import (
+	"context"
+	"time"
+
+	composeType "github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// ClaimComposeRecordImportSession updates the import session
+// with a single (conditional) update so that the session
+// can not be claimed by more than one node
+func (s Store) ClaimComposeRecordImportSession(ctx context.Context, ses *composeType.RecordImportSession, updatedBefore time.Time) (bool, error) {
+	return s.execAffecting(ctx, composeRecordImportSessionUpdateQuery(s.Dialect, ses).
+		Where(goqu.C("updated_at").Lt(updatedBefore)),
+	)
+}
+
+// StartComposeRecordImportSession updates the import session
+// with a single (conditional) update so that the session
+// can not be started by more than one request
+//
+// loadedAt holds the update time of the session when it was loaded
+func (s Store) StartComposeRecordImportSession(ctx context.Context, ses *composeType.RecordImportSession, loadedAt time.Time) (bool, error) {
+	return s.execAffecting(ctx, composeRecordImportSessionUpdateQuery(s.Dialect, ses).
+		Where(goqu.C("updated_at").Lte(loadedAt)),
+	)
+}
@@ -0,0 +1,16 @@
+package rdbms
+
+import (
+	"context"
+
+	composeType "github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/doug-martin/goqu/v9"
+)
+
+// CreateUniqueComposeRecordRevision creates the revision
+//
+// Conflicting insert (on unique record & revision number) is ignored
+// instead of failing so that the transaction can continue
+func (s Store) CreateUniqueComposeRecordRevision(ctx context.Context, rev *composeType.RecordRevision) (bool, error) {
+	return s.execAffecting(ctx, composeRecordRevisionInsertQuery(s.Dialect, rev).OnConflict(goqu.DoNothing()))
+}
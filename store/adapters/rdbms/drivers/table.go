@@ -58,7 +58,7 @@ func NewTableCodec(m *dal.Model, d Dialect) *GenericTableCodec {
 			continue
 		}
 
-		switch attr.Store.(type) {
+		switch dal.BaseCodec(attr.Store).(type) {
 		case *dal.CodecRecordValueSetJSON:
 			// when dealing with encoded types there is probably
 			// a different column that can handle the encoded payload
@@ -130,7 +130,7 @@ func (t *GenericTableCodec) AttributeExpression(ident string) (exp.LiteralExpres
 		return nil, fmt.Errorf("unknown attribute %q", ident)
 	}
 
-	switch s := attr.Store.(type) {
+	switch s := dal.BaseCodec(attr.Store).(type) {
 	case *dal.CodecAlias:
 		// using column directly
 		return exp.NewLiteralExpression("?", exp.NewIdentifierExpression("", t.model.Ident, s.Ident)), nil
@@ -149,7 +149,7 @@ func (t *GenericTableCodec) AttributeExpression(ident string) (exp.LiteralExpres
 }
 
 func attrColumnIdent(att *dal.Attribute) string {
-	switch ss := att.Store.(type) {
+	switch ss := dal.BaseCodec(att.Store).(type) {
 	case *dal.CodecRecordValueSetJSON:
 		return ss.Ident
 
@@ -164,7 +164,7 @@ func attrColumnIdent(att *dal.Attribute) string {
 func collectStdRecordValueJSONColumns(ident string, aa ...*dal.Attribute) []*dal.Attribute {
 	filtered := make([]*dal.Attribute, 0)
 	for _, a := range aa {
-		storeType, is := a.Store.(*dal.CodecRecordValueSetJSON)
+		storeType, is := dal.BaseCodec(a.Store).(*dal.CodecRecordValueSetJSON)
 		if !is {
 			continue
 		}
@@ -48,6 +48,31 @@ func DefaultFilters() (f *extendedFilters) {
 		return ee, f, err
 	}
 
+	f.ApigwProfilerRecord = func(s *Store, f systemType.ApigwProfilerRecordFilter) (ee []goqu.Expression, _ systemType.ApigwProfilerRecordFilter, err error) {
+		if ee, f, err = ApigwProfilerRecordFilter(f); err != nil {
+			return
+		}
+
+		// make sure we always sort ID, descending
+		if f.Sorting, err = filter.NewSorting("id DESC"); err != nil {
+			return
+		}
+
+		if f.FromTimestamp != nil {
+			ee = append(ee, goqu.C("started_at").Gte(f.FromTimestamp))
+		}
+
+		if f.BeforeID > 0 {
+			ee = append(ee, goqu.C("id").Lt(f.BeforeID))
+		}
+
+		if f.Limit == 0 || f.Limit > MaxLimit {
+			f.Limit = MaxLimit
+		}
+
+		return ee, f, err
+	}
+
 	f.Application = func(s *Store, f systemType.ApplicationFilter) (ee []goqu.Expression, _ systemType.ApplicationFilter, err error) {
 		if ee, f, err = ApplicationFilter(f); err != nil {
 			return
@@ -72,6 +97,31 @@ func DefaultFilters() (f *extendedFilters) {
 		return ee, f, err
 	}
 
+	f.AutomationTask = func(s *Store, f automationType.TaskFilter) (ee []goqu.Expression, _ automationType.TaskFilter, err error) {
+		if ee, f, err = AutomationTaskFilter(f); err != nil {
+			return
+		}
+
+		if len(f.Status) > 0 {
+			ee = append(ee, goqu.C("status").In(f.Status))
+		}
+
+		if f.InboxUserID > 0 {
+			inbox := goqu.Or(goqu.C("rel_assigned_user").Eq(f.InboxUserID))
+
+			if len(f.InboxRoleID) > 0 {
+				inbox = inbox.Append(goqu.And(
+					goqu.C("rel_assigned_role").In(f.InboxRoleID),
+					goqu.C("claimed_by").In(0, f.InboxUserID),
+				))
+			}
+
+			ee = append(ee, inbox)
+		}
+
+		return ee, f, err
+	}
+
 	f.ComposeAttachment = func(s *Store, f composeType.AttachmentFilter) (ee []goqu.Expression, _ composeType.AttachmentFilter, err error) {
 		if ee, f, err = ComposeAttachmentFilter(f); err != nil {
 			return
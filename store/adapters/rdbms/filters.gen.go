@@ -35,6 +35,12 @@ type (
 		// optional apigwFilter filter function called after the generated function
 		ApigwFilter func(*Store, systemType.ApigwFilterFilter) ([]goqu.Expression, systemType.ApigwFilterFilter, error)
 
+		// optional apigwProfilerRecord filter function called after the generated function
+		ApigwProfilerRecord func(*Store, systemType.ApigwProfilerRecordFilter) ([]goqu.Expression, systemType.ApigwProfilerRecordFilter, error)
+
+		// optional apigwRateLimit filter function called after the generated function
+		ApigwRateLimit func(*Store, systemType.ApigwRateLimitFilter) ([]goqu.Expression, systemType.ApigwRateLimitFilter, error)
+
 		// optional apigwRoute filter function called after the generated function
 		ApigwRoute func(*Store, systemType.ApigwRouteFilter) ([]goqu.Expression, systemType.ApigwRouteFilter, error)
 
@@ -56,15 +62,30 @@ type (
 		// optional authSession filter function called after the generated function
 		AuthSession func(*Store, systemType.AuthSessionFilter) ([]goqu.Expression, systemType.AuthSessionFilter, error)
 
+		// optional automationLease filter function called after the generated function
+		AutomationLease func(*Store, automationType.LeaseFilter) ([]goqu.Expression, automationType.LeaseFilter, error)
+
 		// optional automationSession filter function called after the generated function
 		AutomationSession func(*Store, automationType.SessionFilter) ([]goqu.Expression, automationType.SessionFilter, error)
 
+		// optional automationSessionResume filter function called after the generated function
+		AutomationSessionResume func(*Store, automationType.SessionResumeFilter) ([]goqu.Expression, automationType.SessionResumeFilter, error)
+
+		// optional automationTask filter function called after the generated function
+		AutomationTask func(*Store, automationType.TaskFilter) ([]goqu.Expression, automationType.TaskFilter, error)
+
 		// optional automationTrigger filter function called after the generated function
 		AutomationTrigger func(*Store, automationType.TriggerFilter) ([]goqu.Expression, automationType.TriggerFilter, error)
 
 		// optional automationWorkflow filter function called after the generated function
 		AutomationWorkflow func(*Store, automationType.WorkflowFilter) ([]goqu.Expression, automationType.WorkflowFilter, error)
 
+		// optional automationWorkflowRevision filter function called after the generated function
+		AutomationWorkflowRevision func(*Store, automationType.WorkflowRevisionFilter) ([]goqu.Expression, automationType.WorkflowRevisionFilter, error)
+
+		// optional automationWorkflowTestCase filter function called after the generated function
+		AutomationWorkflowTestCase func(*Store, automationType.WorkflowTestCaseFilter) ([]goqu.Expression, automationType.WorkflowTestCaseFilter, error)
+
 		// optional composeAttachment filter function called after the generated function
 		ComposeAttachment func(*Store, composeType.AttachmentFilter) ([]goqu.Expression, composeType.AttachmentFilter, error)
 
@@ -86,6 +107,15 @@ type (
 		// optional composeRecord filter function called after the generated function
 		ComposeRecord func(*Store, composeType.RecordFilter) ([]goqu.Expression, composeType.RecordFilter, error)
 
+		// optional composeRecordImportError filter function called after the generated function
+		ComposeRecordImportError func(*Store, composeType.RecordImportErrorFilter) ([]goqu.Expression, composeType.RecordImportErrorFilter, error)
+
+		// optional composeRecordImportSession filter function called after the generated function
+		ComposeRecordImportSession func(*Store, composeType.RecordImportSessionFilter) ([]goqu.Expression, composeType.RecordImportSessionFilter, error)
+
+		// optional composeRecordRevision filter function called after the generated function
+		ComposeRecordRevision func(*Store, composeType.RecordRevisionFilter) ([]goqu.Expression, composeType.RecordRevisionFilter, error)
+
 		// optional composeRecordValue filter function called after the generated function
 		ComposeRecordValue func(*Store, composeType.RecordValueFilter) ([]goqu.Expression, composeType.RecordValueFilter, error)
 
@@ -116,6 +146,9 @@ type (
 		// optional flag filter function called after the generated function
 		Flag func(*Store, flagType.FlagFilter) ([]goqu.Expression, flagType.FlagFilter, error)
 
+		// optional holidayCalendar filter function called after the generated function
+		HolidayCalendar func(*Store, systemType.HolidayCalendarFilter) ([]goqu.Expression, systemType.HolidayCalendarFilter, error)
+
 		// optional label filter function called after the generated function
 		Label func(*Store, labelsType.LabelFilter) ([]goqu.Expression, labelsType.LabelFilter, error)
 
@@ -209,6 +242,42 @@ func ApigwFilterFilter(f systemType.ApigwFilterFilter) (ee []goqu.Expression, _
 	return ee, f, err
 }
 
+// ApigwProfilerRecordFilter returns logical expressions
+//
+// This function is called from Store.QueryApigwProfilerRecords() and can be extended
+// by setting Store.Filters.ApigwProfilerRecord. Extension is called after all expressions
+// are generated and can choose to ignore or alter them.
+//
+// This function is auto-generated
+func ApigwProfilerRecordFilter(f systemType.ApigwProfilerRecordFilter) (ee []goqu.Expression, _ systemType.ApigwProfilerRecordFilter, err error) {
+
+	if val := strings.TrimSpace(f.Hit); len(val) > 0 {
+		ee = append(ee, goqu.C("hit").Eq(f.Hit))
+	}
+
+	if f.Route > 0 {
+		ee = append(ee, goqu.C("rel_route").Eq(f.Route))
+	}
+
+	if val := strings.TrimSpace(f.Path); len(val) > 0 {
+		ee = append(ee, goqu.C("path").Eq(f.Path))
+	}
+
+	return ee, f, err
+}
+
+// ApigwRateLimitFilter returns logical expressions
+//
+// This function is called from Store.QueryApigwRateLimits() and can be extended
+// by setting Store.Filters.ApigwRateLimit. Extension is called after all expressions
+// are generated and can choose to ignore or alter them.
+//
+// This function is auto-generated
+func ApigwRateLimitFilter(f systemType.ApigwRateLimitFilter) (ee []goqu.Expression, _ systemType.ApigwRateLimitFilter, err error) {
+
+	return ee, f, err
+}
+
 // ApigwRouteFilter returns logical expressions
 //
 // This function is called from Store.QueryApigwRoutes() and can be extended
@@ -353,6 +422,22 @@ func AuthSessionFilter(f systemType.AuthSessionFilter) (ee []goqu.Expression, _
 	return ee, f, err
 }
 
+// AutomationLeaseFilter returns logical expressions
+//
+// This function is called from Store.QueryAutomationLeases() and can be extended
+// by setting Store.Filters.AutomationLease. Extension is called after all expressions
+// are generated and can choose to ignore or alter them.
+//
+// This function is auto-generated
+func AutomationLeaseFilter(f automationType.LeaseFilter) (ee []goqu.Expression, _ automationType.LeaseFilter, err error) {
+
+	if val := strings.TrimSpace(f.Owner); len(val) > 0 {
+		ee = append(ee, goqu.C("owner").Eq(f.Owner))
+	}
+
+	return ee, f, err
+}
+
 // AutomationSessionFilter returns logical expressions
 //
 // This function is called from Store.QueryAutomationSessions() and can be extended
@@ -373,6 +458,10 @@ func AutomationSessionFilter(f automationType.SessionFilter) (ee []goqu.Expressi
 		ee = append(ee, goqu.C("rel_workflow").In(f.WorkflowID))
 	}
 
+	if len(f.CallerSessionID) > 0 {
+		ee = append(ee, goqu.C("rel_caller_session").In(f.CallerSessionID))
+	}
+
 	if val := strings.TrimSpace(f.EventType); len(val) > 0 {
 		ee = append(ee, goqu.C("event_type").Eq(f.EventType))
 	}
@@ -388,6 +477,61 @@ func AutomationSessionFilter(f automationType.SessionFilter) (ee []goqu.Expressi
 	return ee, f, err
 }
 
+// AutomationSessionResumeFilter returns logical expressions
+//
+// This function is called from Store.QueryAutomationSessionResumes() and can be extended
+// by setting Store.Filters.AutomationSessionResume. Extension is called after all expressions
+// are generated and can choose to ignore or alter them.
+//
+// This function is auto-generated
+func AutomationSessionResumeFilter(f automationType.SessionResumeFilter) (ee []goqu.Expression, _ automationType.SessionResumeFilter, err error) {
+
+	if len(f.SessionID) > 0 {
+		ee = append(ee, goqu.C("rel_session").In(f.SessionID))
+	}
+
+	return ee, f, err
+}
+
+// AutomationTaskFilter returns logical expressions
+//
+// This function is called from Store.QueryAutomationTasks() and can be extended
+// by setting Store.Filters.AutomationTask. Extension is called after all expressions
+// are generated and can choose to ignore or alter them.
+//
+// This function is auto-generated
+func AutomationTaskFilter(f automationType.TaskFilter) (ee []goqu.Expression, _ automationType.TaskFilter, err error) {
+
+	if expr := stateNilComparison("completed_at", f.Completed); expr != nil {
+		ee = append(ee, expr)
+	}
+
+	if len(f.TaskID) > 0 {
+		ee = append(ee, goqu.C("id").In(f.TaskID))
+	}
+
+	if len(f.SessionID) > 0 {
+		ee = append(ee, goqu.C("rel_session").In(f.SessionID))
+	}
+
+	if len(f.WorkflowID) > 0 {
+		ee = append(ee, goqu.C("rel_workflow").In(f.WorkflowID))
+	}
+
+	if len(f.AssignedUserID) > 0 {
+		ee = append(ee, goqu.C("rel_assigned_user").In(f.AssignedUserID))
+	}
+
+	if len(f.AssignedRoleID) > 0 {
+		ee = append(ee, goqu.C("rel_assigned_role").In(f.AssignedRoleID))
+	}
+
+	// @todo codegen warning: filtering by Status ([]string) not supported,
+	//       see rdbms.go.tpl and add an exception
+
+	return ee, f, err
+}
+
 // AutomationTriggerFilter returns logical expressions
 //
 // This function is called from Store.QueryAutomationTriggers() and can be extended
@@ -458,6 +602,46 @@ func AutomationWorkflowFilter(f automationType.WorkflowFilter) (ee []goqu.Expres
 	return ee, f, err
 }
 
+// AutomationWorkflowRevisionFilter returns logical expressions
+//
+// This function is called from Store.QueryAutomationWorkflowRevisions() and can be extended
+// by setting Store.Filters.AutomationWorkflowRevision. Extension is called after all expressions
+// are generated and can choose to ignore or alter them.
+//
+// This function is auto-generated
+func AutomationWorkflowRevisionFilter(f automationType.WorkflowRevisionFilter) (ee []goqu.Expression, _ automationType.WorkflowRevisionFilter, err error) {
+
+	if f.WorkflowID > 0 {
+		ee = append(ee, goqu.C("rel_workflow").Eq(f.WorkflowID))
+	}
+
+	return ee, f, err
+}
+
+// AutomationWorkflowTestCaseFilter returns logical expressions
+//
+// This function is called from Store.QueryAutomationWorkflowTestCases() and can be extended
+// by setting Store.Filters.AutomationWorkflowTestCase. Extension is called after all expressions
+// are generated and can choose to ignore or alter them.
+//
+// This function is auto-generated
+func AutomationWorkflowTestCaseFilter(f automationType.WorkflowTestCaseFilter) (ee []goqu.Expression, _ automationType.WorkflowTestCaseFilter, err error) {
+
+	if expr := stateNilComparison("deleted_at", f.Deleted); expr != nil {
+		ee = append(ee, expr)
+	}
+
+	if len(f.TestCaseID) > 0 {
+		ee = append(ee, goqu.C("id").In(f.TestCaseID))
+	}
+
+	if len(f.WorkflowID) > 0 {
+		ee = append(ee, goqu.C("rel_workflow").In(f.WorkflowID))
+	}
+
+	return ee, f, err
+}
+
 // ComposeAttachmentFilter returns logical expressions
 //
 // This function is called from Store.QueryComposeAttachments() and can be extended
@@ -671,6 +855,58 @@ func ComposeRecordFilter(f composeType.RecordFilter) (ee []goqu.Expression, _ co
 	return ee, f, err
 }
 
+// ComposeRecordImportErrorFilter returns logical expressions
+//
+// This function is called from Store.QueryComposeRecordImportErrors() and can be extended
+// by setting Store.Filters.ComposeRecordImportError. Extension is called after all expressions
+// are generated and can choose to ignore or alter them.
+//
+// This function is auto-generated
+func ComposeRecordImportErrorFilter(f composeType.RecordImportErrorFilter) (ee []goqu.Expression, _ composeType.RecordImportErrorFilter, err error) {
+
+	if f.SessionID > 0 {
+		ee = append(ee, goqu.C("rel_session").Eq(f.SessionID))
+	}
+
+	return ee, f, err
+}
+
+// ComposeRecordImportSessionFilter returns logical expressions
+//
+// This function is called from Store.QueryComposeRecordImportSessions() and can be extended
+// by setting Store.Filters.ComposeRecordImportSession. Extension is called after all expressions
+// are generated and can choose to ignore or alter them.
+//
+// This function is auto-generated
+func ComposeRecordImportSessionFilter(f composeType.RecordImportSessionFilter) (ee []goqu.Expression, _ composeType.RecordImportSessionFilter, err error) {
+
+	if f.UserID > 0 {
+		ee = append(ee, goqu.C("rel_user").Eq(f.UserID))
+	}
+
+	return ee, f, err
+}
+
+// ComposeRecordRevisionFilter returns logical expressions
+//
+// This function is called from Store.QueryComposeRecordRevisions() and can be extended
+// by setting Store.Filters.ComposeRecordRevision. Extension is called after all expressions
+// are generated and can choose to ignore or alter them.
+//
+// This function is auto-generated
+func ComposeRecordRevisionFilter(f composeType.RecordRevisionFilter) (ee []goqu.Expression, _ composeType.RecordRevisionFilter, err error) {
+
+	if f.RecordID > 0 {
+		ee = append(ee, goqu.C("rel_record").Eq(f.RecordID))
+	}
+
+	if f.ModuleID > 0 {
+		ee = append(ee, goqu.C("rel_module").Eq(f.ModuleID))
+	}
+
+	return ee, f, err
+}
+
 // ComposeRecordValueFilter returns logical expressions
 //
 // This function is called from Store.QueryComposeRecordValues() and can be extended
@@ -909,6 +1145,30 @@ func FlagFilter(f flagType.FlagFilter) (ee []goqu.Expression, _ flagType.FlagFil
 	return ee, f, err
 }
 
+// HolidayCalendarFilter returns logical expressions
+//
+// This function is called from Store.QueryHolidayCalendars() and can be extended
+// by setting Store.Filters.HolidayCalendar. Extension is called after all expressions
+// are generated and can choose to ignore or alter them.
+//
+// This function is auto-generated
+func HolidayCalendarFilter(f systemType.HolidayCalendarFilter) (ee []goqu.Expression, _ systemType.HolidayCalendarFilter, err error) {
+
+	if expr := stateNilComparison("deleted_at", f.Deleted); expr != nil {
+		ee = append(ee, expr)
+	}
+
+	if len(f.HolidayCalendarID) > 0 {
+		ee = append(ee, goqu.C("id").In(f.HolidayCalendarID))
+	}
+
+	if val := strings.TrimSpace(f.Handle); len(val) > 0 {
+		ee = append(ee, goqu.C("handle").Eq(f.Handle))
+	}
+
+	return ee, f, err
+}
+
 // LabelFilter returns logical expressions
 //
 // This function is called from Store.QueryLabels() and can be extended
@@ -256,6 +256,210 @@ var (
 		}
 	}
 
+	// apigwProfilerRecordTable represents apigwProfilerRecords store table
+	//
+	// This value is auto-generated
+	apigwProfilerRecordTable = goqu.T("apigw_profiler_records")
+
+	// apigwProfilerRecordSelectQuery assembles select query for fetching apigwProfilerRecords
+	//
+	// This function is auto-generated
+	apigwProfilerRecordSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+		return d.Select(
+			"id",
+			"hit",
+			"rel_route",
+			"method",
+			"path",
+			"query",
+			"status",
+			"cache",
+			"content_length",
+			"duration",
+			"meta",
+			"started_at",
+		).From(apigwProfilerRecordTable)
+	}
+
+	// apigwProfilerRecordInsertQuery assembles query inserting apigwProfilerRecords
+	//
+	// This function is auto-generated
+	apigwProfilerRecordInsertQuery = func(d goqu.DialectWrapper, res *systemType.ApigwProfilerRecord) *goqu.InsertDataset {
+		return d.Insert(apigwProfilerRecordTable).
+			Rows(goqu.Record{
+				"id":             res.ID,
+				"hit":            res.Hit,
+				"rel_route":      res.Route,
+				"method":         res.Method,
+				"path":           res.Path,
+				"query":          res.Query,
+				"status":         res.Status,
+				"cache":          res.Cache,
+				"content_length": res.ContentLength,
+				"duration":       res.Duration,
+				"meta":           res.Meta,
+				"started_at":     res.StartedAt,
+			})
+	}
+
+	// apigwProfilerRecordUpsertQuery assembles (insert+on-conflict) query for replacing apigwProfilerRecords
+	//
+	// This function is auto-generated
+	apigwProfilerRecordUpsertQuery = func(d goqu.DialectWrapper, res *systemType.ApigwProfilerRecord) *goqu.InsertDataset {
+		var target = `,id`
+
+		return apigwProfilerRecordInsertQuery(d, res).
+			OnConflict(
+				goqu.DoUpdate(target[1:],
+					goqu.Record{
+						"hit":            res.Hit,
+						"rel_route":      res.Route,
+						"method":         res.Method,
+						"path":           res.Path,
+						"query":          res.Query,
+						"status":         res.Status,
+						"cache":          res.Cache,
+						"content_length": res.ContentLength,
+						"duration":       res.Duration,
+						"meta":           res.Meta,
+						"started_at":     res.StartedAt,
+					},
+				),
+			)
+	}
+
+	// apigwProfilerRecordUpdateQuery assembles query for updating apigwProfilerRecords
+	//
+	// This function is auto-generated
+	apigwProfilerRecordUpdateQuery = func(d goqu.DialectWrapper, res *systemType.ApigwProfilerRecord) *goqu.UpdateDataset {
+		return d.Update(apigwProfilerRecordTable).
+			Set(goqu.Record{
+				"hit":            res.Hit,
+				"rel_route":      res.Route,
+				"method":         res.Method,
+				"path":           res.Path,
+				"query":          res.Query,
+				"status":         res.Status,
+				"cache":          res.Cache,
+				"content_length": res.ContentLength,
+				"duration":       res.Duration,
+				"meta":           res.Meta,
+				"started_at":     res.StartedAt,
+			}).
+			Where(apigwProfilerRecordPrimaryKeys(res))
+	}
+
+	// apigwProfilerRecordDeleteQuery assembles delete query for removing apigwProfilerRecords
+	//
+	// This function is auto-generated
+	apigwProfilerRecordDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(apigwProfilerRecordTable).Where(ee...)
+	}
+
+	// apigwProfilerRecordDeleteQuery assembles delete query for removing apigwProfilerRecords
+	//
+	// This function is auto-generated
+	apigwProfilerRecordTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(apigwProfilerRecordTable)
+	}
+
+	// apigwProfilerRecordPrimaryKeys assembles set of conditions for all primary keys
+	//
+	// This function is auto-generated
+	apigwProfilerRecordPrimaryKeys = func(res *systemType.ApigwProfilerRecord) goqu.Ex {
+		return goqu.Ex{
+			"id": res.ID,
+		}
+	}
+
+	// apigwRateLimitTable represents apigwRateLimits store table
+	//
+	// This value is auto-generated
+	apigwRateLimitTable = goqu.T("apigw_rate_limits")
+
+	// apigwRateLimitSelectQuery assembles select query for fetching apigwRateLimits
+	//
+	// This function is auto-generated
+	apigwRateLimitSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+		return d.Select(
+			"name",
+			"count",
+			"stamp",
+			"version",
+			"expires_at",
+		).From(apigwRateLimitTable)
+	}
+
+	// apigwRateLimitInsertQuery assembles query inserting apigwRateLimits
+	//
+	// This function is auto-generated
+	apigwRateLimitInsertQuery = func(d goqu.DialectWrapper, res *systemType.ApigwRateLimit) *goqu.InsertDataset {
+		return d.Insert(apigwRateLimitTable).
+			Rows(goqu.Record{
+				"name":       res.Name,
+				"count":      res.Count,
+				"stamp":      res.Stamp,
+				"version":    res.Version,
+				"expires_at": res.ExpiresAt,
+			})
+	}
+
+	// apigwRateLimitUpsertQuery assembles (insert+on-conflict) query for replacing apigwRateLimits
+	//
+	// This function is auto-generated
+	apigwRateLimitUpsertQuery = func(d goqu.DialectWrapper, res *systemType.ApigwRateLimit) *goqu.InsertDataset {
+		var target = `,name`
+
+		return apigwRateLimitInsertQuery(d, res).
+			OnConflict(
+				goqu.DoUpdate(target[1:],
+					goqu.Record{
+						"count":      res.Count,
+						"stamp":      res.Stamp,
+						"version":    res.Version,
+						"expires_at": res.ExpiresAt,
+					},
+				),
+			)
+	}
+
+	// apigwRateLimitUpdateQuery assembles query for updating apigwRateLimits
+	//
+	// This function is auto-generated
+	apigwRateLimitUpdateQuery = func(d goqu.DialectWrapper, res *systemType.ApigwRateLimit) *goqu.UpdateDataset {
+		return d.Update(apigwRateLimitTable).
+			Set(goqu.Record{
+				"count":      res.Count,
+				"stamp":      res.Stamp,
+				"version":    res.Version,
+				"expires_at": res.ExpiresAt,
+			}).
+			Where(apigwRateLimitPrimaryKeys(res))
+	}
+
+	// apigwRateLimitDeleteQuery assembles delete query for removing apigwRateLimits
+	//
+	// This function is auto-generated
+	apigwRateLimitDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(apigwRateLimitTable).Where(ee...)
+	}
+
+	// apigwRateLimitDeleteQuery assembles delete query for removing apigwRateLimits
+	//
+	// This function is auto-generated
+	apigwRateLimitTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(apigwRateLimitTable)
+	}
+
+	// apigwRateLimitPrimaryKeys assembles set of conditions for all primary keys
+	//
+	// This function is auto-generated
+	apigwRateLimitPrimaryKeys = func(res *systemType.ApigwRateLimit) goqu.Ex {
+		return goqu.Ex{
+			"name": res.Name,
+		}
+	}
+
 	// apigwRouteTable represents apigwRoutes store table
 	//
 	// This value is auto-generated
@@ -1015,6 +1219,94 @@ var (
 		}
 	}
 
+	// automationLeaseTable represents automationLeases store table
+	//
+	// This value is auto-generated
+	automationLeaseTable = goqu.T("automation_leases")
+
+	// automationLeaseSelectQuery assembles select query for fetching automationLeases
+	//
+	// This function is auto-generated
+	automationLeaseSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+		return d.Select(
+			"name",
+			"owner",
+			"expires_at",
+			"created_at",
+			"updated_at",
+		).From(automationLeaseTable)
+	}
+
+	// automationLeaseInsertQuery assembles query inserting automationLeases
+	//
+	// This function is auto-generated
+	automationLeaseInsertQuery = func(d goqu.DialectWrapper, res *automationType.Lease) *goqu.InsertDataset {
+		return d.Insert(automationLeaseTable).
+			Rows(goqu.Record{
+				"name":       res.Name,
+				"owner":      res.Owner,
+				"expires_at": res.ExpiresAt,
+				"created_at": res.CreatedAt,
+				"updated_at": res.UpdatedAt,
+			})
+	}
+
+	// automationLeaseUpsertQuery assembles (insert+on-conflict) query for replacing automationLeases
+	//
+	// This function is auto-generated
+	automationLeaseUpsertQuery = func(d goqu.DialectWrapper, res *automationType.Lease) *goqu.InsertDataset {
+		var target = `,name`
+
+		return automationLeaseInsertQuery(d, res).
+			OnConflict(
+				goqu.DoUpdate(target[1:],
+					goqu.Record{
+						"owner":      res.Owner,
+						"expires_at": res.ExpiresAt,
+						"created_at": res.CreatedAt,
+						"updated_at": res.UpdatedAt,
+					},
+				),
+			)
+	}
+
+	// automationLeaseUpdateQuery assembles query for updating automationLeases
+	//
+	// This function is auto-generated
+	automationLeaseUpdateQuery = func(d goqu.DialectWrapper, res *automationType.Lease) *goqu.UpdateDataset {
+		return d.Update(automationLeaseTable).
+			Set(goqu.Record{
+				"owner":      res.Owner,
+				"expires_at": res.ExpiresAt,
+				"created_at": res.CreatedAt,
+				"updated_at": res.UpdatedAt,
+			}).
+			Where(automationLeasePrimaryKeys(res))
+	}
+
+	// automationLeaseDeleteQuery assembles delete query for removing automationLeases
+	//
+	// This function is auto-generated
+	automationLeaseDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(automationLeaseTable).Where(ee...)
+	}
+
+	// automationLeaseDeleteQuery assembles delete query for removing automationLeases
+	//
+	// This function is auto-generated
+	automationLeaseTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(automationLeaseTable)
+	}
+
+	// automationLeasePrimaryKeys assembles set of conditions for all primary keys
+	//
+	// This function is auto-generated
+	automationLeasePrimaryKeys = func(res *automationType.Lease) goqu.Ex {
+		return goqu.Ex{
+			"name": res.Name,
+		}
+	}
+
 	// automationSessionTable represents automationSessions store table
 	//
 	// This value is auto-generated
@@ -1027,12 +1319,16 @@ var (
 		return d.Select(
 			"id",
 			"rel_workflow",
+			"workflow_revision",
+			"rel_caller_session",
+			"rel_caller_step",
 			"event_type",
 			"resource_type",
 			"status",
 			"input",
 			"output",
 			"stacktrace",
+			"snapshot",
 			"created_by",
 			"created_at",
 			"purge_at",
@@ -1048,20 +1344,24 @@ var (
 	automationSessionInsertQuery = func(d goqu.DialectWrapper, res *automationType.Session) *goqu.InsertDataset {
 		return d.Insert(automationSessionTable).
 			Rows(goqu.Record{
-				"id":            res.ID,
-				"rel_workflow":  res.WorkflowID,
-				"event_type":    res.EventType,
-				"resource_type": res.ResourceType,
-				"status":        res.Status,
-				"input":         res.Input,
-				"output":        res.Output,
-				"stacktrace":    res.Stacktrace,
-				"created_by":    res.CreatedBy,
-				"created_at":    res.CreatedAt,
-				"purge_at":      res.PurgeAt,
-				"completed_at":  res.CompletedAt,
-				"suspended_at":  res.SuspendedAt,
-				"error":         res.Error,
+				"id":                 res.ID,
+				"rel_workflow":       res.WorkflowID,
+				"workflow_revision":  res.WorkflowRevision,
+				"rel_caller_session": res.CallerSessionID,
+				"rel_caller_step":    res.CallerStepID,
+				"event_type":         res.EventType,
+				"resource_type":      res.ResourceType,
+				"status":             res.Status,
+				"input":              res.Input,
+				"output":             res.Output,
+				"stacktrace":         res.Stacktrace,
+				"snapshot":           res.Snapshot,
+				"created_by":         res.CreatedBy,
+				"created_at":         res.CreatedAt,
+				"purge_at":           res.PurgeAt,
+				"completed_at":       res.CompletedAt,
+				"suspended_at":       res.SuspendedAt,
+				"error":              res.Error,
 			})
 	}
 
@@ -1075,19 +1375,23 @@ var (
 			OnConflict(
 				goqu.DoUpdate(target[1:],
 					goqu.Record{
-						"rel_workflow":  res.WorkflowID,
-						"event_type":    res.EventType,
-						"resource_type": res.ResourceType,
-						"status":        res.Status,
-						"input":         res.Input,
-						"output":        res.Output,
-						"stacktrace":    res.Stacktrace,
-						"created_by":    res.CreatedBy,
-						"created_at":    res.CreatedAt,
-						"purge_at":      res.PurgeAt,
-						"completed_at":  res.CompletedAt,
-						"suspended_at":  res.SuspendedAt,
-						"error":         res.Error,
+						"rel_workflow":       res.WorkflowID,
+						"workflow_revision":  res.WorkflowRevision,
+						"rel_caller_session": res.CallerSessionID,
+						"rel_caller_step":    res.CallerStepID,
+						"event_type":         res.EventType,
+						"resource_type":      res.ResourceType,
+						"status":             res.Status,
+						"input":              res.Input,
+						"output":             res.Output,
+						"stacktrace":         res.Stacktrace,
+						"snapshot":           res.Snapshot,
+						"created_by":         res.CreatedBy,
+						"created_at":         res.CreatedAt,
+						"purge_at":           res.PurgeAt,
+						"completed_at":       res.CompletedAt,
+						"suspended_at":       res.SuspendedAt,
+						"error":              res.Error,
 					},
 				),
 			)
@@ -1099,19 +1403,23 @@ var (
 	automationSessionUpdateQuery = func(d goqu.DialectWrapper, res *automationType.Session) *goqu.UpdateDataset {
 		return d.Update(automationSessionTable).
 			Set(goqu.Record{
-				"rel_workflow":  res.WorkflowID,
-				"event_type":    res.EventType,
-				"resource_type": res.ResourceType,
-				"status":        res.Status,
-				"input":         res.Input,
-				"output":        res.Output,
-				"stacktrace":    res.Stacktrace,
-				"created_by":    res.CreatedBy,
-				"created_at":    res.CreatedAt,
-				"purge_at":      res.PurgeAt,
-				"completed_at":  res.CompletedAt,
-				"suspended_at":  res.SuspendedAt,
-				"error":         res.Error,
+				"rel_workflow":       res.WorkflowID,
+				"workflow_revision":  res.WorkflowRevision,
+				"rel_caller_session": res.CallerSessionID,
+				"rel_caller_step":    res.CallerStepID,
+				"event_type":         res.EventType,
+				"resource_type":      res.ResourceType,
+				"status":             res.Status,
+				"input":              res.Input,
+				"output":             res.Output,
+				"stacktrace":         res.Stacktrace,
+				"snapshot":           res.Snapshot,
+				"created_by":         res.CreatedBy,
+				"created_at":         res.CreatedAt,
+				"purge_at":           res.PurgeAt,
+				"completed_at":       res.CompletedAt,
+				"suspended_at":       res.SuspendedAt,
+				"error":              res.Error,
 			}).
 			Where(automationSessionPrimaryKeys(res))
 	}
@@ -1139,756 +1447,1232 @@ var (
 		}
 	}
 
-	// automationTriggerTable represents automationTriggers store table
+	// automationSessionResumeTable represents automationSessionResumes store table
 	//
 	// This value is auto-generated
-	automationTriggerTable = goqu.T("automation_triggers")
+	automationSessionResumeTable = goqu.T("automation_session_resumes")
 
-	// automationTriggerSelectQuery assembles select query for fetching automationTriggers
+	// automationSessionResumeSelectQuery assembles select query for fetching automationSessionResumes
 	//
 	// This function is auto-generated
-	automationTriggerSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+	automationSessionResumeSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
 		return d.Select(
 			"id",
-			"rel_workflow",
-			"rel_step",
-			"enabled",
-			"resource_type",
-			"event_type",
-			"meta",
-			"constraints",
+			"rel_session",
+			"rel_state",
 			"input",
-			"owned_by",
-			"created_at",
-			"updated_at",
-			"deleted_at",
 			"created_by",
-			"updated_by",
-			"deleted_by",
-		).From(automationTriggerTable)
+			"created_at",
+		).From(automationSessionResumeTable)
 	}
 
-	// automationTriggerInsertQuery assembles query inserting automationTriggers
+	// automationSessionResumeInsertQuery assembles query inserting automationSessionResumes
 	//
 	// This function is auto-generated
-	automationTriggerInsertQuery = func(d goqu.DialectWrapper, res *automationType.Trigger) *goqu.InsertDataset {
-		return d.Insert(automationTriggerTable).
+	automationSessionResumeInsertQuery = func(d goqu.DialectWrapper, res *automationType.SessionResume) *goqu.InsertDataset {
+		return d.Insert(automationSessionResumeTable).
 			Rows(goqu.Record{
-				"id":            res.ID,
-				"rel_workflow":  res.WorkflowID,
-				"rel_step":      res.StepID,
-				"enabled":       res.Enabled,
-				"resource_type": res.ResourceType,
-				"event_type":    res.EventType,
-				"meta":          res.Meta,
-				"constraints":   res.Constraints,
-				"input":         res.Input,
-				"owned_by":      res.OwnedBy,
-				"created_at":    res.CreatedAt,
-				"updated_at":    res.UpdatedAt,
-				"deleted_at":    res.DeletedAt,
-				"created_by":    res.CreatedBy,
-				"updated_by":    res.UpdatedBy,
-				"deleted_by":    res.DeletedBy,
+				"id":          res.ID,
+				"rel_session": res.SessionID,
+				"rel_state":   res.StateID,
+				"input":       res.Input,
+				"created_by":  res.CreatedBy,
+				"created_at":  res.CreatedAt,
 			})
 	}
 
-	// automationTriggerUpsertQuery assembles (insert+on-conflict) query for replacing automationTriggers
+	// automationSessionResumeUpsertQuery assembles (insert+on-conflict) query for replacing automationSessionResumes
 	//
 	// This function is auto-generated
-	automationTriggerUpsertQuery = func(d goqu.DialectWrapper, res *automationType.Trigger) *goqu.InsertDataset {
+	automationSessionResumeUpsertQuery = func(d goqu.DialectWrapper, res *automationType.SessionResume) *goqu.InsertDataset {
 		var target = `,id`
 
-		return automationTriggerInsertQuery(d, res).
+		return automationSessionResumeInsertQuery(d, res).
 			OnConflict(
 				goqu.DoUpdate(target[1:],
 					goqu.Record{
-						"rel_workflow":  res.WorkflowID,
-						"rel_step":      res.StepID,
-						"enabled":       res.Enabled,
-						"resource_type": res.ResourceType,
-						"event_type":    res.EventType,
-						"meta":          res.Meta,
-						"constraints":   res.Constraints,
-						"input":         res.Input,
-						"owned_by":      res.OwnedBy,
-						"created_at":    res.CreatedAt,
-						"updated_at":    res.UpdatedAt,
-						"deleted_at":    res.DeletedAt,
-						"created_by":    res.CreatedBy,
-						"updated_by":    res.UpdatedBy,
-						"deleted_by":    res.DeletedBy,
+						"rel_session": res.SessionID,
+						"rel_state":   res.StateID,
+						"input":       res.Input,
+						"created_by":  res.CreatedBy,
+						"created_at":  res.CreatedAt,
 					},
 				),
 			)
 	}
 
-	// automationTriggerUpdateQuery assembles query for updating automationTriggers
+	// automationSessionResumeUpdateQuery assembles query for updating automationSessionResumes
 	//
 	// This function is auto-generated
-	automationTriggerUpdateQuery = func(d goqu.DialectWrapper, res *automationType.Trigger) *goqu.UpdateDataset {
-		return d.Update(automationTriggerTable).
+	automationSessionResumeUpdateQuery = func(d goqu.DialectWrapper, res *automationType.SessionResume) *goqu.UpdateDataset {
+		return d.Update(automationSessionResumeTable).
 			Set(goqu.Record{
-				"rel_workflow":  res.WorkflowID,
-				"rel_step":      res.StepID,
-				"enabled":       res.Enabled,
-				"resource_type": res.ResourceType,
-				"event_type":    res.EventType,
-				"meta":          res.Meta,
-				"constraints":   res.Constraints,
-				"input":         res.Input,
-				"owned_by":      res.OwnedBy,
-				"created_at":    res.CreatedAt,
-				"updated_at":    res.UpdatedAt,
-				"deleted_at":    res.DeletedAt,
-				"created_by":    res.CreatedBy,
-				"updated_by":    res.UpdatedBy,
-				"deleted_by":    res.DeletedBy,
+				"rel_session": res.SessionID,
+				"rel_state":   res.StateID,
+				"input":       res.Input,
+				"created_by":  res.CreatedBy,
+				"created_at":  res.CreatedAt,
 			}).
-			Where(automationTriggerPrimaryKeys(res))
+			Where(automationSessionResumePrimaryKeys(res))
 	}
 
-	// automationTriggerDeleteQuery assembles delete query for removing automationTriggers
+	// automationSessionResumeDeleteQuery assembles delete query for removing automationSessionResumes
 	//
 	// This function is auto-generated
-	automationTriggerDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
-		return d.Delete(automationTriggerTable).Where(ee...)
+	automationSessionResumeDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(automationSessionResumeTable).Where(ee...)
 	}
 
-	// automationTriggerDeleteQuery assembles delete query for removing automationTriggers
+	// automationSessionResumeDeleteQuery assembles delete query for removing automationSessionResumes
 	//
 	// This function is auto-generated
-	automationTriggerTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
-		return d.Truncate(automationTriggerTable)
+	automationSessionResumeTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(automationSessionResumeTable)
 	}
 
-	// automationTriggerPrimaryKeys assembles set of conditions for all primary keys
+	// automationSessionResumePrimaryKeys assembles set of conditions for all primary keys
 	//
 	// This function is auto-generated
-	automationTriggerPrimaryKeys = func(res *automationType.Trigger) goqu.Ex {
+	automationSessionResumePrimaryKeys = func(res *automationType.SessionResume) goqu.Ex {
 		return goqu.Ex{
 			"id": res.ID,
 		}
 	}
 
-	// automationWorkflowTable represents automationWorkflows store table
+	// automationTaskTable represents automationTasks store table
 	//
 	// This value is auto-generated
-	automationWorkflowTable = goqu.T("automation_workflows")
+	automationTaskTable = goqu.T("automation_tasks")
 
-	// automationWorkflowSelectQuery assembles select query for fetching automationWorkflows
+	// automationTaskSelectQuery assembles select query for fetching automationTasks
 	//
 	// This function is auto-generated
-	automationWorkflowSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+	automationTaskSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
 		return d.Select(
 			"id",
-			"handle",
-			"meta",
-			"enabled",
-			"trace",
-			"keep_sessions",
-			"scope",
-			"steps",
-			"paths",
-			"issues",
-			"run_as",
-			"owned_by",
+			"rel_session",
+			"rel_state",
+			"rel_workflow",
+			"rel_step",
+			"ref",
+			"payload",
+			"rel_owner",
+			"rel_assigned_user",
+			"rel_assigned_role",
+			"claimed_by",
+			"delegated_by",
+			"status",
+			"due_at",
 			"created_at",
 			"updated_at",
-			"deleted_at",
-			"created_by",
-			"updated_by",
-			"deleted_by",
-		).From(automationWorkflowTable)
+			"completed_at",
+			"completed_by",
+		).From(automationTaskTable)
 	}
 
-	// automationWorkflowInsertQuery assembles query inserting automationWorkflows
+	// automationTaskInsertQuery assembles query inserting automationTasks
 	//
 	// This function is auto-generated
-	automationWorkflowInsertQuery = func(d goqu.DialectWrapper, res *automationType.Workflow) *goqu.InsertDataset {
-		return d.Insert(automationWorkflowTable).
+	automationTaskInsertQuery = func(d goqu.DialectWrapper, res *automationType.Task) *goqu.InsertDataset {
+		return d.Insert(automationTaskTable).
 			Rows(goqu.Record{
-				"id":            res.ID,
-				"handle":        res.Handle,
-				"meta":          res.Meta,
-				"enabled":       res.Enabled,
-				"trace":         res.Trace,
-				"keep_sessions": res.KeepSessions,
-				"scope":         res.Scope,
-				"steps":         res.Steps,
-				"paths":         res.Paths,
-				"issues":        res.Issues,
-				"run_as":        res.RunAs,
-				"owned_by":      res.OwnedBy,
-				"created_at":    res.CreatedAt,
-				"updated_at":    res.UpdatedAt,
-				"deleted_at":    res.DeletedAt,
-				"created_by":    res.CreatedBy,
-				"updated_by":    res.UpdatedBy,
-				"deleted_by":    res.DeletedBy,
+				"id":                res.ID,
+				"rel_session":       res.SessionID,
+				"rel_state":         res.StateID,
+				"rel_workflow":      res.WorkflowID,
+				"rel_step":          res.StepID,
+				"ref":               res.Ref,
+				"payload":           res.Payload,
+				"rel_owner":         res.OwnerID,
+				"rel_assigned_user": res.AssignedUserID,
+				"rel_assigned_role": res.AssignedRoleID,
+				"claimed_by":        res.ClaimedBy,
+				"delegated_by":      res.DelegatedBy,
+				"status":            res.Status,
+				"due_at":            res.DueAt,
+				"created_at":        res.CreatedAt,
+				"updated_at":        res.UpdatedAt,
+				"completed_at":      res.CompletedAt,
+				"completed_by":      res.CompletedBy,
 			})
 	}
 
-	// automationWorkflowUpsertQuery assembles (insert+on-conflict) query for replacing automationWorkflows
+	// automationTaskUpsertQuery assembles (insert+on-conflict) query for replacing automationTasks
 	//
 	// This function is auto-generated
-	automationWorkflowUpsertQuery = func(d goqu.DialectWrapper, res *automationType.Workflow) *goqu.InsertDataset {
+	automationTaskUpsertQuery = func(d goqu.DialectWrapper, res *automationType.Task) *goqu.InsertDataset {
 		var target = `,id`
 
-		return automationWorkflowInsertQuery(d, res).
+		return automationTaskInsertQuery(d, res).
 			OnConflict(
 				goqu.DoUpdate(target[1:],
 					goqu.Record{
-						"handle":        res.Handle,
-						"meta":          res.Meta,
-						"enabled":       res.Enabled,
-						"trace":         res.Trace,
-						"keep_sessions": res.KeepSessions,
-						"scope":         res.Scope,
-						"steps":         res.Steps,
-						"paths":         res.Paths,
-						"issues":        res.Issues,
-						"run_as":        res.RunAs,
-						"owned_by":      res.OwnedBy,
-						"created_at":    res.CreatedAt,
-						"updated_at":    res.UpdatedAt,
-						"deleted_at":    res.DeletedAt,
-						"created_by":    res.CreatedBy,
-						"updated_by":    res.UpdatedBy,
-						"deleted_by":    res.DeletedBy,
+						"rel_session":       res.SessionID,
+						"rel_state":         res.StateID,
+						"rel_workflow":      res.WorkflowID,
+						"rel_step":          res.StepID,
+						"ref":               res.Ref,
+						"payload":           res.Payload,
+						"rel_owner":         res.OwnerID,
+						"rel_assigned_user": res.AssignedUserID,
+						"rel_assigned_role": res.AssignedRoleID,
+						"claimed_by":        res.ClaimedBy,
+						"delegated_by":      res.DelegatedBy,
+						"status":            res.Status,
+						"due_at":            res.DueAt,
+						"created_at":        res.CreatedAt,
+						"updated_at":        res.UpdatedAt,
+						"completed_at":      res.CompletedAt,
+						"completed_by":      res.CompletedBy,
 					},
 				),
 			)
 	}
 
-	// automationWorkflowUpdateQuery assembles query for updating automationWorkflows
+	// automationTaskUpdateQuery assembles query for updating automationTasks
 	//
 	// This function is auto-generated
-	automationWorkflowUpdateQuery = func(d goqu.DialectWrapper, res *automationType.Workflow) *goqu.UpdateDataset {
-		return d.Update(automationWorkflowTable).
+	automationTaskUpdateQuery = func(d goqu.DialectWrapper, res *automationType.Task) *goqu.UpdateDataset {
+		return d.Update(automationTaskTable).
 			Set(goqu.Record{
-				"handle":        res.Handle,
-				"meta":          res.Meta,
-				"enabled":       res.Enabled,
-				"trace":         res.Trace,
-				"keep_sessions": res.KeepSessions,
-				"scope":         res.Scope,
-				"steps":         res.Steps,
-				"paths":         res.Paths,
-				"issues":        res.Issues,
-				"run_as":        res.RunAs,
-				"owned_by":      res.OwnedBy,
-				"created_at":    res.CreatedAt,
-				"updated_at":    res.UpdatedAt,
-				"deleted_at":    res.DeletedAt,
-				"created_by":    res.CreatedBy,
-				"updated_by":    res.UpdatedBy,
-				"deleted_by":    res.DeletedBy,
+				"rel_session":       res.SessionID,
+				"rel_state":         res.StateID,
+				"rel_workflow":      res.WorkflowID,
+				"rel_step":          res.StepID,
+				"ref":               res.Ref,
+				"payload":           res.Payload,
+				"rel_owner":         res.OwnerID,
+				"rel_assigned_user": res.AssignedUserID,
+				"rel_assigned_role": res.AssignedRoleID,
+				"claimed_by":        res.ClaimedBy,
+				"delegated_by":      res.DelegatedBy,
+				"status":            res.Status,
+				"due_at":            res.DueAt,
+				"created_at":        res.CreatedAt,
+				"updated_at":        res.UpdatedAt,
+				"completed_at":      res.CompletedAt,
+				"completed_by":      res.CompletedBy,
 			}).
-			Where(automationWorkflowPrimaryKeys(res))
+			Where(automationTaskPrimaryKeys(res))
 	}
 
-	// automationWorkflowDeleteQuery assembles delete query for removing automationWorkflows
+	// automationTaskDeleteQuery assembles delete query for removing automationTasks
 	//
 	// This function is auto-generated
-	automationWorkflowDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
-		return d.Delete(automationWorkflowTable).Where(ee...)
+	automationTaskDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(automationTaskTable).Where(ee...)
 	}
 
-	// automationWorkflowDeleteQuery assembles delete query for removing automationWorkflows
+	// automationTaskDeleteQuery assembles delete query for removing automationTasks
 	//
 	// This function is auto-generated
-	automationWorkflowTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
-		return d.Truncate(automationWorkflowTable)
+	automationTaskTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(automationTaskTable)
 	}
 
-	// automationWorkflowPrimaryKeys assembles set of conditions for all primary keys
+	// automationTaskPrimaryKeys assembles set of conditions for all primary keys
 	//
 	// This function is auto-generated
-	automationWorkflowPrimaryKeys = func(res *automationType.Workflow) goqu.Ex {
+	automationTaskPrimaryKeys = func(res *automationType.Task) goqu.Ex {
 		return goqu.Ex{
 			"id": res.ID,
 		}
 	}
 
-	// composeAttachmentTable represents composeAttachments store table
+	// automationTriggerTable represents automationTriggers store table
 	//
 	// This value is auto-generated
-	composeAttachmentTable = goqu.T("compose_attachment")
+	automationTriggerTable = goqu.T("automation_triggers")
 
-	// composeAttachmentSelectQuery assembles select query for fetching composeAttachments
+	// automationTriggerSelectQuery assembles select query for fetching automationTriggers
 	//
 	// This function is auto-generated
-	composeAttachmentSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+	automationTriggerSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
 		return d.Select(
 			"id",
-			"rel_owner",
-			"rel_namespace",
-			"kind",
-			"url",
-			"preview_url",
-			"name",
+			"rel_workflow",
+			"rel_step",
+			"enabled",
+			"resource_type",
+			"event_type",
 			"meta",
+			"constraints",
+			"input",
+			"owned_by",
 			"created_at",
 			"updated_at",
 			"deleted_at",
-		).From(composeAttachmentTable)
+			"created_by",
+			"updated_by",
+			"deleted_by",
+		).From(automationTriggerTable)
 	}
 
-	// composeAttachmentInsertQuery assembles query inserting composeAttachments
+	// automationTriggerInsertQuery assembles query inserting automationTriggers
 	//
 	// This function is auto-generated
-	composeAttachmentInsertQuery = func(d goqu.DialectWrapper, res *composeType.Attachment) *goqu.InsertDataset {
-		return d.Insert(composeAttachmentTable).
+	automationTriggerInsertQuery = func(d goqu.DialectWrapper, res *automationType.Trigger) *goqu.InsertDataset {
+		return d.Insert(automationTriggerTable).
 			Rows(goqu.Record{
 				"id":            res.ID,
-				"rel_owner":     res.OwnerID,
-				"rel_namespace": res.NamespaceID,
-				"kind":          res.Kind,
-				"url":           res.Url,
-				"preview_url":   res.PreviewUrl,
-				"name":          res.Name,
+				"rel_workflow":  res.WorkflowID,
+				"rel_step":      res.StepID,
+				"enabled":       res.Enabled,
+				"resource_type": res.ResourceType,
+				"event_type":    res.EventType,
 				"meta":          res.Meta,
+				"constraints":   res.Constraints,
+				"input":         res.Input,
+				"owned_by":      res.OwnedBy,
 				"created_at":    res.CreatedAt,
 				"updated_at":    res.UpdatedAt,
 				"deleted_at":    res.DeletedAt,
+				"created_by":    res.CreatedBy,
+				"updated_by":    res.UpdatedBy,
+				"deleted_by":    res.DeletedBy,
 			})
 	}
 
-	// composeAttachmentUpsertQuery assembles (insert+on-conflict) query for replacing composeAttachments
+	// automationTriggerUpsertQuery assembles (insert+on-conflict) query for replacing automationTriggers
 	//
 	// This function is auto-generated
-	composeAttachmentUpsertQuery = func(d goqu.DialectWrapper, res *composeType.Attachment) *goqu.InsertDataset {
+	automationTriggerUpsertQuery = func(d goqu.DialectWrapper, res *automationType.Trigger) *goqu.InsertDataset {
 		var target = `,id`
 
-		return composeAttachmentInsertQuery(d, res).
+		return automationTriggerInsertQuery(d, res).
 			OnConflict(
 				goqu.DoUpdate(target[1:],
 					goqu.Record{
-						"rel_owner":     res.OwnerID,
-						"rel_namespace": res.NamespaceID,
-						"kind":          res.Kind,
-						"url":           res.Url,
-						"preview_url":   res.PreviewUrl,
-						"name":          res.Name,
+						"rel_workflow":  res.WorkflowID,
+						"rel_step":      res.StepID,
+						"enabled":       res.Enabled,
+						"resource_type": res.ResourceType,
+						"event_type":    res.EventType,
 						"meta":          res.Meta,
+						"constraints":   res.Constraints,
+						"input":         res.Input,
+						"owned_by":      res.OwnedBy,
 						"created_at":    res.CreatedAt,
 						"updated_at":    res.UpdatedAt,
 						"deleted_at":    res.DeletedAt,
+						"created_by":    res.CreatedBy,
+						"updated_by":    res.UpdatedBy,
+						"deleted_by":    res.DeletedBy,
 					},
 				),
 			)
 	}
 
-	// composeAttachmentUpdateQuery assembles query for updating composeAttachments
+	// automationTriggerUpdateQuery assembles query for updating automationTriggers
 	//
 	// This function is auto-generated
-	composeAttachmentUpdateQuery = func(d goqu.DialectWrapper, res *composeType.Attachment) *goqu.UpdateDataset {
-		return d.Update(composeAttachmentTable).
+	automationTriggerUpdateQuery = func(d goqu.DialectWrapper, res *automationType.Trigger) *goqu.UpdateDataset {
+		return d.Update(automationTriggerTable).
 			Set(goqu.Record{
-				"rel_owner":     res.OwnerID,
-				"rel_namespace": res.NamespaceID,
-				"kind":          res.Kind,
-				"url":           res.Url,
-				"preview_url":   res.PreviewUrl,
-				"name":          res.Name,
+				"rel_workflow":  res.WorkflowID,
+				"rel_step":      res.StepID,
+				"enabled":       res.Enabled,
+				"resource_type": res.ResourceType,
+				"event_type":    res.EventType,
 				"meta":          res.Meta,
+				"constraints":   res.Constraints,
+				"input":         res.Input,
+				"owned_by":      res.OwnedBy,
 				"created_at":    res.CreatedAt,
 				"updated_at":    res.UpdatedAt,
 				"deleted_at":    res.DeletedAt,
+				"created_by":    res.CreatedBy,
+				"updated_by":    res.UpdatedBy,
+				"deleted_by":    res.DeletedBy,
 			}).
-			Where(composeAttachmentPrimaryKeys(res))
+			Where(automationTriggerPrimaryKeys(res))
 	}
 
-	// composeAttachmentDeleteQuery assembles delete query for removing composeAttachments
+	// automationTriggerDeleteQuery assembles delete query for removing automationTriggers
 	//
 	// This function is auto-generated
-	composeAttachmentDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
-		return d.Delete(composeAttachmentTable).Where(ee...)
+	automationTriggerDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(automationTriggerTable).Where(ee...)
 	}
 
-	// composeAttachmentDeleteQuery assembles delete query for removing composeAttachments
+	// automationTriggerDeleteQuery assembles delete query for removing automationTriggers
 	//
 	// This function is auto-generated
-	composeAttachmentTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
-		return d.Truncate(composeAttachmentTable)
+	automationTriggerTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(automationTriggerTable)
 	}
 
-	// composeAttachmentPrimaryKeys assembles set of conditions for all primary keys
+	// automationTriggerPrimaryKeys assembles set of conditions for all primary keys
 	//
 	// This function is auto-generated
-	composeAttachmentPrimaryKeys = func(res *composeType.Attachment) goqu.Ex {
+	automationTriggerPrimaryKeys = func(res *automationType.Trigger) goqu.Ex {
 		return goqu.Ex{
 			"id": res.ID,
 		}
 	}
 
-	// composeChartTable represents composeCharts store table
+	// automationWorkflowTable represents automationWorkflows store table
 	//
 	// This value is auto-generated
-	composeChartTable = goqu.T("compose_chart")
+	automationWorkflowTable = goqu.T("automation_workflows")
 
-	// composeChartSelectQuery assembles select query for fetching composeCharts
+	// automationWorkflowSelectQuery assembles select query for fetching automationWorkflows
 	//
 	// This function is auto-generated
-	composeChartSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+	automationWorkflowSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
 		return d.Select(
 			"id",
 			"handle",
-			"name",
-			"config",
-			"rel_namespace",
+			"meta",
+			"enabled",
+			"trace",
+			"keep_sessions",
+			"scope",
+			"steps",
+			"paths",
+			"issues",
+			"run_as",
+			"published_revision",
+			"owned_by",
 			"created_at",
 			"updated_at",
 			"deleted_at",
-		).From(composeChartTable)
+			"created_by",
+			"updated_by",
+			"deleted_by",
+		).From(automationWorkflowTable)
 	}
 
-	// composeChartInsertQuery assembles query inserting composeCharts
+	// automationWorkflowInsertQuery assembles query inserting automationWorkflows
 	//
 	// This function is auto-generated
-	composeChartInsertQuery = func(d goqu.DialectWrapper, res *composeType.Chart) *goqu.InsertDataset {
-		return d.Insert(composeChartTable).
+	automationWorkflowInsertQuery = func(d goqu.DialectWrapper, res *automationType.Workflow) *goqu.InsertDataset {
+		return d.Insert(automationWorkflowTable).
 			Rows(goqu.Record{
-				"id":            res.ID,
-				"handle":        res.Handle,
-				"name":          res.Name,
-				"config":        res.Config,
-				"rel_namespace": res.NamespaceID,
-				"created_at":    res.CreatedAt,
-				"updated_at":    res.UpdatedAt,
-				"deleted_at":    res.DeletedAt,
+				"id":                 res.ID,
+				"handle":             res.Handle,
+				"meta":               res.Meta,
+				"enabled":            res.Enabled,
+				"trace":              res.Trace,
+				"keep_sessions":      res.KeepSessions,
+				"scope":              res.Scope,
+				"steps":              res.Steps,
+				"paths":              res.Paths,
+				"issues":             res.Issues,
+				"run_as":             res.RunAs,
+				"published_revision": res.PublishedRevision,
+				"owned_by":           res.OwnedBy,
+				"created_at":         res.CreatedAt,
+				"updated_at":         res.UpdatedAt,
+				"deleted_at":         res.DeletedAt,
+				"created_by":         res.CreatedBy,
+				"updated_by":         res.UpdatedBy,
+				"deleted_by":         res.DeletedBy,
 			})
 	}
 
-	// composeChartUpsertQuery assembles (insert+on-conflict) query for replacing composeCharts
+	// automationWorkflowUpsertQuery assembles (insert+on-conflict) query for replacing automationWorkflows
 	//
 	// This function is auto-generated
-	composeChartUpsertQuery = func(d goqu.DialectWrapper, res *composeType.Chart) *goqu.InsertDataset {
+	automationWorkflowUpsertQuery = func(d goqu.DialectWrapper, res *automationType.Workflow) *goqu.InsertDataset {
 		var target = `,id`
 
-		return composeChartInsertQuery(d, res).
+		return automationWorkflowInsertQuery(d, res).
 			OnConflict(
 				goqu.DoUpdate(target[1:],
 					goqu.Record{
-						"handle":        res.Handle,
-						"name":          res.Name,
-						"config":        res.Config,
-						"rel_namespace": res.NamespaceID,
-						"created_at":    res.CreatedAt,
-						"updated_at":    res.UpdatedAt,
-						"deleted_at":    res.DeletedAt,
+						"handle":             res.Handle,
+						"meta":               res.Meta,
+						"enabled":            res.Enabled,
+						"trace":              res.Trace,
+						"keep_sessions":      res.KeepSessions,
+						"scope":              res.Scope,
+						"steps":              res.Steps,
+						"paths":              res.Paths,
+						"issues":             res.Issues,
+						"run_as":             res.RunAs,
+						"published_revision": res.PublishedRevision,
+						"owned_by":           res.OwnedBy,
+						"created_at":         res.CreatedAt,
+						"updated_at":         res.UpdatedAt,
+						"deleted_at":         res.DeletedAt,
+						"created_by":         res.CreatedBy,
+						"updated_by":         res.UpdatedBy,
+						"deleted_by":         res.DeletedBy,
 					},
 				),
 			)
 	}
 
-	// composeChartUpdateQuery assembles query for updating composeCharts
+	// automationWorkflowUpdateQuery assembles query for updating automationWorkflows
 	//
 	// This function is auto-generated
-	composeChartUpdateQuery = func(d goqu.DialectWrapper, res *composeType.Chart) *goqu.UpdateDataset {
-		return d.Update(composeChartTable).
+	automationWorkflowUpdateQuery = func(d goqu.DialectWrapper, res *automationType.Workflow) *goqu.UpdateDataset {
+		return d.Update(automationWorkflowTable).
 			Set(goqu.Record{
-				"handle":        res.Handle,
-				"name":          res.Name,
-				"config":        res.Config,
-				"rel_namespace": res.NamespaceID,
-				"created_at":    res.CreatedAt,
-				"updated_at":    res.UpdatedAt,
-				"deleted_at":    res.DeletedAt,
+				"handle":             res.Handle,
+				"meta":               res.Meta,
+				"enabled":            res.Enabled,
+				"trace":              res.Trace,
+				"keep_sessions":      res.KeepSessions,
+				"scope":              res.Scope,
+				"steps":              res.Steps,
+				"paths":              res.Paths,
+				"issues":             res.Issues,
+				"run_as":             res.RunAs,
+				"published_revision": res.PublishedRevision,
+				"owned_by":           res.OwnedBy,
+				"created_at":         res.CreatedAt,
+				"updated_at":         res.UpdatedAt,
+				"deleted_at":         res.DeletedAt,
+				"created_by":         res.CreatedBy,
+				"updated_by":         res.UpdatedBy,
+				"deleted_by":         res.DeletedBy,
 			}).
-			Where(composeChartPrimaryKeys(res))
+			Where(automationWorkflowPrimaryKeys(res))
 	}
 
-	// composeChartDeleteQuery assembles delete query for removing composeCharts
+	// automationWorkflowDeleteQuery assembles delete query for removing automationWorkflows
 	//
 	// This function is auto-generated
-	composeChartDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
-		return d.Delete(composeChartTable).Where(ee...)
+	automationWorkflowDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(automationWorkflowTable).Where(ee...)
 	}
 
-	// composeChartDeleteQuery assembles delete query for removing composeCharts
+	// automationWorkflowDeleteQuery assembles delete query for removing automationWorkflows
 	//
 	// This function is auto-generated
-	composeChartTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
-		return d.Truncate(composeChartTable)
+	automationWorkflowTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(automationWorkflowTable)
 	}
 
-	// composeChartPrimaryKeys assembles set of conditions for all primary keys
+	// automationWorkflowPrimaryKeys assembles set of conditions for all primary keys
 	//
 	// This function is auto-generated
-	composeChartPrimaryKeys = func(res *composeType.Chart) goqu.Ex {
+	automationWorkflowPrimaryKeys = func(res *automationType.Workflow) goqu.Ex {
 		return goqu.Ex{
 			"id": res.ID,
 		}
 	}
 
-	// composeModuleTable represents composeModules store table
+	// automationWorkflowRevisionTable represents automationWorkflowRevisions store table
 	//
 	// This value is auto-generated
-	composeModuleTable = goqu.T("compose_module")
+	automationWorkflowRevisionTable = goqu.T("automation_workflow_revisions")
 
-	// composeModuleSelectQuery assembles select query for fetching composeModules
+	// automationWorkflowRevisionSelectQuery assembles select query for fetching automationWorkflowRevisions
 	//
 	// This function is auto-generated
-	composeModuleSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+	automationWorkflowRevisionSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
 		return d.Select(
 			"id",
-			"handle",
+			"rel_workflow",
+			"revision",
 			"meta",
-			"model_config",
-			"rel_namespace",
-			"name",
+			"scope",
+			"steps",
+			"paths",
 			"created_at",
-			"updated_at",
-			"deleted_at",
-		).From(composeModuleTable)
+			"created_by",
+		).From(automationWorkflowRevisionTable)
 	}
 
-	// composeModuleInsertQuery assembles query inserting composeModules
+	// automationWorkflowRevisionInsertQuery assembles query inserting automationWorkflowRevisions
 	//
 	// This function is auto-generated
-	composeModuleInsertQuery = func(d goqu.DialectWrapper, res *composeType.Module) *goqu.InsertDataset {
-		return d.Insert(composeModuleTable).
+	automationWorkflowRevisionInsertQuery = func(d goqu.DialectWrapper, res *automationType.WorkflowRevision) *goqu.InsertDataset {
+		return d.Insert(automationWorkflowRevisionTable).
 			Rows(goqu.Record{
-				"id":            res.ID,
-				"handle":        res.Handle,
-				"meta":          res.Meta,
-				"model_config":  res.ModelConfig,
-				"rel_namespace": res.NamespaceID,
-				"name":          res.Name,
-				"created_at":    res.CreatedAt,
-				"updated_at":    res.UpdatedAt,
-				"deleted_at":    res.DeletedAt,
+				"id":           res.ID,
+				"rel_workflow": res.WorkflowID,
+				"revision":     res.Revision,
+				"meta":         res.Meta,
+				"scope":        res.Scope,
+				"steps":        res.Steps,
+				"paths":        res.Paths,
+				"created_at":   res.CreatedAt,
+				"created_by":   res.CreatedBy,
 			})
 	}
 
-	// composeModuleUpsertQuery assembles (insert+on-conflict) query for replacing composeModules
+	// automationWorkflowRevisionUpsertQuery assembles (insert+on-conflict) query for replacing automationWorkflowRevisions
 	//
 	// This function is auto-generated
-	composeModuleUpsertQuery = func(d goqu.DialectWrapper, res *composeType.Module) *goqu.InsertDataset {
+	automationWorkflowRevisionUpsertQuery = func(d goqu.DialectWrapper, res *automationType.WorkflowRevision) *goqu.InsertDataset {
 		var target = `,id`
 
-		return composeModuleInsertQuery(d, res).
+		return automationWorkflowRevisionInsertQuery(d, res).
 			OnConflict(
 				goqu.DoUpdate(target[1:],
 					goqu.Record{
-						"handle":        res.Handle,
-						"meta":          res.Meta,
-						"model_config":  res.ModelConfig,
-						"rel_namespace": res.NamespaceID,
-						"name":          res.Name,
-						"created_at":    res.CreatedAt,
-						"updated_at":    res.UpdatedAt,
-						"deleted_at":    res.DeletedAt,
+						"rel_workflow": res.WorkflowID,
+						"revision":     res.Revision,
+						"meta":         res.Meta,
+						"scope":        res.Scope,
+						"steps":        res.Steps,
+						"paths":        res.Paths,
+						"created_at":   res.CreatedAt,
+						"created_by":   res.CreatedBy,
 					},
 				),
 			)
 	}
 
-	// composeModuleUpdateQuery assembles query for updating composeModules
+	// automationWorkflowRevisionUpdateQuery assembles query for updating automationWorkflowRevisions
 	//
 	// This function is auto-generated
-	composeModuleUpdateQuery = func(d goqu.DialectWrapper, res *composeType.Module) *goqu.UpdateDataset {
-		return d.Update(composeModuleTable).
+	automationWorkflowRevisionUpdateQuery = func(d goqu.DialectWrapper, res *automationType.WorkflowRevision) *goqu.UpdateDataset {
+		return d.Update(automationWorkflowRevisionTable).
 			Set(goqu.Record{
-				"handle":        res.Handle,
-				"meta":          res.Meta,
-				"model_config":  res.ModelConfig,
-				"rel_namespace": res.NamespaceID,
-				"name":          res.Name,
-				"created_at":    res.CreatedAt,
-				"updated_at":    res.UpdatedAt,
-				"deleted_at":    res.DeletedAt,
+				"rel_workflow": res.WorkflowID,
+				"revision":     res.Revision,
+				"meta":         res.Meta,
+				"scope":        res.Scope,
+				"steps":        res.Steps,
+				"paths":        res.Paths,
+				"created_at":   res.CreatedAt,
+				"created_by":   res.CreatedBy,
 			}).
-			Where(composeModulePrimaryKeys(res))
+			Where(automationWorkflowRevisionPrimaryKeys(res))
 	}
 
-	// composeModuleDeleteQuery assembles delete query for removing composeModules
+	// automationWorkflowRevisionDeleteQuery assembles delete query for removing automationWorkflowRevisions
 	//
 	// This function is auto-generated
-	composeModuleDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
-		return d.Delete(composeModuleTable).Where(ee...)
+	automationWorkflowRevisionDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(automationWorkflowRevisionTable).Where(ee...)
 	}
 
-	// composeModuleDeleteQuery assembles delete query for removing composeModules
+	// automationWorkflowRevisionDeleteQuery assembles delete query for removing automationWorkflowRevisions
 	//
 	// This function is auto-generated
-	composeModuleTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
-		return d.Truncate(composeModuleTable)
+	automationWorkflowRevisionTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(automationWorkflowRevisionTable)
 	}
 
-	// composeModulePrimaryKeys assembles set of conditions for all primary keys
+	// automationWorkflowRevisionPrimaryKeys assembles set of conditions for all primary keys
 	//
 	// This function is auto-generated
-	composeModulePrimaryKeys = func(res *composeType.Module) goqu.Ex {
+	automationWorkflowRevisionPrimaryKeys = func(res *automationType.WorkflowRevision) goqu.Ex {
 		return goqu.Ex{
 			"id": res.ID,
 		}
 	}
 
-	// composeModuleFieldTable represents composeModuleFields store table
+	// automationWorkflowTestCaseTable represents automationWorkflowTestCases store table
 	//
 	// This value is auto-generated
-	composeModuleFieldTable = goqu.T("compose_module_field")
+	automationWorkflowTestCaseTable = goqu.T("automation_workflow_test_cases")
 
-	// composeModuleFieldSelectQuery assembles select query for fetching composeModuleFields
+	// automationWorkflowTestCaseSelectQuery assembles select query for fetching automationWorkflowTestCases
 	//
 	// This function is auto-generated
-	composeModuleFieldSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+	automationWorkflowTestCaseSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
 		return d.Select(
 			"id",
-			"rel_module",
-			"place",
-			"kind",
-			"name",
-			"label",
-			"options",
-			"encoding_strategy",
-			"is_private",
-			"is_required",
-			"is_visible",
-			"is_multi",
-			"default_value",
-			"expressions",
+			"rel_workflow",
+			"meta",
+			"rel_step",
+			"input",
+			"mocks",
+			"passthrough",
+			"assertions",
+			"expect_error",
+			"owned_by",
 			"created_at",
 			"updated_at",
 			"deleted_at",
-		).From(composeModuleFieldTable)
+			"created_by",
+			"updated_by",
+			"deleted_by",
+		).From(automationWorkflowTestCaseTable)
 	}
 
-	// composeModuleFieldInsertQuery assembles query inserting composeModuleFields
+	// automationWorkflowTestCaseInsertQuery assembles query inserting automationWorkflowTestCases
 	//
 	// This function is auto-generated
-	composeModuleFieldInsertQuery = func(d goqu.DialectWrapper, res *composeType.ModuleField) *goqu.InsertDataset {
-		return d.Insert(composeModuleFieldTable).
+	automationWorkflowTestCaseInsertQuery = func(d goqu.DialectWrapper, res *automationType.WorkflowTestCase) *goqu.InsertDataset {
+		return d.Insert(automationWorkflowTestCaseTable).
 			Rows(goqu.Record{
-				"id":                res.ID,
-				"rel_module":        res.ModuleID,
-				"place":             res.Place,
-				"kind":              res.Kind,
-				"name":              res.Name,
-				"label":             res.Label,
-				"options":           res.Options,
-				"encoding_strategy": res.EncodingStrategy,
-				"is_private":        res.Private,
-				"is_required":       res.Required,
-				"is_visible":        res.Visible,
-				"is_multi":          res.Multi,
-				"default_value":     res.DefaultValue,
-				"expressions":       res.Expressions,
-				"created_at":        res.CreatedAt,
-				"updated_at":        res.UpdatedAt,
-				"deleted_at":        res.DeletedAt,
+				"id":           res.ID,
+				"rel_workflow": res.WorkflowID,
+				"meta":         res.Meta,
+				"rel_step":     res.StepID,
+				"input":        res.Input,
+				"mocks":        res.Mocks,
+				"passthrough":  res.Passthrough,
+				"assertions":   res.Assertions,
+				"expect_error": res.ExpectError,
+				"owned_by":     res.OwnedBy,
+				"created_at":   res.CreatedAt,
+				"updated_at":   res.UpdatedAt,
+				"deleted_at":   res.DeletedAt,
+				"created_by":   res.CreatedBy,
+				"updated_by":   res.UpdatedBy,
+				"deleted_by":   res.DeletedBy,
 			})
 	}
 
-	// composeModuleFieldUpsertQuery assembles (insert+on-conflict) query for replacing composeModuleFields
+	// automationWorkflowTestCaseUpsertQuery assembles (insert+on-conflict) query for replacing automationWorkflowTestCases
 	//
 	// This function is auto-generated
-	composeModuleFieldUpsertQuery = func(d goqu.DialectWrapper, res *composeType.ModuleField) *goqu.InsertDataset {
+	automationWorkflowTestCaseUpsertQuery = func(d goqu.DialectWrapper, res *automationType.WorkflowTestCase) *goqu.InsertDataset {
 		var target = `,id`
 
-		return composeModuleFieldInsertQuery(d, res).
+		return automationWorkflowTestCaseInsertQuery(d, res).
 			OnConflict(
 				goqu.DoUpdate(target[1:],
 					goqu.Record{
-						"rel_module":        res.ModuleID,
-						"place":             res.Place,
-						"kind":              res.Kind,
-						"name":              res.Name,
-						"label":             res.Label,
-						"options":           res.Options,
-						"encoding_strategy": res.EncodingStrategy,
-						"is_private":        res.Private,
-						"is_required":       res.Required,
-						"is_visible":        res.Visible,
-						"is_multi":          res.Multi,
-						"default_value":     res.DefaultValue,
-						"expressions":       res.Expressions,
-						"created_at":        res.CreatedAt,
-						"updated_at":        res.UpdatedAt,
-						"deleted_at":        res.DeletedAt,
+						"rel_workflow": res.WorkflowID,
+						"meta":         res.Meta,
+						"rel_step":     res.StepID,
+						"input":        res.Input,
+						"mocks":        res.Mocks,
+						"passthrough":  res.Passthrough,
+						"assertions":   res.Assertions,
+						"expect_error": res.ExpectError,
+						"owned_by":     res.OwnedBy,
+						"created_at":   res.CreatedAt,
+						"updated_at":   res.UpdatedAt,
+						"deleted_at":   res.DeletedAt,
+						"created_by":   res.CreatedBy,
+						"updated_by":   res.UpdatedBy,
+						"deleted_by":   res.DeletedBy,
 					},
 				),
 			)
 	}
 
-	// composeModuleFieldUpdateQuery assembles query for updating composeModuleFields
+	// automationWorkflowTestCaseUpdateQuery assembles query for updating automationWorkflowTestCases
 	//
 	// This function is auto-generated
-	composeModuleFieldUpdateQuery = func(d goqu.DialectWrapper, res *composeType.ModuleField) *goqu.UpdateDataset {
-		return d.Update(composeModuleFieldTable).
+	automationWorkflowTestCaseUpdateQuery = func(d goqu.DialectWrapper, res *automationType.WorkflowTestCase) *goqu.UpdateDataset {
+		return d.Update(automationWorkflowTestCaseTable).
 			Set(goqu.Record{
-				"rel_module":        res.ModuleID,
-				"place":             res.Place,
-				"kind":              res.Kind,
-				"name":              res.Name,
-				"label":             res.Label,
-				"options":           res.Options,
-				"encoding_strategy": res.EncodingStrategy,
-				"is_private":        res.Private,
-				"is_required":       res.Required,
-				"is_visible":        res.Visible,
-				"is_multi":          res.Multi,
-				"default_value":     res.DefaultValue,
-				"expressions":       res.Expressions,
-				"created_at":        res.CreatedAt,
-				"updated_at":        res.UpdatedAt,
-				"deleted_at":        res.DeletedAt,
+				"rel_workflow": res.WorkflowID,
+				"meta":         res.Meta,
+				"rel_step":     res.StepID,
+				"input":        res.Input,
+				"mocks":        res.Mocks,
+				"passthrough":  res.Passthrough,
+				"assertions":   res.Assertions,
+				"expect_error": res.ExpectError,
+				"owned_by":     res.OwnedBy,
+				"created_at":   res.CreatedAt,
+				"updated_at":   res.UpdatedAt,
+				"deleted_at":   res.DeletedAt,
+				"created_by":   res.CreatedBy,
+				"updated_by":   res.UpdatedBy,
+				"deleted_by":   res.DeletedBy,
 			}).
-			Where(composeModuleFieldPrimaryKeys(res))
+			Where(automationWorkflowTestCasePrimaryKeys(res))
 	}
 
-	// composeModuleFieldDeleteQuery assembles delete query for removing composeModuleFields
+	// automationWorkflowTestCaseDeleteQuery assembles delete query for removing automationWorkflowTestCases
 	//
 	// This function is auto-generated
-	composeModuleFieldDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
-		return d.Delete(composeModuleFieldTable).Where(ee...)
+	automationWorkflowTestCaseDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(automationWorkflowTestCaseTable).Where(ee...)
 	}
 
-	// composeModuleFieldDeleteQuery assembles delete query for removing composeModuleFields
+	// automationWorkflowTestCaseDeleteQuery assembles delete query for removing automationWorkflowTestCases
 	//
 	// This function is auto-generated
-	composeModuleFieldTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
-		return d.Truncate(composeModuleFieldTable)
+	automationWorkflowTestCaseTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(automationWorkflowTestCaseTable)
 	}
 
-	// composeModuleFieldPrimaryKeys assembles set of conditions for all primary keys
+	// automationWorkflowTestCasePrimaryKeys assembles set of conditions for all primary keys
 	//
 	// This function is auto-generated
-	composeModuleFieldPrimaryKeys = func(res *composeType.ModuleField) goqu.Ex {
+	automationWorkflowTestCasePrimaryKeys = func(res *automationType.WorkflowTestCase) goqu.Ex {
 		return goqu.Ex{
 			"id": res.ID,
 		}
 	}
 
-	// composeNamespaceTable represents composeNamespaces store table
+	// composeAttachmentTable represents composeAttachments store table
 	//
 	// This value is auto-generated
-	composeNamespaceTable = goqu.T("compose_namespace")
+	composeAttachmentTable = goqu.T("compose_attachment")
 
-	// composeNamespaceSelectQuery assembles select query for fetching composeNamespaces
+	// composeAttachmentSelectQuery assembles select query for fetching composeAttachments
 	//
 	// This function is auto-generated
-	composeNamespaceSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+	composeAttachmentSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
 		return d.Select(
 			"id",
-			"slug",
-			"enabled",
-			"meta",
+			"rel_owner",
+			"rel_namespace",
+			"kind",
+			"url",
+			"preview_url",
 			"name",
+			"meta",
 			"created_at",
 			"updated_at",
 			"deleted_at",
-		).From(composeNamespaceTable)
+		).From(composeAttachmentTable)
 	}
 
-	// composeNamespaceInsertQuery assembles query inserting composeNamespaces
+	// composeAttachmentInsertQuery assembles query inserting composeAttachments
 	//
 	// This function is auto-generated
-	composeNamespaceInsertQuery = func(d goqu.DialectWrapper, res *composeType.Namespace) *goqu.InsertDataset {
-		return d.Insert(composeNamespaceTable).
+	composeAttachmentInsertQuery = func(d goqu.DialectWrapper, res *composeType.Attachment) *goqu.InsertDataset {
+		return d.Insert(composeAttachmentTable).
+			Rows(goqu.Record{
+				"id":            res.ID,
+				"rel_owner":     res.OwnerID,
+				"rel_namespace": res.NamespaceID,
+				"kind":          res.Kind,
+				"url":           res.Url,
+				"preview_url":   res.PreviewUrl,
+				"name":          res.Name,
+				"meta":          res.Meta,
+				"created_at":    res.CreatedAt,
+				"updated_at":    res.UpdatedAt,
+				"deleted_at":    res.DeletedAt,
+			})
+	}
+
+	// composeAttachmentUpsertQuery assembles (insert+on-conflict) query for replacing composeAttachments
+	//
+	// This function is auto-generated
+	composeAttachmentUpsertQuery = func(d goqu.DialectWrapper, res *composeType.Attachment) *goqu.InsertDataset {
+		var target = `,id`
+
+		return composeAttachmentInsertQuery(d, res).
+			OnConflict(
+				goqu.DoUpdate(target[1:],
+					goqu.Record{
+						"rel_owner":     res.OwnerID,
+						"rel_namespace": res.NamespaceID,
+						"kind":          res.Kind,
+						"url":           res.Url,
+						"preview_url":   res.PreviewUrl,
+						"name":          res.Name,
+						"meta":          res.Meta,
+						"created_at":    res.CreatedAt,
+						"updated_at":    res.UpdatedAt,
+						"deleted_at":    res.DeletedAt,
+					},
+				),
+			)
+	}
+
+	// composeAttachmentUpdateQuery assembles query for updating composeAttachments
+	//
+	// This function is auto-generated
+	composeAttachmentUpdateQuery = func(d goqu.DialectWrapper, res *composeType.Attachment) *goqu.UpdateDataset {
+		return d.Update(composeAttachmentTable).
+			Set(goqu.Record{
+				"rel_owner":     res.OwnerID,
+				"rel_namespace": res.NamespaceID,
+				"kind":          res.Kind,
+				"url":           res.Url,
+				"preview_url":   res.PreviewUrl,
+				"name":          res.Name,
+				"meta":          res.Meta,
+				"created_at":    res.CreatedAt,
+				"updated_at":    res.UpdatedAt,
+				"deleted_at":    res.DeletedAt,
+			}).
+			Where(composeAttachmentPrimaryKeys(res))
+	}
+
+	// composeAttachmentDeleteQuery assembles delete query for removing composeAttachments
+	//
+	// This function is auto-generated
+	composeAttachmentDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(composeAttachmentTable).Where(ee...)
+	}
+
+	// composeAttachmentDeleteQuery assembles delete query for removing composeAttachments
+	//
+	// This function is auto-generated
+	composeAttachmentTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(composeAttachmentTable)
+	}
+
+	// composeAttachmentPrimaryKeys assembles set of conditions for all primary keys
+	//
+	// This function is auto-generated
+	composeAttachmentPrimaryKeys = func(res *composeType.Attachment) goqu.Ex {
+		return goqu.Ex{
+			"id": res.ID,
+		}
+	}
+
+	// composeChartTable represents composeCharts store table
+	//
+	// This value is auto-generated
+	composeChartTable = goqu.T("compose_chart")
+
+	// composeChartSelectQuery assembles select query for fetching composeCharts
+	//
+	// This function is auto-generated
+	composeChartSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+		return d.Select(
+			"id",
+			"handle",
+			"name",
+			"config",
+			"rel_namespace",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+		).From(composeChartTable)
+	}
+
+	// composeChartInsertQuery assembles query inserting composeCharts
+	//
+	// This function is auto-generated
+	composeChartInsertQuery = func(d goqu.DialectWrapper, res *composeType.Chart) *goqu.InsertDataset {
+		return d.Insert(composeChartTable).
+			Rows(goqu.Record{
+				"id":            res.ID,
+				"handle":        res.Handle,
+				"name":          res.Name,
+				"config":        res.Config,
+				"rel_namespace": res.NamespaceID,
+				"created_at":    res.CreatedAt,
+				"updated_at":    res.UpdatedAt,
+				"deleted_at":    res.DeletedAt,
+			})
+	}
+
+	// composeChartUpsertQuery assembles (insert+on-conflict) query for replacing composeCharts
+	//
+	// This function is auto-generated
+	composeChartUpsertQuery = func(d goqu.DialectWrapper, res *composeType.Chart) *goqu.InsertDataset {
+		var target = `,id`
+
+		return composeChartInsertQuery(d, res).
+			OnConflict(
+				goqu.DoUpdate(target[1:],
+					goqu.Record{
+						"handle":        res.Handle,
+						"name":          res.Name,
+						"config":        res.Config,
+						"rel_namespace": res.NamespaceID,
+						"created_at":    res.CreatedAt,
+						"updated_at":    res.UpdatedAt,
+						"deleted_at":    res.DeletedAt,
+					},
+				),
+			)
+	}
+
+	// composeChartUpdateQuery assembles query for updating composeCharts
+	//
+	// This function is auto-generated
+	composeChartUpdateQuery = func(d goqu.DialectWrapper, res *composeType.Chart) *goqu.UpdateDataset {
+		return d.Update(composeChartTable).
+			Set(goqu.Record{
+				"handle":        res.Handle,
+				"name":          res.Name,
+				"config":        res.Config,
+				"rel_namespace": res.NamespaceID,
+				"created_at":    res.CreatedAt,
+				"updated_at":    res.UpdatedAt,
+				"deleted_at":    res.DeletedAt,
+			}).
+			Where(composeChartPrimaryKeys(res))
+	}
+
+	// composeChartDeleteQuery assembles delete query for removing composeCharts
+	//
+	// This function is auto-generated
+	composeChartDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(composeChartTable).Where(ee...)
+	}
+
+	// composeChartDeleteQuery assembles delete query for removing composeCharts
+	//
+	// This function is auto-generated
+	composeChartTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(composeChartTable)
+	}
+
+	// composeChartPrimaryKeys assembles set of conditions for all primary keys
+	//
+	// This function is auto-generated
+	composeChartPrimaryKeys = func(res *composeType.Chart) goqu.Ex {
+		return goqu.Ex{
+			"id": res.ID,
+		}
+	}
+
+	// composeModuleTable represents composeModules store table
+	//
+	// This value is auto-generated
+	composeModuleTable = goqu.T("compose_module")
+
+	// composeModuleSelectQuery assembles select query for fetching composeModules
+	//
+	// This function is auto-generated
+	composeModuleSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+		return d.Select(
+			"id",
+			"handle",
+			"meta",
+			"model_config",
+			"rel_namespace",
+			"name",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+		).From(composeModuleTable)
+	}
+
+	// composeModuleInsertQuery assembles query inserting composeModules
+	//
+	// This function is auto-generated
+	composeModuleInsertQuery = func(d goqu.DialectWrapper, res *composeType.Module) *goqu.InsertDataset {
+		return d.Insert(composeModuleTable).
+			Rows(goqu.Record{
+				"id":            res.ID,
+				"handle":        res.Handle,
+				"meta":          res.Meta,
+				"model_config":  res.ModelConfig,
+				"rel_namespace": res.NamespaceID,
+				"name":          res.Name,
+				"created_at":    res.CreatedAt,
+				"updated_at":    res.UpdatedAt,
+				"deleted_at":    res.DeletedAt,
+			})
+	}
+
+	// composeModuleUpsertQuery assembles (insert+on-conflict) query for replacing composeModules
+	//
+	// This function is auto-generated
+	composeModuleUpsertQuery = func(d goqu.DialectWrapper, res *composeType.Module) *goqu.InsertDataset {
+		var target = `,id`
+
+		return composeModuleInsertQuery(d, res).
+			OnConflict(
+				goqu.DoUpdate(target[1:],
+					goqu.Record{
+						"handle":        res.Handle,
+						"meta":          res.Meta,
+						"model_config":  res.ModelConfig,
+						"rel_namespace": res.NamespaceID,
+						"name":          res.Name,
+						"created_at":    res.CreatedAt,
+						"updated_at":    res.UpdatedAt,
+						"deleted_at":    res.DeletedAt,
+					},
+				),
+			)
+	}
+
+	// composeModuleUpdateQuery assembles query for updating composeModules
+	//
+	// This function is auto-generated
+	composeModuleUpdateQuery = func(d goqu.DialectWrapper, res *composeType.Module) *goqu.UpdateDataset {
+		return d.Update(composeModuleTable).
+			Set(goqu.Record{
+				"handle":        res.Handle,
+				"meta":          res.Meta,
+				"model_config":  res.ModelConfig,
+				"rel_namespace": res.NamespaceID,
+				"name":          res.Name,
+				"created_at":    res.CreatedAt,
+				"updated_at":    res.UpdatedAt,
+				"deleted_at":    res.DeletedAt,
+			}).
+			Where(composeModulePrimaryKeys(res))
+	}
+
+	// composeModuleDeleteQuery assembles delete query for removing composeModules
+	//
+	// This function is auto-generated
+	composeModuleDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(composeModuleTable).Where(ee...)
+	}
+
+	// composeModuleDeleteQuery assembles delete query for removing composeModules
+	//
+	// This function is auto-generated
+	composeModuleTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(composeModuleTable)
+	}
+
+	// composeModulePrimaryKeys assembles set of conditions for all primary keys
+	//
+	// This function is auto-generated
+	composeModulePrimaryKeys = func(res *composeType.Module) goqu.Ex {
+		return goqu.Ex{
+			"id": res.ID,
+		}
+	}
+
+	// composeModuleFieldTable represents composeModuleFields store table
+	//
+	// This value is auto-generated
+	composeModuleFieldTable = goqu.T("compose_module_field")
+
+	// composeModuleFieldSelectQuery assembles select query for fetching composeModuleFields
+	//
+	// This function is auto-generated
+	composeModuleFieldSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+		return d.Select(
+			"id",
+			"rel_module",
+			"place",
+			"kind",
+			"name",
+			"label",
+			"options",
+			"encoding_strategy",
+			"is_private",
+			"is_required",
+			"is_visible",
+			"is_multi",
+			"default_value",
+			"expressions",
+			"sensitivity_level",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+		).From(composeModuleFieldTable)
+	}
+
+	// composeModuleFieldInsertQuery assembles query inserting composeModuleFields
+	//
+	// This function is auto-generated
+	composeModuleFieldInsertQuery = func(d goqu.DialectWrapper, res *composeType.ModuleField) *goqu.InsertDataset {
+		return d.Insert(composeModuleFieldTable).
+			Rows(goqu.Record{
+				"id":                res.ID,
+				"rel_module":        res.ModuleID,
+				"place":             res.Place,
+				"kind":              res.Kind,
+				"name":              res.Name,
+				"label":             res.Label,
+				"options":           res.Options,
+				"encoding_strategy": res.EncodingStrategy,
+				"is_private":        res.Private,
+				"is_required":       res.Required,
+				"is_visible":        res.Visible,
+				"is_multi":          res.Multi,
+				"default_value":     res.DefaultValue,
+				"expressions":       res.Expressions,
+				"sensitivity_level": res.SensitivityLevel,
+				"created_at":        res.CreatedAt,
+				"updated_at":        res.UpdatedAt,
+				"deleted_at":        res.DeletedAt,
+			})
+	}
+
+	// composeModuleFieldUpsertQuery assembles (insert+on-conflict) query for replacing composeModuleFields
+	//
+	// This function is auto-generated
+	composeModuleFieldUpsertQuery = func(d goqu.DialectWrapper, res *composeType.ModuleField) *goqu.InsertDataset {
+		var target = `,id`
+
+		return composeModuleFieldInsertQuery(d, res).
+			OnConflict(
+				goqu.DoUpdate(target[1:],
+					goqu.Record{
+						"rel_module":        res.ModuleID,
+						"place":             res.Place,
+						"kind":              res.Kind,
+						"name":              res.Name,
+						"label":             res.Label,
+						"options":           res.Options,
+						"encoding_strategy": res.EncodingStrategy,
+						"is_private":        res.Private,
+						"is_required":       res.Required,
+						"is_visible":        res.Visible,
+						"is_multi":          res.Multi,
+						"default_value":     res.DefaultValue,
+						"expressions":       res.Expressions,
+						"sensitivity_level": res.SensitivityLevel,
+						"created_at":        res.CreatedAt,
+						"updated_at":        res.UpdatedAt,
+						"deleted_at":        res.DeletedAt,
+					},
+				),
+			)
+	}
+
+	// composeModuleFieldUpdateQuery assembles query for updating composeModuleFields
+	//
+	// This function is auto-generated
+	composeModuleFieldUpdateQuery = func(d goqu.DialectWrapper, res *composeType.ModuleField) *goqu.UpdateDataset {
+		return d.Update(composeModuleFieldTable).
+			Set(goqu.Record{
+				"rel_module":        res.ModuleID,
+				"place":             res.Place,
+				"kind":              res.Kind,
+				"name":              res.Name,
+				"label":             res.Label,
+				"options":           res.Options,
+				"encoding_strategy": res.EncodingStrategy,
+				"is_private":        res.Private,
+				"is_required":       res.Required,
+				"is_visible":        res.Visible,
+				"is_multi":          res.Multi,
+				"default_value":     res.DefaultValue,
+				"expressions":       res.Expressions,
+				"sensitivity_level": res.SensitivityLevel,
+				"created_at":        res.CreatedAt,
+				"updated_at":        res.UpdatedAt,
+				"deleted_at":        res.DeletedAt,
+			}).
+			Where(composeModuleFieldPrimaryKeys(res))
+	}
+
+	// composeModuleFieldDeleteQuery assembles delete query for removing composeModuleFields
+	//
+	// This function is auto-generated
+	composeModuleFieldDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(composeModuleFieldTable).Where(ee...)
+	}
+
+	// composeModuleFieldDeleteQuery assembles delete query for removing composeModuleFields
+	//
+	// This function is auto-generated
+	composeModuleFieldTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(composeModuleFieldTable)
+	}
+
+	// composeModuleFieldPrimaryKeys assembles set of conditions for all primary keys
+	//
+	// This function is auto-generated
+	composeModuleFieldPrimaryKeys = func(res *composeType.ModuleField) goqu.Ex {
+		return goqu.Ex{
+			"id": res.ID,
+		}
+	}
+
+	// composeNamespaceTable represents composeNamespaces store table
+	//
+	// This value is auto-generated
+	composeNamespaceTable = goqu.T("compose_namespace")
+
+	// composeNamespaceSelectQuery assembles select query for fetching composeNamespaces
+	//
+	// This function is auto-generated
+	composeNamespaceSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+		return d.Select(
+			"id",
+			"slug",
+			"enabled",
+			"meta",
+			"name",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+		).From(composeNamespaceTable)
+	}
+
+	// composeNamespaceInsertQuery assembles query inserting composeNamespaces
+	//
+	// This function is auto-generated
+	composeNamespaceInsertQuery = func(d goqu.DialectWrapper, res *composeType.Namespace) *goqu.InsertDataset {
+		return d.Insert(composeNamespaceTable).
 			Rows(goqu.Record{
 				"id":         res.ID,
 				"slug":       res.Slug,
@@ -1901,295 +2685,614 @@ var (
 			})
 	}
 
-	// composeNamespaceUpsertQuery assembles (insert+on-conflict) query for replacing composeNamespaces
+	// composeNamespaceUpsertQuery assembles (insert+on-conflict) query for replacing composeNamespaces
+	//
+	// This function is auto-generated
+	composeNamespaceUpsertQuery = func(d goqu.DialectWrapper, res *composeType.Namespace) *goqu.InsertDataset {
+		var target = `,id`
+
+		return composeNamespaceInsertQuery(d, res).
+			OnConflict(
+				goqu.DoUpdate(target[1:],
+					goqu.Record{
+						"slug":       res.Slug,
+						"enabled":    res.Enabled,
+						"meta":       res.Meta,
+						"name":       res.Name,
+						"created_at": res.CreatedAt,
+						"updated_at": res.UpdatedAt,
+						"deleted_at": res.DeletedAt,
+					},
+				),
+			)
+	}
+
+	// composeNamespaceUpdateQuery assembles query for updating composeNamespaces
+	//
+	// This function is auto-generated
+	composeNamespaceUpdateQuery = func(d goqu.DialectWrapper, res *composeType.Namespace) *goqu.UpdateDataset {
+		return d.Update(composeNamespaceTable).
+			Set(goqu.Record{
+				"slug":       res.Slug,
+				"enabled":    res.Enabled,
+				"meta":       res.Meta,
+				"name":       res.Name,
+				"created_at": res.CreatedAt,
+				"updated_at": res.UpdatedAt,
+				"deleted_at": res.DeletedAt,
+			}).
+			Where(composeNamespacePrimaryKeys(res))
+	}
+
+	// composeNamespaceDeleteQuery assembles delete query for removing composeNamespaces
+	//
+	// This function is auto-generated
+	composeNamespaceDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(composeNamespaceTable).Where(ee...)
+	}
+
+	// composeNamespaceDeleteQuery assembles delete query for removing composeNamespaces
+	//
+	// This function is auto-generated
+	composeNamespaceTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(composeNamespaceTable)
+	}
+
+	// composeNamespacePrimaryKeys assembles set of conditions for all primary keys
+	//
+	// This function is auto-generated
+	composeNamespacePrimaryKeys = func(res *composeType.Namespace) goqu.Ex {
+		return goqu.Ex{
+			"id": res.ID,
+		}
+	}
+
+	// composePageTable represents composePages store table
+	//
+	// This value is auto-generated
+	composePageTable = goqu.T("compose_page")
+
+	// composePageSelectQuery assembles select query for fetching composePages
+	//
+	// This function is auto-generated
+	composePageSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+		return d.Select(
+			"id",
+			"self_id",
+			"rel_module",
+			"rel_namespace",
+			"handle",
+			"config",
+			"blocks",
+			"visible",
+			"weight",
+			"title",
+			"description",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+		).From(composePageTable)
+	}
+
+	// composePageInsertQuery assembles query inserting composePages
+	//
+	// This function is auto-generated
+	composePageInsertQuery = func(d goqu.DialectWrapper, res *composeType.Page) *goqu.InsertDataset {
+		return d.Insert(composePageTable).
+			Rows(goqu.Record{
+				"id":            res.ID,
+				"self_id":       res.SelfID,
+				"rel_module":    res.ModuleID,
+				"rel_namespace": res.NamespaceID,
+				"handle":        res.Handle,
+				"config":        res.Config,
+				"blocks":        res.Blocks,
+				"visible":       res.Visible,
+				"weight":        res.Weight,
+				"title":         res.Title,
+				"description":   res.Description,
+				"created_at":    res.CreatedAt,
+				"updated_at":    res.UpdatedAt,
+				"deleted_at":    res.DeletedAt,
+			})
+	}
+
+	// composePageUpsertQuery assembles (insert+on-conflict) query for replacing composePages
+	//
+	// This function is auto-generated
+	composePageUpsertQuery = func(d goqu.DialectWrapper, res *composeType.Page) *goqu.InsertDataset {
+		var target = `,id`
+
+		return composePageInsertQuery(d, res).
+			OnConflict(
+				goqu.DoUpdate(target[1:],
+					goqu.Record{
+						"self_id":       res.SelfID,
+						"rel_module":    res.ModuleID,
+						"rel_namespace": res.NamespaceID,
+						"handle":        res.Handle,
+						"config":        res.Config,
+						"blocks":        res.Blocks,
+						"visible":       res.Visible,
+						"weight":        res.Weight,
+						"title":         res.Title,
+						"description":   res.Description,
+						"created_at":    res.CreatedAt,
+						"updated_at":    res.UpdatedAt,
+						"deleted_at":    res.DeletedAt,
+					},
+				),
+			)
+	}
+
+	// composePageUpdateQuery assembles query for updating composePages
+	//
+	// This function is auto-generated
+	composePageUpdateQuery = func(d goqu.DialectWrapper, res *composeType.Page) *goqu.UpdateDataset {
+		return d.Update(composePageTable).
+			Set(goqu.Record{
+				"self_id":       res.SelfID,
+				"rel_module":    res.ModuleID,
+				"rel_namespace": res.NamespaceID,
+				"handle":        res.Handle,
+				"config":        res.Config,
+				"blocks":        res.Blocks,
+				"visible":       res.Visible,
+				"weight":        res.Weight,
+				"title":         res.Title,
+				"description":   res.Description,
+				"created_at":    res.CreatedAt,
+				"updated_at":    res.UpdatedAt,
+				"deleted_at":    res.DeletedAt,
+			}).
+			Where(composePagePrimaryKeys(res))
+	}
+
+	// composePageDeleteQuery assembles delete query for removing composePages
+	//
+	// This function is auto-generated
+	composePageDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(composePageTable).Where(ee...)
+	}
+
+	// composePageDeleteQuery assembles delete query for removing composePages
+	//
+	// This function is auto-generated
+	composePageTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(composePageTable)
+	}
+
+	// composePagePrimaryKeys assembles set of conditions for all primary keys
+	//
+	// This function is auto-generated
+	composePagePrimaryKeys = func(res *composeType.Page) goqu.Ex {
+		return goqu.Ex{
+			"id": res.ID,
+		}
+	}
+
+	// composeRecordTable represents composeRecords store table
+	//
+	// This value is auto-generated
+	composeRecordTable = goqu.T("compose_record")
+
+	// composeRecordSelectQuery assembles select query for fetching composeRecords
+	//
+	// This function is auto-generated
+	composeRecordSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+		return d.Select(
+			"id",
+			"module_id",
+			"rel_namespace",
+			"owned_by",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+			"created_by",
+			"updated_by",
+			"deleted_by",
+		).From(composeRecordTable)
+	}
+
+	// composeRecordInsertQuery assembles query inserting composeRecords
+	//
+	// This function is auto-generated
+	composeRecordInsertQuery = func(d goqu.DialectWrapper, res *composeType.Record) *goqu.InsertDataset {
+		return d.Insert(composeRecordTable).
+			Rows(goqu.Record{
+				"id":            res.ID,
+				"module_id":     res.ModuleID,
+				"rel_namespace": res.NamespaceID,
+				"owned_by":      res.OwnedBy,
+				"created_at":    res.CreatedAt,
+				"updated_at":    res.UpdatedAt,
+				"deleted_at":    res.DeletedAt,
+				"created_by":    res.CreatedBy,
+				"updated_by":    res.UpdatedBy,
+				"deleted_by":    res.DeletedBy,
+			})
+	}
+
+	// composeRecordUpsertQuery assembles (insert+on-conflict) query for replacing composeRecords
+	//
+	// This function is auto-generated
+	composeRecordUpsertQuery = func(d goqu.DialectWrapper, res *composeType.Record) *goqu.InsertDataset {
+		var target = `,id`
+
+		return composeRecordInsertQuery(d, res).
+			OnConflict(
+				goqu.DoUpdate(target[1:],
+					goqu.Record{
+						"module_id":     res.ModuleID,
+						"rel_namespace": res.NamespaceID,
+						"owned_by":      res.OwnedBy,
+						"created_at":    res.CreatedAt,
+						"updated_at":    res.UpdatedAt,
+						"deleted_at":    res.DeletedAt,
+						"created_by":    res.CreatedBy,
+						"updated_by":    res.UpdatedBy,
+						"deleted_by":    res.DeletedBy,
+					},
+				),
+			)
+	}
+
+	// composeRecordUpdateQuery assembles query for updating composeRecords
+	//
+	// This function is auto-generated
+	composeRecordUpdateQuery = func(d goqu.DialectWrapper, res *composeType.Record) *goqu.UpdateDataset {
+		return d.Update(composeRecordTable).
+			Set(goqu.Record{
+				"module_id":     res.ModuleID,
+				"rel_namespace": res.NamespaceID,
+				"owned_by":      res.OwnedBy,
+				"created_at":    res.CreatedAt,
+				"updated_at":    res.UpdatedAt,
+				"deleted_at":    res.DeletedAt,
+				"created_by":    res.CreatedBy,
+				"updated_by":    res.UpdatedBy,
+				"deleted_by":    res.DeletedBy,
+			}).
+			Where(composeRecordPrimaryKeys(res))
+	}
+
+	// composeRecordDeleteQuery assembles delete query for removing composeRecords
+	//
+	// This function is auto-generated
+	composeRecordDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(composeRecordTable).Where(ee...)
+	}
+
+	// composeRecordDeleteQuery assembles delete query for removing composeRecords
+	//
+	// This function is auto-generated
+	composeRecordTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(composeRecordTable)
+	}
+
+	// composeRecordPrimaryKeys assembles set of conditions for all primary keys
+	//
+	// This function is auto-generated
+	composeRecordPrimaryKeys = func(res *composeType.Record) goqu.Ex {
+		return goqu.Ex{
+			"id": res.ID,
+		}
+	}
+
+	// composeRecordImportErrorTable represents composeRecordImportErrors store table
+	//
+	// This value is auto-generated
+	composeRecordImportErrorTable = goqu.T("compose_record_import_error")
+
+	// composeRecordImportErrorSelectQuery assembles select query for fetching composeRecordImportErrors
+	//
+	// This function is auto-generated
+	composeRecordImportErrorSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+		return d.Select(
+			"rel_session",
+			"row_index",
+			"message",
+			"created_at",
+		).From(composeRecordImportErrorTable)
+	}
+
+	// composeRecordImportErrorInsertQuery assembles query inserting composeRecordImportErrors
+	//
+	// This function is auto-generated
+	composeRecordImportErrorInsertQuery = func(d goqu.DialectWrapper, res *composeType.RecordImportError) *goqu.InsertDataset {
+		return d.Insert(composeRecordImportErrorTable).
+			Rows(goqu.Record{
+				"rel_session": res.SessionID,
+				"row_index":   res.Row,
+				"message":     res.Message,
+				"created_at":  res.CreatedAt,
+			})
+	}
+
+	// composeRecordImportErrorUpsertQuery assembles (insert+on-conflict) query for replacing composeRecordImportErrors
 	//
 	// This function is auto-generated
-	composeNamespaceUpsertQuery = func(d goqu.DialectWrapper, res *composeType.Namespace) *goqu.InsertDataset {
-		var target = `,id`
+	composeRecordImportErrorUpsertQuery = func(d goqu.DialectWrapper, res *composeType.RecordImportError) *goqu.InsertDataset {
+		var target = `,rel_session,row_index`
 
-		return composeNamespaceInsertQuery(d, res).
+		return composeRecordImportErrorInsertQuery(d, res).
 			OnConflict(
 				goqu.DoUpdate(target[1:],
 					goqu.Record{
-						"slug":       res.Slug,
-						"enabled":    res.Enabled,
-						"meta":       res.Meta,
-						"name":       res.Name,
+						"message":    res.Message,
 						"created_at": res.CreatedAt,
-						"updated_at": res.UpdatedAt,
-						"deleted_at": res.DeletedAt,
 					},
 				),
 			)
 	}
 
-	// composeNamespaceUpdateQuery assembles query for updating composeNamespaces
+	// composeRecordImportErrorUpdateQuery assembles query for updating composeRecordImportErrors
 	//
 	// This function is auto-generated
-	composeNamespaceUpdateQuery = func(d goqu.DialectWrapper, res *composeType.Namespace) *goqu.UpdateDataset {
-		return d.Update(composeNamespaceTable).
+	composeRecordImportErrorUpdateQuery = func(d goqu.DialectWrapper, res *composeType.RecordImportError) *goqu.UpdateDataset {
+		return d.Update(composeRecordImportErrorTable).
 			Set(goqu.Record{
-				"slug":       res.Slug,
-				"enabled":    res.Enabled,
-				"meta":       res.Meta,
-				"name":       res.Name,
+				"message":    res.Message,
 				"created_at": res.CreatedAt,
-				"updated_at": res.UpdatedAt,
-				"deleted_at": res.DeletedAt,
 			}).
-			Where(composeNamespacePrimaryKeys(res))
+			Where(composeRecordImportErrorPrimaryKeys(res))
 	}
 
-	// composeNamespaceDeleteQuery assembles delete query for removing composeNamespaces
+	// composeRecordImportErrorDeleteQuery assembles delete query for removing composeRecordImportErrors
 	//
 	// This function is auto-generated
-	composeNamespaceDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
-		return d.Delete(composeNamespaceTable).Where(ee...)
+	composeRecordImportErrorDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(composeRecordImportErrorTable).Where(ee...)
 	}
 
-	// composeNamespaceDeleteQuery assembles delete query for removing composeNamespaces
+	// composeRecordImportErrorDeleteQuery assembles delete query for removing composeRecordImportErrors
 	//
 	// This function is auto-generated
-	composeNamespaceTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
-		return d.Truncate(composeNamespaceTable)
+	composeRecordImportErrorTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(composeRecordImportErrorTable)
 	}
 
-	// composeNamespacePrimaryKeys assembles set of conditions for all primary keys
+	// composeRecordImportErrorPrimaryKeys assembles set of conditions for all primary keys
 	//
 	// This function is auto-generated
-	composeNamespacePrimaryKeys = func(res *composeType.Namespace) goqu.Ex {
+	composeRecordImportErrorPrimaryKeys = func(res *composeType.RecordImportError) goqu.Ex {
 		return goqu.Ex{
-			"id": res.ID,
+			"rel_session": res.SessionID,
+			"row_index":   res.Row,
 		}
 	}
 
-	// composePageTable represents composePages store table
+	// composeRecordImportSessionTable represents composeRecordImportSessions store table
 	//
 	// This value is auto-generated
-	composePageTable = goqu.T("compose_page")
+	composeRecordImportSessionTable = goqu.T("compose_record_import_session")
 
-	// composePageSelectQuery assembles select query for fetching composePages
+	// composeRecordImportSessionSelectQuery assembles select query for fetching composeRecordImportSessions
 	//
 	// This function is auto-generated
-	composePageSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+	composeRecordImportSessionSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
 		return d.Select(
 			"id",
-			"self_id",
-			"rel_module",
+			"name",
+			"content_type",
+			"source",
+			"sheet",
+			"sheets",
+			"rel_user",
 			"rel_namespace",
-			"handle",
-			"config",
-			"blocks",
-			"visible",
-			"weight",
-			"title",
-			"description",
+			"rel_module",
+			"on_error",
+			"fields",
+			"key_field",
+			"progress",
 			"created_at",
 			"updated_at",
-			"deleted_at",
-		).From(composePageTable)
+		).From(composeRecordImportSessionTable)
 	}
 
-	// composePageInsertQuery assembles query inserting composePages
+	// composeRecordImportSessionInsertQuery assembles query inserting composeRecordImportSessions
 	//
 	// This function is auto-generated
-	composePageInsertQuery = func(d goqu.DialectWrapper, res *composeType.Page) *goqu.InsertDataset {
-		return d.Insert(composePageTable).
+	composeRecordImportSessionInsertQuery = func(d goqu.DialectWrapper, res *composeType.RecordImportSession) *goqu.InsertDataset {
+		return d.Insert(composeRecordImportSessionTable).
 			Rows(goqu.Record{
 				"id":            res.ID,
-				"self_id":       res.SelfID,
-				"rel_module":    res.ModuleID,
+				"name":          res.Name,
+				"content_type":  res.ContentType,
+				"source":        res.Source,
+				"sheet":         res.Sheet,
+				"sheets":        res.Sheets,
+				"rel_user":      res.UserID,
 				"rel_namespace": res.NamespaceID,
-				"handle":        res.Handle,
-				"config":        res.Config,
-				"blocks":        res.Blocks,
-				"visible":       res.Visible,
-				"weight":        res.Weight,
-				"title":         res.Title,
-				"description":   res.Description,
+				"rel_module":    res.ModuleID,
+				"on_error":      res.OnError,
+				"fields":        res.Fields,
+				"key_field":     res.Key,
+				"progress":      res.Progress,
 				"created_at":    res.CreatedAt,
 				"updated_at":    res.UpdatedAt,
-				"deleted_at":    res.DeletedAt,
 			})
 	}
 
-	// composePageUpsertQuery assembles (insert+on-conflict) query for replacing composePages
+	// composeRecordImportSessionUpsertQuery assembles (insert+on-conflict) query for replacing composeRecordImportSessions
 	//
 	// This function is auto-generated
-	composePageUpsertQuery = func(d goqu.DialectWrapper, res *composeType.Page) *goqu.InsertDataset {
+	composeRecordImportSessionUpsertQuery = func(d goqu.DialectWrapper, res *composeType.RecordImportSession) *goqu.InsertDataset {
 		var target = `,id`
 
-		return composePageInsertQuery(d, res).
+		return composeRecordImportSessionInsertQuery(d, res).
 			OnConflict(
 				goqu.DoUpdate(target[1:],
 					goqu.Record{
-						"self_id":       res.SelfID,
-						"rel_module":    res.ModuleID,
+						"name":          res.Name,
+						"content_type":  res.ContentType,
+						"source":        res.Source,
+						"sheet":         res.Sheet,
+						"sheets":        res.Sheets,
+						"rel_user":      res.UserID,
 						"rel_namespace": res.NamespaceID,
-						"handle":        res.Handle,
-						"config":        res.Config,
-						"blocks":        res.Blocks,
-						"visible":       res.Visible,
-						"weight":        res.Weight,
-						"title":         res.Title,
-						"description":   res.Description,
+						"rel_module":    res.ModuleID,
+						"on_error":      res.OnError,
+						"fields":        res.Fields,
+						"key_field":     res.Key,
+						"progress":      res.Progress,
 						"created_at":    res.CreatedAt,
 						"updated_at":    res.UpdatedAt,
-						"deleted_at":    res.DeletedAt,
 					},
 				),
 			)
 	}
 
-	// composePageUpdateQuery assembles query for updating composePages
+	// composeRecordImportSessionUpdateQuery assembles query for updating composeRecordImportSessions
 	//
 	// This function is auto-generated
-	composePageUpdateQuery = func(d goqu.DialectWrapper, res *composeType.Page) *goqu.UpdateDataset {
-		return d.Update(composePageTable).
+	composeRecordImportSessionUpdateQuery = func(d goqu.DialectWrapper, res *composeType.RecordImportSession) *goqu.UpdateDataset {
+		return d.Update(composeRecordImportSessionTable).
 			Set(goqu.Record{
-				"self_id":       res.SelfID,
-				"rel_module":    res.ModuleID,
+				"name":          res.Name,
+				"content_type":  res.ContentType,
+				"source":        res.Source,
+				"sheet":         res.Sheet,
+				"sheets":        res.Sheets,
+				"rel_user":      res.UserID,
 				"rel_namespace": res.NamespaceID,
-				"handle":        res.Handle,
-				"config":        res.Config,
-				"blocks":        res.Blocks,
-				"visible":       res.Visible,
-				"weight":        res.Weight,
-				"title":         res.Title,
-				"description":   res.Description,
+				"rel_module":    res.ModuleID,
+				"on_error":      res.OnError,
+				"fields":        res.Fields,
+				"key_field":     res.Key,
+				"progress":      res.Progress,
 				"created_at":    res.CreatedAt,
 				"updated_at":    res.UpdatedAt,
-				"deleted_at":    res.DeletedAt,
 			}).
-			Where(composePagePrimaryKeys(res))
+			Where(composeRecordImportSessionPrimaryKeys(res))
 	}
 
-	// composePageDeleteQuery assembles delete query for removing composePages
+	// composeRecordImportSessionDeleteQuery assembles delete query for removing composeRecordImportSessions
 	//
 	// This function is auto-generated
-	composePageDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
-		return d.Delete(composePageTable).Where(ee...)
+	composeRecordImportSessionDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(composeRecordImportSessionTable).Where(ee...)
 	}
 
-	// composePageDeleteQuery assembles delete query for removing composePages
+	// composeRecordImportSessionDeleteQuery assembles delete query for removing composeRecordImportSessions
 	//
 	// This function is auto-generated
-	composePageTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
-		return d.Truncate(composePageTable)
+	composeRecordImportSessionTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(composeRecordImportSessionTable)
 	}
 
-	// composePagePrimaryKeys assembles set of conditions for all primary keys
+	// composeRecordImportSessionPrimaryKeys assembles set of conditions for all primary keys
 	//
 	// This function is auto-generated
-	composePagePrimaryKeys = func(res *composeType.Page) goqu.Ex {
+	composeRecordImportSessionPrimaryKeys = func(res *composeType.RecordImportSession) goqu.Ex {
 		return goqu.Ex{
 			"id": res.ID,
 		}
 	}
 
-	// composeRecordTable represents composeRecords store table
+	// composeRecordRevisionTable represents composeRecordRevisions store table
 	//
 	// This value is auto-generated
-	composeRecordTable = goqu.T("compose_record")
+	composeRecordRevisionTable = goqu.T("compose_record_revision")
 
-	// composeRecordSelectQuery assembles select query for fetching composeRecords
+	// composeRecordRevisionSelectQuery assembles select query for fetching composeRecordRevisions
 	//
 	// This function is auto-generated
-	composeRecordSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+	composeRecordRevisionSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
 		return d.Select(
 			"id",
-			"module_id",
-			"rel_namespace",
-			"owned_by",
+			"rel_record",
+			"rel_module",
+			"revision",
+			"operation",
+			"rel_user",
+			"rel_session",
+			"values",
+			"changes",
 			"created_at",
-			"updated_at",
-			"deleted_at",
-			"created_by",
-			"updated_by",
-			"deleted_by",
-		).From(composeRecordTable)
+		).From(composeRecordRevisionTable)
 	}
 
-	// composeRecordInsertQuery assembles query inserting composeRecords
+	// composeRecordRevisionInsertQuery assembles query inserting composeRecordRevisions
 	//
 	// This function is auto-generated
-	composeRecordInsertQuery = func(d goqu.DialectWrapper, res *composeType.Record) *goqu.InsertDataset {
-		return d.Insert(composeRecordTable).
+	composeRecordRevisionInsertQuery = func(d goqu.DialectWrapper, res *composeType.RecordRevision) *goqu.InsertDataset {
+		return d.Insert(composeRecordRevisionTable).
 			Rows(goqu.Record{
-				"id":            res.ID,
-				"module_id":     res.ModuleID,
-				"rel_namespace": res.NamespaceID,
-				"owned_by":      res.OwnedBy,
-				"created_at":    res.CreatedAt,
-				"updated_at":    res.UpdatedAt,
-				"deleted_at":    res.DeletedAt,
-				"created_by":    res.CreatedBy,
-				"updated_by":    res.UpdatedBy,
-				"deleted_by":    res.DeletedBy,
+				"id":          res.ID,
+				"rel_record":  res.RecordID,
+				"rel_module":  res.ModuleID,
+				"revision":    res.Revision,
+				"operation":   res.Operation,
+				"rel_user":    res.UserID,
+				"rel_session": res.SessionID,
+				"values":      res.Values,
+				"changes":     res.Changes,
+				"created_at":  res.CreatedAt,
 			})
 	}
 
-	// composeRecordUpsertQuery assembles (insert+on-conflict) query for replacing composeRecords
+	// composeRecordRevisionUpsertQuery assembles (insert+on-conflict) query for replacing composeRecordRevisions
 	//
 	// This function is auto-generated
-	composeRecordUpsertQuery = func(d goqu.DialectWrapper, res *composeType.Record) *goqu.InsertDataset {
+	composeRecordRevisionUpsertQuery = func(d goqu.DialectWrapper, res *composeType.RecordRevision) *goqu.InsertDataset {
 		var target = `,id`
 
-		return composeRecordInsertQuery(d, res).
+		return composeRecordRevisionInsertQuery(d, res).
 			OnConflict(
 				goqu.DoUpdate(target[1:],
 					goqu.Record{
-						"module_id":     res.ModuleID,
-						"rel_namespace": res.NamespaceID,
-						"owned_by":      res.OwnedBy,
-						"created_at":    res.CreatedAt,
-						"updated_at":    res.UpdatedAt,
-						"deleted_at":    res.DeletedAt,
-						"created_by":    res.CreatedBy,
-						"updated_by":    res.UpdatedBy,
-						"deleted_by":    res.DeletedBy,
+						"rel_record":  res.RecordID,
+						"rel_module":  res.ModuleID,
+						"revision":    res.Revision,
+						"operation":   res.Operation,
+						"rel_user":    res.UserID,
+						"rel_session": res.SessionID,
+						"values":      res.Values,
+						"changes":     res.Changes,
+						"created_at":  res.CreatedAt,
 					},
 				),
 			)
 	}
 
-	// composeRecordUpdateQuery assembles query for updating composeRecords
+	// composeRecordRevisionUpdateQuery assembles query for updating composeRecordRevisions
 	//
 	// This function is auto-generated
-	composeRecordUpdateQuery = func(d goqu.DialectWrapper, res *composeType.Record) *goqu.UpdateDataset {
-		return d.Update(composeRecordTable).
+	composeRecordRevisionUpdateQuery = func(d goqu.DialectWrapper, res *composeType.RecordRevision) *goqu.UpdateDataset {
+		return d.Update(composeRecordRevisionTable).
 			Set(goqu.Record{
-				"module_id":     res.ModuleID,
-				"rel_namespace": res.NamespaceID,
-				"owned_by":      res.OwnedBy,
-				"created_at":    res.CreatedAt,
-				"updated_at":    res.UpdatedAt,
-				"deleted_at":    res.DeletedAt,
-				"created_by":    res.CreatedBy,
-				"updated_by":    res.UpdatedBy,
-				"deleted_by":    res.DeletedBy,
+				"rel_record":  res.RecordID,
+				"rel_module":  res.ModuleID,
+				"revision":    res.Revision,
+				"operation":   res.Operation,
+				"rel_user":    res.UserID,
+				"rel_session": res.SessionID,
+				"values":      res.Values,
+				"changes":     res.Changes,
+				"created_at":  res.CreatedAt,
 			}).
-			Where(composeRecordPrimaryKeys(res))
+			Where(composeRecordRevisionPrimaryKeys(res))
 	}
 
-	// composeRecordDeleteQuery assembles delete query for removing composeRecords
+	// composeRecordRevisionDeleteQuery assembles delete query for removing composeRecordRevisions
 	//
 	// This function is auto-generated
-	composeRecordDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
-		return d.Delete(composeRecordTable).Where(ee...)
+	composeRecordRevisionDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(composeRecordRevisionTable).Where(ee...)
 	}
 
-	// composeRecordDeleteQuery assembles delete query for removing composeRecords
+	// composeRecordRevisionDeleteQuery assembles delete query for removing composeRecordRevisions
 	//
 	// This function is auto-generated
-	composeRecordTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
-		return d.Truncate(composeRecordTable)
+	composeRecordRevisionTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(composeRecordRevisionTable)
 	}
 
-	// composeRecordPrimaryKeys assembles set of conditions for all primary keys
+	// composeRecordRevisionPrimaryKeys assembles set of conditions for all primary keys
 	//
 	// This function is auto-generated
-	composeRecordPrimaryKeys = func(res *composeType.Record) goqu.Ex {
+	composeRecordRevisionPrimaryKeys = func(res *composeType.RecordRevision) goqu.Ex {
 		return goqu.Ex{
 			"id": res.ID,
 		}
@@ -3254,6 +4357,114 @@ var (
 		}
 	}
 
+	// holidayCalendarTable represents holidayCalendars store table
+	//
+	// This value is auto-generated
+	holidayCalendarTable = goqu.T("holiday_calendars")
+
+	// holidayCalendarSelectQuery assembles select query for fetching holidayCalendars
+	//
+	// This function is auto-generated
+	holidayCalendarSelectQuery = func(d goqu.DialectWrapper) *goqu.SelectDataset {
+		return d.Select(
+			"id",
+			"handle",
+			"meta",
+			"holidays",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+			"created_by",
+			"updated_by",
+			"deleted_by",
+		).From(holidayCalendarTable)
+	}
+
+	// holidayCalendarInsertQuery assembles query inserting holidayCalendars
+	//
+	// This function is auto-generated
+	holidayCalendarInsertQuery = func(d goqu.DialectWrapper, res *systemType.HolidayCalendar) *goqu.InsertDataset {
+		return d.Insert(holidayCalendarTable).
+			Rows(goqu.Record{
+				"id":         res.ID,
+				"handle":     res.Handle,
+				"meta":       res.Meta,
+				"holidays":   res.Holidays,
+				"created_at": res.CreatedAt,
+				"updated_at": res.UpdatedAt,
+				"deleted_at": res.DeletedAt,
+				"created_by": res.CreatedBy,
+				"updated_by": res.UpdatedBy,
+				"deleted_by": res.DeletedBy,
+			})
+	}
+
+	// holidayCalendarUpsertQuery assembles (insert+on-conflict) query for replacing holidayCalendars
+	//
+	// This function is auto-generated
+	holidayCalendarUpsertQuery = func(d goqu.DialectWrapper, res *systemType.HolidayCalendar) *goqu.InsertDataset {
+		var target = `,id`
+
+		return holidayCalendarInsertQuery(d, res).
+			OnConflict(
+				goqu.DoUpdate(target[1:],
+					goqu.Record{
+						"handle":     res.Handle,
+						"meta":       res.Meta,
+						"holidays":   res.Holidays,
+						"created_at": res.CreatedAt,
+						"updated_at": res.UpdatedAt,
+						"deleted_at": res.DeletedAt,
+						"created_by": res.CreatedBy,
+						"updated_by": res.UpdatedBy,
+						"deleted_by": res.DeletedBy,
+					},
+				),
+			)
+	}
+
+	// holidayCalendarUpdateQuery assembles query for updating holidayCalendars
+	//
+	// This function is auto-generated
+	holidayCalendarUpdateQuery = func(d goqu.DialectWrapper, res *systemType.HolidayCalendar) *goqu.UpdateDataset {
+		return d.Update(holidayCalendarTable).
+			Set(goqu.Record{
+				"handle":     res.Handle,
+				"meta":       res.Meta,
+				"holidays":   res.Holidays,
+				"created_at": res.CreatedAt,
+				"updated_at": res.UpdatedAt,
+				"deleted_at": res.DeletedAt,
+				"created_by": res.CreatedBy,
+				"updated_by": res.UpdatedBy,
+				"deleted_by": res.DeletedBy,
+			}).
+			Where(holidayCalendarPrimaryKeys(res))
+	}
+
+	// holidayCalendarDeleteQuery assembles delete query for removing holidayCalendars
+	//
+	// This function is auto-generated
+	holidayCalendarDeleteQuery = func(d goqu.DialectWrapper, ee ...goqu.Expression) *goqu.DeleteDataset {
+		return d.Delete(holidayCalendarTable).Where(ee...)
+	}
+
+	// holidayCalendarDeleteQuery assembles delete query for removing holidayCalendars
+	//
+	// This function is auto-generated
+	holidayCalendarTruncateQuery = func(d goqu.DialectWrapper) *goqu.TruncateDataset {
+		return d.Truncate(holidayCalendarTable)
+	}
+
+	// holidayCalendarPrimaryKeys assembles set of conditions for all primary keys
+	//
+	// This function is auto-generated
+	holidayCalendarPrimaryKeys = func(res *systemType.HolidayCalendar) goqu.Ex {
+		return goqu.Ex{
+			"id": res.ID,
+		}
+	}
+
 	// labelTable represents labels store table
 	//
 	// This value is auto-generated
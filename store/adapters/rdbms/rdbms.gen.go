@@ -29,48 +29,59 @@ import (
 )
 
 var (
-	_ store.Actionlogs               = &Store{}
-	_ store.ApigwFilters             = &Store{}
-	_ store.ApigwRoutes              = &Store{}
-	_ store.Applications             = &Store{}
-	_ store.Attachments              = &Store{}
-	_ store.AuthClients              = &Store{}
-	_ store.AuthConfirmedClients     = &Store{}
-	_ store.AuthOa2tokens            = &Store{}
-	_ store.AuthSessions             = &Store{}
-	_ store.AutomationSessions       = &Store{}
-	_ store.AutomationTriggers       = &Store{}
-	_ store.AutomationWorkflows      = &Store{}
-	_ store.ComposeAttachments       = &Store{}
-	_ store.ComposeCharts            = &Store{}
-	_ store.ComposeModules           = &Store{}
-	_ store.ComposeModuleFields      = &Store{}
-	_ store.ComposeNamespaces        = &Store{}
-	_ store.ComposePages             = &Store{}
-	_ store.ComposeRecords           = &Store{}
-	_ store.ComposeRecordValues      = &Store{}
-	_ store.Credentials              = &Store{}
-	_ store.DalConnections           = &Store{}
-	_ store.DalSensitivityLevels     = &Store{}
-	_ store.FederationExposedModules = &Store{}
-	_ store.FederationModuleMappings = &Store{}
-	_ store.FederationNodes          = &Store{}
-	_ store.FederationNodeSyncs      = &Store{}
-	_ store.FederationSharedModules  = &Store{}
-	_ store.Flags                    = &Store{}
-	_ store.Labels                   = &Store{}
-	_ store.Queues                   = &Store{}
-	_ store.QueueMessages            = &Store{}
-	_ store.RbacRules                = &Store{}
-	_ store.Reminders                = &Store{}
-	_ store.Reports                  = &Store{}
-	_ store.ResourceActivitys        = &Store{}
-	_ store.ResourceTranslations     = &Store{}
-	_ store.Roles                    = &Store{}
-	_ store.RoleMembers              = &Store{}
-	_ store.SettingValues            = &Store{}
-	_ store.Templates                = &Store{}
-	_ store.Users                    = &Store{}
+	_ store.Actionlogs                  = &Store{}
+	_ store.ApigwFilters                = &Store{}
+	_ store.ApigwProfilerRecords        = &Store{}
+	_ store.ApigwRateLimits             = &Store{}
+	_ store.ApigwRoutes                 = &Store{}
+	_ store.Applications                = &Store{}
+	_ store.Attachments                 = &Store{}
+	_ store.AuthClients                 = &Store{}
+	_ store.AuthConfirmedClients        = &Store{}
+	_ store.AuthOa2tokens               = &Store{}
+	_ store.AuthSessions                = &Store{}
+	_ store.AutomationLeases            = &Store{}
+	_ store.AutomationSessions          = &Store{}
+	_ store.AutomationSessionResumes    = &Store{}
+	_ store.AutomationTasks             = &Store{}
+	_ store.AutomationTriggers          = &Store{}
+	_ store.AutomationWorkflows         = &Store{}
+	_ store.AutomationWorkflowRevisions = &Store{}
+	_ store.AutomationWorkflowTestCases = &Store{}
+	_ store.ComposeAttachments          = &Store{}
+	_ store.ComposeCharts               = &Store{}
+	_ store.ComposeModules              = &Store{}
+	_ store.ComposeModuleFields         = &Store{}
+	_ store.ComposeNamespaces           = &Store{}
+	_ store.ComposePages                = &Store{}
+	_ store.ComposeRecords              = &Store{}
+	_ store.ComposeRecordImportErrors   = &Store{}
+	_ store.ComposeRecordImportSessions = &Store{}
+	_ store.ComposeRecordRevisions      = &Store{}
+	_ store.ComposeRecordValues         = &Store{}
+	_ store.Credentials                 = &Store{}
+	_ store.DalConnections              = &Store{}
+	_ store.DalSensitivityLevels        = &Store{}
+	_ store.FederationExposedModules    = &Store{}
+	_ store.FederationModuleMappings    = &Store{}
+	_ store.FederationNodes             = &Store{}
+	_ store.FederationNodeSyncs         = &Store{}
+	_ store.FederationSharedModules     = &Store{}
+	_ store.Flags                       = &Store{}
+	_ store.HolidayCalendars            = &Store{}
+	_ store.Labels                      = &Store{}
+	_ store.Queues                      = &Store{}
+	_ store.QueueMessages               = &Store{}
+	_ store.RbacRules                   = &Store{}
+	_ store.Reminders                   = &Store{}
+	_ store.Reports                     = &Store{}
+	_ store.ResourceActivitys           = &Store{}
+	_ store.ResourceTranslations        = &Store{}
+	_ store.Roles                       = &Store{}
+	_ store.RoleMembers                 = &Store{}
+	_ store.SettingValues               = &Store{}
+	_ store.Templates                   = &Store{}
+	_ store.Users                       = &Store{}
 )
 
 // CreateActionlog creates one or more rows in actionlog collection
@@ -886,16 +897,16 @@ func (s *Store) checkApigwFilterConstraints(ctx context.Context, res *systemType
 	return nil
 }
 
-// CreateApigwRoute creates one or more rows in apigwRoute collection
+// CreateApigwProfilerRecord creates one or more rows in apigwProfilerRecord collection
 //
 // This function is auto-generated
-func (s *Store) CreateApigwRoute(ctx context.Context, rr ...*systemType.ApigwRoute) (err error) {
+func (s *Store) CreateApigwProfilerRecord(ctx context.Context, rr ...*systemType.ApigwProfilerRecord) (err error) {
 	for i := range rr {
-		if err = s.checkApigwRouteConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkApigwProfilerRecordConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, apigwRouteInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, apigwProfilerRecordInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -903,16 +914,16 @@ func (s *Store) CreateApigwRoute(ctx context.Context, rr ...*systemType.ApigwRou
 	return
 }
 
-// UpdateApigwRoute updates one or more existing entries in apigwRoute collection
+// UpdateApigwProfilerRecord updates one or more existing entries in apigwProfilerRecord collection
 //
 // This function is auto-generated
-func (s *Store) UpdateApigwRoute(ctx context.Context, rr ...*systemType.ApigwRoute) (err error) {
+func (s *Store) UpdateApigwProfilerRecord(ctx context.Context, rr ...*systemType.ApigwProfilerRecord) (err error) {
 	for i := range rr {
-		if err = s.checkApigwRouteConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkApigwProfilerRecordConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, apigwRouteUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, apigwProfilerRecordUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -920,16 +931,16 @@ func (s *Store) UpdateApigwRoute(ctx context.Context, rr ...*systemType.ApigwRou
 	return
 }
 
-// UpsertApigwRoute updates one or more existing entries in apigwRoute collection
+// UpsertApigwProfilerRecord updates one or more existing entries in apigwProfilerRecord collection
 //
 // This function is auto-generated
-func (s *Store) UpsertApigwRoute(ctx context.Context, rr ...*systemType.ApigwRoute) (err error) {
+func (s *Store) UpsertApigwProfilerRecord(ctx context.Context, rr ...*systemType.ApigwProfilerRecord) (err error) {
 	for i := range rr {
-		if err = s.checkApigwRouteConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkApigwProfilerRecordConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, apigwRouteUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, apigwProfilerRecordUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -937,12 +948,12 @@ func (s *Store) UpsertApigwRoute(ctx context.Context, rr ...*systemType.ApigwRou
 	return
 }
 
-// DeleteApigwRoute Deletes one or more entries from apigwRoute collection
+// DeleteApigwProfilerRecord Deletes one or more entries from apigwProfilerRecord collection
 //
 // This function is auto-generated
-func (s *Store) DeleteApigwRoute(ctx context.Context, rr ...*systemType.ApigwRoute) (err error) {
+func (s *Store) DeleteApigwProfilerRecord(ctx context.Context, rr ...*systemType.ApigwProfilerRecord) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, apigwRouteDeleteQuery(s.Dialect, apigwRoutePrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, apigwProfilerRecordDeleteQuery(s.Dialect, apigwProfilerRecordPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -950,60 +961,26 @@ func (s *Store) DeleteApigwRoute(ctx context.Context, rr ...*systemType.ApigwRou
 	return nil
 }
 
-// DeleteApigwRouteByID deletes single entry from apigwRoute collection
+// DeleteApigwProfilerRecordByID deletes single entry from apigwProfilerRecord collection
 //
 // This function is auto-generated
-func (s *Store) DeleteApigwRouteByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, apigwRouteDeleteQuery(s.Dialect, goqu.Ex{
+func (s *Store) DeleteApigwProfilerRecordByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, apigwProfilerRecordDeleteQuery(s.Dialect, goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateApigwRoutes Deletes all rows from the apigwRoute collection
-func (s Store) TruncateApigwRoutes(ctx context.Context) error {
-	return s.Exec(ctx, apigwRouteTruncateQuery(s.Dialect))
+// TruncateApigwProfilerRecords Deletes all rows from the apigwProfilerRecord collection
+func (s Store) TruncateApigwProfilerRecords(ctx context.Context) error {
+	return s.Exec(ctx, apigwProfilerRecordTruncateQuery(s.Dialect))
 }
 
-// SearchApigwRoutes returns (filtered) set of ApigwRoutes
+// SearchApigwProfilerRecords returns (filtered) set of ApigwProfilerRecords
 //
 // This function is auto-generated
-func (s *Store) SearchApigwRoutes(ctx context.Context, f systemType.ApigwRouteFilter) (set systemType.ApigwRouteSet, _ systemType.ApigwRouteFilter, err error) {
-
-	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
-	f.PrevPage, f.NextPage = nil, nil
-
-	if f.PageCursor != nil {
-		// Page cursor exists; we need to validate it against used sort
-		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
-		// from the cursor.
-		// This (extracted sorting info) is then returned as part of response
-		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
-			return
-		}
-	}
-
-	// Make sure results are always sorted at least by primary keys
-	if f.Sort.Get("id") == nil {
-		f.Sort = append(f.Sort, &filter.SortExpr{
-			Column:     "id",
-			Descending: f.Sort.LastDescending(),
-		})
-	}
-
-	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfApigwRoutes fn used for cursor creation;
-	// direction information it MUST keep the initial
-	sort := f.Sort.Clone()
-
-	// When cursor for a previous page is used it's marked as reversed
-	// This tells us to flip the descending flag on all used sort keys
-	if f.PageCursor != nil && f.PageCursor.ROrder {
-		sort.Reverse()
-	}
-
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfApigwRoutes(ctx, f, sort)
+func (s *Store) SearchApigwProfilerRecords(ctx context.Context, f systemType.ApigwProfilerRecordFilter) (set systemType.ApigwProfilerRecordSet, _ systemType.ApigwProfilerRecordFilter, err error) {
 
-	f.PageCursor = nil
+	set, _, err = s.QueryApigwProfilerRecords(ctx, f)
 	if err != nil {
 		return nil, f, err
 	}
@@ -1011,149 +988,20 @@ func (s *Store) SearchApigwRoutes(ctx context.Context, f systemType.ApigwRouteFi
 	return set, f, nil
 }
 
-// fetchFullPageOfApigwRoutes collects all requested results.
-//
-// Function applies:
-//  - cursor conditions (where ...)
-//  - limit
-//
-// Main responsibility of this function is to perform additional sequential queries in case when not enough results
-// are collected due to failed check on a specific row (by check fn).
-//
-// Function then moves cursor to the last item fetched
-//
-// This function is auto-generated
-func (s *Store) fetchFullPageOfApigwRoutes(
-	ctx context.Context,
-	filter systemType.ApigwRouteFilter,
-	sort filter.SortExprSet,
-) (set []*systemType.ApigwRoute, prev, next *filter.PagingCursor, err error) {
-	var (
-		aux []*systemType.ApigwRoute
-
-		// When cursor for a previous page is used it's marked as reversed
-		// This tells us to flip the descending flag on all used sort keys
-		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
-
-		// Copy no. of required items to limit
-		// Limit will change when doing subsequent queries to fill
-		// the set with all required items
-		limit = filter.Limit
-
-		reqItems = filter.Limit
-
-		// cursor to prev. page is only calculated when cursor is used
-		hasPrev = filter.PageCursor != nil
-
-		// next cursor is calculated when there are more pages to come
-		hasNext bool
-
-		tryFilter systemType.ApigwRouteFilter
-	)
-
-	set = make([]*systemType.ApigwRoute, 0, DefaultSliceCapacity)
-
-	for try := 0; try < MaxRefetches; try++ {
-		// Copy filter & apply custom sorting that might be affected by cursor
-		tryFilter = filter
-		tryFilter.Sort = sort
-
-		if limit > 0 {
-			// fetching + 1 to peak ahead if there are more items
-			// we can fetch (next-page cursor)
-			tryFilter.Limit = limit + 1
-		}
-
-		if aux, hasNext, err = s.QueryApigwRoutes(ctx, tryFilter); err != nil {
-			return nil, nil, nil, err
-		}
-
-		if len(aux) == 0 {
-			// nothing fetched
-			break
-		}
-
-		// append fetched items
-		set = append(set, aux...)
-
-		if reqItems == 0 || !hasNext {
-			// no max requested items specified, break out
-			break
-		}
-
-		collected := uint(len(set))
-
-		if reqItems > collected {
-			// not enough items fetched, try again with adjusted limit
-			limit = reqItems - collected
-
-			if limit < MinEnsureFetchLimit {
-				// In case limit is set very low and we've missed records in the first fetch,
-				// make sure next fetch limit is a bit higher
-				limit = MinEnsureFetchLimit
-			}
-
-			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectApigwRouteCursorValues(set[collected-1], filter.Sort...)
-
-			// Copy reverse flag from sorting
-			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
-			continue
-		}
-
-		if reqItems < collected {
-			set = set[:reqItems]
-		}
-
-		break
-	}
-
-	collected := len(set)
-
-	if collected == 0 {
-		return nil, nil, nil, nil
-	}
-
-	if reversedOrder {
-		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
-		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
-			set[i], set[j] = set[j], set[i]
-		}
-
-		// when in reverse-order rules on what cursor to return change
-		hasPrev, hasNext = hasNext, hasPrev
-	}
-
-	if hasPrev {
-		prev = s.collectApigwRouteCursorValues(set[0], filter.Sort...)
-		prev.ROrder = true
-		prev.LThen = !filter.Sort.Reversed()
-	}
-
-	if hasNext {
-		next = s.collectApigwRouteCursorValues(set[collected-1], filter.Sort...)
-		next.LThen = filter.Sort.Reversed()
-	}
-
-	return set, prev, next, nil
-}
-
-// QueryApigwRoutes queries the database, converts and checks each row and returns collected set
+// QueryApigwProfilerRecords queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryApigwRoutes(
+func (s *Store) QueryApigwProfilerRecords(
 	ctx context.Context,
-	f systemType.ApigwRouteFilter,
-) (_ []*systemType.ApigwRoute, more bool, err error) {
+	f systemType.ApigwProfilerRecordFilter,
+) (_ []*systemType.ApigwProfilerRecord, more bool, err error) {
 	var (
-		ok bool
-
-		set         = make([]*systemType.ApigwRoute, 0, DefaultSliceCapacity)
-		res         *systemType.ApigwRoute
-		aux         *auxApigwRoute
+		set         = make([]*systemType.ApigwProfilerRecord, 0, DefaultSliceCapacity)
+		res         *systemType.ApigwProfilerRecord
+		aux         *auxApigwProfilerRecord
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -1161,35 +1009,26 @@ func (s *Store) QueryApigwRoutes(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.ApigwRoute != nil {
+	if s.Filters.ApigwProfilerRecord != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.ApigwRoute(s, f)
+		tExpr, f, err = s.Filters.ApigwProfilerRecord(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = ApigwRouteFilter(f)
+		tExpr, f, err = ApigwProfilerRecordFilter(f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for ApigwRoute: %w", err)
+		err = fmt.Errorf("could generate filter expression for ApigwProfilerRecord: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	// paging feature is enabled
-	if f.PageCursor != nil {
-		if tExpr, err = cursor(f.PageCursor); err != nil {
-			return
-		} else {
-			expr = append(expr, tExpr...)
-		}
-	}
-
-	query := apigwRouteSelectQuery(s.Dialect).Where(expr...)
+	query := apigwProfilerRecordSelectQuery(s.Dialect).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableApigwRouteFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for ApigwRoute: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableApigwProfilerRecordFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for ApigwProfilerRecord: %w", err)
 		return
 	}
 
@@ -1203,12 +1042,12 @@ func (s *Store) QueryApigwRoutes(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query ApigwRoute: %w", err)
+		err = fmt.Errorf("could not query ApigwProfilerRecord: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query ApigwRoute: %w", err)
+		err = fmt.Errorf("could not query ApigwProfilerRecord: %w", err)
 		return
 	}
 
@@ -1222,49 +1061,37 @@ func (s *Store) QueryApigwRoutes(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query ApigwRoute: %w", err)
+			err = fmt.Errorf("could not query ApigwProfilerRecord: %w", err)
 			return
 		}
 
-		aux = new(auxApigwRoute)
+		aux = new(auxApigwProfilerRecord)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for ApigwRoute: %w", err)
+			err = fmt.Errorf("could not scan rows for ApigwProfilerRecord: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode ApigwRoute: %w", err)
+			err = fmt.Errorf("could not decode ApigwProfilerRecord: %w", err)
 			return
 		}
 
-		// check fn set, call it and see if it passed the test
-		// if not, skip the item
-		if f.Check != nil {
-			if ok, err = f.Check(res); err != nil {
-				return
-			} else if !ok {
-				continue
-			}
-		}
-
 		set = append(set, res)
 	}
 
-	return set, f.Limit > 0 && count >= f.Limit, err
+	return set, false, err
 
 }
 
-// LookupApigwRouteByID searches for route by ID
-//
-// It returns route even if deleted or suspended
+// LookupApigwProfilerRecordByID searches for profiler record by ID
 //
 // This function is auto-generated
-func (s *Store) LookupApigwRouteByID(ctx context.Context, id uint64) (_ *systemType.ApigwRoute, err error) {
+func (s *Store) LookupApigwProfilerRecordByID(ctx context.Context, id uint64) (_ *systemType.ApigwProfilerRecord, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxApigwRoute)
-		lookup = apigwRouteSelectQuery(s.Dialect).Where(
+		aux    = new(auxApigwProfilerRecord)
+		lookup = apigwProfilerRecordSelectQuery(s.Dialect).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -1297,66 +1124,20 @@ func (s *Store) LookupApigwRouteByID(ctx context.Context, id uint64) (_ *systemT
 	return aux.decode()
 }
 
-// LookupApigwRouteByEndpoint searches for route by endpoint
+// sortableApigwProfilerRecordFields returns all <no value> columns flagged as sortable
 //
-// It returns route even if deleted or suspended
+// With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (s *Store) LookupApigwRouteByEndpoint(ctx context.Context, endpoint string) (_ *systemType.ApigwRoute, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxApigwRoute)
-		lookup = apigwRouteSelectQuery(s.Dialect).Where(
-			goqu.I("endpoint").Eq(endpoint),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
+func (Store) sortableApigwProfilerRecordFields() map[string]string {
+	return map[string]string{
+		"id":         "id",
+		"started_at": "started_at",
+		"startedat":  "started_at",
 	}
+}
 
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
-	}
-
-	return aux.decode()
-}
-
-// sortableApigwRouteFields returns all <no value> columns flagged as sortable
-//
-// With optional string arg, all columns are returned aliased
-//
-// This function is auto-generated
-func (Store) sortableApigwRouteFields() map[string]string {
-	return map[string]string{
-		"created_at": "created_at",
-		"createdat":  "created_at",
-		"deleted_at": "deleted_at",
-		"deletedat":  "deleted_at",
-		"id":         "id",
-		"updated_at": "updated_at",
-		"updatedat":  "updated_at",
-	}
-}
-
-// collectApigwRouteCursorValues collects values from the given resource that and sets them to the cursor
+// collectApigwProfilerRecordCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -1367,7 +1148,7 @@ func (Store) sortableApigwRouteFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectApigwRouteCursorValues(res *systemType.ApigwRoute, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectApigwProfilerRecordCursorValues(res *systemType.ApigwProfilerRecord, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -1381,12 +1162,8 @@ func (s *Store) collectApigwRouteCursorValues(res *systemType.ApigwRoute, cc ...
 				case "id":
 					cur.Set(c.Column, res.ID, c.Descending)
 					pkID = true
-				case "createdAt":
-					cur.Set(c.Column, res.CreatedAt, c.Descending)
-				case "updatedAt":
-					cur.Set(c.Column, res.UpdatedAt, c.Descending)
-				case "deletedAt":
-					cur.Set(c.Column, res.DeletedAt, c.Descending)
+				case "startedAt":
+					cur.Set(c.Column, res.StartedAt, c.Descending)
 				}
 			}
 		}
@@ -1401,27 +1178,27 @@ func (s *Store) collectApigwRouteCursorValues(res *systemType.ApigwRoute, cc ...
 
 }
 
-// checkApigwRouteConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkApigwProfilerRecordConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkApigwRouteConstraints(ctx context.Context, res *systemType.ApigwRoute) (err error) {
+func (s *Store) checkApigwProfilerRecordConstraints(ctx context.Context, res *systemType.ApigwProfilerRecord) (err error) {
 	return nil
 }
 
-// CreateApplication creates one or more rows in application collection
+// CreateApigwRateLimit creates one or more rows in apigwRateLimit collection
 //
 // This function is auto-generated
-func (s *Store) CreateApplication(ctx context.Context, rr ...*systemType.Application) (err error) {
+func (s *Store) CreateApigwRateLimit(ctx context.Context, rr ...*systemType.ApigwRateLimit) (err error) {
 	for i := range rr {
-		if err = s.checkApplicationConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkApigwRateLimitConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, applicationInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, apigwRateLimitInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -1429,16 +1206,16 @@ func (s *Store) CreateApplication(ctx context.Context, rr ...*systemType.Applica
 	return
 }
 
-// UpdateApplication updates one or more existing entries in application collection
+// UpdateApigwRateLimit updates one or more existing entries in apigwRateLimit collection
 //
 // This function is auto-generated
-func (s *Store) UpdateApplication(ctx context.Context, rr ...*systemType.Application) (err error) {
+func (s *Store) UpdateApigwRateLimit(ctx context.Context, rr ...*systemType.ApigwRateLimit) (err error) {
 	for i := range rr {
-		if err = s.checkApplicationConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkApigwRateLimitConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, applicationUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, apigwRateLimitUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -1446,16 +1223,16 @@ func (s *Store) UpdateApplication(ctx context.Context, rr ...*systemType.Applica
 	return
 }
 
-// UpsertApplication updates one or more existing entries in application collection
+// UpsertApigwRateLimit updates one or more existing entries in apigwRateLimit collection
 //
 // This function is auto-generated
-func (s *Store) UpsertApplication(ctx context.Context, rr ...*systemType.Application) (err error) {
+func (s *Store) UpsertApigwRateLimit(ctx context.Context, rr ...*systemType.ApigwRateLimit) (err error) {
 	for i := range rr {
-		if err = s.checkApplicationConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkApigwRateLimitConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, applicationUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, apigwRateLimitUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -1463,12 +1240,12 @@ func (s *Store) UpsertApplication(ctx context.Context, rr ...*systemType.Applica
 	return
 }
 
-// DeleteApplication Deletes one or more entries from application collection
+// DeleteApigwRateLimit Deletes one or more entries from apigwRateLimit collection
 //
 // This function is auto-generated
-func (s *Store) DeleteApplication(ctx context.Context, rr ...*systemType.Application) (err error) {
+func (s *Store) DeleteApigwRateLimit(ctx context.Context, rr ...*systemType.ApigwRateLimit) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, applicationDeleteQuery(s.Dialect, applicationPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, apigwRateLimitDeleteQuery(s.Dialect, apigwRateLimitPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -1476,60 +1253,26 @@ func (s *Store) DeleteApplication(ctx context.Context, rr ...*systemType.Applica
 	return nil
 }
 
-// DeleteApplicationByID deletes single entry from application collection
+// DeleteApigwRateLimitByID deletes single entry from apigwRateLimit collection
 //
 // This function is auto-generated
-func (s *Store) DeleteApplicationByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, applicationDeleteQuery(s.Dialect, goqu.Ex{
-		"id": id,
+func (s *Store) DeleteApigwRateLimitByName(ctx context.Context, name string) error {
+	return s.Exec(ctx, apigwRateLimitDeleteQuery(s.Dialect, goqu.Ex{
+		"name": name,
 	}))
 }
 
-// TruncateApplications Deletes all rows from the application collection
-func (s Store) TruncateApplications(ctx context.Context) error {
-	return s.Exec(ctx, applicationTruncateQuery(s.Dialect))
+// TruncateApigwRateLimits Deletes all rows from the apigwRateLimit collection
+func (s Store) TruncateApigwRateLimits(ctx context.Context) error {
+	return s.Exec(ctx, apigwRateLimitTruncateQuery(s.Dialect))
 }
 
-// SearchApplications returns (filtered) set of Applications
+// SearchApigwRateLimits returns (filtered) set of ApigwRateLimits
 //
 // This function is auto-generated
-func (s *Store) SearchApplications(ctx context.Context, f systemType.ApplicationFilter) (set systemType.ApplicationSet, _ systemType.ApplicationFilter, err error) {
-
-	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
-	f.PrevPage, f.NextPage = nil, nil
-
-	if f.PageCursor != nil {
-		// Page cursor exists; we need to validate it against used sort
-		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
-		// from the cursor.
-		// This (extracted sorting info) is then returned as part of response
-		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
-			return
-		}
-	}
-
-	// Make sure results are always sorted at least by primary keys
-	if f.Sort.Get("id") == nil {
-		f.Sort = append(f.Sort, &filter.SortExpr{
-			Column:     "id",
-			Descending: f.Sort.LastDescending(),
-		})
-	}
-
-	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfApplications fn used for cursor creation;
-	// direction information it MUST keep the initial
-	sort := f.Sort.Clone()
+func (s *Store) SearchApigwRateLimits(ctx context.Context, f systemType.ApigwRateLimitFilter) (set systemType.ApigwRateLimitSet, _ systemType.ApigwRateLimitFilter, err error) {
 
-	// When cursor for a previous page is used it's marked as reversed
-	// This tells us to flip the descending flag on all used sort keys
-	if f.PageCursor != nil && f.PageCursor.ROrder {
-		sort.Reverse()
-	}
-
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfApplications(ctx, f, sort)
-
-	f.PageCursor = nil
+	set, _, err = s.QueryApigwRateLimits(ctx, f)
 	if err != nil {
 		return nil, f, err
 	}
@@ -1537,310 +1280,146 @@ func (s *Store) SearchApplications(ctx context.Context, f systemType.Application
 	return set, f, nil
 }
 
-// fetchFullPageOfApplications collects all requested results.
-//
-// Function applies:
-//  - cursor conditions (where ...)
-//  - limit
-//
-// Main responsibility of this function is to perform additional sequential queries in case when not enough results
-// are collected due to failed check on a specific row (by check fn).
+// QueryApigwRateLimits queries the database, converts and checks each row and returns collected set
 //
-// Function then moves cursor to the last item fetched
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfApplications(
+func (s *Store) QueryApigwRateLimits(
 	ctx context.Context,
-	filter systemType.ApplicationFilter,
-	sort filter.SortExprSet,
-) (set []*systemType.Application, prev, next *filter.PagingCursor, err error) {
+	f systemType.ApigwRateLimitFilter,
+) (_ []*systemType.ApigwRateLimit, more bool, err error) {
 	var (
-		aux []*systemType.Application
-
-		// When cursor for a previous page is used it's marked as reversed
-		// This tells us to flip the descending flag on all used sort keys
-		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+		set         = make([]*systemType.ApigwRateLimit, 0, DefaultSliceCapacity)
+		res         *systemType.ApigwRateLimit
+		aux         *auxApigwRateLimit
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+	)
 
-		// Copy no. of required items to limit
-		// Limit will change when doing subsequent queries to fill
-		// the set with all required items
-		limit = filter.Limit
+	if s.Filters.ApigwRateLimit != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.ApigwRateLimit(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = ApigwRateLimitFilter(f)
+	}
 
-		reqItems = filter.Limit
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for ApigwRateLimit: %w", err)
+		return
+	}
 
-		// cursor to prev. page is only calculated when cursor is used
-		hasPrev = filter.PageCursor != nil
+	expr = append(expr, tExpr...)
 
-		// next cursor is calculated when there are more pages to come
-		hasNext bool
+	query := apigwRateLimitSelectQuery(s.Dialect).Where(expr...)
 
-		tryFilter systemType.ApplicationFilter
-	)
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
 
-	set = make([]*systemType.Application, 0, DefaultSliceCapacity)
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query ApigwRateLimit: %w", err)
+		return
+	}
 
-	for try := 0; try < MaxRefetches; try++ {
-		// Copy filter & apply custom sorting that might be affected by cursor
-		tryFilter = filter
-		tryFilter.Sort = sort
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query ApigwRateLimit: %w", err)
+		return
+	}
 
-		if limit > 0 {
-			// fetching + 1 to peak ahead if there are more items
-			// we can fetch (next-page cursor)
-			tryFilter.Limit = limit + 1
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
 		}
+	}()
 
-		if aux, hasNext, err = s.QueryApplications(ctx, tryFilter); err != nil {
-			return nil, nil, nil, err
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query ApigwRateLimit: %w", err)
+			return
 		}
 
-		if len(aux) == 0 {
-			// nothing fetched
-			break
+		aux = new(auxApigwRateLimit)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for ApigwRateLimit: %w", err)
+			return
 		}
 
-		// append fetched items
-		set = append(set, aux...)
-
-		if reqItems == 0 || !hasNext {
-			// no max requested items specified, break out
-			break
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode ApigwRateLimit: %w", err)
+			return
 		}
 
-		collected := uint(len(set))
-
-		if reqItems > collected {
-			// not enough items fetched, try again with adjusted limit
-			limit = reqItems - collected
-
-			if limit < MinEnsureFetchLimit {
-				// In case limit is set very low and we've missed records in the first fetch,
-				// make sure next fetch limit is a bit higher
-				limit = MinEnsureFetchLimit
-			}
+		set = append(set, res)
+	}
 
-			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectApplicationCursorValues(set[collected-1], filter.Sort...)
+	return set, false, err
 
-			// Copy reverse flag from sorting
-			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
-			continue
-		}
+}
 
-		if reqItems < collected {
-			set = set[:reqItems]
-		}
+// LookupApigwRateLimitByName searches for rate limit counter by name
+//
+// It returns counter even if expired
+//
+// This function is auto-generated
+func (s *Store) LookupApigwRateLimitByName(ctx context.Context, name string) (_ *systemType.ApigwRateLimit, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxApigwRateLimit)
+		lookup = apigwRateLimitSelectQuery(s.Dialect).Where(
+			goqu.I("name").Eq(name),
+		).Limit(1)
+	)
 
-		break
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
 	}
 
-	collected := len(set)
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
 
-	if collected == 0 {
-		return nil, nil, nil, nil
+	if err = rows.Err(); err != nil {
+		return
 	}
 
-	if reversedOrder {
-		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
-		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
-			set[i], set[j] = set[j], set[i]
-		}
-
-		// when in reverse-order rules on what cursor to return change
-		hasPrev, hasNext = hasNext, hasPrev
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
 	}
 
-	if hasPrev {
-		prev = s.collectApplicationCursorValues(set[0], filter.Sort...)
-		prev.ROrder = true
-		prev.LThen = !filter.Sort.Reversed()
-	}
-
-	if hasNext {
-		next = s.collectApplicationCursorValues(set[collected-1], filter.Sort...)
-		next.LThen = filter.Sort.Reversed()
-	}
-
-	return set, prev, next, nil
-}
-
-// QueryApplications queries the database, converts and checks each row and returns collected set
-//
-// With generics, we can remove this per-resource-generated function
-// and replace it with a single utility fetcher
-//
-// This function is auto-generated
-func (s *Store) QueryApplications(
-	ctx context.Context,
-	f systemType.ApplicationFilter,
-) (_ []*systemType.Application, more bool, err error) {
-	var (
-		ok bool
-
-		set         = make([]*systemType.Application, 0, DefaultSliceCapacity)
-		res         *systemType.Application
-		aux         *auxApplication
-		rows        *sql.Rows
-		count       uint
-		expr, tExpr []goqu.Expression
-
-		sortExpr []exp.OrderedExpression
-	)
-
-	if s.Filters.Application != nil {
-		// extended filter set
-		tExpr, f, err = s.Filters.Application(s, f)
-	} else {
-		// using generated filter
-		tExpr, f, err = ApplicationFilter(f)
-	}
-
-	if err != nil {
-		err = fmt.Errorf("could generate filter expression for Application: %w", err)
-		return
-	}
-
-	expr = append(expr, tExpr...)
-
-	// paging feature is enabled
-	if f.PageCursor != nil {
-		if tExpr, err = cursor(f.PageCursor); err != nil {
-			return
-		} else {
-			expr = append(expr, tExpr...)
-		}
-	}
-
-	query := applicationSelectQuery(s.Dialect).Where(expr...)
-
-	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableApplicationFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for Application: %w", err)
-		return
-	}
-
-	if len(sortExpr) > 0 {
-		query = query.Order(sortExpr...)
-	}
-
-	if f.Limit > 0 {
-		query = query.Limit(f.Limit)
-	}
-
-	rows, err = s.Query(ctx, query)
-	if err != nil {
-		err = fmt.Errorf("could not query Application: %w", err)
-		return
-	}
-
-	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query Application: %w", err)
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	for rows.Next() {
-		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query Application: %w", err)
-			return
-		}
-
-		aux = new(auxApplication)
-		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for Application: %w", err)
-			return
-		}
-
-		count++
-		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode Application: %w", err)
-			return
-		}
-
-		// check fn set, call it and see if it passed the test
-		// if not, skip the item
-		if f.Check != nil {
-			if ok, err = f.Check(res); err != nil {
-				return
-			} else if !ok {
-				continue
-			}
-		}
-
-		set = append(set, res)
-	}
-
-	return set, f.Limit > 0 && count >= f.Limit, err
-
-}
-
-// LookupApplicationByID searches for role by ID
-//
-// It returns role even if deleted or suspended
-//
-// This function is auto-generated
-func (s *Store) LookupApplicationByID(ctx context.Context, id uint64) (_ *systemType.Application, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxApplication)
-		lookup = applicationSelectQuery(s.Dialect).Where(
-			goqu.I("id").Eq(id),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
+	if err = aux.scan(rows); err != nil {
+		return
 	}
 
 	return aux.decode()
 }
 
-// sortableApplicationFields returns all <no value> columns flagged as sortable
+// sortableApigwRateLimitFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableApplicationFields() map[string]string {
+func (Store) sortableApigwRateLimitFields() map[string]string {
 	return map[string]string{
-		"created_at": "created_at",
-		"createdat":  "created_at",
-		"deleted_at": "deleted_at",
-		"deletedat":  "deleted_at",
-		"id":         "id",
-		"updated_at": "updated_at",
-		"updatedat":  "updated_at",
+		"expires_at": "expires_at",
+		"expiresat":  "expires_at",
+		"name":       "name",
 	}
 }
 
-// collectApplicationCursorValues collects values from the given resource that and sets them to the cursor
+// collectApigwRateLimitCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -1851,61 +1430,57 @@ func (Store) sortableApplicationFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectApplicationCursorValues(res *systemType.Application, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectApigwRateLimitCursorValues(res *systemType.ApigwRateLimit, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
 		hasUnique bool
 
-		pkID bool
+		pkName bool
 
 		collect = func(cc ...*filter.SortExpr) {
 			for _, c := range cc {
 				switch c.Column {
-				case "id":
-					cur.Set(c.Column, res.ID, c.Descending)
-					pkID = true
-				case "createdAt":
-					cur.Set(c.Column, res.CreatedAt, c.Descending)
-				case "updatedAt":
-					cur.Set(c.Column, res.UpdatedAt, c.Descending)
-				case "deletedAt":
-					cur.Set(c.Column, res.DeletedAt, c.Descending)
+				case "name":
+					cur.Set(c.Column, res.Name, c.Descending)
+					pkName = true
+				case "expiresAt":
+					cur.Set(c.Column, res.ExpiresAt, c.Descending)
 				}
 			}
 		}
 	)
 
 	collect(cc...)
-	if !hasUnique || !pkID {
-		collect(&filter.SortExpr{Column: "id", Descending: false})
+	if !hasUnique || !pkName {
+		collect(&filter.SortExpr{Column: "name", Descending: false})
 	}
 
 	return cur
 
 }
 
-// checkApplicationConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkApigwRateLimitConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkApplicationConstraints(ctx context.Context, res *systemType.Application) (err error) {
+func (s *Store) checkApigwRateLimitConstraints(ctx context.Context, res *systemType.ApigwRateLimit) (err error) {
 	return nil
 }
 
-// CreateAttachment creates one or more rows in attachment collection
+// CreateApigwRoute creates one or more rows in apigwRoute collection
 //
 // This function is auto-generated
-func (s *Store) CreateAttachment(ctx context.Context, rr ...*systemType.Attachment) (err error) {
+func (s *Store) CreateApigwRoute(ctx context.Context, rr ...*systemType.ApigwRoute) (err error) {
 	for i := range rr {
-		if err = s.checkAttachmentConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkApigwRouteConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, attachmentInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, apigwRouteInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -1913,16 +1488,16 @@ func (s *Store) CreateAttachment(ctx context.Context, rr ...*systemType.Attachme
 	return
 }
 
-// UpdateAttachment updates one or more existing entries in attachment collection
+// UpdateApigwRoute updates one or more existing entries in apigwRoute collection
 //
 // This function is auto-generated
-func (s *Store) UpdateAttachment(ctx context.Context, rr ...*systemType.Attachment) (err error) {
+func (s *Store) UpdateApigwRoute(ctx context.Context, rr ...*systemType.ApigwRoute) (err error) {
 	for i := range rr {
-		if err = s.checkAttachmentConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkApigwRouteConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, attachmentUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, apigwRouteUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -1930,16 +1505,16 @@ func (s *Store) UpdateAttachment(ctx context.Context, rr ...*systemType.Attachme
 	return
 }
 
-// UpsertAttachment updates one or more existing entries in attachment collection
+// UpsertApigwRoute updates one or more existing entries in apigwRoute collection
 //
 // This function is auto-generated
-func (s *Store) UpsertAttachment(ctx context.Context, rr ...*systemType.Attachment) (err error) {
+func (s *Store) UpsertApigwRoute(ctx context.Context, rr ...*systemType.ApigwRoute) (err error) {
 	for i := range rr {
-		if err = s.checkAttachmentConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkApigwRouteConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, attachmentUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, apigwRouteUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -1947,12 +1522,12 @@ func (s *Store) UpsertAttachment(ctx context.Context, rr ...*systemType.Attachme
 	return
 }
 
-// DeleteAttachment Deletes one or more entries from attachment collection
+// DeleteApigwRoute Deletes one or more entries from apigwRoute collection
 //
 // This function is auto-generated
-func (s *Store) DeleteAttachment(ctx context.Context, rr ...*systemType.Attachment) (err error) {
+func (s *Store) DeleteApigwRoute(ctx context.Context, rr ...*systemType.ApigwRoute) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, attachmentDeleteQuery(s.Dialect, attachmentPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, apigwRouteDeleteQuery(s.Dialect, apigwRoutePrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -1960,24 +1535,24 @@ func (s *Store) DeleteAttachment(ctx context.Context, rr ...*systemType.Attachme
 	return nil
 }
 
-// DeleteAttachmentByID deletes single entry from attachment collection
+// DeleteApigwRouteByID deletes single entry from apigwRoute collection
 //
 // This function is auto-generated
-func (s *Store) DeleteAttachmentByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, attachmentDeleteQuery(s.Dialect, goqu.Ex{
+func (s *Store) DeleteApigwRouteByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, apigwRouteDeleteQuery(s.Dialect, goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateAttachments Deletes all rows from the attachment collection
-func (s Store) TruncateAttachments(ctx context.Context) error {
-	return s.Exec(ctx, attachmentTruncateQuery(s.Dialect))
+// TruncateApigwRoutes Deletes all rows from the apigwRoute collection
+func (s Store) TruncateApigwRoutes(ctx context.Context) error {
+	return s.Exec(ctx, apigwRouteTruncateQuery(s.Dialect))
 }
 
-// SearchAttachments returns (filtered) set of Attachments
+// SearchApigwRoutes returns (filtered) set of ApigwRoutes
 //
 // This function is auto-generated
-func (s *Store) SearchAttachments(ctx context.Context, f systemType.AttachmentFilter) (set systemType.AttachmentSet, _ systemType.AttachmentFilter, err error) {
+func (s *Store) SearchApigwRoutes(ctx context.Context, f systemType.ApigwRouteFilter) (set systemType.ApigwRouteSet, _ systemType.ApigwRouteFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -2001,7 +1576,7 @@ func (s *Store) SearchAttachments(ctx context.Context, f systemType.AttachmentFi
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfAttachments fn used for cursor creation;
+	// Original are passed to the etchFullPageOfApigwRoutes fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -2011,7 +1586,7 @@ func (s *Store) SearchAttachments(ctx context.Context, f systemType.AttachmentFi
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfAttachments(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfApigwRoutes(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -2021,7 +1596,7 @@ func (s *Store) SearchAttachments(ctx context.Context, f systemType.AttachmentFi
 	return set, f, nil
 }
 
-// fetchFullPageOfAttachments collects all requested results.
+// fetchFullPageOfApigwRoutes collects all requested results.
 //
 // Function applies:
 //  - cursor conditions (where ...)
@@ -2033,13 +1608,13 @@ func (s *Store) SearchAttachments(ctx context.Context, f systemType.AttachmentFi
 // Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfAttachments(
+func (s *Store) fetchFullPageOfApigwRoutes(
 	ctx context.Context,
-	filter systemType.AttachmentFilter,
+	filter systemType.ApigwRouteFilter,
 	sort filter.SortExprSet,
-) (set []*systemType.Attachment, prev, next *filter.PagingCursor, err error) {
+) (set []*systemType.ApigwRoute, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*systemType.Attachment
+		aux []*systemType.ApigwRoute
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -2058,10 +1633,10 @@ func (s *Store) fetchFullPageOfAttachments(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter systemType.AttachmentFilter
+		tryFilter systemType.ApigwRouteFilter
 	)
 
-	set = make([]*systemType.Attachment, 0, DefaultSliceCapacity)
+	set = make([]*systemType.ApigwRoute, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -2074,7 +1649,7 @@ func (s *Store) fetchFullPageOfAttachments(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryAttachments(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryApigwRoutes(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -2104,7 +1679,7 @@ func (s *Store) fetchFullPageOfAttachments(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectAttachmentCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectApigwRouteCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -2135,35 +1710,35 @@ func (s *Store) fetchFullPageOfAttachments(
 	}
 
 	if hasPrev {
-		prev = s.collectAttachmentCursorValues(set[0], filter.Sort...)
+		prev = s.collectApigwRouteCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectAttachmentCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectApigwRouteCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryAttachments queries the database, converts and checks each row and returns collected set
+// QueryApigwRoutes queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryAttachments(
+func (s *Store) QueryApigwRoutes(
 	ctx context.Context,
-	f systemType.AttachmentFilter,
-) (_ []*systemType.Attachment, more bool, err error) {
+	f systemType.ApigwRouteFilter,
+) (_ []*systemType.ApigwRoute, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*systemType.Attachment, 0, DefaultSliceCapacity)
-		res         *systemType.Attachment
-		aux         *auxAttachment
+		set         = make([]*systemType.ApigwRoute, 0, DefaultSliceCapacity)
+		res         *systemType.ApigwRoute
+		aux         *auxApigwRoute
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -2171,16 +1746,16 @@ func (s *Store) QueryAttachments(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.Attachment != nil {
+	if s.Filters.ApigwRoute != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.Attachment(s, f)
+		tExpr, f, err = s.Filters.ApigwRoute(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = AttachmentFilter(f)
+		tExpr, f, err = ApigwRouteFilter(f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for Attachment: %w", err)
+		err = fmt.Errorf("could generate filter expression for ApigwRoute: %w", err)
 		return
 	}
 
@@ -2195,11 +1770,11 @@ func (s *Store) QueryAttachments(
 		}
 	}
 
-	query := attachmentSelectQuery(s.Dialect).Where(expr...)
+	query := apigwRouteSelectQuery(s.Dialect).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableAttachmentFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for Attachment: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableApigwRouteFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for ApigwRoute: %w", err)
 		return
 	}
 
@@ -2213,12 +1788,12 @@ func (s *Store) QueryAttachments(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query Attachment: %w", err)
+		err = fmt.Errorf("could not query ApigwRoute: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query Attachment: %w", err)
+		err = fmt.Errorf("could not query ApigwRoute: %w", err)
 		return
 	}
 
@@ -2232,19 +1807,19 @@ func (s *Store) QueryAttachments(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query Attachment: %w", err)
+			err = fmt.Errorf("could not query ApigwRoute: %w", err)
 			return
 		}
 
-		aux = new(auxAttachment)
+		aux = new(auxApigwRoute)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for Attachment: %w", err)
+			err = fmt.Errorf("could not scan rows for ApigwRoute: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode Attachment: %w", err)
+			err = fmt.Errorf("could not decode ApigwRoute: %w", err)
 			return
 		}
 
@@ -2265,14 +1840,16 @@ func (s *Store) QueryAttachments(
 
 }
 
-// LookupAttachmentByID
+// LookupApigwRouteByID searches for route by ID
+//
+// It returns route even if deleted or suspended
 //
 // This function is auto-generated
-func (s *Store) LookupAttachmentByID(ctx context.Context, id uint64) (_ *systemType.Attachment, err error) {
+func (s *Store) LookupApigwRouteByID(ctx context.Context, id uint64) (_ *systemType.ApigwRoute, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxAttachment)
-		lookup = attachmentSelectQuery(s.Dialect).Where(
+		aux    = new(auxApigwRoute)
+		lookup = apigwRouteSelectQuery(s.Dialect).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -2305,12 +1882,54 @@ func (s *Store) LookupAttachmentByID(ctx context.Context, id uint64) (_ *systemT
 	return aux.decode()
 }
 
-// sortableAttachmentFields returns all <no value> columns flagged as sortable
+// LookupApigwRouteByEndpoint searches for route by endpoint
+//
+// It returns route even if deleted or suspended
+//
+// This function is auto-generated
+func (s *Store) LookupApigwRouteByEndpoint(ctx context.Context, endpoint string) (_ *systemType.ApigwRoute, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxApigwRoute)
+		lookup = apigwRouteSelectQuery(s.Dialect).Where(
+			goqu.I("endpoint").Eq(endpoint),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableApigwRouteFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableAttachmentFields() map[string]string {
+func (Store) sortableApigwRouteFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
@@ -2322,7 +1941,7 @@ func (Store) sortableAttachmentFields() map[string]string {
 	}
 }
 
-// collectAttachmentCursorValues collects values from the given resource that and sets them to the cursor
+// collectApigwRouteCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -2333,7 +1952,7 @@ func (Store) sortableAttachmentFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectAttachmentCursorValues(res *systemType.Attachment, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectApigwRouteCursorValues(res *systemType.ApigwRoute, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -2367,27 +1986,27 @@ func (s *Store) collectAttachmentCursorValues(res *systemType.Attachment, cc ...
 
 }
 
-// checkAttachmentConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkApigwRouteConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkAttachmentConstraints(ctx context.Context, res *systemType.Attachment) (err error) {
+func (s *Store) checkApigwRouteConstraints(ctx context.Context, res *systemType.ApigwRoute) (err error) {
 	return nil
 }
 
-// CreateAuthClient creates one or more rows in authClient collection
+// CreateApplication creates one or more rows in application collection
 //
 // This function is auto-generated
-func (s *Store) CreateAuthClient(ctx context.Context, rr ...*systemType.AuthClient) (err error) {
+func (s *Store) CreateApplication(ctx context.Context, rr ...*systemType.Application) (err error) {
 	for i := range rr {
-		if err = s.checkAuthClientConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkApplicationConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, authClientInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, applicationInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -2395,16 +2014,16 @@ func (s *Store) CreateAuthClient(ctx context.Context, rr ...*systemType.AuthClie
 	return
 }
 
-// UpdateAuthClient updates one or more existing entries in authClient collection
+// UpdateApplication updates one or more existing entries in application collection
 //
 // This function is auto-generated
-func (s *Store) UpdateAuthClient(ctx context.Context, rr ...*systemType.AuthClient) (err error) {
+func (s *Store) UpdateApplication(ctx context.Context, rr ...*systemType.Application) (err error) {
 	for i := range rr {
-		if err = s.checkAuthClientConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkApplicationConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, authClientUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, applicationUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -2412,16 +2031,16 @@ func (s *Store) UpdateAuthClient(ctx context.Context, rr ...*systemType.AuthClie
 	return
 }
 
-// UpsertAuthClient updates one or more existing entries in authClient collection
+// UpsertApplication updates one or more existing entries in application collection
 //
 // This function is auto-generated
-func (s *Store) UpsertAuthClient(ctx context.Context, rr ...*systemType.AuthClient) (err error) {
+func (s *Store) UpsertApplication(ctx context.Context, rr ...*systemType.Application) (err error) {
 	for i := range rr {
-		if err = s.checkAuthClientConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkApplicationConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, authClientUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, applicationUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -2429,12 +2048,12 @@ func (s *Store) UpsertAuthClient(ctx context.Context, rr ...*systemType.AuthClie
 	return
 }
 
-// DeleteAuthClient Deletes one or more entries from authClient collection
+// DeleteApplication Deletes one or more entries from application collection
 //
 // This function is auto-generated
-func (s *Store) DeleteAuthClient(ctx context.Context, rr ...*systemType.AuthClient) (err error) {
+func (s *Store) DeleteApplication(ctx context.Context, rr ...*systemType.Application) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, authClientDeleteQuery(s.Dialect, authClientPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, applicationDeleteQuery(s.Dialect, applicationPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -2442,24 +2061,24 @@ func (s *Store) DeleteAuthClient(ctx context.Context, rr ...*systemType.AuthClie
 	return nil
 }
 
-// DeleteAuthClientByID deletes single entry from authClient collection
+// DeleteApplicationByID deletes single entry from application collection
 //
 // This function is auto-generated
-func (s *Store) DeleteAuthClientByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, authClientDeleteQuery(s.Dialect, goqu.Ex{
+func (s *Store) DeleteApplicationByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, applicationDeleteQuery(s.Dialect, goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateAuthClients Deletes all rows from the authClient collection
-func (s Store) TruncateAuthClients(ctx context.Context) error {
-	return s.Exec(ctx, authClientTruncateQuery(s.Dialect))
+// TruncateApplications Deletes all rows from the application collection
+func (s Store) TruncateApplications(ctx context.Context) error {
+	return s.Exec(ctx, applicationTruncateQuery(s.Dialect))
 }
 
-// SearchAuthClients returns (filtered) set of AuthClients
+// SearchApplications returns (filtered) set of Applications
 //
 // This function is auto-generated
-func (s *Store) SearchAuthClients(ctx context.Context, f systemType.AuthClientFilter) (set systemType.AuthClientSet, _ systemType.AuthClientFilter, err error) {
+func (s *Store) SearchApplications(ctx context.Context, f systemType.ApplicationFilter) (set systemType.ApplicationSet, _ systemType.ApplicationFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -2483,7 +2102,7 @@ func (s *Store) SearchAuthClients(ctx context.Context, f systemType.AuthClientFi
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfAuthClients fn used for cursor creation;
+	// Original are passed to the etchFullPageOfApplications fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -2493,7 +2112,7 @@ func (s *Store) SearchAuthClients(ctx context.Context, f systemType.AuthClientFi
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfAuthClients(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfApplications(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -2503,7 +2122,7 @@ func (s *Store) SearchAuthClients(ctx context.Context, f systemType.AuthClientFi
 	return set, f, nil
 }
 
-// fetchFullPageOfAuthClients collects all requested results.
+// fetchFullPageOfApplications collects all requested results.
 //
 // Function applies:
 //  - cursor conditions (where ...)
@@ -2515,13 +2134,13 @@ func (s *Store) SearchAuthClients(ctx context.Context, f systemType.AuthClientFi
 // Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfAuthClients(
+func (s *Store) fetchFullPageOfApplications(
 	ctx context.Context,
-	filter systemType.AuthClientFilter,
+	filter systemType.ApplicationFilter,
 	sort filter.SortExprSet,
-) (set []*systemType.AuthClient, prev, next *filter.PagingCursor, err error) {
+) (set []*systemType.Application, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*systemType.AuthClient
+		aux []*systemType.Application
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -2540,10 +2159,10 @@ func (s *Store) fetchFullPageOfAuthClients(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter systemType.AuthClientFilter
+		tryFilter systemType.ApplicationFilter
 	)
 
-	set = make([]*systemType.AuthClient, 0, DefaultSliceCapacity)
+	set = make([]*systemType.Application, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -2556,7 +2175,7 @@ func (s *Store) fetchFullPageOfAuthClients(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryAuthClients(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryApplications(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -2586,7 +2205,7 @@ func (s *Store) fetchFullPageOfAuthClients(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectAuthClientCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectApplicationCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -2617,35 +2236,35 @@ func (s *Store) fetchFullPageOfAuthClients(
 	}
 
 	if hasPrev {
-		prev = s.collectAuthClientCursorValues(set[0], filter.Sort...)
+		prev = s.collectApplicationCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectAuthClientCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectApplicationCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryAuthClients queries the database, converts and checks each row and returns collected set
+// QueryApplications queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryAuthClients(
+func (s *Store) QueryApplications(
 	ctx context.Context,
-	f systemType.AuthClientFilter,
-) (_ []*systemType.AuthClient, more bool, err error) {
+	f systemType.ApplicationFilter,
+) (_ []*systemType.Application, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*systemType.AuthClient, 0, DefaultSliceCapacity)
-		res         *systemType.AuthClient
-		aux         *auxAuthClient
+		set         = make([]*systemType.Application, 0, DefaultSliceCapacity)
+		res         *systemType.Application
+		aux         *auxApplication
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -2653,16 +2272,16 @@ func (s *Store) QueryAuthClients(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.AuthClient != nil {
+	if s.Filters.Application != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.AuthClient(s, f)
+		tExpr, f, err = s.Filters.Application(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = AuthClientFilter(f)
+		tExpr, f, err = ApplicationFilter(f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for AuthClient: %w", err)
+		err = fmt.Errorf("could generate filter expression for Application: %w", err)
 		return
 	}
 
@@ -2677,11 +2296,11 @@ func (s *Store) QueryAuthClients(
 		}
 	}
 
-	query := authClientSelectQuery(s.Dialect).Where(expr...)
+	query := applicationSelectQuery(s.Dialect).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableAuthClientFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for AuthClient: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableApplicationFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for Application: %w", err)
 		return
 	}
 
@@ -2695,12 +2314,12 @@ func (s *Store) QueryAuthClients(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query AuthClient: %w", err)
+		err = fmt.Errorf("could not query Application: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query AuthClient: %w", err)
+		err = fmt.Errorf("could not query Application: %w", err)
 		return
 	}
 
@@ -2714,19 +2333,19 @@ func (s *Store) QueryAuthClients(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query AuthClient: %w", err)
+			err = fmt.Errorf("could not query Application: %w", err)
 			return
 		}
 
-		aux = new(auxAuthClient)
+		aux = new(auxApplication)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for AuthClient: %w", err)
+			err = fmt.Errorf("could not scan rows for Application: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode AuthClient: %w", err)
+			err = fmt.Errorf("could not decode Application: %w", err)
 			return
 		}
 
@@ -2747,16 +2366,16 @@ func (s *Store) QueryAuthClients(
 
 }
 
-// LookupAuthClientByID 	searches for auth client by ID
+// LookupApplicationByID searches for role by ID
 //
-// 	It returns auth clint even if deleted
+// It returns role even if deleted or suspended
 //
 // This function is auto-generated
-func (s *Store) LookupAuthClientByID(ctx context.Context, id uint64) (_ *systemType.AuthClient, err error) {
+func (s *Store) LookupApplicationByID(ctx context.Context, id uint64) (_ *systemType.Application, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxAuthClient)
-		lookup = authClientSelectQuery(s.Dialect).Where(
+		aux    = new(auxApplication)
+		lookup = applicationSelectQuery(s.Dialect).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -2789,70 +2408,24 @@ func (s *Store) LookupAuthClientByID(ctx context.Context, id uint64) (_ *systemT
 	return aux.decode()
 }
 
-// LookupAuthClientByHandle searches for auth client by ID
+// sortableApplicationFields returns all <no value> columns flagged as sortable
 //
-// It returns auth clint even if deleted
+// With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (s *Store) LookupAuthClientByHandle(ctx context.Context, handle string) (_ *systemType.AuthClient, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxAuthClient)
-		lookup = authClientSelectQuery(s.Dialect).Where(
-			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
-			goqu.I("deleted_at").IsNull(),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
-	}
-
-	return aux.decode()
-}
-
-// sortableAuthClientFields returns all <no value> columns flagged as sortable
-//
-// With optional string arg, all columns are returned aliased
-//
-// This function is auto-generated
-func (Store) sortableAuthClientFields() map[string]string {
+func (Store) sortableApplicationFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
 		"deleted_at": "deleted_at",
 		"deletedat":  "deleted_at",
-		"expires_at": "expires_at",
-		"expiresat":  "expires_at",
-		"handle":     "handle",
 		"id":         "id",
 		"updated_at": "updated_at",
 		"updatedat":  "updated_at",
 	}
 }
 
-// collectAuthClientCursorValues collects values from the given resource that and sets them to the cursor
+// collectApplicationCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -2863,7 +2436,7 @@ func (Store) sortableAuthClientFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectAuthClientCursorValues(res *systemType.AuthClient, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectApplicationCursorValues(res *systemType.Application, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -2877,11 +2450,6 @@ func (s *Store) collectAuthClientCursorValues(res *systemType.AuthClient, cc ...
 				case "id":
 					cur.Set(c.Column, res.ID, c.Descending)
 					pkID = true
-				case "handle":
-					cur.Set(c.Column, res.Handle, c.Descending)
-					hasUnique = true
-				case "expiresAt":
-					cur.Set(c.Column, res.ExpiresAt, c.Descending)
 				case "createdAt":
 					cur.Set(c.Column, res.CreatedAt, c.Descending)
 				case "updatedAt":
@@ -2902,54 +2470,27 @@ func (s *Store) collectAuthClientCursorValues(res *systemType.AuthClient, cc ...
 
 }
 
-// checkAuthClientConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkApplicationConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkAuthClientConstraints(ctx context.Context, res *systemType.AuthClient) (err error) {
-	err = func() (err error) {
-
-		// handling string type as default
-		if len(res.Handle) == 0 {
-			// skip check on empty values
-			return nil
-		}
-
-		if res.DeletedAt != nil {
-			// skip check if value is not nil
-			return nil
-		}
-
-		ex, err := s.LookupAuthClientByHandle(ctx, res.Handle)
-		if err == nil && ex != nil && ex.ID != res.ID {
-			return store.ErrNotUnique.Stack(1)
-		} else if !errors.IsNotFound(err) {
-			return err
-		}
-
-		return nil
-	}()
-
-	if err != nil {
-		return
-	}
-
+func (s *Store) checkApplicationConstraints(ctx context.Context, res *systemType.Application) (err error) {
 	return nil
 }
 
-// CreateAuthConfirmedClient creates one or more rows in authConfirmedClient collection
+// CreateAttachment creates one or more rows in attachment collection
 //
 // This function is auto-generated
-func (s *Store) CreateAuthConfirmedClient(ctx context.Context, rr ...*systemType.AuthConfirmedClient) (err error) {
+func (s *Store) CreateAttachment(ctx context.Context, rr ...*systemType.Attachment) (err error) {
 	for i := range rr {
-		if err = s.checkAuthConfirmedClientConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAttachmentConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, authConfirmedClientInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, attachmentInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -2957,16 +2498,16 @@ func (s *Store) CreateAuthConfirmedClient(ctx context.Context, rr ...*systemType
 	return
 }
 
-// UpdateAuthConfirmedClient updates one or more existing entries in authConfirmedClient collection
+// UpdateAttachment updates one or more existing entries in attachment collection
 //
 // This function is auto-generated
-func (s *Store) UpdateAuthConfirmedClient(ctx context.Context, rr ...*systemType.AuthConfirmedClient) (err error) {
+func (s *Store) UpdateAttachment(ctx context.Context, rr ...*systemType.Attachment) (err error) {
 	for i := range rr {
-		if err = s.checkAuthConfirmedClientConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAttachmentConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, authConfirmedClientUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, attachmentUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -2974,16 +2515,16 @@ func (s *Store) UpdateAuthConfirmedClient(ctx context.Context, rr ...*systemType
 	return
 }
 
-// UpsertAuthConfirmedClient updates one or more existing entries in authConfirmedClient collection
+// UpsertAttachment updates one or more existing entries in attachment collection
 //
 // This function is auto-generated
-func (s *Store) UpsertAuthConfirmedClient(ctx context.Context, rr ...*systemType.AuthConfirmedClient) (err error) {
+func (s *Store) UpsertAttachment(ctx context.Context, rr ...*systemType.Attachment) (err error) {
 	for i := range rr {
-		if err = s.checkAuthConfirmedClientConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAttachmentConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, authConfirmedClientUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, attachmentUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -2991,12 +2532,12 @@ func (s *Store) UpsertAuthConfirmedClient(ctx context.Context, rr ...*systemType
 	return
 }
 
-// DeleteAuthConfirmedClient Deletes one or more entries from authConfirmedClient collection
+// DeleteAttachment Deletes one or more entries from attachment collection
 //
 // This function is auto-generated
-func (s *Store) DeleteAuthConfirmedClient(ctx context.Context, rr ...*systemType.AuthConfirmedClient) (err error) {
+func (s *Store) DeleteAttachment(ctx context.Context, rr ...*systemType.Attachment) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, authConfirmedClientDeleteQuery(s.Dialect, authConfirmedClientPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, attachmentDeleteQuery(s.Dialect, attachmentPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -3004,27 +2545,60 @@ func (s *Store) DeleteAuthConfirmedClient(ctx context.Context, rr ...*systemType
 	return nil
 }
 
-// DeleteAuthConfirmedClientByID deletes single entry from authConfirmedClient collection
+// DeleteAttachmentByID deletes single entry from attachment collection
 //
 // This function is auto-generated
-func (s *Store) DeleteAuthConfirmedClientByUserIDClientID(ctx context.Context, userID uint64, clientID uint64) error {
-	return s.Exec(ctx, authConfirmedClientDeleteQuery(s.Dialect, goqu.Ex{
-		"rel_user":   userID,
-		"rel_client": clientID,
+func (s *Store) DeleteAttachmentByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, attachmentDeleteQuery(s.Dialect, goqu.Ex{
+		"id": id,
 	}))
 }
 
-// TruncateAuthConfirmedClients Deletes all rows from the authConfirmedClient collection
-func (s Store) TruncateAuthConfirmedClients(ctx context.Context) error {
-	return s.Exec(ctx, authConfirmedClientTruncateQuery(s.Dialect))
+// TruncateAttachments Deletes all rows from the attachment collection
+func (s Store) TruncateAttachments(ctx context.Context) error {
+	return s.Exec(ctx, attachmentTruncateQuery(s.Dialect))
 }
 
-// SearchAuthConfirmedClients returns (filtered) set of AuthConfirmedClients
+// SearchAttachments returns (filtered) set of Attachments
 //
 // This function is auto-generated
-func (s *Store) SearchAuthConfirmedClients(ctx context.Context, f systemType.AuthConfirmedClientFilter) (set systemType.AuthConfirmedClientSet, _ systemType.AuthConfirmedClientFilter, err error) {
+func (s *Store) SearchAttachments(ctx context.Context, f systemType.AttachmentFilter) (set systemType.AttachmentSet, _ systemType.AttachmentFilter, err error) {
 
-	set, _, err = s.QueryAuthConfirmedClients(ctx, f)
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfAttachments fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfAttachments(ctx, f, sort)
+
+	f.PageCursor = nil
 	if err != nil {
 		return nil, f, err
 	}
@@ -3032,100 +2606,259 @@ func (s *Store) SearchAuthConfirmedClients(ctx context.Context, f systemType.Aut
 	return set, f, nil
 }
 
-// QueryAuthConfirmedClients queries the database, converts and checks each row and returns collected set
+// fetchFullPageOfAttachments collects all requested results.
 //
-// With generics, we can remove this per-resource-generated function
-// and replace it with a single utility fetcher
+// Function applies:
+//  - cursor conditions (where ...)
+//  - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) QueryAuthConfirmedClients(
+func (s *Store) fetchFullPageOfAttachments(
 	ctx context.Context,
-	f systemType.AuthConfirmedClientFilter,
-) (_ []*systemType.AuthConfirmedClient, more bool, err error) {
+	filter systemType.AttachmentFilter,
+	sort filter.SortExprSet,
+) (set []*systemType.Attachment, prev, next *filter.PagingCursor, err error) {
 	var (
-		set         = make([]*systemType.AuthConfirmedClient, 0, DefaultSliceCapacity)
-		res         *systemType.AuthConfirmedClient
-		aux         *auxAuthConfirmedClient
-		rows        *sql.Rows
-		count       uint
-		expr, tExpr []goqu.Expression
-	)
+		aux []*systemType.Attachment
 
-	if s.Filters.AuthConfirmedClient != nil {
-		// extended filter set
-		tExpr, f, err = s.Filters.AuthConfirmedClient(s, f)
-	} else {
-		// using generated filter
-		tExpr, f, err = AuthConfirmedClientFilter(f)
-	}
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
 
-	if err != nil {
-		err = fmt.Errorf("could generate filter expression for AuthConfirmedClient: %w", err)
-		return
-	}
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
 
-	expr = append(expr, tExpr...)
+		reqItems = filter.Limit
 
-	query := authConfirmedClientSelectQuery(s.Dialect).Where(expr...)
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
 
-	if f.Limit > 0 {
-		query = query.Limit(f.Limit)
-	}
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
 
-	rows, err = s.Query(ctx, query)
-	if err != nil {
-		err = fmt.Errorf("could not query AuthConfirmedClient: %w", err)
-		return
-	}
+		tryFilter systemType.AttachmentFilter
+	)
 
-	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query AuthConfirmedClient: %w", err)
-		return
-	}
+	set = make([]*systemType.Attachment, 0, DefaultSliceCapacity)
 
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
 
-	for rows.Next() {
-		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query AuthConfirmedClient: %w", err)
-			return
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
 		}
 
-		aux = new(auxAuthConfirmedClient)
-		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for AuthConfirmedClient: %w", err)
-			return
+		if aux, hasNext, err = s.QueryAttachments(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
 		}
 
-		count++
-		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode AuthConfirmedClient: %w", err)
+		if len(aux) == 0 {
+			// nothing fetched
+			break
+		}
+
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
+		}
+
+		collected := uint(len(set))
+
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
+
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectAttachmentCursorValues(set[collected-1], filter.Sort...)
+
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
+		}
+
+		break
+	}
+
+	collected := len(set)
+
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
+
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
+
+	if hasPrev {
+		prev = s.collectAttachmentCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
+	}
+
+	if hasNext {
+		next = s.collectAttachmentCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
+}
+
+// QueryAttachments queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryAttachments(
+	ctx context.Context,
+	f systemType.AttachmentFilter,
+) (_ []*systemType.Attachment, more bool, err error) {
+	var (
+		ok bool
+
+		set         = make([]*systemType.Attachment, 0, DefaultSliceCapacity)
+		res         *systemType.Attachment
+		aux         *auxAttachment
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
+	)
+
+	if s.Filters.Attachment != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.Attachment(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = AttachmentFilter(f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for Attachment: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursor(f.PageCursor); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := attachmentSelectQuery(s.Dialect).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableAttachmentFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for Attachment: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query Attachment: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query Attachment: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query Attachment: %w", err)
+			return
+		}
+
+		aux = new(auxAttachment)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for Attachment: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode Attachment: %w", err)
 			return
 		}
 
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
+		}
+
 		set = append(set, res)
 	}
 
-	return set, false, err
+	return set, f.Limit > 0 && count >= f.Limit, err
 
 }
 
-// LookupAuthConfirmedClientByUserIDClientID
+// LookupAttachmentByID
 //
 // This function is auto-generated
-func (s *Store) LookupAuthConfirmedClientByUserIDClientID(ctx context.Context, userID uint64, clientID uint64) (_ *systemType.AuthConfirmedClient, err error) {
+func (s *Store) LookupAttachmentByID(ctx context.Context, id uint64) (_ *systemType.Attachment, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxAuthConfirmedClient)
-		lookup = authConfirmedClientSelectQuery(s.Dialect).Where(
-			goqu.I("rel_user").Eq(userID),
-			goqu.I("rel_client").Eq(clientID),
+		aux    = new(auxAttachment)
+		lookup = attachmentSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
 
@@ -3157,23 +2890,24 @@ func (s *Store) LookupAuthConfirmedClientByUserIDClientID(ctx context.Context, u
 	return aux.decode()
 }
 
-// sortableAuthConfirmedClientFields returns all <no value> columns flagged as sortable
+// sortableAttachmentFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableAuthConfirmedClientFields() map[string]string {
+func (Store) sortableAttachmentFields() map[string]string {
 	return map[string]string{
-		"client_id":    "client_id",
-		"clientid":     "client_id",
-		"confirmed_at": "confirmed_at",
-		"confirmedat":  "confirmed_at",
-		"user_id":      "user_id",
-		"userid":       "user_id",
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"id":         "id",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
 	}
 }
 
-// collectAuthConfirmedClientCursorValues collects values from the given resource that and sets them to the cursor
+// collectAttachmentCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -3184,64 +2918,61 @@ func (Store) sortableAuthConfirmedClientFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectAuthConfirmedClientCursorValues(res *systemType.AuthConfirmedClient, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectAttachmentCursorValues(res *systemType.Attachment, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
 		hasUnique bool
 
-		pkUserID   bool
-		pkClientID bool
+		pkID bool
 
 		collect = func(cc ...*filter.SortExpr) {
 			for _, c := range cc {
 				switch c.Column {
-				case "userID":
-					cur.Set(c.Column, res.UserID, c.Descending)
-					pkUserID = true
-				case "clientID":
-					cur.Set(c.Column, res.ClientID, c.Descending)
-					pkClientID = true
-				case "confirmedAt":
-					cur.Set(c.Column, res.ConfirmedAt, c.Descending)
+				case "id":
+					cur.Set(c.Column, res.ID, c.Descending)
+					pkID = true
+				case "createdAt":
+					cur.Set(c.Column, res.CreatedAt, c.Descending)
+				case "updatedAt":
+					cur.Set(c.Column, res.UpdatedAt, c.Descending)
+				case "deletedAt":
+					cur.Set(c.Column, res.DeletedAt, c.Descending)
 				}
 			}
 		}
 	)
 
 	collect(cc...)
-	if !hasUnique || !pkUserID {
-		collect(&filter.SortExpr{Column: "userID", Descending: false})
-	}
-	if !hasUnique || !pkClientID {
-		collect(&filter.SortExpr{Column: "clientID", Descending: false})
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
 	}
 
 	return cur
 
 }
 
-// checkAuthConfirmedClientConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkAttachmentConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkAuthConfirmedClientConstraints(ctx context.Context, res *systemType.AuthConfirmedClient) (err error) {
+func (s *Store) checkAttachmentConstraints(ctx context.Context, res *systemType.Attachment) (err error) {
 	return nil
 }
 
-// CreateAuthOa2token creates one or more rows in authOa2token collection
+// CreateAuthClient creates one or more rows in authClient collection
 //
 // This function is auto-generated
-func (s *Store) CreateAuthOa2token(ctx context.Context, rr ...*systemType.AuthOa2token) (err error) {
+func (s *Store) CreateAuthClient(ctx context.Context, rr ...*systemType.AuthClient) (err error) {
 	for i := range rr {
-		if err = s.checkAuthOa2tokenConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAuthClientConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, authOa2tokenInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, authClientInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -3249,16 +2980,16 @@ func (s *Store) CreateAuthOa2token(ctx context.Context, rr ...*systemType.AuthOa
 	return
 }
 
-// UpdateAuthOa2token updates one or more existing entries in authOa2token collection
+// UpdateAuthClient updates one or more existing entries in authClient collection
 //
 // This function is auto-generated
-func (s *Store) UpdateAuthOa2token(ctx context.Context, rr ...*systemType.AuthOa2token) (err error) {
+func (s *Store) UpdateAuthClient(ctx context.Context, rr ...*systemType.AuthClient) (err error) {
 	for i := range rr {
-		if err = s.checkAuthOa2tokenConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAuthClientConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, authOa2tokenUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, authClientUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -3266,16 +2997,16 @@ func (s *Store) UpdateAuthOa2token(ctx context.Context, rr ...*systemType.AuthOa
 	return
 }
 
-// UpsertAuthOa2token updates one or more existing entries in authOa2token collection
+// UpsertAuthClient updates one or more existing entries in authClient collection
 //
 // This function is auto-generated
-func (s *Store) UpsertAuthOa2token(ctx context.Context, rr ...*systemType.AuthOa2token) (err error) {
+func (s *Store) UpsertAuthClient(ctx context.Context, rr ...*systemType.AuthClient) (err error) {
 	for i := range rr {
-		if err = s.checkAuthOa2tokenConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAuthClientConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, authOa2tokenUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, authClientUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -3283,12 +3014,12 @@ func (s *Store) UpsertAuthOa2token(ctx context.Context, rr ...*systemType.AuthOa
 	return
 }
 
-// DeleteAuthOa2token Deletes one or more entries from authOa2token collection
+// DeleteAuthClient Deletes one or more entries from authClient collection
 //
 // This function is auto-generated
-func (s *Store) DeleteAuthOa2token(ctx context.Context, rr ...*systemType.AuthOa2token) (err error) {
+func (s *Store) DeleteAuthClient(ctx context.Context, rr ...*systemType.AuthClient) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, authOa2tokenDeleteQuery(s.Dialect, authOa2tokenPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, authClientDeleteQuery(s.Dialect, authClientPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -3296,26 +3027,60 @@ func (s *Store) DeleteAuthOa2token(ctx context.Context, rr ...*systemType.AuthOa
 	return nil
 }
 
-// DeleteAuthOa2tokenByID deletes single entry from authOa2token collection
+// DeleteAuthClientByID deletes single entry from authClient collection
 //
 // This function is auto-generated
-func (s *Store) DeleteAuthOa2tokenByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, authOa2tokenDeleteQuery(s.Dialect, goqu.Ex{
+func (s *Store) DeleteAuthClientByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, authClientDeleteQuery(s.Dialect, goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateAuthOa2tokens Deletes all rows from the authOa2token collection
-func (s Store) TruncateAuthOa2tokens(ctx context.Context) error {
-	return s.Exec(ctx, authOa2tokenTruncateQuery(s.Dialect))
+// TruncateAuthClients Deletes all rows from the authClient collection
+func (s Store) TruncateAuthClients(ctx context.Context) error {
+	return s.Exec(ctx, authClientTruncateQuery(s.Dialect))
 }
 
-// SearchAuthOa2tokens returns (filtered) set of AuthOa2tokens
+// SearchAuthClients returns (filtered) set of AuthClients
 //
 // This function is auto-generated
-func (s *Store) SearchAuthOa2tokens(ctx context.Context, f systemType.AuthOa2tokenFilter) (set systemType.AuthOa2tokenSet, _ systemType.AuthOa2tokenFilter, err error) {
+func (s *Store) SearchAuthClients(ctx context.Context, f systemType.AuthClientFilter) (set systemType.AuthClientSet, _ systemType.AuthClientFilter, err error) {
 
-	set, _, err = s.QueryAuthOa2tokens(ctx, f)
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfAuthClients fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfAuthClients(ctx, f, sort)
+
+	f.PageCursor = nil
 	if err != nil {
 		return nil, f, err
 	}
@@ -3323,41 +3088,191 @@ func (s *Store) SearchAuthOa2tokens(ctx context.Context, f systemType.AuthOa2tok
 	return set, f, nil
 }
 
-// QueryAuthOa2tokens queries the database, converts and checks each row and returns collected set
+// fetchFullPageOfAuthClients collects all requested results.
+//
+// Function applies:
+//  - cursor conditions (where ...)
+//  - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// Function then moves cursor to the last item fetched
+//
+// This function is auto-generated
+func (s *Store) fetchFullPageOfAuthClients(
+	ctx context.Context,
+	filter systemType.AuthClientFilter,
+	sort filter.SortExprSet,
+) (set []*systemType.AuthClient, prev, next *filter.PagingCursor, err error) {
+	var (
+		aux []*systemType.AuthClient
+
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
+
+		reqItems = filter.Limit
+
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
+
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
+
+		tryFilter systemType.AuthClientFilter
+	)
+
+	set = make([]*systemType.AuthClient, 0, DefaultSliceCapacity)
+
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
+		}
+
+		if aux, hasNext, err = s.QueryAuthClients(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if len(aux) == 0 {
+			// nothing fetched
+			break
+		}
+
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
+		}
+
+		collected := uint(len(set))
+
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
+
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectAuthClientCursorValues(set[collected-1], filter.Sort...)
+
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
+		}
+
+		break
+	}
+
+	collected := len(set)
+
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
+
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
+
+	if hasPrev {
+		prev = s.collectAuthClientCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
+	}
+
+	if hasNext {
+		next = s.collectAuthClientCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
+}
+
+// QueryAuthClients queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryAuthOa2tokens(
+func (s *Store) QueryAuthClients(
 	ctx context.Context,
-	f systemType.AuthOa2tokenFilter,
-) (_ []*systemType.AuthOa2token, more bool, err error) {
+	f systemType.AuthClientFilter,
+) (_ []*systemType.AuthClient, more bool, err error) {
 	var (
-		set         = make([]*systemType.AuthOa2token, 0, DefaultSliceCapacity)
-		res         *systemType.AuthOa2token
-		aux         *auxAuthOa2token
+		ok bool
+
+		set         = make([]*systemType.AuthClient, 0, DefaultSliceCapacity)
+		res         *systemType.AuthClient
+		aux         *auxAuthClient
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.AuthOa2token != nil {
+	if s.Filters.AuthClient != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.AuthOa2token(s, f)
+		tExpr, f, err = s.Filters.AuthClient(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = AuthOa2tokenFilter(f)
+		tExpr, f, err = AuthClientFilter(f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for AuthOa2token: %w", err)
+		err = fmt.Errorf("could generate filter expression for AuthClient: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	query := authOa2tokenSelectQuery(s.Dialect).Where(expr...)
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursor(f.PageCursor); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := authClientSelectQuery(s.Dialect).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableAuthClientFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for AuthClient: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
 
 	if f.Limit > 0 {
 		query = query.Limit(f.Limit)
@@ -3365,12 +3280,12 @@ func (s *Store) QueryAuthOa2tokens(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query AuthOa2token: %w", err)
+		err = fmt.Errorf("could not query AuthClient: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query AuthOa2token: %w", err)
+		err = fmt.Errorf("could not query AuthClient: %w", err)
 		return
 	}
 
@@ -3384,118 +3299,50 @@ func (s *Store) QueryAuthOa2tokens(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query AuthOa2token: %w", err)
+			err = fmt.Errorf("could not query AuthClient: %w", err)
 			return
 		}
 
-		aux = new(auxAuthOa2token)
+		aux = new(auxAuthClient)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for AuthOa2token: %w", err)
+			err = fmt.Errorf("could not scan rows for AuthClient: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode AuthOa2token: %w", err)
+			err = fmt.Errorf("could not decode AuthClient: %w", err)
 			return
 		}
 
-		set = append(set, res)
-	}
-
-	return set, false, err
-
-}
-
-// LookupAuthOa2tokenByID
-//
-// This function is auto-generated
-func (s *Store) LookupAuthOa2tokenByID(ctx context.Context, id uint64) (_ *systemType.AuthOa2token, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxAuthOa2token)
-		lookup = authOa2tokenSelectQuery(s.Dialect).Where(
-			goqu.I("id").Eq(id),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
 		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
 
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
+		set = append(set, res)
 	}
 
-	if err = aux.scan(rows); err != nil {
-		return
-	}
+	return set, f.Limit > 0 && count >= f.Limit, err
 
-	return aux.decode()
 }
 
-// LookupAuthOa2tokenByCode
+// LookupAuthClientByID 	searches for auth client by ID
 //
-// This function is auto-generated
-func (s *Store) LookupAuthOa2tokenByCode(ctx context.Context, code string) (_ *systemType.AuthOa2token, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxAuthOa2token)
-		lookup = authOa2tokenSelectQuery(s.Dialect).Where(
-			goqu.I("code").Eq(code),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
-	}
-
-	return aux.decode()
-}
-
-// LookupAuthOa2tokenByAccess
+// 	It returns auth clint even if deleted
 //
 // This function is auto-generated
-func (s *Store) LookupAuthOa2tokenByAccess(ctx context.Context, access string) (_ *systemType.AuthOa2token, err error) {
+func (s *Store) LookupAuthClientByID(ctx context.Context, id uint64) (_ *systemType.AuthClient, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxAuthOa2token)
-		lookup = authOa2tokenSelectQuery(s.Dialect).Where(
-			goqu.I("access").Eq(access),
+		aux    = new(auxAuthClient)
+		lookup = authClientSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
 
@@ -3527,15 +3374,18 @@ func (s *Store) LookupAuthOa2tokenByAccess(ctx context.Context, access string) (
 	return aux.decode()
 }
 
-// LookupAuthOa2tokenByRefresh
+// LookupAuthClientByHandle searches for auth client by ID
+//
+// It returns auth clint even if deleted
 //
 // This function is auto-generated
-func (s *Store) LookupAuthOa2tokenByRefresh(ctx context.Context, refresh string) (_ *systemType.AuthOa2token, err error) {
+func (s *Store) LookupAuthClientByHandle(ctx context.Context, handle string) (_ *systemType.AuthClient, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxAuthOa2token)
-		lookup = authOa2tokenSelectQuery(s.Dialect).Where(
-			goqu.I("refresh").Eq(refresh),
+		aux    = new(auxAuthClient)
+		lookup = authClientSelectQuery(s.Dialect).Where(
+			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
+			goqu.I("deleted_at").IsNull(),
 		).Limit(1)
 	)
 
@@ -3567,22 +3417,27 @@ func (s *Store) LookupAuthOa2tokenByRefresh(ctx context.Context, refresh string)
 	return aux.decode()
 }
 
-// sortableAuthOa2tokenFields returns all <no value> columns flagged as sortable
+// sortableAuthClientFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableAuthOa2tokenFields() map[string]string {
+func (Store) sortableAuthClientFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
 		"expires_at": "expires_at",
 		"expiresat":  "expires_at",
+		"handle":     "handle",
 		"id":         "id",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
 	}
 }
 
-// collectAuthOa2tokenCursorValues collects values from the given resource that and sets them to the cursor
+// collectAuthClientCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -3593,7 +3448,7 @@ func (Store) sortableAuthOa2tokenFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectAuthOa2tokenCursorValues(res *systemType.AuthOa2token, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectAuthClientCursorValues(res *systemType.AuthClient, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -3607,10 +3462,17 @@ func (s *Store) collectAuthOa2tokenCursorValues(res *systemType.AuthOa2token, cc
 				case "id":
 					cur.Set(c.Column, res.ID, c.Descending)
 					pkID = true
+				case "handle":
+					cur.Set(c.Column, res.Handle, c.Descending)
+					hasUnique = true
 				case "expiresAt":
 					cur.Set(c.Column, res.ExpiresAt, c.Descending)
 				case "createdAt":
 					cur.Set(c.Column, res.CreatedAt, c.Descending)
+				case "updatedAt":
+					cur.Set(c.Column, res.UpdatedAt, c.Descending)
+				case "deletedAt":
+					cur.Set(c.Column, res.DeletedAt, c.Descending)
 				}
 			}
 		}
@@ -3625,27 +3487,54 @@ func (s *Store) collectAuthOa2tokenCursorValues(res *systemType.AuthOa2token, cc
 
 }
 
-// checkAuthOa2tokenConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkAuthClientConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkAuthOa2tokenConstraints(ctx context.Context, res *systemType.AuthOa2token) (err error) {
+func (s *Store) checkAuthClientConstraints(ctx context.Context, res *systemType.AuthClient) (err error) {
+	err = func() (err error) {
+
+		// handling string type as default
+		if len(res.Handle) == 0 {
+			// skip check on empty values
+			return nil
+		}
+
+		if res.DeletedAt != nil {
+			// skip check if value is not nil
+			return nil
+		}
+
+		ex, err := s.LookupAuthClientByHandle(ctx, res.Handle)
+		if err == nil && ex != nil && ex.ID != res.ID {
+			return store.ErrNotUnique.Stack(1)
+		} else if !errors.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}()
+
+	if err != nil {
+		return
+	}
+
 	return nil
 }
 
-// CreateAuthSession creates one or more rows in authSession collection
+// CreateAuthConfirmedClient creates one or more rows in authConfirmedClient collection
 //
 // This function is auto-generated
-func (s *Store) CreateAuthSession(ctx context.Context, rr ...*systemType.AuthSession) (err error) {
+func (s *Store) CreateAuthConfirmedClient(ctx context.Context, rr ...*systemType.AuthConfirmedClient) (err error) {
 	for i := range rr {
-		if err = s.checkAuthSessionConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAuthConfirmedClientConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, authSessionInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, authConfirmedClientInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -3653,16 +3542,16 @@ func (s *Store) CreateAuthSession(ctx context.Context, rr ...*systemType.AuthSes
 	return
 }
 
-// UpdateAuthSession updates one or more existing entries in authSession collection
+// UpdateAuthConfirmedClient updates one or more existing entries in authConfirmedClient collection
 //
 // This function is auto-generated
-func (s *Store) UpdateAuthSession(ctx context.Context, rr ...*systemType.AuthSession) (err error) {
+func (s *Store) UpdateAuthConfirmedClient(ctx context.Context, rr ...*systemType.AuthConfirmedClient) (err error) {
 	for i := range rr {
-		if err = s.checkAuthSessionConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAuthConfirmedClientConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, authSessionUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, authConfirmedClientUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -3670,16 +3559,16 @@ func (s *Store) UpdateAuthSession(ctx context.Context, rr ...*systemType.AuthSes
 	return
 }
 
-// UpsertAuthSession updates one or more existing entries in authSession collection
+// UpsertAuthConfirmedClient updates one or more existing entries in authConfirmedClient collection
 //
 // This function is auto-generated
-func (s *Store) UpsertAuthSession(ctx context.Context, rr ...*systemType.AuthSession) (err error) {
+func (s *Store) UpsertAuthConfirmedClient(ctx context.Context, rr ...*systemType.AuthConfirmedClient) (err error) {
 	for i := range rr {
-		if err = s.checkAuthSessionConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAuthConfirmedClientConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, authSessionUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, authConfirmedClientUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -3687,12 +3576,12 @@ func (s *Store) UpsertAuthSession(ctx context.Context, rr ...*systemType.AuthSes
 	return
 }
 
-// DeleteAuthSession Deletes one or more entries from authSession collection
+// DeleteAuthConfirmedClient Deletes one or more entries from authConfirmedClient collection
 //
 // This function is auto-generated
-func (s *Store) DeleteAuthSession(ctx context.Context, rr ...*systemType.AuthSession) (err error) {
+func (s *Store) DeleteAuthConfirmedClient(ctx context.Context, rr ...*systemType.AuthConfirmedClient) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, authSessionDeleteQuery(s.Dialect, authSessionPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, authConfirmedClientDeleteQuery(s.Dialect, authConfirmedClientPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -3700,26 +3589,27 @@ func (s *Store) DeleteAuthSession(ctx context.Context, rr ...*systemType.AuthSes
 	return nil
 }
 
-// DeleteAuthSessionByID deletes single entry from authSession collection
+// DeleteAuthConfirmedClientByID deletes single entry from authConfirmedClient collection
 //
 // This function is auto-generated
-func (s *Store) DeleteAuthSessionByID(ctx context.Context, id string) error {
-	return s.Exec(ctx, authSessionDeleteQuery(s.Dialect, goqu.Ex{
-		"id": id,
+func (s *Store) DeleteAuthConfirmedClientByUserIDClientID(ctx context.Context, userID uint64, clientID uint64) error {
+	return s.Exec(ctx, authConfirmedClientDeleteQuery(s.Dialect, goqu.Ex{
+		"rel_user":   userID,
+		"rel_client": clientID,
 	}))
 }
 
-// TruncateAuthSessions Deletes all rows from the authSession collection
-func (s Store) TruncateAuthSessions(ctx context.Context) error {
-	return s.Exec(ctx, authSessionTruncateQuery(s.Dialect))
+// TruncateAuthConfirmedClients Deletes all rows from the authConfirmedClient collection
+func (s Store) TruncateAuthConfirmedClients(ctx context.Context) error {
+	return s.Exec(ctx, authConfirmedClientTruncateQuery(s.Dialect))
 }
 
-// SearchAuthSessions returns (filtered) set of AuthSessions
+// SearchAuthConfirmedClients returns (filtered) set of AuthConfirmedClients
 //
 // This function is auto-generated
-func (s *Store) SearchAuthSessions(ctx context.Context, f systemType.AuthSessionFilter) (set systemType.AuthSessionSet, _ systemType.AuthSessionFilter, err error) {
+func (s *Store) SearchAuthConfirmedClients(ctx context.Context, f systemType.AuthConfirmedClientFilter) (set systemType.AuthConfirmedClientSet, _ systemType.AuthConfirmedClientFilter, err error) {
 
-	set, _, err = s.QueryAuthSessions(ctx, f)
+	set, _, err = s.QueryAuthConfirmedClients(ctx, f)
 	if err != nil {
 		return nil, f, err
 	}
@@ -3727,41 +3617,41 @@ func (s *Store) SearchAuthSessions(ctx context.Context, f systemType.AuthSession
 	return set, f, nil
 }
 
-// QueryAuthSessions queries the database, converts and checks each row and returns collected set
+// QueryAuthConfirmedClients queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryAuthSessions(
+func (s *Store) QueryAuthConfirmedClients(
 	ctx context.Context,
-	f systemType.AuthSessionFilter,
-) (_ []*systemType.AuthSession, more bool, err error) {
+	f systemType.AuthConfirmedClientFilter,
+) (_ []*systemType.AuthConfirmedClient, more bool, err error) {
 	var (
-		set         = make([]*systemType.AuthSession, 0, DefaultSliceCapacity)
-		res         *systemType.AuthSession
-		aux         *auxAuthSession
+		set         = make([]*systemType.AuthConfirmedClient, 0, DefaultSliceCapacity)
+		res         *systemType.AuthConfirmedClient
+		aux         *auxAuthConfirmedClient
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
 	)
 
-	if s.Filters.AuthSession != nil {
+	if s.Filters.AuthConfirmedClient != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.AuthSession(s, f)
+		tExpr, f, err = s.Filters.AuthConfirmedClient(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = AuthSessionFilter(f)
+		tExpr, f, err = AuthConfirmedClientFilter(f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for AuthSession: %w", err)
+		err = fmt.Errorf("could generate filter expression for AuthConfirmedClient: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	query := authSessionSelectQuery(s.Dialect).Where(expr...)
+	query := authConfirmedClientSelectQuery(s.Dialect).Where(expr...)
 
 	if f.Limit > 0 {
 		query = query.Limit(f.Limit)
@@ -3769,12 +3659,12 @@ func (s *Store) QueryAuthSessions(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query AuthSession: %w", err)
+		err = fmt.Errorf("could not query AuthConfirmedClient: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query AuthSession: %w", err)
+		err = fmt.Errorf("could not query AuthConfirmedClient: %w", err)
 		return
 	}
 
@@ -3788,19 +3678,19 @@ func (s *Store) QueryAuthSessions(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query AuthSession: %w", err)
+			err = fmt.Errorf("could not query AuthConfirmedClient: %w", err)
 			return
 		}
 
-		aux = new(auxAuthSession)
+		aux = new(auxAuthConfirmedClient)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for AuthSession: %w", err)
+			err = fmt.Errorf("could not scan rows for AuthConfirmedClient: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode AuthSession: %w", err)
+			err = fmt.Errorf("could not decode AuthConfirmedClient: %w", err)
 			return
 		}
 
@@ -3811,15 +3701,16 @@ func (s *Store) QueryAuthSessions(
 
 }
 
-// LookupAuthSessionByID
+// LookupAuthConfirmedClientByUserIDClientID
 //
 // This function is auto-generated
-func (s *Store) LookupAuthSessionByID(ctx context.Context, id string) (_ *systemType.AuthSession, err error) {
+func (s *Store) LookupAuthConfirmedClientByUserIDClientID(ctx context.Context, userID uint64, clientID uint64) (_ *systemType.AuthConfirmedClient, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxAuthSession)
-		lookup = authSessionSelectQuery(s.Dialect).Where(
-			goqu.I("id").Eq(id),
+		aux    = new(auxAuthConfirmedClient)
+		lookup = authConfirmedClientSelectQuery(s.Dialect).Where(
+			goqu.I("rel_user").Eq(userID),
+			goqu.I("rel_client").Eq(clientID),
 		).Limit(1)
 	)
 
@@ -3851,22 +3742,23 @@ func (s *Store) LookupAuthSessionByID(ctx context.Context, id string) (_ *system
 	return aux.decode()
 }
 
-// sortableAuthSessionFields returns all <no value> columns flagged as sortable
+// sortableAuthConfirmedClientFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableAuthSessionFields() map[string]string {
+func (Store) sortableAuthConfirmedClientFields() map[string]string {
 	return map[string]string{
-		"created_at": "created_at",
-		"createdat":  "created_at",
-		"expires_at": "expires_at",
-		"expiresat":  "expires_at",
-		"id":         "id",
+		"client_id":    "client_id",
+		"clientid":     "client_id",
+		"confirmed_at": "confirmed_at",
+		"confirmedat":  "confirmed_at",
+		"user_id":      "user_id",
+		"userid":       "user_id",
 	}
 }
 
-// collectAuthSessionCursorValues collects values from the given resource that and sets them to the cursor
+// collectAuthConfirmedClientCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -3877,59 +3769,64 @@ func (Store) sortableAuthSessionFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectAuthSessionCursorValues(res *systemType.AuthSession, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectAuthConfirmedClientCursorValues(res *systemType.AuthConfirmedClient, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
 		hasUnique bool
 
-		pkID bool
+		pkUserID   bool
+		pkClientID bool
 
 		collect = func(cc ...*filter.SortExpr) {
 			for _, c := range cc {
 				switch c.Column {
-				case "id":
-					cur.Set(c.Column, res.ID, c.Descending)
-					pkID = true
-				case "expiresAt":
-					cur.Set(c.Column, res.ExpiresAt, c.Descending)
-				case "createdAt":
-					cur.Set(c.Column, res.CreatedAt, c.Descending)
+				case "userID":
+					cur.Set(c.Column, res.UserID, c.Descending)
+					pkUserID = true
+				case "clientID":
+					cur.Set(c.Column, res.ClientID, c.Descending)
+					pkClientID = true
+				case "confirmedAt":
+					cur.Set(c.Column, res.ConfirmedAt, c.Descending)
 				}
 			}
 		}
 	)
 
 	collect(cc...)
-	if !hasUnique || !pkID {
-		collect(&filter.SortExpr{Column: "id", Descending: false})
+	if !hasUnique || !pkUserID {
+		collect(&filter.SortExpr{Column: "userID", Descending: false})
+	}
+	if !hasUnique || !pkClientID {
+		collect(&filter.SortExpr{Column: "clientID", Descending: false})
 	}
 
 	return cur
 
 }
 
-// checkAuthSessionConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkAuthConfirmedClientConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkAuthSessionConstraints(ctx context.Context, res *systemType.AuthSession) (err error) {
+func (s *Store) checkAuthConfirmedClientConstraints(ctx context.Context, res *systemType.AuthConfirmedClient) (err error) {
 	return nil
 }
 
-// CreateAutomationSession creates one or more rows in automationSession collection
+// CreateAuthOa2token creates one or more rows in authOa2token collection
 //
 // This function is auto-generated
-func (s *Store) CreateAutomationSession(ctx context.Context, rr ...*automationType.Session) (err error) {
+func (s *Store) CreateAuthOa2token(ctx context.Context, rr ...*systemType.AuthOa2token) (err error) {
 	for i := range rr {
-		if err = s.checkAutomationSessionConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAuthOa2tokenConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, automationSessionInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, authOa2tokenInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -3937,16 +3834,16 @@ func (s *Store) CreateAutomationSession(ctx context.Context, rr ...*automationTy
 	return
 }
 
-// UpdateAutomationSession updates one or more existing entries in automationSession collection
+// UpdateAuthOa2token updates one or more existing entries in authOa2token collection
 //
 // This function is auto-generated
-func (s *Store) UpdateAutomationSession(ctx context.Context, rr ...*automationType.Session) (err error) {
+func (s *Store) UpdateAuthOa2token(ctx context.Context, rr ...*systemType.AuthOa2token) (err error) {
 	for i := range rr {
-		if err = s.checkAutomationSessionConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAuthOa2tokenConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, automationSessionUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, authOa2tokenUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -3954,16 +3851,16 @@ func (s *Store) UpdateAutomationSession(ctx context.Context, rr ...*automationTy
 	return
 }
 
-// UpsertAutomationSession updates one or more existing entries in automationSession collection
+// UpsertAuthOa2token updates one or more existing entries in authOa2token collection
 //
 // This function is auto-generated
-func (s *Store) UpsertAutomationSession(ctx context.Context, rr ...*automationType.Session) (err error) {
+func (s *Store) UpsertAuthOa2token(ctx context.Context, rr ...*systemType.AuthOa2token) (err error) {
 	for i := range rr {
-		if err = s.checkAutomationSessionConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAuthOa2tokenConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, automationSessionUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, authOa2tokenUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -3971,12 +3868,12 @@ func (s *Store) UpsertAutomationSession(ctx context.Context, rr ...*automationTy
 	return
 }
 
-// DeleteAutomationSession Deletes one or more entries from automationSession collection
+// DeleteAuthOa2token Deletes one or more entries from authOa2token collection
 //
 // This function is auto-generated
-func (s *Store) DeleteAutomationSession(ctx context.Context, rr ...*automationType.Session) (err error) {
+func (s *Store) DeleteAuthOa2token(ctx context.Context, rr ...*systemType.AuthOa2token) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, automationSessionDeleteQuery(s.Dialect, automationSessionPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, authOa2tokenDeleteQuery(s.Dialect, authOa2tokenPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -3984,60 +3881,26 @@ func (s *Store) DeleteAutomationSession(ctx context.Context, rr ...*automationTy
 	return nil
 }
 
-// DeleteAutomationSessionByID deletes single entry from automationSession collection
+// DeleteAuthOa2tokenByID deletes single entry from authOa2token collection
 //
 // This function is auto-generated
-func (s *Store) DeleteAutomationSessionByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, automationSessionDeleteQuery(s.Dialect, goqu.Ex{
+func (s *Store) DeleteAuthOa2tokenByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, authOa2tokenDeleteQuery(s.Dialect, goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateAutomationSessions Deletes all rows from the automationSession collection
-func (s Store) TruncateAutomationSessions(ctx context.Context) error {
-	return s.Exec(ctx, automationSessionTruncateQuery(s.Dialect))
+// TruncateAuthOa2tokens Deletes all rows from the authOa2token collection
+func (s Store) TruncateAuthOa2tokens(ctx context.Context) error {
+	return s.Exec(ctx, authOa2tokenTruncateQuery(s.Dialect))
 }
 
-// SearchAutomationSessions returns (filtered) set of AutomationSessions
+// SearchAuthOa2tokens returns (filtered) set of AuthOa2tokens
 //
 // This function is auto-generated
-func (s *Store) SearchAutomationSessions(ctx context.Context, f automationType.SessionFilter) (set automationType.SessionSet, _ automationType.SessionFilter, err error) {
-
-	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
-	f.PrevPage, f.NextPage = nil, nil
-
-	if f.PageCursor != nil {
-		// Page cursor exists; we need to validate it against used sort
-		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
-		// from the cursor.
-		// This (extracted sorting info) is then returned as part of response
-		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
-			return
-		}
-	}
-
-	// Make sure results are always sorted at least by primary keys
-	if f.Sort.Get("id") == nil {
-		f.Sort = append(f.Sort, &filter.SortExpr{
-			Column:     "id",
-			Descending: f.Sort.LastDescending(),
-		})
-	}
-
-	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfAutomationSessions fn used for cursor creation;
-	// direction information it MUST keep the initial
-	sort := f.Sort.Clone()
-
-	// When cursor for a previous page is used it's marked as reversed
-	// This tells us to flip the descending flag on all used sort keys
-	if f.PageCursor != nil && f.PageCursor.ROrder {
-		sort.Reverse()
-	}
-
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfAutomationSessions(ctx, f, sort)
+func (s *Store) SearchAuthOa2tokens(ctx context.Context, f systemType.AuthOa2tokenFilter) (set systemType.AuthOa2tokenSet, _ systemType.AuthOa2tokenFilter, err error) {
 
-	f.PageCursor = nil
+	set, _, err = s.QueryAuthOa2tokens(ctx, f)
 	if err != nil {
 		return nil, f, err
 	}
@@ -4045,204 +3908,184 @@ func (s *Store) SearchAutomationSessions(ctx context.Context, f automationType.S
 	return set, f, nil
 }
 
-// fetchFullPageOfAutomationSessions collects all requested results.
-//
-// Function applies:
-//  - cursor conditions (where ...)
-//  - limit
-//
-// Main responsibility of this function is to perform additional sequential queries in case when not enough results
-// are collected due to failed check on a specific row (by check fn).
+// QueryAuthOa2tokens queries the database, converts and checks each row and returns collected set
 //
-// Function then moves cursor to the last item fetched
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfAutomationSessions(
+func (s *Store) QueryAuthOa2tokens(
 	ctx context.Context,
-	filter automationType.SessionFilter,
-	sort filter.SortExprSet,
-) (set []*automationType.Session, prev, next *filter.PagingCursor, err error) {
+	f systemType.AuthOa2tokenFilter,
+) (_ []*systemType.AuthOa2token, more bool, err error) {
 	var (
-		aux []*automationType.Session
-
-		// When cursor for a previous page is used it's marked as reversed
-		// This tells us to flip the descending flag on all used sort keys
-		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+		set         = make([]*systemType.AuthOa2token, 0, DefaultSliceCapacity)
+		res         *systemType.AuthOa2token
+		aux         *auxAuthOa2token
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+	)
 
-		// Copy no. of required items to limit
-		// Limit will change when doing subsequent queries to fill
-		// the set with all required items
-		limit = filter.Limit
+	if s.Filters.AuthOa2token != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.AuthOa2token(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = AuthOa2tokenFilter(f)
+	}
 
-		reqItems = filter.Limit
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for AuthOa2token: %w", err)
+		return
+	}
 
-		// cursor to prev. page is only calculated when cursor is used
-		hasPrev = filter.PageCursor != nil
+	expr = append(expr, tExpr...)
 
-		// next cursor is calculated when there are more pages to come
-		hasNext bool
+	query := authOa2tokenSelectQuery(s.Dialect).Where(expr...)
 
-		tryFilter automationType.SessionFilter
-	)
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
 
-	set = make([]*automationType.Session, 0, DefaultSliceCapacity)
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query AuthOa2token: %w", err)
+		return
+	}
 
-	for try := 0; try < MaxRefetches; try++ {
-		// Copy filter & apply custom sorting that might be affected by cursor
-		tryFilter = filter
-		tryFilter.Sort = sort
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query AuthOa2token: %w", err)
+		return
+	}
 
-		if limit > 0 {
-			// fetching + 1 to peak ahead if there are more items
-			// we can fetch (next-page cursor)
-			tryFilter.Limit = limit + 1
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
 		}
+	}()
 
-		if aux, hasNext, err = s.QueryAutomationSessions(ctx, tryFilter); err != nil {
-			return nil, nil, nil, err
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query AuthOa2token: %w", err)
+			return
 		}
 
-		if len(aux) == 0 {
-			// nothing fetched
-			break
+		aux = new(auxAuthOa2token)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for AuthOa2token: %w", err)
+			return
 		}
 
-		// append fetched items
-		set = append(set, aux...)
-
-		if reqItems == 0 || !hasNext {
-			// no max requested items specified, break out
-			break
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode AuthOa2token: %w", err)
+			return
 		}
 
-		collected := uint(len(set))
-
-		if reqItems > collected {
-			// not enough items fetched, try again with adjusted limit
-			limit = reqItems - collected
+		set = append(set, res)
+	}
 
-			if limit < MinEnsureFetchLimit {
-				// In case limit is set very low and we've missed records in the first fetch,
-				// make sure next fetch limit is a bit higher
-				limit = MinEnsureFetchLimit
-			}
+	return set, false, err
 
-			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectAutomationSessionCursorValues(set[collected-1], filter.Sort...)
+}
 
-			// Copy reverse flag from sorting
-			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
-			continue
-		}
-
-		if reqItems < collected {
-			set = set[:reqItems]
-		}
-
-		break
-	}
-
-	collected := len(set)
+// LookupAuthOa2tokenByID
+//
+// This function is auto-generated
+func (s *Store) LookupAuthOa2tokenByID(ctx context.Context, id uint64) (_ *systemType.AuthOa2token, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxAuthOa2token)
+		lookup = authOa2tokenSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
 
-	if collected == 0 {
-		return nil, nil, nil, nil
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
 	}
 
-	if reversedOrder {
-		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
-		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
-			set[i], set[j] = set[j], set[i]
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
 		}
+	}()
 
-		// when in reverse-order rules on what cursor to return change
-		hasPrev, hasNext = hasNext, hasPrev
+	if err = rows.Err(); err != nil {
+		return
 	}
 
-	if hasPrev {
-		prev = s.collectAutomationSessionCursorValues(set[0], filter.Sort...)
-		prev.ROrder = true
-		prev.LThen = !filter.Sort.Reversed()
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
 	}
 
-	if hasNext {
-		next = s.collectAutomationSessionCursorValues(set[collected-1], filter.Sort...)
-		next.LThen = filter.Sort.Reversed()
+	if err = aux.scan(rows); err != nil {
+		return
 	}
 
-	return set, prev, next, nil
+	return aux.decode()
 }
 
-// QueryAutomationSessions queries the database, converts and checks each row and returns collected set
-//
-// With generics, we can remove this per-resource-generated function
-// and replace it with a single utility fetcher
+// LookupAuthOa2tokenByCode
 //
 // This function is auto-generated
-func (s *Store) QueryAutomationSessions(
-	ctx context.Context,
-	f automationType.SessionFilter,
-) (_ []*automationType.Session, more bool, err error) {
+func (s *Store) LookupAuthOa2tokenByCode(ctx context.Context, code string) (_ *systemType.AuthOa2token, err error) {
 	var (
-		ok bool
-
-		set         = make([]*automationType.Session, 0, DefaultSliceCapacity)
-		res         *automationType.Session
-		aux         *auxAutomationSession
-		rows        *sql.Rows
-		count       uint
-		expr, tExpr []goqu.Expression
-
-		sortExpr []exp.OrderedExpression
+		rows   *sql.Rows
+		aux    = new(auxAuthOa2token)
+		lookup = authOa2tokenSelectQuery(s.Dialect).Where(
+			goqu.I("code").Eq(code),
+		).Limit(1)
 	)
 
-	if s.Filters.AutomationSession != nil {
-		// extended filter set
-		tExpr, f, err = s.Filters.AutomationSession(s, f)
-	} else {
-		// using generated filter
-		tExpr, f, err = AutomationSessionFilter(f)
-	}
-
+	rows, err = s.Query(ctx, lookup)
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for AutomationSession: %w", err)
 		return
 	}
 
-	expr = append(expr, tExpr...)
-
-	// paging feature is enabled
-	if f.PageCursor != nil {
-		if tExpr, err = cursor(f.PageCursor); err != nil {
-			return
-		} else {
-			expr = append(expr, tExpr...)
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
 		}
-	}
-
-	query := automationSessionSelectQuery(s.Dialect).Where(expr...)
+	}()
 
-	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableAutomationSessionFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for AutomationSession: %w", err)
+	if err = rows.Err(); err != nil {
 		return
 	}
 
-	if len(sortExpr) > 0 {
-		query = query.Order(sortExpr...)
-	}
-
-	if f.Limit > 0 {
-		query = query.Limit(f.Limit)
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
 	}
 
-	rows, err = s.Query(ctx, query)
-	if err != nil {
-		err = fmt.Errorf("could not query AutomationSession: %w", err)
+	if err = aux.scan(rows); err != nil {
 		return
 	}
 
-	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query AutomationSession: %w", err)
+	return aux.decode()
+}
+
+// LookupAuthOa2tokenByAccess
+//
+// This function is auto-generated
+func (s *Store) LookupAuthOa2tokenByAccess(ctx context.Context, access string) (_ *systemType.AuthOa2token, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxAuthOa2token)
+		lookup = authOa2tokenSelectQuery(s.Dialect).Where(
+			goqu.I("access").Eq(access),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
 		return
 	}
 
@@ -4254,52 +4097,30 @@ func (s *Store) QueryAutomationSessions(
 		}
 	}()
 
-	for rows.Next() {
-		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query AutomationSession: %w", err)
-			return
-		}
-
-		aux = new(auxAutomationSession)
-		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for AutomationSession: %w", err)
-			return
-		}
-
-		count++
-		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode AutomationSession: %w", err)
-			return
-		}
-
-		// check fn set, call it and see if it passed the test
-		// if not, skip the item
-		if f.Check != nil {
-			if ok, err = f.Check(res); err != nil {
-				return
-			} else if !ok {
-				continue
-			}
-		}
+	if err = rows.Err(); err != nil {
+		return
+	}
 
-		set = append(set, res)
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
 	}
 
-	return set, f.Limit > 0 && count >= f.Limit, err
+	if err = aux.scan(rows); err != nil {
+		return
+	}
 
+	return aux.decode()
 }
 
-// LookupAutomationSessionByID searches for session by ID
-//
-// It returns session even if deleted
+// LookupAuthOa2tokenByRefresh
 //
 // This function is auto-generated
-func (s *Store) LookupAutomationSessionByID(ctx context.Context, id uint64) (_ *automationType.Session, err error) {
+func (s *Store) LookupAuthOa2tokenByRefresh(ctx context.Context, refresh string) (_ *systemType.AuthOa2token, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxAutomationSession)
-		lookup = automationSessionSelectQuery(s.Dialect).Where(
-			goqu.I("id").Eq(id),
+		aux    = new(auxAuthOa2token)
+		lookup = authOa2tokenSelectQuery(s.Dialect).Where(
+			goqu.I("refresh").Eq(refresh),
 		).Limit(1)
 	)
 
@@ -4331,26 +4152,22 @@ func (s *Store) LookupAutomationSessionByID(ctx context.Context, id uint64) (_ *
 	return aux.decode()
 }
 
-// sortableAutomationSessionFields returns all <no value> columns flagged as sortable
+// sortableAuthOa2tokenFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableAutomationSessionFields() map[string]string {
+func (Store) sortableAuthOa2tokenFields() map[string]string {
 	return map[string]string{
-		"completed_at": "completed_at",
-		"completedat":  "completed_at",
-		"created_at":   "created_at",
-		"createdat":    "created_at",
-		"id":           "id",
-		"purge_at":     "purge_at",
-		"purgeat":      "purge_at",
-		"suspended_at": "suspended_at",
-		"suspendedat":  "suspended_at",
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"expires_at": "expires_at",
+		"expiresat":  "expires_at",
+		"id":         "id",
 	}
 }
 
-// collectAutomationSessionCursorValues collects values from the given resource that and sets them to the cursor
+// collectAuthOa2tokenCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -4361,7 +4178,7 @@ func (Store) sortableAutomationSessionFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectAutomationSessionCursorValues(res *automationType.Session, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectAuthOa2tokenCursorValues(res *systemType.AuthOa2token, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -4375,14 +4192,10 @@ func (s *Store) collectAutomationSessionCursorValues(res *automationType.Session
 				case "id":
 					cur.Set(c.Column, res.ID, c.Descending)
 					pkID = true
+				case "expiresAt":
+					cur.Set(c.Column, res.ExpiresAt, c.Descending)
 				case "createdAt":
 					cur.Set(c.Column, res.CreatedAt, c.Descending)
-				case "purgeAt":
-					cur.Set(c.Column, res.PurgeAt, c.Descending)
-				case "completedAt":
-					cur.Set(c.Column, res.CompletedAt, c.Descending)
-				case "suspendedAt":
-					cur.Set(c.Column, res.SuspendedAt, c.Descending)
 				}
 			}
 		}
@@ -4397,27 +4210,27 @@ func (s *Store) collectAutomationSessionCursorValues(res *automationType.Session
 
 }
 
-// checkAutomationSessionConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkAuthOa2tokenConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkAutomationSessionConstraints(ctx context.Context, res *automationType.Session) (err error) {
+func (s *Store) checkAuthOa2tokenConstraints(ctx context.Context, res *systemType.AuthOa2token) (err error) {
 	return nil
 }
 
-// CreateAutomationTrigger creates one or more rows in automationTrigger collection
+// CreateAuthSession creates one or more rows in authSession collection
 //
 // This function is auto-generated
-func (s *Store) CreateAutomationTrigger(ctx context.Context, rr ...*automationType.Trigger) (err error) {
+func (s *Store) CreateAuthSession(ctx context.Context, rr ...*systemType.AuthSession) (err error) {
 	for i := range rr {
-		if err = s.checkAutomationTriggerConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAuthSessionConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, automationTriggerInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, authSessionInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -4425,16 +4238,16 @@ func (s *Store) CreateAutomationTrigger(ctx context.Context, rr ...*automationTy
 	return
 }
 
-// UpdateAutomationTrigger updates one or more existing entries in automationTrigger collection
+// UpdateAuthSession updates one or more existing entries in authSession collection
 //
 // This function is auto-generated
-func (s *Store) UpdateAutomationTrigger(ctx context.Context, rr ...*automationType.Trigger) (err error) {
+func (s *Store) UpdateAuthSession(ctx context.Context, rr ...*systemType.AuthSession) (err error) {
 	for i := range rr {
-		if err = s.checkAutomationTriggerConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAuthSessionConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, automationTriggerUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, authSessionUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -4442,16 +4255,16 @@ func (s *Store) UpdateAutomationTrigger(ctx context.Context, rr ...*automationTy
 	return
 }
 
-// UpsertAutomationTrigger updates one or more existing entries in automationTrigger collection
+// UpsertAuthSession updates one or more existing entries in authSession collection
 //
 // This function is auto-generated
-func (s *Store) UpsertAutomationTrigger(ctx context.Context, rr ...*automationType.Trigger) (err error) {
+func (s *Store) UpsertAuthSession(ctx context.Context, rr ...*systemType.AuthSession) (err error) {
 	for i := range rr {
-		if err = s.checkAutomationTriggerConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAuthSessionConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, automationTriggerUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, authSessionUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -4459,12 +4272,12 @@ func (s *Store) UpsertAutomationTrigger(ctx context.Context, rr ...*automationTy
 	return
 }
 
-// DeleteAutomationTrigger Deletes one or more entries from automationTrigger collection
+// DeleteAuthSession Deletes one or more entries from authSession collection
 //
 // This function is auto-generated
-func (s *Store) DeleteAutomationTrigger(ctx context.Context, rr ...*automationType.Trigger) (err error) {
+func (s *Store) DeleteAuthSession(ctx context.Context, rr ...*systemType.AuthSession) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, automationTriggerDeleteQuery(s.Dialect, automationTriggerPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, authSessionDeleteQuery(s.Dialect, authSessionPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -4472,60 +4285,26 @@ func (s *Store) DeleteAutomationTrigger(ctx context.Context, rr ...*automationTy
 	return nil
 }
 
-// DeleteAutomationTriggerByID deletes single entry from automationTrigger collection
+// DeleteAuthSessionByID deletes single entry from authSession collection
 //
 // This function is auto-generated
-func (s *Store) DeleteAutomationTriggerByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, automationTriggerDeleteQuery(s.Dialect, goqu.Ex{
+func (s *Store) DeleteAuthSessionByID(ctx context.Context, id string) error {
+	return s.Exec(ctx, authSessionDeleteQuery(s.Dialect, goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateAutomationTriggers Deletes all rows from the automationTrigger collection
-func (s Store) TruncateAutomationTriggers(ctx context.Context) error {
-	return s.Exec(ctx, automationTriggerTruncateQuery(s.Dialect))
+// TruncateAuthSessions Deletes all rows from the authSession collection
+func (s Store) TruncateAuthSessions(ctx context.Context) error {
+	return s.Exec(ctx, authSessionTruncateQuery(s.Dialect))
 }
 
-// SearchAutomationTriggers returns (filtered) set of AutomationTriggers
+// SearchAuthSessions returns (filtered) set of AuthSessions
 //
 // This function is auto-generated
-func (s *Store) SearchAutomationTriggers(ctx context.Context, f automationType.TriggerFilter) (set automationType.TriggerSet, _ automationType.TriggerFilter, err error) {
-
-	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
-	f.PrevPage, f.NextPage = nil, nil
-
-	if f.PageCursor != nil {
-		// Page cursor exists; we need to validate it against used sort
-		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
-		// from the cursor.
-		// This (extracted sorting info) is then returned as part of response
-		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
-			return
-		}
-	}
-
-	// Make sure results are always sorted at least by primary keys
-	if f.Sort.Get("id") == nil {
-		f.Sort = append(f.Sort, &filter.SortExpr{
-			Column:     "id",
-			Descending: f.Sort.LastDescending(),
-		})
-	}
-
-	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfAutomationTriggers fn used for cursor creation;
-	// direction information it MUST keep the initial
-	sort := f.Sort.Clone()
-
-	// When cursor for a previous page is used it's marked as reversed
-	// This tells us to flip the descending flag on all used sort keys
-	if f.PageCursor != nil && f.PageCursor.ROrder {
-		sort.Reverse()
-	}
-
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfAutomationTriggers(ctx, f, sort)
+func (s *Store) SearchAuthSessions(ctx context.Context, f systemType.AuthSessionFilter) (set systemType.AuthSessionSet, _ systemType.AuthSessionFilter, err error) {
 
-	f.PageCursor = nil
+	set, _, err = s.QueryAuthSessions(ctx, f)
 	if err != nil {
 		return nil, f, err
 	}
@@ -4533,191 +4312,41 @@ func (s *Store) SearchAutomationTriggers(ctx context.Context, f automationType.T
 	return set, f, nil
 }
 
-// fetchFullPageOfAutomationTriggers collects all requested results.
-//
-// Function applies:
-//  - cursor conditions (where ...)
-//  - limit
-//
-// Main responsibility of this function is to perform additional sequential queries in case when not enough results
-// are collected due to failed check on a specific row (by check fn).
-//
-// Function then moves cursor to the last item fetched
-//
-// This function is auto-generated
-func (s *Store) fetchFullPageOfAutomationTriggers(
-	ctx context.Context,
-	filter automationType.TriggerFilter,
-	sort filter.SortExprSet,
-) (set []*automationType.Trigger, prev, next *filter.PagingCursor, err error) {
-	var (
-		aux []*automationType.Trigger
-
-		// When cursor for a previous page is used it's marked as reversed
-		// This tells us to flip the descending flag on all used sort keys
-		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
-
-		// Copy no. of required items to limit
-		// Limit will change when doing subsequent queries to fill
-		// the set with all required items
-		limit = filter.Limit
-
-		reqItems = filter.Limit
-
-		// cursor to prev. page is only calculated when cursor is used
-		hasPrev = filter.PageCursor != nil
-
-		// next cursor is calculated when there are more pages to come
-		hasNext bool
-
-		tryFilter automationType.TriggerFilter
-	)
-
-	set = make([]*automationType.Trigger, 0, DefaultSliceCapacity)
-
-	for try := 0; try < MaxRefetches; try++ {
-		// Copy filter & apply custom sorting that might be affected by cursor
-		tryFilter = filter
-		tryFilter.Sort = sort
-
-		if limit > 0 {
-			// fetching + 1 to peak ahead if there are more items
-			// we can fetch (next-page cursor)
-			tryFilter.Limit = limit + 1
-		}
-
-		if aux, hasNext, err = s.QueryAutomationTriggers(ctx, tryFilter); err != nil {
-			return nil, nil, nil, err
-		}
-
-		if len(aux) == 0 {
-			// nothing fetched
-			break
-		}
-
-		// append fetched items
-		set = append(set, aux...)
-
-		if reqItems == 0 || !hasNext {
-			// no max requested items specified, break out
-			break
-		}
-
-		collected := uint(len(set))
-
-		if reqItems > collected {
-			// not enough items fetched, try again with adjusted limit
-			limit = reqItems - collected
-
-			if limit < MinEnsureFetchLimit {
-				// In case limit is set very low and we've missed records in the first fetch,
-				// make sure next fetch limit is a bit higher
-				limit = MinEnsureFetchLimit
-			}
-
-			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectAutomationTriggerCursorValues(set[collected-1], filter.Sort...)
-
-			// Copy reverse flag from sorting
-			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
-			continue
-		}
-
-		if reqItems < collected {
-			set = set[:reqItems]
-		}
-
-		break
-	}
-
-	collected := len(set)
-
-	if collected == 0 {
-		return nil, nil, nil, nil
-	}
-
-	if reversedOrder {
-		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
-		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
-			set[i], set[j] = set[j], set[i]
-		}
-
-		// when in reverse-order rules on what cursor to return change
-		hasPrev, hasNext = hasNext, hasPrev
-	}
-
-	if hasPrev {
-		prev = s.collectAutomationTriggerCursorValues(set[0], filter.Sort...)
-		prev.ROrder = true
-		prev.LThen = !filter.Sort.Reversed()
-	}
-
-	if hasNext {
-		next = s.collectAutomationTriggerCursorValues(set[collected-1], filter.Sort...)
-		next.LThen = filter.Sort.Reversed()
-	}
-
-	return set, prev, next, nil
-}
-
-// QueryAutomationTriggers queries the database, converts and checks each row and returns collected set
+// QueryAuthSessions queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryAutomationTriggers(
+func (s *Store) QueryAuthSessions(
 	ctx context.Context,
-	f automationType.TriggerFilter,
-) (_ []*automationType.Trigger, more bool, err error) {
+	f systemType.AuthSessionFilter,
+) (_ []*systemType.AuthSession, more bool, err error) {
 	var (
-		ok bool
-
-		set         = make([]*automationType.Trigger, 0, DefaultSliceCapacity)
-		res         *automationType.Trigger
-		aux         *auxAutomationTrigger
+		set         = make([]*systemType.AuthSession, 0, DefaultSliceCapacity)
+		res         *systemType.AuthSession
+		aux         *auxAuthSession
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
-
-		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.AutomationTrigger != nil {
+	if s.Filters.AuthSession != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.AutomationTrigger(s, f)
+		tExpr, f, err = s.Filters.AuthSession(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = AutomationTriggerFilter(f)
+		tExpr, f, err = AuthSessionFilter(f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for AutomationTrigger: %w", err)
+		err = fmt.Errorf("could generate filter expression for AuthSession: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	// paging feature is enabled
-	if f.PageCursor != nil {
-		if tExpr, err = cursor(f.PageCursor); err != nil {
-			return
-		} else {
-			expr = append(expr, tExpr...)
-		}
-	}
-
-	query := automationTriggerSelectQuery(s.Dialect).Where(expr...)
-
-	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableAutomationTriggerFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for AutomationTrigger: %w", err)
-		return
-	}
-
-	if len(sortExpr) > 0 {
-		query = query.Order(sortExpr...)
-	}
+	query := authSessionSelectQuery(s.Dialect).Where(expr...)
 
 	if f.Limit > 0 {
 		query = query.Limit(f.Limit)
@@ -4725,12 +4354,12 @@ func (s *Store) QueryAutomationTriggers(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query AutomationTrigger: %w", err)
+		err = fmt.Errorf("could not query AuthSession: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query AutomationTrigger: %w", err)
+		err = fmt.Errorf("could not query AuthSession: %w", err)
 		return
 	}
 
@@ -4744,49 +4373,37 @@ func (s *Store) QueryAutomationTriggers(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query AutomationTrigger: %w", err)
+			err = fmt.Errorf("could not query AuthSession: %w", err)
 			return
 		}
 
-		aux = new(auxAutomationTrigger)
+		aux = new(auxAuthSession)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for AutomationTrigger: %w", err)
+			err = fmt.Errorf("could not scan rows for AuthSession: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode AutomationTrigger: %w", err)
+			err = fmt.Errorf("could not decode AuthSession: %w", err)
 			return
 		}
 
-		// check fn set, call it and see if it passed the test
-		// if not, skip the item
-		if f.Check != nil {
-			if ok, err = f.Check(res); err != nil {
-				return
-			} else if !ok {
-				continue
-			}
-		}
-
 		set = append(set, res)
 	}
 
-	return set, f.Limit > 0 && count >= f.Limit, err
+	return set, false, err
 
 }
 
-// LookupAutomationTriggerByID searches for trigger by ID
-//
-// It returns trigger even if deleted
+// LookupAuthSessionByID
 //
 // This function is auto-generated
-func (s *Store) LookupAutomationTriggerByID(ctx context.Context, id uint64) (_ *automationType.Trigger, err error) {
+func (s *Store) LookupAuthSessionByID(ctx context.Context, id string) (_ *systemType.AuthSession, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxAutomationTrigger)
-		lookup = automationTriggerSelectQuery(s.Dialect).Where(
+		aux    = new(auxAuthSession)
+		lookup = authSessionSelectQuery(s.Dialect).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -4819,24 +4436,22 @@ func (s *Store) LookupAutomationTriggerByID(ctx context.Context, id uint64) (_ *
 	return aux.decode()
 }
 
-// sortableAutomationTriggerFields returns all <no value> columns flagged as sortable
+// sortableAuthSessionFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableAutomationTriggerFields() map[string]string {
+func (Store) sortableAuthSessionFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
-		"deleted_at": "deleted_at",
-		"deletedat":  "deleted_at",
+		"expires_at": "expires_at",
+		"expiresat":  "expires_at",
 		"id":         "id",
-		"updated_at": "updated_at",
-		"updatedat":  "updated_at",
 	}
 }
 
-// collectAutomationTriggerCursorValues collects values from the given resource that and sets them to the cursor
+// collectAuthSessionCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -4847,7 +4462,7 @@ func (Store) sortableAutomationTriggerFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectAutomationTriggerCursorValues(res *automationType.Trigger, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectAuthSessionCursorValues(res *systemType.AuthSession, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -4861,12 +4476,10 @@ func (s *Store) collectAutomationTriggerCursorValues(res *automationType.Trigger
 				case "id":
 					cur.Set(c.Column, res.ID, c.Descending)
 					pkID = true
+				case "expiresAt":
+					cur.Set(c.Column, res.ExpiresAt, c.Descending)
 				case "createdAt":
 					cur.Set(c.Column, res.CreatedAt, c.Descending)
-				case "updatedAt":
-					cur.Set(c.Column, res.UpdatedAt, c.Descending)
-				case "deletedAt":
-					cur.Set(c.Column, res.DeletedAt, c.Descending)
 				}
 			}
 		}
@@ -4881,27 +4494,27 @@ func (s *Store) collectAutomationTriggerCursorValues(res *automationType.Trigger
 
 }
 
-// checkAutomationTriggerConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkAuthSessionConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkAutomationTriggerConstraints(ctx context.Context, res *automationType.Trigger) (err error) {
+func (s *Store) checkAuthSessionConstraints(ctx context.Context, res *systemType.AuthSession) (err error) {
 	return nil
 }
 
-// CreateAutomationWorkflow creates one or more rows in automationWorkflow collection
+// CreateAutomationLease creates one or more rows in automationLease collection
 //
 // This function is auto-generated
-func (s *Store) CreateAutomationWorkflow(ctx context.Context, rr ...*automationType.Workflow) (err error) {
+func (s *Store) CreateAutomationLease(ctx context.Context, rr ...*automationType.Lease) (err error) {
 	for i := range rr {
-		if err = s.checkAutomationWorkflowConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationLeaseConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, automationWorkflowInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationLeaseInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -4909,16 +4522,16 @@ func (s *Store) CreateAutomationWorkflow(ctx context.Context, rr ...*automationT
 	return
 }
 
-// UpdateAutomationWorkflow updates one or more existing entries in automationWorkflow collection
+// UpdateAutomationLease updates one or more existing entries in automationLease collection
 //
 // This function is auto-generated
-func (s *Store) UpdateAutomationWorkflow(ctx context.Context, rr ...*automationType.Workflow) (err error) {
+func (s *Store) UpdateAutomationLease(ctx context.Context, rr ...*automationType.Lease) (err error) {
 	for i := range rr {
-		if err = s.checkAutomationWorkflowConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationLeaseConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, automationWorkflowUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationLeaseUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -4926,16 +4539,16 @@ func (s *Store) UpdateAutomationWorkflow(ctx context.Context, rr ...*automationT
 	return
 }
 
-// UpsertAutomationWorkflow updates one or more existing entries in automationWorkflow collection
+// UpsertAutomationLease updates one or more existing entries in automationLease collection
 //
 // This function is auto-generated
-func (s *Store) UpsertAutomationWorkflow(ctx context.Context, rr ...*automationType.Workflow) (err error) {
+func (s *Store) UpsertAutomationLease(ctx context.Context, rr ...*automationType.Lease) (err error) {
 	for i := range rr {
-		if err = s.checkAutomationWorkflowConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationLeaseConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, automationWorkflowUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationLeaseUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -4943,12 +4556,12 @@ func (s *Store) UpsertAutomationWorkflow(ctx context.Context, rr ...*automationT
 	return
 }
 
-// DeleteAutomationWorkflow Deletes one or more entries from automationWorkflow collection
+// DeleteAutomationLease Deletes one or more entries from automationLease collection
 //
 // This function is auto-generated
-func (s *Store) DeleteAutomationWorkflow(ctx context.Context, rr ...*automationType.Workflow) (err error) {
+func (s *Store) DeleteAutomationLease(ctx context.Context, rr ...*automationType.Lease) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, automationWorkflowDeleteQuery(s.Dialect, automationWorkflowPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, automationLeaseDeleteQuery(s.Dialect, automationLeasePrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -4956,60 +4569,26 @@ func (s *Store) DeleteAutomationWorkflow(ctx context.Context, rr ...*automationT
 	return nil
 }
 
-// DeleteAutomationWorkflowByID deletes single entry from automationWorkflow collection
+// DeleteAutomationLeaseByID deletes single entry from automationLease collection
 //
 // This function is auto-generated
-func (s *Store) DeleteAutomationWorkflowByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, automationWorkflowDeleteQuery(s.Dialect, goqu.Ex{
-		"id": id,
+func (s *Store) DeleteAutomationLeaseByName(ctx context.Context, name string) error {
+	return s.Exec(ctx, automationLeaseDeleteQuery(s.Dialect, goqu.Ex{
+		"name": name,
 	}))
 }
 
-// TruncateAutomationWorkflows Deletes all rows from the automationWorkflow collection
-func (s Store) TruncateAutomationWorkflows(ctx context.Context) error {
-	return s.Exec(ctx, automationWorkflowTruncateQuery(s.Dialect))
+// TruncateAutomationLeases Deletes all rows from the automationLease collection
+func (s Store) TruncateAutomationLeases(ctx context.Context) error {
+	return s.Exec(ctx, automationLeaseTruncateQuery(s.Dialect))
 }
 
-// SearchAutomationWorkflows returns (filtered) set of AutomationWorkflows
+// SearchAutomationLeases returns (filtered) set of AutomationLeases
 //
 // This function is auto-generated
-func (s *Store) SearchAutomationWorkflows(ctx context.Context, f automationType.WorkflowFilter) (set automationType.WorkflowSet, _ automationType.WorkflowFilter, err error) {
-
-	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
-	f.PrevPage, f.NextPage = nil, nil
-
-	if f.PageCursor != nil {
-		// Page cursor exists; we need to validate it against used sort
-		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
-		// from the cursor.
-		// This (extracted sorting info) is then returned as part of response
-		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
-			return
-		}
-	}
-
-	// Make sure results are always sorted at least by primary keys
-	if f.Sort.Get("id") == nil {
-		f.Sort = append(f.Sort, &filter.SortExpr{
-			Column:     "id",
-			Descending: f.Sort.LastDescending(),
-		})
-	}
-
-	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfAutomationWorkflows fn used for cursor creation;
-	// direction information it MUST keep the initial
-	sort := f.Sort.Clone()
-
-	// When cursor for a previous page is used it's marked as reversed
-	// This tells us to flip the descending flag on all used sort keys
-	if f.PageCursor != nil && f.PageCursor.ROrder {
-		sort.Reverse()
-	}
-
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfAutomationWorkflows(ctx, f, sort)
+func (s *Store) SearchAutomationLeases(ctx context.Context, f automationType.LeaseFilter) (set automationType.LeaseSet, _ automationType.LeaseFilter, err error) {
 
-	f.PageCursor = nil
+	set, _, err = s.QueryAutomationLeases(ctx, f)
 	if err != nil {
 		return nil, f, err
 	}
@@ -5017,191 +4596,41 @@ func (s *Store) SearchAutomationWorkflows(ctx context.Context, f automationType.
 	return set, f, nil
 }
 
-// fetchFullPageOfAutomationWorkflows collects all requested results.
-//
-// Function applies:
-//  - cursor conditions (where ...)
-//  - limit
-//
-// Main responsibility of this function is to perform additional sequential queries in case when not enough results
-// are collected due to failed check on a specific row (by check fn).
-//
-// Function then moves cursor to the last item fetched
-//
-// This function is auto-generated
-func (s *Store) fetchFullPageOfAutomationWorkflows(
-	ctx context.Context,
-	filter automationType.WorkflowFilter,
-	sort filter.SortExprSet,
-) (set []*automationType.Workflow, prev, next *filter.PagingCursor, err error) {
-	var (
-		aux []*automationType.Workflow
-
-		// When cursor for a previous page is used it's marked as reversed
-		// This tells us to flip the descending flag on all used sort keys
-		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
-
-		// Copy no. of required items to limit
-		// Limit will change when doing subsequent queries to fill
-		// the set with all required items
-		limit = filter.Limit
-
-		reqItems = filter.Limit
-
-		// cursor to prev. page is only calculated when cursor is used
-		hasPrev = filter.PageCursor != nil
-
-		// next cursor is calculated when there are more pages to come
-		hasNext bool
-
-		tryFilter automationType.WorkflowFilter
-	)
-
-	set = make([]*automationType.Workflow, 0, DefaultSliceCapacity)
-
-	for try := 0; try < MaxRefetches; try++ {
-		// Copy filter & apply custom sorting that might be affected by cursor
-		tryFilter = filter
-		tryFilter.Sort = sort
-
-		if limit > 0 {
-			// fetching + 1 to peak ahead if there are more items
-			// we can fetch (next-page cursor)
-			tryFilter.Limit = limit + 1
-		}
-
-		if aux, hasNext, err = s.QueryAutomationWorkflows(ctx, tryFilter); err != nil {
-			return nil, nil, nil, err
-		}
-
-		if len(aux) == 0 {
-			// nothing fetched
-			break
-		}
-
-		// append fetched items
-		set = append(set, aux...)
-
-		if reqItems == 0 || !hasNext {
-			// no max requested items specified, break out
-			break
-		}
-
-		collected := uint(len(set))
-
-		if reqItems > collected {
-			// not enough items fetched, try again with adjusted limit
-			limit = reqItems - collected
-
-			if limit < MinEnsureFetchLimit {
-				// In case limit is set very low and we've missed records in the first fetch,
-				// make sure next fetch limit is a bit higher
-				limit = MinEnsureFetchLimit
-			}
-
-			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectAutomationWorkflowCursorValues(set[collected-1], filter.Sort...)
-
-			// Copy reverse flag from sorting
-			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
-			continue
-		}
-
-		if reqItems < collected {
-			set = set[:reqItems]
-		}
-
-		break
-	}
-
-	collected := len(set)
-
-	if collected == 0 {
-		return nil, nil, nil, nil
-	}
-
-	if reversedOrder {
-		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
-		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
-			set[i], set[j] = set[j], set[i]
-		}
-
-		// when in reverse-order rules on what cursor to return change
-		hasPrev, hasNext = hasNext, hasPrev
-	}
-
-	if hasPrev {
-		prev = s.collectAutomationWorkflowCursorValues(set[0], filter.Sort...)
-		prev.ROrder = true
-		prev.LThen = !filter.Sort.Reversed()
-	}
-
-	if hasNext {
-		next = s.collectAutomationWorkflowCursorValues(set[collected-1], filter.Sort...)
-		next.LThen = filter.Sort.Reversed()
-	}
-
-	return set, prev, next, nil
-}
-
-// QueryAutomationWorkflows queries the database, converts and checks each row and returns collected set
+// QueryAutomationLeases queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryAutomationWorkflows(
+func (s *Store) QueryAutomationLeases(
 	ctx context.Context,
-	f automationType.WorkflowFilter,
-) (_ []*automationType.Workflow, more bool, err error) {
+	f automationType.LeaseFilter,
+) (_ []*automationType.Lease, more bool, err error) {
 	var (
-		ok bool
-
-		set         = make([]*automationType.Workflow, 0, DefaultSliceCapacity)
-		res         *automationType.Workflow
-		aux         *auxAutomationWorkflow
+		set         = make([]*automationType.Lease, 0, DefaultSliceCapacity)
+		res         *automationType.Lease
+		aux         *auxAutomationLease
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
-
-		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.AutomationWorkflow != nil {
+	if s.Filters.AutomationLease != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.AutomationWorkflow(s, f)
+		tExpr, f, err = s.Filters.AutomationLease(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = AutomationWorkflowFilter(f)
+		tExpr, f, err = AutomationLeaseFilter(f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for AutomationWorkflow: %w", err)
+		err = fmt.Errorf("could generate filter expression for AutomationLease: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	// paging feature is enabled
-	if f.PageCursor != nil {
-		if tExpr, err = cursor(f.PageCursor); err != nil {
-			return
-		} else {
-			expr = append(expr, tExpr...)
-		}
-	}
-
-	query := automationWorkflowSelectQuery(s.Dialect).Where(expr...)
-
-	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableAutomationWorkflowFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for AutomationWorkflow: %w", err)
-		return
-	}
-
-	if len(sortExpr) > 0 {
-		query = query.Order(sortExpr...)
-	}
+	query := automationLeaseSelectQuery(s.Dialect).Where(expr...)
 
 	if f.Limit > 0 {
 		query = query.Limit(f.Limit)
@@ -5209,12 +4638,12 @@ func (s *Store) QueryAutomationWorkflows(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query AutomationWorkflow: %w", err)
+		err = fmt.Errorf("could not query AutomationLease: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query AutomationWorkflow: %w", err)
+		err = fmt.Errorf("could not query AutomationLease: %w", err)
 		return
 	}
 
@@ -5228,93 +4657,40 @@ func (s *Store) QueryAutomationWorkflows(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query AutomationWorkflow: %w", err)
+			err = fmt.Errorf("could not query AutomationLease: %w", err)
 			return
 		}
 
-		aux = new(auxAutomationWorkflow)
+		aux = new(auxAutomationLease)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for AutomationWorkflow: %w", err)
+			err = fmt.Errorf("could not scan rows for AutomationLease: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode AutomationWorkflow: %w", err)
+			err = fmt.Errorf("could not decode AutomationLease: %w", err)
 			return
 		}
 
-		// check fn set, call it and see if it passed the test
-		// if not, skip the item
-		if f.Check != nil {
-			if ok, err = f.Check(res); err != nil {
-				return
-			} else if !ok {
-				continue
-			}
-		}
-
 		set = append(set, res)
 	}
 
-	return set, f.Limit > 0 && count >= f.Limit, err
-
-}
-
-// LookupAutomationWorkflowByID searches for workflow by ID
-//
-// It returns workflow even if deleted
-//
-// This function is auto-generated
-func (s *Store) LookupAutomationWorkflowByID(ctx context.Context, id uint64) (_ *automationType.Workflow, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxAutomationWorkflow)
-		lookup = automationWorkflowSelectQuery(s.Dialect).Where(
-			goqu.I("id").Eq(id),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
-	}
+	return set, false, err
 
-	return aux.decode()
 }
 
-// LookupAutomationWorkflowByHandle searches for workflow by their handle
+// LookupAutomationLeaseByName searches for lease by name
 //
-// It returns only valid workflows
+// It returns lease even if expired
 //
 // This function is auto-generated
-func (s *Store) LookupAutomationWorkflowByHandle(ctx context.Context, handle string) (_ *automationType.Workflow, err error) {
+func (s *Store) LookupAutomationLeaseByName(ctx context.Context, name string) (_ *automationType.Lease, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxAutomationWorkflow)
-		lookup = automationWorkflowSelectQuery(s.Dialect).Where(
-			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
-			goqu.I("deleted_at").IsNull(),
+		aux    = new(auxAutomationLease)
+		lookup = automationLeaseSelectQuery(s.Dialect).Where(
+			goqu.I("name").Eq(name),
 		).Limit(1)
 	)
 
@@ -5346,25 +4722,24 @@ func (s *Store) LookupAutomationWorkflowByHandle(ctx context.Context, handle str
 	return aux.decode()
 }
 
-// sortableAutomationWorkflowFields returns all <no value> columns flagged as sortable
+// sortableAutomationLeaseFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableAutomationWorkflowFields() map[string]string {
+func (Store) sortableAutomationLeaseFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
-		"deleted_at": "deleted_at",
-		"deletedat":  "deleted_at",
-		"handle":     "handle",
-		"id":         "id",
+		"expires_at": "expires_at",
+		"expiresat":  "expires_at",
+		"name":       "name",
 		"updated_at": "updated_at",
 		"updatedat":  "updated_at",
 	}
 }
 
-// collectAutomationWorkflowCursorValues collects values from the given resource that and sets them to the cursor
+// collectAutomationLeaseCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -5375,91 +4750,61 @@ func (Store) sortableAutomationWorkflowFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectAutomationWorkflowCursorValues(res *automationType.Workflow, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectAutomationLeaseCursorValues(res *automationType.Lease, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
 		hasUnique bool
 
-		pkID bool
+		pkName bool
 
 		collect = func(cc ...*filter.SortExpr) {
 			for _, c := range cc {
 				switch c.Column {
-				case "id":
-					cur.Set(c.Column, res.ID, c.Descending)
-					pkID = true
-				case "handle":
-					cur.Set(c.Column, res.Handle, c.Descending)
-					hasUnique = true
+				case "name":
+					cur.Set(c.Column, res.Name, c.Descending)
+					pkName = true
+				case "expiresAt":
+					cur.Set(c.Column, res.ExpiresAt, c.Descending)
 				case "createdAt":
 					cur.Set(c.Column, res.CreatedAt, c.Descending)
 				case "updatedAt":
 					cur.Set(c.Column, res.UpdatedAt, c.Descending)
-				case "deletedAt":
-					cur.Set(c.Column, res.DeletedAt, c.Descending)
 				}
 			}
 		}
 	)
 
 	collect(cc...)
-	if !hasUnique || !pkID {
-		collect(&filter.SortExpr{Column: "id", Descending: false})
+	if !hasUnique || !pkName {
+		collect(&filter.SortExpr{Column: "name", Descending: false})
 	}
 
 	return cur
 
 }
 
-// checkAutomationWorkflowConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkAutomationLeaseConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkAutomationWorkflowConstraints(ctx context.Context, res *automationType.Workflow) (err error) {
-	err = func() (err error) {
-
-		// handling string type as default
-		if len(res.Handle) == 0 {
-			// skip check on empty values
-			return nil
-		}
-
-		if res.DeletedAt != nil {
-			// skip check if value is not nil
-			return nil
-		}
-
-		ex, err := s.LookupAutomationWorkflowByHandle(ctx, res.Handle)
-		if err == nil && ex != nil && ex.ID != res.ID {
-			return store.ErrNotUnique.Stack(1)
-		} else if !errors.IsNotFound(err) {
-			return err
-		}
-
-		return nil
-	}()
-
-	if err != nil {
-		return
-	}
-
+func (s *Store) checkAutomationLeaseConstraints(ctx context.Context, res *automationType.Lease) (err error) {
 	return nil
 }
 
-// CreateComposeAttachment creates one or more rows in composeAttachment collection
+// CreateAutomationSession creates one or more rows in automationSession collection
 //
 // This function is auto-generated
-func (s *Store) CreateComposeAttachment(ctx context.Context, rr ...*composeType.Attachment) (err error) {
+func (s *Store) CreateAutomationSession(ctx context.Context, rr ...*automationType.Session) (err error) {
 	for i := range rr {
-		if err = s.checkComposeAttachmentConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationSessionConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeAttachmentInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationSessionInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -5467,16 +4812,16 @@ func (s *Store) CreateComposeAttachment(ctx context.Context, rr ...*composeType.
 	return
 }
 
-// UpdateComposeAttachment updates one or more existing entries in composeAttachment collection
+// UpdateAutomationSession updates one or more existing entries in automationSession collection
 //
 // This function is auto-generated
-func (s *Store) UpdateComposeAttachment(ctx context.Context, rr ...*composeType.Attachment) (err error) {
+func (s *Store) UpdateAutomationSession(ctx context.Context, rr ...*automationType.Session) (err error) {
 	for i := range rr {
-		if err = s.checkComposeAttachmentConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationSessionConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeAttachmentUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationSessionUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -5484,16 +4829,16 @@ func (s *Store) UpdateComposeAttachment(ctx context.Context, rr ...*composeType.
 	return
 }
 
-// UpsertComposeAttachment updates one or more existing entries in composeAttachment collection
+// UpsertAutomationSession updates one or more existing entries in automationSession collection
 //
 // This function is auto-generated
-func (s *Store) UpsertComposeAttachment(ctx context.Context, rr ...*composeType.Attachment) (err error) {
+func (s *Store) UpsertAutomationSession(ctx context.Context, rr ...*automationType.Session) (err error) {
 	for i := range rr {
-		if err = s.checkComposeAttachmentConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationSessionConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeAttachmentUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationSessionUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -5501,12 +4846,12 @@ func (s *Store) UpsertComposeAttachment(ctx context.Context, rr ...*composeType.
 	return
 }
 
-// DeleteComposeAttachment Deletes one or more entries from composeAttachment collection
+// DeleteAutomationSession Deletes one or more entries from automationSession collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeAttachment(ctx context.Context, rr ...*composeType.Attachment) (err error) {
+func (s *Store) DeleteAutomationSession(ctx context.Context, rr ...*automationType.Session) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, composeAttachmentDeleteQuery(s.Dialect, composeAttachmentPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, automationSessionDeleteQuery(s.Dialect, automationSessionPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -5514,24 +4859,24 @@ func (s *Store) DeleteComposeAttachment(ctx context.Context, rr ...*composeType.
 	return nil
 }
 
-// DeleteComposeAttachmentByID deletes single entry from composeAttachment collection
+// DeleteAutomationSessionByID deletes single entry from automationSession collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeAttachmentByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, composeAttachmentDeleteQuery(s.Dialect, goqu.Ex{
+func (s *Store) DeleteAutomationSessionByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, automationSessionDeleteQuery(s.Dialect, goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateComposeAttachments Deletes all rows from the composeAttachment collection
-func (s Store) TruncateComposeAttachments(ctx context.Context) error {
-	return s.Exec(ctx, composeAttachmentTruncateQuery(s.Dialect))
+// TruncateAutomationSessions Deletes all rows from the automationSession collection
+func (s Store) TruncateAutomationSessions(ctx context.Context) error {
+	return s.Exec(ctx, automationSessionTruncateQuery(s.Dialect))
 }
 
-// SearchComposeAttachments returns (filtered) set of ComposeAttachments
+// SearchAutomationSessions returns (filtered) set of AutomationSessions
 //
 // This function is auto-generated
-func (s *Store) SearchComposeAttachments(ctx context.Context, f composeType.AttachmentFilter) (set composeType.AttachmentSet, _ composeType.AttachmentFilter, err error) {
+func (s *Store) SearchAutomationSessions(ctx context.Context, f automationType.SessionFilter) (set automationType.SessionSet, _ automationType.SessionFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -5555,7 +4900,7 @@ func (s *Store) SearchComposeAttachments(ctx context.Context, f composeType.Atta
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfComposeAttachments fn used for cursor creation;
+	// Original are passed to the etchFullPageOfAutomationSessions fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -5565,7 +4910,7 @@ func (s *Store) SearchComposeAttachments(ctx context.Context, f composeType.Atta
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposeAttachments(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfAutomationSessions(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -5575,7 +4920,7 @@ func (s *Store) SearchComposeAttachments(ctx context.Context, f composeType.Atta
 	return set, f, nil
 }
 
-// fetchFullPageOfComposeAttachments collects all requested results.
+// fetchFullPageOfAutomationSessions collects all requested results.
 //
 // Function applies:
 //  - cursor conditions (where ...)
@@ -5587,13 +4932,13 @@ func (s *Store) SearchComposeAttachments(ctx context.Context, f composeType.Atta
 // Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfComposeAttachments(
+func (s *Store) fetchFullPageOfAutomationSessions(
 	ctx context.Context,
-	filter composeType.AttachmentFilter,
+	filter automationType.SessionFilter,
 	sort filter.SortExprSet,
-) (set []*composeType.Attachment, prev, next *filter.PagingCursor, err error) {
+) (set []*automationType.Session, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*composeType.Attachment
+		aux []*automationType.Session
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -5612,10 +4957,10 @@ func (s *Store) fetchFullPageOfComposeAttachments(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter composeType.AttachmentFilter
+		tryFilter automationType.SessionFilter
 	)
 
-	set = make([]*composeType.Attachment, 0, DefaultSliceCapacity)
+	set = make([]*automationType.Session, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -5628,7 +4973,7 @@ func (s *Store) fetchFullPageOfComposeAttachments(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryComposeAttachments(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryAutomationSessions(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -5658,7 +5003,7 @@ func (s *Store) fetchFullPageOfComposeAttachments(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectComposeAttachmentCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectAutomationSessionCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -5689,35 +5034,35 @@ func (s *Store) fetchFullPageOfComposeAttachments(
 	}
 
 	if hasPrev {
-		prev = s.collectComposeAttachmentCursorValues(set[0], filter.Sort...)
+		prev = s.collectAutomationSessionCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectComposeAttachmentCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectAutomationSessionCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryComposeAttachments queries the database, converts and checks each row and returns collected set
+// QueryAutomationSessions queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryComposeAttachments(
+func (s *Store) QueryAutomationSessions(
 	ctx context.Context,
-	f composeType.AttachmentFilter,
-) (_ []*composeType.Attachment, more bool, err error) {
+	f automationType.SessionFilter,
+) (_ []*automationType.Session, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*composeType.Attachment, 0, DefaultSliceCapacity)
-		res         *composeType.Attachment
-		aux         *auxComposeAttachment
+		set         = make([]*automationType.Session, 0, DefaultSliceCapacity)
+		res         *automationType.Session
+		aux         *auxAutomationSession
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -5725,16 +5070,16 @@ func (s *Store) QueryComposeAttachments(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.ComposeAttachment != nil {
+	if s.Filters.AutomationSession != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.ComposeAttachment(s, f)
+		tExpr, f, err = s.Filters.AutomationSession(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = ComposeAttachmentFilter(f)
+		tExpr, f, err = AutomationSessionFilter(f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for ComposeAttachment: %w", err)
+		err = fmt.Errorf("could generate filter expression for AutomationSession: %w", err)
 		return
 	}
 
@@ -5749,11 +5094,11 @@ func (s *Store) QueryComposeAttachments(
 		}
 	}
 
-	query := composeAttachmentSelectQuery(s.Dialect).Where(expr...)
+	query := automationSessionSelectQuery(s.Dialect).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableComposeAttachmentFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for ComposeAttachment: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableAutomationSessionFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for AutomationSession: %w", err)
 		return
 	}
 
@@ -5767,12 +5112,12 @@ func (s *Store) QueryComposeAttachments(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query ComposeAttachment: %w", err)
+		err = fmt.Errorf("could not query AutomationSession: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query ComposeAttachment: %w", err)
+		err = fmt.Errorf("could not query AutomationSession: %w", err)
 		return
 	}
 
@@ -5786,19 +5131,19 @@ func (s *Store) QueryComposeAttachments(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query ComposeAttachment: %w", err)
+			err = fmt.Errorf("could not query AutomationSession: %w", err)
 			return
 		}
 
-		aux = new(auxComposeAttachment)
+		aux = new(auxAutomationSession)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for ComposeAttachment: %w", err)
+			err = fmt.Errorf("could not scan rows for AutomationSession: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode ComposeAttachment: %w", err)
+			err = fmt.Errorf("could not decode AutomationSession: %w", err)
 			return
 		}
 
@@ -5819,14 +5164,16 @@ func (s *Store) QueryComposeAttachments(
 
 }
 
-// LookupComposeAttachmentByID
+// LookupAutomationSessionByID searches for session by ID
+//
+// It returns session even if deleted
 //
 // This function is auto-generated
-func (s *Store) LookupComposeAttachmentByID(ctx context.Context, id uint64) (_ *composeType.Attachment, err error) {
+func (s *Store) LookupAutomationSessionByID(ctx context.Context, id uint64) (_ *automationType.Session, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposeAttachment)
-		lookup = composeAttachmentSelectQuery(s.Dialect).Where(
+		aux    = new(auxAutomationSession)
+		lookup = automationSessionSelectQuery(s.Dialect).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -5859,24 +5206,26 @@ func (s *Store) LookupComposeAttachmentByID(ctx context.Context, id uint64) (_ *
 	return aux.decode()
 }
 
-// sortableComposeAttachmentFields returns all <no value> columns flagged as sortable
+// sortableAutomationSessionFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableComposeAttachmentFields() map[string]string {
+func (Store) sortableAutomationSessionFields() map[string]string {
 	return map[string]string{
-		"created_at": "created_at",
-		"createdat":  "created_at",
-		"deleted_at": "deleted_at",
-		"deletedat":  "deleted_at",
-		"id":         "id",
-		"updated_at": "updated_at",
-		"updatedat":  "updated_at",
-	}
-}
-
-// collectComposeAttachmentCursorValues collects values from the given resource that and sets them to the cursor
+		"completed_at": "completed_at",
+		"completedat":  "completed_at",
+		"created_at":   "created_at",
+		"createdat":    "created_at",
+		"id":           "id",
+		"purge_at":     "purge_at",
+		"purgeat":      "purge_at",
+		"suspended_at": "suspended_at",
+		"suspendedat":  "suspended_at",
+	}
+}
+
+// collectAutomationSessionCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -5887,7 +5236,7 @@ func (Store) sortableComposeAttachmentFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectComposeAttachmentCursorValues(res *composeType.Attachment, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectAutomationSessionCursorValues(res *automationType.Session, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -5903,10 +5252,12 @@ func (s *Store) collectComposeAttachmentCursorValues(res *composeType.Attachment
 					pkID = true
 				case "createdAt":
 					cur.Set(c.Column, res.CreatedAt, c.Descending)
-				case "updatedAt":
-					cur.Set(c.Column, res.UpdatedAt, c.Descending)
-				case "deletedAt":
-					cur.Set(c.Column, res.DeletedAt, c.Descending)
+				case "purgeAt":
+					cur.Set(c.Column, res.PurgeAt, c.Descending)
+				case "completedAt":
+					cur.Set(c.Column, res.CompletedAt, c.Descending)
+				case "suspendedAt":
+					cur.Set(c.Column, res.SuspendedAt, c.Descending)
 				}
 			}
 		}
@@ -5921,27 +5272,27 @@ func (s *Store) collectComposeAttachmentCursorValues(res *composeType.Attachment
 
 }
 
-// checkComposeAttachmentConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkAutomationSessionConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkComposeAttachmentConstraints(ctx context.Context, res *composeType.Attachment) (err error) {
+func (s *Store) checkAutomationSessionConstraints(ctx context.Context, res *automationType.Session) (err error) {
 	return nil
 }
 
-// CreateComposeChart creates one or more rows in composeChart collection
+// CreateAutomationSessionResume creates one or more rows in automationSessionResume collection
 //
 // This function is auto-generated
-func (s *Store) CreateComposeChart(ctx context.Context, rr ...*composeType.Chart) (err error) {
+func (s *Store) CreateAutomationSessionResume(ctx context.Context, rr ...*automationType.SessionResume) (err error) {
 	for i := range rr {
-		if err = s.checkComposeChartConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationSessionResumeConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeChartInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationSessionResumeInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -5949,16 +5300,16 @@ func (s *Store) CreateComposeChart(ctx context.Context, rr ...*composeType.Chart
 	return
 }
 
-// UpdateComposeChart updates one or more existing entries in composeChart collection
+// UpdateAutomationSessionResume updates one or more existing entries in automationSessionResume collection
 //
 // This function is auto-generated
-func (s *Store) UpdateComposeChart(ctx context.Context, rr ...*composeType.Chart) (err error) {
+func (s *Store) UpdateAutomationSessionResume(ctx context.Context, rr ...*automationType.SessionResume) (err error) {
 	for i := range rr {
-		if err = s.checkComposeChartConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationSessionResumeConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeChartUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationSessionResumeUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -5966,16 +5317,16 @@ func (s *Store) UpdateComposeChart(ctx context.Context, rr ...*composeType.Chart
 	return
 }
 
-// UpsertComposeChart updates one or more existing entries in composeChart collection
+// UpsertAutomationSessionResume updates one or more existing entries in automationSessionResume collection
 //
 // This function is auto-generated
-func (s *Store) UpsertComposeChart(ctx context.Context, rr ...*composeType.Chart) (err error) {
+func (s *Store) UpsertAutomationSessionResume(ctx context.Context, rr ...*automationType.SessionResume) (err error) {
 	for i := range rr {
-		if err = s.checkComposeChartConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationSessionResumeConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeChartUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationSessionResumeUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -5983,12 +5334,12 @@ func (s *Store) UpsertComposeChart(ctx context.Context, rr ...*composeType.Chart
 	return
 }
 
-// DeleteComposeChart Deletes one or more entries from composeChart collection
+// DeleteAutomationSessionResume Deletes one or more entries from automationSessionResume collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeChart(ctx context.Context, rr ...*composeType.Chart) (err error) {
+func (s *Store) DeleteAutomationSessionResume(ctx context.Context, rr ...*automationType.SessionResume) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, composeChartDeleteQuery(s.Dialect, composeChartPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, automationSessionResumeDeleteQuery(s.Dialect, automationSessionResumePrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -5996,60 +5347,26 @@ func (s *Store) DeleteComposeChart(ctx context.Context, rr ...*composeType.Chart
 	return nil
 }
 
-// DeleteComposeChartByID deletes single entry from composeChart collection
+// DeleteAutomationSessionResumeByID deletes single entry from automationSessionResume collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeChartByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, composeChartDeleteQuery(s.Dialect, goqu.Ex{
+func (s *Store) DeleteAutomationSessionResumeByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, automationSessionResumeDeleteQuery(s.Dialect, goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateComposeCharts Deletes all rows from the composeChart collection
-func (s Store) TruncateComposeCharts(ctx context.Context) error {
-	return s.Exec(ctx, composeChartTruncateQuery(s.Dialect))
+// TruncateAutomationSessionResumes Deletes all rows from the automationSessionResume collection
+func (s Store) TruncateAutomationSessionResumes(ctx context.Context) error {
+	return s.Exec(ctx, automationSessionResumeTruncateQuery(s.Dialect))
 }
 
-// SearchComposeCharts returns (filtered) set of ComposeCharts
+// SearchAutomationSessionResumes returns (filtered) set of AutomationSessionResumes
 //
 // This function is auto-generated
-func (s *Store) SearchComposeCharts(ctx context.Context, f composeType.ChartFilter) (set composeType.ChartSet, _ composeType.ChartFilter, err error) {
-
-	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
-	f.PrevPage, f.NextPage = nil, nil
-
-	if f.PageCursor != nil {
-		// Page cursor exists; we need to validate it against used sort
-		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
-		// from the cursor.
-		// This (extracted sorting info) is then returned as part of response
-		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
-			return
-		}
-	}
-
-	// Make sure results are always sorted at least by primary keys
-	if f.Sort.Get("id") == nil {
-		f.Sort = append(f.Sort, &filter.SortExpr{
-			Column:     "id",
-			Descending: f.Sort.LastDescending(),
-		})
-	}
-
-	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfComposeCharts fn used for cursor creation;
-	// direction information it MUST keep the initial
-	sort := f.Sort.Clone()
-
-	// When cursor for a previous page is used it's marked as reversed
-	// This tells us to flip the descending flag on all used sort keys
-	if f.PageCursor != nil && f.PageCursor.ROrder {
-		sort.Reverse()
-	}
-
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposeCharts(ctx, f, sort)
+func (s *Store) SearchAutomationSessionResumes(ctx context.Context, f automationType.SessionResumeFilter) (set automationType.SessionResumeSet, _ automationType.SessionResumeFilter, err error) {
 
-	f.PageCursor = nil
+	set, _, err = s.QueryAutomationSessionResumes(ctx, f)
 	if err != nil {
 		return nil, f, err
 	}
@@ -6057,191 +5374,41 @@ func (s *Store) SearchComposeCharts(ctx context.Context, f composeType.ChartFilt
 	return set, f, nil
 }
 
-// fetchFullPageOfComposeCharts collects all requested results.
-//
-// Function applies:
-//  - cursor conditions (where ...)
-//  - limit
-//
-// Main responsibility of this function is to perform additional sequential queries in case when not enough results
-// are collected due to failed check on a specific row (by check fn).
-//
-// Function then moves cursor to the last item fetched
-//
-// This function is auto-generated
-func (s *Store) fetchFullPageOfComposeCharts(
-	ctx context.Context,
-	filter composeType.ChartFilter,
-	sort filter.SortExprSet,
-) (set []*composeType.Chart, prev, next *filter.PagingCursor, err error) {
-	var (
-		aux []*composeType.Chart
-
-		// When cursor for a previous page is used it's marked as reversed
-		// This tells us to flip the descending flag on all used sort keys
-		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
-
-		// Copy no. of required items to limit
-		// Limit will change when doing subsequent queries to fill
-		// the set with all required items
-		limit = filter.Limit
-
-		reqItems = filter.Limit
-
-		// cursor to prev. page is only calculated when cursor is used
-		hasPrev = filter.PageCursor != nil
-
-		// next cursor is calculated when there are more pages to come
-		hasNext bool
-
-		tryFilter composeType.ChartFilter
-	)
-
-	set = make([]*composeType.Chart, 0, DefaultSliceCapacity)
-
-	for try := 0; try < MaxRefetches; try++ {
-		// Copy filter & apply custom sorting that might be affected by cursor
-		tryFilter = filter
-		tryFilter.Sort = sort
-
-		if limit > 0 {
-			// fetching + 1 to peak ahead if there are more items
-			// we can fetch (next-page cursor)
-			tryFilter.Limit = limit + 1
-		}
-
-		if aux, hasNext, err = s.QueryComposeCharts(ctx, tryFilter); err != nil {
-			return nil, nil, nil, err
-		}
-
-		if len(aux) == 0 {
-			// nothing fetched
-			break
-		}
-
-		// append fetched items
-		set = append(set, aux...)
-
-		if reqItems == 0 || !hasNext {
-			// no max requested items specified, break out
-			break
-		}
-
-		collected := uint(len(set))
-
-		if reqItems > collected {
-			// not enough items fetched, try again with adjusted limit
-			limit = reqItems - collected
-
-			if limit < MinEnsureFetchLimit {
-				// In case limit is set very low and we've missed records in the first fetch,
-				// make sure next fetch limit is a bit higher
-				limit = MinEnsureFetchLimit
-			}
-
-			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectComposeChartCursorValues(set[collected-1], filter.Sort...)
-
-			// Copy reverse flag from sorting
-			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
-			continue
-		}
-
-		if reqItems < collected {
-			set = set[:reqItems]
-		}
-
-		break
-	}
-
-	collected := len(set)
-
-	if collected == 0 {
-		return nil, nil, nil, nil
-	}
-
-	if reversedOrder {
-		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
-		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
-			set[i], set[j] = set[j], set[i]
-		}
-
-		// when in reverse-order rules on what cursor to return change
-		hasPrev, hasNext = hasNext, hasPrev
-	}
-
-	if hasPrev {
-		prev = s.collectComposeChartCursorValues(set[0], filter.Sort...)
-		prev.ROrder = true
-		prev.LThen = !filter.Sort.Reversed()
-	}
-
-	if hasNext {
-		next = s.collectComposeChartCursorValues(set[collected-1], filter.Sort...)
-		next.LThen = filter.Sort.Reversed()
-	}
-
-	return set, prev, next, nil
-}
-
-// QueryComposeCharts queries the database, converts and checks each row and returns collected set
+// QueryAutomationSessionResumes queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryComposeCharts(
+func (s *Store) QueryAutomationSessionResumes(
 	ctx context.Context,
-	f composeType.ChartFilter,
-) (_ []*composeType.Chart, more bool, err error) {
+	f automationType.SessionResumeFilter,
+) (_ []*automationType.SessionResume, more bool, err error) {
 	var (
-		ok bool
-
-		set         = make([]*composeType.Chart, 0, DefaultSliceCapacity)
-		res         *composeType.Chart
-		aux         *auxComposeChart
+		set         = make([]*automationType.SessionResume, 0, DefaultSliceCapacity)
+		res         *automationType.SessionResume
+		aux         *auxAutomationSessionResume
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
-
-		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.ComposeChart != nil {
+	if s.Filters.AutomationSessionResume != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.ComposeChart(s, f)
+		tExpr, f, err = s.Filters.AutomationSessionResume(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = ComposeChartFilter(f)
+		tExpr, f, err = AutomationSessionResumeFilter(f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for ComposeChart: %w", err)
+		err = fmt.Errorf("could generate filter expression for AutomationSessionResume: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	// paging feature is enabled
-	if f.PageCursor != nil {
-		if tExpr, err = cursor(f.PageCursor); err != nil {
-			return
-		} else {
-			expr = append(expr, tExpr...)
-		}
-	}
-
-	query := composeChartSelectQuery(s.Dialect).Where(expr...)
-
-	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableComposeChartFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for ComposeChart: %w", err)
-		return
-	}
-
-	if len(sortExpr) > 0 {
-		query = query.Order(sortExpr...)
-	}
+	query := automationSessionResumeSelectQuery(s.Dialect).Where(expr...)
 
 	if f.Limit > 0 {
 		query = query.Limit(f.Limit)
@@ -6249,12 +5416,12 @@ func (s *Store) QueryComposeCharts(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query ComposeChart: %w", err)
+		err = fmt.Errorf("could not query AutomationSessionResume: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query ComposeChart: %w", err)
+		err = fmt.Errorf("could not query AutomationSessionResume: %w", err)
 		return
 	}
 
@@ -6268,49 +5435,37 @@ func (s *Store) QueryComposeCharts(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query ComposeChart: %w", err)
+			err = fmt.Errorf("could not query AutomationSessionResume: %w", err)
 			return
 		}
 
-		aux = new(auxComposeChart)
+		aux = new(auxAutomationSessionResume)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for ComposeChart: %w", err)
+			err = fmt.Errorf("could not scan rows for AutomationSessionResume: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode ComposeChart: %w", err)
+			err = fmt.Errorf("could not decode AutomationSessionResume: %w", err)
 			return
 		}
 
-		// check fn set, call it and see if it passed the test
-		// if not, skip the item
-		if f.Check != nil {
-			if ok, err = f.Check(res); err != nil {
-				return
-			} else if !ok {
-				continue
-			}
-		}
-
 		set = append(set, res)
 	}
 
-	return set, f.Limit > 0 && count >= f.Limit, err
+	return set, false, err
 
 }
 
-// LookupComposeChartByID searches for compose chart by ID
-//
-// It returns compose chart even if deleted
+// LookupAutomationSessionResumeByID searches for session resume request by ID
 //
 // This function is auto-generated
-func (s *Store) LookupComposeChartByID(ctx context.Context, id uint64) (_ *composeType.Chart, err error) {
+func (s *Store) LookupAutomationSessionResumeByID(ctx context.Context, id uint64) (_ *automationType.SessionResume, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposeChart)
-		lookup = composeChartSelectQuery(s.Dialect).Where(
+		aux    = new(auxAutomationSessionResume)
+		lookup = automationSessionResumeSelectQuery(s.Dialect).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -6343,67 +5498,20 @@ func (s *Store) LookupComposeChartByID(ctx context.Context, id uint64) (_ *compo
 	return aux.decode()
 }
 
-// LookupComposeChartByNamespaceIDHandle searches for compose chart by handle (case-insensitive)
-//
-// This function is auto-generated
-func (s *Store) LookupComposeChartByNamespaceIDHandle(ctx context.Context, namespaceID uint64, handle string) (_ *composeType.Chart, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxComposeChart)
-		lookup = composeChartSelectQuery(s.Dialect).Where(
-			goqu.I("rel_namespace").Eq(namespaceID),
-			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
-			goqu.I("deleted_at").IsNull(),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
-	}
-
-	return aux.decode()
-}
-
-// sortableComposeChartFields returns all <no value> columns flagged as sortable
+// sortableAutomationSessionResumeFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableComposeChartFields() map[string]string {
+func (Store) sortableAutomationSessionResumeFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
-		"deleted_at": "deleted_at",
-		"deletedat":  "deleted_at",
-		"handle":     "handle",
 		"id":         "id",
-		"updated_at": "updated_at",
-		"updatedat":  "updated_at",
 	}
 }
 
-// collectComposeChartCursorValues collects values from the given resource that and sets them to the cursor
+// collectAutomationSessionResumeCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -6414,7 +5522,7 @@ func (Store) sortableComposeChartFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectComposeChartCursorValues(res *composeType.Chart, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectAutomationSessionResumeCursorValues(res *automationType.SessionResume, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -6428,15 +5536,8 @@ func (s *Store) collectComposeChartCursorValues(res *composeType.Chart, cc ...*f
 				case "id":
 					cur.Set(c.Column, res.ID, c.Descending)
 					pkID = true
-				case "handle":
-					cur.Set(c.Column, res.Handle, c.Descending)
-					hasUnique = true
 				case "createdAt":
 					cur.Set(c.Column, res.CreatedAt, c.Descending)
-				case "updatedAt":
-					cur.Set(c.Column, res.UpdatedAt, c.Descending)
-				case "deletedAt":
-					cur.Set(c.Column, res.DeletedAt, c.Descending)
 				}
 			}
 		}
@@ -6451,27 +5552,27 @@ func (s *Store) collectComposeChartCursorValues(res *composeType.Chart, cc ...*f
 
 }
 
-// checkComposeChartConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkAutomationSessionResumeConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkComposeChartConstraints(ctx context.Context, res *composeType.Chart) (err error) {
+func (s *Store) checkAutomationSessionResumeConstraints(ctx context.Context, res *automationType.SessionResume) (err error) {
 	return nil
 }
 
-// CreateComposeModule creates one or more rows in composeModule collection
+// CreateAutomationTask creates one or more rows in automationTask collection
 //
 // This function is auto-generated
-func (s *Store) CreateComposeModule(ctx context.Context, rr ...*composeType.Module) (err error) {
+func (s *Store) CreateAutomationTask(ctx context.Context, rr ...*automationType.Task) (err error) {
 	for i := range rr {
-		if err = s.checkComposeModuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationTaskConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeModuleInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationTaskInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -6479,16 +5580,16 @@ func (s *Store) CreateComposeModule(ctx context.Context, rr ...*composeType.Modu
 	return
 }
 
-// UpdateComposeModule updates one or more existing entries in composeModule collection
+// UpdateAutomationTask updates one or more existing entries in automationTask collection
 //
 // This function is auto-generated
-func (s *Store) UpdateComposeModule(ctx context.Context, rr ...*composeType.Module) (err error) {
+func (s *Store) UpdateAutomationTask(ctx context.Context, rr ...*automationType.Task) (err error) {
 	for i := range rr {
-		if err = s.checkComposeModuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationTaskConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeModuleUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationTaskUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -6496,16 +5597,16 @@ func (s *Store) UpdateComposeModule(ctx context.Context, rr ...*composeType.Modu
 	return
 }
 
-// UpsertComposeModule updates one or more existing entries in composeModule collection
+// UpsertAutomationTask updates one or more existing entries in automationTask collection
 //
 // This function is auto-generated
-func (s *Store) UpsertComposeModule(ctx context.Context, rr ...*composeType.Module) (err error) {
+func (s *Store) UpsertAutomationTask(ctx context.Context, rr ...*automationType.Task) (err error) {
 	for i := range rr {
-		if err = s.checkComposeModuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationTaskConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeModuleUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationTaskUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -6513,12 +5614,12 @@ func (s *Store) UpsertComposeModule(ctx context.Context, rr ...*composeType.Modu
 	return
 }
 
-// DeleteComposeModule Deletes one or more entries from composeModule collection
+// DeleteAutomationTask Deletes one or more entries from automationTask collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeModule(ctx context.Context, rr ...*composeType.Module) (err error) {
+func (s *Store) DeleteAutomationTask(ctx context.Context, rr ...*automationType.Task) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, composeModuleDeleteQuery(s.Dialect, composeModulePrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, automationTaskDeleteQuery(s.Dialect, automationTaskPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -6526,60 +5627,26 @@ func (s *Store) DeleteComposeModule(ctx context.Context, rr ...*composeType.Modu
 	return nil
 }
 
-// DeleteComposeModuleByID deletes single entry from composeModule collection
+// DeleteAutomationTaskByID deletes single entry from automationTask collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeModuleByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, composeModuleDeleteQuery(s.Dialect, goqu.Ex{
+func (s *Store) DeleteAutomationTaskByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, automationTaskDeleteQuery(s.Dialect, goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateComposeModules Deletes all rows from the composeModule collection
-func (s Store) TruncateComposeModules(ctx context.Context) error {
-	return s.Exec(ctx, composeModuleTruncateQuery(s.Dialect))
+// TruncateAutomationTasks Deletes all rows from the automationTask collection
+func (s Store) TruncateAutomationTasks(ctx context.Context) error {
+	return s.Exec(ctx, automationTaskTruncateQuery(s.Dialect))
 }
 
-// SearchComposeModules returns (filtered) set of ComposeModules
+// SearchAutomationTasks returns (filtered) set of AutomationTasks
 //
 // This function is auto-generated
-func (s *Store) SearchComposeModules(ctx context.Context, f composeType.ModuleFilter) (set composeType.ModuleSet, _ composeType.ModuleFilter, err error) {
-
-	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
-	f.PrevPage, f.NextPage = nil, nil
-
-	if f.PageCursor != nil {
-		// Page cursor exists; we need to validate it against used sort
-		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
-		// from the cursor.
-		// This (extracted sorting info) is then returned as part of response
-		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
-			return
-		}
-	}
-
-	// Make sure results are always sorted at least by primary keys
-	if f.Sort.Get("id") == nil {
-		f.Sort = append(f.Sort, &filter.SortExpr{
-			Column:     "id",
-			Descending: f.Sort.LastDescending(),
-		})
-	}
-
-	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfComposeModules fn used for cursor creation;
-	// direction information it MUST keep the initial
-	sort := f.Sort.Clone()
-
-	// When cursor for a previous page is used it's marked as reversed
-	// This tells us to flip the descending flag on all used sort keys
-	if f.PageCursor != nil && f.PageCursor.ROrder {
-		sort.Reverse()
-	}
-
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposeModules(ctx, f, sort)
+func (s *Store) SearchAutomationTasks(ctx context.Context, f automationType.TaskFilter) (set automationType.TaskSet, _ automationType.TaskFilter, err error) {
 
-	f.PageCursor = nil
+	set, _, err = s.QueryAutomationTasks(ctx, f)
 	if err != nil {
 		return nil, f, err
 	}
@@ -6587,310 +5654,108 @@ func (s *Store) SearchComposeModules(ctx context.Context, f composeType.ModuleFi
 	return set, f, nil
 }
 
-// fetchFullPageOfComposeModules collects all requested results.
-//
-// Function applies:
-//  - cursor conditions (where ...)
-//  - limit
-//
-// Main responsibility of this function is to perform additional sequential queries in case when not enough results
-// are collected due to failed check on a specific row (by check fn).
+// QueryAutomationTasks queries the database, converts and checks each row and returns collected set
 //
-// Function then moves cursor to the last item fetched
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfComposeModules(
+func (s *Store) QueryAutomationTasks(
 	ctx context.Context,
-	filter composeType.ModuleFilter,
-	sort filter.SortExprSet,
-) (set []*composeType.Module, prev, next *filter.PagingCursor, err error) {
+	f automationType.TaskFilter,
+) (_ []*automationType.Task, more bool, err error) {
 	var (
-		aux []*composeType.Module
-
-		// When cursor for a previous page is used it's marked as reversed
-		// This tells us to flip the descending flag on all used sort keys
-		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+		set         = make([]*automationType.Task, 0, DefaultSliceCapacity)
+		res         *automationType.Task
+		aux         *auxAutomationTask
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+	)
 
-		// Copy no. of required items to limit
-		// Limit will change when doing subsequent queries to fill
-		// the set with all required items
-		limit = filter.Limit
+	if s.Filters.AutomationTask != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.AutomationTask(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = AutomationTaskFilter(f)
+	}
 
-		reqItems = filter.Limit
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for AutomationTask: %w", err)
+		return
+	}
 
-		// cursor to prev. page is only calculated when cursor is used
-		hasPrev = filter.PageCursor != nil
+	expr = append(expr, tExpr...)
 
-		// next cursor is calculated when there are more pages to come
-		hasNext bool
+	query := automationTaskSelectQuery(s.Dialect).Where(expr...)
 
-		tryFilter composeType.ModuleFilter
-	)
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
 
-	set = make([]*composeType.Module, 0, DefaultSliceCapacity)
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query AutomationTask: %w", err)
+		return
+	}
 
-	for try := 0; try < MaxRefetches; try++ {
-		// Copy filter & apply custom sorting that might be affected by cursor
-		tryFilter = filter
-		tryFilter.Sort = sort
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query AutomationTask: %w", err)
+		return
+	}
 
-		if limit > 0 {
-			// fetching + 1 to peak ahead if there are more items
-			// we can fetch (next-page cursor)
-			tryFilter.Limit = limit + 1
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
 		}
+	}()
 
-		if aux, hasNext, err = s.QueryComposeModules(ctx, tryFilter); err != nil {
-			return nil, nil, nil, err
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query AutomationTask: %w", err)
+			return
 		}
 
-		if len(aux) == 0 {
-			// nothing fetched
-			break
+		aux = new(auxAutomationTask)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for AutomationTask: %w", err)
+			return
 		}
 
-		// append fetched items
-		set = append(set, aux...)
-
-		if reqItems == 0 || !hasNext {
-			// no max requested items specified, break out
-			break
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode AutomationTask: %w", err)
+			return
 		}
 
-		collected := uint(len(set))
+		set = append(set, res)
+	}
 
-		if reqItems > collected {
-			// not enough items fetched, try again with adjusted limit
-			limit = reqItems - collected
+	return set, false, err
 
-			if limit < MinEnsureFetchLimit {
-				// In case limit is set very low and we've missed records in the first fetch,
-				// make sure next fetch limit is a bit higher
-				limit = MinEnsureFetchLimit
-			}
+}
 
-			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectComposeModuleCursorValues(set[collected-1], filter.Sort...)
+// LookupAutomationTaskByID searches for task by ID
+//
+// It returns task even if completed
+//
+// This function is auto-generated
+func (s *Store) LookupAutomationTaskByID(ctx context.Context, id uint64) (_ *automationType.Task, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxAutomationTask)
+		lookup = automationTaskSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
 
-			// Copy reverse flag from sorting
-			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
-			continue
-		}
-
-		if reqItems < collected {
-			set = set[:reqItems]
-		}
-
-		break
-	}
-
-	collected := len(set)
-
-	if collected == 0 {
-		return nil, nil, nil, nil
-	}
-
-	if reversedOrder {
-		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
-		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
-			set[i], set[j] = set[j], set[i]
-		}
-
-		// when in reverse-order rules on what cursor to return change
-		hasPrev, hasNext = hasNext, hasPrev
-	}
-
-	if hasPrev {
-		prev = s.collectComposeModuleCursorValues(set[0], filter.Sort...)
-		prev.ROrder = true
-		prev.LThen = !filter.Sort.Reversed()
-	}
-
-	if hasNext {
-		next = s.collectComposeModuleCursorValues(set[collected-1], filter.Sort...)
-		next.LThen = filter.Sort.Reversed()
-	}
-
-	return set, prev, next, nil
-}
-
-// QueryComposeModules queries the database, converts and checks each row and returns collected set
-//
-// With generics, we can remove this per-resource-generated function
-// and replace it with a single utility fetcher
-//
-// This function is auto-generated
-func (s *Store) QueryComposeModules(
-	ctx context.Context,
-	f composeType.ModuleFilter,
-) (_ []*composeType.Module, more bool, err error) {
-	var (
-		ok bool
-
-		set         = make([]*composeType.Module, 0, DefaultSliceCapacity)
-		res         *composeType.Module
-		aux         *auxComposeModule
-		rows        *sql.Rows
-		count       uint
-		expr, tExpr []goqu.Expression
-
-		sortExpr []exp.OrderedExpression
-	)
-
-	if s.Filters.ComposeModule != nil {
-		// extended filter set
-		tExpr, f, err = s.Filters.ComposeModule(s, f)
-	} else {
-		// using generated filter
-		tExpr, f, err = ComposeModuleFilter(f)
-	}
-
-	if err != nil {
-		err = fmt.Errorf("could generate filter expression for ComposeModule: %w", err)
-		return
-	}
-
-	expr = append(expr, tExpr...)
-
-	// paging feature is enabled
-	if f.PageCursor != nil {
-		if tExpr, err = cursor(f.PageCursor); err != nil {
-			return
-		} else {
-			expr = append(expr, tExpr...)
-		}
-	}
-
-	query := composeModuleSelectQuery(s.Dialect).Where(expr...)
-
-	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableComposeModuleFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for ComposeModule: %w", err)
-		return
-	}
-
-	if len(sortExpr) > 0 {
-		query = query.Order(sortExpr...)
-	}
-
-	if f.Limit > 0 {
-		query = query.Limit(f.Limit)
-	}
-
-	rows, err = s.Query(ctx, query)
-	if err != nil {
-		err = fmt.Errorf("could not query ComposeModule: %w", err)
-		return
-	}
-
-	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query ComposeModule: %w", err)
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	for rows.Next() {
-		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query ComposeModule: %w", err)
-			return
-		}
-
-		aux = new(auxComposeModule)
-		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for ComposeModule: %w", err)
-			return
-		}
-
-		count++
-		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode ComposeModule: %w", err)
-			return
-		}
-
-		// check fn set, call it and see if it passed the test
-		// if not, skip the item
-		if f.Check != nil {
-			if ok, err = f.Check(res); err != nil {
-				return
-			} else if !ok {
-				continue
-			}
-		}
-
-		set = append(set, res)
-	}
-
-	return set, f.Limit > 0 && count >= f.Limit, err
-
-}
-
-// LookupComposeModuleByNamespaceIDHandle searches for compose module by handle (case-insensitive)
-//
-// This function is auto-generated
-func (s *Store) LookupComposeModuleByNamespaceIDHandle(ctx context.Context, namespaceID uint64, handle string) (_ *composeType.Module, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxComposeModule)
-		lookup = composeModuleSelectQuery(s.Dialect).Where(
-			goqu.I("rel_namespace").Eq(namespaceID),
-			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
-			goqu.I("deleted_at").IsNull(),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
-	}
-
-	return aux.decode()
-}
-
-// LookupComposeModuleByNamespaceIDName searches for compose module by name (case-insensitive)
-//
-// This function is auto-generated
-func (s *Store) LookupComposeModuleByNamespaceIDName(ctx context.Context, namespaceID uint64, name string) (_ *composeType.Module, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxComposeModule)
-		lookup = composeModuleSelectQuery(s.Dialect).Where(
-			goqu.I("rel_namespace").Eq(namespaceID),
-			goqu.I("name").Eq(name),
-			goqu.I("deleted_at").IsNull(),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
 
 	defer func() {
 		closeError := rows.Close()
@@ -6915,17 +5780,16 @@ func (s *Store) LookupComposeModuleByNamespaceIDName(ctx context.Context, namesp
 	return aux.decode()
 }
 
-// LookupComposeModuleByID searches for compose module by ID
-//
-// It returns compose module even if deleted
+// LookupAutomationTaskBySessionIDStateID searches for task of the prompted session state
 //
 // This function is auto-generated
-func (s *Store) LookupComposeModuleByID(ctx context.Context, id uint64) (_ *composeType.Module, err error) {
+func (s *Store) LookupAutomationTaskBySessionIDStateID(ctx context.Context, sessionID uint64, stateID uint64) (_ *automationType.Task, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposeModule)
-		lookup = composeModuleSelectQuery(s.Dialect).Where(
-			goqu.I("id").Eq(id),
+		aux    = new(auxAutomationTask)
+		lookup = automationTaskSelectQuery(s.Dialect).Where(
+			goqu.I("rel_session").Eq(sessionID),
+			goqu.I("rel_state").Eq(stateID),
 		).Limit(1)
 	)
 
@@ -6957,25 +5821,26 @@ func (s *Store) LookupComposeModuleByID(ctx context.Context, id uint64) (_ *comp
 	return aux.decode()
 }
 
-// sortableComposeModuleFields returns all <no value> columns flagged as sortable
+// sortableAutomationTaskFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableComposeModuleFields() map[string]string {
+func (Store) sortableAutomationTaskFields() map[string]string {
 	return map[string]string{
-		"created_at": "created_at",
-		"createdat":  "created_at",
-		"deleted_at": "deleted_at",
-		"deletedat":  "deleted_at",
-		"handle":     "handle",
-		"id":         "id",
-		"updated_at": "updated_at",
-		"updatedat":  "updated_at",
+		"completed_at": "completed_at",
+		"completedat":  "completed_at",
+		"created_at":   "created_at",
+		"createdat":    "created_at",
+		"due_at":       "due_at",
+		"dueat":        "due_at",
+		"id":           "id",
+		"updated_at":   "updated_at",
+		"updatedat":    "updated_at",
 	}
 }
 
-// collectComposeModuleCursorValues collects values from the given resource that and sets them to the cursor
+// collectAutomationTaskCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -6986,7 +5851,7 @@ func (Store) sortableComposeModuleFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectComposeModuleCursorValues(res *composeType.Module, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectAutomationTaskCursorValues(res *automationType.Task, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -7000,15 +5865,14 @@ func (s *Store) collectComposeModuleCursorValues(res *composeType.Module, cc ...
 				case "id":
 					cur.Set(c.Column, res.ID, c.Descending)
 					pkID = true
-				case "handle":
-					cur.Set(c.Column, res.Handle, c.Descending)
-					hasUnique = true
+				case "dueAt":
+					cur.Set(c.Column, res.DueAt, c.Descending)
 				case "createdAt":
 					cur.Set(c.Column, res.CreatedAt, c.Descending)
 				case "updatedAt":
 					cur.Set(c.Column, res.UpdatedAt, c.Descending)
-				case "deletedAt":
-					cur.Set(c.Column, res.DeletedAt, c.Descending)
+				case "completedAt":
+					cur.Set(c.Column, res.CompletedAt, c.Descending)
 				}
 			}
 		}
@@ -7023,59 +5887,27 @@ func (s *Store) collectComposeModuleCursorValues(res *composeType.Module, cc ...
 
 }
 
-// checkComposeModuleConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkAutomationTaskConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkComposeModuleConstraints(ctx context.Context, res *composeType.Module) (err error) {
-	err = func() (err error) {
-
-		if res.NamespaceID == 0 {
-			// skip check on empty values
-			return nil
-		}
+func (s *Store) checkAutomationTaskConstraints(ctx context.Context, res *automationType.Task) (err error) {
+	return nil
+}
 
-		// handling string type as default
-		if len(res.Handle) == 0 {
-			// skip check on empty values
-			return nil
-		}
-
-		if res.DeletedAt != nil {
-			// skip check if value is not nil
-			return nil
-		}
-
-		ex, err := s.LookupComposeModuleByNamespaceIDHandle(ctx, res.NamespaceID, res.Handle)
-		if err == nil && ex != nil && ex.ID != res.ID {
-			return store.ErrNotUnique.Stack(1)
-		} else if !errors.IsNotFound(err) {
-			return err
-		}
-
-		return nil
-	}()
-
-	if err != nil {
-		return
-	}
-
-	return nil
-}
-
-// CreateComposeModuleField creates one or more rows in composeModuleField collection
+// CreateAutomationTrigger creates one or more rows in automationTrigger collection
 //
 // This function is auto-generated
-func (s *Store) CreateComposeModuleField(ctx context.Context, rr ...*composeType.ModuleField) (err error) {
+func (s *Store) CreateAutomationTrigger(ctx context.Context, rr ...*automationType.Trigger) (err error) {
 	for i := range rr {
-		if err = s.checkComposeModuleFieldConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationTriggerConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeModuleFieldInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationTriggerInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -7083,16 +5915,16 @@ func (s *Store) CreateComposeModuleField(ctx context.Context, rr ...*composeType
 	return
 }
 
-// UpdateComposeModuleField updates one or more existing entries in composeModuleField collection
+// UpdateAutomationTrigger updates one or more existing entries in automationTrigger collection
 //
 // This function is auto-generated
-func (s *Store) UpdateComposeModuleField(ctx context.Context, rr ...*composeType.ModuleField) (err error) {
+func (s *Store) UpdateAutomationTrigger(ctx context.Context, rr ...*automationType.Trigger) (err error) {
 	for i := range rr {
-		if err = s.checkComposeModuleFieldConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationTriggerConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeModuleFieldUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationTriggerUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -7100,16 +5932,16 @@ func (s *Store) UpdateComposeModuleField(ctx context.Context, rr ...*composeType
 	return
 }
 
-// UpsertComposeModuleField updates one or more existing entries in composeModuleField collection
+// UpsertAutomationTrigger updates one or more existing entries in automationTrigger collection
 //
 // This function is auto-generated
-func (s *Store) UpsertComposeModuleField(ctx context.Context, rr ...*composeType.ModuleField) (err error) {
+func (s *Store) UpsertAutomationTrigger(ctx context.Context, rr ...*automationType.Trigger) (err error) {
 	for i := range rr {
-		if err = s.checkComposeModuleFieldConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationTriggerConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeModuleFieldUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationTriggerUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -7117,12 +5949,12 @@ func (s *Store) UpsertComposeModuleField(ctx context.Context, rr ...*composeType
 	return
 }
 
-// DeleteComposeModuleField Deletes one or more entries from composeModuleField collection
+// DeleteAutomationTrigger Deletes one or more entries from automationTrigger collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeModuleField(ctx context.Context, rr ...*composeType.ModuleField) (err error) {
+func (s *Store) DeleteAutomationTrigger(ctx context.Context, rr ...*automationType.Trigger) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, composeModuleFieldDeleteQuery(s.Dialect, composeModuleFieldPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, automationTriggerDeleteQuery(s.Dialect, automationTriggerPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -7130,26 +5962,60 @@ func (s *Store) DeleteComposeModuleField(ctx context.Context, rr ...*composeType
 	return nil
 }
 
-// DeleteComposeModuleFieldByID deletes single entry from composeModuleField collection
+// DeleteAutomationTriggerByID deletes single entry from automationTrigger collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeModuleFieldByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, composeModuleFieldDeleteQuery(s.Dialect, goqu.Ex{
+func (s *Store) DeleteAutomationTriggerByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, automationTriggerDeleteQuery(s.Dialect, goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateComposeModuleFields Deletes all rows from the composeModuleField collection
-func (s Store) TruncateComposeModuleFields(ctx context.Context) error {
-	return s.Exec(ctx, composeModuleFieldTruncateQuery(s.Dialect))
+// TruncateAutomationTriggers Deletes all rows from the automationTrigger collection
+func (s Store) TruncateAutomationTriggers(ctx context.Context) error {
+	return s.Exec(ctx, automationTriggerTruncateQuery(s.Dialect))
 }
 
-// SearchComposeModuleFields returns (filtered) set of ComposeModuleFields
+// SearchAutomationTriggers returns (filtered) set of AutomationTriggers
 //
 // This function is auto-generated
-func (s *Store) SearchComposeModuleFields(ctx context.Context, f composeType.ModuleFieldFilter) (set composeType.ModuleFieldSet, _ composeType.ModuleFieldFilter, err error) {
+func (s *Store) SearchAutomationTriggers(ctx context.Context, f automationType.TriggerFilter) (set automationType.TriggerSet, _ automationType.TriggerFilter, err error) {
 
-	set, _, err = s.QueryComposeModuleFields(ctx, f)
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfAutomationTriggers fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfAutomationTriggers(ctx, f, sort)
+
+	f.PageCursor = nil
 	if err != nil {
 		return nil, f, err
 	}
@@ -7157,107 +6023,267 @@ func (s *Store) SearchComposeModuleFields(ctx context.Context, f composeType.Mod
 	return set, f, nil
 }
 
-// QueryComposeModuleFields queries the database, converts and checks each row and returns collected set
+// fetchFullPageOfAutomationTriggers collects all requested results.
 //
-// With generics, we can remove this per-resource-generated function
-// and replace it with a single utility fetcher
+// Function applies:
+//  - cursor conditions (where ...)
+//  - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) QueryComposeModuleFields(
+func (s *Store) fetchFullPageOfAutomationTriggers(
 	ctx context.Context,
-	f composeType.ModuleFieldFilter,
-) (_ []*composeType.ModuleField, more bool, err error) {
+	filter automationType.TriggerFilter,
+	sort filter.SortExprSet,
+) (set []*automationType.Trigger, prev, next *filter.PagingCursor, err error) {
 	var (
-		set         = make([]*composeType.ModuleField, 0, DefaultSliceCapacity)
-		res         *composeType.ModuleField
-		aux         *auxComposeModuleField
-		rows        *sql.Rows
-		count       uint
-		expr, tExpr []goqu.Expression
-	)
+		aux []*automationType.Trigger
 
-	if s.Filters.ComposeModuleField != nil {
-		// extended filter set
-		tExpr, f, err = s.Filters.ComposeModuleField(s, f)
-	} else {
-		// using generated filter
-		tExpr, f, err = ComposeModuleFieldFilter(f)
-	}
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
 
-	if err != nil {
-		err = fmt.Errorf("could generate filter expression for ComposeModuleField: %w", err)
-		return
-	}
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
 
-	expr = append(expr, tExpr...)
+		reqItems = filter.Limit
 
-	query := composeModuleFieldSelectQuery(s.Dialect).Where(expr...)
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
 
-	if f.Limit > 0 {
-		query = query.Limit(f.Limit)
-	}
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
 
-	rows, err = s.Query(ctx, query)
-	if err != nil {
-		err = fmt.Errorf("could not query ComposeModuleField: %w", err)
-		return
-	}
+		tryFilter automationType.TriggerFilter
+	)
 
-	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query ComposeModuleField: %w", err)
-		return
-	}
+	set = make([]*automationType.Trigger, 0, DefaultSliceCapacity)
 
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
 		}
-	}()
 
-	for rows.Next() {
-		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query ComposeModuleField: %w", err)
-			return
+		if aux, hasNext, err = s.QueryAutomationTriggers(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
 		}
 
-		aux = new(auxComposeModuleField)
-		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for ComposeModuleField: %w", err)
-			return
+		if len(aux) == 0 {
+			// nothing fetched
+			break
 		}
 
-		count++
-		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode ComposeModuleField: %w", err)
-			return
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
 		}
 
-		set = append(set, res)
-	}
+		collected := uint(len(set))
 
-	return set, false, err
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
 
-}
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
 
-// LookupComposeModuleFieldByModuleIDName searches for compose module field by name (case-insensitive)
-//
-// This function is auto-generated
-func (s *Store) LookupComposeModuleFieldByModuleIDName(ctx context.Context, moduleID uint64, name string) (_ *composeType.ModuleField, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxComposeModuleField)
-		lookup = composeModuleFieldSelectQuery(s.Dialect).Where(
-			goqu.I("rel_module").Eq(moduleID),
-			goqu.I("name").Eq(name),
-			goqu.I("deleted_at").IsNull(),
-		).Limit(1)
-	)
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectAutomationTriggerCursorValues(set[collected-1], filter.Sort...)
 
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
+		}
+
+		break
+	}
+
+	collected := len(set)
+
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
+
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
+
+	if hasPrev {
+		prev = s.collectAutomationTriggerCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
+	}
+
+	if hasNext {
+		next = s.collectAutomationTriggerCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
+}
+
+// QueryAutomationTriggers queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryAutomationTriggers(
+	ctx context.Context,
+	f automationType.TriggerFilter,
+) (_ []*automationType.Trigger, more bool, err error) {
+	var (
+		ok bool
+
+		set         = make([]*automationType.Trigger, 0, DefaultSliceCapacity)
+		res         *automationType.Trigger
+		aux         *auxAutomationTrigger
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
+	)
+
+	if s.Filters.AutomationTrigger != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.AutomationTrigger(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = AutomationTriggerFilter(f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for AutomationTrigger: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursor(f.PageCursor); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := automationTriggerSelectQuery(s.Dialect).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableAutomationTriggerFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for AutomationTrigger: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query AutomationTrigger: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query AutomationTrigger: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query AutomationTrigger: %w", err)
+			return
+		}
+
+		aux = new(auxAutomationTrigger)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for AutomationTrigger: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode AutomationTrigger: %w", err)
+			return
+		}
+
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
+		}
+
+		set = append(set, res)
+	}
+
+	return set, f.Limit > 0 && count >= f.Limit, err
+
+}
+
+// LookupAutomationTriggerByID searches for trigger by ID
+//
+// It returns trigger even if deleted
+//
+// This function is auto-generated
+func (s *Store) LookupAutomationTriggerByID(ctx context.Context, id uint64) (_ *automationType.Trigger, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxAutomationTrigger)
+		lookup = automationTriggerSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
 	}
 
 	defer func() {
@@ -7283,18 +6309,24 @@ func (s *Store) LookupComposeModuleFieldByModuleIDName(ctx context.Context, modu
 	return aux.decode()
 }
 
-// sortableComposeModuleFieldFields returns all <no value> columns flagged as sortable
+// sortableAutomationTriggerFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableComposeModuleFieldFields() map[string]string {
+func (Store) sortableAutomationTriggerFields() map[string]string {
 	return map[string]string{
-		"id": "id",
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"id":         "id",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
 	}
 }
 
-// collectComposeModuleFieldCursorValues collects values from the given resource that and sets them to the cursor
+// collectAutomationTriggerCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -7305,7 +6337,7 @@ func (Store) sortableComposeModuleFieldFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectComposeModuleFieldCursorValues(res *composeType.ModuleField, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectAutomationTriggerCursorValues(res *automationType.Trigger, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -7319,6 +6351,12 @@ func (s *Store) collectComposeModuleFieldCursorValues(res *composeType.ModuleFie
 				case "id":
 					cur.Set(c.Column, res.ID, c.Descending)
 					pkID = true
+				case "createdAt":
+					cur.Set(c.Column, res.CreatedAt, c.Descending)
+				case "updatedAt":
+					cur.Set(c.Column, res.UpdatedAt, c.Descending)
+				case "deletedAt":
+					cur.Set(c.Column, res.DeletedAt, c.Descending)
 				}
 			}
 		}
@@ -7333,59 +6371,27 @@ func (s *Store) collectComposeModuleFieldCursorValues(res *composeType.ModuleFie
 
 }
 
-// checkComposeModuleFieldConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkAutomationTriggerConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkComposeModuleFieldConstraints(ctx context.Context, res *composeType.ModuleField) (err error) {
-	err = func() (err error) {
-
-		if res.ModuleID == 0 {
-			// skip check on empty values
-			return nil
-		}
-
-		// handling string type as default
-		if len(res.Name) == 0 {
-			// skip check on empty values
-			return nil
-		}
-
-		if res.DeletedAt != nil {
-			// skip check if value is not nil
-			return nil
-		}
-
-		ex, err := s.LookupComposeModuleFieldByModuleIDName(ctx, res.ModuleID, res.Name)
-		if err == nil && ex != nil && ex.ID != res.ID {
-			return store.ErrNotUnique.Stack(1)
-		} else if !errors.IsNotFound(err) {
-			return err
-		}
-
-		return nil
-	}()
-
-	if err != nil {
-		return
-	}
-
+func (s *Store) checkAutomationTriggerConstraints(ctx context.Context, res *automationType.Trigger) (err error) {
 	return nil
 }
 
-// CreateComposeNamespace creates one or more rows in composeNamespace collection
+// CreateAutomationWorkflow creates one or more rows in automationWorkflow collection
 //
 // This function is auto-generated
-func (s *Store) CreateComposeNamespace(ctx context.Context, rr ...*composeType.Namespace) (err error) {
+func (s *Store) CreateAutomationWorkflow(ctx context.Context, rr ...*automationType.Workflow) (err error) {
 	for i := range rr {
-		if err = s.checkComposeNamespaceConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationWorkflowConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeNamespaceInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationWorkflowInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -7393,16 +6399,16 @@ func (s *Store) CreateComposeNamespace(ctx context.Context, rr ...*composeType.N
 	return
 }
 
-// UpdateComposeNamespace updates one or more existing entries in composeNamespace collection
+// UpdateAutomationWorkflow updates one or more existing entries in automationWorkflow collection
 //
 // This function is auto-generated
-func (s *Store) UpdateComposeNamespace(ctx context.Context, rr ...*composeType.Namespace) (err error) {
+func (s *Store) UpdateAutomationWorkflow(ctx context.Context, rr ...*automationType.Workflow) (err error) {
 	for i := range rr {
-		if err = s.checkComposeNamespaceConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationWorkflowConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeNamespaceUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationWorkflowUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -7410,16 +6416,16 @@ func (s *Store) UpdateComposeNamespace(ctx context.Context, rr ...*composeType.N
 	return
 }
 
-// UpsertComposeNamespace updates one or more existing entries in composeNamespace collection
+// UpsertAutomationWorkflow updates one or more existing entries in automationWorkflow collection
 //
 // This function is auto-generated
-func (s *Store) UpsertComposeNamespace(ctx context.Context, rr ...*composeType.Namespace) (err error) {
+func (s *Store) UpsertAutomationWorkflow(ctx context.Context, rr ...*automationType.Workflow) (err error) {
 	for i := range rr {
-		if err = s.checkComposeNamespaceConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationWorkflowConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeNamespaceUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationWorkflowUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -7427,12 +6433,12 @@ func (s *Store) UpsertComposeNamespace(ctx context.Context, rr ...*composeType.N
 	return
 }
 
-// DeleteComposeNamespace Deletes one or more entries from composeNamespace collection
+// DeleteAutomationWorkflow Deletes one or more entries from automationWorkflow collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeNamespace(ctx context.Context, rr ...*composeType.Namespace) (err error) {
+func (s *Store) DeleteAutomationWorkflow(ctx context.Context, rr ...*automationType.Workflow) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, composeNamespaceDeleteQuery(s.Dialect, composeNamespacePrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, automationWorkflowDeleteQuery(s.Dialect, automationWorkflowPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -7440,24 +6446,24 @@ func (s *Store) DeleteComposeNamespace(ctx context.Context, rr ...*composeType.N
 	return nil
 }
 
-// DeleteComposeNamespaceByID deletes single entry from composeNamespace collection
+// DeleteAutomationWorkflowByID deletes single entry from automationWorkflow collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeNamespaceByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, composeNamespaceDeleteQuery(s.Dialect, goqu.Ex{
+func (s *Store) DeleteAutomationWorkflowByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, automationWorkflowDeleteQuery(s.Dialect, goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateComposeNamespaces Deletes all rows from the composeNamespace collection
-func (s Store) TruncateComposeNamespaces(ctx context.Context) error {
-	return s.Exec(ctx, composeNamespaceTruncateQuery(s.Dialect))
+// TruncateAutomationWorkflows Deletes all rows from the automationWorkflow collection
+func (s Store) TruncateAutomationWorkflows(ctx context.Context) error {
+	return s.Exec(ctx, automationWorkflowTruncateQuery(s.Dialect))
 }
 
-// SearchComposeNamespaces returns (filtered) set of ComposeNamespaces
+// SearchAutomationWorkflows returns (filtered) set of AutomationWorkflows
 //
 // This function is auto-generated
-func (s *Store) SearchComposeNamespaces(ctx context.Context, f composeType.NamespaceFilter) (set composeType.NamespaceSet, _ composeType.NamespaceFilter, err error) {
+func (s *Store) SearchAutomationWorkflows(ctx context.Context, f automationType.WorkflowFilter) (set automationType.WorkflowSet, _ automationType.WorkflowFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -7481,7 +6487,7 @@ func (s *Store) SearchComposeNamespaces(ctx context.Context, f composeType.Names
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfComposeNamespaces fn used for cursor creation;
+	// Original are passed to the etchFullPageOfAutomationWorkflows fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -7491,7 +6497,7 @@ func (s *Store) SearchComposeNamespaces(ctx context.Context, f composeType.Names
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposeNamespaces(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfAutomationWorkflows(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -7501,7 +6507,7 @@ func (s *Store) SearchComposeNamespaces(ctx context.Context, f composeType.Names
 	return set, f, nil
 }
 
-// fetchFullPageOfComposeNamespaces collects all requested results.
+// fetchFullPageOfAutomationWorkflows collects all requested results.
 //
 // Function applies:
 //  - cursor conditions (where ...)
@@ -7513,13 +6519,13 @@ func (s *Store) SearchComposeNamespaces(ctx context.Context, f composeType.Names
 // Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfComposeNamespaces(
+func (s *Store) fetchFullPageOfAutomationWorkflows(
 	ctx context.Context,
-	filter composeType.NamespaceFilter,
+	filter automationType.WorkflowFilter,
 	sort filter.SortExprSet,
-) (set []*composeType.Namespace, prev, next *filter.PagingCursor, err error) {
+) (set []*automationType.Workflow, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*composeType.Namespace
+		aux []*automationType.Workflow
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -7538,10 +6544,10 @@ func (s *Store) fetchFullPageOfComposeNamespaces(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter composeType.NamespaceFilter
+		tryFilter automationType.WorkflowFilter
 	)
 
-	set = make([]*composeType.Namespace, 0, DefaultSliceCapacity)
+	set = make([]*automationType.Workflow, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -7554,7 +6560,7 @@ func (s *Store) fetchFullPageOfComposeNamespaces(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryComposeNamespaces(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryAutomationWorkflows(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -7584,7 +6590,7 @@ func (s *Store) fetchFullPageOfComposeNamespaces(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectComposeNamespaceCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectAutomationWorkflowCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -7615,35 +6621,35 @@ func (s *Store) fetchFullPageOfComposeNamespaces(
 	}
 
 	if hasPrev {
-		prev = s.collectComposeNamespaceCursorValues(set[0], filter.Sort...)
+		prev = s.collectAutomationWorkflowCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectComposeNamespaceCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectAutomationWorkflowCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryComposeNamespaces queries the database, converts and checks each row and returns collected set
+// QueryAutomationWorkflows queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryComposeNamespaces(
+func (s *Store) QueryAutomationWorkflows(
 	ctx context.Context,
-	f composeType.NamespaceFilter,
-) (_ []*composeType.Namespace, more bool, err error) {
+	f automationType.WorkflowFilter,
+) (_ []*automationType.Workflow, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*composeType.Namespace, 0, DefaultSliceCapacity)
-		res         *composeType.Namespace
-		aux         *auxComposeNamespace
+		set         = make([]*automationType.Workflow, 0, DefaultSliceCapacity)
+		res         *automationType.Workflow
+		aux         *auxAutomationWorkflow
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -7651,16 +6657,16 @@ func (s *Store) QueryComposeNamespaces(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.ComposeNamespace != nil {
+	if s.Filters.AutomationWorkflow != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.ComposeNamespace(s, f)
+		tExpr, f, err = s.Filters.AutomationWorkflow(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = ComposeNamespaceFilter(f)
+		tExpr, f, err = AutomationWorkflowFilter(f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for ComposeNamespace: %w", err)
+		err = fmt.Errorf("could generate filter expression for AutomationWorkflow: %w", err)
 		return
 	}
 
@@ -7675,11 +6681,11 @@ func (s *Store) QueryComposeNamespaces(
 		}
 	}
 
-	query := composeNamespaceSelectQuery(s.Dialect).Where(expr...)
+	query := automationWorkflowSelectQuery(s.Dialect).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableComposeNamespaceFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for ComposeNamespace: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableAutomationWorkflowFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for AutomationWorkflow: %w", err)
 		return
 	}
 
@@ -7693,12 +6699,12 @@ func (s *Store) QueryComposeNamespaces(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query ComposeNamespace: %w", err)
+		err = fmt.Errorf("could not query AutomationWorkflow: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query ComposeNamespace: %w", err)
+		err = fmt.Errorf("could not query AutomationWorkflow: %w", err)
 		return
 	}
 
@@ -7712,19 +6718,19 @@ func (s *Store) QueryComposeNamespaces(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query ComposeNamespace: %w", err)
+			err = fmt.Errorf("could not query AutomationWorkflow: %w", err)
 			return
 		}
 
-		aux = new(auxComposeNamespace)
+		aux = new(auxAutomationWorkflow)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for ComposeNamespace: %w", err)
+			err = fmt.Errorf("could not scan rows for AutomationWorkflow: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode ComposeNamespace: %w", err)
+			err = fmt.Errorf("could not decode AutomationWorkflow: %w", err)
 			return
 		}
 
@@ -7745,16 +6751,17 @@ func (s *Store) QueryComposeNamespaces(
 
 }
 
-// LookupComposeNamespaceBySlug searches for namespace by slug (case-insensitive)
+// LookupAutomationWorkflowByID searches for workflow by ID
+//
+// It returns workflow even if deleted
 //
 // This function is auto-generated
-func (s *Store) LookupComposeNamespaceBySlug(ctx context.Context, slug string) (_ *composeType.Namespace, err error) {
+func (s *Store) LookupAutomationWorkflowByID(ctx context.Context, id uint64) (_ *automationType.Workflow, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposeNamespace)
-		lookup = composeNamespaceSelectQuery(s.Dialect).Where(
-			goqu.I("slug").Eq(slug),
-			goqu.I("deleted_at").IsNull(),
+		aux    = new(auxAutomationWorkflow)
+		lookup = automationWorkflowSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
 
@@ -7786,17 +6793,18 @@ func (s *Store) LookupComposeNamespaceBySlug(ctx context.Context, slug string) (
 	return aux.decode()
 }
 
-// LookupComposeNamespaceByID searches for compose namespace by ID
+// LookupAutomationWorkflowByHandle searches for workflow by their handle
 //
-// It returns compose namespace even if deleted
+// It returns only valid workflows
 //
 // This function is auto-generated
-func (s *Store) LookupComposeNamespaceByID(ctx context.Context, id uint64) (_ *composeType.Namespace, err error) {
+func (s *Store) LookupAutomationWorkflowByHandle(ctx context.Context, handle string) (_ *automationType.Workflow, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposeNamespace)
-		lookup = composeNamespaceSelectQuery(s.Dialect).Where(
-			goqu.I("id").Eq(id),
+		aux    = new(auxAutomationWorkflow)
+		lookup = automationWorkflowSelectQuery(s.Dialect).Where(
+			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
+			goqu.I("deleted_at").IsNull(),
 		).Limit(1)
 	)
 
@@ -7828,24 +6836,25 @@ func (s *Store) LookupComposeNamespaceByID(ctx context.Context, id uint64) (_ *c
 	return aux.decode()
 }
 
-// sortableComposeNamespaceFields returns all <no value> columns flagged as sortable
+// sortableAutomationWorkflowFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableComposeNamespaceFields() map[string]string {
+func (Store) sortableAutomationWorkflowFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
 		"deleted_at": "deleted_at",
 		"deletedat":  "deleted_at",
+		"handle":     "handle",
 		"id":         "id",
 		"updated_at": "updated_at",
 		"updatedat":  "updated_at",
 	}
 }
 
-// collectComposeNamespaceCursorValues collects values from the given resource that and sets them to the cursor
+// collectAutomationWorkflowCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -7856,7 +6865,7 @@ func (Store) sortableComposeNamespaceFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectComposeNamespaceCursorValues(res *composeType.Namespace, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectAutomationWorkflowCursorValues(res *automationType.Workflow, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -7870,6 +6879,9 @@ func (s *Store) collectComposeNamespaceCursorValues(res *composeType.Namespace,
 				case "id":
 					cur.Set(c.Column, res.ID, c.Descending)
 					pkID = true
+				case "handle":
+					cur.Set(c.Column, res.Handle, c.Descending)
+					hasUnique = true
 				case "createdAt":
 					cur.Set(c.Column, res.CreatedAt, c.Descending)
 				case "updatedAt":
@@ -7890,18 +6902,18 @@ func (s *Store) collectComposeNamespaceCursorValues(res *composeType.Namespace,
 
 }
 
-// checkComposeNamespaceConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkAutomationWorkflowConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkComposeNamespaceConstraints(ctx context.Context, res *composeType.Namespace) (err error) {
+func (s *Store) checkAutomationWorkflowConstraints(ctx context.Context, res *automationType.Workflow) (err error) {
 	err = func() (err error) {
 
 		// handling string type as default
-		if len(res.Slug) == 0 {
+		if len(res.Handle) == 0 {
 			// skip check on empty values
 			return nil
 		}
@@ -7911,7 +6923,7 @@ func (s *Store) checkComposeNamespaceConstraints(ctx context.Context, res *compo
 			return nil
 		}
 
-		ex, err := s.LookupComposeNamespaceBySlug(ctx, res.Slug)
+		ex, err := s.LookupAutomationWorkflowByHandle(ctx, res.Handle)
 		if err == nil && ex != nil && ex.ID != res.ID {
 			return store.ErrNotUnique.Stack(1)
 		} else if !errors.IsNotFound(err) {
@@ -7928,16 +6940,16 @@ func (s *Store) checkComposeNamespaceConstraints(ctx context.Context, res *compo
 	return nil
 }
 
-// CreateComposePage creates one or more rows in composePage collection
+// CreateAutomationWorkflowRevision creates one or more rows in automationWorkflowRevision collection
 //
 // This function is auto-generated
-func (s *Store) CreateComposePage(ctx context.Context, rr ...*composeType.Page) (err error) {
+func (s *Store) CreateAutomationWorkflowRevision(ctx context.Context, rr ...*automationType.WorkflowRevision) (err error) {
 	for i := range rr {
-		if err = s.checkComposePageConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationWorkflowRevisionConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composePageInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationWorkflowRevisionInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -7945,16 +6957,16 @@ func (s *Store) CreateComposePage(ctx context.Context, rr ...*composeType.Page)
 	return
 }
 
-// UpdateComposePage updates one or more existing entries in composePage collection
+// UpdateAutomationWorkflowRevision updates one or more existing entries in automationWorkflowRevision collection
 //
 // This function is auto-generated
-func (s *Store) UpdateComposePage(ctx context.Context, rr ...*composeType.Page) (err error) {
+func (s *Store) UpdateAutomationWorkflowRevision(ctx context.Context, rr ...*automationType.WorkflowRevision) (err error) {
 	for i := range rr {
-		if err = s.checkComposePageConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationWorkflowRevisionConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composePageUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationWorkflowRevisionUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -7962,16 +6974,16 @@ func (s *Store) UpdateComposePage(ctx context.Context, rr ...*composeType.Page)
 	return
 }
 
-// UpsertComposePage updates one or more existing entries in composePage collection
+// UpsertAutomationWorkflowRevision updates one or more existing entries in automationWorkflowRevision collection
 //
 // This function is auto-generated
-func (s *Store) UpsertComposePage(ctx context.Context, rr ...*composeType.Page) (err error) {
+func (s *Store) UpsertAutomationWorkflowRevision(ctx context.Context, rr ...*automationType.WorkflowRevision) (err error) {
 	for i := range rr {
-		if err = s.checkComposePageConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationWorkflowRevisionConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composePageUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationWorkflowRevisionUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -7979,12 +6991,12 @@ func (s *Store) UpsertComposePage(ctx context.Context, rr ...*composeType.Page)
 	return
 }
 
-// DeleteComposePage Deletes one or more entries from composePage collection
+// DeleteAutomationWorkflowRevision Deletes one or more entries from automationWorkflowRevision collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposePage(ctx context.Context, rr ...*composeType.Page) (err error) {
+func (s *Store) DeleteAutomationWorkflowRevision(ctx context.Context, rr ...*automationType.WorkflowRevision) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, composePageDeleteQuery(s.Dialect, composePagePrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, automationWorkflowRevisionDeleteQuery(s.Dialect, automationWorkflowRevisionPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -7992,24 +7004,24 @@ func (s *Store) DeleteComposePage(ctx context.Context, rr ...*composeType.Page)
 	return nil
 }
 
-// DeleteComposePageByID deletes single entry from composePage collection
+// DeleteAutomationWorkflowRevisionByID deletes single entry from automationWorkflowRevision collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposePageByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, composePageDeleteQuery(s.Dialect, goqu.Ex{
+func (s *Store) DeleteAutomationWorkflowRevisionByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, automationWorkflowRevisionDeleteQuery(s.Dialect, goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateComposePages Deletes all rows from the composePage collection
-func (s Store) TruncateComposePages(ctx context.Context) error {
-	return s.Exec(ctx, composePageTruncateQuery(s.Dialect))
+// TruncateAutomationWorkflowRevisions Deletes all rows from the automationWorkflowRevision collection
+func (s Store) TruncateAutomationWorkflowRevisions(ctx context.Context) error {
+	return s.Exec(ctx, automationWorkflowRevisionTruncateQuery(s.Dialect))
 }
 
-// SearchComposePages returns (filtered) set of ComposePages
+// SearchAutomationWorkflowRevisions returns (filtered) set of AutomationWorkflowRevisions
 //
 // This function is auto-generated
-func (s *Store) SearchComposePages(ctx context.Context, f composeType.PageFilter) (set composeType.PageSet, _ composeType.PageFilter, err error) {
+func (s *Store) SearchAutomationWorkflowRevisions(ctx context.Context, f automationType.WorkflowRevisionFilter) (set automationType.WorkflowRevisionSet, _ automationType.WorkflowRevisionFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -8033,7 +7045,7 @@ func (s *Store) SearchComposePages(ctx context.Context, f composeType.PageFilter
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfComposePages fn used for cursor creation;
+	// Original are passed to the etchFullPageOfAutomationWorkflowRevisions fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -8043,7 +7055,7 @@ func (s *Store) SearchComposePages(ctx context.Context, f composeType.PageFilter
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposePages(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfAutomationWorkflowRevisions(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -8053,25 +7065,25 @@ func (s *Store) SearchComposePages(ctx context.Context, f composeType.PageFilter
 	return set, f, nil
 }
 
-// fetchFullPageOfComposePages collects all requested results.
+// fetchFullPageOfAutomationWorkflowRevisions collects all requested results.
 //
 // Function applies:
-//  - cursor conditions (where ...)
-//  - limit
+//   - cursor conditions (where ...)
+//   - limit
 //
 // Main responsibility of this function is to perform additional sequential queries in case when not enough results
 // are collected due to failed check on a specific row (by check fn).
 //
-// Function then moves cursor to the last item fetched
+// # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfComposePages(
+func (s *Store) fetchFullPageOfAutomationWorkflowRevisions(
 	ctx context.Context,
-	filter composeType.PageFilter,
+	filter automationType.WorkflowRevisionFilter,
 	sort filter.SortExprSet,
-) (set []*composeType.Page, prev, next *filter.PagingCursor, err error) {
+) (set []*automationType.WorkflowRevision, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*composeType.Page
+		aux []*automationType.WorkflowRevision
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -8090,10 +7102,10 @@ func (s *Store) fetchFullPageOfComposePages(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter composeType.PageFilter
+		tryFilter automationType.WorkflowRevisionFilter
 	)
 
-	set = make([]*composeType.Page, 0, DefaultSliceCapacity)
+	set = make([]*automationType.WorkflowRevision, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -8106,7 +7118,7 @@ func (s *Store) fetchFullPageOfComposePages(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryComposePages(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryAutomationWorkflowRevisions(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -8136,7 +7148,7 @@ func (s *Store) fetchFullPageOfComposePages(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectComposePageCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectAutomationWorkflowRevisionCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -8167,35 +7179,33 @@ func (s *Store) fetchFullPageOfComposePages(
 	}
 
 	if hasPrev {
-		prev = s.collectComposePageCursorValues(set[0], filter.Sort...)
+		prev = s.collectAutomationWorkflowRevisionCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectComposePageCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectAutomationWorkflowRevisionCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryComposePages queries the database, converts and checks each row and returns collected set
+// QueryAutomationWorkflowRevisions queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryComposePages(
+func (s *Store) QueryAutomationWorkflowRevisions(
 	ctx context.Context,
-	f composeType.PageFilter,
-) (_ []*composeType.Page, more bool, err error) {
+	f automationType.WorkflowRevisionFilter,
+) (_ []*automationType.WorkflowRevision, more bool, err error) {
 	var (
-		ok bool
-
-		set         = make([]*composeType.Page, 0, DefaultSliceCapacity)
-		res         *composeType.Page
-		aux         *auxComposePage
+		set         = make([]*automationType.WorkflowRevision, 0, DefaultSliceCapacity)
+		res         *automationType.WorkflowRevision
+		aux         *auxAutomationWorkflowRevision
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -8203,16 +7213,16 @@ func (s *Store) QueryComposePages(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.ComposePage != nil {
+	if s.Filters.AutomationWorkflowRevision != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.ComposePage(s, f)
+		tExpr, f, err = s.Filters.AutomationWorkflowRevision(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = ComposePageFilter(f)
+		tExpr, f, err = AutomationWorkflowRevisionFilter(f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for ComposePage: %w", err)
+		err = fmt.Errorf("could generate filter expression for AutomationWorkflowRevision: %w", err)
 		return
 	}
 
@@ -8227,11 +7237,11 @@ func (s *Store) QueryComposePages(
 		}
 	}
 
-	query := composePageSelectQuery(s.Dialect).Where(expr...)
+	query := automationWorkflowRevisionSelectQuery(s.Dialect).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableComposePageFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for ComposePage: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableAutomationWorkflowRevisionFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for AutomationWorkflowRevision: %w", err)
 		return
 	}
 
@@ -8245,12 +7255,12 @@ func (s *Store) QueryComposePages(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query ComposePage: %w", err)
+		err = fmt.Errorf("could not query AutomationWorkflowRevision: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query ComposePage: %w", err)
+		err = fmt.Errorf("could not query AutomationWorkflowRevision: %w", err)
 		return
 	}
 
@@ -8264,32 +7274,22 @@ func (s *Store) QueryComposePages(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query ComposePage: %w", err)
+			err = fmt.Errorf("could not query AutomationWorkflowRevision: %w", err)
 			return
 		}
 
-		aux = new(auxComposePage)
+		aux = new(auxAutomationWorkflowRevision)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for ComposePage: %w", err)
+			err = fmt.Errorf("could not scan rows for AutomationWorkflowRevision: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode ComposePage: %w", err)
+			err = fmt.Errorf("could not decode AutomationWorkflowRevision: %w", err)
 			return
 		}
 
-		// check fn set, call it and see if it passed the test
-		// if not, skip the item
-		if f.Check != nil {
-			if ok, err = f.Check(res); err != nil {
-				return
-			} else if !ok {
-				continue
-			}
-		}
-
 		set = append(set, res)
 	}
 
@@ -8297,59 +7297,15 @@ func (s *Store) QueryComposePages(
 
 }
 
-// LookupComposePageByNamespaceIDHandle searches for page by handle (case-insensitive)
-//
-// This function is auto-generated
-func (s *Store) LookupComposePageByNamespaceIDHandle(ctx context.Context, namespaceID uint64, handle string) (_ *composeType.Page, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxComposePage)
-		lookup = composePageSelectQuery(s.Dialect).Where(
-			goqu.I("rel_namespace").Eq(namespaceID),
-			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
-			goqu.I("deleted_at").IsNull(),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
-	}
-
-	return aux.decode()
-}
-
-// LookupComposePageByNamespaceIDModuleID searches for page by moduleID
+// LookupAutomationWorkflowRevisionByID
 //
 // This function is auto-generated
-func (s *Store) LookupComposePageByNamespaceIDModuleID(ctx context.Context, namespaceID uint64, moduleID uint64) (_ *composeType.Page, err error) {
+func (s *Store) LookupAutomationWorkflowRevisionByID(ctx context.Context, id uint64) (_ *automationType.WorkflowRevision, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposePage)
-		lookup = composePageSelectQuery(s.Dialect).Where(
-			goqu.I("rel_namespace").Eq(namespaceID),
-			goqu.I("rel_module").Eq(moduleID),
-			goqu.I("deleted_at").IsNull(),
+		aux    = new(auxAutomationWorkflowRevision)
+		lookup = automationWorkflowRevisionSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
 
@@ -8381,17 +7337,16 @@ func (s *Store) LookupComposePageByNamespaceIDModuleID(ctx context.Context, name
 	return aux.decode()
 }
 
-// LookupComposePageByID searches for compose page by ID
-//
-// It returns compose page even if deleted
+// LookupAutomationWorkflowRevisionByWorkflowIDRevision searches for workflow revision by workflow ID and revision number
 //
 // This function is auto-generated
-func (s *Store) LookupComposePageByID(ctx context.Context, id uint64) (_ *composeType.Page, err error) {
+func (s *Store) LookupAutomationWorkflowRevisionByWorkflowIDRevision(ctx context.Context, workflowID uint64, revision uint) (_ *automationType.WorkflowRevision, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposePage)
-		lookup = composePageSelectQuery(s.Dialect).Where(
-			goqu.I("id").Eq(id),
+		aux    = new(auxAutomationWorkflowRevision)
+		lookup = automationWorkflowRevisionSelectQuery(s.Dialect).Where(
+			goqu.I("rel_workflow").Eq(workflowID),
+			goqu.I("revision").Eq(revision),
 		).Limit(1)
 	)
 
@@ -8423,25 +7378,21 @@ func (s *Store) LookupComposePageByID(ctx context.Context, id uint64) (_ *compos
 	return aux.decode()
 }
 
-// sortableComposePageFields returns all <no value> columns flagged as sortable
+// sortableAutomationWorkflowRevisionFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableComposePageFields() map[string]string {
+func (Store) sortableAutomationWorkflowRevisionFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
-		"deleted_at": "deleted_at",
-		"deletedat":  "deleted_at",
-		"handle":     "handle",
 		"id":         "id",
-		"updated_at": "updated_at",
-		"updatedat":  "updated_at",
+		"revision":   "revision",
 	}
 }
 
-// collectComposePageCursorValues collects values from the given resource that and sets them to the cursor
+// collectAutomationWorkflowRevisionCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -8452,7 +7403,7 @@ func (Store) sortableComposePageFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectComposePageCursorValues(res *composeType.Page, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectAutomationWorkflowRevisionCursorValues(res *automationType.WorkflowRevision, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -8466,15 +7417,10 @@ func (s *Store) collectComposePageCursorValues(res *composeType.Page, cc ...*fil
 				case "id":
 					cur.Set(c.Column, res.ID, c.Descending)
 					pkID = true
-				case "handle":
-					cur.Set(c.Column, res.Handle, c.Descending)
-					hasUnique = true
+				case "revision":
+					cur.Set(c.Column, res.Revision, c.Descending)
 				case "createdAt":
 					cur.Set(c.Column, res.CreatedAt, c.Descending)
-				case "updatedAt":
-					cur.Set(c.Column, res.UpdatedAt, c.Descending)
-				case "deletedAt":
-					cur.Set(c.Column, res.DeletedAt, c.Descending)
 				}
 			}
 		}
@@ -8489,27 +7435,27 @@ func (s *Store) collectComposePageCursorValues(res *composeType.Page, cc ...*fil
 
 }
 
-// checkComposePageConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkAutomationWorkflowRevisionConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkComposePageConstraints(ctx context.Context, res *composeType.Page) (err error) {
+func (s *Store) checkAutomationWorkflowRevisionConstraints(ctx context.Context, res *automationType.WorkflowRevision) (err error) {
 	return nil
 }
 
-// CreateComposeRecord creates one or more rows in composeRecord collection
+// CreateAutomationWorkflowTestCase creates one or more rows in automationWorkflowTestCase collection
 //
 // This function is auto-generated
-func (s *Store) CreateComposeRecord(ctx context.Context, mod *composeType.Module, rr ...*composeType.Record) (err error) {
+func (s *Store) CreateAutomationWorkflowTestCase(ctx context.Context, rr ...*automationType.WorkflowTestCase) (err error) {
 	for i := range rr {
-		if err = s.checkComposeRecordConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationWorkflowTestCaseConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeRecordInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationWorkflowTestCaseInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -8517,16 +7463,16 @@ func (s *Store) CreateComposeRecord(ctx context.Context, mod *composeType.Module
 	return
 }
 
-// UpdateComposeRecord updates one or more existing entries in composeRecord collection
+// UpdateAutomationWorkflowTestCase updates one or more existing entries in automationWorkflowTestCase collection
 //
 // This function is auto-generated
-func (s *Store) UpdateComposeRecord(ctx context.Context, mod *composeType.Module, rr ...*composeType.Record) (err error) {
+func (s *Store) UpdateAutomationWorkflowTestCase(ctx context.Context, rr ...*automationType.WorkflowTestCase) (err error) {
 	for i := range rr {
-		if err = s.checkComposeRecordConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationWorkflowTestCaseConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeRecordUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationWorkflowTestCaseUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -8534,16 +7480,16 @@ func (s *Store) UpdateComposeRecord(ctx context.Context, mod *composeType.Module
 	return
 }
 
-// UpsertComposeRecord updates one or more existing entries in composeRecord collection
+// UpsertAutomationWorkflowTestCase updates one or more existing entries in automationWorkflowTestCase collection
 //
 // This function is auto-generated
-func (s *Store) UpsertComposeRecord(ctx context.Context, mod *composeType.Module, rr ...*composeType.Record) (err error) {
+func (s *Store) UpsertAutomationWorkflowTestCase(ctx context.Context, rr ...*automationType.WorkflowTestCase) (err error) {
 	for i := range rr {
-		if err = s.checkComposeRecordConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkAutomationWorkflowTestCaseConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeRecordUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, automationWorkflowTestCaseUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -8551,12 +7497,12 @@ func (s *Store) UpsertComposeRecord(ctx context.Context, mod *composeType.Module
 	return
 }
 
-// DeleteComposeRecord Deletes one or more entries from composeRecord collection
+// DeleteAutomationWorkflowTestCase Deletes one or more entries from automationWorkflowTestCase collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeRecord(ctx context.Context, mod *composeType.Module, rr ...*composeType.Record) (err error) {
+func (s *Store) DeleteAutomationWorkflowTestCase(ctx context.Context, rr ...*automationType.WorkflowTestCase) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, composeRecordDeleteQuery(s.Dialect, composeRecordPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, automationWorkflowTestCaseDeleteQuery(s.Dialect, automationWorkflowTestCasePrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -8564,24 +7510,24 @@ func (s *Store) DeleteComposeRecord(ctx context.Context, mod *composeType.Module
 	return nil
 }
 
-// DeleteComposeRecordByID deletes single entry from composeRecord collection
+// DeleteAutomationWorkflowTestCaseByID deletes single entry from automationWorkflowTestCase collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeRecordByID(ctx context.Context, mod *composeType.Module, id uint64) error {
-	return s.Exec(ctx, composeRecordDeleteQuery(s.Dialect, goqu.Ex{
+func (s *Store) DeleteAutomationWorkflowTestCaseByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, automationWorkflowTestCaseDeleteQuery(s.Dialect, goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateComposeRecords Deletes all rows from the composeRecord collection
-func (s Store) TruncateComposeRecords(ctx context.Context, mod *composeType.Module) error {
-	return s.Exec(ctx, composeRecordTruncateQuery(s.Dialect))
+// TruncateAutomationWorkflowTestCases Deletes all rows from the automationWorkflowTestCase collection
+func (s Store) TruncateAutomationWorkflowTestCases(ctx context.Context) error {
+	return s.Exec(ctx, automationWorkflowTestCaseTruncateQuery(s.Dialect))
 }
 
-// SearchComposeRecords returns (filtered) set of ComposeRecords
+// SearchAutomationWorkflowTestCases returns (filtered) set of AutomationWorkflowTestCases
 //
 // This function is auto-generated
-func (s *Store) SearchComposeRecords(ctx context.Context, mod *composeType.Module, f composeType.RecordFilter) (set composeType.RecordSet, _ composeType.RecordFilter, err error) {
+func (s *Store) SearchAutomationWorkflowTestCases(ctx context.Context, f automationType.WorkflowTestCaseFilter) (set automationType.WorkflowTestCaseSet, _ automationType.WorkflowTestCaseFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -8605,7 +7551,7 @@ func (s *Store) SearchComposeRecords(ctx context.Context, mod *composeType.Modul
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfComposeRecords fn used for cursor creation;
+	// Original are passed to the etchFullPageOfAutomationWorkflowTestCases fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -8615,7 +7561,7 @@ func (s *Store) SearchComposeRecords(ctx context.Context, mod *composeType.Modul
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposeRecords(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfAutomationWorkflowTestCases(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -8625,25 +7571,25 @@ func (s *Store) SearchComposeRecords(ctx context.Context, mod *composeType.Modul
 	return set, f, nil
 }
 
-// fetchFullPageOfComposeRecords collects all requested results.
+// fetchFullPageOfAutomationWorkflowTestCases collects all requested results.
 //
 // Function applies:
-//  - cursor conditions (where ...)
-//  - limit
+//   - cursor conditions (where ...)
+//   - limit
 //
 // Main responsibility of this function is to perform additional sequential queries in case when not enough results
 // are collected due to failed check on a specific row (by check fn).
 //
-// Function then moves cursor to the last item fetched
+// # Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfComposeRecords(
+func (s *Store) fetchFullPageOfAutomationWorkflowTestCases(
 	ctx context.Context,
-	filter composeType.RecordFilter,
+	filter automationType.WorkflowTestCaseFilter,
 	sort filter.SortExprSet,
-) (set []*composeType.Record, prev, next *filter.PagingCursor, err error) {
+) (set []*automationType.WorkflowTestCase, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*composeType.Record
+		aux []*automationType.WorkflowTestCase
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -8662,10 +7608,10 @@ func (s *Store) fetchFullPageOfComposeRecords(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter composeType.RecordFilter
+		tryFilter automationType.WorkflowTestCaseFilter
 	)
 
-	set = make([]*composeType.Record, 0, DefaultSliceCapacity)
+	set = make([]*automationType.WorkflowTestCase, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -8678,7 +7624,7 @@ func (s *Store) fetchFullPageOfComposeRecords(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryComposeRecords(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryAutomationWorkflowTestCases(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -8708,7 +7654,7 @@ func (s *Store) fetchFullPageOfComposeRecords(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectComposeRecordCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectAutomationWorkflowTestCaseCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -8739,35 +7685,33 @@ func (s *Store) fetchFullPageOfComposeRecords(
 	}
 
 	if hasPrev {
-		prev = s.collectComposeRecordCursorValues(set[0], filter.Sort...)
+		prev = s.collectAutomationWorkflowTestCaseCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectComposeRecordCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectAutomationWorkflowTestCaseCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryComposeRecords queries the database, converts and checks each row and returns collected set
+// QueryAutomationWorkflowTestCases queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryComposeRecords(
+func (s *Store) QueryAutomationWorkflowTestCases(
 	ctx context.Context,
-	f composeType.RecordFilter,
-) (_ []*composeType.Record, more bool, err error) {
+	f automationType.WorkflowTestCaseFilter,
+) (_ []*automationType.WorkflowTestCase, more bool, err error) {
 	var (
-		ok bool
-
-		set         = make([]*composeType.Record, 0, DefaultSliceCapacity)
-		res         *composeType.Record
-		aux         *auxComposeRecord
+		set         = make([]*automationType.WorkflowTestCase, 0, DefaultSliceCapacity)
+		res         *automationType.WorkflowTestCase
+		aux         *auxAutomationWorkflowTestCase
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -8775,16 +7719,16 @@ func (s *Store) QueryComposeRecords(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.ComposeRecord != nil {
+	if s.Filters.AutomationWorkflowTestCase != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.ComposeRecord(s, f)
+		tExpr, f, err = s.Filters.AutomationWorkflowTestCase(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = ComposeRecordFilter(f)
+		tExpr, f, err = AutomationWorkflowTestCaseFilter(f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for ComposeRecord: %w", err)
+		err = fmt.Errorf("could generate filter expression for AutomationWorkflowTestCase: %w", err)
 		return
 	}
 
@@ -8799,11 +7743,11 @@ func (s *Store) QueryComposeRecords(
 		}
 	}
 
-	query := composeRecordSelectQuery(s.Dialect).Where(expr...)
+	query := automationWorkflowTestCaseSelectQuery(s.Dialect).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableComposeRecordFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for ComposeRecord: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableAutomationWorkflowTestCaseFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for AutomationWorkflowTestCase: %w", err)
 		return
 	}
 
@@ -8817,12 +7761,12 @@ func (s *Store) QueryComposeRecords(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query ComposeRecord: %w", err)
+		err = fmt.Errorf("could not query AutomationWorkflowTestCase: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query ComposeRecord: %w", err)
+		err = fmt.Errorf("could not query AutomationWorkflowTestCase: %w", err)
 		return
 	}
 
@@ -8836,32 +7780,22 @@ func (s *Store) QueryComposeRecords(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query ComposeRecord: %w", err)
+			err = fmt.Errorf("could not query AutomationWorkflowTestCase: %w", err)
 			return
 		}
 
-		aux = new(auxComposeRecord)
+		aux = new(auxAutomationWorkflowTestCase)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for ComposeRecord: %w", err)
+			err = fmt.Errorf("could not scan rows for AutomationWorkflowTestCase: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode ComposeRecord: %w", err)
+			err = fmt.Errorf("could not decode AutomationWorkflowTestCase: %w", err)
 			return
 		}
 
-		// check fn set, call it and see if it passed the test
-		// if not, skip the item
-		if f.Check != nil {
-			if ok, err = f.Check(res); err != nil {
-				return
-			} else if !ok {
-				continue
-			}
-		}
-
 		set = append(set, res)
 	}
 
@@ -8869,16 +7803,16 @@ func (s *Store) QueryComposeRecords(
 
 }
 
-// LookupComposeRecordByID searches for compose record by ID
+// LookupAutomationWorkflowTestCaseByID searches for workflow test case by ID
 //
-// It returns compose record even if deleted
+// It returns test case even if deleted
 //
 // This function is auto-generated
-func (s *Store) LookupComposeRecordByID(ctx context.Context, mod *composeType.Module, id uint64) (_ *composeType.Record, err error) {
+func (s *Store) LookupAutomationWorkflowTestCaseByID(ctx context.Context, id uint64) (_ *automationType.WorkflowTestCase, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxComposeRecord)
-		lookup = composeRecordSelectQuery(s.Dialect).Where(
+		aux    = new(auxAutomationWorkflowTestCase)
+		lookup = automationWorkflowTestCaseSelectQuery(s.Dialect).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -8911,12 +7845,12 @@ func (s *Store) LookupComposeRecordByID(ctx context.Context, mod *composeType.Mo
 	return aux.decode()
 }
 
-// sortableComposeRecordFields returns all <no value> columns flagged as sortable
+// sortableAutomationWorkflowTestCaseFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableComposeRecordFields() map[string]string {
+func (Store) sortableAutomationWorkflowTestCaseFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
@@ -8928,7 +7862,7 @@ func (Store) sortableComposeRecordFields() map[string]string {
 	}
 }
 
-// collectComposeRecordCursorValues collects values from the given resource that and sets them to the cursor
+// collectAutomationWorkflowTestCaseCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -8939,7 +7873,7 @@ func (Store) sortableComposeRecordFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectComposeRecordCursorValues(res *composeType.Record, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectAutomationWorkflowTestCaseCursorValues(res *automationType.WorkflowTestCase, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -8973,27 +7907,27 @@ func (s *Store) collectComposeRecordCursorValues(res *composeType.Record, cc ...
 
 }
 
-// checkComposeRecordConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkAutomationWorkflowTestCaseConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkComposeRecordConstraints(ctx context.Context, res *composeType.Record) (err error) {
+func (s *Store) checkAutomationWorkflowTestCaseConstraints(ctx context.Context, res *automationType.WorkflowTestCase) (err error) {
 	return nil
 }
 
-// CreateComposeRecordValue creates one or more rows in composeRecordValue collection
+// CreateComposeAttachment creates one or more rows in composeAttachment collection
 //
 // This function is auto-generated
-func (s *Store) CreateComposeRecordValue(ctx context.Context, rr ...*composeType.RecordValue) (err error) {
+func (s *Store) CreateComposeAttachment(ctx context.Context, rr ...*composeType.Attachment) (err error) {
 	for i := range rr {
-		if err = s.checkComposeRecordValueConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeAttachmentConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeRecordValueInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeAttachmentInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -9001,16 +7935,16 @@ func (s *Store) CreateComposeRecordValue(ctx context.Context, rr ...*composeType
 	return
 }
 
-// UpdateComposeRecordValue updates one or more existing entries in composeRecordValue collection
+// UpdateComposeAttachment updates one or more existing entries in composeAttachment collection
 //
 // This function is auto-generated
-func (s *Store) UpdateComposeRecordValue(ctx context.Context, rr ...*composeType.RecordValue) (err error) {
+func (s *Store) UpdateComposeAttachment(ctx context.Context, rr ...*composeType.Attachment) (err error) {
 	for i := range rr {
-		if err = s.checkComposeRecordValueConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeAttachmentConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeRecordValueUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeAttachmentUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -9018,16 +7952,16 @@ func (s *Store) UpdateComposeRecordValue(ctx context.Context, rr ...*composeType
 	return
 }
 
-// UpsertComposeRecordValue updates one or more existing entries in composeRecordValue collection
+// UpsertComposeAttachment updates one or more existing entries in composeAttachment collection
 //
 // This function is auto-generated
-func (s *Store) UpsertComposeRecordValue(ctx context.Context, rr ...*composeType.RecordValue) (err error) {
+func (s *Store) UpsertComposeAttachment(ctx context.Context, rr ...*composeType.Attachment) (err error) {
 	for i := range rr {
-		if err = s.checkComposeRecordValueConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeAttachmentConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, composeRecordValueUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeAttachmentUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -9035,12 +7969,12 @@ func (s *Store) UpsertComposeRecordValue(ctx context.Context, rr ...*composeType
 	return
 }
 
-// DeleteComposeRecordValue Deletes one or more entries from composeRecordValue collection
+// DeleteComposeAttachment Deletes one or more entries from composeAttachment collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeRecordValue(ctx context.Context, rr ...*composeType.RecordValue) (err error) {
+func (s *Store) DeleteComposeAttachment(ctx context.Context, rr ...*composeType.Attachment) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, composeRecordValueDeleteQuery(s.Dialect, composeRecordValuePrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, composeAttachmentDeleteQuery(s.Dialect, composeAttachmentPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -9048,28 +7982,60 @@ func (s *Store) DeleteComposeRecordValue(ctx context.Context, rr ...*composeType
 	return nil
 }
 
-// DeleteComposeRecordValueByID deletes single entry from composeRecordValue collection
+// DeleteComposeAttachmentByID deletes single entry from composeAttachment collection
 //
 // This function is auto-generated
-func (s *Store) DeleteComposeRecordValueByRecordIDNamePlace(ctx context.Context, recordID uint64, name string, place uint) error {
-	return s.Exec(ctx, composeRecordValueDeleteQuery(s.Dialect, goqu.Ex{
-		"record_id": recordID,
-		"name":      name,
-		"place":     place,
+func (s *Store) DeleteComposeAttachmentByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, composeAttachmentDeleteQuery(s.Dialect, goqu.Ex{
+		"id": id,
 	}))
 }
 
-// TruncateComposeRecordValues Deletes all rows from the composeRecordValue collection
-func (s Store) TruncateComposeRecordValues(ctx context.Context) error {
-	return s.Exec(ctx, composeRecordValueTruncateQuery(s.Dialect))
+// TruncateComposeAttachments Deletes all rows from the composeAttachment collection
+func (s Store) TruncateComposeAttachments(ctx context.Context) error {
+	return s.Exec(ctx, composeAttachmentTruncateQuery(s.Dialect))
 }
 
-// SearchComposeRecordValues returns (filtered) set of ComposeRecordValues
+// SearchComposeAttachments returns (filtered) set of ComposeAttachments
 //
 // This function is auto-generated
-func (s *Store) SearchComposeRecordValues(ctx context.Context, f composeType.RecordValueFilter) (set composeType.RecordValueSet, _ composeType.RecordValueFilter, err error) {
+func (s *Store) SearchComposeAttachments(ctx context.Context, f composeType.AttachmentFilter) (set composeType.AttachmentSet, _ composeType.AttachmentFilter, err error) {
 
-	set, _, err = s.QueryComposeRecordValues(ctx, f)
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfComposeAttachments fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposeAttachments(ctx, f, sort)
+
+	f.PageCursor = nil
 	if err != nil {
 		return nil, f, err
 	}
@@ -9077,311 +8043,204 @@ func (s *Store) SearchComposeRecordValues(ctx context.Context, f composeType.Rec
 	return set, f, nil
 }
 
-// QueryComposeRecordValues queries the database, converts and checks each row and returns collected set
+// fetchFullPageOfComposeAttachments collects all requested results.
 //
-// With generics, we can remove this per-resource-generated function
-// and replace it with a single utility fetcher
+// Function applies:
+//  - cursor conditions (where ...)
+//  - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) QueryComposeRecordValues(
+func (s *Store) fetchFullPageOfComposeAttachments(
 	ctx context.Context,
-	f composeType.RecordValueFilter,
-) (_ []*composeType.RecordValue, more bool, err error) {
+	filter composeType.AttachmentFilter,
+	sort filter.SortExprSet,
+) (set []*composeType.Attachment, prev, next *filter.PagingCursor, err error) {
 	var (
-		set         = make([]*composeType.RecordValue, 0, DefaultSliceCapacity)
-		res         *composeType.RecordValue
-		aux         *auxComposeRecordValue
-		rows        *sql.Rows
-		count       uint
-		expr, tExpr []goqu.Expression
-	)
+		aux []*composeType.Attachment
 
-	if s.Filters.ComposeRecordValue != nil {
-		// extended filter set
-		tExpr, f, err = s.Filters.ComposeRecordValue(s, f)
-	} else {
-		// using generated filter
-		tExpr, f, err = ComposeRecordValueFilter(f)
-	}
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
 
-	if err != nil {
-		err = fmt.Errorf("could generate filter expression for ComposeRecordValue: %w", err)
-		return
-	}
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
 
-	expr = append(expr, tExpr...)
+		reqItems = filter.Limit
 
-	query := composeRecordValueSelectQuery(s.Dialect).Where(expr...)
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
 
-	if f.Limit > 0 {
-		query = query.Limit(f.Limit)
-	}
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
 
-	rows, err = s.Query(ctx, query)
-	if err != nil {
-		err = fmt.Errorf("could not query ComposeRecordValue: %w", err)
-		return
-	}
+		tryFilter composeType.AttachmentFilter
+	)
 
-	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query ComposeRecordValue: %w", err)
-		return
-	}
+	set = make([]*composeType.Attachment, 0, DefaultSliceCapacity)
 
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
 
-	for rows.Next() {
-		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query ComposeRecordValue: %w", err)
-			return
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
 		}
 
-		aux = new(auxComposeRecordValue)
-		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for ComposeRecordValue: %w", err)
-			return
+		if aux, hasNext, err = s.QueryComposeAttachments(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
 		}
 
-		count++
-		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode ComposeRecordValue: %w", err)
-			return
+		if len(aux) == 0 {
+			// nothing fetched
+			break
 		}
 
-		set = append(set, res)
-	}
-
-	return set, false, err
-
-}
-
-// sortableComposeRecordValueFields returns all <no value> columns flagged as sortable
-//
-// With optional string arg, all columns are returned aliased
-//
-// This function is auto-generated
-func (Store) sortableComposeRecordValueFields() map[string]string {
-	return map[string]string{
-		"deleted_at": "deleted_at",
-		"deletedat":  "deleted_at",
-		"name":       "name",
-		"place":      "place",
-		"record_id":  "record_id",
-		"recordid":   "record_id",
-	}
-}
-
-// collectComposeRecordValueCursorValues collects values from the given resource that and sets them to the cursor
-// to be used for pagination
-//
-// Values that are collected must come from sortable, unique or primary columns/fields
-// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
-//
-// Known issue:
-//   when collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
-//   undeleted items)
-//
-// This function is auto-generated
-func (s *Store) collectComposeRecordValueCursorValues(res *composeType.RecordValue, cc ...*filter.SortExpr) *filter.PagingCursor {
-	var (
-		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
-
-		hasUnique bool
-
-		pkRecordID bool
-		pkName     bool
-		pkPlace    bool
+		// append fetched items
+		set = append(set, aux...)
 
-		collect = func(cc ...*filter.SortExpr) {
-			for _, c := range cc {
-				switch c.Column {
-				case "recordID":
-					cur.Set(c.Column, res.RecordID, c.Descending)
-					pkRecordID = true
-				case "name":
-					cur.Set(c.Column, res.Name, c.Descending)
-					pkName = true
-				case "place":
-					cur.Set(c.Column, res.Place, c.Descending)
-					pkPlace = true
-				case "deletedAt":
-					cur.Set(c.Column, res.DeletedAt, c.Descending)
-				}
-			}
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
 		}
-	)
 
-	collect(cc...)
-	if !hasUnique || !pkRecordID {
-		collect(&filter.SortExpr{Column: "recordID", Descending: false})
-	}
-	if !hasUnique || !pkName {
-		collect(&filter.SortExpr{Column: "name", Descending: false})
-	}
-	if !hasUnique || !pkPlace {
-		collect(&filter.SortExpr{Column: "place", Descending: false})
-	}
+		collected := uint(len(set))
 
-	return cur
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
 
-}
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
 
-// checkComposeRecordValueConstraints performs lookups (on valid) resource to check if any of the values on unique fields
-// already exists in the store
-//
-// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
-// on the full support (MySQL does not support conditional indexes)
-//
-// This function is auto-generated
-func (s *Store) checkComposeRecordValueConstraints(ctx context.Context, res *composeType.RecordValue) (err error) {
-	return nil
-}
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectComposeAttachmentCursorValues(set[collected-1], filter.Sort...)
 
-// CreateCredential creates one or more rows in credential collection
-//
-// This function is auto-generated
-func (s *Store) CreateCredential(ctx context.Context, rr ...*systemType.Credential) (err error) {
-	for i := range rr {
-		if err = s.checkCredentialConstraints(ctx, rr[i]); err != nil {
-			return
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
 		}
 
-		if err = s.Exec(ctx, credentialInsertQuery(s.Dialect, rr[i])); err != nil {
-			return
+		if reqItems < collected {
+			set = set[:reqItems]
 		}
-	}
 
-	return
-}
+		break
+	}
 
-// UpdateCredential updates one or more existing entries in credential collection
-//
-// This function is auto-generated
-func (s *Store) UpdateCredential(ctx context.Context, rr ...*systemType.Credential) (err error) {
-	for i := range rr {
-		if err = s.checkCredentialConstraints(ctx, rr[i]); err != nil {
-			return
-		}
+	collected := len(set)
 
-		if err = s.Exec(ctx, credentialUpdateQuery(s.Dialect, rr[i])); err != nil {
-			return
-		}
+	if collected == 0 {
+		return nil, nil, nil, nil
 	}
 
-	return
-}
-
-// UpsertCredential updates one or more existing entries in credential collection
-//
-// This function is auto-generated
-func (s *Store) UpsertCredential(ctx context.Context, rr ...*systemType.Credential) (err error) {
-	for i := range rr {
-		if err = s.checkCredentialConstraints(ctx, rr[i]); err != nil {
-			return
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
 		}
 
-		if err = s.Exec(ctx, credentialUpsertQuery(s.Dialect, rr[i])); err != nil {
-			return
-		}
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
 	}
 
-	return
-}
-
-// DeleteCredential Deletes one or more entries from credential collection
-//
-// This function is auto-generated
-func (s *Store) DeleteCredential(ctx context.Context, rr ...*systemType.Credential) (err error) {
-	for i := range rr {
-		if err = s.Exec(ctx, credentialDeleteQuery(s.Dialect, credentialPrimaryKeys(rr[i]))); err != nil {
-			return
-		}
+	if hasPrev {
+		prev = s.collectComposeAttachmentCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
 	}
 
-	return nil
-}
-
-// DeleteCredentialByID deletes single entry from credential collection
-//
-// This function is auto-generated
-func (s *Store) DeleteCredentialByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, credentialDeleteQuery(s.Dialect, goqu.Ex{
-		"id": id,
-	}))
-}
-
-// TruncateCredentials Deletes all rows from the credential collection
-func (s Store) TruncateCredentials(ctx context.Context) error {
-	return s.Exec(ctx, credentialTruncateQuery(s.Dialect))
-}
-
-// SearchCredentials returns (filtered) set of Credentials
-//
-// This function is auto-generated
-func (s *Store) SearchCredentials(ctx context.Context, f systemType.CredentialFilter) (set systemType.CredentialSet, _ systemType.CredentialFilter, err error) {
-
-	set, _, err = s.QueryCredentials(ctx, f)
-	if err != nil {
-		return nil, f, err
+	if hasNext {
+		next = s.collectComposeAttachmentCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
 	}
 
-	return set, f, nil
+	return set, prev, next, nil
 }
 
-// QueryCredentials queries the database, converts and checks each row and returns collected set
+// QueryComposeAttachments queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryCredentials(
+func (s *Store) QueryComposeAttachments(
 	ctx context.Context,
-	f systemType.CredentialFilter,
-) (_ []*systemType.Credential, more bool, err error) {
+	f composeType.AttachmentFilter,
+) (_ []*composeType.Attachment, more bool, err error) {
 	var (
-		set         = make([]*systemType.Credential, 0, DefaultSliceCapacity)
-		res         *systemType.Credential
-		aux         *auxCredential
+		ok bool
+
+		set         = make([]*composeType.Attachment, 0, DefaultSliceCapacity)
+		res         *composeType.Attachment
+		aux         *auxComposeAttachment
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.Credential != nil {
+	if s.Filters.ComposeAttachment != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.Credential(s, f)
+		tExpr, f, err = s.Filters.ComposeAttachment(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = CredentialFilter(f)
+		tExpr, f, err = ComposeAttachmentFilter(f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for Credential: %w", err)
+		err = fmt.Errorf("could generate filter expression for ComposeAttachment: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	query := credentialSelectQuery(s.Dialect).Where(expr...)
-
-	if f.Limit > 0 {
-		query = query.Limit(f.Limit)
-	}
-
-	rows, err = s.Query(ctx, query)
-	if err != nil {
-		err = fmt.Errorf("could not query Credential: %w", err)
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursor(f.PageCursor); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := composeAttachmentSelectQuery(s.Dialect).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableComposeAttachmentFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for ComposeAttachment: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query ComposeAttachment: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query Credential: %w", err)
+		err = fmt.Errorf("could not query ComposeAttachment: %w", err)
 		return
 	}
 
@@ -9395,39 +8254,47 @@ func (s *Store) QueryCredentials(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query Credential: %w", err)
+			err = fmt.Errorf("could not query ComposeAttachment: %w", err)
 			return
 		}
 
-		aux = new(auxCredential)
+		aux = new(auxComposeAttachment)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for Credential: %w", err)
+			err = fmt.Errorf("could not scan rows for ComposeAttachment: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode Credential: %w", err)
+			err = fmt.Errorf("could not decode ComposeAttachment: %w", err)
 			return
 		}
 
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
+		}
+
 		set = append(set, res)
 	}
 
-	return set, false, err
+	return set, f.Limit > 0 && count >= f.Limit, err
 
 }
 
-// LookupCredentialByID searches for credentials by ID
-//
-// It returns credentials even if deleted
+// LookupComposeAttachmentByID
 //
 // This function is auto-generated
-func (s *Store) LookupCredentialByID(ctx context.Context, id uint64) (_ *systemType.Credential, err error) {
+func (s *Store) LookupComposeAttachmentByID(ctx context.Context, id uint64) (_ *composeType.Attachment, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxCredential)
-		lookup = credentialSelectQuery(s.Dialect).Where(
+		aux    = new(auxComposeAttachment)
+		lookup = composeAttachmentSelectQuery(s.Dialect).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -9460,28 +8327,24 @@ func (s *Store) LookupCredentialByID(ctx context.Context, id uint64) (_ *systemT
 	return aux.decode()
 }
 
-// sortableCredentialFields returns all <no value> columns flagged as sortable
+// sortableComposeAttachmentFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableCredentialFields() map[string]string {
+func (Store) sortableComposeAttachmentFields() map[string]string {
 	return map[string]string{
-		"created_at":   "created_at",
-		"createdat":    "created_at",
-		"deleted_at":   "deleted_at",
-		"deletedat":    "deleted_at",
-		"expires_at":   "expires_at",
-		"expiresat":    "expires_at",
-		"id":           "id",
-		"last_used_at": "last_used_at",
-		"lastusedat":   "last_used_at",
-		"updated_at":   "updated_at",
-		"updatedat":    "updated_at",
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"id":         "id",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
 	}
 }
 
-// collectCredentialCursorValues collects values from the given resource that and sets them to the cursor
+// collectComposeAttachmentCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -9492,7 +8355,7 @@ func (Store) sortableCredentialFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectCredentialCursorValues(res *systemType.Credential, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectComposeAttachmentCursorValues(res *composeType.Attachment, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -9512,10 +8375,6 @@ func (s *Store) collectCredentialCursorValues(res *systemType.Credential, cc ...
 					cur.Set(c.Column, res.UpdatedAt, c.Descending)
 				case "deletedAt":
 					cur.Set(c.Column, res.DeletedAt, c.Descending)
-				case "lastUsedAt":
-					cur.Set(c.Column, res.LastUsedAt, c.Descending)
-				case "expiresAt":
-					cur.Set(c.Column, res.ExpiresAt, c.Descending)
 				}
 			}
 		}
@@ -9530,27 +8389,27 @@ func (s *Store) collectCredentialCursorValues(res *systemType.Credential, cc ...
 
 }
 
-// checkCredentialConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkComposeAttachmentConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkCredentialConstraints(ctx context.Context, res *systemType.Credential) (err error) {
+func (s *Store) checkComposeAttachmentConstraints(ctx context.Context, res *composeType.Attachment) (err error) {
 	return nil
 }
 
-// CreateDalConnection creates one or more rows in dalConnection collection
+// CreateComposeChart creates one or more rows in composeChart collection
 //
 // This function is auto-generated
-func (s *Store) CreateDalConnection(ctx context.Context, rr ...*systemType.DalConnection) (err error) {
+func (s *Store) CreateComposeChart(ctx context.Context, rr ...*composeType.Chart) (err error) {
 	for i := range rr {
-		if err = s.checkDalConnectionConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeChartConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, dalConnectionInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeChartInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -9558,16 +8417,16 @@ func (s *Store) CreateDalConnection(ctx context.Context, rr ...*systemType.DalCo
 	return
 }
 
-// UpdateDalConnection updates one or more existing entries in dalConnection collection
+// UpdateComposeChart updates one or more existing entries in composeChart collection
 //
 // This function is auto-generated
-func (s *Store) UpdateDalConnection(ctx context.Context, rr ...*systemType.DalConnection) (err error) {
+func (s *Store) UpdateComposeChart(ctx context.Context, rr ...*composeType.Chart) (err error) {
 	for i := range rr {
-		if err = s.checkDalConnectionConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeChartConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, dalConnectionUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeChartUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -9575,16 +8434,16 @@ func (s *Store) UpdateDalConnection(ctx context.Context, rr ...*systemType.DalCo
 	return
 }
 
-// UpsertDalConnection updates one or more existing entries in dalConnection collection
+// UpsertComposeChart updates one or more existing entries in composeChart collection
 //
 // This function is auto-generated
-func (s *Store) UpsertDalConnection(ctx context.Context, rr ...*systemType.DalConnection) (err error) {
+func (s *Store) UpsertComposeChart(ctx context.Context, rr ...*composeType.Chart) (err error) {
 	for i := range rr {
-		if err = s.checkDalConnectionConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeChartConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, dalConnectionUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeChartUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -9592,12 +8451,12 @@ func (s *Store) UpsertDalConnection(ctx context.Context, rr ...*systemType.DalCo
 	return
 }
 
-// DeleteDalConnection Deletes one or more entries from dalConnection collection
+// DeleteComposeChart Deletes one or more entries from composeChart collection
 //
 // This function is auto-generated
-func (s *Store) DeleteDalConnection(ctx context.Context, rr ...*systemType.DalConnection) (err error) {
+func (s *Store) DeleteComposeChart(ctx context.Context, rr ...*composeType.Chart) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, dalConnectionDeleteQuery(s.Dialect, dalConnectionPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, composeChartDeleteQuery(s.Dialect, composeChartPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -9605,26 +8464,60 @@ func (s *Store) DeleteDalConnection(ctx context.Context, rr ...*systemType.DalCo
 	return nil
 }
 
-// DeleteDalConnectionByID deletes single entry from dalConnection collection
+// DeleteComposeChartByID deletes single entry from composeChart collection
 //
 // This function is auto-generated
-func (s *Store) DeleteDalConnectionByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, dalConnectionDeleteQuery(s.Dialect, goqu.Ex{
+func (s *Store) DeleteComposeChartByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, composeChartDeleteQuery(s.Dialect, goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateDalConnections Deletes all rows from the dalConnection collection
-func (s Store) TruncateDalConnections(ctx context.Context) error {
-	return s.Exec(ctx, dalConnectionTruncateQuery(s.Dialect))
+// TruncateComposeCharts Deletes all rows from the composeChart collection
+func (s Store) TruncateComposeCharts(ctx context.Context) error {
+	return s.Exec(ctx, composeChartTruncateQuery(s.Dialect))
 }
 
-// SearchDalConnections returns (filtered) set of DalConnections
+// SearchComposeCharts returns (filtered) set of ComposeCharts
 //
 // This function is auto-generated
-func (s *Store) SearchDalConnections(ctx context.Context, f systemType.DalConnectionFilter) (set systemType.DalConnectionSet, _ systemType.DalConnectionFilter, err error) {
+func (s *Store) SearchComposeCharts(ctx context.Context, f composeType.ChartFilter) (set composeType.ChartSet, _ composeType.ChartFilter, err error) {
 
-	set, _, err = s.QueryDalConnections(ctx, f)
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfComposeCharts fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposeCharts(ctx, f, sort)
+
+	f.PageCursor = nil
 	if err != nil {
 		return nil, f, err
 	}
@@ -9632,82 +8525,230 @@ func (s *Store) SearchDalConnections(ctx context.Context, f systemType.DalConnec
 	return set, f, nil
 }
 
-// QueryDalConnections queries the database, converts and checks each row and returns collected set
+// fetchFullPageOfComposeCharts collects all requested results.
 //
-// With generics, we can remove this per-resource-generated function
-// and replace it with a single utility fetcher
+// Function applies:
+//  - cursor conditions (where ...)
+//  - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) QueryDalConnections(
+func (s *Store) fetchFullPageOfComposeCharts(
 	ctx context.Context,
-	f systemType.DalConnectionFilter,
-) (_ []*systemType.DalConnection, more bool, err error) {
+	filter composeType.ChartFilter,
+	sort filter.SortExprSet,
+) (set []*composeType.Chart, prev, next *filter.PagingCursor, err error) {
 	var (
-		ok bool
+		aux []*composeType.Chart
 
-		set         = make([]*systemType.DalConnection, 0, DefaultSliceCapacity)
-		res         *systemType.DalConnection
-		aux         *auxDalConnection
-		rows        *sql.Rows
-		count       uint
-		expr, tExpr []goqu.Expression
-	)
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
 
-	if s.Filters.DalConnection != nil {
-		// extended filter set
-		tExpr, f, err = s.Filters.DalConnection(s, f)
-	} else {
-		// using generated filter
-		tExpr, f, err = DalConnectionFilter(f)
-	}
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
 
-	if err != nil {
-		err = fmt.Errorf("could generate filter expression for DalConnection: %w", err)
-		return
-	}
+		reqItems = filter.Limit
 
-	expr = append(expr, tExpr...)
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
 
-	query := dalConnectionSelectQuery(s.Dialect).Where(expr...)
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
 
-	if f.Limit > 0 {
-		query = query.Limit(f.Limit)
-	}
+		tryFilter composeType.ChartFilter
+	)
 
-	rows, err = s.Query(ctx, query)
-	if err != nil {
-		err = fmt.Errorf("could not query DalConnection: %w", err)
-		return
-	}
+	set = make([]*composeType.Chart, 0, DefaultSliceCapacity)
 
-	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query DalConnection: %w", err)
-		return
-	}
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
 
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
+		}
+
+		if aux, hasNext, err = s.QueryComposeCharts(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if len(aux) == 0 {
+			// nothing fetched
+			break
+		}
+
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
+		}
+
+		collected := uint(len(set))
+
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
+
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectComposeChartCursorValues(set[collected-1], filter.Sort...)
+
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
+		}
+
+		break
+	}
+
+	collected := len(set)
+
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
+
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
+
+	if hasPrev {
+		prev = s.collectComposeChartCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
+	}
+
+	if hasNext {
+		next = s.collectComposeChartCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
+}
+
+// QueryComposeCharts queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryComposeCharts(
+	ctx context.Context,
+	f composeType.ChartFilter,
+) (_ []*composeType.Chart, more bool, err error) {
+	var (
+		ok bool
+
+		set         = make([]*composeType.Chart, 0, DefaultSliceCapacity)
+		res         *composeType.Chart
+		aux         *auxComposeChart
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
+	)
+
+	if s.Filters.ComposeChart != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.ComposeChart(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = ComposeChartFilter(f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for ComposeChart: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursor(f.PageCursor); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := composeChartSelectQuery(s.Dialect).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableComposeChartFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for ComposeChart: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query ComposeChart: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query ComposeChart: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query DalConnection: %w", err)
+			err = fmt.Errorf("could not query ComposeChart: %w", err)
 			return
 		}
 
-		aux = new(auxDalConnection)
+		aux = new(auxComposeChart)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for DalConnection: %w", err)
+			err = fmt.Errorf("could not scan rows for ComposeChart: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode DalConnection: %w", err)
+			err = fmt.Errorf("could not decode ComposeChart: %w", err)
 			return
 		}
 
@@ -9724,20 +8765,20 @@ func (s *Store) QueryDalConnections(
 		set = append(set, res)
 	}
 
-	return set, false, err
+	return set, f.Limit > 0 && count >= f.Limit, err
 
 }
 
-// LookupDalConnectionByID searches for connection by ID
+// LookupComposeChartByID searches for compose chart by ID
 //
-// It returns connection even if deleted or suspended
+// It returns compose chart even if deleted
 //
 // This function is auto-generated
-func (s *Store) LookupDalConnectionByID(ctx context.Context, id uint64) (_ *systemType.DalConnection, err error) {
+func (s *Store) LookupComposeChartByID(ctx context.Context, id uint64) (_ *composeType.Chart, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxDalConnection)
-		lookup = dalConnectionSelectQuery(s.Dialect).Where(
+		aux    = new(auxComposeChart)
+		lookup = composeChartSelectQuery(s.Dialect).Where(
 			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
@@ -9770,16 +8811,15 @@ func (s *Store) LookupDalConnectionByID(ctx context.Context, id uint64) (_ *syst
 	return aux.decode()
 }
 
-// LookupDalConnectionByHandle searches for connection by handle
-//
-// It returns only valid connection (not deleted)
+// LookupComposeChartByNamespaceIDHandle searches for compose chart by handle (case-insensitive)
 //
 // This function is auto-generated
-func (s *Store) LookupDalConnectionByHandle(ctx context.Context, handle string) (_ *systemType.DalConnection, err error) {
+func (s *Store) LookupComposeChartByNamespaceIDHandle(ctx context.Context, namespaceID uint64, handle string) (_ *composeType.Chart, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxDalConnection)
-		lookup = dalConnectionSelectQuery(s.Dialect).Where(
+		aux    = new(auxComposeChart)
+		lookup = composeChartSelectQuery(s.Dialect).Where(
+			goqu.I("rel_namespace").Eq(namespaceID),
 			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
 			goqu.I("deleted_at").IsNull(),
 		).Limit(1)
@@ -9813,12 +8853,12 @@ func (s *Store) LookupDalConnectionByHandle(ctx context.Context, handle string)
 	return aux.decode()
 }
 
-// sortableDalConnectionFields returns all <no value> columns flagged as sortable
+// sortableComposeChartFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableDalConnectionFields() map[string]string {
+func (Store) sortableComposeChartFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
@@ -9831,7 +8871,7 @@ func (Store) sortableDalConnectionFields() map[string]string {
 	}
 }
 
-// collectDalConnectionCursorValues collects values from the given resource that and sets them to the cursor
+// collectComposeChartCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -9842,7 +8882,7 @@ func (Store) sortableDalConnectionFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectDalConnectionCursorValues(res *systemType.DalConnection, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectComposeChartCursorValues(res *composeType.Chart, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -9879,54 +8919,27 @@ func (s *Store) collectDalConnectionCursorValues(res *systemType.DalConnection,
 
 }
 
-// checkDalConnectionConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkComposeChartConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkDalConnectionConstraints(ctx context.Context, res *systemType.DalConnection) (err error) {
-	err = func() (err error) {
-
-		// handling string type as default
-		if len(res.Handle) == 0 {
-			// skip check on empty values
-			return nil
-		}
-
-		if res.DeletedAt != nil {
-			// skip check if value is not nil
-			return nil
-		}
-
-		ex, err := s.LookupDalConnectionByHandle(ctx, res.Handle)
-		if err == nil && ex != nil && ex.ID != res.ID {
-			return store.ErrNotUnique.Stack(1)
-		} else if !errors.IsNotFound(err) {
-			return err
-		}
-
-		return nil
-	}()
-
-	if err != nil {
-		return
-	}
-
+func (s *Store) checkComposeChartConstraints(ctx context.Context, res *composeType.Chart) (err error) {
 	return nil
 }
 
-// CreateDalSensitivityLevel creates one or more rows in dalSensitivityLevel collection
+// CreateComposeModule creates one or more rows in composeModule collection
 //
 // This function is auto-generated
-func (s *Store) CreateDalSensitivityLevel(ctx context.Context, rr ...*systemType.DalSensitivityLevel) (err error) {
+func (s *Store) CreateComposeModule(ctx context.Context, rr ...*composeType.Module) (err error) {
 	for i := range rr {
-		if err = s.checkDalSensitivityLevelConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeModuleConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, dalSensitivityLevelInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeModuleInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -9934,16 +8947,16 @@ func (s *Store) CreateDalSensitivityLevel(ctx context.Context, rr ...*systemType
 	return
 }
 
-// UpdateDalSensitivityLevel updates one or more existing entries in dalSensitivityLevel collection
+// UpdateComposeModule updates one or more existing entries in composeModule collection
 //
 // This function is auto-generated
-func (s *Store) UpdateDalSensitivityLevel(ctx context.Context, rr ...*systemType.DalSensitivityLevel) (err error) {
+func (s *Store) UpdateComposeModule(ctx context.Context, rr ...*composeType.Module) (err error) {
 	for i := range rr {
-		if err = s.checkDalSensitivityLevelConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeModuleConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, dalSensitivityLevelUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeModuleUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -9951,16 +8964,16 @@ func (s *Store) UpdateDalSensitivityLevel(ctx context.Context, rr ...*systemType
 	return
 }
 
-// UpsertDalSensitivityLevel updates one or more existing entries in dalSensitivityLevel collection
+// UpsertComposeModule updates one or more existing entries in composeModule collection
 //
 // This function is auto-generated
-func (s *Store) UpsertDalSensitivityLevel(ctx context.Context, rr ...*systemType.DalSensitivityLevel) (err error) {
+func (s *Store) UpsertComposeModule(ctx context.Context, rr ...*composeType.Module) (err error) {
 	for i := range rr {
-		if err = s.checkDalSensitivityLevelConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeModuleConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, dalSensitivityLevelUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeModuleUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -9968,12 +8981,12 @@ func (s *Store) UpsertDalSensitivityLevel(ctx context.Context, rr ...*systemType
 	return
 }
 
-// DeleteDalSensitivityLevel Deletes one or more entries from dalSensitivityLevel collection
+// DeleteComposeModule Deletes one or more entries from composeModule collection
 //
 // This function is auto-generated
-func (s *Store) DeleteDalSensitivityLevel(ctx context.Context, rr ...*systemType.DalSensitivityLevel) (err error) {
+func (s *Store) DeleteComposeModule(ctx context.Context, rr ...*composeType.Module) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, dalSensitivityLevelDeleteQuery(s.Dialect, dalSensitivityLevelPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, composeModuleDeleteQuery(s.Dialect, composeModulePrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -9981,26 +8994,60 @@ func (s *Store) DeleteDalSensitivityLevel(ctx context.Context, rr ...*systemType
 	return nil
 }
 
-// DeleteDalSensitivityLevelByID deletes single entry from dalSensitivityLevel collection
+// DeleteComposeModuleByID deletes single entry from composeModule collection
 //
 // This function is auto-generated
-func (s *Store) DeleteDalSensitivityLevelByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, dalSensitivityLevelDeleteQuery(s.Dialect, goqu.Ex{
+func (s *Store) DeleteComposeModuleByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, composeModuleDeleteQuery(s.Dialect, goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateDalSensitivityLevels Deletes all rows from the dalSensitivityLevel collection
-func (s Store) TruncateDalSensitivityLevels(ctx context.Context) error {
-	return s.Exec(ctx, dalSensitivityLevelTruncateQuery(s.Dialect))
+// TruncateComposeModules Deletes all rows from the composeModule collection
+func (s Store) TruncateComposeModules(ctx context.Context) error {
+	return s.Exec(ctx, composeModuleTruncateQuery(s.Dialect))
 }
 
-// SearchDalSensitivityLevels returns (filtered) set of DalSensitivityLevels
+// SearchComposeModules returns (filtered) set of ComposeModules
 //
 // This function is auto-generated
-func (s *Store) SearchDalSensitivityLevels(ctx context.Context, f systemType.DalSensitivityLevelFilter) (set systemType.DalSensitivityLevelSet, _ systemType.DalSensitivityLevelFilter, err error) {
+func (s *Store) SearchComposeModules(ctx context.Context, f composeType.ModuleFilter) (set composeType.ModuleSet, _ composeType.ModuleFilter, err error) {
 
-	set, _, err = s.QueryDalSensitivityLevels(ctx, f)
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfComposeModules fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposeModules(ctx, f, sort)
+
+	f.PageCursor = nil
 	if err != nil {
 		return nil, f, err
 	}
@@ -10008,43 +9055,191 @@ func (s *Store) SearchDalSensitivityLevels(ctx context.Context, f systemType.Dal
 	return set, f, nil
 }
 
-// QueryDalSensitivityLevels queries the database, converts and checks each row and returns collected set
+// fetchFullPageOfComposeModules collects all requested results.
+//
+// Function applies:
+//  - cursor conditions (where ...)
+//  - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// Function then moves cursor to the last item fetched
+//
+// This function is auto-generated
+func (s *Store) fetchFullPageOfComposeModules(
+	ctx context.Context,
+	filter composeType.ModuleFilter,
+	sort filter.SortExprSet,
+) (set []*composeType.Module, prev, next *filter.PagingCursor, err error) {
+	var (
+		aux []*composeType.Module
+
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
+
+		reqItems = filter.Limit
+
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
+
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
+
+		tryFilter composeType.ModuleFilter
+	)
+
+	set = make([]*composeType.Module, 0, DefaultSliceCapacity)
+
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
+		}
+
+		if aux, hasNext, err = s.QueryComposeModules(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if len(aux) == 0 {
+			// nothing fetched
+			break
+		}
+
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
+		}
+
+		collected := uint(len(set))
+
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
+
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectComposeModuleCursorValues(set[collected-1], filter.Sort...)
+
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
+		}
+
+		break
+	}
+
+	collected := len(set)
+
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
+
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
+
+	if hasPrev {
+		prev = s.collectComposeModuleCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
+	}
+
+	if hasNext {
+		next = s.collectComposeModuleCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
+}
+
+// QueryComposeModules queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryDalSensitivityLevels(
+func (s *Store) QueryComposeModules(
 	ctx context.Context,
-	f systemType.DalSensitivityLevelFilter,
-) (_ []*systemType.DalSensitivityLevel, more bool, err error) {
+	f composeType.ModuleFilter,
+) (_ []*composeType.Module, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*systemType.DalSensitivityLevel, 0, DefaultSliceCapacity)
-		res         *systemType.DalSensitivityLevel
-		aux         *auxDalSensitivityLevel
+		set         = make([]*composeType.Module, 0, DefaultSliceCapacity)
+		res         *composeType.Module
+		aux         *auxComposeModule
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.DalSensitivityLevel != nil {
+	if s.Filters.ComposeModule != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.DalSensitivityLevel(s, f)
+		tExpr, f, err = s.Filters.ComposeModule(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = DalSensitivityLevelFilter(f)
+		tExpr, f, err = ComposeModuleFilter(f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for DalSensitivityLevel: %w", err)
+		err = fmt.Errorf("could generate filter expression for ComposeModule: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	query := dalSensitivityLevelSelectQuery(s.Dialect).Where(expr...)
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursor(f.PageCursor); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := composeModuleSelectQuery(s.Dialect).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableComposeModuleFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for ComposeModule: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
 
 	if f.Limit > 0 {
 		query = query.Limit(f.Limit)
@@ -10052,12 +9247,12 @@ func (s *Store) QueryDalSensitivityLevels(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query DalSensitivityLevel: %w", err)
+		err = fmt.Errorf("could not query ComposeModule: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query DalSensitivityLevel: %w", err)
+		err = fmt.Errorf("could not query ComposeModule: %w", err)
 		return
 	}
 
@@ -10071,19 +9266,19 @@ func (s *Store) QueryDalSensitivityLevels(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query DalSensitivityLevel: %w", err)
+			err = fmt.Errorf("could not query ComposeModule: %w", err)
 			return
 		}
 
-		aux = new(auxDalSensitivityLevel)
+		aux = new(auxComposeModule)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for DalSensitivityLevel: %w", err)
+			err = fmt.Errorf("could not scan rows for ComposeModule: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode DalSensitivityLevel: %w", err)
+			err = fmt.Errorf("could not decode ComposeModule: %w", err)
 			return
 		}
 
@@ -10100,25 +9295,25 @@ func (s *Store) QueryDalSensitivityLevels(
 		set = append(set, res)
 	}
 
-	return set, false, err
+	return set, f.Limit > 0 && count >= f.Limit, err
 
 }
 
-// LookupDalSensitivityLevelByID searches for user by ID
-//
-// It returns user even if deleted or suspended
+// LookupComposeModuleByNamespaceIDHandle searches for compose module by handle (case-insensitive)
 //
 // This function is auto-generated
-func (s *Store) LookupDalSensitivityLevelByID(ctx context.Context, id uint64) (_ *systemType.DalSensitivityLevel, err error) {
+func (s *Store) LookupComposeModuleByNamespaceIDHandle(ctx context.Context, namespaceID uint64, handle string) (_ *composeType.Module, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxDalSensitivityLevel)
-		lookup = dalSensitivityLevelSelectQuery(s.Dialect).Where(
-			goqu.I("id").Eq(id),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
+		aux    = new(auxComposeModule)
+		lookup = composeModuleSelectQuery(s.Dialect).Where(
+			goqu.I("rel_namespace").Eq(namespaceID),
+			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
+			goqu.I("deleted_at").IsNull(),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
 	if err != nil {
 		return
 	}
@@ -10146,12 +9341,96 @@ func (s *Store) LookupDalSensitivityLevelByID(ctx context.Context, id uint64) (_
 	return aux.decode()
 }
 
-// sortableDalSensitivityLevelFields returns all <no value> columns flagged as sortable
+// LookupComposeModuleByNamespaceIDName searches for compose module by name (case-insensitive)
+//
+// This function is auto-generated
+func (s *Store) LookupComposeModuleByNamespaceIDName(ctx context.Context, namespaceID uint64, name string) (_ *composeType.Module, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxComposeModule)
+		lookup = composeModuleSelectQuery(s.Dialect).Where(
+			goqu.I("rel_namespace").Eq(namespaceID),
+			goqu.I("name").Eq(name),
+			goqu.I("deleted_at").IsNull(),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// LookupComposeModuleByID searches for compose module by ID
+//
+// It returns compose module even if deleted
+//
+// This function is auto-generated
+func (s *Store) LookupComposeModuleByID(ctx context.Context, id uint64) (_ *composeType.Module, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxComposeModule)
+		lookup = composeModuleSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableComposeModuleFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableDalSensitivityLevelFields() map[string]string {
+func (Store) sortableComposeModuleFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
@@ -10164,7 +9443,7 @@ func (Store) sortableDalSensitivityLevelFields() map[string]string {
 	}
 }
 
-// collectDalSensitivityLevelCursorValues collects values from the given resource that and sets them to the cursor
+// collectComposeModuleCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -10175,7 +9454,7 @@ func (Store) sortableDalSensitivityLevelFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectDalSensitivityLevelCursorValues(res *systemType.DalSensitivityLevel, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectComposeModuleCursorValues(res *composeType.Module, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -10212,27 +9491,59 @@ func (s *Store) collectDalSensitivityLevelCursorValues(res *systemType.DalSensit
 
 }
 
-// checkDalSensitivityLevelConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkComposeModuleConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkDalSensitivityLevelConstraints(ctx context.Context, res *systemType.DalSensitivityLevel) (err error) {
+func (s *Store) checkComposeModuleConstraints(ctx context.Context, res *composeType.Module) (err error) {
+	err = func() (err error) {
+
+		if res.NamespaceID == 0 {
+			// skip check on empty values
+			return nil
+		}
+
+		// handling string type as default
+		if len(res.Handle) == 0 {
+			// skip check on empty values
+			return nil
+		}
+
+		if res.DeletedAt != nil {
+			// skip check if value is not nil
+			return nil
+		}
+
+		ex, err := s.LookupComposeModuleByNamespaceIDHandle(ctx, res.NamespaceID, res.Handle)
+		if err == nil && ex != nil && ex.ID != res.ID {
+			return store.ErrNotUnique.Stack(1)
+		} else if !errors.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}()
+
+	if err != nil {
+		return
+	}
+
 	return nil
 }
 
-// CreateFederationExposedModule creates one or more rows in federationExposedModule collection
+// CreateComposeModuleField creates one or more rows in composeModuleField collection
 //
 // This function is auto-generated
-func (s *Store) CreateFederationExposedModule(ctx context.Context, rr ...*federationType.ExposedModule) (err error) {
+func (s *Store) CreateComposeModuleField(ctx context.Context, rr ...*composeType.ModuleField) (err error) {
 	for i := range rr {
-		if err = s.checkFederationExposedModuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeModuleFieldConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationExposedModuleInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeModuleFieldInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -10240,16 +9551,16 @@ func (s *Store) CreateFederationExposedModule(ctx context.Context, rr ...*federa
 	return
 }
 
-// UpdateFederationExposedModule updates one or more existing entries in federationExposedModule collection
+// UpdateComposeModuleField updates one or more existing entries in composeModuleField collection
 //
 // This function is auto-generated
-func (s *Store) UpdateFederationExposedModule(ctx context.Context, rr ...*federationType.ExposedModule) (err error) {
+func (s *Store) UpdateComposeModuleField(ctx context.Context, rr ...*composeType.ModuleField) (err error) {
 	for i := range rr {
-		if err = s.checkFederationExposedModuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeModuleFieldConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationExposedModuleUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeModuleFieldUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -10257,16 +9568,16 @@ func (s *Store) UpdateFederationExposedModule(ctx context.Context, rr ...*federa
 	return
 }
 
-// UpsertFederationExposedModule updates one or more existing entries in federationExposedModule collection
+// UpsertComposeModuleField updates one or more existing entries in composeModuleField collection
 //
 // This function is auto-generated
-func (s *Store) UpsertFederationExposedModule(ctx context.Context, rr ...*federationType.ExposedModule) (err error) {
+func (s *Store) UpsertComposeModuleField(ctx context.Context, rr ...*composeType.ModuleField) (err error) {
 	for i := range rr {
-		if err = s.checkFederationExposedModuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeModuleFieldConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationExposedModuleUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeModuleFieldUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -10274,12 +9585,12 @@ func (s *Store) UpsertFederationExposedModule(ctx context.Context, rr ...*federa
 	return
 }
 
-// DeleteFederationExposedModule Deletes one or more entries from federationExposedModule collection
+// DeleteComposeModuleField Deletes one or more entries from composeModuleField collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFederationExposedModule(ctx context.Context, rr ...*federationType.ExposedModule) (err error) {
+func (s *Store) DeleteComposeModuleField(ctx context.Context, rr ...*composeType.ModuleField) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, federationExposedModuleDeleteQuery(s.Dialect, federationExposedModulePrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, composeModuleFieldDeleteQuery(s.Dialect, composeModuleFieldPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -10287,60 +9598,26 @@ func (s *Store) DeleteFederationExposedModule(ctx context.Context, rr ...*federa
 	return nil
 }
 
-// DeleteFederationExposedModuleByID deletes single entry from federationExposedModule collection
+// DeleteComposeModuleFieldByID deletes single entry from composeModuleField collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFederationExposedModuleByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, federationExposedModuleDeleteQuery(s.Dialect, goqu.Ex{
+func (s *Store) DeleteComposeModuleFieldByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, composeModuleFieldDeleteQuery(s.Dialect, goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateFederationExposedModules Deletes all rows from the federationExposedModule collection
-func (s Store) TruncateFederationExposedModules(ctx context.Context) error {
-	return s.Exec(ctx, federationExposedModuleTruncateQuery(s.Dialect))
+// TruncateComposeModuleFields Deletes all rows from the composeModuleField collection
+func (s Store) TruncateComposeModuleFields(ctx context.Context) error {
+	return s.Exec(ctx, composeModuleFieldTruncateQuery(s.Dialect))
 }
 
-// SearchFederationExposedModules returns (filtered) set of FederationExposedModules
+// SearchComposeModuleFields returns (filtered) set of ComposeModuleFields
 //
 // This function is auto-generated
-func (s *Store) SearchFederationExposedModules(ctx context.Context, f federationType.ExposedModuleFilter) (set federationType.ExposedModuleSet, _ federationType.ExposedModuleFilter, err error) {
-
-	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
-	f.PrevPage, f.NextPage = nil, nil
-
-	if f.PageCursor != nil {
-		// Page cursor exists; we need to validate it against used sort
-		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
-		// from the cursor.
-		// This (extracted sorting info) is then returned as part of response
-		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
-			return
-		}
-	}
-
-	// Make sure results are always sorted at least by primary keys
-	if f.Sort.Get("id") == nil {
-		f.Sort = append(f.Sort, &filter.SortExpr{
-			Column:     "id",
-			Descending: f.Sort.LastDescending(),
-		})
-	}
-
-	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfFederationExposedModules fn used for cursor creation;
-	// direction information it MUST keep the initial
-	sort := f.Sort.Clone()
-
-	// When cursor for a previous page is used it's marked as reversed
-	// This tells us to flip the descending flag on all used sort keys
-	if f.PageCursor != nil && f.PageCursor.ROrder {
-		sort.Reverse()
-	}
-
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfFederationExposedModules(ctx, f, sort)
+func (s *Store) SearchComposeModuleFields(ctx context.Context, f composeType.ModuleFieldFilter) (set composeType.ModuleFieldSet, _ composeType.ModuleFieldFilter, err error) {
 
-	f.PageCursor = nil
+	set, _, err = s.QueryComposeModuleFields(ctx, f)
 	if err != nil {
 		return nil, f, err
 	}
@@ -10348,191 +9625,41 @@ func (s *Store) SearchFederationExposedModules(ctx context.Context, f federation
 	return set, f, nil
 }
 
-// fetchFullPageOfFederationExposedModules collects all requested results.
-//
-// Function applies:
-//  - cursor conditions (where ...)
-//  - limit
-//
-// Main responsibility of this function is to perform additional sequential queries in case when not enough results
-// are collected due to failed check on a specific row (by check fn).
+// QueryComposeModuleFields queries the database, converts and checks each row and returns collected set
 //
-// Function then moves cursor to the last item fetched
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfFederationExposedModules(
+func (s *Store) QueryComposeModuleFields(
 	ctx context.Context,
-	filter federationType.ExposedModuleFilter,
-	sort filter.SortExprSet,
-) (set []*federationType.ExposedModule, prev, next *filter.PagingCursor, err error) {
+	f composeType.ModuleFieldFilter,
+) (_ []*composeType.ModuleField, more bool, err error) {
 	var (
-		aux []*federationType.ExposedModule
+		set         = make([]*composeType.ModuleField, 0, DefaultSliceCapacity)
+		res         *composeType.ModuleField
+		aux         *auxComposeModuleField
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+	)
 
-		// When cursor for a previous page is used it's marked as reversed
-		// This tells us to flip the descending flag on all used sort keys
-		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
-
-		// Copy no. of required items to limit
-		// Limit will change when doing subsequent queries to fill
-		// the set with all required items
-		limit = filter.Limit
-
-		reqItems = filter.Limit
-
-		// cursor to prev. page is only calculated when cursor is used
-		hasPrev = filter.PageCursor != nil
-
-		// next cursor is calculated when there are more pages to come
-		hasNext bool
-
-		tryFilter federationType.ExposedModuleFilter
-	)
-
-	set = make([]*federationType.ExposedModule, 0, DefaultSliceCapacity)
-
-	for try := 0; try < MaxRefetches; try++ {
-		// Copy filter & apply custom sorting that might be affected by cursor
-		tryFilter = filter
-		tryFilter.Sort = sort
-
-		if limit > 0 {
-			// fetching + 1 to peak ahead if there are more items
-			// we can fetch (next-page cursor)
-			tryFilter.Limit = limit + 1
-		}
-
-		if aux, hasNext, err = s.QueryFederationExposedModules(ctx, tryFilter); err != nil {
-			return nil, nil, nil, err
-		}
-
-		if len(aux) == 0 {
-			// nothing fetched
-			break
-		}
-
-		// append fetched items
-		set = append(set, aux...)
-
-		if reqItems == 0 || !hasNext {
-			// no max requested items specified, break out
-			break
-		}
-
-		collected := uint(len(set))
-
-		if reqItems > collected {
-			// not enough items fetched, try again with adjusted limit
-			limit = reqItems - collected
-
-			if limit < MinEnsureFetchLimit {
-				// In case limit is set very low and we've missed records in the first fetch,
-				// make sure next fetch limit is a bit higher
-				limit = MinEnsureFetchLimit
-			}
-
-			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectFederationExposedModuleCursorValues(set[collected-1], filter.Sort...)
-
-			// Copy reverse flag from sorting
-			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
-			continue
-		}
-
-		if reqItems < collected {
-			set = set[:reqItems]
-		}
-
-		break
-	}
-
-	collected := len(set)
-
-	if collected == 0 {
-		return nil, nil, nil, nil
-	}
-
-	if reversedOrder {
-		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
-		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
-			set[i], set[j] = set[j], set[i]
-		}
-
-		// when in reverse-order rules on what cursor to return change
-		hasPrev, hasNext = hasNext, hasPrev
-	}
-
-	if hasPrev {
-		prev = s.collectFederationExposedModuleCursorValues(set[0], filter.Sort...)
-		prev.ROrder = true
-		prev.LThen = !filter.Sort.Reversed()
-	}
-
-	if hasNext {
-		next = s.collectFederationExposedModuleCursorValues(set[collected-1], filter.Sort...)
-		next.LThen = filter.Sort.Reversed()
-	}
-
-	return set, prev, next, nil
-}
-
-// QueryFederationExposedModules queries the database, converts and checks each row and returns collected set
-//
-// With generics, we can remove this per-resource-generated function
-// and replace it with a single utility fetcher
-//
-// This function is auto-generated
-func (s *Store) QueryFederationExposedModules(
-	ctx context.Context,
-	f federationType.ExposedModuleFilter,
-) (_ []*federationType.ExposedModule, more bool, err error) {
-	var (
-		ok bool
-
-		set         = make([]*federationType.ExposedModule, 0, DefaultSliceCapacity)
-		res         *federationType.ExposedModule
-		aux         *auxFederationExposedModule
-		rows        *sql.Rows
-		count       uint
-		expr, tExpr []goqu.Expression
-
-		sortExpr []exp.OrderedExpression
-	)
-
-	if s.Filters.FederationExposedModule != nil {
-		// extended filter set
-		tExpr, f, err = s.Filters.FederationExposedModule(s, f)
-	} else {
-		// using generated filter
-		tExpr, f, err = FederationExposedModuleFilter(f)
-	}
+	if s.Filters.ComposeModuleField != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.ComposeModuleField(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = ComposeModuleFieldFilter(f)
+	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for FederationExposedModule: %w", err)
+		err = fmt.Errorf("could generate filter expression for ComposeModuleField: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	// paging feature is enabled
-	if f.PageCursor != nil {
-		if tExpr, err = cursor(f.PageCursor); err != nil {
-			return
-		} else {
-			expr = append(expr, tExpr...)
-		}
-	}
-
-	query := federationExposedModuleSelectQuery(s.Dialect).Where(expr...)
-
-	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableFederationExposedModuleFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for FederationExposedModule: %w", err)
-		return
-	}
-
-	if len(sortExpr) > 0 {
-		query = query.Order(sortExpr...)
-	}
+	query := composeModuleFieldSelectQuery(s.Dialect).Where(expr...)
 
 	if f.Limit > 0 {
 		query = query.Limit(f.Limit)
@@ -10540,12 +9667,12 @@ func (s *Store) QueryFederationExposedModules(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query FederationExposedModule: %w", err)
+		err = fmt.Errorf("could not query ComposeModuleField: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query FederationExposedModule: %w", err)
+		err = fmt.Errorf("could not query ComposeModuleField: %w", err)
 		return
 	}
 
@@ -10559,50 +9686,40 @@ func (s *Store) QueryFederationExposedModules(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query FederationExposedModule: %w", err)
+			err = fmt.Errorf("could not query ComposeModuleField: %w", err)
 			return
 		}
 
-		aux = new(auxFederationExposedModule)
+		aux = new(auxComposeModuleField)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for FederationExposedModule: %w", err)
+			err = fmt.Errorf("could not scan rows for ComposeModuleField: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode FederationExposedModule: %w", err)
+			err = fmt.Errorf("could not decode ComposeModuleField: %w", err)
 			return
 		}
 
-		// check fn set, call it and see if it passed the test
-		// if not, skip the item
-		if f.Check != nil {
-			if ok, err = f.Check(res); err != nil {
-				return
-			} else if !ok {
-				continue
-			}
-		}
-
 		set = append(set, res)
 	}
 
-	return set, f.Limit > 0 && count >= f.Limit, err
+	return set, false, err
 
 }
 
-// LookupFederationExposedModuleByID searches for federation module by ID
-//
-// It returns federation module
+// LookupComposeModuleFieldByModuleIDName searches for compose module field by name (case-insensitive)
 //
 // This function is auto-generated
-func (s *Store) LookupFederationExposedModuleByID(ctx context.Context, id uint64) (_ *federationType.ExposedModule, err error) {
+func (s *Store) LookupComposeModuleFieldByModuleIDName(ctx context.Context, moduleID uint64, name string) (_ *composeType.ModuleField, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxFederationExposedModule)
-		lookup = federationExposedModuleSelectQuery(s.Dialect).Where(
-			goqu.I("id").Eq(id),
+		aux    = new(auxComposeModuleField)
+		lookup = composeModuleFieldSelectQuery(s.Dialect).Where(
+			goqu.I("rel_module").Eq(moduleID),
+			goqu.I("name").Eq(name),
+			goqu.I("deleted_at").IsNull(),
 		).Limit(1)
 	)
 
@@ -10634,25 +9751,18 @@ func (s *Store) LookupFederationExposedModuleByID(ctx context.Context, id uint64
 	return aux.decode()
 }
 
-// sortableFederationExposedModuleFields returns all <no value> columns flagged as sortable
+// sortableComposeModuleFieldFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableFederationExposedModuleFields() map[string]string {
+func (Store) sortableComposeModuleFieldFields() map[string]string {
 	return map[string]string{
-		"created_at": "created_at",
-		"createdat":  "created_at",
-		"deleted_at": "deleted_at",
-		"deletedat":  "deleted_at",
-		"handle":     "handle",
-		"id":         "id",
-		"updated_at": "updated_at",
-		"updatedat":  "updated_at",
+		"id": "id",
 	}
 }
 
-// collectFederationExposedModuleCursorValues collects values from the given resource that and sets them to the cursor
+// collectComposeModuleFieldCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -10663,7 +9773,7 @@ func (Store) sortableFederationExposedModuleFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectFederationExposedModuleCursorValues(res *federationType.ExposedModule, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectComposeModuleFieldCursorValues(res *composeType.ModuleField, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -10677,15 +9787,6 @@ func (s *Store) collectFederationExposedModuleCursorValues(res *federationType.E
 				case "id":
 					cur.Set(c.Column, res.ID, c.Descending)
 					pkID = true
-				case "handle":
-					cur.Set(c.Column, res.Handle, c.Descending)
-					hasUnique = true
-				case "createdAt":
-					cur.Set(c.Column, res.CreatedAt, c.Descending)
-				case "updatedAt":
-					cur.Set(c.Column, res.UpdatedAt, c.Descending)
-				case "deletedAt":
-					cur.Set(c.Column, res.DeletedAt, c.Descending)
 				}
 			}
 		}
@@ -10700,44 +9801,59 @@ func (s *Store) collectFederationExposedModuleCursorValues(res *federationType.E
 
 }
 
-// checkFederationExposedModuleConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkComposeModuleFieldConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkFederationExposedModuleConstraints(ctx context.Context, res *federationType.ExposedModule) (err error) {
-	return nil
-}
+func (s *Store) checkComposeModuleFieldConstraints(ctx context.Context, res *composeType.ModuleField) (err error) {
+	err = func() (err error) {
 
-// CreateFederationModuleMapping creates one or more rows in federationModuleMapping collection
-//
-// This function is auto-generated
-func (s *Store) CreateFederationModuleMapping(ctx context.Context, rr ...*federationType.ModuleMapping) (err error) {
-	for i := range rr {
-		if err = s.checkFederationModuleMappingConstraints(ctx, rr[i]); err != nil {
-			return
+		if res.ModuleID == 0 {
+			// skip check on empty values
+			return nil
 		}
 
-		if err = s.Exec(ctx, federationModuleMappingInsertQuery(s.Dialect, rr[i])); err != nil {
-			return
+		// handling string type as default
+		if len(res.Name) == 0 {
+			// skip check on empty values
+			return nil
 		}
-	}
 
-	return
-}
+		if res.DeletedAt != nil {
+			// skip check if value is not nil
+			return nil
+		}
 
-// UpdateFederationModuleMapping updates one or more existing entries in federationModuleMapping collection
-//
-// This function is auto-generated
-func (s *Store) UpdateFederationModuleMapping(ctx context.Context, rr ...*federationType.ModuleMapping) (err error) {
-	for i := range rr {
-		if err = s.checkFederationModuleMappingConstraints(ctx, rr[i]); err != nil {
+		ex, err := s.LookupComposeModuleFieldByModuleIDName(ctx, res.ModuleID, res.Name)
+		if err == nil && ex != nil && ex.ID != res.ID {
+			return store.ErrNotUnique.Stack(1)
+		} else if !errors.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}()
+
+	if err != nil {
+		return
+	}
+
+	return nil
+}
+
+// CreateComposeNamespace creates one or more rows in composeNamespace collection
+//
+// This function is auto-generated
+func (s *Store) CreateComposeNamespace(ctx context.Context, rr ...*composeType.Namespace) (err error) {
+	for i := range rr {
+		if err = s.checkComposeNamespaceConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationModuleMappingUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeNamespaceInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -10745,16 +9861,16 @@ func (s *Store) UpdateFederationModuleMapping(ctx context.Context, rr ...*federa
 	return
 }
 
-// UpsertFederationModuleMapping updates one or more existing entries in federationModuleMapping collection
+// UpdateComposeNamespace updates one or more existing entries in composeNamespace collection
 //
 // This function is auto-generated
-func (s *Store) UpsertFederationModuleMapping(ctx context.Context, rr ...*federationType.ModuleMapping) (err error) {
+func (s *Store) UpdateComposeNamespace(ctx context.Context, rr ...*composeType.Namespace) (err error) {
 	for i := range rr {
-		if err = s.checkFederationModuleMappingConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeNamespaceConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationModuleMappingUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeNamespaceUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -10762,12 +9878,29 @@ func (s *Store) UpsertFederationModuleMapping(ctx context.Context, rr ...*federa
 	return
 }
 
-// DeleteFederationModuleMapping Deletes one or more entries from federationModuleMapping collection
+// UpsertComposeNamespace updates one or more existing entries in composeNamespace collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFederationModuleMapping(ctx context.Context, rr ...*federationType.ModuleMapping) (err error) {
+func (s *Store) UpsertComposeNamespace(ctx context.Context, rr ...*composeType.Namespace) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, federationModuleMappingDeleteQuery(s.Dialect, federationModuleMappingPrimaryKeys(rr[i]))); err != nil {
+		if err = s.checkComposeNamespaceConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, composeNamespaceUpsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// DeleteComposeNamespace Deletes one or more entries from composeNamespace collection
+//
+// This function is auto-generated
+func (s *Store) DeleteComposeNamespace(ctx context.Context, rr ...*composeType.Namespace) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, composeNamespaceDeleteQuery(s.Dialect, composeNamespacePrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -10775,22 +9908,24 @@ func (s *Store) DeleteFederationModuleMapping(ctx context.Context, rr ...*federa
 	return nil
 }
 
-// DeleteFederationModuleMappingByID deletes single entry from federationModuleMapping collection
+// DeleteComposeNamespaceByID deletes single entry from composeNamespace collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFederationModuleMappingBy(ctx context.Context) error {
-	return s.Exec(ctx, federationModuleMappingDeleteQuery(s.Dialect, goqu.Ex{}))
+func (s *Store) DeleteComposeNamespaceByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, composeNamespaceDeleteQuery(s.Dialect, goqu.Ex{
+		"id": id,
+	}))
 }
 
-// TruncateFederationModuleMappings Deletes all rows from the federationModuleMapping collection
-func (s Store) TruncateFederationModuleMappings(ctx context.Context) error {
-	return s.Exec(ctx, federationModuleMappingTruncateQuery(s.Dialect))
+// TruncateComposeNamespaces Deletes all rows from the composeNamespace collection
+func (s Store) TruncateComposeNamespaces(ctx context.Context) error {
+	return s.Exec(ctx, composeNamespaceTruncateQuery(s.Dialect))
 }
 
-// SearchFederationModuleMappings returns (filtered) set of FederationModuleMappings
+// SearchComposeNamespaces returns (filtered) set of ComposeNamespaces
 //
 // This function is auto-generated
-func (s *Store) SearchFederationModuleMappings(ctx context.Context, f federationType.ModuleMappingFilter) (set federationType.ModuleMappingSet, _ federationType.ModuleMappingFilter, err error) {
+func (s *Store) SearchComposeNamespaces(ctx context.Context, f composeType.NamespaceFilter) (set composeType.NamespaceSet, _ composeType.NamespaceFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -10814,7 +9949,7 @@ func (s *Store) SearchFederationModuleMappings(ctx context.Context, f federation
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfFederationModuleMappings fn used for cursor creation;
+	// Original are passed to the etchFullPageOfComposeNamespaces fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -10824,7 +9959,7 @@ func (s *Store) SearchFederationModuleMappings(ctx context.Context, f federation
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfFederationModuleMappings(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposeNamespaces(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -10834,7 +9969,7 @@ func (s *Store) SearchFederationModuleMappings(ctx context.Context, f federation
 	return set, f, nil
 }
 
-// fetchFullPageOfFederationModuleMappings collects all requested results.
+// fetchFullPageOfComposeNamespaces collects all requested results.
 //
 // Function applies:
 //  - cursor conditions (where ...)
@@ -10846,13 +9981,13 @@ func (s *Store) SearchFederationModuleMappings(ctx context.Context, f federation
 // Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfFederationModuleMappings(
+func (s *Store) fetchFullPageOfComposeNamespaces(
 	ctx context.Context,
-	filter federationType.ModuleMappingFilter,
+	filter composeType.NamespaceFilter,
 	sort filter.SortExprSet,
-) (set []*federationType.ModuleMapping, prev, next *filter.PagingCursor, err error) {
+) (set []*composeType.Namespace, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*federationType.ModuleMapping
+		aux []*composeType.Namespace
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -10871,10 +10006,10 @@ func (s *Store) fetchFullPageOfFederationModuleMappings(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter federationType.ModuleMappingFilter
+		tryFilter composeType.NamespaceFilter
 	)
 
-	set = make([]*federationType.ModuleMapping, 0, DefaultSliceCapacity)
+	set = make([]*composeType.Namespace, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -10887,7 +10022,7 @@ func (s *Store) fetchFullPageOfFederationModuleMappings(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryFederationModuleMappings(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryComposeNamespaces(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -10917,7 +10052,7 @@ func (s *Store) fetchFullPageOfFederationModuleMappings(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectFederationModuleMappingCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectComposeNamespaceCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -10948,35 +10083,35 @@ func (s *Store) fetchFullPageOfFederationModuleMappings(
 	}
 
 	if hasPrev {
-		prev = s.collectFederationModuleMappingCursorValues(set[0], filter.Sort...)
+		prev = s.collectComposeNamespaceCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectFederationModuleMappingCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectComposeNamespaceCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryFederationModuleMappings queries the database, converts and checks each row and returns collected set
+// QueryComposeNamespaces queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryFederationModuleMappings(
+func (s *Store) QueryComposeNamespaces(
 	ctx context.Context,
-	f federationType.ModuleMappingFilter,
-) (_ []*federationType.ModuleMapping, more bool, err error) {
+	f composeType.NamespaceFilter,
+) (_ []*composeType.Namespace, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*federationType.ModuleMapping, 0, DefaultSliceCapacity)
-		res         *federationType.ModuleMapping
-		aux         *auxFederationModuleMapping
+		set         = make([]*composeType.Namespace, 0, DefaultSliceCapacity)
+		res         *composeType.Namespace
+		aux         *auxComposeNamespace
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -10984,16 +10119,16 @@ func (s *Store) QueryFederationModuleMappings(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.FederationModuleMapping != nil {
+	if s.Filters.ComposeNamespace != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.FederationModuleMapping(s, f)
+		tExpr, f, err = s.Filters.ComposeNamespace(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = FederationModuleMappingFilter(f)
+		tExpr, f, err = ComposeNamespaceFilter(f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for FederationModuleMapping: %w", err)
+		err = fmt.Errorf("could generate filter expression for ComposeNamespace: %w", err)
 		return
 	}
 
@@ -11008,11 +10143,11 @@ func (s *Store) QueryFederationModuleMappings(
 		}
 	}
 
-	query := federationModuleMappingSelectQuery(s.Dialect).Where(expr...)
+	query := composeNamespaceSelectQuery(s.Dialect).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableFederationModuleMappingFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for FederationModuleMapping: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableComposeNamespaceFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for ComposeNamespace: %w", err)
 		return
 	}
 
@@ -11026,12 +10161,12 @@ func (s *Store) QueryFederationModuleMappings(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query FederationModuleMapping: %w", err)
+		err = fmt.Errorf("could not query ComposeNamespace: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query FederationModuleMapping: %w", err)
+		err = fmt.Errorf("could not query ComposeNamespace: %w", err)
 		return
 	}
 
@@ -11045,19 +10180,19 @@ func (s *Store) QueryFederationModuleMappings(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query FederationModuleMapping: %w", err)
+			err = fmt.Errorf("could not query ComposeNamespace: %w", err)
 			return
 		}
 
-		aux = new(auxFederationModuleMapping)
+		aux = new(auxComposeNamespace)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for FederationModuleMapping: %w", err)
+			err = fmt.Errorf("could not scan rows for ComposeNamespace: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode FederationModuleMapping: %w", err)
+			err = fmt.Errorf("could not decode ComposeNamespace: %w", err)
 			return
 		}
 
@@ -11078,19 +10213,16 @@ func (s *Store) QueryFederationModuleMappings(
 
 }
 
-// LookupFederationModuleMappingByFederationModuleIDComposeModuleIDComposeNamespaceID searches for module mapping by federation module id and compose module id
-//
-// It returns module mapping
+// LookupComposeNamespaceBySlug searches for namespace by slug (case-insensitive)
 //
 // This function is auto-generated
-func (s *Store) LookupFederationModuleMappingByFederationModuleIDComposeModuleIDComposeNamespaceID(ctx context.Context, federationModuleID uint64, composeModuleID uint64, composeNamespaceID uint64) (_ *federationType.ModuleMapping, err error) {
+func (s *Store) LookupComposeNamespaceBySlug(ctx context.Context, slug string) (_ *composeType.Namespace, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxFederationModuleMapping)
-		lookup = federationModuleMappingSelectQuery(s.Dialect).Where(
-			goqu.I("federation_module_id").Eq(federationModuleID),
-			goqu.I("compose_module_id").Eq(composeModuleID),
-			goqu.I("compose_namespace_id").Eq(composeNamespaceID),
+		aux    = new(auxComposeNamespace)
+		lookup = composeNamespaceSelectQuery(s.Dialect).Where(
+			goqu.I("slug").Eq(slug),
+			goqu.I("deleted_at").IsNull(),
 		).Limit(1)
 	)
 
@@ -11122,17 +10254,17 @@ func (s *Store) LookupFederationModuleMappingByFederationModuleIDComposeModuleID
 	return aux.decode()
 }
 
-// LookupFederationModuleMappingByFederationModuleID searches for module mapping by federation module id
+// LookupComposeNamespaceByID searches for compose namespace by ID
 //
-// It returns module mapping
+// It returns compose namespace even if deleted
 //
 // This function is auto-generated
-func (s *Store) LookupFederationModuleMappingByFederationModuleID(ctx context.Context, federationModuleID uint64) (_ *federationType.ModuleMapping, err error) {
+func (s *Store) LookupComposeNamespaceByID(ctx context.Context, id uint64) (_ *composeType.Namespace, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxFederationModuleMapping)
-		lookup = federationModuleMappingSelectQuery(s.Dialect).Where(
-			goqu.I("federation_module_id").Eq(federationModuleID),
+		aux    = new(auxComposeNamespace)
+		lookup = composeNamespaceSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
 
@@ -11164,16 +10296,24 @@ func (s *Store) LookupFederationModuleMappingByFederationModuleID(ctx context.Co
 	return aux.decode()
 }
 
-// sortableFederationModuleMappingFields returns all <no value> columns flagged as sortable
+// sortableComposeNamespaceFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableFederationModuleMappingFields() map[string]string {
-	return map[string]string{}
+func (Store) sortableComposeNamespaceFields() map[string]string {
+	return map[string]string{
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"id":         "id",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
+	}
 }
 
-// collectFederationModuleMappingCursorValues collects values from the given resource that and sets them to the cursor
+// collectComposeNamespaceCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -11184,31 +10324,88 @@ func (Store) sortableFederationModuleMappingFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectFederationModuleMappingCursorValues(res *federationType.ModuleMapping, cc ...*filter.SortExpr) *filter.PagingCursor {
-	return nil
+func (s *Store) collectComposeNamespaceCursorValues(res *composeType.Namespace, cc ...*filter.SortExpr) *filter.PagingCursor {
+	var (
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
+
+		hasUnique bool
+
+		pkID bool
+
+		collect = func(cc ...*filter.SortExpr) {
+			for _, c := range cc {
+				switch c.Column {
+				case "id":
+					cur.Set(c.Column, res.ID, c.Descending)
+					pkID = true
+				case "createdAt":
+					cur.Set(c.Column, res.CreatedAt, c.Descending)
+				case "updatedAt":
+					cur.Set(c.Column, res.UpdatedAt, c.Descending)
+				case "deletedAt":
+					cur.Set(c.Column, res.DeletedAt, c.Descending)
+				}
+			}
+		}
+	)
+
+	collect(cc...)
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
+	}
+
+	return cur
+
 }
 
-// checkFederationModuleMappingConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkComposeNamespaceConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkFederationModuleMappingConstraints(ctx context.Context, res *federationType.ModuleMapping) (err error) {
+func (s *Store) checkComposeNamespaceConstraints(ctx context.Context, res *composeType.Namespace) (err error) {
+	err = func() (err error) {
+
+		// handling string type as default
+		if len(res.Slug) == 0 {
+			// skip check on empty values
+			return nil
+		}
+
+		if res.DeletedAt != nil {
+			// skip check if value is not nil
+			return nil
+		}
+
+		ex, err := s.LookupComposeNamespaceBySlug(ctx, res.Slug)
+		if err == nil && ex != nil && ex.ID != res.ID {
+			return store.ErrNotUnique.Stack(1)
+		} else if !errors.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}()
+
+	if err != nil {
+		return
+	}
+
 	return nil
 }
 
-// CreateFederationNode creates one or more rows in federationNode collection
+// CreateComposePage creates one or more rows in composePage collection
 //
 // This function is auto-generated
-func (s *Store) CreateFederationNode(ctx context.Context, rr ...*federationType.Node) (err error) {
+func (s *Store) CreateComposePage(ctx context.Context, rr ...*composeType.Page) (err error) {
 	for i := range rr {
-		if err = s.checkFederationNodeConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposePageConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationNodeInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composePageInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -11216,16 +10413,16 @@ func (s *Store) CreateFederationNode(ctx context.Context, rr ...*federationType.
 	return
 }
 
-// UpdateFederationNode updates one or more existing entries in federationNode collection
+// UpdateComposePage updates one or more existing entries in composePage collection
 //
 // This function is auto-generated
-func (s *Store) UpdateFederationNode(ctx context.Context, rr ...*federationType.Node) (err error) {
+func (s *Store) UpdateComposePage(ctx context.Context, rr ...*composeType.Page) (err error) {
 	for i := range rr {
-		if err = s.checkFederationNodeConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposePageConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationNodeUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composePageUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -11233,16 +10430,16 @@ func (s *Store) UpdateFederationNode(ctx context.Context, rr ...*federationType.
 	return
 }
 
-// UpsertFederationNode updates one or more existing entries in federationNode collection
+// UpsertComposePage updates one or more existing entries in composePage collection
 //
 // This function is auto-generated
-func (s *Store) UpsertFederationNode(ctx context.Context, rr ...*federationType.Node) (err error) {
+func (s *Store) UpsertComposePage(ctx context.Context, rr ...*composeType.Page) (err error) {
 	for i := range rr {
-		if err = s.checkFederationNodeConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposePageConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationNodeUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composePageUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -11250,12 +10447,12 @@ func (s *Store) UpsertFederationNode(ctx context.Context, rr ...*federationType.
 	return
 }
 
-// DeleteFederationNode Deletes one or more entries from federationNode collection
+// DeleteComposePage Deletes one or more entries from composePage collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFederationNode(ctx context.Context, rr ...*federationType.Node) (err error) {
+func (s *Store) DeleteComposePage(ctx context.Context, rr ...*composeType.Page) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, federationNodeDeleteQuery(s.Dialect, federationNodePrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, composePageDeleteQuery(s.Dialect, composePagePrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -11263,26 +10460,60 @@ func (s *Store) DeleteFederationNode(ctx context.Context, rr ...*federationType.
 	return nil
 }
 
-// DeleteFederationNodeByID deletes single entry from federationNode collection
+// DeleteComposePageByID deletes single entry from composePage collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFederationNodeByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, federationNodeDeleteQuery(s.Dialect, goqu.Ex{
+func (s *Store) DeleteComposePageByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, composePageDeleteQuery(s.Dialect, goqu.Ex{
 		"id": id,
 	}))
 }
 
-// TruncateFederationNodes Deletes all rows from the federationNode collection
-func (s Store) TruncateFederationNodes(ctx context.Context) error {
-	return s.Exec(ctx, federationNodeTruncateQuery(s.Dialect))
+// TruncateComposePages Deletes all rows from the composePage collection
+func (s Store) TruncateComposePages(ctx context.Context) error {
+	return s.Exec(ctx, composePageTruncateQuery(s.Dialect))
 }
 
-// SearchFederationNodes returns (filtered) set of FederationNodes
+// SearchComposePages returns (filtered) set of ComposePages
 //
 // This function is auto-generated
-func (s *Store) SearchFederationNodes(ctx context.Context, f federationType.NodeFilter) (set federationType.NodeSet, _ federationType.NodeFilter, err error) {
+func (s *Store) SearchComposePages(ctx context.Context, f composeType.PageFilter) (set composeType.PageSet, _ composeType.PageFilter, err error) {
 
-	set, _, err = s.QueryFederationNodes(ctx, f)
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfComposePages fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposePages(ctx, f, sort)
+
+	f.PageCursor = nil
 	if err != nil {
 		return nil, f, err
 	}
@@ -11290,43 +10521,191 @@ func (s *Store) SearchFederationNodes(ctx context.Context, f federationType.Node
 	return set, f, nil
 }
 
-// QueryFederationNodes queries the database, converts and checks each row and returns collected set
+// fetchFullPageOfComposePages collects all requested results.
+//
+// Function applies:
+//  - cursor conditions (where ...)
+//  - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// Function then moves cursor to the last item fetched
+//
+// This function is auto-generated
+func (s *Store) fetchFullPageOfComposePages(
+	ctx context.Context,
+	filter composeType.PageFilter,
+	sort filter.SortExprSet,
+) (set []*composeType.Page, prev, next *filter.PagingCursor, err error) {
+	var (
+		aux []*composeType.Page
+
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
+
+		reqItems = filter.Limit
+
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
+
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
+
+		tryFilter composeType.PageFilter
+	)
+
+	set = make([]*composeType.Page, 0, DefaultSliceCapacity)
+
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
+		}
+
+		if aux, hasNext, err = s.QueryComposePages(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if len(aux) == 0 {
+			// nothing fetched
+			break
+		}
+
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
+		}
+
+		collected := uint(len(set))
+
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
+
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectComposePageCursorValues(set[collected-1], filter.Sort...)
+
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
+		}
+
+		break
+	}
+
+	collected := len(set)
+
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
+
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
+
+	if hasPrev {
+		prev = s.collectComposePageCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
+	}
+
+	if hasNext {
+		next = s.collectComposePageCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
+}
+
+// QueryComposePages queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryFederationNodes(
+func (s *Store) QueryComposePages(
 	ctx context.Context,
-	f federationType.NodeFilter,
-) (_ []*federationType.Node, more bool, err error) {
+	f composeType.PageFilter,
+) (_ []*composeType.Page, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*federationType.Node, 0, DefaultSliceCapacity)
-		res         *federationType.Node
-		aux         *auxFederationNode
+		set         = make([]*composeType.Page, 0, DefaultSliceCapacity)
+		res         *composeType.Page
+		aux         *auxComposePage
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.FederationNode != nil {
+	if s.Filters.ComposePage != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.FederationNode(s, f)
+		tExpr, f, err = s.Filters.ComposePage(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = FederationNodeFilter(f)
+		tExpr, f, err = ComposePageFilter(f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for FederationNode: %w", err)
+		err = fmt.Errorf("could generate filter expression for ComposePage: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	query := federationNodeSelectQuery(s.Dialect).Where(expr...)
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursor(f.PageCursor); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := composePageSelectQuery(s.Dialect).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableComposePageFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for ComposePage: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
 
 	if f.Limit > 0 {
 		query = query.Limit(f.Limit)
@@ -11334,12 +10713,12 @@ func (s *Store) QueryFederationNodes(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query FederationNode: %w", err)
+		err = fmt.Errorf("could not query ComposePage: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query FederationNode: %w", err)
+		err = fmt.Errorf("could not query ComposePage: %w", err)
 		return
 	}
 
@@ -11353,19 +10732,19 @@ func (s *Store) QueryFederationNodes(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query FederationNode: %w", err)
+			err = fmt.Errorf("could not query ComposePage: %w", err)
 			return
 		}
 
-		aux = new(auxFederationNode)
+		aux = new(auxComposePage)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for FederationNode: %w", err)
+			err = fmt.Errorf("could not scan rows for ComposePage: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode FederationNode: %w", err)
+			err = fmt.Errorf("could not decode ComposePage: %w", err)
 			return
 		}
 
@@ -11382,21 +10761,21 @@ func (s *Store) QueryFederationNodes(
 		set = append(set, res)
 	}
 
-	return set, false, err
+	return set, f.Limit > 0 && count >= f.Limit, err
 
 }
 
-// LookupFederationNodeByID searches for federation node by ID
-//
-// It returns federation node
+// LookupComposePageByNamespaceIDHandle searches for page by handle (case-insensitive)
 //
 // This function is auto-generated
-func (s *Store) LookupFederationNodeByID(ctx context.Context, id uint64) (_ *federationType.Node, err error) {
+func (s *Store) LookupComposePageByNamespaceIDHandle(ctx context.Context, namespaceID uint64, handle string) (_ *composeType.Page, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxFederationNode)
-		lookup = federationNodeSelectQuery(s.Dialect).Where(
-			goqu.I("id").Eq(id),
+		aux    = new(auxComposePage)
+		lookup = composePageSelectQuery(s.Dialect).Where(
+			goqu.I("rel_namespace").Eq(namespaceID),
+			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
+			goqu.I("deleted_at").IsNull(),
 		).Limit(1)
 	)
 
@@ -11428,16 +10807,17 @@ func (s *Store) LookupFederationNodeByID(ctx context.Context, id uint64) (_ *fed
 	return aux.decode()
 }
 
-// LookupFederationNodeByBaseURLSharedNodeID searches for node by shared-node-id and base-url
+// LookupComposePageByNamespaceIDModuleID searches for page by moduleID
 //
 // This function is auto-generated
-func (s *Store) LookupFederationNodeByBaseURLSharedNodeID(ctx context.Context, baseURL string, sharedNodeID uint64) (_ *federationType.Node, err error) {
+func (s *Store) LookupComposePageByNamespaceIDModuleID(ctx context.Context, namespaceID uint64, moduleID uint64) (_ *composeType.Page, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxFederationNode)
-		lookup = federationNodeSelectQuery(s.Dialect).Where(
-			goqu.I("base_url").Eq(baseURL),
-			goqu.I("shared_node_id").Eq(sharedNodeID),
+		aux    = new(auxComposePage)
+		lookup = composePageSelectQuery(s.Dialect).Where(
+			goqu.I("rel_namespace").Eq(namespaceID),
+			goqu.I("rel_module").Eq(moduleID),
+			goqu.I("deleted_at").IsNull(),
 		).Limit(1)
 	)
 
@@ -11469,15 +10849,17 @@ func (s *Store) LookupFederationNodeByBaseURLSharedNodeID(ctx context.Context, b
 	return aux.decode()
 }
 
-// LookupFederationNodeBySharedNodeID searches for node by shared-node-id
+// LookupComposePageByID searches for compose page by ID
+//
+// It returns compose page even if deleted
 //
 // This function is auto-generated
-func (s *Store) LookupFederationNodeBySharedNodeID(ctx context.Context, sharedNodeID uint64) (_ *federationType.Node, err error) {
+func (s *Store) LookupComposePageByID(ctx context.Context, id uint64) (_ *composeType.Page, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxFederationNode)
-		lookup = federationNodeSelectQuery(s.Dialect).Where(
-			goqu.I("shared_node_id").Eq(sharedNodeID),
+		aux    = new(auxComposePage)
+		lookup = composePageSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
 
@@ -11509,24 +10891,25 @@ func (s *Store) LookupFederationNodeBySharedNodeID(ctx context.Context, sharedNo
 	return aux.decode()
 }
 
-// sortableFederationNodeFields returns all <no value> columns flagged as sortable
+// sortableComposePageFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableFederationNodeFields() map[string]string {
+func (Store) sortableComposePageFields() map[string]string {
 	return map[string]string{
 		"created_at": "created_at",
 		"createdat":  "created_at",
 		"deleted_at": "deleted_at",
 		"deletedat":  "deleted_at",
+		"handle":     "handle",
 		"id":         "id",
 		"updated_at": "updated_at",
 		"updatedat":  "updated_at",
 	}
 }
 
-// collectFederationNodeCursorValues collects values from the given resource that and sets them to the cursor
+// collectComposePageCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -11537,7 +10920,7 @@ func (Store) sortableFederationNodeFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectFederationNodeCursorValues(res *federationType.Node, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectComposePageCursorValues(res *composeType.Page, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
@@ -11551,6 +10934,9 @@ func (s *Store) collectFederationNodeCursorValues(res *federationType.Node, cc .
 				case "id":
 					cur.Set(c.Column, res.ID, c.Descending)
 					pkID = true
+				case "handle":
+					cur.Set(c.Column, res.Handle, c.Descending)
+					hasUnique = true
 				case "createdAt":
 					cur.Set(c.Column, res.CreatedAt, c.Descending)
 				case "updatedAt":
@@ -11571,27 +10957,27 @@ func (s *Store) collectFederationNodeCursorValues(res *federationType.Node, cc .
 
 }
 
-// checkFederationNodeConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkComposePageConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkFederationNodeConstraints(ctx context.Context, res *federationType.Node) (err error) {
+func (s *Store) checkComposePageConstraints(ctx context.Context, res *composeType.Page) (err error) {
 	return nil
 }
 
-// CreateFederationNodeSync creates one or more rows in federationNodeSync collection
+// CreateComposeRecord creates one or more rows in composeRecord collection
 //
 // This function is auto-generated
-func (s *Store) CreateFederationNodeSync(ctx context.Context, rr ...*federationType.NodeSync) (err error) {
+func (s *Store) CreateComposeRecord(ctx context.Context, mod *composeType.Module, rr ...*composeType.Record) (err error) {
 	for i := range rr {
-		if err = s.checkFederationNodeSyncConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeRecordConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationNodeSyncInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeRecordInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -11599,16 +10985,16 @@ func (s *Store) CreateFederationNodeSync(ctx context.Context, rr ...*federationT
 	return
 }
 
-// UpdateFederationNodeSync updates one or more existing entries in federationNodeSync collection
+// UpdateComposeRecord updates one or more existing entries in composeRecord collection
 //
 // This function is auto-generated
-func (s *Store) UpdateFederationNodeSync(ctx context.Context, rr ...*federationType.NodeSync) (err error) {
+func (s *Store) UpdateComposeRecord(ctx context.Context, mod *composeType.Module, rr ...*composeType.Record) (err error) {
 	for i := range rr {
-		if err = s.checkFederationNodeSyncConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeRecordConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationNodeSyncUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeRecordUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -11616,16 +11002,16 @@ func (s *Store) UpdateFederationNodeSync(ctx context.Context, rr ...*federationT
 	return
 }
 
-// UpsertFederationNodeSync updates one or more existing entries in federationNodeSync collection
+// UpsertComposeRecord updates one or more existing entries in composeRecord collection
 //
 // This function is auto-generated
-func (s *Store) UpsertFederationNodeSync(ctx context.Context, rr ...*federationType.NodeSync) (err error) {
+func (s *Store) UpsertComposeRecord(ctx context.Context, mod *composeType.Module, rr ...*composeType.Record) (err error) {
 	for i := range rr {
-		if err = s.checkFederationNodeSyncConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeRecordConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationNodeSyncUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeRecordUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -11633,12 +11019,12 @@ func (s *Store) UpsertFederationNodeSync(ctx context.Context, rr ...*federationT
 	return
 }
 
-// DeleteFederationNodeSync Deletes one or more entries from federationNodeSync collection
+// DeleteComposeRecord Deletes one or more entries from composeRecord collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFederationNodeSync(ctx context.Context, rr ...*federationType.NodeSync) (err error) {
+func (s *Store) DeleteComposeRecord(ctx context.Context, mod *composeType.Module, rr ...*composeType.Record) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, federationNodeSyncDeleteQuery(s.Dialect, federationNodeSyncPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, composeRecordDeleteQuery(s.Dialect, composeRecordPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -11646,24 +11032,24 @@ func (s *Store) DeleteFederationNodeSync(ctx context.Context, rr ...*federationT
 	return nil
 }
 
-// DeleteFederationNodeSyncByID deletes single entry from federationNodeSync collection
+// DeleteComposeRecordByID deletes single entry from composeRecord collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFederationNodeSyncByNodeID(ctx context.Context, nodeID uint64) error {
-	return s.Exec(ctx, federationNodeSyncDeleteQuery(s.Dialect, goqu.Ex{
-		"node_id": nodeID,
+func (s *Store) DeleteComposeRecordByID(ctx context.Context, mod *composeType.Module, id uint64) error {
+	return s.Exec(ctx, composeRecordDeleteQuery(s.Dialect, goqu.Ex{
+		"id": id,
 	}))
 }
 
-// TruncateFederationNodeSyncs Deletes all rows from the federationNodeSync collection
-func (s Store) TruncateFederationNodeSyncs(ctx context.Context) error {
-	return s.Exec(ctx, federationNodeSyncTruncateQuery(s.Dialect))
+// TruncateComposeRecords Deletes all rows from the composeRecord collection
+func (s Store) TruncateComposeRecords(ctx context.Context, mod *composeType.Module) error {
+	return s.Exec(ctx, composeRecordTruncateQuery(s.Dialect))
 }
 
-// SearchFederationNodeSyncs returns (filtered) set of FederationNodeSyncs
+// SearchComposeRecords returns (filtered) set of ComposeRecords
 //
 // This function is auto-generated
-func (s *Store) SearchFederationNodeSyncs(ctx context.Context, f federationType.NodeSyncFilter) (set federationType.NodeSyncSet, _ federationType.NodeSyncFilter, err error) {
+func (s *Store) SearchComposeRecords(ctx context.Context, mod *composeType.Module, f composeType.RecordFilter) (set composeType.RecordSet, _ composeType.RecordFilter, err error) {
 
 	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
 	f.PrevPage, f.NextPage = nil, nil
@@ -11687,7 +11073,7 @@ func (s *Store) SearchFederationNodeSyncs(ctx context.Context, f federationType.
 	}
 
 	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfFederationNodeSyncs fn used for cursor creation;
+	// Original are passed to the etchFullPageOfComposeRecords fn used for cursor creation;
 	// direction information it MUST keep the initial
 	sort := f.Sort.Clone()
 
@@ -11697,7 +11083,7 @@ func (s *Store) SearchFederationNodeSyncs(ctx context.Context, f federationType.
 		sort.Reverse()
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfFederationNodeSyncs(ctx, f, sort)
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposeRecords(ctx, f, sort)
 
 	f.PageCursor = nil
 	if err != nil {
@@ -11707,7 +11093,7 @@ func (s *Store) SearchFederationNodeSyncs(ctx context.Context, f federationType.
 	return set, f, nil
 }
 
-// fetchFullPageOfFederationNodeSyncs collects all requested results.
+// fetchFullPageOfComposeRecords collects all requested results.
 //
 // Function applies:
 //  - cursor conditions (where ...)
@@ -11719,13 +11105,13 @@ func (s *Store) SearchFederationNodeSyncs(ctx context.Context, f federationType.
 // Function then moves cursor to the last item fetched
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfFederationNodeSyncs(
+func (s *Store) fetchFullPageOfComposeRecords(
 	ctx context.Context,
-	filter federationType.NodeSyncFilter,
+	filter composeType.RecordFilter,
 	sort filter.SortExprSet,
-) (set []*federationType.NodeSync, prev, next *filter.PagingCursor, err error) {
+) (set []*composeType.Record, prev, next *filter.PagingCursor, err error) {
 	var (
-		aux []*federationType.NodeSync
+		aux []*composeType.Record
 
 		// When cursor for a previous page is used it's marked as reversed
 		// This tells us to flip the descending flag on all used sort keys
@@ -11744,10 +11130,10 @@ func (s *Store) fetchFullPageOfFederationNodeSyncs(
 		// next cursor is calculated when there are more pages to come
 		hasNext bool
 
-		tryFilter federationType.NodeSyncFilter
+		tryFilter composeType.RecordFilter
 	)
 
-	set = make([]*federationType.NodeSync, 0, DefaultSliceCapacity)
+	set = make([]*composeType.Record, 0, DefaultSliceCapacity)
 
 	for try := 0; try < MaxRefetches; try++ {
 		// Copy filter & apply custom sorting that might be affected by cursor
@@ -11760,7 +11146,7 @@ func (s *Store) fetchFullPageOfFederationNodeSyncs(
 			tryFilter.Limit = limit + 1
 		}
 
-		if aux, hasNext, err = s.QueryFederationNodeSyncs(ctx, tryFilter); err != nil {
+		if aux, hasNext, err = s.QueryComposeRecords(ctx, tryFilter); err != nil {
 			return nil, nil, nil, err
 		}
 
@@ -11790,7 +11176,7 @@ func (s *Store) fetchFullPageOfFederationNodeSyncs(
 			}
 
 			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectFederationNodeSyncCursorValues(set[collected-1], filter.Sort...)
+			tryFilter.PageCursor = s.collectComposeRecordCursorValues(set[collected-1], filter.Sort...)
 
 			// Copy reverse flag from sorting
 			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
@@ -11821,35 +11207,35 @@ func (s *Store) fetchFullPageOfFederationNodeSyncs(
 	}
 
 	if hasPrev {
-		prev = s.collectFederationNodeSyncCursorValues(set[0], filter.Sort...)
+		prev = s.collectComposeRecordCursorValues(set[0], filter.Sort...)
 		prev.ROrder = true
 		prev.LThen = !filter.Sort.Reversed()
 	}
 
 	if hasNext {
-		next = s.collectFederationNodeSyncCursorValues(set[collected-1], filter.Sort...)
+		next = s.collectComposeRecordCursorValues(set[collected-1], filter.Sort...)
 		next.LThen = filter.Sort.Reversed()
 	}
 
 	return set, prev, next, nil
 }
 
-// QueryFederationNodeSyncs queries the database, converts and checks each row and returns collected set
+// QueryComposeRecords queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryFederationNodeSyncs(
+func (s *Store) QueryComposeRecords(
 	ctx context.Context,
-	f federationType.NodeSyncFilter,
-) (_ []*federationType.NodeSync, more bool, err error) {
+	f composeType.RecordFilter,
+) (_ []*composeType.Record, more bool, err error) {
 	var (
 		ok bool
 
-		set         = make([]*federationType.NodeSync, 0, DefaultSliceCapacity)
-		res         *federationType.NodeSync
-		aux         *auxFederationNodeSync
+		set         = make([]*composeType.Record, 0, DefaultSliceCapacity)
+		res         *composeType.Record
+		aux         *auxComposeRecord
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
@@ -11857,16 +11243,16 @@ func (s *Store) QueryFederationNodeSyncs(
 		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.FederationNodeSync != nil {
+	if s.Filters.ComposeRecord != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.FederationNodeSync(s, f)
+		tExpr, f, err = s.Filters.ComposeRecord(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = FederationNodeSyncFilter(f)
+		tExpr, f, err = ComposeRecordFilter(f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for FederationNodeSync: %w", err)
+		err = fmt.Errorf("could generate filter expression for ComposeRecord: %w", err)
 		return
 	}
 
@@ -11881,11 +11267,11 @@ func (s *Store) QueryFederationNodeSyncs(
 		}
 	}
 
-	query := federationNodeSyncSelectQuery(s.Dialect).Where(expr...)
+	query := composeRecordSelectQuery(s.Dialect).Where(expr...)
 
 	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableFederationNodeSyncFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for FederationNodeSync: %w", err)
+	if sortExpr, err = order(f.Sort, s.sortableComposeRecordFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for ComposeRecord: %w", err)
 		return
 	}
 
@@ -11899,12 +11285,12 @@ func (s *Store) QueryFederationNodeSyncs(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query FederationNodeSync: %w", err)
+		err = fmt.Errorf("could not query ComposeRecord: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query FederationNodeSync: %w", err)
+		err = fmt.Errorf("could not query ComposeRecord: %w", err)
 		return
 	}
 
@@ -11918,19 +11304,19 @@ func (s *Store) QueryFederationNodeSyncs(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query FederationNodeSync: %w", err)
+			err = fmt.Errorf("could not query ComposeRecord: %w", err)
 			return
 		}
 
-		aux = new(auxFederationNodeSync)
+		aux = new(auxComposeRecord)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for FederationNodeSync: %w", err)
+			err = fmt.Errorf("could not scan rows for ComposeRecord: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode FederationNodeSync: %w", err)
+			err = fmt.Errorf("could not decode ComposeRecord: %w", err)
 			return
 		}
 
@@ -11951,62 +11337,17 @@ func (s *Store) QueryFederationNodeSyncs(
 
 }
 
-// LookupFederationNodeSyncByNodeID searches for sync activity by node ID
-//
-// It returns sync activity
-//
-// This function is auto-generated
-func (s *Store) LookupFederationNodeSyncByNodeID(ctx context.Context, nodeID uint64) (_ *federationType.NodeSync, err error) {
-	var (
-		rows   *sql.Rows
-		aux    = new(auxFederationNodeSync)
-		lookup = federationNodeSyncSelectQuery(s.Dialect).Where(
-			goqu.I("node_id").Eq(nodeID),
-		).Limit(1)
-	)
-
-	rows, err = s.Query(ctx, lookup)
-	if err != nil {
-		return
-	}
-
-	defer func() {
-		closeError := rows.Close()
-		if err == nil {
-			// return error from close
-			err = closeError
-		}
-	}()
-
-	if err = rows.Err(); err != nil {
-		return
-	}
-
-	if !rows.Next() {
-		return nil, store.ErrNotFound.Stack(1)
-	}
-
-	if err = aux.scan(rows); err != nil {
-		return
-	}
-
-	return aux.decode()
-}
-
-// LookupFederationNodeSyncByNodeIDModuleIDSyncTypeSyncStatus searches for activity by node, type and status
+// LookupComposeRecordByID searches for compose record by ID
 //
-// It returns sync activity
+// It returns compose record even if deleted
 //
 // This function is auto-generated
-func (s *Store) LookupFederationNodeSyncByNodeIDModuleIDSyncTypeSyncStatus(ctx context.Context, nodeID uint64, moduleID uint64, syncType string, syncStatus string) (_ *federationType.NodeSync, err error) {
+func (s *Store) LookupComposeRecordByID(ctx context.Context, mod *composeType.Module, id uint64) (_ *composeType.Record, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxFederationNodeSync)
-		lookup = federationNodeSyncSelectQuery(s.Dialect).Where(
-			goqu.I("node_id").Eq(nodeID),
-			goqu.I("module_id").Eq(moduleID),
-			goqu.I("sync_type").Eq(syncType),
-			goqu.I("sync_status").Eq(syncStatus),
+		aux    = new(auxComposeRecord)
+		lookup = composeRecordSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
 
@@ -12038,21 +11379,24 @@ func (s *Store) LookupFederationNodeSyncByNodeIDModuleIDSyncTypeSyncStatus(ctx c
 	return aux.decode()
 }
 
-// sortableFederationNodeSyncFields returns all <no value> columns flagged as sortable
+// sortableComposeRecordFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableFederationNodeSyncFields() map[string]string {
+func (Store) sortableComposeRecordFields() map[string]string {
 	return map[string]string{
-		"node_id":        "node_id",
-		"nodeid":         "node_id",
-		"time_of_action": "time_of_action",
-		"timeofaction":   "time_of_action",
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"id":         "id",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
 	}
 }
 
-// collectFederationNodeSyncCursorValues collects values from the given resource that and sets them to the cursor
+// collectComposeRecordCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -12063,57 +11407,61 @@ func (Store) sortableFederationNodeSyncFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectFederationNodeSyncCursorValues(res *federationType.NodeSync, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectComposeRecordCursorValues(res *composeType.Record, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
 		hasUnique bool
 
-		pkNodeID bool
+		pkID bool
 
 		collect = func(cc ...*filter.SortExpr) {
 			for _, c := range cc {
 				switch c.Column {
-				case "nodeID":
-					cur.Set(c.Column, res.NodeID, c.Descending)
-					pkNodeID = true
-				case "timeOfAction":
-					cur.Set(c.Column, res.TimeOfAction, c.Descending)
+				case "id":
+					cur.Set(c.Column, res.ID, c.Descending)
+					pkID = true
+				case "createdAt":
+					cur.Set(c.Column, res.CreatedAt, c.Descending)
+				case "updatedAt":
+					cur.Set(c.Column, res.UpdatedAt, c.Descending)
+				case "deletedAt":
+					cur.Set(c.Column, res.DeletedAt, c.Descending)
 				}
 			}
 		}
 	)
 
 	collect(cc...)
-	if !hasUnique || !pkNodeID {
-		collect(&filter.SortExpr{Column: "nodeID", Descending: false})
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
 	}
 
 	return cur
 
 }
 
-// checkFederationNodeSyncConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkComposeRecordConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkFederationNodeSyncConstraints(ctx context.Context, res *federationType.NodeSync) (err error) {
+func (s *Store) checkComposeRecordConstraints(ctx context.Context, res *composeType.Record) (err error) {
 	return nil
 }
 
-// CreateFederationSharedModule creates one or more rows in federationSharedModule collection
+// CreateComposeRecordImportError creates one or more rows in composeRecordImportError collection
 //
 // This function is auto-generated
-func (s *Store) CreateFederationSharedModule(ctx context.Context, rr ...*federationType.SharedModule) (err error) {
+func (s *Store) CreateComposeRecordImportError(ctx context.Context, rr ...*composeType.RecordImportError) (err error) {
 	for i := range rr {
-		if err = s.checkFederationSharedModuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeRecordImportErrorConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationSharedModuleInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeRecordImportErrorInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -12121,16 +11469,16 @@ func (s *Store) CreateFederationSharedModule(ctx context.Context, rr ...*federat
 	return
 }
 
-// UpdateFederationSharedModule updates one or more existing entries in federationSharedModule collection
+// UpdateComposeRecordImportError updates one or more existing entries in composeRecordImportError collection
 //
 // This function is auto-generated
-func (s *Store) UpdateFederationSharedModule(ctx context.Context, rr ...*federationType.SharedModule) (err error) {
+func (s *Store) UpdateComposeRecordImportError(ctx context.Context, rr ...*composeType.RecordImportError) (err error) {
 	for i := range rr {
-		if err = s.checkFederationSharedModuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeRecordImportErrorConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationSharedModuleUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeRecordImportErrorUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -12138,16 +11486,16 @@ func (s *Store) UpdateFederationSharedModule(ctx context.Context, rr ...*federat
 	return
 }
 
-// UpsertFederationSharedModule updates one or more existing entries in federationSharedModule collection
+// UpsertComposeRecordImportError updates one or more existing entries in composeRecordImportError collection
 //
 // This function is auto-generated
-func (s *Store) UpsertFederationSharedModule(ctx context.Context, rr ...*federationType.SharedModule) (err error) {
+func (s *Store) UpsertComposeRecordImportError(ctx context.Context, rr ...*composeType.RecordImportError) (err error) {
 	for i := range rr {
-		if err = s.checkFederationSharedModuleConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkComposeRecordImportErrorConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, federationSharedModuleUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, composeRecordImportErrorUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -12155,12 +11503,12 @@ func (s *Store) UpsertFederationSharedModule(ctx context.Context, rr ...*federat
 	return
 }
 
-// DeleteFederationSharedModule Deletes one or more entries from federationSharedModule collection
+// DeleteComposeRecordImportError Deletes one or more entries from composeRecordImportError collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFederationSharedModule(ctx context.Context, rr ...*federationType.SharedModule) (err error) {
+func (s *Store) DeleteComposeRecordImportError(ctx context.Context, rr ...*composeType.RecordImportError) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, federationSharedModuleDeleteQuery(s.Dialect, federationSharedModulePrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, composeRecordImportErrorDeleteQuery(s.Dialect, composeRecordImportErrorPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -12168,60 +11516,276 @@ func (s *Store) DeleteFederationSharedModule(ctx context.Context, rr ...*federat
 	return nil
 }
 
-// DeleteFederationSharedModuleByID deletes single entry from federationSharedModule collection
+// DeleteComposeRecordImportErrorByID deletes single entry from composeRecordImportError collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFederationSharedModuleByID(ctx context.Context, id uint64) error {
-	return s.Exec(ctx, federationSharedModuleDeleteQuery(s.Dialect, goqu.Ex{
-		"id": id,
+func (s *Store) DeleteComposeRecordImportErrorBySessionIDRow(ctx context.Context, sessionID uint64, row uint64) error {
+	return s.Exec(ctx, composeRecordImportErrorDeleteQuery(s.Dialect, goqu.Ex{
+		"rel_session": sessionID,
+		"row_index":   row,
 	}))
 }
 
-// TruncateFederationSharedModules Deletes all rows from the federationSharedModule collection
-func (s Store) TruncateFederationSharedModules(ctx context.Context) error {
-	return s.Exec(ctx, federationSharedModuleTruncateQuery(s.Dialect))
+// TruncateComposeRecordImportErrors Deletes all rows from the composeRecordImportError collection
+func (s Store) TruncateComposeRecordImportErrors(ctx context.Context) error {
+	return s.Exec(ctx, composeRecordImportErrorTruncateQuery(s.Dialect))
 }
 
-// SearchFederationSharedModules returns (filtered) set of FederationSharedModules
+// SearchComposeRecordImportErrors returns (filtered) set of ComposeRecordImportErrors
 //
 // This function is auto-generated
-func (s *Store) SearchFederationSharedModules(ctx context.Context, f federationType.SharedModuleFilter) (set federationType.SharedModuleSet, _ federationType.SharedModuleFilter, err error) {
+func (s *Store) SearchComposeRecordImportErrors(ctx context.Context, f composeType.RecordImportErrorFilter) (set composeType.RecordImportErrorSet, _ composeType.RecordImportErrorFilter, err error) {
 
-	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
-	f.PrevPage, f.NextPage = nil, nil
+	set, _, err = s.QueryComposeRecordImportErrors(ctx, f)
+	if err != nil {
+		return nil, f, err
+	}
 
-	if f.PageCursor != nil {
-		// Page cursor exists; we need to validate it against used sort
-		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
-		// from the cursor.
-		// This (extracted sorting info) is then returned as part of response
-		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+	return set, f, nil
+}
+
+// QueryComposeRecordImportErrors queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryComposeRecordImportErrors(
+	ctx context.Context,
+	f composeType.RecordImportErrorFilter,
+) (_ []*composeType.RecordImportError, more bool, err error) {
+	var (
+		set         = make([]*composeType.RecordImportError, 0, DefaultSliceCapacity)
+		res         *composeType.RecordImportError
+		aux         *auxComposeRecordImportError
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+	)
+
+	if s.Filters.ComposeRecordImportError != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.ComposeRecordImportError(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = ComposeRecordImportErrorFilter(f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for ComposeRecordImportError: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	query := composeRecordImportErrorSelectQuery(s.Dialect).Where(expr...)
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query ComposeRecordImportError: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query ComposeRecordImportError: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query ComposeRecordImportError: %w", err)
+			return
+		}
+
+		aux = new(auxComposeRecordImportError)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for ComposeRecordImportError: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode ComposeRecordImportError: %w", err)
 			return
 		}
+
+		set = append(set, res)
 	}
 
-	// Make sure results are always sorted at least by primary keys
-	if f.Sort.Get("id") == nil {
-		f.Sort = append(f.Sort, &filter.SortExpr{
-			Column:     "id",
-			Descending: f.Sort.LastDescending(),
-		})
+	return set, false, err
+
+}
+
+// sortableComposeRecordImportErrorFields returns all <no value> columns flagged as sortable
+//
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableComposeRecordImportErrorFields() map[string]string {
+	return map[string]string{
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"row":        "row",
+		"session_id": "session_id",
+		"sessionid":  "session_id",
 	}
+}
 
-	// Cloned sorting instructions for the actual sorting
-	// Original are passed to the etchFullPageOfFederationSharedModules fn used for cursor creation;
-	// direction information it MUST keep the initial
-	sort := f.Sort.Clone()
+// collectComposeRecordImportErrorCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
+//
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
+//
+// Known issue:
+//   when collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+//   undeleted items)
+//
+// This function is auto-generated
+func (s *Store) collectComposeRecordImportErrorCursorValues(res *composeType.RecordImportError, cc ...*filter.SortExpr) *filter.PagingCursor {
+	var (
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
-	// When cursor for a previous page is used it's marked as reversed
-	// This tells us to flip the descending flag on all used sort keys
-	if f.PageCursor != nil && f.PageCursor.ROrder {
-		sort.Reverse()
+		hasUnique bool
+
+		pkSessionID bool
+		pkRow       bool
+
+		collect = func(cc ...*filter.SortExpr) {
+			for _, c := range cc {
+				switch c.Column {
+				case "sessionID":
+					cur.Set(c.Column, res.SessionID, c.Descending)
+					pkSessionID = true
+				case "row":
+					cur.Set(c.Column, res.Row, c.Descending)
+					pkRow = true
+				case "createdAt":
+					cur.Set(c.Column, res.CreatedAt, c.Descending)
+				}
+			}
+		}
+	)
+
+	collect(cc...)
+	if !hasUnique || !pkSessionID {
+		collect(&filter.SortExpr{Column: "sessionID", Descending: false})
+	}
+	if !hasUnique || !pkRow {
+		collect(&filter.SortExpr{Column: "row", Descending: false})
 	}
 
-	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfFederationSharedModules(ctx, f, sort)
+	return cur
 
-	f.PageCursor = nil
+}
+
+// checkComposeRecordImportErrorConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkComposeRecordImportErrorConstraints(ctx context.Context, res *composeType.RecordImportError) (err error) {
+	return nil
+}
+
+// CreateComposeRecordImportSession creates one or more rows in composeRecordImportSession collection
+//
+// This function is auto-generated
+func (s *Store) CreateComposeRecordImportSession(ctx context.Context, rr ...*composeType.RecordImportSession) (err error) {
+	for i := range rr {
+		if err = s.checkComposeRecordImportSessionConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, composeRecordImportSessionInsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpdateComposeRecordImportSession updates one or more existing entries in composeRecordImportSession collection
+//
+// This function is auto-generated
+func (s *Store) UpdateComposeRecordImportSession(ctx context.Context, rr ...*composeType.RecordImportSession) (err error) {
+	for i := range rr {
+		if err = s.checkComposeRecordImportSessionConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, composeRecordImportSessionUpdateQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpsertComposeRecordImportSession updates one or more existing entries in composeRecordImportSession collection
+//
+// This function is auto-generated
+func (s *Store) UpsertComposeRecordImportSession(ctx context.Context, rr ...*composeType.RecordImportSession) (err error) {
+	for i := range rr {
+		if err = s.checkComposeRecordImportSessionConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, composeRecordImportSessionUpsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// DeleteComposeRecordImportSession Deletes one or more entries from composeRecordImportSession collection
+//
+// This function is auto-generated
+func (s *Store) DeleteComposeRecordImportSession(ctx context.Context, rr ...*composeType.RecordImportSession) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, composeRecordImportSessionDeleteQuery(s.Dialect, composeRecordImportSessionPrimaryKeys(rr[i]))); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// DeleteComposeRecordImportSessionByID deletes single entry from composeRecordImportSession collection
+//
+// This function is auto-generated
+func (s *Store) DeleteComposeRecordImportSessionByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, composeRecordImportSessionDeleteQuery(s.Dialect, goqu.Ex{
+		"id": id,
+	}))
+}
+
+// TruncateComposeRecordImportSessions Deletes all rows from the composeRecordImportSession collection
+func (s Store) TruncateComposeRecordImportSessions(ctx context.Context) error {
+	return s.Exec(ctx, composeRecordImportSessionTruncateQuery(s.Dialect))
+}
+
+// SearchComposeRecordImportSessions returns (filtered) set of ComposeRecordImportSessions
+//
+// This function is auto-generated
+func (s *Store) SearchComposeRecordImportSessions(ctx context.Context, f composeType.RecordImportSessionFilter) (set composeType.RecordImportSessionSet, _ composeType.RecordImportSessionFilter, err error) {
+
+	set, _, err = s.QueryComposeRecordImportSessions(ctx, f)
 	if err != nil {
 		return nil, f, err
 	}
@@ -12229,191 +11793,4442 @@ func (s *Store) SearchFederationSharedModules(ctx context.Context, f federationT
 	return set, f, nil
 }
 
-// fetchFullPageOfFederationSharedModules collects all requested results.
-//
-// Function applies:
-//  - cursor conditions (where ...)
-//  - limit
-//
-// Main responsibility of this function is to perform additional sequential queries in case when not enough results
-// are collected due to failed check on a specific row (by check fn).
+// QueryComposeRecordImportSessions queries the database, converts and checks each row and returns collected set
 //
-// Function then moves cursor to the last item fetched
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) fetchFullPageOfFederationSharedModules(
+func (s *Store) QueryComposeRecordImportSessions(
 	ctx context.Context,
-	filter federationType.SharedModuleFilter,
-	sort filter.SortExprSet,
-) (set []*federationType.SharedModule, prev, next *filter.PagingCursor, err error) {
+	f composeType.RecordImportSessionFilter,
+) (_ []*composeType.RecordImportSession, more bool, err error) {
+	var (
+		set         = make([]*composeType.RecordImportSession, 0, DefaultSliceCapacity)
+		res         *composeType.RecordImportSession
+		aux         *auxComposeRecordImportSession
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+	)
+
+	if s.Filters.ComposeRecordImportSession != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.ComposeRecordImportSession(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = ComposeRecordImportSessionFilter(f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for ComposeRecordImportSession: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	query := composeRecordImportSessionSelectQuery(s.Dialect).Where(expr...)
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query ComposeRecordImportSession: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query ComposeRecordImportSession: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query ComposeRecordImportSession: %w", err)
+			return
+		}
+
+		aux = new(auxComposeRecordImportSession)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for ComposeRecordImportSession: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode ComposeRecordImportSession: %w", err)
+			return
+		}
+
+		set = append(set, res)
+	}
+
+	return set, false, err
+
+}
+
+// LookupComposeRecordImportSessionByID
+//
+// This function is auto-generated
+func (s *Store) LookupComposeRecordImportSessionByID(ctx context.Context, id uint64) (_ *composeType.RecordImportSession, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxComposeRecordImportSession)
+		lookup = composeRecordImportSessionSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableComposeRecordImportSessionFields returns all <no value> columns flagged as sortable
+//
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableComposeRecordImportSessionFields() map[string]string {
+	return map[string]string{
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"id":         "id",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
+	}
+}
+
+// collectComposeRecordImportSessionCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
+//
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
+//
+// Known issue:
+//   when collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+//   undeleted items)
+//
+// This function is auto-generated
+func (s *Store) collectComposeRecordImportSessionCursorValues(res *composeType.RecordImportSession, cc ...*filter.SortExpr) *filter.PagingCursor {
+	var (
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
+
+		hasUnique bool
+
+		pkID bool
+
+		collect = func(cc ...*filter.SortExpr) {
+			for _, c := range cc {
+				switch c.Column {
+				case "id":
+					cur.Set(c.Column, res.ID, c.Descending)
+					pkID = true
+				case "createdAt":
+					cur.Set(c.Column, res.CreatedAt, c.Descending)
+				case "updatedAt":
+					cur.Set(c.Column, res.UpdatedAt, c.Descending)
+				}
+			}
+		}
+	)
+
+	collect(cc...)
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
+	}
+
+	return cur
+
+}
+
+// checkComposeRecordImportSessionConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkComposeRecordImportSessionConstraints(ctx context.Context, res *composeType.RecordImportSession) (err error) {
+	return nil
+}
+
+// CreateComposeRecordRevision creates one or more rows in composeRecordRevision collection
+//
+// This function is auto-generated
+func (s *Store) CreateComposeRecordRevision(ctx context.Context, rr ...*composeType.RecordRevision) (err error) {
+	for i := range rr {
+		if err = s.checkComposeRecordRevisionConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, composeRecordRevisionInsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpdateComposeRecordRevision updates one or more existing entries in composeRecordRevision collection
+//
+// This function is auto-generated
+func (s *Store) UpdateComposeRecordRevision(ctx context.Context, rr ...*composeType.RecordRevision) (err error) {
+	for i := range rr {
+		if err = s.checkComposeRecordRevisionConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, composeRecordRevisionUpdateQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpsertComposeRecordRevision updates one or more existing entries in composeRecordRevision collection
+//
+// This function is auto-generated
+func (s *Store) UpsertComposeRecordRevision(ctx context.Context, rr ...*composeType.RecordRevision) (err error) {
+	for i := range rr {
+		if err = s.checkComposeRecordRevisionConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, composeRecordRevisionUpsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// DeleteComposeRecordRevision Deletes one or more entries from composeRecordRevision collection
+//
+// This function is auto-generated
+func (s *Store) DeleteComposeRecordRevision(ctx context.Context, rr ...*composeType.RecordRevision) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, composeRecordRevisionDeleteQuery(s.Dialect, composeRecordRevisionPrimaryKeys(rr[i]))); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// DeleteComposeRecordRevisionByID deletes single entry from composeRecordRevision collection
+//
+// This function is auto-generated
+func (s *Store) DeleteComposeRecordRevisionByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, composeRecordRevisionDeleteQuery(s.Dialect, goqu.Ex{
+		"id": id,
+	}))
+}
+
+// TruncateComposeRecordRevisions Deletes all rows from the composeRecordRevision collection
+func (s Store) TruncateComposeRecordRevisions(ctx context.Context) error {
+	return s.Exec(ctx, composeRecordRevisionTruncateQuery(s.Dialect))
+}
+
+// SearchComposeRecordRevisions returns (filtered) set of ComposeRecordRevisions
+//
+// This function is auto-generated
+func (s *Store) SearchComposeRecordRevisions(ctx context.Context, f composeType.RecordRevisionFilter) (set composeType.RecordRevisionSet, _ composeType.RecordRevisionFilter, err error) {
+
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfComposeRecordRevisions fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfComposeRecordRevisions(ctx, f, sort)
+
+	f.PageCursor = nil
+	if err != nil {
+		return nil, f, err
+	}
+
+	return set, f, nil
+}
+
+// fetchFullPageOfComposeRecordRevisions collects all requested results.
+//
+// Function applies:
+//   - cursor conditions (where ...)
+//   - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// # Function then moves cursor to the last item fetched
+//
+// This function is auto-generated
+func (s *Store) fetchFullPageOfComposeRecordRevisions(
+	ctx context.Context,
+	filter composeType.RecordRevisionFilter,
+	sort filter.SortExprSet,
+) (set []*composeType.RecordRevision, prev, next *filter.PagingCursor, err error) {
+	var (
+		aux []*composeType.RecordRevision
+
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
+
+		reqItems = filter.Limit
+
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
+
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
+
+		tryFilter composeType.RecordRevisionFilter
+	)
+
+	set = make([]*composeType.RecordRevision, 0, DefaultSliceCapacity)
+
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
+		}
+
+		if aux, hasNext, err = s.QueryComposeRecordRevisions(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if len(aux) == 0 {
+			// nothing fetched
+			break
+		}
+
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
+		}
+
+		collected := uint(len(set))
+
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
+
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectComposeRecordRevisionCursorValues(set[collected-1], filter.Sort...)
+
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
+		}
+
+		break
+	}
+
+	collected := len(set)
+
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
+
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
+
+	if hasPrev {
+		prev = s.collectComposeRecordRevisionCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
+	}
+
+	if hasNext {
+		next = s.collectComposeRecordRevisionCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
+}
+
+// QueryComposeRecordRevisions queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryComposeRecordRevisions(
+	ctx context.Context,
+	f composeType.RecordRevisionFilter,
+) (_ []*composeType.RecordRevision, more bool, err error) {
+	var (
+		set         = make([]*composeType.RecordRevision, 0, DefaultSliceCapacity)
+		res         *composeType.RecordRevision
+		aux         *auxComposeRecordRevision
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
+	)
+
+	if s.Filters.ComposeRecordRevision != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.ComposeRecordRevision(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = ComposeRecordRevisionFilter(f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for ComposeRecordRevision: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursor(f.PageCursor); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := composeRecordRevisionSelectQuery(s.Dialect).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableComposeRecordRevisionFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for ComposeRecordRevision: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query ComposeRecordRevision: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query ComposeRecordRevision: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query ComposeRecordRevision: %w", err)
+			return
+		}
+
+		aux = new(auxComposeRecordRevision)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for ComposeRecordRevision: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode ComposeRecordRevision: %w", err)
+			return
+		}
+
+		set = append(set, res)
+	}
+
+	return set, f.Limit > 0 && count >= f.Limit, err
+
+}
+
+// LookupComposeRecordRevisionByID
+//
+// This function is auto-generated
+func (s *Store) LookupComposeRecordRevisionByID(ctx context.Context, id uint64) (_ *composeType.RecordRevision, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxComposeRecordRevision)
+		lookup = composeRecordRevisionSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// LookupComposeRecordRevisionByRecordIDRevision searches for record revision by record ID and revision number
+//
+// This function is auto-generated
+func (s *Store) LookupComposeRecordRevisionByRecordIDRevision(ctx context.Context, recordID uint64, revision uint) (_ *composeType.RecordRevision, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxComposeRecordRevision)
+		lookup = composeRecordRevisionSelectQuery(s.Dialect).Where(
+			goqu.I("rel_record").Eq(recordID),
+			goqu.I("revision").Eq(revision),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableComposeRecordRevisionFields returns all <no value> columns flagged as sortable
+//
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableComposeRecordRevisionFields() map[string]string {
+	return map[string]string{
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"id":         "id",
+		"revision":   "revision",
+	}
+}
+
+// collectComposeRecordRevisionCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
+//
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
+//
+// Known issue:
+//   when collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+//   undeleted items)
+//
+// This function is auto-generated
+func (s *Store) collectComposeRecordRevisionCursorValues(res *composeType.RecordRevision, cc ...*filter.SortExpr) *filter.PagingCursor {
+	var (
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
+
+		hasUnique bool
+
+		pkID bool
+
+		collect = func(cc ...*filter.SortExpr) {
+			for _, c := range cc {
+				switch c.Column {
+				case "id":
+					cur.Set(c.Column, res.ID, c.Descending)
+					pkID = true
+				case "revision":
+					cur.Set(c.Column, res.Revision, c.Descending)
+				case "createdAt":
+					cur.Set(c.Column, res.CreatedAt, c.Descending)
+				}
+			}
+		}
+	)
+
+	collect(cc...)
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
+	}
+
+	return cur
+
+}
+
+// checkComposeRecordRevisionConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkComposeRecordRevisionConstraints(ctx context.Context, res *composeType.RecordRevision) (err error) {
+	return nil
+}
+
+// CreateComposeRecordValue creates one or more rows in composeRecordValue collection
+//
+// This function is auto-generated
+func (s *Store) CreateComposeRecordValue(ctx context.Context, rr ...*composeType.RecordValue) (err error) {
+	for i := range rr {
+		if err = s.checkComposeRecordValueConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, composeRecordValueInsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpdateComposeRecordValue updates one or more existing entries in composeRecordValue collection
+//
+// This function is auto-generated
+func (s *Store) UpdateComposeRecordValue(ctx context.Context, rr ...*composeType.RecordValue) (err error) {
+	for i := range rr {
+		if err = s.checkComposeRecordValueConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, composeRecordValueUpdateQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpsertComposeRecordValue updates one or more existing entries in composeRecordValue collection
+//
+// This function is auto-generated
+func (s *Store) UpsertComposeRecordValue(ctx context.Context, rr ...*composeType.RecordValue) (err error) {
+	for i := range rr {
+		if err = s.checkComposeRecordValueConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, composeRecordValueUpsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// DeleteComposeRecordValue Deletes one or more entries from composeRecordValue collection
+//
+// This function is auto-generated
+func (s *Store) DeleteComposeRecordValue(ctx context.Context, rr ...*composeType.RecordValue) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, composeRecordValueDeleteQuery(s.Dialect, composeRecordValuePrimaryKeys(rr[i]))); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// DeleteComposeRecordValueByID deletes single entry from composeRecordValue collection
+//
+// This function is auto-generated
+func (s *Store) DeleteComposeRecordValueByRecordIDNamePlace(ctx context.Context, recordID uint64, name string, place uint) error {
+	return s.Exec(ctx, composeRecordValueDeleteQuery(s.Dialect, goqu.Ex{
+		"record_id": recordID,
+		"name":      name,
+		"place":     place,
+	}))
+}
+
+// TruncateComposeRecordValues Deletes all rows from the composeRecordValue collection
+func (s Store) TruncateComposeRecordValues(ctx context.Context) error {
+	return s.Exec(ctx, composeRecordValueTruncateQuery(s.Dialect))
+}
+
+// SearchComposeRecordValues returns (filtered) set of ComposeRecordValues
+//
+// This function is auto-generated
+func (s *Store) SearchComposeRecordValues(ctx context.Context, f composeType.RecordValueFilter) (set composeType.RecordValueSet, _ composeType.RecordValueFilter, err error) {
+
+	set, _, err = s.QueryComposeRecordValues(ctx, f)
+	if err != nil {
+		return nil, f, err
+	}
+
+	return set, f, nil
+}
+
+// QueryComposeRecordValues queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryComposeRecordValues(
+	ctx context.Context,
+	f composeType.RecordValueFilter,
+) (_ []*composeType.RecordValue, more bool, err error) {
+	var (
+		set         = make([]*composeType.RecordValue, 0, DefaultSliceCapacity)
+		res         *composeType.RecordValue
+		aux         *auxComposeRecordValue
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+	)
+
+	if s.Filters.ComposeRecordValue != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.ComposeRecordValue(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = ComposeRecordValueFilter(f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for ComposeRecordValue: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	query := composeRecordValueSelectQuery(s.Dialect).Where(expr...)
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query ComposeRecordValue: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query ComposeRecordValue: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query ComposeRecordValue: %w", err)
+			return
+		}
+
+		aux = new(auxComposeRecordValue)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for ComposeRecordValue: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode ComposeRecordValue: %w", err)
+			return
+		}
+
+		set = append(set, res)
+	}
+
+	return set, false, err
+
+}
+
+// sortableComposeRecordValueFields returns all <no value> columns flagged as sortable
+//
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableComposeRecordValueFields() map[string]string {
+	return map[string]string{
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"name":       "name",
+		"place":      "place",
+		"record_id":  "record_id",
+		"recordid":   "record_id",
+	}
+}
+
+// collectComposeRecordValueCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
+//
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
+//
+// Known issue:
+//   when collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+//   undeleted items)
+//
+// This function is auto-generated
+func (s *Store) collectComposeRecordValueCursorValues(res *composeType.RecordValue, cc ...*filter.SortExpr) *filter.PagingCursor {
+	var (
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
+
+		hasUnique bool
+
+		pkRecordID bool
+		pkName     bool
+		pkPlace    bool
+
+		collect = func(cc ...*filter.SortExpr) {
+			for _, c := range cc {
+				switch c.Column {
+				case "recordID":
+					cur.Set(c.Column, res.RecordID, c.Descending)
+					pkRecordID = true
+				case "name":
+					cur.Set(c.Column, res.Name, c.Descending)
+					pkName = true
+				case "place":
+					cur.Set(c.Column, res.Place, c.Descending)
+					pkPlace = true
+				case "deletedAt":
+					cur.Set(c.Column, res.DeletedAt, c.Descending)
+				}
+			}
+		}
+	)
+
+	collect(cc...)
+	if !hasUnique || !pkRecordID {
+		collect(&filter.SortExpr{Column: "recordID", Descending: false})
+	}
+	if !hasUnique || !pkName {
+		collect(&filter.SortExpr{Column: "name", Descending: false})
+	}
+	if !hasUnique || !pkPlace {
+		collect(&filter.SortExpr{Column: "place", Descending: false})
+	}
+
+	return cur
+
+}
+
+// checkComposeRecordValueConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkComposeRecordValueConstraints(ctx context.Context, res *composeType.RecordValue) (err error) {
+	return nil
+}
+
+// CreateCredential creates one or more rows in credential collection
+//
+// This function is auto-generated
+func (s *Store) CreateCredential(ctx context.Context, rr ...*systemType.Credential) (err error) {
+	for i := range rr {
+		if err = s.checkCredentialConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, credentialInsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpdateCredential updates one or more existing entries in credential collection
+//
+// This function is auto-generated
+func (s *Store) UpdateCredential(ctx context.Context, rr ...*systemType.Credential) (err error) {
+	for i := range rr {
+		if err = s.checkCredentialConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, credentialUpdateQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpsertCredential updates one or more existing entries in credential collection
+//
+// This function is auto-generated
+func (s *Store) UpsertCredential(ctx context.Context, rr ...*systemType.Credential) (err error) {
+	for i := range rr {
+		if err = s.checkCredentialConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, credentialUpsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// DeleteCredential Deletes one or more entries from credential collection
+//
+// This function is auto-generated
+func (s *Store) DeleteCredential(ctx context.Context, rr ...*systemType.Credential) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, credentialDeleteQuery(s.Dialect, credentialPrimaryKeys(rr[i]))); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// DeleteCredentialByID deletes single entry from credential collection
+//
+// This function is auto-generated
+func (s *Store) DeleteCredentialByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, credentialDeleteQuery(s.Dialect, goqu.Ex{
+		"id": id,
+	}))
+}
+
+// TruncateCredentials Deletes all rows from the credential collection
+func (s Store) TruncateCredentials(ctx context.Context) error {
+	return s.Exec(ctx, credentialTruncateQuery(s.Dialect))
+}
+
+// SearchCredentials returns (filtered) set of Credentials
+//
+// This function is auto-generated
+func (s *Store) SearchCredentials(ctx context.Context, f systemType.CredentialFilter) (set systemType.CredentialSet, _ systemType.CredentialFilter, err error) {
+
+	set, _, err = s.QueryCredentials(ctx, f)
+	if err != nil {
+		return nil, f, err
+	}
+
+	return set, f, nil
+}
+
+// QueryCredentials queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryCredentials(
+	ctx context.Context,
+	f systemType.CredentialFilter,
+) (_ []*systemType.Credential, more bool, err error) {
+	var (
+		set         = make([]*systemType.Credential, 0, DefaultSliceCapacity)
+		res         *systemType.Credential
+		aux         *auxCredential
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+	)
+
+	if s.Filters.Credential != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.Credential(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = CredentialFilter(f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for Credential: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	query := credentialSelectQuery(s.Dialect).Where(expr...)
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query Credential: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query Credential: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query Credential: %w", err)
+			return
+		}
+
+		aux = new(auxCredential)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for Credential: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode Credential: %w", err)
+			return
+		}
+
+		set = append(set, res)
+	}
+
+	return set, false, err
+
+}
+
+// LookupCredentialByID searches for credentials by ID
+//
+// It returns credentials even if deleted
+//
+// This function is auto-generated
+func (s *Store) LookupCredentialByID(ctx context.Context, id uint64) (_ *systemType.Credential, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxCredential)
+		lookup = credentialSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableCredentialFields returns all <no value> columns flagged as sortable
+//
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableCredentialFields() map[string]string {
+	return map[string]string{
+		"created_at":   "created_at",
+		"createdat":    "created_at",
+		"deleted_at":   "deleted_at",
+		"deletedat":    "deleted_at",
+		"expires_at":   "expires_at",
+		"expiresat":    "expires_at",
+		"id":           "id",
+		"last_used_at": "last_used_at",
+		"lastusedat":   "last_used_at",
+		"updated_at":   "updated_at",
+		"updatedat":    "updated_at",
+	}
+}
+
+// collectCredentialCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
+//
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
+//
+// Known issue:
+//   when collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+//   undeleted items)
+//
+// This function is auto-generated
+func (s *Store) collectCredentialCursorValues(res *systemType.Credential, cc ...*filter.SortExpr) *filter.PagingCursor {
+	var (
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
+
+		hasUnique bool
+
+		pkID bool
+
+		collect = func(cc ...*filter.SortExpr) {
+			for _, c := range cc {
+				switch c.Column {
+				case "id":
+					cur.Set(c.Column, res.ID, c.Descending)
+					pkID = true
+				case "createdAt":
+					cur.Set(c.Column, res.CreatedAt, c.Descending)
+				case "updatedAt":
+					cur.Set(c.Column, res.UpdatedAt, c.Descending)
+				case "deletedAt":
+					cur.Set(c.Column, res.DeletedAt, c.Descending)
+				case "lastUsedAt":
+					cur.Set(c.Column, res.LastUsedAt, c.Descending)
+				case "expiresAt":
+					cur.Set(c.Column, res.ExpiresAt, c.Descending)
+				}
+			}
+		}
+	)
+
+	collect(cc...)
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
+	}
+
+	return cur
+
+}
+
+// checkCredentialConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkCredentialConstraints(ctx context.Context, res *systemType.Credential) (err error) {
+	return nil
+}
+
+// CreateDalConnection creates one or more rows in dalConnection collection
+//
+// This function is auto-generated
+func (s *Store) CreateDalConnection(ctx context.Context, rr ...*systemType.DalConnection) (err error) {
+	for i := range rr {
+		if err = s.checkDalConnectionConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, dalConnectionInsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpdateDalConnection updates one or more existing entries in dalConnection collection
+//
+// This function is auto-generated
+func (s *Store) UpdateDalConnection(ctx context.Context, rr ...*systemType.DalConnection) (err error) {
+	for i := range rr {
+		if err = s.checkDalConnectionConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, dalConnectionUpdateQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpsertDalConnection updates one or more existing entries in dalConnection collection
+//
+// This function is auto-generated
+func (s *Store) UpsertDalConnection(ctx context.Context, rr ...*systemType.DalConnection) (err error) {
+	for i := range rr {
+		if err = s.checkDalConnectionConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, dalConnectionUpsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// DeleteDalConnection Deletes one or more entries from dalConnection collection
+//
+// This function is auto-generated
+func (s *Store) DeleteDalConnection(ctx context.Context, rr ...*systemType.DalConnection) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, dalConnectionDeleteQuery(s.Dialect, dalConnectionPrimaryKeys(rr[i]))); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// DeleteDalConnectionByID deletes single entry from dalConnection collection
+//
+// This function is auto-generated
+func (s *Store) DeleteDalConnectionByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, dalConnectionDeleteQuery(s.Dialect, goqu.Ex{
+		"id": id,
+	}))
+}
+
+// TruncateDalConnections Deletes all rows from the dalConnection collection
+func (s Store) TruncateDalConnections(ctx context.Context) error {
+	return s.Exec(ctx, dalConnectionTruncateQuery(s.Dialect))
+}
+
+// SearchDalConnections returns (filtered) set of DalConnections
+//
+// This function is auto-generated
+func (s *Store) SearchDalConnections(ctx context.Context, f systemType.DalConnectionFilter) (set systemType.DalConnectionSet, _ systemType.DalConnectionFilter, err error) {
+
+	set, _, err = s.QueryDalConnections(ctx, f)
+	if err != nil {
+		return nil, f, err
+	}
+
+	return set, f, nil
+}
+
+// QueryDalConnections queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryDalConnections(
+	ctx context.Context,
+	f systemType.DalConnectionFilter,
+) (_ []*systemType.DalConnection, more bool, err error) {
+	var (
+		ok bool
+
+		set         = make([]*systemType.DalConnection, 0, DefaultSliceCapacity)
+		res         *systemType.DalConnection
+		aux         *auxDalConnection
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+	)
+
+	if s.Filters.DalConnection != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.DalConnection(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = DalConnectionFilter(f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for DalConnection: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	query := dalConnectionSelectQuery(s.Dialect).Where(expr...)
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query DalConnection: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query DalConnection: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query DalConnection: %w", err)
+			return
+		}
+
+		aux = new(auxDalConnection)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for DalConnection: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode DalConnection: %w", err)
+			return
+		}
+
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
+		}
+
+		set = append(set, res)
+	}
+
+	return set, false, err
+
+}
+
+// LookupDalConnectionByID searches for connection by ID
+//
+// It returns connection even if deleted or suspended
+//
+// This function is auto-generated
+func (s *Store) LookupDalConnectionByID(ctx context.Context, id uint64) (_ *systemType.DalConnection, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxDalConnection)
+		lookup = dalConnectionSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// LookupDalConnectionByHandle searches for connection by handle
+//
+// It returns only valid connection (not deleted)
+//
+// This function is auto-generated
+func (s *Store) LookupDalConnectionByHandle(ctx context.Context, handle string) (_ *systemType.DalConnection, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxDalConnection)
+		lookup = dalConnectionSelectQuery(s.Dialect).Where(
+			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
+			goqu.I("deleted_at").IsNull(),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableDalConnectionFields returns all <no value> columns flagged as sortable
+//
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableDalConnectionFields() map[string]string {
+	return map[string]string{
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"handle":     "handle",
+		"id":         "id",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
+	}
+}
+
+// collectDalConnectionCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
+//
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
+//
+// Known issue:
+//   when collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+//   undeleted items)
+//
+// This function is auto-generated
+func (s *Store) collectDalConnectionCursorValues(res *systemType.DalConnection, cc ...*filter.SortExpr) *filter.PagingCursor {
+	var (
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
+
+		hasUnique bool
+
+		pkID bool
+
+		collect = func(cc ...*filter.SortExpr) {
+			for _, c := range cc {
+				switch c.Column {
+				case "id":
+					cur.Set(c.Column, res.ID, c.Descending)
+					pkID = true
+				case "handle":
+					cur.Set(c.Column, res.Handle, c.Descending)
+					hasUnique = true
+				case "createdAt":
+					cur.Set(c.Column, res.CreatedAt, c.Descending)
+				case "updatedAt":
+					cur.Set(c.Column, res.UpdatedAt, c.Descending)
+				case "deletedAt":
+					cur.Set(c.Column, res.DeletedAt, c.Descending)
+				}
+			}
+		}
+	)
+
+	collect(cc...)
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
+	}
+
+	return cur
+
+}
+
+// checkDalConnectionConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkDalConnectionConstraints(ctx context.Context, res *systemType.DalConnection) (err error) {
+	err = func() (err error) {
+
+		// handling string type as default
+		if len(res.Handle) == 0 {
+			// skip check on empty values
+			return nil
+		}
+
+		if res.DeletedAt != nil {
+			// skip check if value is not nil
+			return nil
+		}
+
+		ex, err := s.LookupDalConnectionByHandle(ctx, res.Handle)
+		if err == nil && ex != nil && ex.ID != res.ID {
+			return store.ErrNotUnique.Stack(1)
+		} else if !errors.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}()
+
+	if err != nil {
+		return
+	}
+
+	return nil
+}
+
+// CreateDalSensitivityLevel creates one or more rows in dalSensitivityLevel collection
+//
+// This function is auto-generated
+func (s *Store) CreateDalSensitivityLevel(ctx context.Context, rr ...*systemType.DalSensitivityLevel) (err error) {
+	for i := range rr {
+		if err = s.checkDalSensitivityLevelConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, dalSensitivityLevelInsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpdateDalSensitivityLevel updates one or more existing entries in dalSensitivityLevel collection
+//
+// This function is auto-generated
+func (s *Store) UpdateDalSensitivityLevel(ctx context.Context, rr ...*systemType.DalSensitivityLevel) (err error) {
+	for i := range rr {
+		if err = s.checkDalSensitivityLevelConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, dalSensitivityLevelUpdateQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpsertDalSensitivityLevel updates one or more existing entries in dalSensitivityLevel collection
+//
+// This function is auto-generated
+func (s *Store) UpsertDalSensitivityLevel(ctx context.Context, rr ...*systemType.DalSensitivityLevel) (err error) {
+	for i := range rr {
+		if err = s.checkDalSensitivityLevelConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, dalSensitivityLevelUpsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// DeleteDalSensitivityLevel Deletes one or more entries from dalSensitivityLevel collection
+//
+// This function is auto-generated
+func (s *Store) DeleteDalSensitivityLevel(ctx context.Context, rr ...*systemType.DalSensitivityLevel) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, dalSensitivityLevelDeleteQuery(s.Dialect, dalSensitivityLevelPrimaryKeys(rr[i]))); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// DeleteDalSensitivityLevelByID deletes single entry from dalSensitivityLevel collection
+//
+// This function is auto-generated
+func (s *Store) DeleteDalSensitivityLevelByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, dalSensitivityLevelDeleteQuery(s.Dialect, goqu.Ex{
+		"id": id,
+	}))
+}
+
+// TruncateDalSensitivityLevels Deletes all rows from the dalSensitivityLevel collection
+func (s Store) TruncateDalSensitivityLevels(ctx context.Context) error {
+	return s.Exec(ctx, dalSensitivityLevelTruncateQuery(s.Dialect))
+}
+
+// SearchDalSensitivityLevels returns (filtered) set of DalSensitivityLevels
+//
+// This function is auto-generated
+func (s *Store) SearchDalSensitivityLevels(ctx context.Context, f systemType.DalSensitivityLevelFilter) (set systemType.DalSensitivityLevelSet, _ systemType.DalSensitivityLevelFilter, err error) {
+
+	set, _, err = s.QueryDalSensitivityLevels(ctx, f)
+	if err != nil {
+		return nil, f, err
+	}
+
+	return set, f, nil
+}
+
+// QueryDalSensitivityLevels queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryDalSensitivityLevels(
+	ctx context.Context,
+	f systemType.DalSensitivityLevelFilter,
+) (_ []*systemType.DalSensitivityLevel, more bool, err error) {
+	var (
+		ok bool
+
+		set         = make([]*systemType.DalSensitivityLevel, 0, DefaultSliceCapacity)
+		res         *systemType.DalSensitivityLevel
+		aux         *auxDalSensitivityLevel
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+	)
+
+	if s.Filters.DalSensitivityLevel != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.DalSensitivityLevel(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = DalSensitivityLevelFilter(f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for DalSensitivityLevel: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	query := dalSensitivityLevelSelectQuery(s.Dialect).Where(expr...)
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query DalSensitivityLevel: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query DalSensitivityLevel: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query DalSensitivityLevel: %w", err)
+			return
+		}
+
+		aux = new(auxDalSensitivityLevel)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for DalSensitivityLevel: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode DalSensitivityLevel: %w", err)
+			return
+		}
+
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
+		}
+
+		set = append(set, res)
+	}
+
+	return set, false, err
+
+}
+
+// LookupDalSensitivityLevelByID searches for user by ID
+//
+// It returns user even if deleted or suspended
+//
+// This function is auto-generated
+func (s *Store) LookupDalSensitivityLevelByID(ctx context.Context, id uint64) (_ *systemType.DalSensitivityLevel, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxDalSensitivityLevel)
+		lookup = dalSensitivityLevelSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableDalSensitivityLevelFields returns all <no value> columns flagged as sortable
+//
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableDalSensitivityLevelFields() map[string]string {
+	return map[string]string{
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"handle":     "handle",
+		"id":         "id",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
+	}
+}
+
+// collectDalSensitivityLevelCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
+//
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
+//
+// Known issue:
+//   when collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+//   undeleted items)
+//
+// This function is auto-generated
+func (s *Store) collectDalSensitivityLevelCursorValues(res *systemType.DalSensitivityLevel, cc ...*filter.SortExpr) *filter.PagingCursor {
+	var (
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
+
+		hasUnique bool
+
+		pkID bool
+
+		collect = func(cc ...*filter.SortExpr) {
+			for _, c := range cc {
+				switch c.Column {
+				case "id":
+					cur.Set(c.Column, res.ID, c.Descending)
+					pkID = true
+				case "handle":
+					cur.Set(c.Column, res.Handle, c.Descending)
+					hasUnique = true
+				case "createdAt":
+					cur.Set(c.Column, res.CreatedAt, c.Descending)
+				case "updatedAt":
+					cur.Set(c.Column, res.UpdatedAt, c.Descending)
+				case "deletedAt":
+					cur.Set(c.Column, res.DeletedAt, c.Descending)
+				}
+			}
+		}
+	)
+
+	collect(cc...)
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
+	}
+
+	return cur
+
+}
+
+// checkDalSensitivityLevelConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkDalSensitivityLevelConstraints(ctx context.Context, res *systemType.DalSensitivityLevel) (err error) {
+	return nil
+}
+
+// CreateFederationExposedModule creates one or more rows in federationExposedModule collection
+//
+// This function is auto-generated
+func (s *Store) CreateFederationExposedModule(ctx context.Context, rr ...*federationType.ExposedModule) (err error) {
+	for i := range rr {
+		if err = s.checkFederationExposedModuleConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, federationExposedModuleInsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpdateFederationExposedModule updates one or more existing entries in federationExposedModule collection
+//
+// This function is auto-generated
+func (s *Store) UpdateFederationExposedModule(ctx context.Context, rr ...*federationType.ExposedModule) (err error) {
+	for i := range rr {
+		if err = s.checkFederationExposedModuleConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, federationExposedModuleUpdateQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpsertFederationExposedModule updates one or more existing entries in federationExposedModule collection
+//
+// This function is auto-generated
+func (s *Store) UpsertFederationExposedModule(ctx context.Context, rr ...*federationType.ExposedModule) (err error) {
+	for i := range rr {
+		if err = s.checkFederationExposedModuleConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, federationExposedModuleUpsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// DeleteFederationExposedModule Deletes one or more entries from federationExposedModule collection
+//
+// This function is auto-generated
+func (s *Store) DeleteFederationExposedModule(ctx context.Context, rr ...*federationType.ExposedModule) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, federationExposedModuleDeleteQuery(s.Dialect, federationExposedModulePrimaryKeys(rr[i]))); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// DeleteFederationExposedModuleByID deletes single entry from federationExposedModule collection
+//
+// This function is auto-generated
+func (s *Store) DeleteFederationExposedModuleByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, federationExposedModuleDeleteQuery(s.Dialect, goqu.Ex{
+		"id": id,
+	}))
+}
+
+// TruncateFederationExposedModules Deletes all rows from the federationExposedModule collection
+func (s Store) TruncateFederationExposedModules(ctx context.Context) error {
+	return s.Exec(ctx, federationExposedModuleTruncateQuery(s.Dialect))
+}
+
+// SearchFederationExposedModules returns (filtered) set of FederationExposedModules
+//
+// This function is auto-generated
+func (s *Store) SearchFederationExposedModules(ctx context.Context, f federationType.ExposedModuleFilter) (set federationType.ExposedModuleSet, _ federationType.ExposedModuleFilter, err error) {
+
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfFederationExposedModules fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfFederationExposedModules(ctx, f, sort)
+
+	f.PageCursor = nil
+	if err != nil {
+		return nil, f, err
+	}
+
+	return set, f, nil
+}
+
+// fetchFullPageOfFederationExposedModules collects all requested results.
+//
+// Function applies:
+//  - cursor conditions (where ...)
+//  - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// Function then moves cursor to the last item fetched
+//
+// This function is auto-generated
+func (s *Store) fetchFullPageOfFederationExposedModules(
+	ctx context.Context,
+	filter federationType.ExposedModuleFilter,
+	sort filter.SortExprSet,
+) (set []*federationType.ExposedModule, prev, next *filter.PagingCursor, err error) {
+	var (
+		aux []*federationType.ExposedModule
+
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
+
+		reqItems = filter.Limit
+
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
+
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
+
+		tryFilter federationType.ExposedModuleFilter
+	)
+
+	set = make([]*federationType.ExposedModule, 0, DefaultSliceCapacity)
+
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
+		}
+
+		if aux, hasNext, err = s.QueryFederationExposedModules(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if len(aux) == 0 {
+			// nothing fetched
+			break
+		}
+
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
+		}
+
+		collected := uint(len(set))
+
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
+
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectFederationExposedModuleCursorValues(set[collected-1], filter.Sort...)
+
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
+		}
+
+		break
+	}
+
+	collected := len(set)
+
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
+
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
+
+	if hasPrev {
+		prev = s.collectFederationExposedModuleCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
+	}
+
+	if hasNext {
+		next = s.collectFederationExposedModuleCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
+}
+
+// QueryFederationExposedModules queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryFederationExposedModules(
+	ctx context.Context,
+	f federationType.ExposedModuleFilter,
+) (_ []*federationType.ExposedModule, more bool, err error) {
+	var (
+		ok bool
+
+		set         = make([]*federationType.ExposedModule, 0, DefaultSliceCapacity)
+		res         *federationType.ExposedModule
+		aux         *auxFederationExposedModule
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
+	)
+
+	if s.Filters.FederationExposedModule != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.FederationExposedModule(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = FederationExposedModuleFilter(f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for FederationExposedModule: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursor(f.PageCursor); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := federationExposedModuleSelectQuery(s.Dialect).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableFederationExposedModuleFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for FederationExposedModule: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query FederationExposedModule: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query FederationExposedModule: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query FederationExposedModule: %w", err)
+			return
+		}
+
+		aux = new(auxFederationExposedModule)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for FederationExposedModule: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode FederationExposedModule: %w", err)
+			return
+		}
+
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
+		}
+
+		set = append(set, res)
+	}
+
+	return set, f.Limit > 0 && count >= f.Limit, err
+
+}
+
+// LookupFederationExposedModuleByID searches for federation module by ID
+//
+// It returns federation module
+//
+// This function is auto-generated
+func (s *Store) LookupFederationExposedModuleByID(ctx context.Context, id uint64) (_ *federationType.ExposedModule, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxFederationExposedModule)
+		lookup = federationExposedModuleSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableFederationExposedModuleFields returns all <no value> columns flagged as sortable
+//
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableFederationExposedModuleFields() map[string]string {
+	return map[string]string{
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"handle":     "handle",
+		"id":         "id",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
+	}
+}
+
+// collectFederationExposedModuleCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
+//
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
+//
+// Known issue:
+//   when collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+//   undeleted items)
+//
+// This function is auto-generated
+func (s *Store) collectFederationExposedModuleCursorValues(res *federationType.ExposedModule, cc ...*filter.SortExpr) *filter.PagingCursor {
+	var (
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
+
+		hasUnique bool
+
+		pkID bool
+
+		collect = func(cc ...*filter.SortExpr) {
+			for _, c := range cc {
+				switch c.Column {
+				case "id":
+					cur.Set(c.Column, res.ID, c.Descending)
+					pkID = true
+				case "handle":
+					cur.Set(c.Column, res.Handle, c.Descending)
+					hasUnique = true
+				case "createdAt":
+					cur.Set(c.Column, res.CreatedAt, c.Descending)
+				case "updatedAt":
+					cur.Set(c.Column, res.UpdatedAt, c.Descending)
+				case "deletedAt":
+					cur.Set(c.Column, res.DeletedAt, c.Descending)
+				}
+			}
+		}
+	)
+
+	collect(cc...)
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
+	}
+
+	return cur
+
+}
+
+// checkFederationExposedModuleConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkFederationExposedModuleConstraints(ctx context.Context, res *federationType.ExposedModule) (err error) {
+	return nil
+}
+
+// CreateFederationModuleMapping creates one or more rows in federationModuleMapping collection
+//
+// This function is auto-generated
+func (s *Store) CreateFederationModuleMapping(ctx context.Context, rr ...*federationType.ModuleMapping) (err error) {
+	for i := range rr {
+		if err = s.checkFederationModuleMappingConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, federationModuleMappingInsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpdateFederationModuleMapping updates one or more existing entries in federationModuleMapping collection
+//
+// This function is auto-generated
+func (s *Store) UpdateFederationModuleMapping(ctx context.Context, rr ...*federationType.ModuleMapping) (err error) {
+	for i := range rr {
+		if err = s.checkFederationModuleMappingConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, federationModuleMappingUpdateQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpsertFederationModuleMapping updates one or more existing entries in federationModuleMapping collection
+//
+// This function is auto-generated
+func (s *Store) UpsertFederationModuleMapping(ctx context.Context, rr ...*federationType.ModuleMapping) (err error) {
+	for i := range rr {
+		if err = s.checkFederationModuleMappingConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, federationModuleMappingUpsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// DeleteFederationModuleMapping Deletes one or more entries from federationModuleMapping collection
+//
+// This function is auto-generated
+func (s *Store) DeleteFederationModuleMapping(ctx context.Context, rr ...*federationType.ModuleMapping) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, federationModuleMappingDeleteQuery(s.Dialect, federationModuleMappingPrimaryKeys(rr[i]))); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// DeleteFederationModuleMappingByID deletes single entry from federationModuleMapping collection
+//
+// This function is auto-generated
+func (s *Store) DeleteFederationModuleMappingBy(ctx context.Context) error {
+	return s.Exec(ctx, federationModuleMappingDeleteQuery(s.Dialect, goqu.Ex{}))
+}
+
+// TruncateFederationModuleMappings Deletes all rows from the federationModuleMapping collection
+func (s Store) TruncateFederationModuleMappings(ctx context.Context) error {
+	return s.Exec(ctx, federationModuleMappingTruncateQuery(s.Dialect))
+}
+
+// SearchFederationModuleMappings returns (filtered) set of FederationModuleMappings
+//
+// This function is auto-generated
+func (s *Store) SearchFederationModuleMappings(ctx context.Context, f federationType.ModuleMappingFilter) (set federationType.ModuleMappingSet, _ federationType.ModuleMappingFilter, err error) {
+
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfFederationModuleMappings fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfFederationModuleMappings(ctx, f, sort)
+
+	f.PageCursor = nil
+	if err != nil {
+		return nil, f, err
+	}
+
+	return set, f, nil
+}
+
+// fetchFullPageOfFederationModuleMappings collects all requested results.
+//
+// Function applies:
+//  - cursor conditions (where ...)
+//  - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// Function then moves cursor to the last item fetched
+//
+// This function is auto-generated
+func (s *Store) fetchFullPageOfFederationModuleMappings(
+	ctx context.Context,
+	filter federationType.ModuleMappingFilter,
+	sort filter.SortExprSet,
+) (set []*federationType.ModuleMapping, prev, next *filter.PagingCursor, err error) {
+	var (
+		aux []*federationType.ModuleMapping
+
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
+
+		reqItems = filter.Limit
+
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
+
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
+
+		tryFilter federationType.ModuleMappingFilter
+	)
+
+	set = make([]*federationType.ModuleMapping, 0, DefaultSliceCapacity)
+
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
+		}
+
+		if aux, hasNext, err = s.QueryFederationModuleMappings(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if len(aux) == 0 {
+			// nothing fetched
+			break
+		}
+
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
+		}
+
+		collected := uint(len(set))
+
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
+
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectFederationModuleMappingCursorValues(set[collected-1], filter.Sort...)
+
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
+		}
+
+		break
+	}
+
+	collected := len(set)
+
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
+
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
+
+	if hasPrev {
+		prev = s.collectFederationModuleMappingCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
+	}
+
+	if hasNext {
+		next = s.collectFederationModuleMappingCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
+}
+
+// QueryFederationModuleMappings queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryFederationModuleMappings(
+	ctx context.Context,
+	f federationType.ModuleMappingFilter,
+) (_ []*federationType.ModuleMapping, more bool, err error) {
+	var (
+		ok bool
+
+		set         = make([]*federationType.ModuleMapping, 0, DefaultSliceCapacity)
+		res         *federationType.ModuleMapping
+		aux         *auxFederationModuleMapping
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
+	)
+
+	if s.Filters.FederationModuleMapping != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.FederationModuleMapping(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = FederationModuleMappingFilter(f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for FederationModuleMapping: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursor(f.PageCursor); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := federationModuleMappingSelectQuery(s.Dialect).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableFederationModuleMappingFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for FederationModuleMapping: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query FederationModuleMapping: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query FederationModuleMapping: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query FederationModuleMapping: %w", err)
+			return
+		}
+
+		aux = new(auxFederationModuleMapping)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for FederationModuleMapping: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode FederationModuleMapping: %w", err)
+			return
+		}
+
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
+		}
+
+		set = append(set, res)
+	}
+
+	return set, f.Limit > 0 && count >= f.Limit, err
+
+}
+
+// LookupFederationModuleMappingByFederationModuleIDComposeModuleIDComposeNamespaceID searches for module mapping by federation module id and compose module id
+//
+// It returns module mapping
+//
+// This function is auto-generated
+func (s *Store) LookupFederationModuleMappingByFederationModuleIDComposeModuleIDComposeNamespaceID(ctx context.Context, federationModuleID uint64, composeModuleID uint64, composeNamespaceID uint64) (_ *federationType.ModuleMapping, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxFederationModuleMapping)
+		lookup = federationModuleMappingSelectQuery(s.Dialect).Where(
+			goqu.I("federation_module_id").Eq(federationModuleID),
+			goqu.I("compose_module_id").Eq(composeModuleID),
+			goqu.I("compose_namespace_id").Eq(composeNamespaceID),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// LookupFederationModuleMappingByFederationModuleID searches for module mapping by federation module id
+//
+// It returns module mapping
+//
+// This function is auto-generated
+func (s *Store) LookupFederationModuleMappingByFederationModuleID(ctx context.Context, federationModuleID uint64) (_ *federationType.ModuleMapping, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxFederationModuleMapping)
+		lookup = federationModuleMappingSelectQuery(s.Dialect).Where(
+			goqu.I("federation_module_id").Eq(federationModuleID),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableFederationModuleMappingFields returns all <no value> columns flagged as sortable
+//
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableFederationModuleMappingFields() map[string]string {
+	return map[string]string{}
+}
+
+// collectFederationModuleMappingCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
+//
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
+//
+// Known issue:
+//   when collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+//   undeleted items)
+//
+// This function is auto-generated
+func (s *Store) collectFederationModuleMappingCursorValues(res *federationType.ModuleMapping, cc ...*filter.SortExpr) *filter.PagingCursor {
+	return nil
+}
+
+// checkFederationModuleMappingConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkFederationModuleMappingConstraints(ctx context.Context, res *federationType.ModuleMapping) (err error) {
+	return nil
+}
+
+// CreateFederationNode creates one or more rows in federationNode collection
+//
+// This function is auto-generated
+func (s *Store) CreateFederationNode(ctx context.Context, rr ...*federationType.Node) (err error) {
+	for i := range rr {
+		if err = s.checkFederationNodeConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, federationNodeInsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpdateFederationNode updates one or more existing entries in federationNode collection
+//
+// This function is auto-generated
+func (s *Store) UpdateFederationNode(ctx context.Context, rr ...*federationType.Node) (err error) {
+	for i := range rr {
+		if err = s.checkFederationNodeConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, federationNodeUpdateQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpsertFederationNode updates one or more existing entries in federationNode collection
+//
+// This function is auto-generated
+func (s *Store) UpsertFederationNode(ctx context.Context, rr ...*federationType.Node) (err error) {
+	for i := range rr {
+		if err = s.checkFederationNodeConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, federationNodeUpsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// DeleteFederationNode Deletes one or more entries from federationNode collection
+//
+// This function is auto-generated
+func (s *Store) DeleteFederationNode(ctx context.Context, rr ...*federationType.Node) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, federationNodeDeleteQuery(s.Dialect, federationNodePrimaryKeys(rr[i]))); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// DeleteFederationNodeByID deletes single entry from federationNode collection
+//
+// This function is auto-generated
+func (s *Store) DeleteFederationNodeByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, federationNodeDeleteQuery(s.Dialect, goqu.Ex{
+		"id": id,
+	}))
+}
+
+// TruncateFederationNodes Deletes all rows from the federationNode collection
+func (s Store) TruncateFederationNodes(ctx context.Context) error {
+	return s.Exec(ctx, federationNodeTruncateQuery(s.Dialect))
+}
+
+// SearchFederationNodes returns (filtered) set of FederationNodes
+//
+// This function is auto-generated
+func (s *Store) SearchFederationNodes(ctx context.Context, f federationType.NodeFilter) (set federationType.NodeSet, _ federationType.NodeFilter, err error) {
+
+	set, _, err = s.QueryFederationNodes(ctx, f)
+	if err != nil {
+		return nil, f, err
+	}
+
+	return set, f, nil
+}
+
+// QueryFederationNodes queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryFederationNodes(
+	ctx context.Context,
+	f federationType.NodeFilter,
+) (_ []*federationType.Node, more bool, err error) {
+	var (
+		ok bool
+
+		set         = make([]*federationType.Node, 0, DefaultSliceCapacity)
+		res         *federationType.Node
+		aux         *auxFederationNode
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+	)
+
+	if s.Filters.FederationNode != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.FederationNode(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = FederationNodeFilter(f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for FederationNode: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	query := federationNodeSelectQuery(s.Dialect).Where(expr...)
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query FederationNode: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query FederationNode: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query FederationNode: %w", err)
+			return
+		}
+
+		aux = new(auxFederationNode)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for FederationNode: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode FederationNode: %w", err)
+			return
+		}
+
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
+		}
+
+		set = append(set, res)
+	}
+
+	return set, false, err
+
+}
+
+// LookupFederationNodeByID searches for federation node by ID
+//
+// It returns federation node
+//
+// This function is auto-generated
+func (s *Store) LookupFederationNodeByID(ctx context.Context, id uint64) (_ *federationType.Node, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxFederationNode)
+		lookup = federationNodeSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// LookupFederationNodeByBaseURLSharedNodeID searches for node by shared-node-id and base-url
+//
+// This function is auto-generated
+func (s *Store) LookupFederationNodeByBaseURLSharedNodeID(ctx context.Context, baseURL string, sharedNodeID uint64) (_ *federationType.Node, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxFederationNode)
+		lookup = federationNodeSelectQuery(s.Dialect).Where(
+			goqu.I("base_url").Eq(baseURL),
+			goqu.I("shared_node_id").Eq(sharedNodeID),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// LookupFederationNodeBySharedNodeID searches for node by shared-node-id
+//
+// This function is auto-generated
+func (s *Store) LookupFederationNodeBySharedNodeID(ctx context.Context, sharedNodeID uint64) (_ *federationType.Node, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxFederationNode)
+		lookup = federationNodeSelectQuery(s.Dialect).Where(
+			goqu.I("shared_node_id").Eq(sharedNodeID),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableFederationNodeFields returns all <no value> columns flagged as sortable
+//
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableFederationNodeFields() map[string]string {
+	return map[string]string{
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"id":         "id",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
+	}
+}
+
+// collectFederationNodeCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
+//
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
+//
+// Known issue:
+//   when collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+//   undeleted items)
+//
+// This function is auto-generated
+func (s *Store) collectFederationNodeCursorValues(res *federationType.Node, cc ...*filter.SortExpr) *filter.PagingCursor {
+	var (
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
+
+		hasUnique bool
+
+		pkID bool
+
+		collect = func(cc ...*filter.SortExpr) {
+			for _, c := range cc {
+				switch c.Column {
+				case "id":
+					cur.Set(c.Column, res.ID, c.Descending)
+					pkID = true
+				case "createdAt":
+					cur.Set(c.Column, res.CreatedAt, c.Descending)
+				case "updatedAt":
+					cur.Set(c.Column, res.UpdatedAt, c.Descending)
+				case "deletedAt":
+					cur.Set(c.Column, res.DeletedAt, c.Descending)
+				}
+			}
+		}
+	)
+
+	collect(cc...)
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
+	}
+
+	return cur
+
+}
+
+// checkFederationNodeConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkFederationNodeConstraints(ctx context.Context, res *federationType.Node) (err error) {
+	return nil
+}
+
+// CreateFederationNodeSync creates one or more rows in federationNodeSync collection
+//
+// This function is auto-generated
+func (s *Store) CreateFederationNodeSync(ctx context.Context, rr ...*federationType.NodeSync) (err error) {
+	for i := range rr {
+		if err = s.checkFederationNodeSyncConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, federationNodeSyncInsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpdateFederationNodeSync updates one or more existing entries in federationNodeSync collection
+//
+// This function is auto-generated
+func (s *Store) UpdateFederationNodeSync(ctx context.Context, rr ...*federationType.NodeSync) (err error) {
+	for i := range rr {
+		if err = s.checkFederationNodeSyncConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, federationNodeSyncUpdateQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpsertFederationNodeSync updates one or more existing entries in federationNodeSync collection
+//
+// This function is auto-generated
+func (s *Store) UpsertFederationNodeSync(ctx context.Context, rr ...*federationType.NodeSync) (err error) {
+	for i := range rr {
+		if err = s.checkFederationNodeSyncConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, federationNodeSyncUpsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// DeleteFederationNodeSync Deletes one or more entries from federationNodeSync collection
+//
+// This function is auto-generated
+func (s *Store) DeleteFederationNodeSync(ctx context.Context, rr ...*federationType.NodeSync) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, federationNodeSyncDeleteQuery(s.Dialect, federationNodeSyncPrimaryKeys(rr[i]))); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// DeleteFederationNodeSyncByID deletes single entry from federationNodeSync collection
+//
+// This function is auto-generated
+func (s *Store) DeleteFederationNodeSyncByNodeID(ctx context.Context, nodeID uint64) error {
+	return s.Exec(ctx, federationNodeSyncDeleteQuery(s.Dialect, goqu.Ex{
+		"node_id": nodeID,
+	}))
+}
+
+// TruncateFederationNodeSyncs Deletes all rows from the federationNodeSync collection
+func (s Store) TruncateFederationNodeSyncs(ctx context.Context) error {
+	return s.Exec(ctx, federationNodeSyncTruncateQuery(s.Dialect))
+}
+
+// SearchFederationNodeSyncs returns (filtered) set of FederationNodeSyncs
+//
+// This function is auto-generated
+func (s *Store) SearchFederationNodeSyncs(ctx context.Context, f federationType.NodeSyncFilter) (set federationType.NodeSyncSet, _ federationType.NodeSyncFilter, err error) {
+
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfFederationNodeSyncs fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfFederationNodeSyncs(ctx, f, sort)
+
+	f.PageCursor = nil
+	if err != nil {
+		return nil, f, err
+	}
+
+	return set, f, nil
+}
+
+// fetchFullPageOfFederationNodeSyncs collects all requested results.
+//
+// Function applies:
+//  - cursor conditions (where ...)
+//  - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// Function then moves cursor to the last item fetched
+//
+// This function is auto-generated
+func (s *Store) fetchFullPageOfFederationNodeSyncs(
+	ctx context.Context,
+	filter federationType.NodeSyncFilter,
+	sort filter.SortExprSet,
+) (set []*federationType.NodeSync, prev, next *filter.PagingCursor, err error) {
+	var (
+		aux []*federationType.NodeSync
+
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
+
+		reqItems = filter.Limit
+
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
+
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
+
+		tryFilter federationType.NodeSyncFilter
+	)
+
+	set = make([]*federationType.NodeSync, 0, DefaultSliceCapacity)
+
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
+		}
+
+		if aux, hasNext, err = s.QueryFederationNodeSyncs(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
+		}
+
+		if len(aux) == 0 {
+			// nothing fetched
+			break
+		}
+
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
+		}
+
+		collected := uint(len(set))
+
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
+
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectFederationNodeSyncCursorValues(set[collected-1], filter.Sort...)
+
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
+		}
+
+		break
+	}
+
+	collected := len(set)
+
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
+
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
+
+	if hasPrev {
+		prev = s.collectFederationNodeSyncCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
+	}
+
+	if hasNext {
+		next = s.collectFederationNodeSyncCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
+}
+
+// QueryFederationNodeSyncs queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryFederationNodeSyncs(
+	ctx context.Context,
+	f federationType.NodeSyncFilter,
+) (_ []*federationType.NodeSync, more bool, err error) {
+	var (
+		ok bool
+
+		set         = make([]*federationType.NodeSync, 0, DefaultSliceCapacity)
+		res         *federationType.NodeSync
+		aux         *auxFederationNodeSync
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
+	)
+
+	if s.Filters.FederationNodeSync != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.FederationNodeSync(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = FederationNodeSyncFilter(f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for FederationNodeSync: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursor(f.PageCursor); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := federationNodeSyncSelectQuery(s.Dialect).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableFederationNodeSyncFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for FederationNodeSync: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query FederationNodeSync: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query FederationNodeSync: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query FederationNodeSync: %w", err)
+			return
+		}
+
+		aux = new(auxFederationNodeSync)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for FederationNodeSync: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode FederationNodeSync: %w", err)
+			return
+		}
+
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
+		}
+
+		set = append(set, res)
+	}
+
+	return set, f.Limit > 0 && count >= f.Limit, err
+
+}
+
+// LookupFederationNodeSyncByNodeID searches for sync activity by node ID
+//
+// It returns sync activity
+//
+// This function is auto-generated
+func (s *Store) LookupFederationNodeSyncByNodeID(ctx context.Context, nodeID uint64) (_ *federationType.NodeSync, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxFederationNodeSync)
+		lookup = federationNodeSyncSelectQuery(s.Dialect).Where(
+			goqu.I("node_id").Eq(nodeID),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// LookupFederationNodeSyncByNodeIDModuleIDSyncTypeSyncStatus searches for activity by node, type and status
+//
+// It returns sync activity
+//
+// This function is auto-generated
+func (s *Store) LookupFederationNodeSyncByNodeIDModuleIDSyncTypeSyncStatus(ctx context.Context, nodeID uint64, moduleID uint64, syncType string, syncStatus string) (_ *federationType.NodeSync, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxFederationNodeSync)
+		lookup = federationNodeSyncSelectQuery(s.Dialect).Where(
+			goqu.I("node_id").Eq(nodeID),
+			goqu.I("module_id").Eq(moduleID),
+			goqu.I("sync_type").Eq(syncType),
+			goqu.I("sync_status").Eq(syncStatus),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableFederationNodeSyncFields returns all <no value> columns flagged as sortable
+//
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableFederationNodeSyncFields() map[string]string {
+	return map[string]string{
+		"node_id":        "node_id",
+		"nodeid":         "node_id",
+		"time_of_action": "time_of_action",
+		"timeofaction":   "time_of_action",
+	}
+}
+
+// collectFederationNodeSyncCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
+//
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
+//
+// Known issue:
+//   when collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+//   undeleted items)
+//
+// This function is auto-generated
+func (s *Store) collectFederationNodeSyncCursorValues(res *federationType.NodeSync, cc ...*filter.SortExpr) *filter.PagingCursor {
+	var (
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
+
+		hasUnique bool
+
+		pkNodeID bool
+
+		collect = func(cc ...*filter.SortExpr) {
+			for _, c := range cc {
+				switch c.Column {
+				case "nodeID":
+					cur.Set(c.Column, res.NodeID, c.Descending)
+					pkNodeID = true
+				case "timeOfAction":
+					cur.Set(c.Column, res.TimeOfAction, c.Descending)
+				}
+			}
+		}
+	)
+
+	collect(cc...)
+	if !hasUnique || !pkNodeID {
+		collect(&filter.SortExpr{Column: "nodeID", Descending: false})
+	}
+
+	return cur
+
+}
+
+// checkFederationNodeSyncConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkFederationNodeSyncConstraints(ctx context.Context, res *federationType.NodeSync) (err error) {
+	return nil
+}
+
+// CreateFederationSharedModule creates one or more rows in federationSharedModule collection
+//
+// This function is auto-generated
+func (s *Store) CreateFederationSharedModule(ctx context.Context, rr ...*federationType.SharedModule) (err error) {
+	for i := range rr {
+		if err = s.checkFederationSharedModuleConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, federationSharedModuleInsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpdateFederationSharedModule updates one or more existing entries in federationSharedModule collection
+//
+// This function is auto-generated
+func (s *Store) UpdateFederationSharedModule(ctx context.Context, rr ...*federationType.SharedModule) (err error) {
+	for i := range rr {
+		if err = s.checkFederationSharedModuleConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, federationSharedModuleUpdateQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UpsertFederationSharedModule updates one or more existing entries in federationSharedModule collection
+//
+// This function is auto-generated
+func (s *Store) UpsertFederationSharedModule(ctx context.Context, rr ...*federationType.SharedModule) (err error) {
+	for i := range rr {
+		if err = s.checkFederationSharedModuleConstraints(ctx, rr[i]); err != nil {
+			return
+		}
+
+		if err = s.Exec(ctx, federationSharedModuleUpsertQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// DeleteFederationSharedModule Deletes one or more entries from federationSharedModule collection
+//
+// This function is auto-generated
+func (s *Store) DeleteFederationSharedModule(ctx context.Context, rr ...*federationType.SharedModule) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, federationSharedModuleDeleteQuery(s.Dialect, federationSharedModulePrimaryKeys(rr[i]))); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+// DeleteFederationSharedModuleByID deletes single entry from federationSharedModule collection
+//
+// This function is auto-generated
+func (s *Store) DeleteFederationSharedModuleByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, federationSharedModuleDeleteQuery(s.Dialect, goqu.Ex{
+		"id": id,
+	}))
+}
+
+// TruncateFederationSharedModules Deletes all rows from the federationSharedModule collection
+func (s Store) TruncateFederationSharedModules(ctx context.Context) error {
+	return s.Exec(ctx, federationSharedModuleTruncateQuery(s.Dialect))
+}
+
+// SearchFederationSharedModules returns (filtered) set of FederationSharedModules
+//
+// This function is auto-generated
+func (s *Store) SearchFederationSharedModules(ctx context.Context, f federationType.SharedModuleFilter) (set federationType.SharedModuleSet, _ federationType.SharedModuleFilter, err error) {
+
+	// Cleanup unwanted cursor values (only relevant is f.PageCursor, next&prev are reset and returned)
+	f.PrevPage, f.NextPage = nil, nil
+
+	if f.PageCursor != nil {
+		// Page cursor exists; we need to validate it against used sort
+		// To cover the case when paging cursor is set but sorting is empty, we collect the sorting instructions
+		// from the cursor.
+		// This (extracted sorting info) is then returned as part of response
+		if f.Sort, err = f.PageCursor.Sort(f.Sort); err != nil {
+			return
+		}
+	}
+
+	// Make sure results are always sorted at least by primary keys
+	if f.Sort.Get("id") == nil {
+		f.Sort = append(f.Sort, &filter.SortExpr{
+			Column:     "id",
+			Descending: f.Sort.LastDescending(),
+		})
+	}
+
+	// Cloned sorting instructions for the actual sorting
+	// Original are passed to the etchFullPageOfFederationSharedModules fn used for cursor creation;
+	// direction information it MUST keep the initial
+	sort := f.Sort.Clone()
+
+	// When cursor for a previous page is used it's marked as reversed
+	// This tells us to flip the descending flag on all used sort keys
+	if f.PageCursor != nil && f.PageCursor.ROrder {
+		sort.Reverse()
+	}
+
+	set, f.PrevPage, f.NextPage, err = s.fetchFullPageOfFederationSharedModules(ctx, f, sort)
+
+	f.PageCursor = nil
+	if err != nil {
+		return nil, f, err
+	}
+
+	return set, f, nil
+}
+
+// fetchFullPageOfFederationSharedModules collects all requested results.
+//
+// Function applies:
+//  - cursor conditions (where ...)
+//  - limit
+//
+// Main responsibility of this function is to perform additional sequential queries in case when not enough results
+// are collected due to failed check on a specific row (by check fn).
+//
+// Function then moves cursor to the last item fetched
+//
+// This function is auto-generated
+func (s *Store) fetchFullPageOfFederationSharedModules(
+	ctx context.Context,
+	filter federationType.SharedModuleFilter,
+	sort filter.SortExprSet,
+) (set []*federationType.SharedModule, prev, next *filter.PagingCursor, err error) {
 	var (
 		aux []*federationType.SharedModule
 
-		// When cursor for a previous page is used it's marked as reversed
-		// This tells us to flip the descending flag on all used sort keys
-		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+		// When cursor for a previous page is used it's marked as reversed
+		// This tells us to flip the descending flag on all used sort keys
+		reversedOrder = filter.PageCursor != nil && filter.PageCursor.ROrder
+
+		// Copy no. of required items to limit
+		// Limit will change when doing subsequent queries to fill
+		// the set with all required items
+		limit = filter.Limit
+
+		reqItems = filter.Limit
+
+		// cursor to prev. page is only calculated when cursor is used
+		hasPrev = filter.PageCursor != nil
+
+		// next cursor is calculated when there are more pages to come
+		hasNext bool
+
+		tryFilter federationType.SharedModuleFilter
+	)
+
+	set = make([]*federationType.SharedModule, 0, DefaultSliceCapacity)
+
+	for try := 0; try < MaxRefetches; try++ {
+		// Copy filter & apply custom sorting that might be affected by cursor
+		tryFilter = filter
+		tryFilter.Sort = sort
+
+		if limit > 0 {
+			// fetching + 1 to peak ahead if there are more items
+			// we can fetch (next-page cursor)
+			tryFilter.Limit = limit + 1
+		}
+
+		if aux, hasNext, err = s.QueryFederationSharedModules(ctx, tryFilter); err != nil {
+			return nil, nil, nil, err
+		}
 
-		// Copy no. of required items to limit
-		// Limit will change when doing subsequent queries to fill
-		// the set with all required items
-		limit = filter.Limit
+		if len(aux) == 0 {
+			// nothing fetched
+			break
+		}
+
+		// append fetched items
+		set = append(set, aux...)
+
+		if reqItems == 0 || !hasNext {
+			// no max requested items specified, break out
+			break
+		}
+
+		collected := uint(len(set))
+
+		if reqItems > collected {
+			// not enough items fetched, try again with adjusted limit
+			limit = reqItems - collected
+
+			if limit < MinEnsureFetchLimit {
+				// In case limit is set very low and we've missed records in the first fetch,
+				// make sure next fetch limit is a bit higher
+				limit = MinEnsureFetchLimit
+			}
+
+			// Update cursor so that it points to the last item fetched
+			tryFilter.PageCursor = s.collectFederationSharedModuleCursorValues(set[collected-1], filter.Sort...)
+
+			// Copy reverse flag from sorting
+			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
+			continue
+		}
+
+		if reqItems < collected {
+			set = set[:reqItems]
+		}
+
+		break
+	}
+
+	collected := len(set)
+
+	if collected == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if reversedOrder {
+		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
+		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
+			set[i], set[j] = set[j], set[i]
+		}
+
+		// when in reverse-order rules on what cursor to return change
+		hasPrev, hasNext = hasNext, hasPrev
+	}
+
+	if hasPrev {
+		prev = s.collectFederationSharedModuleCursorValues(set[0], filter.Sort...)
+		prev.ROrder = true
+		prev.LThen = !filter.Sort.Reversed()
+	}
+
+	if hasNext {
+		next = s.collectFederationSharedModuleCursorValues(set[collected-1], filter.Sort...)
+		next.LThen = filter.Sort.Reversed()
+	}
+
+	return set, prev, next, nil
+}
+
+// QueryFederationSharedModules queries the database, converts and checks each row and returns collected set
+//
+// With generics, we can remove this per-resource-generated function
+// and replace it with a single utility fetcher
+//
+// This function is auto-generated
+func (s *Store) QueryFederationSharedModules(
+	ctx context.Context,
+	f federationType.SharedModuleFilter,
+) (_ []*federationType.SharedModule, more bool, err error) {
+	var (
+		ok bool
+
+		set         = make([]*federationType.SharedModule, 0, DefaultSliceCapacity)
+		res         *federationType.SharedModule
+		aux         *auxFederationSharedModule
+		rows        *sql.Rows
+		count       uint
+		expr, tExpr []goqu.Expression
+
+		sortExpr []exp.OrderedExpression
+	)
+
+	if s.Filters.FederationSharedModule != nil {
+		// extended filter set
+		tExpr, f, err = s.Filters.FederationSharedModule(s, f)
+	} else {
+		// using generated filter
+		tExpr, f, err = FederationSharedModuleFilter(f)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could generate filter expression for FederationSharedModule: %w", err)
+		return
+	}
+
+	expr = append(expr, tExpr...)
+
+	// paging feature is enabled
+	if f.PageCursor != nil {
+		if tExpr, err = cursor(f.PageCursor); err != nil {
+			return
+		} else {
+			expr = append(expr, tExpr...)
+		}
+	}
+
+	query := federationSharedModuleSelectQuery(s.Dialect).Where(expr...)
+
+	// sorting feature is enabled
+	if sortExpr, err = order(f.Sort, s.sortableFederationSharedModuleFields()); err != nil {
+		err = fmt.Errorf("could generate order expression for FederationSharedModule: %w", err)
+		return
+	}
+
+	if len(sortExpr) > 0 {
+		query = query.Order(sortExpr...)
+	}
+
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+
+	rows, err = s.Query(ctx, query)
+	if err != nil {
+		err = fmt.Errorf("could not query FederationSharedModule: %w", err)
+		return
+	}
+
+	if err = rows.Err(); err != nil {
+		err = fmt.Errorf("could not query FederationSharedModule: %w", err)
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	for rows.Next() {
+		if err = rows.Err(); err != nil {
+			err = fmt.Errorf("could not query FederationSharedModule: %w", err)
+			return
+		}
+
+		aux = new(auxFederationSharedModule)
+		if err = aux.scan(rows); err != nil {
+			err = fmt.Errorf("could not scan rows for FederationSharedModule: %w", err)
+			return
+		}
+
+		count++
+		if res, err = aux.decode(); err != nil {
+			err = fmt.Errorf("could not decode FederationSharedModule: %w", err)
+			return
+		}
+
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
+		}
+
+		set = append(set, res)
+	}
+
+	return set, f.Limit > 0 && count >= f.Limit, err
+
+}
+
+// LookupFederationSharedModuleByID searches for shared federation module by ID
+//
+// It returns shared federation module
+//
+// This function is auto-generated
+func (s *Store) LookupFederationSharedModuleByID(ctx context.Context, id uint64) (_ *federationType.SharedModule, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxFederationSharedModule)
+		lookup = federationSharedModuleSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableFederationSharedModuleFields returns all <no value> columns flagged as sortable
+//
+// With optional string arg, all columns are returned aliased
+//
+// This function is auto-generated
+func (Store) sortableFederationSharedModuleFields() map[string]string {
+	return map[string]string{
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"handle":     "handle",
+		"id":         "id",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
+	}
+}
 
-		reqItems = filter.Limit
+// collectFederationSharedModuleCursorValues collects values from the given resource that and sets them to the cursor
+// to be used for pagination
+//
+// Values that are collected must come from sortable, unique or primary columns/fields
+// At least one of the collected columns must be flagged as unique, otherwise fn appends primary keys at the end
+//
+// Known issue:
+//   when collecting cursor values for query that sorts by unique column with partial index (ie: unique handle on
+//   undeleted items)
+//
+// This function is auto-generated
+func (s *Store) collectFederationSharedModuleCursorValues(res *federationType.SharedModule, cc ...*filter.SortExpr) *filter.PagingCursor {
+	var (
+		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
-		// cursor to prev. page is only calculated when cursor is used
-		hasPrev = filter.PageCursor != nil
+		hasUnique bool
 
-		// next cursor is calculated when there are more pages to come
-		hasNext bool
+		pkID bool
 
-		tryFilter federationType.SharedModuleFilter
+		collect = func(cc ...*filter.SortExpr) {
+			for _, c := range cc {
+				switch c.Column {
+				case "id":
+					cur.Set(c.Column, res.ID, c.Descending)
+					pkID = true
+				case "handle":
+					cur.Set(c.Column, res.Handle, c.Descending)
+					hasUnique = true
+				case "createdAt":
+					cur.Set(c.Column, res.CreatedAt, c.Descending)
+				case "updatedAt":
+					cur.Set(c.Column, res.UpdatedAt, c.Descending)
+				case "deletedAt":
+					cur.Set(c.Column, res.DeletedAt, c.Descending)
+				}
+			}
+		}
 	)
 
-	set = make([]*federationType.SharedModule, 0, DefaultSliceCapacity)
+	collect(cc...)
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
+	}
 
-	for try := 0; try < MaxRefetches; try++ {
-		// Copy filter & apply custom sorting that might be affected by cursor
-		tryFilter = filter
-		tryFilter.Sort = sort
+	return cur
 
-		if limit > 0 {
-			// fetching + 1 to peak ahead if there are more items
-			// we can fetch (next-page cursor)
-			tryFilter.Limit = limit + 1
-		}
+}
 
-		if aux, hasNext, err = s.QueryFederationSharedModules(ctx, tryFilter); err != nil {
-			return nil, nil, nil, err
-		}
+// checkFederationSharedModuleConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// already exists in the store
+//
+// Using built-in constraint checking would be more performant, but unfortunately we cannot rely
+// on the full support (MySQL does not support conditional indexes)
+//
+// This function is auto-generated
+func (s *Store) checkFederationSharedModuleConstraints(ctx context.Context, res *federationType.SharedModule) (err error) {
+	return nil
+}
 
-		if len(aux) == 0 {
-			// nothing fetched
-			break
+// CreateFlag creates one or more rows in flag collection
+//
+// This function is auto-generated
+func (s *Store) CreateFlag(ctx context.Context, rr ...*flagType.Flag) (err error) {
+	for i := range rr {
+		if err = s.checkFlagConstraints(ctx, rr[i]); err != nil {
+			return
 		}
 
-		// append fetched items
-		set = append(set, aux...)
-
-		if reqItems == 0 || !hasNext {
-			// no max requested items specified, break out
-			break
+		if err = s.Exec(ctx, flagInsertQuery(s.Dialect, rr[i])); err != nil {
+			return
 		}
+	}
 
-		collected := uint(len(set))
+	return
+}
 
-		if reqItems > collected {
-			// not enough items fetched, try again with adjusted limit
-			limit = reqItems - collected
+// UpdateFlag updates one or more existing entries in flag collection
+//
+// This function is auto-generated
+func (s *Store) UpdateFlag(ctx context.Context, rr ...*flagType.Flag) (err error) {
+	for i := range rr {
+		if err = s.checkFlagConstraints(ctx, rr[i]); err != nil {
+			return
+		}
 
-			if limit < MinEnsureFetchLimit {
-				// In case limit is set very low and we've missed records in the first fetch,
-				// make sure next fetch limit is a bit higher
-				limit = MinEnsureFetchLimit
-			}
+		if err = s.Exec(ctx, flagUpdateQuery(s.Dialect, rr[i])); err != nil {
+			return
+		}
+	}
 
-			// Update cursor so that it points to the last item fetched
-			tryFilter.PageCursor = s.collectFederationSharedModuleCursorValues(set[collected-1], filter.Sort...)
+	return
+}
 
-			// Copy reverse flag from sorting
-			tryFilter.PageCursor.LThen = filter.Sort.Reversed()
-			continue
+// UpsertFlag updates one or more existing entries in flag collection
+//
+// This function is auto-generated
+func (s *Store) UpsertFlag(ctx context.Context, rr ...*flagType.Flag) (err error) {
+	for i := range rr {
+		if err = s.checkFlagConstraints(ctx, rr[i]); err != nil {
+			return
 		}
 
-		if reqItems < collected {
-			set = set[:reqItems]
+		if err = s.Exec(ctx, flagUpsertQuery(s.Dialect, rr[i])); err != nil {
+			return
 		}
-
-		break
 	}
 
-	collected := len(set)
+	return
+}
 
-	if collected == 0 {
-		return nil, nil, nil, nil
+// DeleteFlag Deletes one or more entries from flag collection
+//
+// This function is auto-generated
+func (s *Store) DeleteFlag(ctx context.Context, rr ...*flagType.Flag) (err error) {
+	for i := range rr {
+		if err = s.Exec(ctx, flagDeleteQuery(s.Dialect, flagPrimaryKeys(rr[i]))); err != nil {
+			return
+		}
 	}
 
-	if reversedOrder {
-		// Fetched set needs to be reversed because we've forced a descending order to get the previous page
-		for i, j := 0, collected-1; i < j; i, j = i+1, j-1 {
-			set[i], set[j] = set[j], set[i]
-		}
+	return nil
+}
 
-		// when in reverse-order rules on what cursor to return change
-		hasPrev, hasNext = hasNext, hasPrev
-	}
+// DeleteFlagByID deletes single entry from flag collection
+//
+// This function is auto-generated
+func (s *Store) DeleteFlagByKindResourceIDOwnedByName(ctx context.Context, kind string, resourceID uint64, ownedBy uint64, name string) error {
+	return s.Exec(ctx, flagDeleteQuery(s.Dialect, goqu.Ex{
+		"kind":         kind,
+		"rel_resource": resourceID,
+		"owned_by":     ownedBy,
+		"name":         name,
+	}))
+}
 
-	if hasPrev {
-		prev = s.collectFederationSharedModuleCursorValues(set[0], filter.Sort...)
-		prev.ROrder = true
-		prev.LThen = !filter.Sort.Reversed()
-	}
+// TruncateFlags Deletes all rows from the flag collection
+func (s Store) TruncateFlags(ctx context.Context) error {
+	return s.Exec(ctx, flagTruncateQuery(s.Dialect))
+}
 
-	if hasNext {
-		next = s.collectFederationSharedModuleCursorValues(set[collected-1], filter.Sort...)
-		next.LThen = filter.Sort.Reversed()
+// SearchFlags returns (filtered) set of Flags
+//
+// This function is auto-generated
+func (s *Store) SearchFlags(ctx context.Context, f flagType.FlagFilter) (set flagType.FlagSet, _ flagType.FlagFilter, err error) {
+
+	set, _, err = s.QueryFlags(ctx, f)
+	if err != nil {
+		return nil, f, err
 	}
 
-	return set, prev, next, nil
+	return set, f, nil
 }
 
-// QueryFederationSharedModules queries the database, converts and checks each row and returns collected set
+// QueryFlags queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryFederationSharedModules(
+func (s *Store) QueryFlags(
 	ctx context.Context,
-	f federationType.SharedModuleFilter,
-) (_ []*federationType.SharedModule, more bool, err error) {
+	f flagType.FlagFilter,
+) (_ []*flagType.Flag, more bool, err error) {
 	var (
-		ok bool
-
-		set         = make([]*federationType.SharedModule, 0, DefaultSliceCapacity)
-		res         *federationType.SharedModule
-		aux         *auxFederationSharedModule
+		set         = make([]*flagType.Flag, 0, DefaultSliceCapacity)
+		res         *flagType.Flag
+		aux         *auxFlag
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
-
-		sortExpr []exp.OrderedExpression
 	)
 
-	if s.Filters.FederationSharedModule != nil {
+	if s.Filters.Flag != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.FederationSharedModule(s, f)
+		tExpr, f, err = s.Filters.Flag(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = FederationSharedModuleFilter(f)
+		tExpr, f, err = FlagFilter(f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for FederationSharedModule: %w", err)
+		err = fmt.Errorf("could generate filter expression for Flag: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	// paging feature is enabled
-	if f.PageCursor != nil {
-		if tExpr, err = cursor(f.PageCursor); err != nil {
-			return
-		} else {
-			expr = append(expr, tExpr...)
-		}
-	}
-
-	query := federationSharedModuleSelectQuery(s.Dialect).Where(expr...)
-
-	// sorting feature is enabled
-	if sortExpr, err = order(f.Sort, s.sortableFederationSharedModuleFields()); err != nil {
-		err = fmt.Errorf("could generate order expression for FederationSharedModule: %w", err)
-		return
-	}
-
-	if len(sortExpr) > 0 {
-		query = query.Order(sortExpr...)
-	}
+	query := flagSelectQuery(s.Dialect).Where(expr...)
 
 	if f.Limit > 0 {
 		query = query.Limit(f.Limit)
@@ -12421,12 +16236,12 @@ func (s *Store) QueryFederationSharedModules(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query FederationSharedModule: %w", err)
+		err = fmt.Errorf("could not query Flag: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query FederationSharedModule: %w", err)
+		err = fmt.Errorf("could not query Flag: %w", err)
 		return
 	}
 
@@ -12440,50 +16255,41 @@ func (s *Store) QueryFederationSharedModules(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query FederationSharedModule: %w", err)
+			err = fmt.Errorf("could not query Flag: %w", err)
 			return
 		}
 
-		aux = new(auxFederationSharedModule)
+		aux = new(auxFlag)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for FederationSharedModule: %w", err)
+			err = fmt.Errorf("could not scan rows for Flag: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode FederationSharedModule: %w", err)
-			return
-		}
-
-		// check fn set, call it and see if it passed the test
-		// if not, skip the item
-		if f.Check != nil {
-			if ok, err = f.Check(res); err != nil {
-				return
-			} else if !ok {
-				continue
-			}
+			err = fmt.Errorf("could not decode Flag: %w", err)
+			return
 		}
 
 		set = append(set, res)
 	}
 
-	return set, f.Limit > 0 && count >= f.Limit, err
+	return set, false, err
 
 }
 
-// LookupFederationSharedModuleByID searches for shared federation module by ID
-//
-// It returns shared federation module
+// LookupFlagByKindResourceIDOwnedByName searches for flag by kind, resource ID, owner and name
 //
 // This function is auto-generated
-func (s *Store) LookupFederationSharedModuleByID(ctx context.Context, id uint64) (_ *federationType.SharedModule, err error) {
+func (s *Store) LookupFlagByKindResourceIDOwnedByName(ctx context.Context, kind string, resourceID uint64, ownedBy uint64, name string) (_ *flagType.Flag, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxFederationSharedModule)
-		lookup = federationSharedModuleSelectQuery(s.Dialect).Where(
-			goqu.I("id").Eq(id),
+		aux    = new(auxFlag)
+		lookup = flagSelectQuery(s.Dialect).Where(
+			goqu.I("kind").Eq(kind),
+			goqu.I("rel_resource").Eq(resourceID),
+			goqu.I("owned_by").Eq(ownedBy),
+			s.Functions.LOWER(goqu.I("name")).Eq(strings.ToLower(name)),
 		).Limit(1)
 	)
 
@@ -12515,25 +16321,23 @@ func (s *Store) LookupFederationSharedModuleByID(ctx context.Context, id uint64)
 	return aux.decode()
 }
 
-// sortableFederationSharedModuleFields returns all <no value> columns flagged as sortable
+// sortableFlagFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableFederationSharedModuleFields() map[string]string {
+func (Store) sortableFlagFields() map[string]string {
 	return map[string]string{
-		"created_at": "created_at",
-		"createdat":  "created_at",
-		"deleted_at": "deleted_at",
-		"deletedat":  "deleted_at",
-		"handle":     "handle",
-		"id":         "id",
-		"updated_at": "updated_at",
-		"updatedat":  "updated_at",
+		"kind":        "kind",
+		"name":        "name",
+		"owned_by":    "owned_by",
+		"ownedby":     "owned_by",
+		"resource_id": "resource_id",
+		"resourceid":  "resource_id",
 	}
 }
 
-// collectFederationSharedModuleCursorValues collects values from the given resource that and sets them to the cursor
+// collectFlagCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -12544,64 +16348,76 @@ func (Store) sortableFederationSharedModuleFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectFederationSharedModuleCursorValues(res *federationType.SharedModule, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectFlagCursorValues(res *flagType.Flag, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
 		hasUnique bool
 
-		pkID bool
+		pkKind       bool
+		pkResourceID bool
+		pkOwnedBy    bool
+		pkName       bool
 
 		collect = func(cc ...*filter.SortExpr) {
 			for _, c := range cc {
 				switch c.Column {
-				case "id":
-					cur.Set(c.Column, res.ID, c.Descending)
-					pkID = true
-				case "handle":
-					cur.Set(c.Column, res.Handle, c.Descending)
-					hasUnique = true
-				case "createdAt":
-					cur.Set(c.Column, res.CreatedAt, c.Descending)
-				case "updatedAt":
-					cur.Set(c.Column, res.UpdatedAt, c.Descending)
-				case "deletedAt":
-					cur.Set(c.Column, res.DeletedAt, c.Descending)
+				case "kind":
+					cur.Set(c.Column, res.Kind, c.Descending)
+					pkKind = true
+				case "resourceID":
+					cur.Set(c.Column, res.ResourceID, c.Descending)
+					pkResourceID = true
+				case "ownedBy":
+					cur.Set(c.Column, res.OwnedBy, c.Descending)
+					pkOwnedBy = true
+				case "name":
+					cur.Set(c.Column, res.Name, c.Descending)
+					pkName = true
 				}
 			}
 		}
 	)
 
 	collect(cc...)
-	if !hasUnique || !pkID {
-		collect(&filter.SortExpr{Column: "id", Descending: false})
+	if !hasUnique || !pkKind {
+		collect(&filter.SortExpr{Column: "kind", Descending: false})
+	}
+	if !hasUnique || !pkResourceID {
+		collect(&filter.SortExpr{Column: "resourceID", Descending: false})
+	}
+	if !hasUnique || !pkOwnedBy {
+		collect(&filter.SortExpr{Column: "ownedBy", Descending: false})
+	}
+	if !hasUnique || !pkName {
+		collect(&filter.SortExpr{Column: "name", Descending: false})
 	}
 
 	return cur
 
 }
 
-// checkFederationSharedModuleConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkFlagConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkFederationSharedModuleConstraints(ctx context.Context, res *federationType.SharedModule) (err error) {
+func (s *Store) checkFlagConstraints(ctx context.Context, res *flagType.Flag) (err error) {
 	return nil
 }
 
-// CreateFlag creates one or more rows in flag collection
+// CreateHolidayCalendar creates one or more rows in holidayCalendar collection
 //
 // This function is auto-generated
-func (s *Store) CreateFlag(ctx context.Context, rr ...*flagType.Flag) (err error) {
+func (s *Store) CreateHolidayCalendar(ctx context.Context, rr ...*systemType.HolidayCalendar) (err error) {
 	for i := range rr {
-		if err = s.checkFlagConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkHolidayCalendarConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, flagInsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, holidayCalendarInsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -12609,16 +16425,16 @@ func (s *Store) CreateFlag(ctx context.Context, rr ...*flagType.Flag) (err error
 	return
 }
 
-// UpdateFlag updates one or more existing entries in flag collection
+// UpdateHolidayCalendar updates one or more existing entries in holidayCalendar collection
 //
 // This function is auto-generated
-func (s *Store) UpdateFlag(ctx context.Context, rr ...*flagType.Flag) (err error) {
+func (s *Store) UpdateHolidayCalendar(ctx context.Context, rr ...*systemType.HolidayCalendar) (err error) {
 	for i := range rr {
-		if err = s.checkFlagConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkHolidayCalendarConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, flagUpdateQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, holidayCalendarUpdateQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -12626,16 +16442,16 @@ func (s *Store) UpdateFlag(ctx context.Context, rr ...*flagType.Flag) (err error
 	return
 }
 
-// UpsertFlag updates one or more existing entries in flag collection
+// UpsertHolidayCalendar updates one or more existing entries in holidayCalendar collection
 //
 // This function is auto-generated
-func (s *Store) UpsertFlag(ctx context.Context, rr ...*flagType.Flag) (err error) {
+func (s *Store) UpsertHolidayCalendar(ctx context.Context, rr ...*systemType.HolidayCalendar) (err error) {
 	for i := range rr {
-		if err = s.checkFlagConstraints(ctx, rr[i]); err != nil {
+		if err = s.checkHolidayCalendarConstraints(ctx, rr[i]); err != nil {
 			return
 		}
 
-		if err = s.Exec(ctx, flagUpsertQuery(s.Dialect, rr[i])); err != nil {
+		if err = s.Exec(ctx, holidayCalendarUpsertQuery(s.Dialect, rr[i])); err != nil {
 			return
 		}
 	}
@@ -12643,12 +16459,12 @@ func (s *Store) UpsertFlag(ctx context.Context, rr ...*flagType.Flag) (err error
 	return
 }
 
-// DeleteFlag Deletes one or more entries from flag collection
+// DeleteHolidayCalendar Deletes one or more entries from holidayCalendar collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFlag(ctx context.Context, rr ...*flagType.Flag) (err error) {
+func (s *Store) DeleteHolidayCalendar(ctx context.Context, rr ...*systemType.HolidayCalendar) (err error) {
 	for i := range rr {
-		if err = s.Exec(ctx, flagDeleteQuery(s.Dialect, flagPrimaryKeys(rr[i]))); err != nil {
+		if err = s.Exec(ctx, holidayCalendarDeleteQuery(s.Dialect, holidayCalendarPrimaryKeys(rr[i]))); err != nil {
 			return
 		}
 	}
@@ -12656,29 +16472,26 @@ func (s *Store) DeleteFlag(ctx context.Context, rr ...*flagType.Flag) (err error
 	return nil
 }
 
-// DeleteFlagByID deletes single entry from flag collection
+// DeleteHolidayCalendarByID deletes single entry from holidayCalendar collection
 //
 // This function is auto-generated
-func (s *Store) DeleteFlagByKindResourceIDOwnedByName(ctx context.Context, kind string, resourceID uint64, ownedBy uint64, name string) error {
-	return s.Exec(ctx, flagDeleteQuery(s.Dialect, goqu.Ex{
-		"kind":         kind,
-		"rel_resource": resourceID,
-		"owned_by":     ownedBy,
-		"name":         name,
+func (s *Store) DeleteHolidayCalendarByID(ctx context.Context, id uint64) error {
+	return s.Exec(ctx, holidayCalendarDeleteQuery(s.Dialect, goqu.Ex{
+		"id": id,
 	}))
 }
 
-// TruncateFlags Deletes all rows from the flag collection
-func (s Store) TruncateFlags(ctx context.Context) error {
-	return s.Exec(ctx, flagTruncateQuery(s.Dialect))
+// TruncateHolidayCalendars Deletes all rows from the holidayCalendar collection
+func (s Store) TruncateHolidayCalendars(ctx context.Context) error {
+	return s.Exec(ctx, holidayCalendarTruncateQuery(s.Dialect))
 }
 
-// SearchFlags returns (filtered) set of Flags
+// SearchHolidayCalendars returns (filtered) set of HolidayCalendars
 //
 // This function is auto-generated
-func (s *Store) SearchFlags(ctx context.Context, f flagType.FlagFilter) (set flagType.FlagSet, _ flagType.FlagFilter, err error) {
+func (s *Store) SearchHolidayCalendars(ctx context.Context, f systemType.HolidayCalendarFilter) (set systemType.HolidayCalendarSet, _ systemType.HolidayCalendarFilter, err error) {
 
-	set, _, err = s.QueryFlags(ctx, f)
+	set, _, err = s.QueryHolidayCalendars(ctx, f)
 	if err != nil {
 		return nil, f, err
 	}
@@ -12686,41 +16499,43 @@ func (s *Store) SearchFlags(ctx context.Context, f flagType.FlagFilter) (set fla
 	return set, f, nil
 }
 
-// QueryFlags queries the database, converts and checks each row and returns collected set
+// QueryHolidayCalendars queries the database, converts and checks each row and returns collected set
 //
 // With generics, we can remove this per-resource-generated function
 // and replace it with a single utility fetcher
 //
 // This function is auto-generated
-func (s *Store) QueryFlags(
+func (s *Store) QueryHolidayCalendars(
 	ctx context.Context,
-	f flagType.FlagFilter,
-) (_ []*flagType.Flag, more bool, err error) {
+	f systemType.HolidayCalendarFilter,
+) (_ []*systemType.HolidayCalendar, more bool, err error) {
 	var (
-		set         = make([]*flagType.Flag, 0, DefaultSliceCapacity)
-		res         *flagType.Flag
-		aux         *auxFlag
+		ok bool
+
+		set         = make([]*systemType.HolidayCalendar, 0, DefaultSliceCapacity)
+		res         *systemType.HolidayCalendar
+		aux         *auxHolidayCalendar
 		rows        *sql.Rows
 		count       uint
 		expr, tExpr []goqu.Expression
 	)
 
-	if s.Filters.Flag != nil {
+	if s.Filters.HolidayCalendar != nil {
 		// extended filter set
-		tExpr, f, err = s.Filters.Flag(s, f)
+		tExpr, f, err = s.Filters.HolidayCalendar(s, f)
 	} else {
 		// using generated filter
-		tExpr, f, err = FlagFilter(f)
+		tExpr, f, err = HolidayCalendarFilter(f)
 	}
 
 	if err != nil {
-		err = fmt.Errorf("could generate filter expression for Flag: %w", err)
+		err = fmt.Errorf("could generate filter expression for HolidayCalendar: %w", err)
 		return
 	}
 
 	expr = append(expr, tExpr...)
 
-	query := flagSelectQuery(s.Dialect).Where(expr...)
+	query := holidayCalendarSelectQuery(s.Dialect).Where(expr...)
 
 	if f.Limit > 0 {
 		query = query.Limit(f.Limit)
@@ -12728,12 +16543,12 @@ func (s *Store) QueryFlags(
 
 	rows, err = s.Query(ctx, query)
 	if err != nil {
-		err = fmt.Errorf("could not query Flag: %w", err)
+		err = fmt.Errorf("could not query HolidayCalendar: %w", err)
 		return
 	}
 
 	if err = rows.Err(); err != nil {
-		err = fmt.Errorf("could not query Flag: %w", err)
+		err = fmt.Errorf("could not query HolidayCalendar: %w", err)
 		return
 	}
 
@@ -12747,22 +16562,32 @@ func (s *Store) QueryFlags(
 
 	for rows.Next() {
 		if err = rows.Err(); err != nil {
-			err = fmt.Errorf("could not query Flag: %w", err)
+			err = fmt.Errorf("could not query HolidayCalendar: %w", err)
 			return
 		}
 
-		aux = new(auxFlag)
+		aux = new(auxHolidayCalendar)
 		if err = aux.scan(rows); err != nil {
-			err = fmt.Errorf("could not scan rows for Flag: %w", err)
+			err = fmt.Errorf("could not scan rows for HolidayCalendar: %w", err)
 			return
 		}
 
 		count++
 		if res, err = aux.decode(); err != nil {
-			err = fmt.Errorf("could not decode Flag: %w", err)
+			err = fmt.Errorf("could not decode HolidayCalendar: %w", err)
 			return
 		}
 
+		// check fn set, call it and see if it passed the test
+		// if not, skip the item
+		if f.Check != nil {
+			if ok, err = f.Check(res); err != nil {
+				return
+			} else if !ok {
+				continue
+			}
+		}
+
 		set = append(set, res)
 	}
 
@@ -12770,18 +16595,17 @@ func (s *Store) QueryFlags(
 
 }
 
-// LookupFlagByKindResourceIDOwnedByName searches for flag by kind, resource ID, owner and name
+// LookupHolidayCalendarByID searches for holiday calendar by ID
+//
+// It returns holiday calendar even if deleted
 //
 // This function is auto-generated
-func (s *Store) LookupFlagByKindResourceIDOwnedByName(ctx context.Context, kind string, resourceID uint64, ownedBy uint64, name string) (_ *flagType.Flag, err error) {
+func (s *Store) LookupHolidayCalendarByID(ctx context.Context, id uint64) (_ *systemType.HolidayCalendar, err error) {
 	var (
 		rows   *sql.Rows
-		aux    = new(auxFlag)
-		lookup = flagSelectQuery(s.Dialect).Where(
-			goqu.I("kind").Eq(kind),
-			goqu.I("rel_resource").Eq(resourceID),
-			goqu.I("owned_by").Eq(ownedBy),
-			s.Functions.LOWER(goqu.I("name")).Eq(strings.ToLower(name)),
+		aux    = new(auxHolidayCalendar)
+		lookup = holidayCalendarSelectQuery(s.Dialect).Where(
+			goqu.I("id").Eq(id),
 		).Limit(1)
 	)
 
@@ -12813,23 +16637,68 @@ func (s *Store) LookupFlagByKindResourceIDOwnedByName(ctx context.Context, kind
 	return aux.decode()
 }
 
-// sortableFlagFields returns all <no value> columns flagged as sortable
+// LookupHolidayCalendarByHandle searches for holiday calendar by handle
+//
+// It returns only valid holiday calendars (not deleted)
+//
+// This function is auto-generated
+func (s *Store) LookupHolidayCalendarByHandle(ctx context.Context, handle string) (_ *systemType.HolidayCalendar, err error) {
+	var (
+		rows   *sql.Rows
+		aux    = new(auxHolidayCalendar)
+		lookup = holidayCalendarSelectQuery(s.Dialect).Where(
+			s.Functions.LOWER(goqu.I("handle")).Eq(strings.ToLower(handle)),
+			goqu.I("deleted_at").IsNull(),
+		).Limit(1)
+	)
+
+	rows, err = s.Query(ctx, lookup)
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		closeError := rows.Close()
+		if err == nil {
+			// return error from close
+			err = closeError
+		}
+	}()
+
+	if err = rows.Err(); err != nil {
+		return
+	}
+
+	if !rows.Next() {
+		return nil, store.ErrNotFound.Stack(1)
+	}
+
+	if err = aux.scan(rows); err != nil {
+		return
+	}
+
+	return aux.decode()
+}
+
+// sortableHolidayCalendarFields returns all <no value> columns flagged as sortable
 //
 // With optional string arg, all columns are returned aliased
 //
 // This function is auto-generated
-func (Store) sortableFlagFields() map[string]string {
+func (Store) sortableHolidayCalendarFields() map[string]string {
 	return map[string]string{
-		"kind":        "kind",
-		"name":        "name",
-		"owned_by":    "owned_by",
-		"ownedby":     "owned_by",
-		"resource_id": "resource_id",
-		"resourceid":  "resource_id",
+		"created_at": "created_at",
+		"createdat":  "created_at",
+		"deleted_at": "deleted_at",
+		"deletedat":  "deleted_at",
+		"handle":     "handle",
+		"id":         "id",
+		"updated_at": "updated_at",
+		"updatedat":  "updated_at",
 	}
 }
 
-// collectFlagCursorValues collects values from the given resource that and sets them to the cursor
+// collectHolidayCalendarCursorValues collects values from the given resource that and sets them to the cursor
 // to be used for pagination
 //
 // Values that are collected must come from sortable, unique or primary columns/fields
@@ -12840,63 +16709,51 @@ func (Store) sortableFlagFields() map[string]string {
 //   undeleted items)
 //
 // This function is auto-generated
-func (s *Store) collectFlagCursorValues(res *flagType.Flag, cc ...*filter.SortExpr) *filter.PagingCursor {
+func (s *Store) collectHolidayCalendarCursorValues(res *systemType.HolidayCalendar, cc ...*filter.SortExpr) *filter.PagingCursor {
 	var (
 		cur = &filter.PagingCursor{LThen: filter.SortExprSet(cc).Reversed()}
 
 		hasUnique bool
 
-		pkKind       bool
-		pkResourceID bool
-		pkOwnedBy    bool
-		pkName       bool
+		pkID bool
 
 		collect = func(cc ...*filter.SortExpr) {
 			for _, c := range cc {
 				switch c.Column {
-				case "kind":
-					cur.Set(c.Column, res.Kind, c.Descending)
-					pkKind = true
-				case "resourceID":
-					cur.Set(c.Column, res.ResourceID, c.Descending)
-					pkResourceID = true
-				case "ownedBy":
-					cur.Set(c.Column, res.OwnedBy, c.Descending)
-					pkOwnedBy = true
-				case "name":
-					cur.Set(c.Column, res.Name, c.Descending)
-					pkName = true
+				case "id":
+					cur.Set(c.Column, res.ID, c.Descending)
+					pkID = true
+				case "handle":
+					cur.Set(c.Column, res.Handle, c.Descending)
+					hasUnique = true
+				case "createdAt":
+					cur.Set(c.Column, res.CreatedAt, c.Descending)
+				case "updatedAt":
+					cur.Set(c.Column, res.UpdatedAt, c.Descending)
+				case "deletedAt":
+					cur.Set(c.Column, res.DeletedAt, c.Descending)
 				}
 			}
 		}
 	)
 
 	collect(cc...)
-	if !hasUnique || !pkKind {
-		collect(&filter.SortExpr{Column: "kind", Descending: false})
-	}
-	if !hasUnique || !pkResourceID {
-		collect(&filter.SortExpr{Column: "resourceID", Descending: false})
-	}
-	if !hasUnique || !pkOwnedBy {
-		collect(&filter.SortExpr{Column: "ownedBy", Descending: false})
-	}
-	if !hasUnique || !pkName {
-		collect(&filter.SortExpr{Column: "name", Descending: false})
+	if !hasUnique || !pkID {
+		collect(&filter.SortExpr{Column: "id", Descending: false})
 	}
 
 	return cur
 
 }
 
-// checkFlagConstraints performs lookups (on valid) resource to check if any of the values on unique fields
+// checkHolidayCalendarConstraints performs lookups (on valid) resource to check if any of the values on unique fields
 // already exists in the store
 //
 // Using built-in constraint checking would be more performant, but unfortunately we cannot rely
 // on the full support (MySQL does not support conditional indexes)
 //
 // This function is auto-generated
-func (s *Store) checkFlagConstraints(ctx context.Context, res *flagType.Flag) (err error) {
+func (s *Store) checkHolidayCalendarConstraints(ctx context.Context, res *systemType.HolidayCalendar) (err error) {
 	return nil
 }
 
@@ -3,17 +3,32 @@ package rdbms
 import (
 	"context"
 	"fmt"
+
+	"github.com/cortezaproject/corteza-server/store/adapters/rdbms/ddl"
+	"github.com/doug-martin/goqu/v9"
 )
 
 func (s *Store) Upgrade(ctx context.Context) (err error) {
+	var legacyWorkflows bool
+
 	if err = UpgradeBeforeTableCreation(ctx, s); err != nil {
 		return
 	}
 
+	if legacyWorkflows, err = hasLegacyWorkflows(ctx, s); err != nil {
+		return
+	}
+
 	if err = UpgradeCreateTables(ctx, s); err != nil {
 		return
 	}
 
+	if legacyWorkflows {
+		if err = UpgradeLegacyWorkflows(ctx, s); err != nil {
+			return
+		}
+	}
+
 	if err = UpgradeAfterTableCreation(ctx, s); err != nil {
 		return
 	}
@@ -44,3 +59,69 @@ func UpgradeCreateTables(ctx context.Context, s *Store) (err error) {
 func UpgradeAfterTableCreation(ctx context.Context, s *Store) (err error) {
 	return
 }
+
+// hasLegacyWorkflows checks if workflows were stored before revisions were introduced
+func hasLegacyWorkflows(ctx context.Context, s *Store) (bool, error) {
+	if exists, err := s.SchemaAPI.TableExists(ctx, s.DB, tableAutomationWorkflows().Name); err != nil || !exists {
+		return false, err
+	}
+
+	exists, err := s.SchemaAPI.TableExists(ctx, s.DB, tableAutomationWorkflowRevisions().Name)
+	return !exists, err
+}
+
+// UpgradeLegacyWorkflows adds revision columns to workflow and session tables
+// that were created before revisions were introduced and publishes
+// definitions of the existing workflows as their first revision
+//
+// Runs once, when the workflow revisions table is created.
+func UpgradeLegacyWorkflows(ctx context.Context, s *Store) (err error) {
+	var (
+		addColumn = func(t *ddl.Table, name string) string {
+			for _, col := range t.Columns {
+				if col.Name == name {
+					return fmt.Sprintf(`ALTER TABLE "%s" ADD COLUMN %s`, t.Name, ddl.GenTableColumn(col, ddl.ColumnTypeTranslator))
+				}
+			}
+
+			panic(fmt.Sprintf("unknown column %s on table %s", name, t.Name))
+		}
+
+		// revision shares the ID with the workflow;
+		// only one revision per workflow is created here
+		revisions = s.Dialect.
+			Insert(tableAutomationWorkflowRevisions().Name).
+			Cols("id", "rel_workflow", "revision", "meta", "scope", "steps", "paths", "created_at", "created_by").
+			FromQuery(s.Dialect.
+				From(tableAutomationWorkflows().Name).
+				Select(
+					goqu.C("id"),
+					goqu.C("id"),
+					goqu.V(1),
+					goqu.C("meta"),
+					goqu.C("scope"),
+					goqu.C("steps"),
+					goqu.C("paths"),
+					goqu.COALESCE(goqu.C("updated_at"), goqu.C("created_at")),
+					goqu.C("created_by"),
+				),
+			)
+
+		published = s.Dialect.
+			Update(tableAutomationWorkflows().Name).
+			Set(goqu.Record{"published_revision": 1})
+	)
+
+	err = ddl.Exec(ctx, s.DB,
+		addColumn(tableAutomationWorkflows(), "published_revision"),
+		addColumn(tableAutomationSessions(), "workflow_revision"),
+		revisions,
+		published,
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not upgrade legacy workflows: %w", err)
+	}
+
+	return
+}
@@ -34,6 +34,7 @@ func Tables() []*Table {
 		tableUsers(),
 		tableDalConnections(),
 		tableDalSensitivityLevels(),
+		tableHolidayCalendars(),
 		tableCredentials(),
 		tableAuthClients(),
 		tableAuthConfirmedClients(),
@@ -60,19 +61,29 @@ func Tables() []*Table {
 		tableComposePage(),
 		tableComposeRecord(),
 		tableComposeRecordValue(),
+		tableComposeRecordImportSession(),
+		tableComposeRecordImportError(),
+		tableComposeRecordRevision(),
 		tableFederationModuleShared(),
 		tableFederationModuleExposed(),
 		tableFederationModuleMapping(),
 		tableFederationNodes(),
 		tableFederationNodesSync(),
 		tableAutomationWorkflows(),
+		tableAutomationWorkflowRevisions(),
+		tableAutomationWorkflowTestCases(),
 		tableAutomationTriggers(),
 		tableAutomationSessions(),
+		tableAutomationSessionResumes(),
+		tableAutomationLeases(),
+		tableAutomationTasks(),
 		//tableAutomationState(),
 		tableMessagebusQueue(),
 		tableMessagebusQueuemessage(),
 		tableApigwRoute(),
 		tableApigwFilter(),
+		tableApigwRateLimits(),
+		tableApigwProfilerRecords(),
 		tableResourceActivityLog(),
 	}
 }
@@ -133,6 +144,21 @@ func tableDalSensitivityLevels() *Table {
 	)
 }
 
+func tableHolidayCalendars() *Table {
+	return TableDef("holiday_calendars",
+		ID,
+
+		ColumnDef("handle", ColumnTypeVarchar, ColumnTypeLength(handleLength)),
+		ColumnDef("meta", ColumnTypeJson),
+		ColumnDef("holidays", ColumnTypeJson),
+
+		CUDTimestamps,
+		CUDUsers,
+
+		AddIndex("unique_handle", IExpr("LOWER(handle)"), IWhere("LENGTH(handle) > 0 AND deleted_at IS NULL")),
+	)
+}
+
 func tableCredentials() *Table {
 	return TableDef(`credentials`,
 		ID,
@@ -472,6 +498,7 @@ func tableComposeModuleField() *Table {
 		ColumnDef("is_required", ColumnTypeBoolean),
 		ColumnDef("is_visible", ColumnTypeBoolean),
 		ColumnDef("is_multi", ColumnTypeBoolean),
+		ColumnDef("sensitivity_level", ColumnTypeIdentifier, DefaultValue("0")),
 
 		CUDTimestamps,
 
@@ -545,6 +572,57 @@ func tableComposeRecordValue() *Table {
 	)
 }
 
+func tableComposeRecordImportSession() *Table {
+	return TableDef("compose_record_import_session",
+		ID,
+		ColumnDef("name", ColumnTypeText),
+		ColumnDef("content_type", ColumnTypeText),
+		ColumnDef("source", ColumnTypeText),
+		ColumnDef("sheet", ColumnTypeText),
+		ColumnDef("sheets", ColumnTypeJson),
+		ColumnDef("rel_user", ColumnTypeIdentifier),
+		ColumnDef("rel_namespace", ColumnTypeIdentifier),
+		ColumnDef("rel_module", ColumnTypeIdentifier),
+		ColumnDef("on_error", ColumnTypeText),
+		ColumnDef("fields", ColumnTypeJson),
+		ColumnDef("key_field", ColumnTypeText),
+		ColumnDef("progress", ColumnTypeJson),
+		ColumnDef("created_at", ColumnTypeTimestamp),
+		ColumnDef("updated_at", ColumnTypeTimestamp),
+
+		AddIndex("user", IColumn("rel_user")),
+	)
+}
+
+func tableComposeRecordImportError() *Table {
+	return TableDef("compose_record_import_error",
+		ColumnDef("rel_session", ColumnTypeIdentifier),
+		ColumnDef("row_index", ColumnTypeInteger),
+		ColumnDef("message", ColumnTypeText),
+		ColumnDef("created_at", ColumnTypeTimestamp),
+
+		PrimaryKey(IColumn("rel_session", "row_index")),
+	)
+}
+
+func tableComposeRecordRevision() *Table {
+	return TableDef("compose_record_revision",
+		ID,
+		ColumnDef("rel_record", ColumnTypeIdentifier),
+		ColumnDef("rel_module", ColumnTypeIdentifier),
+		ColumnDef("revision", ColumnTypeInteger),
+		ColumnDef("operation", ColumnTypeVarchar, ColumnTypeLength(16)),
+		ColumnDef("rel_user", ColumnTypeIdentifier),
+		ColumnDef("rel_session", ColumnTypeIdentifier),
+		ColumnDef("values", ColumnTypeJson),
+		ColumnDef("changes", ColumnTypeJson),
+		ColumnDef("created_at", ColumnTypeTimestamp),
+
+		AddIndex("unique_record_revision", IColumn("rel_record", "revision")),
+		AddIndex("module", IColumn("rel_module")),
+	)
+}
+
 func tableFederationModuleShared() *Table {
 	return TableDef("federation_module_shared",
 		ID,
@@ -624,6 +702,7 @@ func tableAutomationWorkflows() *Table {
 		ColumnDef("paths", ColumnTypeJson),
 		ColumnDef("issues", ColumnTypeJson),
 		ColumnDef("run_as", ColumnTypeIdentifier),
+		ColumnDef("published_revision", ColumnTypeInteger, DefaultValue("0")),
 		ColumnDef("owned_by", ColumnTypeIdentifier),
 		CUDTimestamps,
 		CUDUsers,
@@ -632,16 +711,55 @@ func tableAutomationWorkflows() *Table {
 	)
 }
 
+func tableAutomationWorkflowRevisions() *Table {
+	return TableDef("automation_workflow_revisions",
+		ID,
+		ColumnDef("rel_workflow", ColumnTypeIdentifier),
+		ColumnDef("revision", ColumnTypeInteger),
+		ColumnDef("meta", ColumnTypeJson),
+		ColumnDef("scope", ColumnTypeJson),
+		ColumnDef("steps", ColumnTypeJson),
+		ColumnDef("paths", ColumnTypeJson),
+		ColumnDef("created_at", ColumnTypeTimestamp),
+		ColumnDef("created_by", ColumnTypeIdentifier),
+
+		AddIndex("unique_workflow_revision", IColumn("rel_workflow", "revision")),
+	)
+}
+
+func tableAutomationWorkflowTestCases() *Table {
+	return TableDef("automation_workflow_test_cases",
+		ID,
+		ColumnDef("rel_workflow", ColumnTypeIdentifier),
+		ColumnDef("meta", ColumnTypeJson),
+		ColumnDef("rel_step", ColumnTypeIdentifier),
+		ColumnDef("input", ColumnTypeJson),
+		ColumnDef("mocks", ColumnTypeJson),
+		ColumnDef("passthrough", ColumnTypeJson),
+		ColumnDef("assertions", ColumnTypeJson),
+		ColumnDef("expect_error", ColumnTypeText),
+		ColumnDef("owned_by", ColumnTypeIdentifier),
+		CUDTimestamps,
+		CUDUsers,
+
+		AddIndex("workflow", IColumn("rel_workflow")),
+	)
+}
+
 func tableAutomationSessions() *Table {
 	return TableDef("automation_sessions",
 		ID,
 		ColumnDef("rel_workflow", ColumnTypeIdentifier),
+		ColumnDef("workflow_revision", ColumnTypeInteger, DefaultValue("0")),
+		ColumnDef("rel_caller_session", ColumnTypeIdentifier, DefaultValue("0")),
+		ColumnDef("rel_caller_step", ColumnTypeIdentifier, DefaultValue("0")),
 		ColumnDef("status", ColumnTypeInteger),
 		ColumnDef("event_type", ColumnTypeText, ColumnTypeLength(handleLength)),
 		ColumnDef("resource_type", ColumnTypeText, ColumnTypeLength(handleLength)),
 		ColumnDef("input", ColumnTypeJson),
 		ColumnDef("output", ColumnTypeJson),
 		ColumnDef("stacktrace", ColumnTypeJson),
+		ColumnDef("snapshot", ColumnTypeJson, Null),
 		ColumnDef("created_by", ColumnTypeIdentifier),
 		ColumnDef("created_at", ColumnTypeTimestamp),
 		ColumnDef("purge_at", ColumnTypeTimestamp, Null),
@@ -650,6 +768,7 @@ func tableAutomationSessions() *Table {
 		ColumnDef("error", ColumnTypeText),
 
 		AddIndex("workflow", IColumn("rel_workflow")),
+		AddIndex("caller_session", IColumn("rel_caller_session")),
 		AddIndex("event_type", IFieldFull(&IField{Field: "event_type", Length: handleLength})),
 		AddIndex("resource_type", IFieldFull(&IField{Field: "resource_type", Length: handleLength})),
 		AddIndex("status", IColumn("status")),
@@ -659,6 +778,61 @@ func tableAutomationSessions() *Table {
 	)
 }
 
+func tableAutomationSessionResumes() *Table {
+	return TableDef("automation_session_resumes",
+		ID,
+		ColumnDef("rel_session", ColumnTypeIdentifier),
+		ColumnDef("rel_state", ColumnTypeIdentifier),
+		ColumnDef("input", ColumnTypeJson),
+		ColumnDef("created_by", ColumnTypeIdentifier),
+		ColumnDef("created_at", ColumnTypeTimestamp),
+
+		AddIndex("session", IColumn("rel_session")),
+	)
+}
+
+func tableAutomationTasks() *Table {
+	return TableDef("automation_tasks",
+		ID,
+		ColumnDef("rel_session", ColumnTypeIdentifier),
+		ColumnDef("rel_state", ColumnTypeIdentifier),
+		ColumnDef("rel_workflow", ColumnTypeIdentifier),
+		ColumnDef("rel_step", ColumnTypeIdentifier),
+		ColumnDef("ref", ColumnTypeVarchar, ColumnTypeLength(handleLength)),
+		ColumnDef("payload", ColumnTypeJson),
+		ColumnDef("rel_owner", ColumnTypeIdentifier),
+		ColumnDef("rel_assigned_user", ColumnTypeIdentifier, DefaultValue("0")),
+		ColumnDef("rel_assigned_role", ColumnTypeIdentifier, DefaultValue("0")),
+		ColumnDef("claimed_by", ColumnTypeIdentifier, DefaultValue("0")),
+		ColumnDef("delegated_by", ColumnTypeIdentifier, DefaultValue("0")),
+		ColumnDef("status", ColumnTypeVarchar, ColumnTypeLength(16)),
+		ColumnDef("due_at", ColumnTypeTimestamp, Null),
+		ColumnDef("created_at", ColumnTypeTimestamp),
+		ColumnDef("updated_at", ColumnTypeTimestamp, Null),
+		ColumnDef("completed_at", ColumnTypeTimestamp, Null),
+		ColumnDef("completed_by", ColumnTypeIdentifier, DefaultValue("0")),
+
+		AddIndex("unique_state", IColumn("rel_session", "rel_state")),
+		AddIndex("assigned_user", IColumn("rel_assigned_user")),
+		AddIndex("assigned_role", IColumn("rel_assigned_role")),
+		AddIndex("status", IColumn("status")),
+	)
+}
+
+func tableAutomationLeases() *Table {
+	return TableDef("automation_leases",
+		ColumnDef("name", ColumnTypeVarchar, ColumnTypeLength(resourceLength)),
+		ColumnDef("owner", ColumnTypeVarchar, ColumnTypeLength(resourceLength)),
+		ColumnDef("expires_at", ColumnTypeTimestamp),
+		ColumnDef("created_at", ColumnTypeTimestamp),
+		ColumnDef("updated_at", ColumnTypeTimestamp, Null),
+
+		PrimaryKey(IColumn("name")),
+		AddIndex("owner", IColumn("owner")),
+		AddIndex("expires_at", IColumn("expires_at")),
+	)
+}
+
 func tableAutomationTriggers() *Table {
 	return TableDef("automation_triggers",
 		ID,
@@ -728,6 +902,41 @@ func tableApigwFilter() *Table {
 	)
 }
 
+func tableApigwRateLimits() *Table {
+	return TableDef("apigw_rate_limits",
+		ColumnDef("name", ColumnTypeVarchar, ColumnTypeLength(resourceLength)),
+		ColumnDef("count", ColumnTypeInteger),
+		// bigint; holds Unix time in nanoseconds
+		ColumnDef("stamp", ColumnTypeIdentifier),
+		ColumnDef("version", ColumnTypeIdentifier),
+		ColumnDef("expires_at", ColumnTypeTimestamp),
+
+		PrimaryKey(IColumn("name")),
+		AddIndex("expires_at", IColumn("expires_at")),
+	)
+}
+
+func tableApigwProfilerRecords() *Table {
+	return TableDef("apigw_profiler_records",
+		ID,
+		ColumnDef("hit", ColumnTypeText),
+		ColumnDef("rel_route", ColumnTypeIdentifier),
+		ColumnDef("method", ColumnTypeVarchar, ColumnTypeLength(16)),
+		ColumnDef("path", ColumnTypeText),
+		ColumnDef("query", ColumnTypeText),
+		ColumnDef("status", ColumnTypeInteger),
+		ColumnDef("cache", ColumnTypeVarchar, ColumnTypeLength(8)),
+		ColumnDef("content_length", ColumnTypeIdentifier),
+		// bigint; holds duration in nanoseconds
+		ColumnDef("duration", ColumnTypeIdentifier),
+		ColumnDef("meta", ColumnTypeJson),
+		ColumnDef("started_at", ColumnTypeTimestamp),
+
+		AddIndex("started_at", IColumn("started_at")),
+		AddIndex("route", IColumn("rel_route")),
+	)
+}
+
 func tableResourceActivityLog() *Table {
 	return TableDef("resource_activity_log",
 		ID,
@@ -0,0 +1,63 @@
+package rdbms_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/store"
+	"github.com/cortezaproject/corteza-server/store/adapters/rdbms"
+	"github.com/cortezaproject/corteza-server/store/adapters/rdbms/drivers/sqlite"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestUpgradeLegacyWorkflows(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+
+		s, err = sqlite.ConnectInMemory(ctx)
+
+		wf = &types.Workflow{
+			ID:        42,
+			Handle:    "legacy",
+			Steps:     types.WorkflowStepSet{{ID: 1, Kind: "expressions"}},
+			CreatedAt: time.Now(),
+		}
+	)
+
+	req.NoError(err)
+	req.NoError(store.Upgrade(ctx, zap.NewNop(), s))
+	req.NoError(store.CreateAutomationWorkflow(ctx, s, wf))
+
+	// schema from before revisions were introduced
+	db := s.(*rdbms.Store).DB
+	for _, sql := range []string{
+		`DROP TABLE automation_workflow_revisions`,
+		`ALTER TABLE automation_workflows DROP COLUMN published_revision`,
+		`ALTER TABLE automation_sessions DROP COLUMN workflow_revision`,
+	} {
+		_, err = db.ExecContext(ctx, sql)
+		req.NoError(err)
+	}
+
+	req.NoError(store.Upgrade(ctx, zap.NewNop(), s))
+
+	wf, err = store.LookupAutomationWorkflowByID(ctx, s, wf.ID)
+	req.NoError(err)
+	req.Equal(uint(1), wf.PublishedRevision)
+
+	rev, err := store.LookupAutomationWorkflowRevisionByWorkflowIDRevision(ctx, s, wf.ID, 1)
+	req.NoError(err)
+	req.Len(rev.Steps, 1)
+
+	// workflows stored afterwards are not touched by the next upgrade
+	req.NoError(store.CreateAutomationWorkflow(ctx, s, &types.Workflow{ID: 43, Handle: "draft", CreatedAt: time.Now()}))
+	req.NoError(store.Upgrade(ctx, zap.NewNop(), s))
+
+	wf, err = store.LookupAutomationWorkflowByID(ctx, s, 43)
+	req.NoError(err)
+	req.Zero(wf.PublishedRevision)
+}
@@ -21,6 +21,7 @@ import (
 	systemType "github.com/cortezaproject/corteza-server/system/types"
 	"go.uber.org/zap"
 	"golang.org/x/text/language"
+	"time"
 )
 
 type (
@@ -41,6 +42,8 @@ type (
 		Upgrade(context.Context) error
 		Actionlogs
 		ApigwFilters
+		ApigwProfilerRecords
+		ApigwRateLimits
 		ApigwRoutes
 		Applications
 		Attachments
@@ -48,9 +51,14 @@ type (
 		AuthConfirmedClients
 		AuthOa2tokens
 		AuthSessions
+		AutomationLeases
 		AutomationSessions
+		AutomationSessionResumes
+		AutomationTasks
 		AutomationTriggers
 		AutomationWorkflows
+		AutomationWorkflowRevisions
+		AutomationWorkflowTestCases
 		ComposeAttachments
 		ComposeCharts
 		ComposeModules
@@ -58,6 +66,9 @@ type (
 		ComposeNamespaces
 		ComposePages
 		ComposeRecords
+		ComposeRecordImportErrors
+		ComposeRecordImportSessions
+		ComposeRecordRevisions
 		ComposeRecordValues
 		Credentials
 		DalConnections
@@ -68,6 +79,7 @@ type (
 		FederationNodeSyncs
 		FederationSharedModules
 		Flags
+		HolidayCalendars
 		Labels
 		Queues
 		QueueMessages
@@ -106,6 +118,32 @@ type (
 		LookupApigwFilterByRoute(ctx context.Context, route uint64) (*systemType.ApigwFilter, error)
 	}
 
+	ApigwProfilerRecords interface {
+		SearchApigwProfilerRecords(ctx context.Context, f systemType.ApigwProfilerRecordFilter) (systemType.ApigwProfilerRecordSet, systemType.ApigwProfilerRecordFilter, error)
+		CreateApigwProfilerRecord(ctx context.Context, rr ...*systemType.ApigwProfilerRecord) error
+		UpdateApigwProfilerRecord(ctx context.Context, rr ...*systemType.ApigwProfilerRecord) error
+		UpsertApigwProfilerRecord(ctx context.Context, rr ...*systemType.ApigwProfilerRecord) error
+		DeleteApigwProfilerRecord(ctx context.Context, rr ...*systemType.ApigwProfilerRecord) error
+		DeleteApigwProfilerRecordByID(ctx context.Context, id uint64) error
+		TruncateApigwProfilerRecords(ctx context.Context) error
+		LookupApigwProfilerRecordByID(ctx context.Context, id uint64) (*systemType.ApigwProfilerRecord, error)
+		DeleteExpiredApigwProfilerRecords(ctx context.Context, before time.Time) error
+		AggregateApigwProfilerRecords(ctx context.Context, f systemType.ApigwProfilerRecordFilter) (systemType.ApigwProfilerRecordAggregateSet, error)
+	}
+
+	ApigwRateLimits interface {
+		SearchApigwRateLimits(ctx context.Context, f systemType.ApigwRateLimitFilter) (systemType.ApigwRateLimitSet, systemType.ApigwRateLimitFilter, error)
+		CreateApigwRateLimit(ctx context.Context, rr ...*systemType.ApigwRateLimit) error
+		UpdateApigwRateLimit(ctx context.Context, rr ...*systemType.ApigwRateLimit) error
+		UpsertApigwRateLimit(ctx context.Context, rr ...*systemType.ApigwRateLimit) error
+		DeleteApigwRateLimit(ctx context.Context, rr ...*systemType.ApigwRateLimit) error
+		DeleteApigwRateLimitByName(ctx context.Context, name string) error
+		TruncateApigwRateLimits(ctx context.Context) error
+		LookupApigwRateLimitByName(ctx context.Context, name string) (*systemType.ApigwRateLimit, error)
+		SaveApigwRateLimit(ctx context.Context, counter *systemType.ApigwRateLimit) (bool, error)
+		DeleteExpiredApigwRateLimits(ctx context.Context) error
+	}
+
 	ApigwRoutes interface {
 		SearchApigwRoutes(ctx context.Context, f systemType.ApigwRouteFilter) (systemType.ApigwRouteSet, systemType.ApigwRouteFilter, error)
 		CreateApigwRoute(ctx context.Context, rr ...*systemType.ApigwRoute) error
@@ -197,6 +235,20 @@ type (
 		DeleteAuthSessionsByUserID(ctx context.Context, userID uint64) error
 	}
 
+	AutomationLeases interface {
+		SearchAutomationLeases(ctx context.Context, f automationType.LeaseFilter) (automationType.LeaseSet, automationType.LeaseFilter, error)
+		CreateAutomationLease(ctx context.Context, rr ...*automationType.Lease) error
+		UpdateAutomationLease(ctx context.Context, rr ...*automationType.Lease) error
+		UpsertAutomationLease(ctx context.Context, rr ...*automationType.Lease) error
+		DeleteAutomationLease(ctx context.Context, rr ...*automationType.Lease) error
+		DeleteAutomationLeaseByName(ctx context.Context, name string) error
+		TruncateAutomationLeases(ctx context.Context) error
+		LookupAutomationLeaseByName(ctx context.Context, name string) (*automationType.Lease, error)
+		AcquireAutomationLease(ctx context.Context, lease *automationType.Lease) (bool, error)
+		ReleaseAutomationLease(ctx context.Context, name string, owner string) error
+		DeleteExpiredAutomationLeases(ctx context.Context) error
+	}
+
 	AutomationSessions interface {
 		SearchAutomationSessions(ctx context.Context, f automationType.SessionFilter) (automationType.SessionSet, automationType.SessionFilter, error)
 		CreateAutomationSession(ctx context.Context, rr ...*automationType.Session) error
@@ -208,6 +260,30 @@ type (
 		LookupAutomationSessionByID(ctx context.Context, id uint64) (*automationType.Session, error)
 	}
 
+	AutomationSessionResumes interface {
+		SearchAutomationSessionResumes(ctx context.Context, f automationType.SessionResumeFilter) (automationType.SessionResumeSet, automationType.SessionResumeFilter, error)
+		CreateAutomationSessionResume(ctx context.Context, rr ...*automationType.SessionResume) error
+		UpdateAutomationSessionResume(ctx context.Context, rr ...*automationType.SessionResume) error
+		UpsertAutomationSessionResume(ctx context.Context, rr ...*automationType.SessionResume) error
+		DeleteAutomationSessionResume(ctx context.Context, rr ...*automationType.SessionResume) error
+		DeleteAutomationSessionResumeByID(ctx context.Context, id uint64) error
+		TruncateAutomationSessionResumes(ctx context.Context) error
+		LookupAutomationSessionResumeByID(ctx context.Context, id uint64) (*automationType.SessionResume, error)
+	}
+
+	AutomationTasks interface {
+		SearchAutomationTasks(ctx context.Context, f automationType.TaskFilter) (automationType.TaskSet, automationType.TaskFilter, error)
+		CreateAutomationTask(ctx context.Context, rr ...*automationType.Task) error
+		UpdateAutomationTask(ctx context.Context, rr ...*automationType.Task) error
+		UpsertAutomationTask(ctx context.Context, rr ...*automationType.Task) error
+		DeleteAutomationTask(ctx context.Context, rr ...*automationType.Task) error
+		DeleteAutomationTaskByID(ctx context.Context, id uint64) error
+		TruncateAutomationTasks(ctx context.Context) error
+		LookupAutomationTaskByID(ctx context.Context, id uint64) (*automationType.Task, error)
+		LookupAutomationTaskBySessionIDStateID(ctx context.Context, sessionID uint64, stateID uint64) (*automationType.Task, error)
+		TransitionAutomationTask(ctx context.Context, task *automationType.Task, from automationType.TaskStatus) (bool, error)
+	}
+
 	AutomationTriggers interface {
 		SearchAutomationTriggers(ctx context.Context, f automationType.TriggerFilter) (automationType.TriggerSet, automationType.TriggerFilter, error)
 		CreateAutomationTrigger(ctx context.Context, rr ...*automationType.Trigger) error
@@ -231,6 +307,29 @@ type (
 		LookupAutomationWorkflowByHandle(ctx context.Context, handle string) (*automationType.Workflow, error)
 	}
 
+	AutomationWorkflowRevisions interface {
+		SearchAutomationWorkflowRevisions(ctx context.Context, f automationType.WorkflowRevisionFilter) (automationType.WorkflowRevisionSet, automationType.WorkflowRevisionFilter, error)
+		CreateAutomationWorkflowRevision(ctx context.Context, rr ...*automationType.WorkflowRevision) error
+		UpdateAutomationWorkflowRevision(ctx context.Context, rr ...*automationType.WorkflowRevision) error
+		UpsertAutomationWorkflowRevision(ctx context.Context, rr ...*automationType.WorkflowRevision) error
+		DeleteAutomationWorkflowRevision(ctx context.Context, rr ...*automationType.WorkflowRevision) error
+		DeleteAutomationWorkflowRevisionByID(ctx context.Context, id uint64) error
+		TruncateAutomationWorkflowRevisions(ctx context.Context) error
+		LookupAutomationWorkflowRevisionByID(ctx context.Context, id uint64) (*automationType.WorkflowRevision, error)
+		LookupAutomationWorkflowRevisionByWorkflowIDRevision(ctx context.Context, workflowID uint64, revision uint) (*automationType.WorkflowRevision, error)
+	}
+
+	AutomationWorkflowTestCases interface {
+		SearchAutomationWorkflowTestCases(ctx context.Context, f automationType.WorkflowTestCaseFilter) (automationType.WorkflowTestCaseSet, automationType.WorkflowTestCaseFilter, error)
+		CreateAutomationWorkflowTestCase(ctx context.Context, rr ...*automationType.WorkflowTestCase) error
+		UpdateAutomationWorkflowTestCase(ctx context.Context, rr ...*automationType.WorkflowTestCase) error
+		UpsertAutomationWorkflowTestCase(ctx context.Context, rr ...*automationType.WorkflowTestCase) error
+		DeleteAutomationWorkflowTestCase(ctx context.Context, rr ...*automationType.WorkflowTestCase) error
+		DeleteAutomationWorkflowTestCaseByID(ctx context.Context, id uint64) error
+		TruncateAutomationWorkflowTestCases(ctx context.Context) error
+		LookupAutomationWorkflowTestCaseByID(ctx context.Context, id uint64) (*automationType.WorkflowTestCase, error)
+	}
+
 	ComposeAttachments interface {
 		SearchComposeAttachments(ctx context.Context, f composeType.AttachmentFilter) (composeType.AttachmentSet, composeType.AttachmentFilter, error)
 		CreateComposeAttachment(ctx context.Context, rr ...*composeType.Attachment) error
@@ -318,6 +417,43 @@ type (
 		PartialComposeRecordValueUpdate(ctx context.Context, mod *composeType.Module, values ...*composeType.RecordValue) error
 	}
 
+	ComposeRecordImportErrors interface {
+		SearchComposeRecordImportErrors(ctx context.Context, f composeType.RecordImportErrorFilter) (composeType.RecordImportErrorSet, composeType.RecordImportErrorFilter, error)
+		CreateComposeRecordImportError(ctx context.Context, rr ...*composeType.RecordImportError) error
+		UpdateComposeRecordImportError(ctx context.Context, rr ...*composeType.RecordImportError) error
+		UpsertComposeRecordImportError(ctx context.Context, rr ...*composeType.RecordImportError) error
+		DeleteComposeRecordImportError(ctx context.Context, rr ...*composeType.RecordImportError) error
+		DeleteComposeRecordImportErrorBySessionIDRow(ctx context.Context, sessionID uint64, row uint64) error
+		TruncateComposeRecordImportErrors(ctx context.Context) error
+		DeleteComposeRecordImportErrorsBySessionID(ctx context.Context, sessionID uint64) error
+	}
+
+	ComposeRecordImportSessions interface {
+		SearchComposeRecordImportSessions(ctx context.Context, f composeType.RecordImportSessionFilter) (composeType.RecordImportSessionSet, composeType.RecordImportSessionFilter, error)
+		CreateComposeRecordImportSession(ctx context.Context, rr ...*composeType.RecordImportSession) error
+		UpdateComposeRecordImportSession(ctx context.Context, rr ...*composeType.RecordImportSession) error
+		UpsertComposeRecordImportSession(ctx context.Context, rr ...*composeType.RecordImportSession) error
+		DeleteComposeRecordImportSession(ctx context.Context, rr ...*composeType.RecordImportSession) error
+		DeleteComposeRecordImportSessionByID(ctx context.Context, id uint64) error
+		TruncateComposeRecordImportSessions(ctx context.Context) error
+		LookupComposeRecordImportSessionByID(ctx context.Context, id uint64) (*composeType.RecordImportSession, error)
+		ClaimComposeRecordImportSession(ctx context.Context, session *composeType.RecordImportSession, updatedBefore time.Time) (bool, error)
+		StartComposeRecordImportSession(ctx context.Context, session *composeType.RecordImportSession, loadedAt time.Time) (bool, error)
+	}
+
+	ComposeRecordRevisions interface {
+		SearchComposeRecordRevisions(ctx context.Context, f composeType.RecordRevisionFilter) (composeType.RecordRevisionSet, composeType.RecordRevisionFilter, error)
+		CreateComposeRecordRevision(ctx context.Context, rr ...*composeType.RecordRevision) error
+		UpdateComposeRecordRevision(ctx context.Context, rr ...*composeType.RecordRevision) error
+		UpsertComposeRecordRevision(ctx context.Context, rr ...*composeType.RecordRevision) error
+		DeleteComposeRecordRevision(ctx context.Context, rr ...*composeType.RecordRevision) error
+		DeleteComposeRecordRevisionByID(ctx context.Context, id uint64) error
+		TruncateComposeRecordRevisions(ctx context.Context) error
+		LookupComposeRecordRevisionByID(ctx context.Context, id uint64) (*composeType.RecordRevision, error)
+		LookupComposeRecordRevisionByRecordIDRevision(ctx context.Context, recordID uint64, revision uint) (*composeType.RecordRevision, error)
+		CreateUniqueComposeRecordRevision(ctx context.Context, rev *composeType.RecordRevision) (bool, error)
+	}
+
 	ComposeRecordValues interface {
 		SearchComposeRecordValues(ctx context.Context, f composeType.RecordValueFilter) (composeType.RecordValueSet, composeType.RecordValueFilter, error)
 		CreateComposeRecordValue(ctx context.Context, rr ...*composeType.RecordValue) error
@@ -433,6 +569,18 @@ type (
 		LookupFlagByKindResourceIDOwnedByName(ctx context.Context, kind string, resourceID uint64, ownedBy uint64, name string) (*flagType.Flag, error)
 	}
 
+	HolidayCalendars interface {
+		SearchHolidayCalendars(ctx context.Context, f systemType.HolidayCalendarFilter) (systemType.HolidayCalendarSet, systemType.HolidayCalendarFilter, error)
+		CreateHolidayCalendar(ctx context.Context, rr ...*systemType.HolidayCalendar) error
+		UpdateHolidayCalendar(ctx context.Context, rr ...*systemType.HolidayCalendar) error
+		UpsertHolidayCalendar(ctx context.Context, rr ...*systemType.HolidayCalendar) error
+		DeleteHolidayCalendar(ctx context.Context, rr ...*systemType.HolidayCalendar) error
+		DeleteHolidayCalendarByID(ctx context.Context, id uint64) error
+		TruncateHolidayCalendars(ctx context.Context) error
+		LookupHolidayCalendarByID(ctx context.Context, id uint64) (*systemType.HolidayCalendar, error)
+		LookupHolidayCalendarByHandle(ctx context.Context, handle string) (*systemType.HolidayCalendar, error)
+	}
+
 	Labels interface {
 		SearchLabels(ctx context.Context, f labelsType.LabelFilter) (labelsType.LabelSet, labelsType.LabelFilter, error)
 		CreateLabel(ctx context.Context, rr ...*labelsType.Label) error
@@ -707,6 +855,154 @@ func LookupApigwFilterByRoute(ctx context.Context, s ApigwFilters, route uint64)
 	return s.LookupApigwFilterByRoute(ctx, route)
 }
 
+// SearchApigwProfilerRecords returns all matching ApigwProfilerRecords from store
+//
+// This function is auto-generated
+func SearchApigwProfilerRecords(ctx context.Context, s ApigwProfilerRecords, f systemType.ApigwProfilerRecordFilter) (systemType.ApigwProfilerRecordSet, systemType.ApigwProfilerRecordFilter, error) {
+	return s.SearchApigwProfilerRecords(ctx, f)
+}
+
+// CreateApigwProfilerRecord creates one or more ApigwProfilerRecords in store
+//
+// This function is auto-generated
+func CreateApigwProfilerRecord(ctx context.Context, s ApigwProfilerRecords, rr ...*systemType.ApigwProfilerRecord) error {
+	return s.CreateApigwProfilerRecord(ctx, rr...)
+}
+
+// UpdateApigwProfilerRecord updates one or more (existing) ApigwProfilerRecords in store
+//
+// This function is auto-generated
+func UpdateApigwProfilerRecord(ctx context.Context, s ApigwProfilerRecords, rr ...*systemType.ApigwProfilerRecord) error {
+	return s.UpdateApigwProfilerRecord(ctx, rr...)
+}
+
+// UpsertApigwProfilerRecord creates new or updates existing one or more ApigwProfilerRecords in store
+//
+// This function is auto-generated
+func UpsertApigwProfilerRecord(ctx context.Context, s ApigwProfilerRecords, rr ...*systemType.ApigwProfilerRecord) error {
+	return s.UpsertApigwProfilerRecord(ctx, rr...)
+}
+
+// DeleteApigwProfilerRecord deletes one or more ApigwProfilerRecords from store
+//
+// This function is auto-generated
+func DeleteApigwProfilerRecord(ctx context.Context, s ApigwProfilerRecords, rr ...*systemType.ApigwProfilerRecord) error {
+	return s.DeleteApigwProfilerRecord(ctx, rr...)
+}
+
+// DeleteApigwProfilerRecordByID deletes one or more ApigwProfilerRecords from store
+//
+// This function is auto-generated
+func DeleteApigwProfilerRecordByID(ctx context.Context, s ApigwProfilerRecords, id uint64) error {
+	return s.DeleteApigwProfilerRecordByID(ctx, id)
+}
+
+// TruncateApigwProfilerRecords Deletes all ApigwProfilerRecords from store
+//
+// This function is auto-generated
+func TruncateApigwProfilerRecords(ctx context.Context, s ApigwProfilerRecords) error {
+	return s.TruncateApigwProfilerRecords(ctx)
+}
+
+// LookupApigwProfilerRecordByID searches for profiler record by ID
+//
+// This function is auto-generated
+func LookupApigwProfilerRecordByID(ctx context.Context, s ApigwProfilerRecords, id uint64) (*systemType.ApigwProfilerRecord, error) {
+	return s.LookupApigwProfilerRecordByID(ctx, id)
+}
+
+// DeleteExpiredApigwProfilerRecords removes all profiler records of the requests started before the given time
+//
+// This function is auto-generated
+func DeleteExpiredApigwProfilerRecords(ctx context.Context, s ApigwProfilerRecords, before time.Time) error {
+	return s.DeleteExpiredApigwProfilerRecords(ctx, before)
+}
+
+// AggregateApigwProfilerRecords aggregates the profiler records matching the filter by path
+//
+// Paths are ordered by name, up to the limit of the filter
+//
+// This function is auto-generated
+func AggregateApigwProfilerRecords(ctx context.Context, s ApigwProfilerRecords, f systemType.ApigwProfilerRecordFilter) (systemType.ApigwProfilerRecordAggregateSet, error) {
+	return s.AggregateApigwProfilerRecords(ctx, f)
+}
+
+// SearchApigwRateLimits returns all matching ApigwRateLimits from store
+//
+// This function is auto-generated
+func SearchApigwRateLimits(ctx context.Context, s ApigwRateLimits, f systemType.ApigwRateLimitFilter) (systemType.ApigwRateLimitSet, systemType.ApigwRateLimitFilter, error) {
+	return s.SearchApigwRateLimits(ctx, f)
+}
+
+// CreateApigwRateLimit creates one or more ApigwRateLimits in store
+//
+// This function is auto-generated
+func CreateApigwRateLimit(ctx context.Context, s ApigwRateLimits, rr ...*systemType.ApigwRateLimit) error {
+	return s.CreateApigwRateLimit(ctx, rr...)
+}
+
+// UpdateApigwRateLimit updates one or more (existing) ApigwRateLimits in store
+//
+// This function is auto-generated
+func UpdateApigwRateLimit(ctx context.Context, s ApigwRateLimits, rr ...*systemType.ApigwRateLimit) error {
+	return s.UpdateApigwRateLimit(ctx, rr...)
+}
+
+// UpsertApigwRateLimit creates new or updates existing one or more ApigwRateLimits in store
+//
+// This function is auto-generated
+func UpsertApigwRateLimit(ctx context.Context, s ApigwRateLimits, rr ...*systemType.ApigwRateLimit) error {
+	return s.UpsertApigwRateLimit(ctx, rr...)
+}
+
+// DeleteApigwRateLimit deletes one or more ApigwRateLimits from store
+//
+// This function is auto-generated
+func DeleteApigwRateLimit(ctx context.Context, s ApigwRateLimits, rr ...*systemType.ApigwRateLimit) error {
+	return s.DeleteApigwRateLimit(ctx, rr...)
+}
+
+// DeleteApigwRateLimitByID deletes one or more ApigwRateLimits from store
+//
+// This function is auto-generated
+func DeleteApigwRateLimitByName(ctx context.Context, s ApigwRateLimits, name string) error {
+	return s.DeleteApigwRateLimitByName(ctx, name)
+}
+
+// TruncateApigwRateLimits Deletes all ApigwRateLimits from store
+//
+// This function is auto-generated
+func TruncateApigwRateLimits(ctx context.Context, s ApigwRateLimits) error {
+	return s.TruncateApigwRateLimits(ctx)
+}
+
+// LookupApigwRateLimitByName searches for rate limit counter by name
+//
+// It returns counter even if expired
+//
+// This function is auto-generated
+func LookupApigwRateLimitByName(ctx context.Context, s ApigwRateLimits, name string) (*systemType.ApigwRateLimit, error) {
+	return s.LookupApigwRateLimitByName(ctx, name)
+}
+
+// SaveApigwRateLimit creates or updates the rate limit counter
+//
+// Counter is saved only if it was not changed since it was read
+// (version of the stored counter is one less than the given one);
+// returns false if counter was changed in the meantime
+//
+// This function is auto-generated
+func SaveApigwRateLimit(ctx context.Context, s ApigwRateLimits, counter *systemType.ApigwRateLimit) (bool, error) {
+	return s.SaveApigwRateLimit(ctx, counter)
+}
+
+// DeleteExpiredApigwRateLimits removes all expired rate limit counters
+//
+// This function is auto-generated
+func DeleteExpiredApigwRateLimits(ctx context.Context, s ApigwRateLimits) error {
+	return s.DeleteExpiredApigwRateLimits(ctx)
+}
+
 // SearchApigwRoutes returns all matching ApigwRoutes from store
 //
 // This function is auto-generated
@@ -1207,6 +1503,88 @@ func DeleteAuthSessionsByUserID(ctx context.Context, s AuthSessions, userID uint
 	return s.DeleteAuthSessionsByUserID(ctx, userID)
 }
 
+// SearchAutomationLeases returns all matching AutomationLeases from store
+//
+// This function is auto-generated
+func SearchAutomationLeases(ctx context.Context, s AutomationLeases, f automationType.LeaseFilter) (automationType.LeaseSet, automationType.LeaseFilter, error) {
+	return s.SearchAutomationLeases(ctx, f)
+}
+
+// CreateAutomationLease creates one or more AutomationLeases in store
+//
+// This function is auto-generated
+func CreateAutomationLease(ctx context.Context, s AutomationLeases, rr ...*automationType.Lease) error {
+	return s.CreateAutomationLease(ctx, rr...)
+}
+
+// UpdateAutomationLease updates one or more (existing) AutomationLeases in store
+//
+// This function is auto-generated
+func UpdateAutomationLease(ctx context.Context, s AutomationLeases, rr ...*automationType.Lease) error {
+	return s.UpdateAutomationLease(ctx, rr...)
+}
+
+// UpsertAutomationLease creates new or updates existing one or more AutomationLeases in store
+//
+// This function is auto-generated
+func UpsertAutomationLease(ctx context.Context, s AutomationLeases, rr ...*automationType.Lease) error {
+	return s.UpsertAutomationLease(ctx, rr...)
+}
+
+// DeleteAutomationLease deletes one or more AutomationLeases from store
+//
+// This function is auto-generated
+func DeleteAutomationLease(ctx context.Context, s AutomationLeases, rr ...*automationType.Lease) error {
+	return s.DeleteAutomationLease(ctx, rr...)
+}
+
+// DeleteAutomationLeaseByID deletes one or more AutomationLeases from store
+//
+// This function is auto-generated
+func DeleteAutomationLeaseByName(ctx context.Context, s AutomationLeases, name string) error {
+	return s.DeleteAutomationLeaseByName(ctx, name)
+}
+
+// TruncateAutomationLeases Deletes all AutomationLeases from store
+//
+// This function is auto-generated
+func TruncateAutomationLeases(ctx context.Context, s AutomationLeases) error {
+	return s.TruncateAutomationLeases(ctx)
+}
+
+// LookupAutomationLeaseByName searches for lease by name
+//
+// It returns lease even if expired
+//
+// This function is auto-generated
+func LookupAutomationLeaseByName(ctx context.Context, s AutomationLeases, name string) (*automationType.Lease, error) {
+	return s.LookupAutomationLeaseByName(ctx, name)
+}
+
+// AcquireAutomationLease creates or renews a lease
+//
+// Returns true if lease is acquired; when lease is held by
+// another owner and did not expire yet, false is returned
+//
+// This function is auto-generated
+func AcquireAutomationLease(ctx context.Context, s AutomationLeases, lease *automationType.Lease) (bool, error) {
+	return s.AcquireAutomationLease(ctx, lease)
+}
+
+// ReleaseAutomationLease removes the lease if held by the owner
+//
+// This function is auto-generated
+func ReleaseAutomationLease(ctx context.Context, s AutomationLeases, name string, owner string) error {
+	return s.ReleaseAutomationLease(ctx, name, owner)
+}
+
+// DeleteExpiredAutomationLeases removes all expired leases
+//
+// This function is auto-generated
+func DeleteExpiredAutomationLeases(ctx context.Context, s AutomationLeases) error {
+	return s.DeleteExpiredAutomationLeases(ctx)
+}
+
 // SearchAutomationSessions returns all matching AutomationSessions from store
 //
 // This function is auto-generated
@@ -1265,6 +1643,137 @@ func LookupAutomationSessionByID(ctx context.Context, s AutomationSessions, id u
 	return s.LookupAutomationSessionByID(ctx, id)
 }
 
+// SearchAutomationSessionResumes returns all matching AutomationSessionResumes from store
+//
+// This function is auto-generated
+func SearchAutomationSessionResumes(ctx context.Context, s AutomationSessionResumes, f automationType.SessionResumeFilter) (automationType.SessionResumeSet, automationType.SessionResumeFilter, error) {
+	return s.SearchAutomationSessionResumes(ctx, f)
+}
+
+// CreateAutomationSessionResume creates one or more AutomationSessionResumes in store
+//
+// This function is auto-generated
+func CreateAutomationSessionResume(ctx context.Context, s AutomationSessionResumes, rr ...*automationType.SessionResume) error {
+	return s.CreateAutomationSessionResume(ctx, rr...)
+}
+
+// UpdateAutomationSessionResume updates one or more (existing) AutomationSessionResumes in store
+//
+// This function is auto-generated
+func UpdateAutomationSessionResume(ctx context.Context, s AutomationSessionResumes, rr ...*automationType.SessionResume) error {
+	return s.UpdateAutomationSessionResume(ctx, rr...)
+}
+
+// UpsertAutomationSessionResume creates new or updates existing one or more AutomationSessionResumes in store
+//
+// This function is auto-generated
+func UpsertAutomationSessionResume(ctx context.Context, s AutomationSessionResumes, rr ...*automationType.SessionResume) error {
+	return s.UpsertAutomationSessionResume(ctx, rr...)
+}
+
+// DeleteAutomationSessionResume deletes one or more AutomationSessionResumes from store
+//
+// This function is auto-generated
+func DeleteAutomationSessionResume(ctx context.Context, s AutomationSessionResumes, rr ...*automationType.SessionResume) error {
+	return s.DeleteAutomationSessionResume(ctx, rr...)
+}
+
+// DeleteAutomationSessionResumeByID deletes one or more AutomationSessionResumes from store
+//
+// This function is auto-generated
+func DeleteAutomationSessionResumeByID(ctx context.Context, s AutomationSessionResumes, id uint64) error {
+	return s.DeleteAutomationSessionResumeByID(ctx, id)
+}
+
+// TruncateAutomationSessionResumes Deletes all AutomationSessionResumes from store
+//
+// This function is auto-generated
+func TruncateAutomationSessionResumes(ctx context.Context, s AutomationSessionResumes) error {
+	return s.TruncateAutomationSessionResumes(ctx)
+}
+
+// LookupAutomationSessionResumeByID searches for session resume request by ID
+//
+// This function is auto-generated
+func LookupAutomationSessionResumeByID(ctx context.Context, s AutomationSessionResumes, id uint64) (*automationType.SessionResume, error) {
+	return s.LookupAutomationSessionResumeByID(ctx, id)
+}
+
+// SearchAutomationTasks returns all matching AutomationTasks from store
+//
+// This function is auto-generated
+func SearchAutomationTasks(ctx context.Context, s AutomationTasks, f automationType.TaskFilter) (automationType.TaskSet, automationType.TaskFilter, error) {
+	return s.SearchAutomationTasks(ctx, f)
+}
+
+// CreateAutomationTask creates one or more AutomationTasks in store
+//
+// This function is auto-generated
+func CreateAutomationTask(ctx context.Context, s AutomationTasks, rr ...*automationType.Task) error {
+	return s.CreateAutomationTask(ctx, rr...)
+}
+
+// UpdateAutomationTask updates one or more (existing) AutomationTasks in store
+//
+// This function is auto-generated
+func UpdateAutomationTask(ctx context.Context, s AutomationTasks, rr ...*automationType.Task) error {
+	return s.UpdateAutomationTask(ctx, rr...)
+}
+
+// UpsertAutomationTask creates new or updates existing one or more AutomationTasks in store
+//
+// This function is auto-generated
+func UpsertAutomationTask(ctx context.Context, s AutomationTasks, rr ...*automationType.Task) error {
+	return s.UpsertAutomationTask(ctx, rr...)
+}
+
+// DeleteAutomationTask deletes one or more AutomationTasks from store
+//
+// This function is auto-generated
+func DeleteAutomationTask(ctx context.Context, s AutomationTasks, rr ...*automationType.Task) error {
+	return s.DeleteAutomationTask(ctx, rr...)
+}
+
+// DeleteAutomationTaskByID deletes one or more AutomationTasks from store
+//
+// This function is auto-generated
+func DeleteAutomationTaskByID(ctx context.Context, s AutomationTasks, id uint64) error {
+	return s.DeleteAutomationTaskByID(ctx, id)
+}
+
+// TruncateAutomationTasks Deletes all AutomationTasks from store
+//
+// This function is auto-generated
+func TruncateAutomationTasks(ctx context.Context, s AutomationTasks) error {
+	return s.TruncateAutomationTasks(ctx)
+}
+
+// LookupAutomationTaskByID searches for task by ID
+//
+// It returns task even if completed
+//
+// This function is auto-generated
+func LookupAutomationTaskByID(ctx context.Context, s AutomationTasks, id uint64) (*automationType.Task, error) {
+	return s.LookupAutomationTaskByID(ctx, id)
+}
+
+// LookupAutomationTaskBySessionIDStateID searches for task of the prompted session state
+//
+// This function is auto-generated
+func LookupAutomationTaskBySessionIDStateID(ctx context.Context, s AutomationTasks, sessionID uint64, stateID uint64) (*automationType.Task, error) {
+	return s.LookupAutomationTaskBySessionIDStateID(ctx, sessionID, stateID)
+}
+
+// TransitionAutomationTask updates the task only when it is still in the given status
+//
+// Returns true when the task is updated; when the status
+// was changed in the meantime, false is returned
+//
+// This function is auto-generated
+func TransitionAutomationTask(ctx context.Context, s AutomationTasks, task *automationType.Task, from automationType.TaskStatus) (bool, error) {
+	return s.TransitionAutomationTask(ctx, task, from)
+}
+
 // SearchAutomationTriggers returns all matching AutomationTriggers from store
 //
 // This function is auto-generated
@@ -1390,6 +1899,127 @@ func LookupAutomationWorkflowByHandle(ctx context.Context, s AutomationWorkflows
 	return s.LookupAutomationWorkflowByHandle(ctx, handle)
 }
 
+// SearchAutomationWorkflowRevisions returns all matching AutomationWorkflowRevisions from store
+//
+// This function is auto-generated
+func SearchAutomationWorkflowRevisions(ctx context.Context, s AutomationWorkflowRevisions, f automationType.WorkflowRevisionFilter) (automationType.WorkflowRevisionSet, automationType.WorkflowRevisionFilter, error) {
+	return s.SearchAutomationWorkflowRevisions(ctx, f)
+}
+
+// CreateAutomationWorkflowRevision creates one or more AutomationWorkflowRevisions in store
+//
+// This function is auto-generated
+func CreateAutomationWorkflowRevision(ctx context.Context, s AutomationWorkflowRevisions, rr ...*automationType.WorkflowRevision) error {
+	return s.CreateAutomationWorkflowRevision(ctx, rr...)
+}
+
+// UpdateAutomationWorkflowRevision updates one or more (existing) AutomationWorkflowRevisions in store
+//
+// This function is auto-generated
+func UpdateAutomationWorkflowRevision(ctx context.Context, s AutomationWorkflowRevisions, rr ...*automationType.WorkflowRevision) error {
+	return s.UpdateAutomationWorkflowRevision(ctx, rr...)
+}
+
+// UpsertAutomationWorkflowRevision creates new or updates existing one or more AutomationWorkflowRevisions in store
+//
+// This function is auto-generated
+func UpsertAutomationWorkflowRevision(ctx context.Context, s AutomationWorkflowRevisions, rr ...*automationType.WorkflowRevision) error {
+	return s.UpsertAutomationWorkflowRevision(ctx, rr...)
+}
+
+// DeleteAutomationWorkflowRevision deletes one or more AutomationWorkflowRevisions from store
+//
+// This function is auto-generated
+func DeleteAutomationWorkflowRevision(ctx context.Context, s AutomationWorkflowRevisions, rr ...*automationType.WorkflowRevision) error {
+	return s.DeleteAutomationWorkflowRevision(ctx, rr...)
+}
+
+// DeleteAutomationWorkflowRevisionByID deletes one or more AutomationWorkflowRevisions from store
+//
+// This function is auto-generated
+func DeleteAutomationWorkflowRevisionByID(ctx context.Context, s AutomationWorkflowRevisions, id uint64) error {
+	return s.DeleteAutomationWorkflowRevisionByID(ctx, id)
+}
+
+// TruncateAutomationWorkflowRevisions Deletes all AutomationWorkflowRevisions from store
+//
+// This function is auto-generated
+func TruncateAutomationWorkflowRevisions(ctx context.Context, s AutomationWorkflowRevisions) error {
+	return s.TruncateAutomationWorkflowRevisions(ctx)
+}
+
+// LookupAutomationWorkflowRevisionByID
+//
+// This function is auto-generated
+func LookupAutomationWorkflowRevisionByID(ctx context.Context, s AutomationWorkflowRevisions, id uint64) (*automationType.WorkflowRevision, error) {
+	return s.LookupAutomationWorkflowRevisionByID(ctx, id)
+}
+
+// LookupAutomationWorkflowRevisionByWorkflowIDRevision searches for workflow revision by workflow ID and revision number
+//
+// This function is auto-generated
+func LookupAutomationWorkflowRevisionByWorkflowIDRevision(ctx context.Context, s AutomationWorkflowRevisions, workflowID uint64, revision uint) (*automationType.WorkflowRevision, error) {
+	return s.LookupAutomationWorkflowRevisionByWorkflowIDRevision(ctx, workflowID, revision)
+}
+
+// SearchAutomationWorkflowTestCases returns all matching AutomationWorkflowTestCases from store
+//
+// This function is auto-generated
+func SearchAutomationWorkflowTestCases(ctx context.Context, s AutomationWorkflowTestCases, f automationType.WorkflowTestCaseFilter) (automationType.WorkflowTestCaseSet, automationType.WorkflowTestCaseFilter, error) {
+	return s.SearchAutomationWorkflowTestCases(ctx, f)
+}
+
+// CreateAutomationWorkflowTestCase creates one or more AutomationWorkflowTestCases in store
+//
+// This function is auto-generated
+func CreateAutomationWorkflowTestCase(ctx context.Context, s AutomationWorkflowTestCases, rr ...*automationType.WorkflowTestCase) error {
+	return s.CreateAutomationWorkflowTestCase(ctx, rr...)
+}
+
+// UpdateAutomationWorkflowTestCase updates one or more (existing) AutomationWorkflowTestCases in store
+//
+// This function is auto-generated
+func UpdateAutomationWorkflowTestCase(ctx context.Context, s AutomationWorkflowTestCases, rr ...*automationType.WorkflowTestCase) error {
+	return s.UpdateAutomationWorkflowTestCase(ctx, rr...)
+}
+
+// UpsertAutomationWorkflowTestCase creates new or updates existing one or more AutomationWorkflowTestCases in store
+//
+// This function is auto-generated
+func UpsertAutomationWorkflowTestCase(ctx context.Context, s AutomationWorkflowTestCases, rr ...*automationType.WorkflowTestCase) error {
+	return s.UpsertAutomationWorkflowTestCase(ctx, rr...)
+}
+
+// DeleteAutomationWorkflowTestCase deletes one or more AutomationWorkflowTestCases from store
+//
+// This function is auto-generated
+func DeleteAutomationWorkflowTestCase(ctx context.Context, s AutomationWorkflowTestCases, rr ...*automationType.WorkflowTestCase) error {
+	return s.DeleteAutomationWorkflowTestCase(ctx, rr...)
+}
+
+// DeleteAutomationWorkflowTestCaseByID deletes one or more AutomationWorkflowTestCases from store
+//
+// This function is auto-generated
+func DeleteAutomationWorkflowTestCaseByID(ctx context.Context, s AutomationWorkflowTestCases, id uint64) error {
+	return s.DeleteAutomationWorkflowTestCaseByID(ctx, id)
+}
+
+// TruncateAutomationWorkflowTestCases Deletes all AutomationWorkflowTestCases from store
+//
+// This function is auto-generated
+func TruncateAutomationWorkflowTestCases(ctx context.Context, s AutomationWorkflowTestCases) error {
+	return s.TruncateAutomationWorkflowTestCases(ctx)
+}
+
+// LookupAutomationWorkflowTestCaseByID searches for workflow test case by ID
+//
+// It returns test case even if deleted
+//
+// This function is auto-generated
+func LookupAutomationWorkflowTestCaseByID(ctx context.Context, s AutomationWorkflowTestCases, id uint64) (*automationType.WorkflowTestCase, error) {
+	return s.LookupAutomationWorkflowTestCaseByID(ctx, id)
+}
+
 // SearchComposeAttachments returns all matching ComposeAttachments from store
 //
 // This function is auto-generated
@@ -1862,6 +2492,210 @@ func PartialComposeRecordValueUpdate(ctx context.Context, s ComposeRecords, mod
 	return s.PartialComposeRecordValueUpdate(ctx, mod, values...)
 }
 
+// SearchComposeRecordImportErrors returns all matching ComposeRecordImportErrors from store
+//
+// This function is auto-generated
+func SearchComposeRecordImportErrors(ctx context.Context, s ComposeRecordImportErrors, f composeType.RecordImportErrorFilter) (composeType.RecordImportErrorSet, composeType.RecordImportErrorFilter, error) {
+	return s.SearchComposeRecordImportErrors(ctx, f)
+}
+
+// CreateComposeRecordImportError creates one or more ComposeRecordImportErrors in store
+//
+// This function is auto-generated
+func CreateComposeRecordImportError(ctx context.Context, s ComposeRecordImportErrors, rr ...*composeType.RecordImportError) error {
+	return s.CreateComposeRecordImportError(ctx, rr...)
+}
+
+// UpdateComposeRecordImportError updates one or more (existing) ComposeRecordImportErrors in store
+//
+// This function is auto-generated
+func UpdateComposeRecordImportError(ctx context.Context, s ComposeRecordImportErrors, rr ...*composeType.RecordImportError) error {
+	return s.UpdateComposeRecordImportError(ctx, rr...)
+}
+
+// UpsertComposeRecordImportError creates new or updates existing one or more ComposeRecordImportErrors in store
+//
+// This function is auto-generated
+func UpsertComposeRecordImportError(ctx context.Context, s ComposeRecordImportErrors, rr ...*composeType.RecordImportError) error {
+	return s.UpsertComposeRecordImportError(ctx, rr...)
+}
+
+// DeleteComposeRecordImportError deletes one or more ComposeRecordImportErrors from store
+//
+// This function is auto-generated
+func DeleteComposeRecordImportError(ctx context.Context, s ComposeRecordImportErrors, rr ...*composeType.RecordImportError) error {
+	return s.DeleteComposeRecordImportError(ctx, rr...)
+}
+
+// DeleteComposeRecordImportErrorByID deletes one or more ComposeRecordImportErrors from store
+//
+// This function is auto-generated
+func DeleteComposeRecordImportErrorBySessionIDRow(ctx context.Context, s ComposeRecordImportErrors, sessionID uint64, row uint64) error {
+	return s.DeleteComposeRecordImportErrorBySessionIDRow(ctx, sessionID, row)
+}
+
+// TruncateComposeRecordImportErrors Deletes all ComposeRecordImportErrors from store
+//
+// This function is auto-generated
+func TruncateComposeRecordImportErrors(ctx context.Context, s ComposeRecordImportErrors) error {
+	return s.TruncateComposeRecordImportErrors(ctx)
+}
+
+// DeleteComposeRecordImportErrorsBySessionID
+//
+// This function is auto-generated
+func DeleteComposeRecordImportErrorsBySessionID(ctx context.Context, s ComposeRecordImportErrors, sessionID uint64) error {
+	return s.DeleteComposeRecordImportErrorsBySessionID(ctx, sessionID)
+}
+
+// SearchComposeRecordImportSessions returns all matching ComposeRecordImportSessions from store
+//
+// This function is auto-generated
+func SearchComposeRecordImportSessions(ctx context.Context, s ComposeRecordImportSessions, f composeType.RecordImportSessionFilter) (composeType.RecordImportSessionSet, composeType.RecordImportSessionFilter, error) {
+	return s.SearchComposeRecordImportSessions(ctx, f)
+}
+
+// CreateComposeRecordImportSession creates one or more ComposeRecordImportSessions in store
+//
+// This function is auto-generated
+func CreateComposeRecordImportSession(ctx context.Context, s ComposeRecordImportSessions, rr ...*composeType.RecordImportSession) error {
+	return s.CreateComposeRecordImportSession(ctx, rr...)
+}
+
+// UpdateComposeRecordImportSession updates one or more (existing) ComposeRecordImportSessions in store
+//
+// This function is auto-generated
+func UpdateComposeRecordImportSession(ctx context.Context, s ComposeRecordImportSessions, rr ...*composeType.RecordImportSession) error {
+	return s.UpdateComposeRecordImportSession(ctx, rr...)
+}
+
+// UpsertComposeRecordImportSession creates new or updates existing one or more ComposeRecordImportSessions in store
+//
+// This function is auto-generated
+func UpsertComposeRecordImportSession(ctx context.Context, s ComposeRecordImportSessions, rr ...*composeType.RecordImportSession) error {
+	return s.UpsertComposeRecordImportSession(ctx, rr...)
+}
+
+// DeleteComposeRecordImportSession deletes one or more ComposeRecordImportSessions from store
+//
+// This function is auto-generated
+func DeleteComposeRecordImportSession(ctx context.Context, s ComposeRecordImportSessions, rr ...*composeType.RecordImportSession) error {
+	return s.DeleteComposeRecordImportSession(ctx, rr...)
+}
+
+// DeleteComposeRecordImportSessionByID deletes one or more ComposeRecordImportSessions from store
+//
+// This function is auto-generated
+func DeleteComposeRecordImportSessionByID(ctx context.Context, s ComposeRecordImportSessions, id uint64) error {
+	return s.DeleteComposeRecordImportSessionByID(ctx, id)
+}
+
+// TruncateComposeRecordImportSessions Deletes all ComposeRecordImportSessions from store
+//
+// This function is auto-generated
+func TruncateComposeRecordImportSessions(ctx context.Context, s ComposeRecordImportSessions) error {
+	return s.TruncateComposeRecordImportSessions(ctx)
+}
+
+// LookupComposeRecordImportSessionByID
+//
+// This function is auto-generated
+func LookupComposeRecordImportSessionByID(ctx context.Context, s ComposeRecordImportSessions, id uint64) (*composeType.RecordImportSession, error) {
+	return s.LookupComposeRecordImportSessionByID(ctx, id)
+}
+
+// ClaimComposeRecordImportSession updates the import session unless it was updated after the given time
+//
+// Returns true when the session is claimed; when the session
+// was updated in the meantime (by another node), false is returned
+//
+// This function is auto-generated
+func ClaimComposeRecordImportSession(ctx context.Context, s ComposeRecordImportSessions, session *composeType.RecordImportSession, updatedBefore time.Time) (bool, error) {
+	return s.ClaimComposeRecordImportSession(ctx, session, updatedBefore)
+}
+
+// StartComposeRecordImportSession updates the import session unless it was updated after it was loaded
+//
+// Returns true when the session is updated; when the session
+// was updated in the meantime (started by another request), false is returned
+//
+// This function is auto-generated
+func StartComposeRecordImportSession(ctx context.Context, s ComposeRecordImportSessions, session *composeType.RecordImportSession, loadedAt time.Time) (bool, error) {
+	return s.StartComposeRecordImportSession(ctx, session, loadedAt)
+}
+
+// SearchComposeRecordRevisions returns all matching ComposeRecordRevisions from store
+//
+// This function is auto-generated
+func SearchComposeRecordRevisions(ctx context.Context, s ComposeRecordRevisions, f composeType.RecordRevisionFilter) (composeType.RecordRevisionSet, composeType.RecordRevisionFilter, error) {
+	return s.SearchComposeRecordRevisions(ctx, f)
+}
+
+// CreateComposeRecordRevision creates one or more ComposeRecordRevisions in store
+//
+// This function is auto-generated
+func CreateComposeRecordRevision(ctx context.Context, s ComposeRecordRevisions, rr ...*composeType.RecordRevision) error {
+	return s.CreateComposeRecordRevision(ctx, rr...)
+}
+
+// UpdateComposeRecordRevision updates one or more (existing) ComposeRecordRevisions in store
+//
+// This function is auto-generated
+func UpdateComposeRecordRevision(ctx context.Context, s ComposeRecordRevisions, rr ...*composeType.RecordRevision) error {
+	return s.UpdateComposeRecordRevision(ctx, rr...)
+}
+
+// UpsertComposeRecordRevision creates new or updates existing one or more ComposeRecordRevisions in store
+//
+// This function is auto-generated
+func UpsertComposeRecordRevision(ctx context.Context, s ComposeRecordRevisions, rr ...*composeType.RecordRevision) error {
+	return s.UpsertComposeRecordRevision(ctx, rr...)
+}
+
+// DeleteComposeRecordRevision deletes one or more ComposeRecordRevisions from store
+//
+// This function is auto-generated
+func DeleteComposeRecordRevision(ctx context.Context, s ComposeRecordRevisions, rr ...*composeType.RecordRevision) error {
+	return s.DeleteComposeRecordRevision(ctx, rr...)
+}
+
+// DeleteComposeRecordRevisionByID deletes one or more ComposeRecordRevisions from store
+//
+// This function is auto-generated
+func DeleteComposeRecordRevisionByID(ctx context.Context, s ComposeRecordRevisions, id uint64) error {
+	return s.DeleteComposeRecordRevisionByID(ctx, id)
+}
+
+// TruncateComposeRecordRevisions Deletes all ComposeRecordRevisions from store
+//
+// This function is auto-generated
+func TruncateComposeRecordRevisions(ctx context.Context, s ComposeRecordRevisions) error {
+	return s.TruncateComposeRecordRevisions(ctx)
+}
+
+// LookupComposeRecordRevisionByID
+//
+// This function is auto-generated
+func LookupComposeRecordRevisionByID(ctx context.Context, s ComposeRecordRevisions, id uint64) (*composeType.RecordRevision, error) {
+	return s.LookupComposeRecordRevisionByID(ctx, id)
+}
+
+// LookupComposeRecordRevisionByRecordIDRevision searches for record revision by record ID and revision number
+//
+// This function is auto-generated
+func LookupComposeRecordRevisionByRecordIDRevision(ctx context.Context, s ComposeRecordRevisions, recordID uint64, revision uint) (*composeType.RecordRevision, error) {
+	return s.LookupComposeRecordRevisionByRecordIDRevision(ctx, recordID, revision)
+}
+
+// CreateUniqueComposeRecordRevision creates the record revision unless the revision number is already taken
+//
+// Returns false when the record already has the revision with the same number
+//
+// This function is auto-generated
+func CreateUniqueComposeRecordRevision(ctx context.Context, s ComposeRecordRevisions, rev *composeType.RecordRevision) (bool, error) {
+	return s.CreateUniqueComposeRecordRevision(ctx, rev)
+}
+
 // SearchComposeRecordValues returns all matching ComposeRecordValues from store
 //
 // This function is auto-generated
@@ -2479,6 +3313,73 @@ func LookupFlagByKindResourceIDOwnedByName(ctx context.Context, s Flags, kind st
 	return s.LookupFlagByKindResourceIDOwnedByName(ctx, kind, resourceID, ownedBy, name)
 }
 
+// SearchHolidayCalendars returns all matching HolidayCalendars from store
+//
+// This function is auto-generated
+func SearchHolidayCalendars(ctx context.Context, s HolidayCalendars, f systemType.HolidayCalendarFilter) (systemType.HolidayCalendarSet, systemType.HolidayCalendarFilter, error) {
+	return s.SearchHolidayCalendars(ctx, f)
+}
+
+// CreateHolidayCalendar creates one or more HolidayCalendars in store
+//
+// This function is auto-generated
+func CreateHolidayCalendar(ctx context.Context, s HolidayCalendars, rr ...*systemType.HolidayCalendar) error {
+	return s.CreateHolidayCalendar(ctx, rr...)
+}
+
+// UpdateHolidayCalendar updates one or more (existing) HolidayCalendars in store
+//
+// This function is auto-generated
+func UpdateHolidayCalendar(ctx context.Context, s HolidayCalendars, rr ...*systemType.HolidayCalendar) error {
+	return s.UpdateHolidayCalendar(ctx, rr...)
+}
+
+// UpsertHolidayCalendar creates new or updates existing one or more HolidayCalendars in store
+//
+// This function is auto-generated
+func UpsertHolidayCalendar(ctx context.Context, s HolidayCalendars, rr ...*systemType.HolidayCalendar) error {
+	return s.UpsertHolidayCalendar(ctx, rr...)
+}
+
+// DeleteHolidayCalendar deletes one or more HolidayCalendars from store
+//
+// This function is auto-generated
+func DeleteHolidayCalendar(ctx context.Context, s HolidayCalendars, rr ...*systemType.HolidayCalendar) error {
+	return s.DeleteHolidayCalendar(ctx, rr...)
+}
+
+// DeleteHolidayCalendarByID deletes one or more HolidayCalendars from store
+//
+// This function is auto-generated
+func DeleteHolidayCalendarByID(ctx context.Context, s HolidayCalendars, id uint64) error {
+	return s.DeleteHolidayCalendarByID(ctx, id)
+}
+
+// TruncateHolidayCalendars Deletes all HolidayCalendars from store
+//
+// This function is auto-generated
+func TruncateHolidayCalendars(ctx context.Context, s HolidayCalendars) error {
+	return s.TruncateHolidayCalendars(ctx)
+}
+
+// LookupHolidayCalendarByID searches for holiday calendar by ID
+//
+// It returns holiday calendar even if deleted
+//
+// This function is auto-generated
+func LookupHolidayCalendarByID(ctx context.Context, s HolidayCalendars, id uint64) (*systemType.HolidayCalendar, error) {
+	return s.LookupHolidayCalendarByID(ctx, id)
+}
+
+// LookupHolidayCalendarByHandle searches for holiday calendar by handle
+//
+// It returns only valid holiday calendars (not deleted)
+//
+// This function is auto-generated
+func LookupHolidayCalendarByHandle(ctx context.Context, s HolidayCalendars, handle string) (*systemType.HolidayCalendar, error) {
+	return s.LookupHolidayCalendarByHandle(ctx, handle)
+}
+
 // SearchLabels returns all matching Labels from store
 //
 // This function is auto-generated
@@ -20,6 +20,12 @@ func testAllGenerated(t *testing.T, s store.Storer) {
 	t.Run("apigwFilter", func(t *testing.T) {
 		testApigwFilters(t, s)
 	})
+	t.Run("apigwProfilerRecord", func(t *testing.T) {
+		testApigwProfilerRecords(t, s)
+	})
+	t.Run("apigwRateLimit", func(t *testing.T) {
+		testApigwRateLimits(t, s)
+	})
 	t.Run("apigwRoute", func(t *testing.T) {
 		testApigwRoutes(t, s)
 	})
@@ -41,15 +47,30 @@ func testAllGenerated(t *testing.T, s store.Storer) {
 	t.Run("authSession", func(t *testing.T) {
 		testAuthSessions(t, s)
 	})
+	t.Run("automationLease", func(t *testing.T) {
+		testAutomationLeases(t, s)
+	})
 	t.Run("automationSession", func(t *testing.T) {
 		testAutomationSessions(t, s)
 	})
+	t.Run("automationSessionResume", func(t *testing.T) {
+		testAutomationSessionResumes(t, s)
+	})
+	t.Run("automationTask", func(t *testing.T) {
+		testAutomationTasks(t, s)
+	})
 	t.Run("automationTrigger", func(t *testing.T) {
 		testAutomationTriggers(t, s)
 	})
 	t.Run("automationWorkflow", func(t *testing.T) {
 		testAutomationWorkflows(t, s)
 	})
+	t.Run("automationWorkflowRevision", func(t *testing.T) {
+		testAutomationWorkflowRevisions(t, s)
+	})
+	t.Run("automationWorkflowTestCase", func(t *testing.T) {
+		testAutomationWorkflowTestCases(t, s)
+	})
 	t.Run("composeAttachment", func(t *testing.T) {
 		testComposeAttachments(t, s)
 	})
@@ -71,6 +92,15 @@ func testAllGenerated(t *testing.T, s store.Storer) {
 	t.Run("composeRecord", func(t *testing.T) {
 		testComposeRecords(t, s)
 	})
+	t.Run("composeRecordImportError", func(t *testing.T) {
+		testComposeRecordImportErrors(t, s)
+	})
+	t.Run("composeRecordImportSession", func(t *testing.T) {
+		testComposeRecordImportSessions(t, s)
+	})
+	t.Run("composeRecordRevision", func(t *testing.T) {
+		testComposeRecordRevisions(t, s)
+	})
 	t.Run("composeRecordValue", func(t *testing.T) {
 		testComposeRecordValues(t, s)
 	})
@@ -101,6 +131,9 @@ func testAllGenerated(t *testing.T, s store.Storer) {
 	t.Run("flag", func(t *testing.T) {
 		testFlags(t, s)
 	})
+	t.Run("holidayCalendar", func(t *testing.T) {
+		testHolidayCalendars(t, s)
+	})
 	t.Run("label", func(t *testing.T) {
 		testLabels(t, s)
 	})
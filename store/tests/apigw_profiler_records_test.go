@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/id"
+	"github.com/cortezaproject/corteza-server/store"
+	"github.com/cortezaproject/corteza-server/system/types"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/stretchr/testify/require"
+)
+
+func testApigwProfilerRecords(t *testing.T, s store.ApigwProfilerRecords) {
+	var (
+		ctx = context.Background()
+
+		makeNew = func(path string, startedAt time.Time) *types.ApigwProfilerRecord {
+			return &types.ApigwProfilerRecord{
+				ID:        id.Next(),
+				Hit:       path + "_hit",
+				Route:     1,
+				Method:    http.MethodGet,
+				Path:      path,
+				Status:    http.StatusOK,
+				Duration:  int64(time.Millisecond),
+				StartedAt: startedAt,
+				Meta: types.ApigwProfilerRecordMeta{
+					Header: http.Header{"Accept": []string{"application/json"}},
+					Filters: []*types.ApigwProfilerFilterTiming{
+						{Name: "header", Kind: "prefilter", Ts: startedAt, D: time.Millisecond, Dr: 1},
+					},
+				},
+			}
+		}
+	)
+
+	t.Run("create and lookup", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateApigwProfilerRecords(ctx))
+
+		r := makeNew("/foo", time.Now())
+		req.NoError(s.CreateApigwProfilerRecord(ctx, r))
+
+		fetched, err := s.LookupApigwProfilerRecordByID(ctx, r.ID)
+		req.NoError(err)
+		req.Equal(r.Path, fetched.Path)
+		req.Equal("application/json", fetched.Meta.Header.Get("Accept"))
+		req.Len(fetched.Meta.Filters, 1)
+		req.Equal(time.Millisecond, fetched.Meta.Filters[0].D)
+	})
+
+	t.Run("search", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateApigwProfilerRecords(ctx))
+
+		var (
+			now  = time.Now()
+			then = now.Add(-time.Hour)
+		)
+
+		req.NoError(s.CreateApigwProfilerRecord(ctx,
+			makeNew("/foo", then),
+			makeNew("/foo", now),
+			makeNew("/bar", now),
+		))
+
+		set, _, err := s.SearchApigwProfilerRecords(ctx, types.ApigwProfilerRecordFilter{Path: "/foo"})
+		req.NoError(err)
+		req.Len(set, 2)
+
+		// newest first
+		req.True(set[0].ID > set[1].ID)
+
+		from := now.Add(-time.Minute)
+		set, _, err = s.SearchApigwProfilerRecords(ctx, types.ApigwProfilerRecordFilter{FromTimestamp: &from})
+		req.NoError(err)
+		req.Len(set, 2)
+	})
+
+	t.Run("delete expired", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateApigwProfilerRecords(ctx))
+
+		now := time.Now()
+		req.NoError(s.CreateApigwProfilerRecord(ctx, makeNew("/foo", now.Add(-time.Hour)), makeNew("/bar", now)))
+		req.NoError(s.DeleteExpiredApigwProfilerRecords(ctx, now.Add(-time.Minute)))
+
+		set, _, err := s.SearchApigwProfilerRecords(ctx, types.ApigwProfilerRecordFilter{})
+		req.NoError(err)
+		req.Len(set, 1)
+		req.Equal("/bar", set[0].Path)
+	})
+
+	t.Run("aggregate", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateApigwProfilerRecords(ctx))
+
+		var (
+			now = time.Now()
+			rr  []*types.ApigwProfilerRecord
+		)
+
+		for i := 1; i <= 4; i++ {
+			r := makeNew("/foo", now)
+			r.Duration = int64(i) * int64(time.Millisecond)
+			r.ContentLength = int64(i)
+			rr = append(rr, r)
+		}
+
+		req.NoError(s.CreateApigwProfilerRecord(ctx, append(rr, makeNew("/bar", now), makeNew("/baz", now.Add(-time.Hour)))...))
+
+		from := now.Add(-time.Minute)
+		set, err := s.AggregateApigwProfilerRecords(ctx, types.ApigwProfilerRecordFilter{FromTimestamp: &from})
+		req.NoError(err)
+		req.Len(set, 2)
+
+		// ordered by path
+		req.Equal("/bar", set[0].Path)
+		req.Equal(uint64(1), set[0].Count)
+
+		a := set[1]
+		req.Equal("/foo", a.Path)
+		req.Equal(uint64(1), a.Route)
+		req.Equal(uint64(4), a.Count)
+		req.Equal(int64(time.Millisecond), a.DurationMin)
+		req.Equal(int64(4*time.Millisecond), a.DurationMax)
+		req.Equal(int64(10*time.Millisecond), a.DurationSum)
+		req.Equal(int64(2*time.Millisecond), a.DurationP50)
+		req.Equal(int64(4*time.Millisecond), a.DurationP90)
+		req.Equal(int64(4*time.Millisecond), a.DurationP99)
+		req.Equal(int64(1), a.ContentLengthMin)
+		req.Equal(int64(4), a.ContentLengthMax)
+		req.Equal(int64(10), a.ContentLengthSum)
+
+		set, err = s.AggregateApigwProfilerRecords(ctx, types.ApigwProfilerRecordFilter{Limit: 1})
+		req.NoError(err)
+		req.Len(set, 1)
+	})
+}
@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/store"
+	"github.com/cortezaproject/corteza-server/system/types"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/stretchr/testify/require"
+)
+
+func testApigwRateLimits(t *testing.T, s store.ApigwRateLimits) {
+	var (
+		ctx = context.Background()
+
+		counter = func(version, count uint64, ttl time.Duration) *types.ApigwRateLimit {
+			return &types.ApigwRateLimit{
+				Name:      "route:1:ip:127.0.0.1",
+				Count:     count,
+				Stamp:     time.Now().UnixNano(),
+				Version:   version,
+				ExpiresAt: time.Now().Add(ttl),
+			}
+		}
+	)
+
+	t.Run("save", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateApigwRateLimits(ctx))
+
+		ok, err := s.SaveApigwRateLimit(ctx, counter(1, 1, time.Minute))
+		req.NoError(err)
+		req.True(ok)
+
+		// created by someone else in the meantime
+		ok, err = s.SaveApigwRateLimit(ctx, counter(1, 1, time.Minute))
+		req.NoError(err)
+		req.False(ok)
+
+		ok, err = s.SaveApigwRateLimit(ctx, counter(2, 2, time.Minute))
+		req.NoError(err)
+		req.True(ok)
+
+		// stale version
+		ok, err = s.SaveApigwRateLimit(ctx, counter(2, 5, time.Minute))
+		req.NoError(err)
+		req.False(ok)
+
+		c, err := s.LookupApigwRateLimitByName(ctx, "route:1:ip:127.0.0.1")
+		req.NoError(err)
+		req.Equal(uint64(2), c.Version)
+		req.Equal(uint64(2), c.Count)
+	})
+
+	t.Run("delete expired", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateApigwRateLimits(ctx))
+
+		expired := counter(1, 1, -time.Minute)
+		expired.Name = "route:2:ip:127.0.0.1"
+		req.NoError(s.CreateApigwRateLimit(ctx, expired, counter(1, 1, time.Minute)))
+		req.NoError(s.DeleteExpiredApigwRateLimits(ctx))
+
+		set, _, err := s.SearchApigwRateLimits(ctx, types.ApigwRateLimitFilter{})
+		req.NoError(err)
+		req.Len(set, 1)
+		req.Equal("route:1:ip:127.0.0.1", set[0].Name)
+	})
+}
@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/store"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/stretchr/testify/require"
+)
+
+func testAutomationLeases(t *testing.T, s store.AutomationLeases) {
+	var (
+		ctx = context.Background()
+
+		lease = func(owner string, ttl time.Duration) *types.Lease {
+			return &types.Lease{
+				Name:      "session:1",
+				Owner:     owner,
+				ExpiresAt: time.Now().Add(ttl),
+			}
+		}
+	)
+
+	t.Run("acquire", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateAutomationLeases(ctx))
+
+		ok, err := s.AcquireAutomationLease(ctx, lease("a", time.Minute))
+		req.NoError(err)
+		req.True(ok)
+
+		// held by another owner
+		ok, err = s.AcquireAutomationLease(ctx, lease("b", time.Minute))
+		req.NoError(err)
+		req.False(ok)
+
+		// renewed by the same owner
+		ok, err = s.AcquireAutomationLease(ctx, lease("a", time.Hour))
+		req.NoError(err)
+		req.True(ok)
+
+		l, err := s.LookupAutomationLeaseByName(ctx, "session:1")
+		req.NoError(err)
+		req.Equal("a", l.Owner)
+		req.True(l.ExpiresAt.After(time.Now().Add(time.Minute)))
+	})
+
+	t.Run("acquire expired", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateAutomationLeases(ctx))
+
+		ok, err := s.AcquireAutomationLease(ctx, lease("a", -time.Minute))
+		req.NoError(err)
+		req.True(ok)
+
+		ok, err = s.AcquireAutomationLease(ctx, lease("b", time.Minute))
+		req.NoError(err)
+		req.True(ok)
+
+		l, err := s.LookupAutomationLeaseByName(ctx, "session:1")
+		req.NoError(err)
+		req.Equal("b", l.Owner)
+	})
+
+	t.Run("release", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateAutomationLeases(ctx))
+
+		ok, err := s.AcquireAutomationLease(ctx, lease("a", time.Minute))
+		req.NoError(err)
+		req.True(ok)
+
+		// only owner can release the lease
+		req.NoError(s.ReleaseAutomationLease(ctx, "session:1", "b"))
+		_, err = s.LookupAutomationLeaseByName(ctx, "session:1")
+		req.NoError(err)
+
+		req.NoError(s.ReleaseAutomationLease(ctx, "session:1", "a"))
+		_, err = s.LookupAutomationLeaseByName(ctx, "session:1")
+		req.EqualError(err, store.ErrNotFound.Error())
+	})
+
+	t.Run("delete expired", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateAutomationLeases(ctx))
+
+		expired := lease("a", -time.Minute)
+		expired.Name = "scheduler:1"
+		req.NoError(s.CreateAutomationLease(ctx, expired, lease("a", time.Minute)))
+		req.NoError(s.DeleteExpiredAutomationLeases(ctx))
+
+		set, _, err := s.SearchAutomationLeases(ctx, types.LeaseFilter{Owner: "a"})
+		req.NoError(err)
+		req.Len(set, 1)
+		req.Equal("session:1", set[0].Name)
+	})
+}
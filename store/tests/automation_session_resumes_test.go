@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/id"
+	"github.com/cortezaproject/corteza-server/store"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/stretchr/testify/require"
+)
+
+func testAutomationSessionResumes(t *testing.T, s store.AutomationSessionResumes) {
+	var (
+		ctx = context.Background()
+
+		makeNew = func(sessionID uint64) *types.SessionResume {
+			input, _ := expr.NewVars(map[string]interface{}{"foo": "bar"})
+
+			return &types.SessionResume{
+				ID:        id.Next(),
+				SessionID: sessionID,
+				StateID:   id.Next(),
+				Input:     input,
+				CreatedAt: *now(),
+				CreatedBy: id.Next(),
+			}
+		}
+	)
+
+	t.Run("create and lookup", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateAutomationSessionResumes(ctx))
+
+		res := makeNew(id.Next())
+		req.NoError(s.CreateAutomationSessionResume(ctx, res))
+
+		fetched, err := s.LookupAutomationSessionResumeByID(ctx, res.ID)
+		req.NoError(err)
+		req.Equal(res.SessionID, fetched.SessionID)
+		req.Equal(res.StateID, fetched.StateID)
+		req.True(fetched.Input.Has("foo"))
+	})
+
+	t.Run("search by session", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateAutomationSessionResumes(ctx))
+
+		sessionID := id.Next()
+		req.NoError(s.CreateAutomationSessionResume(ctx, makeNew(sessionID), makeNew(sessionID), makeNew(id.Next())))
+
+		set, _, err := s.SearchAutomationSessionResumes(ctx, types.SessionResumeFilter{SessionID: []uint64{sessionID}})
+		req.NoError(err)
+		req.Len(set, 2)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateAutomationSessionResumes(ctx))
+
+		res := makeNew(id.Next())
+		req.NoError(s.CreateAutomationSessionResume(ctx, res))
+		req.NoError(s.DeleteAutomationSessionResume(ctx, res))
+
+		_, err := s.LookupAutomationSessionResumeByID(ctx, res.ID)
+		req.EqualError(err, store.ErrNotFound.Error())
+	})
+}
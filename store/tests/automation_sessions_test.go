@@ -93,6 +93,7 @@ func testAutomationSessions(t *testing.T, s store.AutomationSessions) {
 
 		count := len(prefill)
 
+		prefill[0].CallerSessionID = 2001
 		prefill[4].CompletedAt = &prefill[4].CreatedAt
 		valid := count - 1
 
@@ -119,6 +120,11 @@ func testAutomationSessions(t *testing.T, s store.AutomationSessions) {
 		req.NoError(err)
 		req.Len(set, 4)
 
+		// find sub-workflow sessions
+		set, f, err = s.SearchAutomationSessions(ctx, types.SessionFilter{CallerSessionID: []uint64{2001}})
+		req.NoError(err)
+		req.Len(set, 1)
+
 		_ = f // dummy
 	})
 }
@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/cortezaproject/corteza-server/pkg/id"
+	"github.com/cortezaproject/corteza-server/store"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/stretchr/testify/require"
+)
+
+func testAutomationTasks(t *testing.T, s store.AutomationTasks) {
+	var (
+		ctx = context.Background()
+
+		makeNew = func(sessionID uint64, status types.TaskStatus) *types.Task {
+			return &types.Task{
+				ID:             id.Next(),
+				SessionID:      sessionID,
+				StateID:        id.Next(),
+				WorkflowID:     id.Next(),
+				AssignedRoleID: 7,
+				Status:         status,
+				CreatedAt:      *now(),
+			}
+		}
+	)
+
+	t.Run("create and lookup", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateAutomationTasks(ctx))
+
+		tsk := makeNew(id.Next(), types.TaskPending)
+		req.NoError(s.CreateAutomationTask(ctx, tsk))
+
+		fetched, err := s.LookupAutomationTaskByID(ctx, tsk.ID)
+		req.NoError(err)
+		req.Equal(tsk.SessionID, fetched.SessionID)
+		req.Equal(types.TaskPending, fetched.Status)
+
+		fetched, err = s.LookupAutomationTaskBySessionIDStateID(ctx, tsk.SessionID, tsk.StateID)
+		req.NoError(err)
+		req.Equal(tsk.ID, fetched.ID)
+	})
+
+	t.Run("search by status", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateAutomationTasks(ctx))
+
+		sessionID := id.Next()
+		done := makeNew(sessionID, types.TaskCompleted)
+		done.CompletedAt = now()
+
+		req.NoError(s.CreateAutomationTask(ctx,
+			makeNew(sessionID, types.TaskPending),
+			makeNew(sessionID, types.TaskClaimed),
+			done,
+		))
+
+		set, _, err := s.SearchAutomationTasks(ctx, types.TaskFilter{
+			SessionID: []uint64{sessionID},
+			Status:    []string{string(types.TaskPending), string(types.TaskClaimed)},
+		})
+		req.NoError(err)
+		req.Len(set, 2)
+
+		set, _, err = s.SearchAutomationTasks(ctx, types.TaskFilter{
+			AssignedRoleID: []uint64{7},
+			Completed:      filter.StateExclusive,
+		})
+		req.NoError(err)
+		req.Len(set, 1)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateAutomationTasks(ctx))
+
+		tsk := makeNew(id.Next(), types.TaskPending)
+		req.NoError(s.CreateAutomationTask(ctx, tsk))
+
+		tsk.ClaimedBy = 42
+		tsk.Status = types.TaskClaimed
+		req.NoError(s.UpdateAutomationTask(ctx, tsk))
+
+		fetched, err := s.LookupAutomationTaskByID(ctx, tsk.ID)
+		req.NoError(err)
+		req.Equal(uint64(42), fetched.ClaimedBy)
+		req.Equal(types.TaskClaimed, fetched.Status)
+	})
+}
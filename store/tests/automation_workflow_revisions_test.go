@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/cortezaproject/corteza-server/pkg/id"
+	"github.com/cortezaproject/corteza-server/store"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/stretchr/testify/require"
+)
+
+func testAutomationWorkflowRevisions(t *testing.T, s store.AutomationWorkflowRevisions) {
+	var (
+		ctx = context.Background()
+
+		makeNew = func(workflowID uint64, revision uint) *types.WorkflowRevision {
+			// minimum data set for new revision
+			return &types.WorkflowRevision{
+				ID:         id.Next(),
+				WorkflowID: workflowID,
+				Revision:   revision,
+				Meta:       &types.WorkflowMeta{Name: "wf"},
+				Steps:      types.WorkflowStepSet{{ID: 1, Kind: types.WorkflowStepKindExpressions}},
+				Paths:      types.WorkflowPathSet{},
+				CreatedAt:  *now(),
+				CreatedBy:  id.Next(),
+			}
+		}
+
+		truncAndCreate = func(t *testing.T) (*require.Assertions, *types.WorkflowRevision) {
+			req := require.New(t)
+			req.NoError(s.TruncateAutomationWorkflowRevisions(ctx))
+			res := makeNew(id.Next(), 1)
+			req.NoError(s.CreateAutomationWorkflowRevision(ctx, res))
+			return req, res
+		}
+	)
+
+	t.Run("create", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.CreateAutomationWorkflowRevision(ctx, makeNew(id.Next(), 1)))
+	})
+
+	t.Run("lookup by ID", func(t *testing.T) {
+		req, rev := truncAndCreate(t)
+
+		fetched, err := s.LookupAutomationWorkflowRevisionByID(ctx, rev.ID)
+		req.NoError(err)
+		req.Equal(rev.ID, fetched.ID)
+		req.Equal("wf", fetched.Meta.Name)
+		req.Len(fetched.Steps, 1)
+	})
+
+	t.Run("lookup by workflow ID and revision", func(t *testing.T) {
+		req, rev := truncAndCreate(t)
+
+		fetched, err := s.LookupAutomationWorkflowRevisionByWorkflowIDRevision(ctx, rev.WorkflowID, 1)
+		req.NoError(err)
+		req.Equal(rev.ID, fetched.ID)
+
+		_, err = s.LookupAutomationWorkflowRevisionByWorkflowIDRevision(ctx, rev.WorkflowID, 2)
+		req.EqualError(err, store.ErrNotFound.Error())
+	})
+
+	t.Run("search", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateAutomationWorkflowRevisions(ctx))
+
+		workflowID := id.Next()
+		req.NoError(s.CreateAutomationWorkflowRevision(ctx,
+			makeNew(workflowID, 1),
+			makeNew(workflowID, 2),
+			makeNew(workflowID, 3),
+			makeNew(id.Next(), 1),
+		))
+
+		set, _, err := s.SearchAutomationWorkflowRevisions(ctx, types.WorkflowRevisionFilter{WorkflowID: workflowID})
+		req.NoError(err)
+		req.Len(set, 3)
+
+		f := types.WorkflowRevisionFilter{WorkflowID: workflowID}
+		f.Sort = filter.SortExprSet{&filter.SortExpr{Column: "revision", Descending: true}}
+		f.Limit = 1
+
+		set, _, err = s.SearchAutomationWorkflowRevisions(ctx, f)
+		req.NoError(err)
+		req.Len(set, 1)
+		req.Equal(uint(3), set[0].Revision)
+	})
+}
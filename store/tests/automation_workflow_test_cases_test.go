@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/pkg/expr"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/cortezaproject/corteza-server/pkg/id"
+	"github.com/cortezaproject/corteza-server/store"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/stretchr/testify/require"
+)
+
+func testAutomationWorkflowTestCases(t *testing.T, s store.AutomationWorkflowTestCases) {
+	var (
+		ctx = context.Background()
+
+		makeNew = func(workflowID uint64) *types.WorkflowTestCase {
+			input, _ := expr.NewVars(map[string]interface{}{"foo": "bar"})
+
+			// minimum data set for new test case
+			return &types.WorkflowTestCase{
+				ID:         id.Next(),
+				WorkflowID: workflowID,
+				Meta:       &types.WorkflowTestCaseMeta{Name: "tc"},
+				Input:      input,
+				Mocks: types.WorkflowTestMockSet{
+					{Ref: "httpRequestSend", Error: "offline"},
+				},
+				Passthrough: types.WorkflowTestPassthrough{"loopSequence"},
+				Assertions:  types.TestSet{{Expr: "foo == \"bar\"", Error: "foo is not bar"}},
+				CreatedAt:   *now(),
+				CreatedBy:   id.Next(),
+			}
+		}
+
+		truncAndCreate = func(t *testing.T) (*require.Assertions, *types.WorkflowTestCase) {
+			req := require.New(t)
+			req.NoError(s.TruncateAutomationWorkflowTestCases(ctx))
+			res := makeNew(id.Next())
+			req.NoError(s.CreateAutomationWorkflowTestCase(ctx, res))
+			return req, res
+		}
+	)
+
+	t.Run("create", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.CreateAutomationWorkflowTestCase(ctx, makeNew(id.Next())))
+	})
+
+	t.Run("lookup by ID", func(t *testing.T) {
+		req, tc := truncAndCreate(t)
+
+		fetched, err := s.LookupAutomationWorkflowTestCaseByID(ctx, tc.ID)
+		req.NoError(err)
+		req.Equal(tc.ID, fetched.ID)
+		req.Equal("tc", fetched.Meta.Name)
+		req.Len(fetched.Mocks, 1)
+		req.Equal("offline", fetched.Mocks[0].Error)
+		req.True(fetched.Passthrough.Has("loopSequence"))
+		req.Len(fetched.Assertions, 1)
+		req.True(fetched.Input.Has("foo"))
+	})
+
+	t.Run("update", func(t *testing.T) {
+		req, tc := truncAndCreate(t)
+		tc.ExpectError = "offline"
+		req.NoError(s.UpdateAutomationWorkflowTestCase(ctx, tc))
+
+		fetched, err := s.LookupAutomationWorkflowTestCaseByID(ctx, tc.ID)
+		req.NoError(err)
+		req.Equal("offline", fetched.ExpectError)
+	})
+
+	t.Run("search", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateAutomationWorkflowTestCases(ctx))
+
+		var (
+			workflowID = id.Next()
+			deleted    = makeNew(workflowID)
+		)
+
+		deleted.DeletedAt = now()
+		req.NoError(s.CreateAutomationWorkflowTestCase(ctx,
+			makeNew(workflowID),
+			makeNew(workflowID),
+			deleted,
+			makeNew(id.Next()),
+		))
+
+		set, _, err := s.SearchAutomationWorkflowTestCases(ctx, types.WorkflowTestCaseFilter{WorkflowID: []uint64{workflowID}})
+		req.NoError(err)
+		req.Len(set, 2)
+
+		set, _, err = s.SearchAutomationWorkflowTestCases(ctx, types.WorkflowTestCaseFilter{
+			WorkflowID: []uint64{workflowID},
+			Deleted:    filter.StateInclusive,
+		})
+		req.NoError(err)
+		req.Len(set, 3)
+	})
+}
@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/id"
+	"github.com/cortezaproject/corteza-server/store"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/stretchr/testify/require"
+)
+
+func testComposeRecordImportErrors(t *testing.T, s store.ComposeRecordImportErrors) {
+	var (
+		ctx = context.Background()
+
+		makeNew = func(sessionID, row uint64) *types.RecordImportError {
+			return &types.RecordImportError{
+				SessionID: sessionID,
+				Row:       row,
+				Message:   "invalid",
+				CreatedAt: *now(),
+			}
+		}
+	)
+
+	t.Run("create", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.CreateComposeRecordImportError(ctx, makeNew(id.Next(), 1)))
+	})
+
+	t.Run("search by session", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateComposeRecordImportErrors(ctx))
+
+		sessionID := id.Next()
+		req.NoError(s.CreateComposeRecordImportError(ctx,
+			makeNew(sessionID, 1),
+			makeNew(sessionID, 5),
+			makeNew(id.Next(), 1),
+		))
+
+		set, _, err := s.SearchComposeRecordImportErrors(ctx, types.RecordImportErrorFilter{SessionID: sessionID})
+		req.NoError(err)
+		req.Len(set, 2)
+	})
+
+	t.Run("delete by session", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateComposeRecordImportErrors(ctx))
+
+		sessionID := id.Next()
+		req.NoError(s.CreateComposeRecordImportError(ctx, makeNew(sessionID, 1), makeNew(sessionID, 2)))
+		req.NoError(s.DeleteComposeRecordImportErrorsBySessionID(ctx, sessionID))
+
+		set, _, err := s.SearchComposeRecordImportErrors(ctx, types.RecordImportErrorFilter{SessionID: sessionID})
+		req.NoError(err)
+		req.Empty(set)
+	})
+}
@@ -0,0 +1,134 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/id"
+	"github.com/cortezaproject/corteza-server/store"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/stretchr/testify/require"
+)
+
+func testComposeRecordImportSessions(t *testing.T, s store.ComposeRecordImportSessions) {
+	var (
+		ctx = context.Background()
+
+		makeNew = func(userID uint64) *types.RecordImportSession {
+			// minimum data set for new import session
+			return &types.RecordImportSession{
+				ID:        id.Next(),
+				UserID:    userID,
+				Name:      "import.csv",
+				Fields:    types.RecordImportFieldMap{"fname": "name"},
+				CreatedAt: *now(),
+				UpdatedAt: *now(),
+			}
+		}
+
+		truncAndCreate = func(t *testing.T) (*require.Assertions, *types.RecordImportSession) {
+			req := require.New(t)
+			req.NoError(s.TruncateComposeRecordImportSessions(ctx))
+			res := makeNew(id.Next())
+			req.NoError(s.CreateComposeRecordImportSession(ctx, res))
+			return req, res
+		}
+	)
+
+	t.Run("create", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.CreateComposeRecordImportSession(ctx, makeNew(id.Next())))
+	})
+
+	t.Run("lookup by ID", func(t *testing.T) {
+		req, ses := truncAndCreate(t)
+
+		fetched, err := s.LookupComposeRecordImportSessionByID(ctx, ses.ID)
+		req.NoError(err)
+		req.Equal(ses.ID, fetched.ID)
+		req.Equal("name", fetched.Fields["fname"])
+		req.Nil(fetched.Progress.StartedAt)
+	})
+
+	t.Run("sheets", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateComposeRecordImportSessions(ctx))
+
+		ses := makeNew(id.Next())
+		ses.Name = "import.xlsx"
+		ses.Sheet = "second"
+		ses.Sheets = types.RecordImportSheetSet{
+			{Name: "first", Fields: []string{"a"}, EntryCount: 1},
+			{Name: "second", Fields: []string{"b", "c"}, EntryCount: 2},
+		}
+		req.NoError(s.CreateComposeRecordImportSession(ctx, ses))
+
+		fetched, err := s.LookupComposeRecordImportSessionByID(ctx, ses.ID)
+		req.NoError(err)
+		req.Equal("second", fetched.Sheet)
+		req.Len(fetched.Sheets, 2)
+		req.Equal([]string{"b", "c"}, fetched.Sheets.FindByName("second").Fields)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		req, ses := truncAndCreate(t)
+		ses.Progress.StartedAt = now()
+		ses.Progress.Completed = 42
+		ses.Progress.FailLog = &types.RecordImportFailLog{
+			Records: types.RecordImportIndex{1, 2},
+			Errors:  types.RecordImportErrorIndex{"invalid": 2},
+		}
+		req.NoError(s.UpdateComposeRecordImportSession(ctx, ses))
+
+		fetched, err := s.LookupComposeRecordImportSessionByID(ctx, ses.ID)
+		req.NoError(err)
+		req.NotNil(fetched.Progress.StartedAt)
+		req.Equal(uint64(42), fetched.Progress.Completed)
+		req.NotNil(fetched.Progress.FailLog)
+		req.Equal(2, fetched.Progress.FailLog.Errors["invalid"])
+
+		// failed records are stored separately
+		req.Empty(fetched.Progress.FailLog.Records)
+	})
+
+	t.Run("claim", func(t *testing.T) {
+		req, ses := truncAndCreate(t)
+
+		claimed := *ses
+		claimed.UpdatedAt = ses.UpdatedAt.Add(time.Minute)
+
+		ok, err := s.ClaimComposeRecordImportSession(ctx, &claimed, ses.UpdatedAt.Add(time.Second))
+		req.NoError(err)
+		req.True(ok)
+
+		// session was updated after it was loaded
+		ok, err = s.ClaimComposeRecordImportSession(ctx, ses, ses.UpdatedAt.Add(time.Second))
+		req.NoError(err)
+		req.False(ok)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		t.Run("by ID", func(t *testing.T) {
+			req, ses := truncAndCreate(t)
+			req.NoError(s.DeleteComposeRecordImportSessionByID(ctx, ses.ID))
+			_, err := s.LookupComposeRecordImportSessionByID(ctx, ses.ID)
+			req.EqualError(err, store.ErrNotFound.Error())
+		})
+	})
+
+	t.Run("search", func(t *testing.T) {
+		t.Run("by user", func(t *testing.T) {
+			req := require.New(t)
+			req.NoError(s.TruncateComposeRecordImportSessions(ctx))
+
+			userID := id.Next()
+			req.NoError(s.CreateComposeRecordImportSession(ctx, makeNew(userID), makeNew(userID), makeNew(id.Next())))
+
+			set, _, err := s.SearchComposeRecordImportSessions(ctx, types.RecordImportSessionFilter{UserID: userID})
+			req.NoError(err)
+			req.Len(set, 2)
+		})
+	})
+}
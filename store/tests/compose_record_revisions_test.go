@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/cortezaproject/corteza-server/pkg/id"
+	"github.com/cortezaproject/corteza-server/store"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/stretchr/testify/require"
+)
+
+func testComposeRecordRevisions(t *testing.T, s store.ComposeRecordRevisions) {
+	var (
+		ctx = context.Background()
+
+		makeNew = func(recordID uint64, revision uint) *types.RecordRevision {
+			// minimum data set for new revision
+			return &types.RecordRevision{
+				ID:        id.Next(),
+				RecordID:  recordID,
+				ModuleID:  42,
+				Revision:  revision,
+				Operation: types.RecordRevisionUpdate,
+				UserID:    id.Next(),
+				Values:    types.RecordValueSet{{Name: "name", Value: "value"}},
+				Changes:   types.RecordRevisionChangeSet{"name"},
+				CreatedAt: *now(),
+			}
+		}
+
+		truncAndCreate = func(t *testing.T) (*require.Assertions, *types.RecordRevision) {
+			req := require.New(t)
+			req.NoError(s.TruncateComposeRecordRevisions(ctx))
+			res := makeNew(id.Next(), 1)
+			req.NoError(s.CreateComposeRecordRevision(ctx, res))
+			return req, res
+		}
+	)
+
+	t.Run("create", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.CreateComposeRecordRevision(ctx, makeNew(id.Next(), 1)))
+	})
+
+	t.Run("lookup by ID", func(t *testing.T) {
+		req, rev := truncAndCreate(t)
+
+		fetched, err := s.LookupComposeRecordRevisionByID(ctx, rev.ID)
+		req.NoError(err)
+		req.Equal(rev.ID, fetched.ID)
+		req.Equal(types.RecordRevisionUpdate, fetched.Operation)
+		req.Equal("value", fetched.Values.Get("name", 0).Value)
+		req.Equal(types.RecordRevisionChangeSet{"name"}, fetched.Changes)
+	})
+
+	t.Run("lookup by record ID and revision", func(t *testing.T) {
+		req, rev := truncAndCreate(t)
+
+		fetched, err := s.LookupComposeRecordRevisionByRecordIDRevision(ctx, rev.RecordID, 1)
+		req.NoError(err)
+		req.Equal(rev.ID, fetched.ID)
+
+		_, err = s.LookupComposeRecordRevisionByRecordIDRevision(ctx, rev.RecordID, 2)
+		req.EqualError(err, store.ErrNotFound.Error())
+	})
+
+	t.Run("search", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateComposeRecordRevisions(ctx))
+
+		recordID := id.Next()
+		req.NoError(s.CreateComposeRecordRevision(ctx,
+			makeNew(recordID, 1),
+			makeNew(recordID, 2),
+			makeNew(recordID, 3),
+			makeNew(id.Next(), 1),
+		))
+
+		set, _, err := s.SearchComposeRecordRevisions(ctx, types.RecordRevisionFilter{RecordID: recordID})
+		req.NoError(err)
+		req.Len(set, 3)
+
+		f := types.RecordRevisionFilter{RecordID: recordID}
+		f.Sort = filter.SortExprSet{&filter.SortExpr{Column: "revision", Descending: true}}
+		f.Limit = 1
+
+		set, _, err = s.SearchComposeRecordRevisions(ctx, f)
+		req.NoError(err)
+		req.Len(set, 1)
+		req.Equal(uint(3), set[0].Revision)
+	})
+}
@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/cortezaproject/corteza-server/pkg/id"
+	"github.com/cortezaproject/corteza-server/pkg/rand"
+	"github.com/cortezaproject/corteza-server/store"
+	"github.com/cortezaproject/corteza-server/system/types"
+	_ "github.com/joho/godotenv/autoload"
+	"github.com/stretchr/testify/require"
+)
+
+func testHolidayCalendars(t *testing.T, s store.HolidayCalendars) {
+	var (
+		ctx = context.Background()
+
+		makeNew = func(handle string) *types.HolidayCalendar {
+			// minimum data set for new holiday calendar
+			return &types.HolidayCalendar{
+				ID:        id.Next(),
+				CreatedAt: time.Now(),
+				Handle:    handle,
+				Holidays: types.HolidaySet{
+					{Date: "01-01", Name: "New year"},
+					{Date: "2022-04-18"},
+				},
+			}
+		}
+
+		truncAndCreate = func(t *testing.T) (*require.Assertions, *types.HolidayCalendar) {
+			req := require.New(t)
+			req.NoError(s.TruncateHolidayCalendars(ctx))
+			res := makeNew(string(rand.Bytes(10)))
+			req.NoError(s.CreateHolidayCalendar(ctx, res))
+			return req, res
+		}
+	)
+
+	t.Run("create", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.CreateHolidayCalendar(ctx, makeNew("create")))
+	})
+
+	t.Run("lookup by ID", func(t *testing.T) {
+		req, calendar := truncAndCreate(t)
+		fetched, err := s.LookupHolidayCalendarByID(ctx, calendar.ID)
+		req.NoError(err)
+		req.Equal(calendar.Handle, fetched.Handle)
+		req.Equal(calendar.ID, fetched.ID)
+		req.Len(fetched.Holidays, 2)
+		req.Equal("01-01", fetched.Holidays[0].Date)
+		req.Nil(fetched.UpdatedAt)
+		req.Nil(fetched.DeletedAt)
+	})
+
+	t.Run("lookup by handle", func(t *testing.T) {
+		req, calendar := truncAndCreate(t)
+		fetched, err := s.LookupHolidayCalendarByHandle(ctx, calendar.Handle)
+		req.NoError(err)
+		req.Equal(calendar.ID, fetched.ID)
+
+		calendar.DeletedAt = &calendar.CreatedAt
+		req.NoError(s.UpdateHolidayCalendar(ctx, calendar))
+		_, err = s.LookupHolidayCalendarByHandle(ctx, calendar.Handle)
+		req.EqualError(err, store.ErrNotFound.Error())
+	})
+
+	t.Run("update", func(t *testing.T) {
+		req, calendar := truncAndCreate(t)
+		calendar.Meta.Name = "updated"
+		calendar.Holidays = types.HolidaySet{{Date: "12-25"}}
+		req.NoError(s.UpdateHolidayCalendar(ctx, calendar))
+
+		updated, err := s.LookupHolidayCalendarByID(ctx, calendar.ID)
+		req.NoError(err)
+		req.Equal("updated", updated.Meta.Name)
+		req.Len(updated.Holidays, 1)
+	})
+
+	t.Run("delete by ID", func(t *testing.T) {
+		req, calendar := truncAndCreate(t)
+		req.NoError(s.DeleteHolidayCalendarByID(ctx, calendar.ID))
+		_, err := s.LookupHolidayCalendarByID(ctx, calendar.ID)
+		req.EqualError(err, store.ErrNotFound.Error())
+	})
+
+	t.Run("search", func(t *testing.T) {
+		req := require.New(t)
+		req.NoError(s.TruncateHolidayCalendars(ctx))
+
+		deleted := makeNew("deleted")
+		deleted.DeletedAt = &deleted.CreatedAt
+
+		req.NoError(s.CreateHolidayCalendar(ctx, makeNew("one"), makeNew("two"), deleted))
+
+		set, _, err := s.SearchHolidayCalendars(ctx, types.HolidayCalendarFilter{})
+		req.NoError(err)
+		req.Len(set, 2)
+
+		set, _, err = s.SearchHolidayCalendars(ctx, types.HolidayCalendarFilter{Deleted: filter.StateInclusive})
+		req.NoError(err)
+		req.Len(set, 3)
+
+		set, _, err = s.SearchHolidayCalendars(ctx, types.HolidayCalendarFilter{Handle: "two"})
+		req.NoError(err)
+		req.Len(set, 1)
+	})
+}
@@ -0,0 +1,86 @@
+package system
+
+import (
+	"github.com/cortezaproject/corteza-server/codegen/schema"
+)
+
+apigw_profiler_record: schema.#Resource & {
+	features: {
+		labels: false
+		paging: false
+		checkFn: false
+	}
+
+	struct: {
+		id:             schema.IdField
+		hit:            {}
+		route:          { goType: "uint64", storeIdent: "rel_route" }
+		method:         {}
+		path:           {}
+		query:          {}
+		status:         { goType: "int" }
+		cache:          {}
+		content_length: { goType: "int64" }
+		duration:       { goType: "int64" }
+		meta:           { goType: "types.ApigwProfilerRecordMeta" }
+		started_at:     schema.SortableTimestampField
+	}
+
+	filter: {
+		struct: {
+			hit:            {}
+			route:          { goType: "uint64", storeIdent: "rel_route" }
+			path:           {}
+			from_timestamp: { goType: "*time.Time" }
+			before_id:      { goType: "uint64" }
+			limit:          { goType: "uint" }
+		}
+
+		byValue: ["hit", "route", "path"]
+	}
+
+	store: {
+		ident: "apigwProfilerRecord"
+
+		settings: {
+			rdbms: {
+				table: "apigw_profiler_records"
+			}
+		}
+
+		api: {
+			lookups: [
+				{
+					fields: ["id"]
+					description: """
+						searches for profiler record by ID
+						"""
+				},
+			]
+
+			functions: [
+				{
+					expIdent: "DeleteExpiredApigwProfilerRecords"
+					description: """
+						removes all profiler records of the requests started before the given time
+						"""
+					args: [
+						{ ident: "before", goType: "time.Time" },
+					]
+				},
+				{
+					expIdent: "AggregateApigwProfilerRecords"
+					description: """
+						aggregates the profiler records matching the filter by path
+
+						Paths are ordered by name, up to the limit of the filter
+						"""
+					args: [
+						{ ident: "f", goType: "types.ApigwProfilerRecordFilter" },
+					]
+					return: [ "types.ApigwProfilerRecordAggregateSet" ]
+				},
+			]
+		}
+	}
+}
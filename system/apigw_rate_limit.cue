@@ -0,0 +1,72 @@
+package system
+
+import (
+	"github.com/cortezaproject/corteza-server/codegen/schema"
+)
+
+apigw_rate_limit: schema.#Resource & {
+	features: {
+		labels: false
+		paging: false
+		sorting: false
+		checkFn: false
+	}
+
+	struct: {
+		name:       { primaryKey: true }
+		count:      { goType: "uint64" }
+		stamp:      { goType: "int64" }
+		version:    { goType: "uint64" }
+		expires_at: schema.SortableTimestampField
+	}
+
+	filter: {
+		struct: {}
+	}
+
+	store: {
+		ident: "apigwRateLimit"
+
+		settings: {
+			rdbms: {
+				table: "apigw_rate_limits"
+			}
+		}
+
+		api: {
+			lookups: [
+				{
+					fields: ["name"]
+					description: """
+						searches for rate limit counter by name
+
+						It returns counter even if expired
+						"""
+				},
+			]
+
+			functions: [
+				{
+					expIdent: "SaveApigwRateLimit"
+					description: """
+						creates or updates the rate limit counter
+
+						Counter is saved only if it was not changed since it was read
+						(version of the stored counter is one less than the given one);
+						returns false if counter was changed in the meantime
+						"""
+					args: [
+						{ ident: "counter", goType: "*types.ApigwRateLimit" },
+					]
+					return: [ "bool" ]
+				},
+				{
+					expIdent: "DeleteExpiredApigwRateLimits"
+					description: """
+						removes all expired rate limit counters
+						"""
+				},
+			]
+		}
+	}
+}
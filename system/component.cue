@@ -12,6 +12,8 @@ component: schema.#component & {
 		"application":           application
 		"apigw-route":           apigw_route
 		"apigw-filter":          apigw_filter
+		"apigw-rate-limit":      apigw_rate_limit
+		"apigw-profiler-record": apigw_profiler_record
 		"auth-client":           auth_client
 		"auth-confirmed-client": auth_confirmed_client
 		"auth-session":          auth_session
@@ -29,6 +31,7 @@ component: schema.#component & {
 		"user":                  user
 		"dal_connection":        dal_connection
 		"dal_sensitivity_level": dal_sensitivity_level
+		"holiday_calendar":      holiday_calendar
 	}
 
 	rbac: operations: {
@@ -50,6 +53,8 @@ component: schema.#component & {
 
 		"dal-sensitivity-level.manage": description:  "Can manage DAL sensitivity levels"
 
+		"holiday-calendar.manage": description: "Can manage holiday calendars"
+
 		"application.create": description:      "Create applications"
 		"applications.search": description:     "List, search or filter auth clients"
 		"application.flag.self": description:   "Manage private flags for applications"
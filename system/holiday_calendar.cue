@@ -0,0 +1,66 @@
+package system
+
+import (
+	"github.com/cortezaproject/corteza-server/codegen/schema"
+)
+
+holiday_calendar: schema.#Resource & {
+	struct: {
+		id:     schema.IdField
+		handle: schema.HandleField
+		meta: {goType: "types.HolidayCalendarMeta"}
+		holidays: {goType: "types.HolidaySet"}
+
+		created_at: schema.SortableTimestampField
+		updated_at: schema.SortableTimestampNilField
+		deleted_at: schema.SortableTimestampNilField
+		created_by: { goType: "uint64" }
+		updated_by: { goType: "uint64" }
+		deleted_by: { goType: "uint64" }
+	}
+
+	filter: {
+		struct: {
+			holiday_calendar_id: {goType: "[]uint64", ident: "holidayCalendarID", storeIdent: "id"}
+			handle: {goType: "string"}
+
+			deleted: {goType: "filter.State", storeIdent: "deleted_at"}
+		}
+
+		byValue: ["holiday_calendar_id", "handle"]
+		byNilState: ["deleted"]
+	}
+
+	rbac: {
+		operations: {}
+	}
+
+	features: {
+		labels: false
+		paging: false
+		sorting: false
+	}
+
+	store: {
+		api: {
+			lookups: [
+				{
+					fields: ["id"]
+					description: """
+						searches for holiday calendar by ID
+
+						It returns holiday calendar even if deleted
+						"""
+				}, {
+					fields: ["handle"]
+					nullConstraint: ["deleted_at"]
+					description: """
+						searches for holiday calendar by handle
+
+						It returns only valid holiday calendars (not deleted)
+						"""
+				},
+			]
+		}
+	}
+}
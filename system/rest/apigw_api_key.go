@@ -0,0 +1,67 @@
+package rest
+
+import (
+	"context"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/api"
+	"github.com/cortezaproject/corteza-server/system/rest/request"
+	"github.com/cortezaproject/corteza-server/system/service"
+	"github.com/cortezaproject/corteza-server/system/types"
+)
+
+type (
+	ApigwApiKey struct {
+		svc apiKeyService
+	}
+
+	apiKeyPayload struct {
+		*types.Credential
+
+		// Key is only set when created
+		Key string `json:"key,omitempty"`
+	}
+
+	apiKeySetPayload struct {
+		Set []*apiKeyPayload `json:"set"`
+	}
+
+	apiKeyService interface {
+		Search(ctx context.Context, userID uint64) (types.CredentialSet, error)
+		Create(ctx context.Context, userID uint64, label string, expiresAt *time.Time) (string, *types.Credential, error)
+		DeleteByID(ctx context.Context, userID, keyID uint64) error
+	}
+)
+
+func (ApigwApiKey) New() *ApigwApiKey {
+	return &ApigwApiKey{
+		svc: service.DefaultApigwApiKey,
+	}
+}
+
+func (ctrl *ApigwApiKey) List(ctx context.Context, r *request.ApigwApiKeyList) (interface{}, error) {
+	set, err := ctrl.svc.Search(ctx, r.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &apiKeySetPayload{Set: make([]*apiKeyPayload, len(set))}
+	for i, c := range set {
+		out.Set[i] = &apiKeyPayload{Credential: c}
+	}
+
+	return out, nil
+}
+
+func (ctrl *ApigwApiKey) Create(ctx context.Context, r *request.ApigwApiKeyCreate) (interface{}, error) {
+	key, c, err := ctrl.svc.Create(ctx, r.UserID, r.Label, r.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiKeyPayload{Credential: c, Key: key}, nil
+}
+
+func (ctrl *ApigwApiKey) Delete(ctx context.Context, r *request.ApigwApiKeyDelete) (interface{}, error) {
+	return api.OK(), ctrl.svc.DeleteByID(ctx, r.UserID, r.KeyID)
+}
@@ -2,6 +2,9 @@ package rest
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
 
 	"github.com/cortezaproject/corteza-server/pkg/filter"
 	"github.com/cortezaproject/corteza-server/system/rest/request"
@@ -13,6 +16,7 @@ type (
 	profilerService interface {
 		Hits(context.Context, types.ApigwProfilerFilter) (types.ApigwProfilerHitSet, types.ApigwProfilerFilter, error)
 		HitsAggregated(context.Context, types.ApigwProfilerFilter) (types.ApigwProfilerAggregationSet, types.ApigwProfilerFilter, error)
+		Export(context.Context, types.ApigwProfilerFilter, string) (interface{}, error)
 	}
 
 	ApigwProfiler struct {
@@ -109,6 +113,37 @@ func (ctrl *ApigwProfiler) Hit(ctx context.Context, r *request.ApigwProfilerHit)
 	return ctrl.makeRoutePayload(ctx, set[0], err)
 }
 
+// Export exports hits as HAR or OpenTelemetry spans
+func (ctrl *ApigwProfiler) Export(ctx context.Context, r *request.ApigwProfilerExport) (interface{}, error) {
+	var (
+		err error
+		f   = types.ApigwProfilerFilter{
+			Hit:  r.HitID,
+			Path: r.Path,
+		}
+	)
+
+	if f.Paging, err = filter.NewPaging(r.Limit, ""); err != nil {
+		return nil, err
+	}
+
+	out, err := ctrl.svc.Export(ctx, f, r.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(w http.ResponseWriter, req *http.Request) {
+		ext := "json"
+		if r.Format == types.ApigwProfilerExportHAR {
+			ext = "har"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=apigw-profiler.%s", ext))
+		_ = json.NewEncoder(w).Encode(out)
+	}, nil
+}
+
 func (ctrl *ApigwProfiler) makePayload(ctx context.Context, q *types.ApigwProfilerAggregation, err error) (*profilerHitPayload, error) {
 	if err != nil || q == nil {
 		return nil, err
@@ -2,9 +2,12 @@ package rest
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
 
 	"github.com/cortezaproject/corteza-server/pkg/api"
 	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/cortezaproject/corteza-server/pkg/options"
 	"github.com/cortezaproject/corteza-server/system/rest/request"
 	"github.com/cortezaproject/corteza-server/system/service"
 	"github.com/cortezaproject/corteza-server/system/types"
@@ -14,6 +17,7 @@ type (
 	ApigwRoute struct {
 		svc routeService
 		ac  templateAccessController
+		opt options.HttpServerOpt
 	}
 
 	routePayload struct {
@@ -31,6 +35,8 @@ type (
 		Update(ctx context.Context, upd *types.ApigwRoute) (*types.ApigwRoute, error)
 		DeleteByID(ctx context.Context, ID uint64) error
 		UndeleteByID(ctx context.Context, ID uint64) error
+		PurgeCache(ctx context.Context, ID uint64) error
+		OpenAPI(ctx context.Context, server string) (*types.ApigwOpenAPI, error)
 		Search(ctx context.Context, filter types.ApigwRouteFilter) (types.ApigwRouteSet, types.ApigwRouteFilter, error)
 	}
 )
@@ -39,6 +45,7 @@ func (ApigwRoute) New() *ApigwRoute {
 	return &ApigwRoute{
 		svc: service.DefaultApigwRoute,
 		ac:  service.DefaultAccessControl,
+		opt: *options.HttpServer(),
 	}
 }
 
@@ -68,6 +75,19 @@ func (ctrl *ApigwRoute) List(ctx context.Context, r *request.ApigwRouteList) (in
 	return ctrl.makeFilterPayload(ctx, set, f, err)
 }
 
+// OpenAPI serves the OpenAPI document as is (not wrapped in the response)
+func (ctrl *ApigwRoute) OpenAPI(ctx context.Context, r *request.ApigwRouteOpenAPI) (interface{}, error) {
+	doc, err := ctrl.svc.OpenAPI(ctx, options.CleanBase(ctrl.opt.BaseUrl, ctrl.opt.ApiBaseUrl, "gateway"))
+	if err != nil {
+		return nil, err
+	}
+
+	return func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(doc)
+	}, nil
+}
+
 func (ctrl *ApigwRoute) Create(ctx context.Context, r *request.ApigwRouteCreate) (interface{}, error) {
 	var (
 		err error
@@ -75,6 +95,7 @@ func (ctrl *ApigwRoute) Create(ctx context.Context, r *request.ApigwRouteCreate)
 			Endpoint: r.Endpoint,
 			Method:   r.Method,
 			Enabled:  r.Enabled,
+			Meta:     r.Meta,
 		}
 	)
 
@@ -96,6 +117,7 @@ func (ctrl *ApigwRoute) Update(ctx context.Context, r *request.ApigwRouteUpdate)
 			Method:   r.Method,
 			Group:    uint64(r.Group),
 			Enabled:  r.Enabled,
+			Meta:     r.Meta,
 		}
 	)
 
@@ -112,6 +134,10 @@ func (ctrl *ApigwRoute) Undelete(ctx context.Context, r *request.ApigwRouteUndel
 	return api.OK(), ctrl.svc.UndeleteByID(ctx, r.RouteID)
 }
 
+func (ctrl *ApigwRoute) PurgeCache(ctx context.Context, r *request.ApigwRoutePurgeCache) (interface{}, error) {
+	return api.OK(), ctrl.svc.PurgeCache(ctx, r.RouteID)
+}
+
 func (ctrl *ApigwRoute) makePayload(ctx context.Context, q *types.ApigwRoute, err error) (*routePayload, error) {
 	if err != nil || q == nil {
 		return nil, err
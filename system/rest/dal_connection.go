@@ -173,7 +173,7 @@ func (ctrl DalConnection) federatedNodeToConnection(f *federationTypes.Node) *ty
 		Ownership: f.Contact,
 
 		Config: types.ConnectionConfig{
-			Connection: dal.NewFederatedNodeCOnnection(f.BaseURL, f.PairToken, f.AuthToken),
+			Connection: dal.NewFederatedNodeCOnnection(f.ID, f.SharedNodeID, f.BaseURL, f.PairToken, f.AuthToken),
 		},
 
 		CreatedAt: f.CreatedAt,
@@ -0,0 +1,95 @@
+package handlers
+
+// This file is auto-generated.
+//
+// Changes to this file may cause incorrect behavior and will be lost if
+// the code is regenerated.
+//
+// Definitions file that controls how this file is generated:
+//
+
+import (
+	"context"
+	"github.com/cortezaproject/corteza-server/pkg/api"
+	"github.com/cortezaproject/corteza-server/system/rest/request"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+)
+
+type (
+	// Internal API interface
+	ApigwApiKeyAPI interface {
+		List(context.Context, *request.ApigwApiKeyList) (interface{}, error)
+		Create(context.Context, *request.ApigwApiKeyCreate) (interface{}, error)
+		Delete(context.Context, *request.ApigwApiKeyDelete) (interface{}, error)
+	}
+
+	// HTTP API interface
+	ApigwApiKey struct {
+		List   func(http.ResponseWriter, *http.Request)
+		Create func(http.ResponseWriter, *http.Request)
+		Delete func(http.ResponseWriter, *http.Request)
+	}
+)
+
+func NewApigwApiKey(h ApigwApiKeyAPI) *ApigwApiKey {
+	return &ApigwApiKey{
+		List: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewApigwApiKeyList()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.List(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Create: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewApigwApiKeyCreate()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Create(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+		Delete: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewApigwApiKeyDelete()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Delete(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
+	}
+}
+
+func (h ApigwApiKey) MountRoutes(r chi.Router, middlewares ...func(http.Handler) http.Handler) {
+	r.Group(func(r chi.Router) {
+		r.Use(middlewares...)
+		r.Get("/apigw/api-key/", h.List)
+		r.Post("/apigw/api-key/", h.Create)
+		r.Delete("/apigw/api-key/{keyID}", h.Delete)
+	})
+}
@@ -22,6 +22,7 @@ type (
 		Aggregation(context.Context, *request.ApigwProfilerAggregation) (interface{}, error)
 		Route(context.Context, *request.ApigwProfilerRoute) (interface{}, error)
 		Hit(context.Context, *request.ApigwProfilerHit) (interface{}, error)
+		Export(context.Context, *request.ApigwProfilerExport) (interface{}, error)
 	}
 
 	// HTTP API interface
@@ -29,6 +30,7 @@ type (
 		Aggregation func(http.ResponseWriter, *http.Request)
 		Route       func(http.ResponseWriter, *http.Request)
 		Hit         func(http.ResponseWriter, *http.Request)
+		Export      func(http.ResponseWriter, *http.Request)
 	}
 )
 
@@ -80,6 +82,22 @@ func NewApigwProfiler(h ApigwProfilerAPI) *ApigwProfiler {
 				return
 			}
 
+			api.Send(w, r, value)
+		},
+		Export: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewApigwProfilerExport()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.Export(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
 			api.Send(w, r, value)
 		},
 	}
@@ -91,5 +109,6 @@ func (h ApigwProfiler) MountRoutes(r chi.Router, middlewares ...func(http.Handle
 		r.Get("/apigw/profiler/", h.Aggregation)
 		r.Get("/apigw/profiler/route/{routeID}", h.Route)
 		r.Get("/apigw/profiler/hit/{hitID}", h.Hit)
+		r.Get("/apigw/profiler/export", h.Export)
 	})
 }
@@ -20,21 +20,25 @@ type (
 	// Internal API interface
 	ApigwRouteAPI interface {
 		List(context.Context, *request.ApigwRouteList) (interface{}, error)
+		OpenAPI(context.Context, *request.ApigwRouteOpenAPI) (interface{}, error)
 		Create(context.Context, *request.ApigwRouteCreate) (interface{}, error)
 		Update(context.Context, *request.ApigwRouteUpdate) (interface{}, error)
 		Read(context.Context, *request.ApigwRouteRead) (interface{}, error)
 		Delete(context.Context, *request.ApigwRouteDelete) (interface{}, error)
 		Undelete(context.Context, *request.ApigwRouteUndelete) (interface{}, error)
+		PurgeCache(context.Context, *request.ApigwRoutePurgeCache) (interface{}, error)
 	}
 
 	// HTTP API interface
 	ApigwRoute struct {
-		List     func(http.ResponseWriter, *http.Request)
-		Create   func(http.ResponseWriter, *http.Request)
-		Update   func(http.ResponseWriter, *http.Request)
-		Read     func(http.ResponseWriter, *http.Request)
-		Delete   func(http.ResponseWriter, *http.Request)
-		Undelete func(http.ResponseWriter, *http.Request)
+		List       func(http.ResponseWriter, *http.Request)
+		OpenAPI    func(http.ResponseWriter, *http.Request)
+		Create     func(http.ResponseWriter, *http.Request)
+		Update     func(http.ResponseWriter, *http.Request)
+		Read       func(http.ResponseWriter, *http.Request)
+		Delete     func(http.ResponseWriter, *http.Request)
+		Undelete   func(http.ResponseWriter, *http.Request)
+		PurgeCache func(http.ResponseWriter, *http.Request)
 	}
 )
 
@@ -56,6 +60,22 @@ func NewApigwRoute(h ApigwRouteAPI) *ApigwRoute {
 
 			api.Send(w, r, value)
 		},
+		OpenAPI: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewApigwRouteOpenAPI()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.OpenAPI(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			api.Send(w, r, value)
+		},
 		Create: func(w http.ResponseWriter, r *http.Request) {
 			defer r.Body.Close()
 			params := request.NewApigwRouteCreate()
@@ -134,6 +154,22 @@ func NewApigwRoute(h ApigwRouteAPI) *ApigwRoute {
 				return
 			}
 
+			api.Send(w, r, value)
+		},
+		PurgeCache: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewApigwRoutePurgeCache()
+			if err := params.Fill(r); err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
+			value, err := h.PurgeCache(r.Context(), params)
+			if err != nil {
+				api.Send(w, r, err)
+				return
+			}
+
 			api.Send(w, r, value)
 		},
 	}
@@ -143,10 +179,12 @@ func (h ApigwRoute) MountRoutes(r chi.Router, middlewares ...func(http.Handler)
 	r.Group(func(r chi.Router) {
 		r.Use(middlewares...)
 		r.Get("/apigw/route/", h.List)
+		r.Get("/apigw/route/openapi", h.OpenAPI)
 		r.Post("/apigw/route", h.Create)
 		r.Put("/apigw/route/{routeID}", h.Update)
 		r.Get("/apigw/route/{routeID}", h.Read)
 		r.Delete("/apigw/route/{routeID}", h.Delete)
 		r.Post("/apigw/route/{routeID}/undelete", h.Undelete)
+		r.Delete("/apigw/route/{routeID}/cache", h.PurgeCache)
 	})
 }
@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"context"
+
+	"github.com/cortezaproject/corteza-server/pkg/api"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/cortezaproject/corteza-server/pkg/payload"
+	"github.com/cortezaproject/corteza-server/system/rest/request"
+	"github.com/cortezaproject/corteza-server/system/service"
+	"github.com/cortezaproject/corteza-server/system/types"
+)
+
+type (
+	HolidayCalendar struct {
+		svc holidayCalendarService
+	}
+
+	holidayCalendarSetPayload struct {
+		Filter types.HolidayCalendarFilter `json:"filter"`
+		Set    types.HolidayCalendarSet    `json:"set"`
+	}
+
+	holidayCalendarService interface {
+		FindByID(ctx context.Context, ID uint64) (*types.HolidayCalendar, error)
+		Create(ctx context.Context, new *types.HolidayCalendar) (*types.HolidayCalendar, error)
+		Update(ctx context.Context, upd *types.HolidayCalendar) (*types.HolidayCalendar, error)
+		DeleteByID(ctx context.Context, ID uint64) error
+		UndeleteByID(ctx context.Context, ID uint64) error
+		Search(ctx context.Context, filter types.HolidayCalendarFilter) (types.HolidayCalendarSet, types.HolidayCalendarFilter, error)
+	}
+)
+
+func (HolidayCalendar) New() *HolidayCalendar {
+	return &HolidayCalendar{
+		svc: service.DefaultHolidayCalendar,
+	}
+}
+
+func (ctrl HolidayCalendar) List(ctx context.Context, r *request.HolidayCalendarList) (interface{}, error) {
+	var (
+		err error
+		set types.HolidayCalendarSet
+
+		f = types.HolidayCalendarFilter{
+			HolidayCalendarID: payload.ParseUint64s(r.HolidayCalendarID),
+			Handle:            r.Handle,
+
+			Deleted: filter.State(r.Deleted),
+		}
+	)
+
+	set, f, err = ctrl.svc.Search(ctx, f)
+	return ctrl.makeFilterPayload(ctx, set, f, err)
+}
+
+func (ctrl HolidayCalendar) Create(ctx context.Context, r *request.HolidayCalendarCreate) (interface{}, error) {
+	calendar := &types.HolidayCalendar{
+		Handle:   r.Handle,
+		Meta:     r.Meta,
+		Holidays: r.Holidays,
+	}
+
+	return ctrl.svc.Create(ctx, calendar)
+}
+
+func (ctrl HolidayCalendar) Update(ctx context.Context, r *request.HolidayCalendarUpdate) (interface{}, error) {
+	calendar := &types.HolidayCalendar{
+		ID:       r.HolidayCalendarID,
+		Handle:   r.Handle,
+		Meta:     r.Meta,
+		Holidays: r.Holidays,
+	}
+
+	return ctrl.svc.Update(ctx, calendar)
+}
+
+func (ctrl HolidayCalendar) Read(ctx context.Context, r *request.HolidayCalendarRead) (interface{}, error) {
+	return ctrl.svc.FindByID(ctx, r.HolidayCalendarID)
+}
+
+func (ctrl HolidayCalendar) Delete(ctx context.Context, r *request.HolidayCalendarDelete) (interface{}, error) {
+	return api.OK(), ctrl.svc.DeleteByID(ctx, r.HolidayCalendarID)
+}
+
+func (ctrl HolidayCalendar) Undelete(ctx context.Context, r *request.HolidayCalendarUndelete) (interface{}, error) {
+	return api.OK(), ctrl.svc.UndeleteByID(ctx, r.HolidayCalendarID)
+}
+
+func (ctrl HolidayCalendar) makeFilterPayload(ctx context.Context, cc types.HolidayCalendarSet, f types.HolidayCalendarFilter, err error) (*holidayCalendarSetPayload, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cc) == 0 {
+		cc = make([]*types.HolidayCalendar, 0)
+	}
+
+	return &holidayCalendarSetPayload{Filter: f, Set: cc}, nil
+}
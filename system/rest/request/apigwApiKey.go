@@ -0,0 +1,265 @@
+package request
+
+// This file is auto-generated.
+//
+// Changes to this file may cause incorrect behavior and will be lost if
+// the code is regenerated.
+//
+// Definitions file that controls how this file is generated:
+//
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/cortezaproject/corteza-server/pkg/payload"
+	"github.com/go-chi/chi/v5"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// dummy vars to prevent
+// unused imports complain
+var (
+	_ = chi.URLParam
+	_ = multipart.ErrMessageTooLarge
+	_ = payload.ParseUint64s
+	_ = strings.ToLower
+	_ = io.EOF
+	_ = fmt.Errorf
+	_ = json.NewEncoder
+)
+
+type (
+	// Internal API interface
+	ApigwApiKeyList struct {
+		// UserID GET parameter
+		//
+		// Owner of the keys, defaults to the current user
+		UserID uint64 `json:",string"`
+	}
+
+	ApigwApiKeyCreate struct {
+		// UserID POST parameter
+		//
+		// Owner of the key, defaults to the current user
+		UserID uint64 `json:",string"`
+
+		// Label POST parameter
+		//
+		// Key label
+		Label string
+
+		// ExpiresAt POST parameter
+		//
+		// Date and time when key expires
+		ExpiresAt *time.Time
+	}
+
+	ApigwApiKeyDelete struct {
+		// KeyID PATH parameter
+		//
+		// API key ID
+		KeyID uint64 `json:",string"`
+
+		// UserID GET parameter
+		//
+		// Owner of the key, defaults to the current user
+		UserID uint64 `json:",string"`
+	}
+)
+
+// NewApigwApiKeyList request
+func NewApigwApiKeyList() *ApigwApiKeyList {
+	return &ApigwApiKeyList{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ApigwApiKeyList) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"userID": r.UserID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ApigwApiKeyList) GetUserID() uint64 {
+	return r.UserID
+}
+
+// Fill processes request and fills internal variables
+func (r *ApigwApiKeyList) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["userID"]; ok && len(val) > 0 {
+			r.UserID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return err
+}
+
+// NewApigwApiKeyCreate request
+func NewApigwApiKeyCreate() *ApigwApiKeyCreate {
+	return &ApigwApiKeyCreate{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ApigwApiKeyCreate) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"userID":    r.UserID,
+		"label":     r.Label,
+		"expiresAt": r.ExpiresAt,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ApigwApiKeyCreate) GetUserID() uint64 {
+	return r.UserID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ApigwApiKeyCreate) GetLabel() string {
+	return r.Label
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ApigwApiKeyCreate) GetExpiresAt() *time.Time {
+	return r.ExpiresAt
+}
+
+// Fill processes request and fills internal variables
+func (r *ApigwApiKeyCreate) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+			if val, ok := req.MultipartForm.Value["userID"]; ok && len(val) > 0 {
+				r.UserID, err = payload.ParseUint64(val[0]), nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["label"]; ok && len(val) > 0 {
+				r.Label, err = val[0], nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["expiresAt"]; ok && len(val) > 0 {
+				r.ExpiresAt, err = payload.ParseISODatePtrWithErr(val[0])
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		if val, ok := req.Form["userID"]; ok && len(val) > 0 {
+			r.UserID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["label"]; ok && len(val) > 0 {
+			r.Label, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["expiresAt"]; ok && len(val) > 0 {
+			r.ExpiresAt, err = payload.ParseISODatePtrWithErr(val[0])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return err
+}
+
+// NewApigwApiKeyDelete request
+func NewApigwApiKeyDelete() *ApigwApiKeyDelete {
+	return &ApigwApiKeyDelete{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ApigwApiKeyDelete) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"keyID":  r.KeyID,
+		"userID": r.UserID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ApigwApiKeyDelete) GetKeyID() uint64 {
+	return r.KeyID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ApigwApiKeyDelete) GetUserID() uint64 {
+	return r.UserID
+}
+
+// Fill processes request and fills internal variables
+func (r *ApigwApiKeyDelete) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["userID"]; ok && len(val) > 0 {
+			r.UserID, err = payload.ParseUint64(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "keyID")
+		r.KeyID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
@@ -88,6 +88,28 @@ type (
 		// Hit ID
 		HitID string
 	}
+
+	ApigwProfilerExport struct {
+		// Format GET parameter
+		//
+		// Export format (har, otel)
+		Format string
+
+		// Path GET parameter
+		//
+		// Filter by request path
+		Path string
+
+		// HitID GET parameter
+		//
+		// Filter by hit ID
+		HitID string
+
+		// Limit GET parameter
+		//
+		// Export only the newest hits
+		Limit uint
+	}
 )
 
 // NewApigwProfilerAggregation request
@@ -284,3 +306,74 @@ func (r *ApigwProfilerHit) Fill(req *http.Request) (err error) {
 
 	return err
 }
+
+// NewApigwProfilerExport request
+func NewApigwProfilerExport() *ApigwProfilerExport {
+	return &ApigwProfilerExport{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ApigwProfilerExport) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"format": r.Format,
+		"path":   r.Path,
+		"hitID":  r.HitID,
+		"limit":  r.Limit,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ApigwProfilerExport) GetFormat() string {
+	return r.Format
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ApigwProfilerExport) GetPath() string {
+	return r.Path
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ApigwProfilerExport) GetHitID() string {
+	return r.HitID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ApigwProfilerExport) GetLimit() uint {
+	return r.Limit
+}
+
+// Fill processes request and fills internal variables
+func (r *ApigwProfilerExport) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["format"]; ok && len(val) > 0 {
+			r.Format, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["path"]; ok && len(val) > 0 {
+			r.Path, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["hitID"]; ok && len(val) > 0 {
+			r.HitID, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["limit"]; ok && len(val) > 0 {
+			r.Limit, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return err
+}
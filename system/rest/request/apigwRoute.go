@@ -77,6 +77,9 @@ type (
 		Sort string
 	}
 
+	ApigwRouteOpenAPI struct {
+	}
+
 	ApigwRouteCreate struct {
 		// Endpoint POST parameter
 		//
@@ -156,6 +159,13 @@ type (
 		// Route ID
 		RouteID uint64 `json:",string"`
 	}
+
+	ApigwRoutePurgeCache struct {
+		// RouteID PATH parameter
+		//
+		// Route ID
+		RouteID uint64 `json:",string"`
+	}
 )
 
 // NewApigwRouteList request
@@ -287,6 +297,22 @@ func (r *ApigwRouteList) Fill(req *http.Request) (err error) {
 	return err
 }
 
+// NewApigwRouteOpenAPI request
+func NewApigwRouteOpenAPI() *ApigwRouteOpenAPI {
+	return &ApigwRouteOpenAPI{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ApigwRouteOpenAPI) Auditable() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+// Fill processes request and fills internal variables
+func (r *ApigwRouteOpenAPI) Fill(req *http.Request) (err error) {
+
+	return err
+}
+
 // NewApigwRouteCreate request
 func NewApigwRouteCreate() *ApigwRouteCreate {
 	return &ApigwRouteCreate{}
@@ -719,3 +745,38 @@ func (r *ApigwRouteUndelete) Fill(req *http.Request) (err error) {
 
 	return err
 }
+
+// NewApigwRoutePurgeCache request
+func NewApigwRoutePurgeCache() *ApigwRoutePurgeCache {
+	return &ApigwRoutePurgeCache{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ApigwRoutePurgeCache) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"routeID": r.RouteID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r ApigwRoutePurgeCache) GetRouteID() uint64 {
+	return r.RouteID
+}
+
+// Fill processes request and fills internal variables
+func (r *ApigwRoutePurgeCache) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "routeID")
+		r.RouteID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
@@ -0,0 +1,550 @@
+package request
+
+// This file is auto-generated.
+//
+// Changes to this file may cause incorrect behavior and will be lost if
+// the code is regenerated.
+//
+// Definitions file that controls how this file is generated:
+//
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/cortezaproject/corteza-server/pkg/payload"
+	"github.com/cortezaproject/corteza-server/system/types"
+	"github.com/go-chi/chi/v5"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+)
+
+// dummy vars to prevent
+// unused imports complain
+var (
+	_ = chi.URLParam
+	_ = multipart.ErrMessageTooLarge
+	_ = payload.ParseUint64s
+	_ = strings.ToLower
+	_ = io.EOF
+	_ = fmt.Errorf
+	_ = json.NewEncoder
+)
+
+type (
+	// Internal API interface
+	HolidayCalendarList struct {
+		// HolidayCalendarID GET parameter
+		//
+		// Filter by holiday calendar ID
+		HolidayCalendarID []string
+
+		// Handle GET parameter
+		//
+		// Filter by handle
+		Handle string
+
+		// Deleted GET parameter
+		//
+		// Exclude (0, default), include (1) or return only (2) deleted holiday calendars
+		Deleted uint
+	}
+
+	HolidayCalendarCreate struct {
+		// Handle POST parameter
+		//
+		// Handle
+		Handle string
+
+		// Meta POST parameter
+		//
+		// Meta
+		Meta types.HolidayCalendarMeta
+
+		// Holidays POST parameter
+		//
+		// Holidays (YYYY-MM-DD or MM-DD for holidays that repeat every year)
+		Holidays types.HolidaySet
+	}
+
+	HolidayCalendarUpdate struct {
+		// HolidayCalendarID PATH parameter
+		//
+		// Holiday calendar ID
+		HolidayCalendarID uint64 `json:",string"`
+
+		// Handle POST parameter
+		//
+		// Handle
+		Handle string
+
+		// Meta POST parameter
+		//
+		// Meta
+		Meta types.HolidayCalendarMeta
+
+		// Holidays POST parameter
+		//
+		// Holidays (YYYY-MM-DD or MM-DD for holidays that repeat every year)
+		Holidays types.HolidaySet
+	}
+
+	HolidayCalendarRead struct {
+		// HolidayCalendarID PATH parameter
+		//
+		// Holiday calendar ID
+		HolidayCalendarID uint64 `json:",string"`
+	}
+
+	HolidayCalendarDelete struct {
+		// HolidayCalendarID PATH parameter
+		//
+		// Holiday calendar ID
+		HolidayCalendarID uint64 `json:",string"`
+	}
+
+	HolidayCalendarUndelete struct {
+		// HolidayCalendarID PATH parameter
+		//
+		// Holiday calendar ID
+		HolidayCalendarID uint64 `json:",string"`
+	}
+)
+
+// NewHolidayCalendarList request
+func NewHolidayCalendarList() *HolidayCalendarList {
+	return &HolidayCalendarList{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r HolidayCalendarList) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"holidayCalendarID": r.HolidayCalendarID,
+		"handle":            r.Handle,
+		"deleted":           r.Deleted,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r HolidayCalendarList) GetHolidayCalendarID() []string {
+	return r.HolidayCalendarID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r HolidayCalendarList) GetHandle() string {
+	return r.Handle
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r HolidayCalendarList) GetDeleted() uint {
+	return r.Deleted
+}
+
+// Fill processes request and fills internal variables
+func (r *HolidayCalendarList) Fill(req *http.Request) (err error) {
+
+	{
+		// GET params
+		tmp := req.URL.Query()
+
+		if val, ok := tmp["holidayCalendarID[]"]; ok {
+			r.HolidayCalendarID, err = val, nil
+			if err != nil {
+				return err
+			}
+		} else if val, ok := tmp["holidayCalendarID"]; ok {
+			r.HolidayCalendarID, err = val, nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["handle"]; ok && len(val) > 0 {
+			r.Handle, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+		if val, ok := tmp["deleted"]; ok && len(val) > 0 {
+			r.Deleted, err = payload.ParseUint(val[0]), nil
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return err
+}
+
+// NewHolidayCalendarCreate request
+func NewHolidayCalendarCreate() *HolidayCalendarCreate {
+	return &HolidayCalendarCreate{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r HolidayCalendarCreate) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"handle":   r.Handle,
+		"meta":     r.Meta,
+		"holidays": r.Holidays,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r HolidayCalendarCreate) GetHandle() string {
+	return r.Handle
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r HolidayCalendarCreate) GetMeta() types.HolidayCalendarMeta {
+	return r.Meta
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r HolidayCalendarCreate) GetHolidays() types.HolidaySet {
+	return r.Holidays
+}
+
+// Fill processes request and fills internal variables
+func (r *HolidayCalendarCreate) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+			if val, ok := req.MultipartForm.Value["handle"]; ok && len(val) > 0 {
+				r.Handle, err = val[0], nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["meta[]"]; ok {
+				r.Meta, err = types.ParseHolidayCalendarMeta(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["meta"]; ok {
+				r.Meta, err = types.ParseHolidayCalendarMeta(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["holidays[]"]; ok {
+				r.Holidays, err = types.ParseHolidaySet(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["holidays"]; ok {
+				r.Holidays, err = types.ParseHolidaySet(val)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		if val, ok := req.Form["handle"]; ok && len(val) > 0 {
+			r.Handle, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["meta[]"]; ok {
+			r.Meta, err = types.ParseHolidayCalendarMeta(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["meta"]; ok {
+			r.Meta, err = types.ParseHolidayCalendarMeta(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["holidays[]"]; ok {
+			r.Holidays, err = types.ParseHolidaySet(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["holidays"]; ok {
+			r.Holidays, err = types.ParseHolidaySet(val)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return err
+}
+
+// NewHolidayCalendarUpdate request
+func NewHolidayCalendarUpdate() *HolidayCalendarUpdate {
+	return &HolidayCalendarUpdate{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r HolidayCalendarUpdate) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"holidayCalendarID": r.HolidayCalendarID,
+		"handle":            r.Handle,
+		"meta":              r.Meta,
+		"holidays":          r.Holidays,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r HolidayCalendarUpdate) GetHolidayCalendarID() uint64 {
+	return r.HolidayCalendarID
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r HolidayCalendarUpdate) GetHandle() string {
+	return r.Handle
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r HolidayCalendarUpdate) GetMeta() types.HolidayCalendarMeta {
+	return r.Meta
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r HolidayCalendarUpdate) GetHolidays() types.HolidaySet {
+	return r.Holidays
+}
+
+// Fill processes request and fills internal variables
+func (r *HolidayCalendarUpdate) Fill(req *http.Request) (err error) {
+
+	if strings.HasPrefix(strings.ToLower(req.Header.Get("content-type")), "application/json") {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return fmt.Errorf("error parsing http request body: %w", err)
+		}
+	}
+
+	{
+		// Caching 32MB to memory, the rest to disk
+		if err = req.ParseMultipartForm(32 << 20); err != nil && err != http.ErrNotMultipart {
+			return err
+		} else if err == nil {
+			// Multipart params
+
+			if val, ok := req.MultipartForm.Value["handle"]; ok && len(val) > 0 {
+				r.Handle, err = val[0], nil
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["meta[]"]; ok {
+				r.Meta, err = types.ParseHolidayCalendarMeta(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["meta"]; ok {
+				r.Meta, err = types.ParseHolidayCalendarMeta(val)
+				if err != nil {
+					return err
+				}
+			}
+
+			if val, ok := req.MultipartForm.Value["holidays[]"]; ok {
+				r.Holidays, err = types.ParseHolidaySet(val)
+				if err != nil {
+					return err
+				}
+			} else if val, ok := req.MultipartForm.Value["holidays"]; ok {
+				r.Holidays, err = types.ParseHolidaySet(val)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	{
+		if err = req.ParseForm(); err != nil {
+			return err
+		}
+
+		// POST params
+
+		if val, ok := req.Form["handle"]; ok && len(val) > 0 {
+			r.Handle, err = val[0], nil
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["meta[]"]; ok {
+			r.Meta, err = types.ParseHolidayCalendarMeta(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["meta"]; ok {
+			r.Meta, err = types.ParseHolidayCalendarMeta(val)
+			if err != nil {
+				return err
+			}
+		}
+
+		if val, ok := req.Form["holidays[]"]; ok {
+			r.Holidays, err = types.ParseHolidaySet(val)
+			if err != nil {
+				return err
+			}
+		} else if val, ok := req.Form["holidays"]; ok {
+			r.Holidays, err = types.ParseHolidaySet(val)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "holidayCalendarID")
+		r.HolidayCalendarID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewHolidayCalendarRead request
+func NewHolidayCalendarRead() *HolidayCalendarRead {
+	return &HolidayCalendarRead{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r HolidayCalendarRead) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"holidayCalendarID": r.HolidayCalendarID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r HolidayCalendarRead) GetHolidayCalendarID() uint64 {
+	return r.HolidayCalendarID
+}
+
+// Fill processes request and fills internal variables
+func (r *HolidayCalendarRead) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "holidayCalendarID")
+		r.HolidayCalendarID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewHolidayCalendarDelete request
+func NewHolidayCalendarDelete() *HolidayCalendarDelete {
+	return &HolidayCalendarDelete{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r HolidayCalendarDelete) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"holidayCalendarID": r.HolidayCalendarID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r HolidayCalendarDelete) GetHolidayCalendarID() uint64 {
+	return r.HolidayCalendarID
+}
+
+// Fill processes request and fills internal variables
+func (r *HolidayCalendarDelete) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "holidayCalendarID")
+		r.HolidayCalendarID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
+
+// NewHolidayCalendarUndelete request
+func NewHolidayCalendarUndelete() *HolidayCalendarUndelete {
+	return &HolidayCalendarUndelete{}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r HolidayCalendarUndelete) Auditable() map[string]interface{} {
+	return map[string]interface{}{
+		"holidayCalendarID": r.HolidayCalendarID,
+	}
+}
+
+// Auditable returns all auditable/loggable parameters
+func (r HolidayCalendarUndelete) GetHolidayCalendarID() uint64 {
+	return r.HolidayCalendarID
+}
+
+// Fill processes request and fills internal variables
+func (r *HolidayCalendarUndelete) Fill(req *http.Request) (err error) {
+
+	{
+		var val string
+		// path params
+
+		val = chi.URLParam(req, "holidayCalendarID")
+		r.HolidayCalendarID, err = payload.ParseUint64(val), nil
+		if err != nil {
+			return err
+		}
+
+	}
+
+	return err
+}
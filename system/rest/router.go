@@ -33,6 +33,7 @@ func MountRoutes() func(r chi.Router) {
 			handlers.NewUser(User{}.New()).MountRoutes(r)
 			handlers.NewDalConnection(DalConnection{}.New()).MountRoutes(r)
 			handlers.NewDalSensitivityLevel(SensitivityLevel{}.New()).MountRoutes(r)
+			handlers.NewHolidayCalendar(HolidayCalendar{}.New()).MountRoutes(r)
 			handlers.NewDalDriver(DalDriver{}.New()).MountRoutes(r)
 			handlers.NewRole(Role{}.New()).MountRoutes(r)
 			handlers.NewPermissions(Permissions{}.New()).MountRoutes(r)
@@ -47,6 +48,7 @@ func MountRoutes() func(r chi.Router) {
 			handlers.NewApigwRoute(ApigwRoute{}.New()).MountRoutes(r)
 			handlers.NewApigwFilter(ApigwFilter{}.New()).MountRoutes(r)
 			handlers.NewApigwProfiler(ApigwProfiler{}.New()).MountRoutes(r)
+			handlers.NewApigwApiKey(ApigwApiKey{}.New()).MountRoutes(r)
 		})
 	}
 }
@@ -334,6 +334,11 @@ func (svc accessControl) List() (out []map[string]string) {
 			"any":  types.ComponentRbacResource(),
 			"op":   "dal-sensitivity-level.manage",
 		},
+		{
+			"type": types.ComponentResourceType,
+			"any":  types.ComponentRbacResource(),
+			"op":   "holiday-calendar.manage",
+		},
 		{
 			"type": types.ComponentResourceType,
 			"any":  types.ComponentRbacResource(),
@@ -882,6 +887,14 @@ func (svc accessControl) CanManageDalSensitivityLevel(ctx context.Context) bool
 	return svc.can(ctx, "dal-sensitivity-level.manage", r)
 }
 
+// CanManageHolidayCalendar checks if current user can can manage holiday calendars
+//
+// This function is auto-generated
+func (svc accessControl) CanManageHolidayCalendar(ctx context.Context) bool {
+	r := &types.Component{}
+	return svc.can(ctx, "holiday-calendar.manage", r)
+}
+
 // CanCreateApplication checks if current user can create applications
 //
 // This function is auto-generated
@@ -1021,6 +1034,8 @@ func rbacResourceValidator(r string, oo ...string) error {
 		return rbacDalConnectionResourceValidator(r, oo...)
 	case types.DalSensitivityLevelResourceType:
 		return rbacDalSensitivityLevelResourceValidator(r, oo...)
+	case types.HolidayCalendarResourceType:
+		return rbacHolidayCalendarResourceValidator(r, oo...)
 	case types.ComponentResourceType:
 		return rbacComponentResourceValidator(r, oo...)
 	}
@@ -1108,6 +1123,8 @@ func rbacResourceOperations(r string) map[string]bool {
 		}
 	case types.DalSensitivityLevelResourceType:
 		return map[string]bool{}
+	case types.HolidayCalendarResourceType:
+		return map[string]bool{}
 	case types.ComponentResourceType:
 		return map[string]bool{
 			"grant":                        true,
@@ -1123,6 +1140,7 @@ func rbacResourceOperations(r string) map[string]bool {
 			"dal-connection.create":        true,
 			"dal-connections.search":       true,
 			"dal-sensitivity-level.manage": true,
+			"holiday-calendar.manage":      true,
 			"application.create":           true,
 			"applications.search":          true,
 			"application.flag.self":        true,
@@ -1627,6 +1645,50 @@ func rbacDalSensitivityLevelResourceValidator(r string, oo ...string) error {
 	return nil
 }
 
+// rbacHolidayCalendarResourceValidator checks validity of RBAC resource and operations
+//
+// Can be called without operations to check for validity of resource string only
+//
+// This function is auto-generated
+func rbacHolidayCalendarResourceValidator(r string, oo ...string) error {
+	if !strings.HasPrefix(r, types.HolidayCalendarResourceType) {
+		// expecting resource to always include path
+		return fmt.Errorf("invalid resource type")
+	}
+
+	defOps := rbacResourceOperations(r)
+	for _, o := range oo {
+		if !defOps[o] {
+			return fmt.Errorf("invalid operation '%s' for holidayCalendar resource", o)
+		}
+	}
+
+	const sep = "/"
+	var (
+		pp  = strings.Split(strings.Trim(r[len(types.HolidayCalendarResourceType):], sep), sep)
+		prc = []string{
+			"ID",
+		}
+	)
+
+	if len(pp) != len(prc) {
+		return fmt.Errorf("invalid resource path structure")
+	}
+
+	for i := 0; i < len(pp); i++ {
+		if pp[i] != "*" {
+			if i > 0 && pp[i-1] == "*" {
+				return fmt.Errorf("invalid path wildcard level (%d) for holidayCalendar resource", i)
+			}
+
+			if _, err := cast.ToUint64E(pp[i]); err != nil {
+				return fmt.Errorf("invalid reference for %s: '%s'", prc[i], pp[i])
+			}
+		}
+	}
+	return nil
+}
+
 // rbacComponentResourceValidator checks validity of RBAC resource and operations
 //
 // Can be called without operations to check for validity of resource string only
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/actionlog"
+	"github.com/cortezaproject/corteza-server/pkg/apigw/filter"
+	a "github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/store"
+	"github.com/cortezaproject/corteza-server/system/types"
+)
+
+type (
+	apigwApiKey struct {
+		actionlog actionlog.Recorder
+		store     store.Storer
+		ac        apigwApiKeyAccessController
+	}
+
+	apigwApiKeyAccessController interface {
+		CanUpdateUser(context.Context, *types.User) bool
+	}
+)
+
+// ApiKey initializes integration gateway API key service
+//
+// API keys are kept as credentials of the user they authenticate
+// (see apiKey prefilter); users can manage their own keys
+// and keys of the users they are allowed to update
+func ApiKey() *apigwApiKey {
+	return &apigwApiKey{
+		ac:        DefaultAccessControl,
+		actionlog: DefaultActionlog,
+		store:     DefaultStore,
+	}
+}
+
+// Search returns valid API keys of the user
+func (svc *apigwApiKey) Search(ctx context.Context, userID uint64) (set types.CredentialSet, err error) {
+	var (
+		akProps = &apigwApiKeyActionProps{}
+	)
+
+	err = func() (err error) {
+		var u *types.User
+		if u, err = svc.lookupUser(ctx, akProps, userID); err != nil {
+			return
+		}
+
+		set, _, err = store.SearchCredentials(ctx, svc.store, types.CredentialFilter{
+			OwnerID: u.ID,
+			Kind:    filter.ApiKeyCredentialsKind,
+		})
+
+		return
+	}()
+
+	return set, svc.recordAction(ctx, akProps, ApigwApiKeyActionSearch, err)
+}
+
+// Create generates new API key for the user
+//
+// Key is returned only once, only its hash is stored
+func (svc *apigwApiKey) Create(ctx context.Context, userID uint64, label string, expiresAt *time.Time) (key string, c *types.Credential, err error) {
+	var (
+		akProps = &apigwApiKeyActionProps{}
+	)
+
+	err = func() (err error) {
+		var u *types.User
+		if u, err = svc.lookupUser(ctx, akProps, userID); err != nil {
+			return
+		}
+
+		c = &types.Credential{
+			ID:        nextID(),
+			OwnerID:   u.ID,
+			Label:     label,
+			Kind:      filter.ApiKeyCredentialsKind,
+			ExpiresAt: expiresAt,
+			CreatedAt: *now(),
+		}
+
+		if key, c.Credentials, err = filter.MakeApiKey(c.ID); err != nil {
+			return
+		}
+
+		akProps.setApiKey(c)
+
+		return store.CreateCredential(ctx, svc.store, c)
+	}()
+
+	return key, c, svc.recordAction(ctx, akProps, ApigwApiKeyActionCreate, err)
+}
+
+// DeleteByID revokes API key of the user
+func (svc *apigwApiKey) DeleteByID(ctx context.Context, userID, keyID uint64) (err error) {
+	var (
+		akProps = &apigwApiKeyActionProps{}
+	)
+
+	err = func() (err error) {
+		var (
+			u *types.User
+			c *types.Credential
+		)
+
+		if keyID == 0 {
+			return ApigwApiKeyErrInvalidID()
+		}
+
+		if u, err = svc.lookupUser(ctx, akProps, userID); err != nil {
+			return
+		}
+
+		if c, err = store.LookupCredentialByID(ctx, svc.store, keyID); err != nil {
+			return ApigwApiKeyErrNotFound(akProps).Wrap(err)
+		}
+
+		if c.Kind != filter.ApiKeyCredentialsKind || c.OwnerID != u.ID || c.DeletedAt != nil {
+			return ApigwApiKeyErrNotFound(akProps)
+		}
+
+		akProps.setApiKey(c)
+
+		c.DeletedAt = now()
+		return store.UpdateCredential(ctx, svc.store, c)
+	}()
+
+	return svc.recordAction(ctx, akProps, ApigwApiKeyActionDelete, err)
+}
+
+// lookupUser loads the owner of the keys and checks if keys can be managed
+//
+// Keys of the current user are managed when user is not set
+func (svc *apigwApiKey) lookupUser(ctx context.Context, akProps *apigwApiKeyActionProps, userID uint64) (u *types.User, err error) {
+	var (
+		identity = a.GetIdentityFromContext(ctx).Identity()
+	)
+
+	if userID == 0 {
+		userID = identity
+	}
+
+	if userID == 0 {
+		return nil, ApigwApiKeyErrNotAllowedToManage(akProps)
+	}
+
+	if u, err = store.LookupUserByID(ctx, svc.store, userID); err != nil {
+		return nil, ApigwApiKeyErrUserNotFound(akProps).Wrap(err)
+	}
+
+	akProps.setUser(u)
+
+	if u.ID != identity && !svc.ac.CanUpdateUser(ctx, u) {
+		return nil, ApigwApiKeyErrNotAllowedToManage(akProps)
+	}
+
+	return u, nil
+}
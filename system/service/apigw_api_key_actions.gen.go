@@ -0,0 +1,462 @@
+package service
+
+// This file is auto-generated.
+//
+// Changes to this file may cause incorrect behavior and will be lost if
+// the code is regenerated.
+//
+// Definitions file that controls how this file is generated:
+// system/service/apigw_api_key_actions.yaml
+
+import (
+	"context"
+	"fmt"
+	"github.com/cortezaproject/corteza-server/pkg/actionlog"
+	"github.com/cortezaproject/corteza-server/pkg/errors"
+	"github.com/cortezaproject/corteza-server/pkg/locale"
+	"github.com/cortezaproject/corteza-server/system/types"
+	"strings"
+	"time"
+)
+
+type (
+	apigwApiKeyActionProps struct {
+		apiKey *types.Credential
+		user   *types.User
+	}
+
+	apigwApiKeyAction struct {
+		timestamp time.Time
+		resource  string
+		action    string
+		log       string
+		severity  actionlog.Severity
+
+		// prefix for error when action fails
+		errorMessage string
+
+		props *apigwApiKeyActionProps
+	}
+
+	apigwApiKeyLogMetaKey   struct{}
+	apigwApiKeyPropsMetaKey struct{}
+)
+
+var (
+	// just a placeholder to cover template cases w/o fmt package use
+	_ = fmt.Println
+)
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Props methods
+// setApiKey updates apigwApiKeyActionProps's apiKey
+//
+// Allows method chaining
+//
+// This function is auto-generated.
+//
+func (p *apigwApiKeyActionProps) setApiKey(apiKey *types.Credential) *apigwApiKeyActionProps {
+	p.apiKey = apiKey
+	return p
+}
+
+// setUser updates apigwApiKeyActionProps's user
+//
+// Allows method chaining
+//
+// This function is auto-generated.
+//
+func (p *apigwApiKeyActionProps) setUser(user *types.User) *apigwApiKeyActionProps {
+	p.user = user
+	return p
+}
+
+// Serialize converts apigwApiKeyActionProps to actionlog.Meta
+//
+// This function is auto-generated.
+//
+func (p apigwApiKeyActionProps) Serialize() actionlog.Meta {
+	var (
+		m = make(actionlog.Meta)
+	)
+
+	if p.apiKey != nil {
+		m.Set("apiKey.label", p.apiKey.Label, true)
+		m.Set("apiKey.ID", p.apiKey.ID, true)
+	}
+	if p.user != nil {
+		m.Set("user.handle", p.user.Handle, true)
+		m.Set("user.email", p.user.Email, true)
+		m.Set("user.ID", p.user.ID, true)
+	}
+
+	return m
+}
+
+// tr translates string and replaces meta value placeholder with values
+//
+// This function is auto-generated.
+//
+func (p apigwApiKeyActionProps) Format(in string, err error) string {
+	var (
+		pairs = []string{"{{err}}"}
+		// first non-empty string
+		fns = func(ii ...interface{}) string {
+			for _, i := range ii {
+				if s := fmt.Sprintf("%v", i); len(s) > 0 {
+					return s
+				}
+			}
+
+			return ""
+		}
+	)
+
+	if err != nil {
+		pairs = append(pairs, err.Error())
+	} else {
+		pairs = append(pairs, "nil")
+	}
+
+	if p.apiKey != nil {
+		// replacement for "{{apiKey}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{apiKey}}",
+			fns(
+				p.apiKey.Label,
+				p.apiKey.ID,
+			),
+		)
+		pairs = append(pairs, "{{apiKey.label}}", fns(p.apiKey.Label))
+		pairs = append(pairs, "{{apiKey.ID}}", fns(p.apiKey.ID))
+	}
+
+	if p.user != nil {
+		// replacement for "{{user}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{user}}",
+			fns(
+				p.user.Handle,
+				p.user.Email,
+				p.user.ID,
+			),
+		)
+		pairs = append(pairs, "{{user.handle}}", fns(p.user.Handle))
+		pairs = append(pairs, "{{user.email}}", fns(p.user.Email))
+		pairs = append(pairs, "{{user.ID}}", fns(p.user.ID))
+	}
+	return strings.NewReplacer(pairs...).Replace(in)
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Action methods
+
+// String returns loggable description as string
+//
+// This function is auto-generated.
+//
+func (a *apigwApiKeyAction) String() string {
+	var props = &apigwApiKeyActionProps{}
+
+	if a.props != nil {
+		props = a.props
+	}
+
+	return props.Format(a.log, nil)
+}
+
+func (e *apigwApiKeyAction) ToAction() *actionlog.Action {
+	return &actionlog.Action{
+		Resource:    e.resource,
+		Action:      e.action,
+		Severity:    e.severity,
+		Description: e.String(),
+		Meta:        e.props.Serialize(),
+	}
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Action constructors
+
+// ApigwApiKeyActionSearch returns "system:apigw-api-key.search" action
+//
+// This function is auto-generated.
+//
+func ApigwApiKeyActionSearch(props ...*apigwApiKeyActionProps) *apigwApiKeyAction {
+	a := &apigwApiKeyAction{
+		timestamp: time.Now(),
+		resource:  "system:apigw-api-key",
+		action:    "search",
+		log:       "searched for API keys of {{user}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// ApigwApiKeyActionCreate returns "system:apigw-api-key.create" action
+//
+// This function is auto-generated.
+//
+func ApigwApiKeyActionCreate(props ...*apigwApiKeyActionProps) *apigwApiKeyAction {
+	a := &apigwApiKeyAction{
+		timestamp: time.Now(),
+		resource:  "system:apigw-api-key",
+		action:    "create",
+		log:       "created API key {{apiKey}} for {{user}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// ApigwApiKeyActionDelete returns "system:apigw-api-key.delete" action
+//
+// This function is auto-generated.
+//
+func ApigwApiKeyActionDelete(props ...*apigwApiKeyActionProps) *apigwApiKeyAction {
+	a := &apigwApiKeyAction{
+		timestamp: time.Now(),
+		resource:  "system:apigw-api-key",
+		action:    "delete",
+		log:       "deleted API key {{apiKey}} of {{user}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Error constructors
+
+// ApigwApiKeyErrGeneric returns "system:apigw-api-key.generic" as *errors.Error
+//
+// This function is auto-generated.
+//
+func ApigwApiKeyErrGeneric(mm ...*apigwApiKeyActionProps) *errors.Error {
+	var p = &apigwApiKeyActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("failed to complete request due to internal error", nil),
+
+		errors.Meta("type", "generic"),
+		errors.Meta("resource", "system:apigw-api-key"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(apigwApiKeyLogMetaKey{}, "{err}"),
+		errors.Meta(apigwApiKeyPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "apigwApiKey.errors.generic"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ApigwApiKeyErrNotFound returns "system:apigw-api-key.notFound" as *errors.Error
+//
+// This function is auto-generated.
+//
+func ApigwApiKeyErrNotFound(mm ...*apigwApiKeyActionProps) *errors.Error {
+	var p = &apigwApiKeyActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("API key not found", nil),
+
+		errors.Meta("type", "notFound"),
+		errors.Meta("resource", "system:apigw-api-key"),
+
+		errors.Meta(apigwApiKeyPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "apigwApiKey.errors.notFound"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ApigwApiKeyErrInvalidID returns "system:apigw-api-key.invalidID" as *errors.Error
+//
+// This function is auto-generated.
+//
+func ApigwApiKeyErrInvalidID(mm ...*apigwApiKeyActionProps) *errors.Error {
+	var p = &apigwApiKeyActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid ID", nil),
+
+		errors.Meta("type", "invalidID"),
+		errors.Meta("resource", "system:apigw-api-key"),
+
+		errors.Meta(apigwApiKeyPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "apigwApiKey.errors.invalidID"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ApigwApiKeyErrUserNotFound returns "system:apigw-api-key.userNotFound" as *errors.Error
+//
+// This function is auto-generated.
+//
+func ApigwApiKeyErrUserNotFound(mm ...*apigwApiKeyActionProps) *errors.Error {
+	var p = &apigwApiKeyActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("user not found", nil),
+
+		errors.Meta("type", "userNotFound"),
+		errors.Meta("resource", "system:apigw-api-key"),
+
+		errors.Meta(apigwApiKeyPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "apigwApiKey.errors.userNotFound"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// ApigwApiKeyErrNotAllowedToManage returns "system:apigw-api-key.notAllowedToManage" as *errors.Error
+//
+// This function is auto-generated.
+//
+func ApigwApiKeyErrNotAllowedToManage(mm ...*apigwApiKeyActionProps) *errors.Error {
+	var p = &apigwApiKeyActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("not allowed to manage API keys of this user", nil),
+
+		errors.Meta("type", "notAllowedToManage"),
+		errors.Meta("resource", "system:apigw-api-key"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(apigwApiKeyLogMetaKey{}, "failed to manage API keys of {{user}}; insufficient permissions"),
+		errors.Meta(apigwApiKeyPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "apigwApiKey.errors.notAllowedToManage"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+
+// recordAction is a service helper function wraps function that can return error
+//
+// It will wrap unrecognized/internal errors with generic errors.
+//
+// This function is auto-generated.
+//
+func (svc apigwApiKey) recordAction(ctx context.Context, props *apigwApiKeyActionProps, actionFn func(...*apigwApiKeyActionProps) *apigwApiKeyAction, err error) error {
+	if svc.actionlog == nil || actionFn == nil {
+		// action log disabled or no action fn passed, return error as-is
+		return err
+	} else if err == nil {
+		// action completed w/o error, record it
+		svc.actionlog.Record(ctx, actionFn(props).ToAction())
+		return nil
+	}
+
+	a := actionFn(props).ToAction()
+
+	// Extracting error information and recording it as action
+	a.Error = err.Error()
+
+	switch c := err.(type) {
+	case *errors.Error:
+		m := c.Meta()
+
+		a.Error = err.Error()
+		a.Severity = actionlog.Severity(m.AsInt("severity"))
+		a.Description = props.Format(m.AsString(apigwApiKeyLogMetaKey{}), err)
+
+		if p, has := m[apigwApiKeyPropsMetaKey{}]; has {
+			a.Meta = p.(*apigwApiKeyActionProps).Serialize()
+		}
+
+		svc.actionlog.Record(ctx, a)
+	default:
+		svc.actionlog.Record(ctx, a)
+	}
+
+	// Original error is passed on
+	return err
+}
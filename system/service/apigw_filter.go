@@ -82,6 +82,10 @@ func (svc *apigwFilter) Create(ctx context.Context, new *types.ApigwFilter) (q *
 			return ApigwRouteErrNotAllowedToUpdate()
 		}
 
+		if err = apigw.Service().ValidateFilter(new); err != nil {
+			return ApigwFilterErrInvalidParams(qProps).Wrap(err)
+		}
+
 		// check for existing filters if route is async
 		if r.Meta.Async {
 			if err = svc.validateAsyncRoute(ctx, r, new, qProps); err != nil {
@@ -158,6 +162,10 @@ func (svc *apigwFilter) Update(ctx context.Context, upd *types.ApigwFilter) (q *
 			return ApigwFilterErrNotFound(qProps)
 		}
 
+		if err = apigw.Service().ValidateFilter(upd); err != nil {
+			return ApigwFilterErrInvalidParams(qProps).Wrap(err)
+		}
+
 		upd.UpdatedAt = now()
 		upd.CreatedAt = qq.CreatedAt
 		upd.UpdatedBy = a.GetIdentityFromContext(ctx).Identity()
@@ -507,6 +507,41 @@ func ApigwFilterErrAsyncRouteTooManyAfterFilters(mm ...*apigwFilterActionProps)
 	return e
 }
 
+// ApigwFilterErrInvalidParams returns "system:filter.invalidParams" as *errors.Error
+//
+// This function is auto-generated.
+//
+func ApigwFilterErrInvalidParams(mm ...*apigwFilterActionProps) *errors.Error {
+	var p = &apigwFilterActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid filter parameters", nil),
+
+		errors.Meta("type", "invalidParams"),
+		errors.Meta("resource", "system:filter"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(apigwFilterLogMetaKey{}, "failed to save {{filter}}; invalid parameters"),
+		errors.Meta(apigwFilterPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "apigwFilter.errors.invalidParams"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
 // *********************************************************************************************************************
 // *********************************************************************************************************************
 
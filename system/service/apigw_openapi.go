@@ -0,0 +1,116 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cortezaproject/corteza-server/pkg/version"
+	"github.com/cortezaproject/corteza-server/system/types"
+)
+
+const (
+	apigwOpenAPIVersion = "3.0.3"
+	apigwContentJSON    = "application/json"
+)
+
+// apigwOpenAPI makes OpenAPI document from the routes
+//
+// Routes are described with the (optional) schema from the route meta;
+// path parameters that are not described are added as required strings
+func apigwOpenAPI(rr types.ApigwRouteSet, server string) *types.ApigwOpenAPI {
+	doc := &types.ApigwOpenAPI{
+		OpenAPI: apigwOpenAPIVersion,
+		Info: types.ApigwOpenAPIInfo{
+			Title:   "Integration gateway",
+			Version: version.Version,
+		},
+		Paths: make(map[string]map[string]*types.ApigwOpenAPIOperation),
+	}
+
+	if server != "" {
+		doc.Servers = []*types.ApigwOpenAPIServer{{URL: server}}
+	}
+
+	for _, r := range rr {
+		if r.Method == "" {
+			// route is not served
+			continue
+		}
+
+		var (
+			path   = r.OpenAPIPath()
+			method = strings.ToLower(r.Method)
+		)
+
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = make(map[string]*types.ApigwOpenAPIOperation)
+		}
+
+		doc.Paths[path][method] = apigwOpenAPIOperation(r)
+	}
+
+	return doc
+}
+
+func apigwOpenAPIOperation(r *types.ApigwRoute) *types.ApigwOpenAPIOperation {
+	var (
+		s  = r.Meta.Schema
+		op = &types.ApigwOpenAPIOperation{
+			OperationID: fmt.Sprintf("route%d", r.ID),
+			Responses:   make(map[string]*types.ApigwOpenAPIResponse),
+		}
+
+		declared = make(map[string]bool)
+	)
+
+	if s != nil {
+		op.Summary = s.Summary
+		op.Description = s.Description
+		op.Tags = s.Tags
+
+		for _, p := range s.Parameters {
+			op.Parameters = append(op.Parameters, p)
+
+			if p.In == types.ApigwSchemaInPath {
+				declared[p.Name] = true
+			}
+		}
+
+		if s.Body != nil {
+			op.RequestBody = &types.ApigwOpenAPIRequestBody{
+				Required: true,
+				Content:  map[string]*types.ApigwOpenAPIMedia{apigwContentJSON: {Schema: s.Body}},
+			}
+		}
+
+		for status, rsp := range s.Responses {
+			oRsp := &types.ApigwOpenAPIResponse{Description: rsp.Description}
+
+			if rsp.Schema != nil {
+				oRsp.Content = map[string]*types.ApigwOpenAPIMedia{apigwContentJSON: {Schema: rsp.Schema}}
+			}
+
+			op.Responses[status] = oRsp
+		}
+	}
+
+	for _, name := range r.EndpointParams() {
+		if declared[name] {
+			continue
+		}
+
+		op.Parameters = append(op.Parameters, &types.ApigwSchemaParameter{
+			Name:     name,
+			In:       types.ApigwSchemaInPath,
+			Required: true,
+			Schema:   &types.ApigwSchema{Type: "string"},
+		})
+	}
+
+	if len(op.Responses) == 0 {
+		// at least one response is required
+		op.Responses["default"] = &types.ApigwOpenAPIResponse{Description: "Default response"}
+	}
+
+	return op
+}
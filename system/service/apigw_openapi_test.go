@@ -0,0 +1,58 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/cortezaproject/corteza-server/system/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_apigwOpenAPI(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		body = &types.ApigwSchema{Type: "object"}
+
+		doc = apigwOpenAPI(types.ApigwRouteSet{
+			{ID: 1, Endpoint: "/orders", Method: "GET"},
+			{ID: 2, Endpoint: "/orders", Method: "POST", Meta: types.ApigwRouteMeta{
+				Schema: &types.ApigwRouteSchema{
+					Summary: "Create order",
+					Body:    body,
+					Responses: map[string]*types.ApigwSchemaResponse{
+						"201": {Description: "Created", Schema: body},
+					},
+				},
+			}},
+			{ID: 3, Endpoint: "/orders/{orderID:[0-9]+}/items/{itemID}", Method: "DELETE", Meta: types.ApigwRouteMeta{
+				Schema: &types.ApigwRouteSchema{
+					Parameters: []*types.ApigwSchemaParameter{
+						{Name: "orderID", In: types.ApigwSchemaInPath, Required: true, Schema: &types.ApigwSchema{Type: "integer"}},
+					},
+				},
+			}},
+			{ID: 4, Endpoint: "/no-method"},
+		}, "/api/gateway")
+	)
+
+	req.Equal("3.0.3", doc.OpenAPI)
+	req.Equal("/api/gateway", doc.Servers[0].URL)
+	req.Len(doc.Paths, 2)
+
+	req.Len(doc.Paths["/orders"], 2)
+	req.Equal("route1", doc.Paths["/orders"]["get"].OperationID)
+	req.Contains(doc.Paths["/orders"]["get"].Responses, "default")
+
+	post := doc.Paths["/orders"]["post"]
+	req.Equal("Create order", post.Summary)
+	req.True(post.RequestBody.Required)
+	req.Equal(body, post.RequestBody.Content["application/json"].Schema)
+	req.Equal("Created", post.Responses["201"].Description)
+
+	del := doc.Paths["/orders/{orderID}/items/{itemID}"]["delete"]
+	req.Len(del.Parameters, 2)
+	req.Equal("integer", del.Parameters[0].Schema.Type)
+	req.Equal("itemID", del.Parameters[1].Name)
+	req.Equal("string", del.Parameters[1].Schema.Type)
+	req.True(del.Parameters[1].Required)
+}
@@ -4,10 +4,9 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"fmt"
 	"io/ioutil"
-	"math"
 	"sort"
-	"time"
 
 	"github.com/cortezaproject/corteza-server/pkg/apigw"
 	"github.com/cortezaproject/corteza-server/pkg/apigw/profiler"
@@ -16,7 +15,7 @@ import (
 )
 
 var (
-	sortAggFields   = []string{"path", "count", "size_min", "size_max", "size_avg", "time_min", "time_max", "time_avg"}
+	sortAggFields   = []string{"path", "count", "size_min", "size_max", "size_avg", "time_min", "time_max", "time_avg", "time_p50", "time_p90", "time_p95", "time_p99"}
 	sortRouteFields = []string{"time_start", "time_finish", "time_duration", "content_length", "http_status_code", "http_method"}
 )
 
@@ -60,9 +59,10 @@ func (svc *apigwProfiler) Hits(ctx context.Context, filter types.ApigwProfilerFi
 		Path: filter.Path,
 	}
 
-	var (
-		list = apigw.Service().Profiler().Hits(sorting)
-	)
+	list, err := apigw.Service().Profiler().Hits(ctx, sorting)
+	if err != nil {
+		return
+	}
 
 	var pp = ""
 
@@ -88,12 +88,15 @@ func (svc *apigwProfiler) Hits(ctx context.Context, filter types.ApigwProfilerFi
 
 			Route:   h.Route,
 			Status:  h.Status,
+			Cache:   h.Cache,
 			Request: *h.R,
 
 			Ts: h.Ts,
 			Tf: h.Tf,
 			D:  h.D,
 			Dr: float64(h.D.Microseconds()) / 1000,
+
+			Filters: h.Filters,
 		}
 
 		// fetch body only on hit details
@@ -127,58 +130,26 @@ func (svc *apigwProfiler) HitsAggregated(ctx context.Context, filter types.Apigw
 
 	filter.Path = string(uDec)
 
-	var (
-		list = apigw.Service().Profiler().Hits(profiler.Sort{
-			Path: filter.Path,
-		})
-
-		tsum, tmin, tmax time.Duration
-		ssum, smin, smax int64
-		i                uint64 = 1
-	)
-
-	for p, v := range list {
-		tmin, tmax, tsum = time.Hour, 0, 0
-		smin, smax, ssum = math.MaxInt64, 0, 0
-
-		i = 0
-
-		for _, vv := range v {
-			var (
-				d = *vv.D
-				s = vv.R.ContentLength
-			)
-
-			if d < tmin {
-				tmin = d
-			}
-
-			if d > tmax {
-				tmax = d
-			}
-
-			if s < smin {
-				smin = s
-			}
-
-			if s > smax {
-				smax = s
-			}
-
-			tsum += d
-			ssum += s
-			i++
-		}
+	list, err := apigw.Service().Profiler().Aggregated(ctx, filter.Path)
+	if err != nil {
+		return
+	}
 
+	for _, a := range list {
 		r = append(r, &types.ApigwProfilerAggregation{
-			Path:  p,
-			Count: i,
-			Tmin:  float64(tmin.Microseconds()) / 1000,
-			Tmax:  float64(tmax.Microseconds()) / 1000,
-			Tavg:  float64(tsum.Microseconds()) / float64(i) / 1000,
-			Smin:  smin,
-			Smax:  smax,
-			Savg:  float64(ssum) / float64(i),
+			Path:  a.Path,
+			Route: a.Route,
+			Count: a.Count,
+			Tmin:  float64(a.Tmin.Microseconds()) / 1000,
+			Tmax:  float64(a.Tmax.Microseconds()) / 1000,
+			Tavg:  float64(a.Tsum.Microseconds()) / float64(a.Count) / 1000,
+			Tp50:  float64(a.Tp50.Microseconds()) / 1000,
+			Tp90:  float64(a.Tp90.Microseconds()) / 1000,
+			Tp95:  float64(a.Tp95.Microseconds()) / 1000,
+			Tp99:  float64(a.Tp99.Microseconds()) / 1000,
+			Smin:  a.Smin,
+			Smax:  a.Smax,
+			Savg:  float64(a.Ssum) / float64(a.Count),
 		})
 	}
 
@@ -193,6 +164,41 @@ func (svc *apigwProfiler) HitsAggregated(ctx context.Context, filter types.Apigw
 	return
 }
 
+// Export fetches a list of hits from integration gateway profiler
+// and converts them to HAR or OpenTelemetry spans
+//
+// Newest hits are exported when limit is set
+func (svc *apigwProfiler) Export(ctx context.Context, filter types.ApigwProfilerFilter, format string) (out interface{}, err error) {
+	uDec, err := base64.URLEncoding.DecodeString(filter.Path)
+
+	if err != nil {
+		return
+	}
+
+	list, err := apigw.Service().Profiler().Hits(ctx, profiler.Sort{
+		Hit:   filter.Hit,
+		Path:  string(uDec),
+		Limit: filter.Limit,
+	})
+	if err != nil {
+		return
+	}
+
+	hh := list.List()
+	if filter.Limit > 0 && uint(len(hh)) > filter.Limit {
+		hh = hh[uint(len(hh))-filter.Limit:]
+	}
+
+	switch format {
+	case types.ApigwProfilerExportHAR:
+		return profiler.ExportHAR(hh), nil
+	case types.ApigwProfilerExportOtel:
+		return profiler.ExportSpans(hh), nil
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
 func sortAggregation(list *types.ApigwProfilerAggregationSet, filter *types.ApigwProfilerFilter) {
 	for _, ff := range sortAggFields {
 		fe := filter.Sort.Get(ff)
@@ -290,6 +296,7 @@ func filterHits(list *types.ApigwProfilerHitSet, filter *types.ApigwProfilerFilt
 	return
 }
 
+// percentile returns the nearest-rank percentile of the sorted durations
 func encodeRoutePath(p string) string {
 	return base64.URLEncoding.EncodeToString([]byte(p))
 }
@@ -319,6 +326,14 @@ func getSortType(s string, list *types.ApigwProfilerAggregationSet) sort.Interfa
 		return types.ByTimeMax(*list)
 	case "time_avg":
 		return types.ByTimeAvg(*list)
+	case "time_p50":
+		return types.ByTimeP50(*list)
+	case "time_p90":
+		return types.ByTimeP90(*list)
+	case "time_p95":
+		return types.ByTimeP95(*list)
+	case "time_p99":
+		return types.ByTimeP99(*list)
 	default:
 		return types.ByCount(*list)
 	}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/cortezaproject/corteza-server/pkg/actionlog"
 	"github.com/cortezaproject/corteza-server/pkg/apigw"
+	agf "github.com/cortezaproject/corteza-server/pkg/apigw/filter"
 	a "github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
 
 	"github.com/cortezaproject/corteza-server/store"
 	"github.com/cortezaproject/corteza-server/system/types"
@@ -73,6 +75,10 @@ func (svc *apigwRoute) Create(ctx context.Context, new *types.ApigwRoute) (q *ty
 			return ApigwRouteErrNotAllowedToCreate(qProps)
 		}
 
+		if err = svc.validateSchema(new, qProps); err != nil {
+			return
+		}
+
 		new.ID = nextID()
 		new.CreatedAt = *now()
 		new.CreatedBy = a.GetIdentityFromContext(ctx).Identity()
@@ -115,6 +121,10 @@ func (svc *apigwRoute) Update(ctx context.Context, upd *types.ApigwRoute) (q *ty
 			return ApigwRouteErrNotAllowedToUpdate(qProps)
 		}
 
+		if err = svc.validateSchema(upd, qProps); err != nil {
+			return
+		}
+
 		// temp todo - update itself with the same endpoint
 		// if qq, e = store.LookupApigwRouteByEndpoint(ctx, svc.store, upd.Endpoint); e == nil && qq == nil {
 		// 	return ApigwRouteErrExistsEndpoint(qProps)
@@ -143,6 +153,19 @@ func (svc *apigwRoute) Update(ctx context.Context, upd *types.ApigwRoute) (q *ty
 	return q, svc.recordAction(ctx, qProps, ApigwRouteActionUpdate, err)
 }
 
+// validateSchema checks if requests can be validated against the route schema
+func (svc *apigwRoute) validateSchema(r *types.ApigwRoute, qProps *apigwRouteActionProps) error {
+	if r.Meta.Schema == nil {
+		return nil
+	}
+
+	if err := agf.ValidateRouteSchema(r.Meta.Schema); err != nil {
+		return ApigwRouteErrInvalidSchema(qProps).Wrap(err)
+	}
+
+	return nil
+}
+
 func (svc *apigwRoute) DeleteByID(ctx context.Context, ID uint64) (err error) {
 	var (
 		qProps = &apigwRouteActionProps{}
@@ -225,6 +248,68 @@ func (svc *apigwRoute) UndeleteByID(ctx context.Context, ID uint64) (err error)
 	return svc.recordAction(ctx, qProps, ApigwRouteActionDelete, err)
 }
 
+// PurgeCache removes cached responses of the route
+func (svc *apigwRoute) PurgeCache(ctx context.Context, ID uint64) (err error) {
+	var (
+		qProps = &apigwRouteActionProps{}
+		q      *types.ApigwRoute
+	)
+
+	err = func() (err error) {
+		if ID == 0 {
+			return ApigwRouteErrInvalidID()
+		}
+
+		if q, err = store.LookupApigwRouteByID(ctx, svc.store, ID); err != nil {
+			return ApigwRouteErrNotFound(qProps).Wrap(err)
+		}
+
+		qProps.setRoute(q)
+
+		if !svc.ac.CanUpdateApigwRoute(ctx, q) {
+			return ApigwRouteErrNotAllowedToUpdate(qProps)
+		}
+
+		apigw.Service().PurgeCache(q.ID)
+
+		return nil
+	}()
+
+	return svc.recordAction(ctx, qProps, ApigwRouteActionPurgeCache, err)
+}
+
+// OpenAPI exports OpenAPI document of all enabled routes
+//
+// Server is the base URL of the integration gateway
+func (svc *apigwRoute) OpenAPI(ctx context.Context, server string) (doc *types.ApigwOpenAPI, err error) {
+	var (
+		qProps = &apigwRouteActionProps{}
+	)
+
+	err = func() (err error) {
+		if !svc.ac.CanSearchApigwRoutes(ctx) {
+			return ApigwRouteErrNotAllowedToSearch()
+		}
+
+		rr, _, err := store.SearchApigwRoutes(ctx, svc.store, types.ApigwRouteFilter{
+			Deleted:  filter.StateExcluded,
+			Disabled: filter.StateExcluded,
+			Check: func(res *types.ApigwRoute) (bool, error) {
+				return svc.ac.CanReadApigwRoute(ctx, res), nil
+			},
+		})
+
+		if err != nil {
+			return
+		}
+
+		doc = apigwOpenAPI(rr, server)
+		return nil
+	}()
+
+	return doc, svc.recordAction(ctx, qProps, ApigwRouteActionExport, err)
+}
+
 func (svc *apigwRoute) Search(ctx context.Context, filter types.ApigwRouteFilter) (r types.ApigwRouteSet, f types.ApigwRouteFilter, err error) {
 	var (
 		aProps = &apigwRouteActionProps{search: &filter}
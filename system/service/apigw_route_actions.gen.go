@@ -353,6 +353,46 @@ func ApigwRouteActionUndelete(props ...*apigwRouteActionProps) *apigwRouteAction
 	return a
 }
 
+// ApigwRouteActionPurgeCache returns "system:apigw-route.purgeCache" action
+//
+// This function is auto-generated.
+//
+func ApigwRouteActionPurgeCache(props ...*apigwRouteActionProps) *apigwRouteAction {
+	a := &apigwRouteAction{
+		timestamp: time.Now(),
+		resource:  "system:apigw-route",
+		action:    "purgeCache",
+		log:       "purged cached responses of {{route}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// ApigwRouteActionExport returns "system:apigw-route.export" action
+//
+// This function is auto-generated.
+//
+func ApigwRouteActionExport(props ...*apigwRouteActionProps) *apigwRouteAction {
+	a := &apigwRouteAction{
+		timestamp: time.Now(),
+		resource:  "system:apigw-route",
+		action:    "export",
+		log:       "exported OpenAPI document of routes",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
 // *********************************************************************************************************************
 // *********************************************************************************************************************
 // Error constructors
@@ -563,6 +603,39 @@ func ApigwRouteErrAlreadyExists(mm ...*apigwRouteActionProps) *errors.Error {
 	return e
 }
 
+// ApigwRouteErrInvalidSchema returns "system:apigw-route.invalidSchema" as *errors.Error
+//
+// This function is auto-generated.
+//
+func ApigwRouteErrInvalidSchema(mm ...*apigwRouteActionProps) *errors.Error {
+	var p = &apigwRouteActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid route schema", nil),
+
+		errors.Meta("type", "invalidSchema"),
+		errors.Meta("resource", "system:apigw-route"),
+
+		errors.Meta(apigwRoutePropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "apigwRoute.errors.invalidSchema"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
 // ApigwRouteErrNotAllowedToCreate returns "system:apigw-route.notAllowedToCreate" as *errors.Error
 //
 //
@@ -113,13 +113,21 @@ func (svc *dalConnection) Create(ctx context.Context, new *types.DalConnection)
 		new.CreatedAt = *now()
 		new.CreatedBy = a.GetIdentityFromContext(ctx).Identity()
 
-		if err = store.CreateDalConnection(ctx, svc.store, new); err != nil {
-			return err
+		// DAL can refuse the connection (unknown sensitivity level, ...);
+		// creation is rolled back in that case
+		err = store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) error {
+			if err = store.CreateDalConnection(ctx, s, new); err != nil {
+				return err
+			}
+
+			return svc.dal.AddConnection(ctx, new.ID, new.Config.Connection, new.ConnectionDefaults(), new.ActiveCapabilities()...)
+		})
+		if err != nil {
+			return
 		}
 
 		q = new
-
-		return svc.dal.AddConnection(ctx, new.ID, new.Config.Connection, new.ConnectionDefaults(), new.ActiveCapabilities()...)
+		return
 	}()
 
 	return q, svc.recordAction(ctx, qProps, DalConnectionActionCreate, err)
@@ -145,13 +153,21 @@ func (svc *dalConnection) Update(ctx context.Context, upd *types.DalConnection)
 		upd.CreatedAt = qq.CreatedAt
 		upd.UpdatedBy = a.GetIdentityFromContext(ctx).Identity()
 
-		if err = store.UpdateDalConnection(ctx, svc.store, upd); err != nil {
+		// DAL can refuse the change (models exceeding the sensitivity level, ...);
+		// update is rolled back in that case
+		err = store.Tx(ctx, svc.store, func(ctx context.Context, s store.Storer) error {
+			if err = store.UpdateDalConnection(ctx, s, upd); err != nil {
+				return err
+			}
+
+			return svc.dal.UpdateConnection(ctx, upd.ID, upd.Config.Connection, upd.ConnectionDefaults(), upd.ActiveCapabilities()...)
+		})
+		if err != nil {
 			return
 		}
 
 		q = upd
-
-		return svc.dal.UpdateConnection(ctx, upd.ID, upd.Config.Connection, upd.ConnectionDefaults(), upd.ActiveCapabilities()...)
+		return
 	}()
 
 	return q, svc.recordAction(ctx, qProps, DalConnectionActionUpdate, err)
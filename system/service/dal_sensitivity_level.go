@@ -5,6 +5,7 @@ import (
 
 	"github.com/cortezaproject/corteza-server/pkg/actionlog"
 	a "github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/dal"
 
 	"github.com/cortezaproject/corteza-server/store"
 	"github.com/cortezaproject/corteza-server/system/types"
@@ -15,29 +16,28 @@ type (
 		actionlog actionlog.Recorder
 		store     store.Storer
 		ac        sensitivityLevelAccessController
-		// dal       dalSensitivityLevels
+		dal       dalSensitivityLevels
 	}
 
 	sensitivityLevelAccessController interface {
 		CanManageDalSensitivityLevel(context.Context) bool
 	}
 
-	// dalSensitivityLevels interface {
-	// 	AddSensitivityLevel(ctx context.Context, sensitivityLevelID uint64, cp dal.SensitivityLevelParams, dft dal.SensitivityLevelDefaults, capabilities ...capabilities.Capability) (err error)
-	// 	UpdateSensitivityLevel(ctx context.Context, sensitivityLevelID uint64, cp dal.SensitivityLevelParams, dft dal.SensitivityLevelDefaults, capabilities ...capabilities.Capability) (err error)
-	// 	RemoveSensitivityLevel(ctx context.Context, sensitivityLevelID uint64) (err error)
-	// }
+	dalSensitivityLevels interface {
+		ReplaceSensitivityLevel(levels ...dal.SensitivityLevel) (err error)
+		RemoveSensitivityLevel(levelIDs ...uint64) (err error)
+	}
 )
 
-func SensitivityLevel(ctx context.Context) (*dalSensitivityLevel, error) {
+func SensitivityLevel(ctx context.Context, dal dalSensitivityLevels) (*dalSensitivityLevel, error) {
 	out := &dalSensitivityLevel{
 		ac:        DefaultAccessControl,
 		actionlog: DefaultActionlog,
 		store:     DefaultStore,
-		// dal:                     dal,
+		dal:       dal,
 	}
 
-	return out, nil // out.reloadSensitivityLevels(ctx)
+	return out, out.reloadSensitivityLevels(ctx)
 }
 
 func (svc *dalSensitivityLevel) FindByID(ctx context.Context, ID uint64) (q *types.DalSensitivityLevel, err error) {
@@ -86,8 +86,7 @@ func (svc *dalSensitivityLevel) Create(ctx context.Context, new *types.DalSensit
 
 		q = new
 
-		// return svc.dal.AddSensitivityLevel(ctx, new.ID, new.Config.SensitivityLevel, new.SensitivityLevelDefaults(), new.ActiveCapabilities()...)
-		return
+		return svc.dal.ReplaceSensitivityLevel(q.SensitivityLevel())
 	}()
 
 	return q, svc.recordAction(ctx, qProps, DalSensitivityLevelActionCreate, err)
@@ -113,13 +112,18 @@ func (svc *dalSensitivityLevel) Update(ctx context.Context, upd *types.DalSensit
 		upd.CreatedAt = qq.CreatedAt
 		upd.UpdatedBy = a.GetIdentityFromContext(ctx).Identity()
 
+		// DAL refuses changes that would invalidate
+		// registered connections or models
+		if err = svc.dal.ReplaceSensitivityLevel(upd.SensitivityLevel()); err != nil {
+			return DalSensitivityLevelErrInUse(qProps).Wrap(err)
+		}
+
 		if err = store.UpdateDalSensitivityLevel(ctx, svc.store, upd); err != nil {
+			_ = svc.dal.ReplaceSensitivityLevel(qq.SensitivityLevel())
 			return
 		}
 
 		q = upd
-
-		// return svc.dal.UpdateSensitivityLevel(ctx, upd.ID, upd.Config.SensitivityLevel, upd.SensitivityLevelDefaults(), upd.ActiveCapabilities()...)
 		return
 	}()
 
@@ -150,11 +154,17 @@ func (svc *dalSensitivityLevel) DeleteByID(ctx context.Context, ID uint64) (err
 		q.DeletedAt = now()
 		q.DeletedBy = a.GetIdentityFromContext(ctx).Identity()
 
+		// DAL refuses removal of sensitivity levels
+		// used by registered connections or models
+		if err = svc.dal.RemoveSensitivityLevel(q.ID); err != nil {
+			return DalSensitivityLevelErrInUse(qProps).Wrap(err)
+		}
+
 		if err = store.UpdateDalSensitivityLevel(ctx, svc.store, q); err != nil {
+			_ = svc.dal.ReplaceSensitivityLevel(q.SensitivityLevel())
 			return
 		}
 
-		// return svc.dal.RemoveSensitivityLevel(ctx, q.ID)
 		return
 	}()
 
@@ -189,8 +199,7 @@ func (svc *dalSensitivityLevel) UndeleteByID(ctx context.Context, ID uint64) (er
 			return
 		}
 
-		// return svc.dal.AddSensitivityLevel(ctx, q.ID, q.Config.SensitivityLevel, q.SensitivityLevelDefaults(), q.ActiveCapabilities()...)
-		return
+		return svc.dal.ReplaceSensitivityLevel(q.SensitivityLevel())
 	}()
 
 	return svc.recordAction(ctx, qProps, DalSensitivityLevelActionDelete, err)
@@ -225,19 +234,17 @@ func (svc *dalSensitivityLevel) Search(ctx context.Context, filter types.DalSens
 	return r, f, svc.recordAction(ctx, aProps, DalSensitivityLevelActionSearch, err)
 }
 
-// func (svc *dalSensitivityLevel) reloadSensitivityLevels(ctx context.Context) (err error) {
-// 	// Get all available sensitivityLevels
-// 	cc, _, err := store.SearchDalSensitivityLevels(ctx, svc.store, types.DalSensitivityLevelFilter{})
-// 	if err != nil {
-// 		return
-// 	}
-
-// 	for _, c := range cc {
-// // 		if err = svc.dal.AddSensitivityLevel(ctx, c.ID, c.Config.SensitivityLevel, c.SensitivityLevelDefaults(), c.ActiveCapabilities()...); err != nil {
-// return
-// 			return
-// 		}
-// 	}
-
-// 	return
-// }
+func (svc *dalSensitivityLevel) reloadSensitivityLevels(ctx context.Context) (err error) {
+	// Get all available sensitivity levels
+	ll, _, err := store.SearchDalSensitivityLevels(ctx, svc.store, types.DalSensitivityLevelFilter{})
+	if err != nil {
+		return
+	}
+
+	levels := make([]dal.SensitivityLevel, 0, len(ll))
+	for _, l := range ll {
+		levels = append(levels, l.SensitivityLevel())
+	}
+
+	return svc.dal.ReplaceSensitivityLevel(levels...)
+}
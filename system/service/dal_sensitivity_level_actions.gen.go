@@ -563,6 +563,39 @@ func DalSensitivityLevelErrAlreadyExists(mm ...*dalSensitivityLevelActionProps)
 	return e
 }
 
+// DalSensitivityLevelErrInUse returns "system:dal-sensitivity-level.inUse" as *errors.Error
+//
+// This function is auto-generated.
+//
+func DalSensitivityLevelErrInUse(mm ...*dalSensitivityLevelActionProps) *errors.Error {
+	var p = &dalSensitivityLevelActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("sensitivityLevel is in use by connections or models", nil),
+
+		errors.Meta("type", "inUse"),
+		errors.Meta("resource", "system:dal-sensitivity-level"),
+
+		errors.Meta(dalSensitivityLevelPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "dalSensitivityLevel.errors.inUse"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
 // DalSensitivityLevelErrNotAllowedToManage returns "system:dal-sensitivity-level.notAllowedToManage" as *errors.Error
 //
 //
@@ -7,7 +7,7 @@ import (
 
 // Match returns false if given conditions do not match event & resource internals
 func (res systemOnInterval) Match(c eventbus.ConstraintMatcher) bool {
-	return scheduler.OnInterval(c.Values()...)
+	return scheduler.MatchInterval(c)
 }
 
 // Match returns false if given conditions do not match event & resource internals
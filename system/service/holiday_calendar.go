@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+
+	"github.com/cortezaproject/corteza-server/pkg/actionlog"
+	a "github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/handle"
+	"github.com/cortezaproject/corteza-server/pkg/scheduler"
+	"github.com/cortezaproject/corteza-server/store"
+	"github.com/cortezaproject/corteza-server/system/types"
+)
+
+type (
+	holidayCalendar struct {
+		actionlog actionlog.Recorder
+		store     store.Storer
+		ac        holidayCalendarAccessController
+	}
+
+	holidayCalendarAccessController interface {
+		CanManageHolidayCalendar(context.Context) bool
+	}
+)
+
+// HolidayCalendar initializes holiday calendar service
+//
+// All valid calendars are loaded and made available
+// to the scheduler (see scheduler.SetCalendars)
+func HolidayCalendar(ctx context.Context) (*holidayCalendar, error) {
+	svc := &holidayCalendar{
+		ac:        DefaultAccessControl,
+		actionlog: DefaultActionlog,
+		store:     DefaultStore,
+	}
+
+	return svc, svc.reloadCalendars(ctx)
+}
+
+func (svc *holidayCalendar) FindByID(ctx context.Context, ID uint64) (c *types.HolidayCalendar, err error) {
+	var (
+		hcProps = &holidayCalendarActionProps{}
+	)
+
+	err = func() error {
+		if ID == 0 {
+			return HolidayCalendarErrInvalidID()
+		}
+
+		if c, err = store.LookupHolidayCalendarByID(ctx, svc.store, ID); err != nil {
+			return HolidayCalendarErrNotFound().Wrap(err)
+		}
+
+		hcProps.setHolidayCalendar(c)
+
+		if !svc.ac.CanManageHolidayCalendar(ctx) {
+			return HolidayCalendarErrNotAllowedToManage(hcProps)
+		}
+
+		return nil
+	}()
+
+	return c, svc.recordAction(ctx, hcProps, HolidayCalendarActionLookup, err)
+}
+
+func (svc *holidayCalendar) Create(ctx context.Context, new *types.HolidayCalendar) (c *types.HolidayCalendar, err error) {
+	var (
+		hcProps = &holidayCalendarActionProps{new: new}
+	)
+
+	err = func() (err error) {
+		if !svc.ac.CanManageHolidayCalendar(ctx) {
+			return HolidayCalendarErrNotAllowedToManage(hcProps)
+		}
+
+		if err = svc.validate(ctx, new); err != nil {
+			return
+		}
+
+		new.ID = nextID()
+		new.CreatedAt = *now()
+		new.CreatedBy = a.GetIdentityFromContext(ctx).Identity()
+
+		if err = store.CreateHolidayCalendar(ctx, svc.store, new); err != nil {
+			return
+		}
+
+		c = new
+		return svc.reloadCalendars(ctx)
+	}()
+
+	return c, svc.recordAction(ctx, hcProps, HolidayCalendarActionCreate, err)
+}
+
+func (svc *holidayCalendar) Update(ctx context.Context, upd *types.HolidayCalendar) (c *types.HolidayCalendar, err error) {
+	var (
+		hcProps = &holidayCalendarActionProps{update: upd}
+		old     *types.HolidayCalendar
+	)
+
+	err = func() (err error) {
+		if old, err = store.LookupHolidayCalendarByID(ctx, svc.store, upd.ID); err != nil {
+			return HolidayCalendarErrNotFound(hcProps)
+		}
+
+		if !svc.ac.CanManageHolidayCalendar(ctx) {
+			return HolidayCalendarErrNotAllowedToManage(hcProps)
+		}
+
+		if err = svc.validate(ctx, upd); err != nil {
+			return
+		}
+
+		upd.CreatedAt = old.CreatedAt
+		upd.CreatedBy = old.CreatedBy
+		upd.UpdatedAt = now()
+		upd.UpdatedBy = a.GetIdentityFromContext(ctx).Identity()
+
+		if err = store.UpdateHolidayCalendar(ctx, svc.store, upd); err != nil {
+			return
+		}
+
+		c = upd
+		return svc.reloadCalendars(ctx)
+	}()
+
+	return c, svc.recordAction(ctx, hcProps, HolidayCalendarActionUpdate, err)
+}
+
+func (svc *holidayCalendar) DeleteByID(ctx context.Context, ID uint64) (err error) {
+	var (
+		hcProps = &holidayCalendarActionProps{}
+		c       *types.HolidayCalendar
+	)
+
+	err = func() (err error) {
+		if ID == 0 {
+			return HolidayCalendarErrInvalidID()
+		}
+
+		if c, err = store.LookupHolidayCalendarByID(ctx, svc.store, ID); err != nil {
+			return HolidayCalendarErrNotFound().Wrap(err)
+		}
+
+		hcProps.setHolidayCalendar(c)
+
+		if !svc.ac.CanManageHolidayCalendar(ctx) {
+			return HolidayCalendarErrNotAllowedToManage(hcProps)
+		}
+
+		c.DeletedAt = now()
+		c.DeletedBy = a.GetIdentityFromContext(ctx).Identity()
+
+		if err = store.UpdateHolidayCalendar(ctx, svc.store, c); err != nil {
+			return
+		}
+
+		return svc.reloadCalendars(ctx)
+	}()
+
+	return svc.recordAction(ctx, hcProps, HolidayCalendarActionDelete, err)
+}
+
+func (svc *holidayCalendar) UndeleteByID(ctx context.Context, ID uint64) (err error) {
+	var (
+		hcProps = &holidayCalendarActionProps{}
+		c       *types.HolidayCalendar
+	)
+
+	err = func() (err error) {
+		if ID == 0 {
+			return HolidayCalendarErrInvalidID()
+		}
+
+		if c, err = store.LookupHolidayCalendarByID(ctx, svc.store, ID); err != nil {
+			return HolidayCalendarErrNotFound().Wrap(err)
+		}
+
+		hcProps.setHolidayCalendar(c)
+
+		if !svc.ac.CanManageHolidayCalendar(ctx) {
+			return HolidayCalendarErrNotAllowedToManage(hcProps)
+		}
+
+		// handle might have been taken
+		// while the calendar was deleted
+		if err = svc.validate(ctx, c); err != nil {
+			return
+		}
+
+		c.DeletedAt = nil
+		c.UpdatedBy = a.GetIdentityFromContext(ctx).Identity()
+
+		if err = store.UpdateHolidayCalendar(ctx, svc.store, c); err != nil {
+			return
+		}
+
+		return svc.reloadCalendars(ctx)
+	}()
+
+	return svc.recordAction(ctx, hcProps, HolidayCalendarActionUndelete, err)
+}
+
+func (svc *holidayCalendar) Search(ctx context.Context, filter types.HolidayCalendarFilter) (r types.HolidayCalendarSet, f types.HolidayCalendarFilter, err error) {
+	var (
+		hcProps = &holidayCalendarActionProps{search: &filter}
+	)
+
+	err = func() error {
+		if !svc.ac.CanManageHolidayCalendar(ctx) {
+			return HolidayCalendarErrNotAllowedToManage()
+		}
+
+		if r, f, err = store.SearchHolidayCalendars(ctx, svc.store, filter); err != nil {
+			return err
+		}
+
+		return nil
+	}()
+
+	return r, f, svc.recordAction(ctx, hcProps, HolidayCalendarActionSearch, err)
+}
+
+func (svc *holidayCalendar) validate(ctx context.Context, c *types.HolidayCalendar) error {
+	var (
+		hcProps = &holidayCalendarActionProps{holidayCalendar: c}
+	)
+
+	if c.Handle == "" || !handle.IsValid(c.Handle) {
+		return HolidayCalendarErrInvalidHandle(hcProps)
+	}
+
+	if ex, _ := store.LookupHolidayCalendarByHandle(ctx, svc.store, c.Handle); ex != nil && ex.ID != c.ID {
+		return HolidayCalendarErrHandleNotUnique(hcProps)
+	}
+
+	if err := c.Holidays.Validate(); err != nil {
+		return HolidayCalendarErrInvalidHolidays(hcProps).Wrap(err)
+	}
+
+	return nil
+}
+
+// reloadCalendars makes all valid calendars available to the scheduler
+func (svc *holidayCalendar) reloadCalendars(ctx context.Context) error {
+	set, _, err := store.SearchHolidayCalendars(ctx, svc.store, types.HolidayCalendarFilter{})
+	if err != nil {
+		return err
+	}
+
+	cc := make(map[string]scheduler.Calendar, len(set))
+	for _, c := range set {
+		cc[c.Handle] = c
+	}
+
+	scheduler.SetCalendars(cc)
+	return nil
+}
@@ -0,0 +1,640 @@
+package service
+
+// This file is auto-generated.
+//
+// Changes to this file may cause incorrect behavior and will be lost if
+// the code is regenerated.
+//
+// Definitions file that controls how this file is generated:
+// system/service/holiday_calendar_actions.yaml
+
+import (
+	"context"
+	"fmt"
+	"github.com/cortezaproject/corteza-server/pkg/actionlog"
+	"github.com/cortezaproject/corteza-server/pkg/errors"
+	"github.com/cortezaproject/corteza-server/pkg/locale"
+	"github.com/cortezaproject/corteza-server/system/types"
+	"strings"
+	"time"
+)
+
+type (
+	holidayCalendarActionProps struct {
+		holidayCalendar *types.HolidayCalendar
+		new             *types.HolidayCalendar
+		update          *types.HolidayCalendar
+		search          *types.HolidayCalendarFilter
+	}
+
+	holidayCalendarAction struct {
+		timestamp time.Time
+		resource  string
+		action    string
+		log       string
+		severity  actionlog.Severity
+
+		// prefix for error when action fails
+		errorMessage string
+
+		props *holidayCalendarActionProps
+	}
+
+	holidayCalendarLogMetaKey   struct{}
+	holidayCalendarPropsMetaKey struct{}
+)
+
+var (
+	// just a placeholder to cover template cases w/o fmt package use
+	_ = fmt.Println
+)
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Props methods
+// setHolidayCalendar updates holidayCalendarActionProps's holidayCalendar
+//
+// Allows method chaining
+//
+// This function is auto-generated.
+//
+func (p *holidayCalendarActionProps) setHolidayCalendar(holidayCalendar *types.HolidayCalendar) *holidayCalendarActionProps {
+	p.holidayCalendar = holidayCalendar
+	return p
+}
+
+// setNew updates holidayCalendarActionProps's new
+//
+// Allows method chaining
+//
+// This function is auto-generated.
+//
+func (p *holidayCalendarActionProps) setNew(new *types.HolidayCalendar) *holidayCalendarActionProps {
+	p.new = new
+	return p
+}
+
+// setUpdate updates holidayCalendarActionProps's update
+//
+// Allows method chaining
+//
+// This function is auto-generated.
+//
+func (p *holidayCalendarActionProps) setUpdate(update *types.HolidayCalendar) *holidayCalendarActionProps {
+	p.update = update
+	return p
+}
+
+// setSearch updates holidayCalendarActionProps's search
+//
+// Allows method chaining
+//
+// This function is auto-generated.
+//
+func (p *holidayCalendarActionProps) setSearch(search *types.HolidayCalendarFilter) *holidayCalendarActionProps {
+	p.search = search
+	return p
+}
+
+// Serialize converts holidayCalendarActionProps to actionlog.Meta
+//
+// This function is auto-generated.
+//
+func (p holidayCalendarActionProps) Serialize() actionlog.Meta {
+	var (
+		m = make(actionlog.Meta)
+	)
+
+	if p.holidayCalendar != nil {
+		m.Set("holidayCalendar.handle", p.holidayCalendar.Handle, true)
+		m.Set("holidayCalendar.ID", p.holidayCalendar.ID, true)
+	}
+	if p.new != nil {
+		m.Set("new.handle", p.new.Handle, true)
+		m.Set("new.ID", p.new.ID, true)
+	}
+	if p.update != nil {
+		m.Set("update.handle", p.update.Handle, true)
+		m.Set("update.ID", p.update.ID, true)
+	}
+	if p.search != nil {
+	}
+
+	return m
+}
+
+// tr translates string and replaces meta value placeholder with values
+//
+// This function is auto-generated.
+//
+func (p holidayCalendarActionProps) Format(in string, err error) string {
+	var (
+		pairs = []string{"{{err}}"}
+		// first non-empty string
+		fns = func(ii ...interface{}) string {
+			for _, i := range ii {
+				if s := fmt.Sprintf("%v", i); len(s) > 0 {
+					return s
+				}
+			}
+
+			return ""
+		}
+	)
+
+	if err != nil {
+		pairs = append(pairs, err.Error())
+	} else {
+		pairs = append(pairs, "nil")
+	}
+
+	if p.holidayCalendar != nil {
+		// replacement for "{{holidayCalendar}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{holidayCalendar}}",
+			fns(
+				p.holidayCalendar.Handle,
+				p.holidayCalendar.ID,
+			),
+		)
+		pairs = append(pairs, "{{holidayCalendar.handle}}", fns(p.holidayCalendar.Handle))
+		pairs = append(pairs, "{{holidayCalendar.ID}}", fns(p.holidayCalendar.ID))
+	}
+
+	if p.new != nil {
+		// replacement for "{{new}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{new}}",
+			fns(
+				p.new.Handle,
+				p.new.ID,
+			),
+		)
+		pairs = append(pairs, "{{new.handle}}", fns(p.new.Handle))
+		pairs = append(pairs, "{{new.ID}}", fns(p.new.ID))
+	}
+
+	if p.update != nil {
+		// replacement for "{{update}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{update}}",
+			fns(
+				p.update.Handle,
+				p.update.ID,
+			),
+		)
+		pairs = append(pairs, "{{update.handle}}", fns(p.update.Handle))
+		pairs = append(pairs, "{{update.ID}}", fns(p.update.ID))
+	}
+
+	if p.search != nil {
+		// replacement for "{{search}}" (in order how fields are defined)
+		pairs = append(
+			pairs,
+			"{{search}}",
+			fns(),
+		)
+	}
+	return strings.NewReplacer(pairs...).Replace(in)
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Action methods
+
+// String returns loggable description as string
+//
+// This function is auto-generated.
+//
+func (a *holidayCalendarAction) String() string {
+	var props = &holidayCalendarActionProps{}
+
+	if a.props != nil {
+		props = a.props
+	}
+
+	return props.Format(a.log, nil)
+}
+
+func (e *holidayCalendarAction) ToAction() *actionlog.Action {
+	return &actionlog.Action{
+		Resource:    e.resource,
+		Action:      e.action,
+		Severity:    e.severity,
+		Description: e.String(),
+		Meta:        e.props.Serialize(),
+	}
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Action constructors
+
+// HolidayCalendarActionSearch returns "system:holiday-calendar.search" action
+//
+// This function is auto-generated.
+//
+func HolidayCalendarActionSearch(props ...*holidayCalendarActionProps) *holidayCalendarAction {
+	a := &holidayCalendarAction{
+		timestamp: time.Now(),
+		resource:  "system:holiday-calendar",
+		action:    "search",
+		log:       "searched for holidayCalendar",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// HolidayCalendarActionLookup returns "system:holiday-calendar.lookup" action
+//
+// This function is auto-generated.
+//
+func HolidayCalendarActionLookup(props ...*holidayCalendarActionProps) *holidayCalendarAction {
+	a := &holidayCalendarAction{
+		timestamp: time.Now(),
+		resource:  "system:holiday-calendar",
+		action:    "lookup",
+		log:       "looked-up for a {{holidayCalendar}}",
+		severity:  actionlog.Info,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// HolidayCalendarActionCreate returns "system:holiday-calendar.create" action
+//
+// This function is auto-generated.
+//
+func HolidayCalendarActionCreate(props ...*holidayCalendarActionProps) *holidayCalendarAction {
+	a := &holidayCalendarAction{
+		timestamp: time.Now(),
+		resource:  "system:holiday-calendar",
+		action:    "create",
+		log:       "created {{holidayCalendar}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// HolidayCalendarActionUpdate returns "system:holiday-calendar.update" action
+//
+// This function is auto-generated.
+//
+func HolidayCalendarActionUpdate(props ...*holidayCalendarActionProps) *holidayCalendarAction {
+	a := &holidayCalendarAction{
+		timestamp: time.Now(),
+		resource:  "system:holiday-calendar",
+		action:    "update",
+		log:       "updated {{holidayCalendar}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// HolidayCalendarActionDelete returns "system:holiday-calendar.delete" action
+//
+// This function is auto-generated.
+//
+func HolidayCalendarActionDelete(props ...*holidayCalendarActionProps) *holidayCalendarAction {
+	a := &holidayCalendarAction{
+		timestamp: time.Now(),
+		resource:  "system:holiday-calendar",
+		action:    "delete",
+		log:       "deleted {{holidayCalendar}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// HolidayCalendarActionUndelete returns "system:holiday-calendar.undelete" action
+//
+// This function is auto-generated.
+//
+func HolidayCalendarActionUndelete(props ...*holidayCalendarActionProps) *holidayCalendarAction {
+	a := &holidayCalendarAction{
+		timestamp: time.Now(),
+		resource:  "system:holiday-calendar",
+		action:    "undelete",
+		log:       "undeleted {{holidayCalendar}}",
+		severity:  actionlog.Notice,
+	}
+
+	if len(props) > 0 {
+		a.props = props[0]
+	}
+
+	return a
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+// Error constructors
+
+// HolidayCalendarErrGeneric returns "system:holiday-calendar.generic" as *errors.Error
+//
+// This function is auto-generated.
+//
+func HolidayCalendarErrGeneric(mm ...*holidayCalendarActionProps) *errors.Error {
+	var p = &holidayCalendarActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("failed to complete request due to internal error", nil),
+
+		errors.Meta("type", "generic"),
+		errors.Meta("resource", "system:holiday-calendar"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(holidayCalendarLogMetaKey{}, "{err}"),
+		errors.Meta(holidayCalendarPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "holidayCalendar.errors.generic"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// HolidayCalendarErrNotFound returns "system:holiday-calendar.notFound" as *errors.Error
+//
+// This function is auto-generated.
+//
+func HolidayCalendarErrNotFound(mm ...*holidayCalendarActionProps) *errors.Error {
+	var p = &holidayCalendarActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("holiday calendar not found", nil),
+
+		errors.Meta("type", "notFound"),
+		errors.Meta("resource", "system:holiday-calendar"),
+
+		errors.Meta(holidayCalendarPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "holidayCalendar.errors.notFound"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// HolidayCalendarErrInvalidID returns "system:holiday-calendar.invalidID" as *errors.Error
+//
+// This function is auto-generated.
+//
+func HolidayCalendarErrInvalidID(mm ...*holidayCalendarActionProps) *errors.Error {
+	var p = &holidayCalendarActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid ID", nil),
+
+		errors.Meta("type", "invalidID"),
+		errors.Meta("resource", "system:holiday-calendar"),
+
+		errors.Meta(holidayCalendarPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "holidayCalendar.errors.invalidID"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// HolidayCalendarErrInvalidHandle returns "system:holiday-calendar.invalidHandle" as *errors.Error
+//
+// This function is auto-generated.
+//
+func HolidayCalendarErrInvalidHandle(mm ...*holidayCalendarActionProps) *errors.Error {
+	var p = &holidayCalendarActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid handle", nil),
+
+		errors.Meta("type", "invalidHandle"),
+		errors.Meta("resource", "system:holiday-calendar"),
+
+		errors.Meta(holidayCalendarPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "holidayCalendar.errors.invalidHandle"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// HolidayCalendarErrHandleNotUnique returns "system:holiday-calendar.handleNotUnique" as *errors.Error
+//
+// This function is auto-generated.
+//
+func HolidayCalendarErrHandleNotUnique(mm ...*holidayCalendarActionProps) *errors.Error {
+	var p = &holidayCalendarActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("holiday calendar handle not unique", nil),
+
+		errors.Meta("type", "handleNotUnique"),
+		errors.Meta("resource", "system:holiday-calendar"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(holidayCalendarLogMetaKey{}, "used duplicate handle ({{holidayCalendar.handle}}) for holiday calendar"),
+		errors.Meta(holidayCalendarPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "holidayCalendar.errors.handleNotUnique"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// HolidayCalendarErrInvalidHolidays returns "system:holiday-calendar.invalidHolidays" as *errors.Error
+//
+// This function is auto-generated.
+//
+func HolidayCalendarErrInvalidHolidays(mm ...*holidayCalendarActionProps) *errors.Error {
+	var p = &holidayCalendarActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("invalid holidays", nil),
+
+		errors.Meta("type", "invalidHolidays"),
+		errors.Meta("resource", "system:holiday-calendar"),
+
+		errors.Meta(holidayCalendarPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "holidayCalendar.errors.invalidHolidays"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// HolidayCalendarErrNotAllowedToManage returns "system:holiday-calendar.notAllowedToManage" as *errors.Error
+//
+// This function is auto-generated.
+//
+func HolidayCalendarErrNotAllowedToManage(mm ...*holidayCalendarActionProps) *errors.Error {
+	var p = &holidayCalendarActionProps{}
+	if len(mm) > 0 {
+		p = mm[0]
+	}
+
+	var e = errors.New(
+		errors.KindInternal,
+
+		p.Format("not allowed to manage holiday calendars", nil),
+
+		errors.Meta("type", "notAllowedToManage"),
+		errors.Meta("resource", "system:holiday-calendar"),
+
+		// action log entry; no formatting, it will be applied inside recordAction fn.
+		errors.Meta(holidayCalendarLogMetaKey{}, "failed to manage holiday calendars; insufficient permissions"),
+		errors.Meta(holidayCalendarPropsMetaKey{}, p),
+
+		// translation namespace & key
+		errors.Meta(locale.ErrorMetaNamespace{}, "system"),
+		errors.Meta(locale.ErrorMetaKey{}, "holidayCalendar.errors.notAllowedToManage"),
+
+		errors.StackSkip(1),
+	)
+
+	if len(mm) > 0 {
+	}
+
+	return e
+}
+
+// *********************************************************************************************************************
+// *********************************************************************************************************************
+
+// recordAction is a service helper function wraps function that can return error
+//
+// It will wrap unrecognized/internal errors with generic errors.
+//
+// This function is auto-generated.
+//
+func (svc holidayCalendar) recordAction(ctx context.Context, props *holidayCalendarActionProps, actionFn func(...*holidayCalendarActionProps) *holidayCalendarAction, err error) error {
+	if svc.actionlog == nil || actionFn == nil {
+		// action log disabled or no action fn passed, return error as-is
+		return err
+	} else if err == nil {
+		// action completed w/o error, record it
+		svc.actionlog.Record(ctx, actionFn(props).ToAction())
+		return nil
+	}
+
+	a := actionFn(props).ToAction()
+
+	// Extracting error information and recording it as action
+	a.Error = err.Error()
+
+	switch c := err.(type) {
+	case *errors.Error:
+		m := c.Meta()
+
+		a.Error = err.Error()
+		a.Severity = actionlog.Severity(m.AsInt("severity"))
+		a.Description = props.Format(m.AsString(holidayCalendarLogMetaKey{}), err)
+
+		if p, has := m[holidayCalendarPropsMetaKey{}]; has {
+			a.Meta = p.(*holidayCalendarActionProps).Serialize()
+		}
+
+		svc.actionlog.Record(ctx, a)
+	default:
+		svc.actionlog.Record(ctx, a)
+	}
+
+	// Original error is passed on
+	return err
+}
@@ -177,6 +177,12 @@ func (svc *queue) Update(ctx context.Context, upd *types.Queue) (q *types.Queue,
 			return QueueErrInvalidConsumer(qProps)
 		}
 
+		// redis url is sent out redacted; keep the stored password
+		// when it was not changed
+		if upd.Meta.RedisURL != "" && upd.Meta.RedisURL == qq.Meta.RedactedRedisURL() {
+			upd.Meta.RedisURL = qq.Meta.RedisURL
+		}
+
 		// Set new values after beforeCreate events are emitted
 		upd.UpdatedAt = now()
 		upd.CreatedAt = qq.CreatedAt
@@ -76,6 +76,7 @@ var (
 	DefaultUser                *user
 	DefaultDalConnection       *dalConnection
 	DefaultDalSensitivityLevel *dalSensitivityLevel
+	DefaultHolidayCalendar     *holidayCalendar
 	DefaultRole                *role
 	DefaultApplication         *application
 	DefaultReminder            ReminderService
@@ -86,6 +87,7 @@ var (
 	DefaultApigwRoute          *apigwRoute
 	DefaultApigwFilter         *apigwFilter
 	DefaultApigwProfiler       *apigwProfiler
+	DefaultApigwApiKey         *apigwApiKey
 	DefaultReport              *report
 	primaryConnectionConfig    types.DalConnection
 
@@ -149,13 +151,21 @@ func Initialize(ctx context.Context, log *zap.Logger, s store.Storer, primaryCon
 
 	DefaultSettings = Settings(ctx, DefaultStore, DefaultLogger, DefaultAccessControl, CurrentSettings)
 
-	primaryConnectionConfig = primaryConn
-	DefaultDalConnection, err = Connection(ctx, primaryConnectionConfig, dal.Service())
+	// Sensitivity levels are loaded first so connections can reference them
+	DefaultDalSensitivityLevel, err = SensitivityLevel(ctx, dal.Service())
+	if err != nil {
+		return
+	}
+
+	// Holiday calendars are loaded before
+	// scheduler starts evaluating interval triggers
+	DefaultHolidayCalendar, err = HolidayCalendar(ctx)
 	if err != nil {
 		return
 	}
 
-	DefaultDalSensitivityLevel, err = SensitivityLevel(ctx)
+	primaryConnectionConfig = primaryConn
+	DefaultDalConnection, err = Connection(ctx, primaryConnectionConfig, dal.Service())
 	if err != nil {
 		return
 	}
@@ -215,6 +225,7 @@ func Initialize(ctx context.Context, log *zap.Logger, s store.Storer, primaryCon
 	DefaultApigwRoute = Route()
 	DefaultApigwProfiler = Profiler()
 	DefaultApigwFilter = Filter()
+	DefaultApigwApiKey = ApiKey()
 
 	if err = initRoles(ctx, log.Named("rbac.roles"), c.RBAC, eventbus.Service(), rbac.Global()); err != nil {
 		return err
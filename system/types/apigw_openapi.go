@@ -0,0 +1,47 @@
+package types
+
+type (
+	// ApigwOpenAPI is the OpenAPI 3 document of the integration gateway routes
+	ApigwOpenAPI struct {
+		OpenAPI string                `json:"openapi"`
+		Info    ApigwOpenAPIInfo      `json:"info"`
+		Servers []*ApigwOpenAPIServer `json:"servers,omitempty"`
+
+		// Operations by path and (lowercase) method
+		Paths map[string]map[string]*ApigwOpenAPIOperation `json:"paths"`
+	}
+
+	ApigwOpenAPIInfo struct {
+		Title   string `json:"title"`
+		Version string `json:"version"`
+	}
+
+	ApigwOpenAPIServer struct {
+		URL string `json:"url"`
+	}
+
+	ApigwOpenAPIOperation struct {
+		OperationID string   `json:"operationId"`
+		Summary     string   `json:"summary,omitempty"`
+		Description string   `json:"description,omitempty"`
+		Tags        []string `json:"tags,omitempty"`
+
+		Parameters  []*ApigwSchemaParameter          `json:"parameters,omitempty"`
+		RequestBody *ApigwOpenAPIRequestBody         `json:"requestBody,omitempty"`
+		Responses   map[string]*ApigwOpenAPIResponse `json:"responses"`
+	}
+
+	ApigwOpenAPIRequestBody struct {
+		Required bool                          `json:"required"`
+		Content  map[string]*ApigwOpenAPIMedia `json:"content"`
+	}
+
+	ApigwOpenAPIResponse struct {
+		Description string                        `json:"description"`
+		Content     map[string]*ApigwOpenAPIMedia `json:"content,omitempty"`
+	}
+
+	ApigwOpenAPIMedia struct {
+		Schema *ApigwSchema `json:"schema"`
+	}
+)
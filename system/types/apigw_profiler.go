@@ -15,15 +15,19 @@ type (
 		Request h.Request `json:"request"`
 		Route   uint64    `json:"route,string"`
 		Status  int       `json:"http_status_code,string"`
+		Cache   string    `json:"cache,omitempty"`
 
 		Ts *time.Time     `json:"time_start"`
 		Tf *time.Time     `json:"time_finish"`
 		D  *time.Duration `json:"-"`
 		Dr float64        `json:"time_duration"`
+
+		Filters []*ApigwProfilerFilterTiming `json:"filters,omitempty"`
 	}
 
 	ApigwProfilerAggregation struct {
 		Path  string  `json:"path"`
+		Route uint64  `json:"route,string"`
 		Count uint64  `json:"count"`
 		Smin  int64   `json:"size_min"`
 		Smax  int64   `json:"size_max"`
@@ -31,6 +35,12 @@ type (
 		Tmin  float64 `json:"time_min"`
 		Tmax  float64 `json:"time_max"`
 		Tavg  float64 `json:"time_avg"`
+
+		// percentiles of the durations
+		Tp50 float64 `json:"time_p50"`
+		Tp90 float64 `json:"time_p90"`
+		Tp95 float64 `json:"time_p95"`
+		Tp99 float64 `json:"time_p99"`
 	}
 
 	ApigwProfilerFilter struct {
@@ -44,6 +54,11 @@ type (
 	}
 )
 
+const (
+	ApigwProfilerExportHAR  = "har"
+	ApigwProfilerExportOtel = "otel"
+)
+
 // sorting methods
 type (
 	ByPath    ApigwProfilerAggregationSet
@@ -54,6 +69,10 @@ type (
 	ByTimeMin ApigwProfilerAggregationSet
 	ByTimeMax ApigwProfilerAggregationSet
 	ByTimeAvg ApigwProfilerAggregationSet
+	ByTimeP50 ApigwProfilerAggregationSet
+	ByTimeP90 ApigwProfilerAggregationSet
+	ByTimeP95 ApigwProfilerAggregationSet
+	ByTimeP99 ApigwProfilerAggregationSet
 
 	BySTime         ApigwProfilerHitSet
 	ByFTime         ApigwProfilerHitSet
@@ -209,6 +228,82 @@ func (h ByTimeMin) Swap(i, j int) {
 	return
 }
 
+func (h ByTimeP50) Len() int {
+	return len(h)
+}
+
+func (h ByTimeP50) Less(i, j int) bool {
+	// make sure to sort via path also
+	// if the counts are the same
+	if h[i].Tp50 == h[j].Tp50 {
+		return h[i].Path < h[j].Path
+	}
+
+	return h[i].Tp50 < h[j].Tp50
+}
+
+func (h ByTimeP50) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	return
+}
+
+func (h ByTimeP90) Len() int {
+	return len(h)
+}
+
+func (h ByTimeP90) Less(i, j int) bool {
+	// make sure to sort via path also
+	// if the counts are the same
+	if h[i].Tp90 == h[j].Tp90 {
+		return h[i].Path < h[j].Path
+	}
+
+	return h[i].Tp90 < h[j].Tp90
+}
+
+func (h ByTimeP90) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	return
+}
+
+func (h ByTimeP95) Len() int {
+	return len(h)
+}
+
+func (h ByTimeP95) Less(i, j int) bool {
+	// make sure to sort via path also
+	// if the counts are the same
+	if h[i].Tp95 == h[j].Tp95 {
+		return h[i].Path < h[j].Path
+	}
+
+	return h[i].Tp95 < h[j].Tp95
+}
+
+func (h ByTimeP95) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	return
+}
+
+func (h ByTimeP99) Len() int {
+	return len(h)
+}
+
+func (h ByTimeP99) Less(i, j int) bool {
+	// make sure to sort via path also
+	// if the counts are the same
+	if h[i].Tp99 == h[j].Tp99 {
+		return h[i].Path < h[j].Path
+	}
+
+	return h[i].Tp99 < h[j].Tp99
+}
+
+func (h ByTimeP99) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	return
+}
+
 //
 // Sorting hits
 //
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/pkg/errors"
+)
+
+type (
+	// ApigwProfilerRecord is a profiled request, kept in store
+	// so it outlives the restarts and is shared between server instances
+	//
+	// Request body is not stored
+	ApigwProfilerRecord struct {
+		ID uint64 `json:"recordID,string"`
+
+		// ID of the profiler hit
+		Hit   string `json:"hit"`
+		Route uint64 `json:"route,string"`
+
+		Method        string `json:"method"`
+		Path          string `json:"path"`
+		Query         string `json:"query"`
+		Status        int    `json:"status"`
+		Cache         string `json:"cache,omitempty"`
+		ContentLength int64  `json:"contentLength"`
+
+		// Duration of the request in nanoseconds
+		Duration int64 `json:"duration"`
+
+		Meta ApigwProfilerRecordMeta `json:"meta"`
+
+		StartedAt time.Time `json:"startedAt"`
+	}
+
+	ApigwProfilerRecordMeta struct {
+		Host string `json:"host,omitempty"`
+
+		// Request headers, sensitive values are masked
+		Header http.Header `json:"header,omitempty"`
+
+		Filters []*ApigwProfilerFilterTiming `json:"filters,omitempty"`
+	}
+
+	// ApigwProfilerFilterTiming holds the time spent in a filter of the route
+	ApigwProfilerFilterTiming struct {
+		Name  string        `json:"name"`
+		Kind  string        `json:"kind"`
+		Error string        `json:"error,omitempty"`
+		Ts    time.Time     `json:"time_start"`
+		D     time.Duration `json:"-"`
+		Dr    float64       `json:"time_duration"`
+	}
+
+	ApigwProfilerRecordFilter struct {
+		Hit   string `json:"hit"`
+		Route uint64 `json:"route,string"`
+		Path  string `json:"path"`
+
+		// Records of the requests started at or after the given time
+		FromTimestamp *time.Time `json:"from"`
+
+		// Records with the ID lower than the given one,
+		// used to page through the records from the newest one
+		BeforeID uint64 `json:"beforeID,string"`
+
+		Limit uint `json:"limit"`
+
+		filter.Sorting
+	}
+
+	// ApigwProfilerRecordAggregate holds the stats of the records of the same path
+	//
+	// Durations are in nanoseconds
+	ApigwProfilerRecordAggregate struct {
+		Path  string
+		Route uint64
+		Count uint64
+
+		DurationMin int64
+		DurationMax int64
+		DurationSum int64
+
+		// durations at the 50th, 90th, 95th and 99th percentile
+		DurationP50 int64
+		DurationP90 int64
+		DurationP95 int64
+		DurationP99 int64
+
+		ContentLengthMin int64
+		ContentLengthMax int64
+		ContentLengthSum int64
+	}
+
+	ApigwProfilerRecordAggregateSet []*ApigwProfilerRecordAggregate
+)
+
+func (cc *ApigwProfilerRecordMeta) Scan(value interface{}) error {
+	//lint:ignore S1034 This typecast is intentional, we need to get []byte out of a []uint8
+	switch value.(type) {
+	case nil:
+		*cc = ApigwProfilerRecordMeta{}
+	case []uint8:
+		b := value.([]byte)
+		if err := json.Unmarshal(b, cc); err != nil {
+			return errors.Wrapf(err, "cannot scan '%v' into ApigwProfilerRecordMeta", string(b))
+		}
+	}
+
+	return nil
+}
+
+func (cc ApigwProfilerRecordMeta) Value() (driver.Value, error) {
+	return json.Marshal(cc)
+}
+
+// UnmarshalJSON restores the duration from the milliseconds
+func (t *ApigwProfilerFilterTiming) UnmarshalJSON(b []byte) error {
+	type aux ApigwProfilerFilterTiming
+
+	if err := json.Unmarshal(b, (*aux)(t)); err != nil {
+		return err
+	}
+
+	t.D = time.Duration(t.Dr*1000) * time.Microsecond
+	return nil
+}
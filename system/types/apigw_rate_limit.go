@@ -0,0 +1,33 @@
+package types
+
+import (
+	"time"
+)
+
+type (
+	// ApigwRateLimit is a rate limit counter shared between server instances
+	//
+	// Counter is updated with optimistic locking; every update
+	// increments version and is saved only if stored version was not
+	// changed in the meantime
+	ApigwRateLimit struct {
+		// Name of the counter (route and limit key)
+		Name string `json:"name"`
+
+		// Number of requests in the window (fixed window policy)
+		Count uint64 `json:"count"`
+
+		// Start of the window (fixed window policy) or theoretical
+		// arrival time of the next request (token bucket policy);
+		// Unix time in nanoseconds
+		Stamp int64 `json:"stamp"`
+
+		Version uint64 `json:"version"`
+
+		ExpiresAt time.Time `json:"expiresAt"`
+	}
+
+	ApigwRateLimitFilter struct {
+		Limit uint `json:"-"`
+	}
+)
@@ -3,6 +3,7 @@ package types
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"regexp"
 	"time"
 
 	"github.com/cortezaproject/corteza-server/pkg/filter"
@@ -29,6 +30,64 @@ type (
 	ApigwRouteMeta struct {
 		Debug bool `json:"debug"`
 		Async bool `json:"async"`
+
+		// Schema describes the requests and responses of the route;
+		// used for OpenAPI export and by validateRequest prefilter
+		Schema *ApigwRouteSchema `json:"schema,omitempty"`
+	}
+
+	ApigwRouteSchema struct {
+		Summary     string   `json:"summary,omitempty"`
+		Description string   `json:"description,omitempty"`
+		Tags        []string `json:"tags,omitempty"`
+
+		Parameters []*ApigwSchemaParameter `json:"parameters,omitempty"`
+
+		// Body of the request, expected to be JSON
+		Body *ApigwSchema `json:"body,omitempty"`
+
+		// Responses by HTTP status code
+		Responses map[string]*ApigwSchemaResponse `json:"responses,omitempty"`
+	}
+
+	ApigwSchemaParameter struct {
+		Name        string       `json:"name"`
+		In          string       `json:"in"`
+		Description string       `json:"description,omitempty"`
+		Required    bool         `json:"required,omitempty"`
+		Schema      *ApigwSchema `json:"schema,omitempty"`
+	}
+
+	ApigwSchemaResponse struct {
+		Description string       `json:"description"`
+		Schema      *ApigwSchema `json:"schema,omitempty"`
+	}
+
+	// ApigwSchema is a subset of the JSON schema as used by OpenAPI 3
+	ApigwSchema struct {
+		Type        string        `json:"type,omitempty"`
+		Format      string        `json:"format,omitempty"`
+		Description string        `json:"description,omitempty"`
+		Enum        []interface{} `json:"enum,omitempty"`
+
+		// string
+		Pattern   string  `json:"pattern,omitempty"`
+		MinLength *uint64 `json:"minLength,omitempty"`
+		MaxLength *uint64 `json:"maxLength,omitempty"`
+
+		// number, integer
+		Minimum *float64 `json:"minimum,omitempty"`
+		Maximum *float64 `json:"maximum,omitempty"`
+
+		// array
+		Items    *ApigwSchema `json:"items,omitempty"`
+		MinItems *uint64      `json:"minItems,omitempty"`
+		MaxItems *uint64      `json:"maxItems,omitempty"`
+
+		// object
+		Properties           map[string]*ApigwSchema `json:"properties,omitempty"`
+		Required             []string                `json:"required,omitempty"`
+		AdditionalProperties *bool                   `json:"additionalProperties,omitempty"`
 	}
 
 	ApigwRouteFilter struct {
@@ -67,3 +126,28 @@ func (cc *ApigwRouteMeta) Scan(value interface{}) error {
 func (cc ApigwRouteMeta) Value() (driver.Value, error) {
 	return json.Marshal(cc)
 }
+
+const (
+	ApigwSchemaInQuery  = "query"
+	ApigwSchemaInHeader = "header"
+	ApigwSchemaInPath   = "path"
+)
+
+var (
+	// matches parameters in the route endpoint; {name} or {name:regexp}
+	apigwEndpointParam = regexp.MustCompile(`{([^/:}]+)(:[^/]*)?}`)
+)
+
+// EndpointParams returns names of the path parameters in the route endpoint
+func (r ApigwRoute) EndpointParams() (pp []string) {
+	for _, m := range apigwEndpointParam.FindAllStringSubmatch(r.Endpoint, -1) {
+		pp = append(pp, m[1])
+	}
+
+	return
+}
+
+// OpenAPIPath returns route endpoint without parameter patterns
+func (r ApigwRoute) OpenAPIPath() string {
+	return apigwEndpointParam.ReplaceAllString(r.Endpoint, "{$1}")
+}
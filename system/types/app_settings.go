@@ -195,6 +195,14 @@ type (
 					// List of mime-types we support,
 					Mimetypes []string
 				}
+
+				Export struct {
+					// Values of fields with sensitivity level above
+					// this one are left out from the export
+					//
+					// Zero value disables the check
+					SensitivityLevel uint64 `kv:"sensitivity-level" json:"sensitivityLevel,string"`
+				}
 			}
 
 			// Page related settings
@@ -215,6 +223,12 @@ type (
 			// This only holds the value of FEDERATION_ENABLED for now
 			//
 			Enabled bool `kv:"-" json:"enabled"`
+
+			// Fields with sensitivity level above this one
+			// can not be exposed to other nodes
+			//
+			// Zero value disables the check
+			SensitivityLevel uint64 `kv:"sensitivity-level" json:"sensitivityLevel,string"`
 		} `kv:"federation" json:"federation"`
 
 		// Integration gateway settings
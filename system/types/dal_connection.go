@@ -83,6 +83,8 @@ func (c DalConnection) ConnectionDefaults() dal.ConnectionDefaults {
 		ModelIdent:      c.Config.DefaultModelIdent,
 		AttributeIdent:  c.Config.DefaultAttributeIdent,
 		PartitionFormat: c.Config.DefaultPartitionFormat,
+
+		SensitivityLevel: c.SensitivityLevel,
 	}
 }
 
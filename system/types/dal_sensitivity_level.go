@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/cortezaproject/corteza-server/pkg/dal"
 	"github.com/cortezaproject/corteza-server/pkg/filter"
 	"github.com/pkg/errors"
 )
@@ -75,3 +76,12 @@ func (nm *DalSensitivityLevelMeta) Scan(value interface{}) error {
 func (nm DalSensitivityLevelMeta) Value() (driver.Value, error) {
 	return json.Marshal(nm)
 }
+
+// SensitivityLevel converts the sensitivity level to the DAL counterpart
+func (l DalSensitivityLevel) SensitivityLevel() dal.SensitivityLevel {
+	return dal.SensitivityLevel{
+		ID:     l.ID,
+		Handle: l.Handle,
+		Level:  l.Level,
+	}
+}
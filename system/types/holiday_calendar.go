@@ -0,0 +1,158 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/pkg/errors"
+)
+
+type (
+	HolidayCalendarMeta struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	// HolidayCalendar is a named set of holidays
+	//
+	// Calendars are used by interval workflow triggers
+	// to skip (or fire only on) holidays
+	HolidayCalendar struct {
+		ID     uint64 `json:"holidayCalendarID,string"`
+		Handle string `json:"handle"`
+
+		Meta     HolidayCalendarMeta `json:"meta"`
+		Holidays HolidaySet          `json:"holidays"`
+
+		CreatedAt time.Time  `json:"createdAt,omitempty"`
+		CreatedBy uint64     `json:"createdBy,string" `
+		UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+		UpdatedBy uint64     `json:"updatedBy,string,omitempty" `
+		DeletedAt *time.Time `json:"deletedAt,omitempty"`
+		DeletedBy uint64     `json:"deletedBy,string,omitempty" `
+	}
+
+	// Holiday is either a single day (YYYY-MM-DD)
+	// or a day that repeats every year (MM-DD)
+	Holiday struct {
+		Date string `json:"date"`
+		Name string `json:"name,omitempty"`
+	}
+
+	HolidaySet []*Holiday
+
+	HolidayCalendarFilter struct {
+		HolidayCalendarID []uint64 `json:"holidayCalendarID,string"`
+		Handle            string   `json:"handle"`
+
+		Deleted filter.State `json:"deleted"`
+
+		// Check fn is called by store backend for each resource found function can
+		// modify the resource and return false if store should not return it
+		//
+		// Store then loads additional resources to satisfy the paging parameters
+		Check func(*HolidayCalendar) (bool, error) `json:"-"`
+
+		Limit uint `json:"-"`
+	}
+)
+
+const (
+	holidayDateLayout      = "2006-01-02"
+	holidayRecurringLayout = "01-02"
+)
+
+// IsHoliday returns true if the day of the given time is a holiday
+//
+// Day is determined in the location of the given time
+func (c HolidayCalendar) IsHoliday(day time.Time) bool {
+	var (
+		date      = day.Format(holidayDateLayout)
+		recurring = day.Format(holidayRecurringLayout)
+	)
+
+	for _, h := range c.Holidays {
+		if h.Date == date || h.Date == recurring {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate checks the format of holiday dates
+func (set HolidaySet) Validate() error {
+	for _, h := range set {
+		if _, err := time.Parse(holidayDateLayout, h.Date); err == nil {
+			continue
+		}
+
+		// leap year is used so that 02-29 is accepted
+		if _, err := time.Parse(holidayDateLayout, "2000-"+h.Date); err == nil && len(h.Date) == len(holidayRecurringLayout) {
+			continue
+		}
+
+		return fmt.Errorf("invalid holiday date %q, expecting YYYY-MM-DD or MM-DD", h.Date)
+	}
+
+	return nil
+}
+
+func ParseHolidayCalendarMeta(ss []string) (m HolidayCalendarMeta, err error) {
+	if len(ss) == 0 {
+		return
+	}
+
+	err = json.Unmarshal([]byte(ss[0]), &m)
+	return
+}
+
+func ParseHolidaySet(ss []string) (set HolidaySet, err error) {
+	if len(ss) == 0 {
+		return
+	}
+
+	err = json.Unmarshal([]byte(ss[0]), &set)
+	return
+}
+
+func (nm *HolidayCalendarMeta) Scan(value interface{}) error {
+	//lint:ignore S1034 This typecast is intentional, we need to get []byte out of a []uint8
+	switch value.(type) {
+	case nil:
+		*nm = HolidayCalendarMeta{}
+	case []uint8:
+		b := value.([]byte)
+		if err := json.Unmarshal(b, nm); err != nil {
+			return errors.Wrapf(err, "cannot scan '%v' into HolidayCalendarMeta", string(b))
+		}
+	}
+
+	return nil
+}
+
+func (nm HolidayCalendarMeta) Value() (driver.Value, error) {
+	return json.Marshal(nm)
+}
+
+func (set *HolidaySet) Scan(value interface{}) error {
+	//lint:ignore S1034 This typecast is intentional, we need to get []byte out of a []uint8
+	switch value.(type) {
+	case nil:
+		*set = HolidaySet{}
+	case []uint8:
+		b := value.([]byte)
+		if err := json.Unmarshal(b, set); err != nil {
+			return errors.Wrapf(err, "cannot scan '%v' into HolidaySet", string(b))
+		}
+	}
+
+	return nil
+}
+
+func (set HolidaySet) Value() (driver.Value, error) {
+	return json.Marshal(set)
+}
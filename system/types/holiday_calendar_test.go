@@ -0,0 +1,34 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHolidayCalendar_IsHoliday(t *testing.T) {
+	var (
+		req = require.New(t)
+		c   = HolidayCalendar{Holidays: HolidaySet{
+			{Date: "12-25"},
+			{Date: "2022-04-18"},
+		}}
+	)
+
+	req.True(c.IsHoliday(time.Date(2022, 12, 25, 10, 0, 0, 0, time.UTC)))
+	req.True(c.IsHoliday(time.Date(2030, 12, 25, 10, 0, 0, 0, time.UTC)))
+	req.True(c.IsHoliday(time.Date(2022, 4, 18, 10, 0, 0, 0, time.UTC)))
+	req.False(c.IsHoliday(time.Date(2023, 4, 18, 10, 0, 0, 0, time.UTC)))
+	req.False(c.IsHoliday(time.Date(2022, 12, 24, 10, 0, 0, 0, time.UTC)))
+}
+
+func TestHolidaySet_Validate(t *testing.T) {
+	req := require.New(t)
+
+	req.NoError(HolidaySet{{Date: "2022-04-18"}, {Date: "02-29"}, {Date: "12-25"}}.Validate())
+	req.Error(HolidaySet{{Date: "2022-02-30"}}.Validate())
+	req.Error(HolidaySet{{Date: "13-01"}}.Validate())
+	req.Error(HolidaySet{{Date: "2022/04/18"}}.Validate())
+	req.Error(HolidaySet{{Date: ""}}.Validate())
+}
@@ -6,7 +6,7 @@ import (
 
 func ParseApigwRouteMeta(ss []string) (p ApigwRouteMeta, err error) {
 	p = ApigwRouteMeta{}
-	return p, parseStringsInput(ss, p)
+	return p, parseStringsInput(ss, &p)
 }
 
 func ParseApigwfFilterParams(ss []string) (p ApigwFilterParams, err error) {
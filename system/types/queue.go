@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/cortezaproject/corteza-server/pkg/filter"
@@ -60,6 +61,16 @@ type (
 	QueueMeta struct {
 		PollDelay      *time.Duration `json:"poll_delay"`
 		DispatchEvents bool           `json:"dispatch_events"`
+
+		// Redis connection settings, used by the redis consumer;
+		// stream defaults to the queue name
+		RedisURL    string `json:"redis_url,omitempty"`
+		RedisStream string `json:"redis_stream,omitempty"`
+		RedisGroup  string `json:"redis_group,omitempty"`
+
+		// ID of the first message the consumer group reads when created,
+		// defaults to 0 (all messages in the stream), $ skips existing messages
+		RedisStart string `json:"redis_start,omitempty"`
 	}
 )
 
@@ -88,11 +99,26 @@ func (h *QueueMeta) UnmarshalJSON(s []byte) error {
 }
 
 func (m QueueMeta) Value() (driver.Value, error) {
-	return json.Marshal(m)
+	return m.marshal(m.RedisURL)
 }
 
+// MarshalJSON encodes queue meta with the
+// password of the redis url redacted
 func (m QueueMeta) MarshalJSON() ([]byte, error) {
+	return m.marshal(m.RedactedRedisURL())
+}
+
+// RedactedRedisURL returns the redis url with the password replaced
+func (m QueueMeta) RedactedRedisURL() string {
+	u, err := url.Parse(m.RedisURL)
+	if err != nil || u.User == nil {
+		return m.RedisURL
+	}
+
+	return u.Redacted()
+}
 
+func (m QueueMeta) marshal(redisURL string) ([]byte, error) {
 	pollDelay := ""
 	if m.PollDelay != nil {
 		pollDelay = m.PollDelay.String()
@@ -101,9 +127,17 @@ func (m QueueMeta) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
 		PollDelay      string `json:"poll_delay"`
 		DispatchEvents bool   `json:"dispatch_events"`
+		RedisURL       string `json:"redis_url,omitempty"`
+		RedisStream    string `json:"redis_stream,omitempty"`
+		RedisGroup     string `json:"redis_group,omitempty"`
+		RedisStart     string `json:"redis_start,omitempty"`
 	}{
 		PollDelay:      pollDelay,
 		DispatchEvents: m.DispatchEvents,
+		RedisURL:       redisURL,
+		RedisStream:    m.RedisStream,
+		RedisGroup:     m.RedisGroup,
+		RedisStart:     m.RedisStart,
 	})
 }
 
@@ -35,6 +35,7 @@ const (
 	UserResourceType                = "corteza::system:user"
 	DalConnectionResourceType       = "corteza::system:dal_connection"
 	DalSensitivityLevelResourceType = "corteza::system:dal_sensitivity_level"
+	HolidayCalendarResourceType     = "corteza::system:holiday_calendar"
 	ComponentResourceType           = "corteza::system"
 )
 
@@ -368,6 +369,36 @@ func DalSensitivityLevelRbacResourceTpl() string {
 	return "%s/%s"
 }
 
+// RbacResource returns string representation of RBAC resource for HolidayCalendar by calling HolidayCalendarRbacResource fn
+//
+// RBAC resource is in the corteza::system:holiday_calendar/... format
+//
+// This function is auto-generated
+func (r HolidayCalendar) RbacResource() string {
+	return HolidayCalendarRbacResource(r.ID)
+}
+
+// HolidayCalendarRbacResource returns string representation of RBAC resource for HolidayCalendar
+//
+// RBAC resource is in the corteza::system:holiday_calendar/... format
+//
+// This function is auto-generated
+func HolidayCalendarRbacResource(id uint64) string {
+	cpts := []interface{}{HolidayCalendarResourceType}
+	if id != 0 {
+		cpts = append(cpts, strconv.FormatUint(id, 10))
+	} else {
+		cpts = append(cpts, "*")
+	}
+
+	return fmt.Sprintf(HolidayCalendarRbacResourceTpl(), cpts...)
+
+}
+
+func HolidayCalendarRbacResourceTpl() string {
+	return "%s/%s"
+}
+
 // RbacResource returns string representation of RBAC resource for Component by calling ComponentRbacResource fn
 //
 // RBAC resource is in the corteza::system/... format
@@ -25,6 +25,16 @@ type (
 	// This type is auto-generated.
 	ApigwProfilerHitSet []*ApigwProfilerHit
 
+	// ApigwProfilerRecordSet slice of ApigwProfilerRecord
+	//
+	// This type is auto-generated.
+	ApigwProfilerRecordSet []*ApigwProfilerRecord
+
+	// ApigwRateLimitSet slice of ApigwRateLimit
+	//
+	// This type is auto-generated.
+	ApigwRateLimitSet []*ApigwRateLimit
+
 	// ApigwRouteSet slice of ApigwRoute
 	//
 	// This type is auto-generated.
@@ -75,6 +85,11 @@ type (
 	// This type is auto-generated.
 	DalSensitivityLevelSet []*DalSensitivityLevel
 
+	// HolidayCalendarSet slice of HolidayCalendar
+	//
+	// This type is auto-generated.
+	HolidayCalendarSet []*HolidayCalendar
+
 	// QueueSet slice of Queue
 	//
 	// This type is auto-generated.
@@ -242,6 +257,92 @@ func (set ApigwProfilerHitSet) Filter(f func(*ApigwProfilerHit) (bool, error)) (
 	return
 }
 
+// Walk iterates through every slice item and calls w(ApigwProfilerRecord) err
+//
+// This function is auto-generated.
+func (set ApigwProfilerRecordSet) Walk(w func(*ApigwProfilerRecord) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(ApigwProfilerRecord) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set ApigwProfilerRecordSet) Filter(f func(*ApigwProfilerRecord) (bool, error)) (out ApigwProfilerRecordSet, err error) {
+	var ok bool
+	out = ApigwProfilerRecordSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
+// FindByID finds items from slice by its ID property
+//
+// This function is auto-generated.
+func (set ApigwProfilerRecordSet) FindByID(ID uint64) *ApigwProfilerRecord {
+	for i := range set {
+		if set[i].ID == ID {
+			return set[i]
+		}
+	}
+
+	return nil
+}
+
+// IDs returns a slice of uint64s from all items in the set
+//
+// This function is auto-generated.
+func (set ApigwProfilerRecordSet) IDs() (IDs []uint64) {
+	IDs = make([]uint64, len(set))
+
+	for i := range set {
+		IDs[i] = set[i].ID
+	}
+
+	return
+}
+
+// Walk iterates through every slice item and calls w(ApigwRateLimit) err
+//
+// This function is auto-generated.
+func (set ApigwRateLimitSet) Walk(w func(*ApigwRateLimit) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(ApigwRateLimit) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set ApigwRateLimitSet) Filter(f func(*ApigwRateLimit) (bool, error)) (out ApigwRateLimitSet, err error) {
+	var ok bool
+	out = ApigwRateLimitSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
 // Walk iterates through every slice item and calls w(ApigwRoute) err
 //
 // This function is auto-generated.
@@ -750,6 +851,62 @@ func (set DalSensitivityLevelSet) IDs() (IDs []uint64) {
 	return
 }
 
+// Walk iterates through every slice item and calls w(HolidayCalendar) err
+//
+// This function is auto-generated.
+func (set HolidayCalendarSet) Walk(w func(*HolidayCalendar) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(HolidayCalendar) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set HolidayCalendarSet) Filter(f func(*HolidayCalendar) (bool, error)) (out HolidayCalendarSet, err error) {
+	var ok bool
+	out = HolidayCalendarSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
+// FindByID finds items from slice by its ID property
+//
+// This function is auto-generated.
+func (set HolidayCalendarSet) FindByID(ID uint64) *HolidayCalendar {
+	for i := range set {
+		if set[i].ID == ID {
+			return set[i]
+		}
+	}
+
+	return nil
+}
+
+// IDs returns a slice of uint64s from all items in the set
+//
+// This function is auto-generated.
+func (set HolidayCalendarSet) IDs() (IDs []uint64) {
+	IDs = make([]uint64, len(set))
+
+	for i := range set {
+		IDs[i] = set[i].ID
+	}
+
+	return
+}
+
 // Walk iterates through every slice item and calls w(Queue) err
 //
 // This function is auto-generated.
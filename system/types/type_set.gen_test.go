@@ -216,6 +216,152 @@ func TestApigwProfilerHitSetFilter(t *testing.T) {
 	}
 }
 
+func TestApigwProfilerRecordSetWalk(t *testing.T) {
+	var (
+		value = make(ApigwProfilerRecordSet, 3)
+		req   = require.New(t)
+	)
+
+	// check walk with no errors
+	{
+		err := value.Walk(func(*ApigwProfilerRecord) error {
+			return nil
+		})
+		req.NoError(err)
+	}
+
+	// check walk with error
+	req.Error(value.Walk(func(*ApigwProfilerRecord) error { return fmt.Errorf("walk error") }))
+}
+
+func TestApigwProfilerRecordSetFilter(t *testing.T) {
+	var (
+		value = make(ApigwProfilerRecordSet, 3)
+		req   = require.New(t)
+	)
+
+	// filter nothing
+	{
+		set, err := value.Filter(func(*ApigwProfilerRecord) (bool, error) {
+			return true, nil
+		})
+		req.NoError(err)
+		req.Equal(len(set), len(value))
+	}
+
+	// filter one item
+	{
+		found := false
+		set, err := value.Filter(func(*ApigwProfilerRecord) (bool, error) {
+			if !found {
+				found = true
+				return found, nil
+			}
+			return false, nil
+		})
+		req.NoError(err)
+		req.Len(set, 1)
+	}
+
+	// filter error
+	{
+		_, err := value.Filter(func(*ApigwProfilerRecord) (bool, error) {
+			return false, fmt.Errorf("filter error")
+		})
+		req.Error(err)
+	}
+}
+
+func TestApigwProfilerRecordSetIDs(t *testing.T) {
+	var (
+		value = make(ApigwProfilerRecordSet, 3)
+		req   = require.New(t)
+	)
+
+	// construct objects
+	value[0] = new(ApigwProfilerRecord)
+	value[1] = new(ApigwProfilerRecord)
+	value[2] = new(ApigwProfilerRecord)
+	// set ids
+	value[0].ID = 1
+	value[1].ID = 2
+	value[2].ID = 3
+
+	// Find existing
+	{
+		val := value.FindByID(2)
+		req.Equal(uint64(2), val.ID)
+	}
+
+	// Find non-existing
+	{
+		val := value.FindByID(4)
+		req.Nil(val)
+	}
+
+	// List IDs from set
+	{
+		val := value.IDs()
+		req.Equal(len(val), len(value))
+	}
+}
+
+func TestApigwRateLimitSetWalk(t *testing.T) {
+	var (
+		value = make(ApigwRateLimitSet, 3)
+		req   = require.New(t)
+	)
+
+	// check walk with no errors
+	{
+		err := value.Walk(func(*ApigwRateLimit) error {
+			return nil
+		})
+		req.NoError(err)
+	}
+
+	// check walk with error
+	req.Error(value.Walk(func(*ApigwRateLimit) error { return fmt.Errorf("walk error") }))
+}
+
+func TestApigwRateLimitSetFilter(t *testing.T) {
+	var (
+		value = make(ApigwRateLimitSet, 3)
+		req   = require.New(t)
+	)
+
+	// filter nothing
+	{
+		set, err := value.Filter(func(*ApigwRateLimit) (bool, error) {
+			return true, nil
+		})
+		req.NoError(err)
+		req.Equal(len(set), len(value))
+	}
+
+	// filter one item
+	{
+		found := false
+		set, err := value.Filter(func(*ApigwRateLimit) (bool, error) {
+			if !found {
+				found = true
+				return found, nil
+			}
+			return false, nil
+		})
+		req.NoError(err)
+		req.Len(set, 1)
+	}
+
+	// filter error
+	{
+		_, err := value.Filter(func(*ApigwRateLimit) (bool, error) {
+			return false, fmt.Errorf("filter error")
+		})
+		req.Error(err)
+	}
+}
+
 func TestApigwRouteSetWalk(t *testing.T) {
 	var (
 		value = make(ApigwRouteSet, 3)
@@ -1048,6 +1194,96 @@ func TestDalSensitivityLevelSetIDs(t *testing.T) {
 	}
 }
 
+func TestHolidayCalendarSetWalk(t *testing.T) {
+	var (
+		value = make(HolidayCalendarSet, 3)
+		req   = require.New(t)
+	)
+
+	// check walk with no errors
+	{
+		err := value.Walk(func(*HolidayCalendar) error {
+			return nil
+		})
+		req.NoError(err)
+	}
+
+	// check walk with error
+	req.Error(value.Walk(func(*HolidayCalendar) error { return fmt.Errorf("walk error") }))
+}
+
+func TestHolidayCalendarSetFilter(t *testing.T) {
+	var (
+		value = make(HolidayCalendarSet, 3)
+		req   = require.New(t)
+	)
+
+	// filter nothing
+	{
+		set, err := value.Filter(func(*HolidayCalendar) (bool, error) {
+			return true, nil
+		})
+		req.NoError(err)
+		req.Equal(len(set), len(value))
+	}
+
+	// filter one item
+	{
+		found := false
+		set, err := value.Filter(func(*HolidayCalendar) (bool, error) {
+			if !found {
+				found = true
+				return found, nil
+			}
+			return false, nil
+		})
+		req.NoError(err)
+		req.Len(set, 1)
+	}
+
+	// filter error
+	{
+		_, err := value.Filter(func(*HolidayCalendar) (bool, error) {
+			return false, fmt.Errorf("filter error")
+		})
+		req.Error(err)
+	}
+}
+
+func TestHolidayCalendarSetIDs(t *testing.T) {
+	var (
+		value = make(HolidayCalendarSet, 3)
+		req   = require.New(t)
+	)
+
+	// construct objects
+	value[0] = new(HolidayCalendar)
+	value[1] = new(HolidayCalendar)
+	value[2] = new(HolidayCalendar)
+	// set ids
+	value[0].ID = 1
+	value[1].ID = 2
+	value[2].ID = 3
+
+	// Find existing
+	{
+		val := value.FindByID(2)
+		req.Equal(uint64(2), val.ID)
+	}
+
+	// Find non-existing
+	{
+		val := value.FindByID(4)
+		req.Nil(val)
+	}
+
+	// List IDs from set
+	{
+		val := value.IDs()
+		req.Equal(len(val), len(value))
+	}
+}
+
 func TestQueueSetWalk(t *testing.T) {
 	var (
 		value = make(QueueSet, 3)
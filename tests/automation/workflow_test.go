@@ -218,6 +218,9 @@ func TestWorkflowCreateFull(t *testing.T) {
 	input.CreatedAt = output.CreatedAt
 	h.a.NoError(output.Scope.ResolveTypes(service.Registry().Type))
 
+	// initial definition is published
+	input.PublishedRevision = 1
+
 	h.a.Equal(input, output)
 
 	helpers.AllowMe(h, output.RbacResource(), "read")
@@ -236,6 +239,39 @@ func TestWorkflowCreateFull(t *testing.T) {
 	h.a.Equal(input, stored)
 }
 
+func TestWorkflowCreateWithIssuesUnpublished(t *testing.T) {
+	h := newHelper(t)
+
+	helpers.AllowMe(h, types.ComponentRbacResource(), "workflow.create")
+
+	h.clearWorkflows()
+	var (
+		output = &types.Workflow{}
+		input  = &types.Workflow{
+			Handle:  "wf_issues_test",
+			Enabled: true,
+			Steps:   types.WorkflowStepSet{{ID: 1, Kind: "unknown-kind"}},
+		}
+	)
+
+	h.apiInit().
+		Post("/workflows/").
+		Header("Accept", "application/json").
+		JSON(helpers.JSON(input)).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		End().
+		JSON(&struct{ Response *types.Workflow }{output})
+
+	h.a.NotEmpty(output.Issues)
+	h.a.Zero(output.PublishedRevision)
+
+	rr, _, err := store.SearchAutomationWorkflowRevisions(context.Background(), service.DefaultStore, types.WorkflowRevisionFilter{WorkflowID: output.ID})
+	h.noError(err)
+	h.a.Empty(rr)
+}
+
 func TestWorkflowUpdateForbidden(t *testing.T) {
 	h := newHelper(t)
 	u := h.repoMakeWorkflow()
@@ -361,6 +361,25 @@ func TestModuleCreateWithReservedFieldName(t *testing.T) {
 		End()
 }
 
+func TestModuleCreateWithEncryptedUniqueField(t *testing.T) {
+	h := newHelper(t)
+	h.clearModules()
+
+	helpers.AllowMe(h, types.NamespaceRbacResource(0), "read", "modules.search")
+	ns := h.makeNamespace("some-namespace")
+	helpers.AllowMe(h, types.ModuleRbacResource(0, 0), "update")
+
+	fjs := `{ "name": "foo", "handle": "foo", "fields": [{ "name": "nid", "kind": "String", "options": { "isEncrypted": true, "isUnique": true } }]}`
+	h.apiInit().
+		Post(fmt.Sprintf("/namespace/%d/module/", ns.ID)).
+		Header("Accept", "application/json").
+		JSON(fjs).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertError("module.errors.fieldEncryptedUnique")).
+		End()
+}
+
 func TestModuleFieldsUpdate_defaults(t *testing.T) {
 	h := newHelper(t)
 	h.clearModules()
@@ -0,0 +1,31 @@
+package reporter
+
+import (
+	"testing"
+)
+
+func Test_transform_basic(t *testing.T) {
+	var (
+		ctx, h, s = setup(t)
+		m, _, dd  = loadScenario(ctx, s, t, h)
+		ff        = loadNoErr(ctx, h, m, dd...)
+	)
+
+	h.a.Len(ff, 1)
+	f := ff[0]
+	h.a.Equal(6, f.Size())
+
+	h.a.Equal("first_name<String>, surname<String>, half<Number>", f.Columns.String())
+	checkRows(h, f,
+		"MARIA, Krüger, 49.5",
+		"ENGEL, Kiefer, 48.5",
+		"ULLI, Förstner, 43.5",
+		"SIGI, Goldschmidt, 33.5",
+		"MARIA, Königsmann, 30.5",
+		"MANU, Specht, 30.5")
+
+	dscr := describeNoErr(ctx, h, m, "transformed")
+	h.a.Len(dscr, 1)
+	h.a.Equal(-1, dscr[0].Columns.Find("email"))
+	h.a.Equal(-1, dscr[0].Columns.Find("last_name"))
+}
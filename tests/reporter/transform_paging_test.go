@@ -0,0 +1,68 @@
+package reporter
+
+import (
+	"testing"
+
+	"github.com/cortezaproject/corteza-server/pkg/report"
+)
+
+func Test_transform_paging(t *testing.T) {
+	var (
+		ctx, h, s = setup(t)
+		m, _, dd  = loadScenario(ctx, s, t, h)
+		ff        []*report.Frame
+		f         *report.Frame
+		def       = dd[0]
+	)
+
+	// ^ going up ^
+	ff = loadNoErr(ctx, h, m, def)
+	h.a.Len(ff, 1)
+	f = ff[0]
+	h.a.NotNil(f.Paging)
+	h.a.NotNil(f.Paging.NextPage)
+	h.a.Nil(f.Paging.PrevPage)
+	checkRows(h, f,
+		", Engel Kempf",
+		", Engel Kiefer",
+		", Engel Loritz",
+		", Manu Specht",
+		", Maria Krüger")
+
+	def.Paging.PageCursor = f.Paging.NextPage
+	ff = loadNoErr(ctx, h, m, def)
+	h.a.Len(ff, 1)
+	f = ff[0]
+	h.a.NotNil(f.Paging.NextPage)
+	h.a.NotNil(f.Paging.PrevPage)
+	checkRows(h, f,
+		", Maria Königsmann",
+		", Maria Spannagel",
+		", Sascha Jans",
+		", Sigi Goldschmidt",
+		", Ulli Böhler")
+
+	def.Paging.PageCursor = f.Paging.NextPage
+	ff = loadNoErr(ctx, h, m, def)
+	h.a.Len(ff, 1)
+	f = ff[0]
+	h.a.Nil(f.Paging.NextPage)
+	h.a.NotNil(f.Paging.PrevPage)
+	checkRows(h, f,
+		", Ulli Förstner",
+		", Ulli Haupt")
+
+	// v going down v
+	def.Paging.PageCursor = f.Paging.PrevPage
+	ff = loadNoErr(ctx, h, m, def)
+	h.a.Len(ff, 1)
+	f = ff[0]
+	h.a.NotNil(f.Paging.NextPage)
+	h.a.NotNil(f.Paging.PrevPage)
+	checkRows(h, f,
+		", Maria Königsmann",
+		", Maria Spannagel",
+		", Sascha Jans",
+		", Sigi Goldschmidt",
+		", Ulli Böhler")
+}
@@ -0,0 +1,14 @@
+package reporter
+
+import (
+	"testing"
+)
+
+func Test_transform_unknown_column(t *testing.T) {
+	var (
+		ctx, h, s = setup(t)
+		m, _, dd  = loadScenario(ctx, s, t, h)
+	)
+
+	loadErr(ctx, h, m, dd[0], "invalid transform step: unknown column renamed to second_name: middle_name")
+}
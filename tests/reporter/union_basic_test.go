@@ -0,0 +1,30 @@
+package reporter
+
+import (
+	"testing"
+)
+
+func Test_union_basic(t *testing.T) {
+	var (
+		ctx, h, s = setup(t)
+		m, _, dd  = loadScenario(ctx, s, t, h)
+		ff        = loadNoErr(ctx, h, m, dd...)
+	)
+
+	h.a.Len(ff, 1)
+	f := ff[0]
+	h.a.Equal(6, f.Size())
+
+	h.a.Equal("first_name<String>, last_name<String>, number_of_numbers<Number>, origin<String>", f.Columns.String())
+	checkRows(h, f,
+		"Maria, Krüger, 99, marias",
+		"Engel, Kiefer, 97, engels",
+		"Maria, Königsmann, 61, marias",
+		"Engel, Loritz, 46, engels",
+		"Engel, Kempf, 36, engels",
+		"Maria, Spannagel, 23, marias")
+
+	dscr := describeNoErr(ctx, h, m, "unioned")
+	h.a.Len(dscr, 1)
+	h.a.NotEqual(-1, dscr[0].Columns.Find("origin"))
+}
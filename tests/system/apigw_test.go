@@ -205,6 +205,25 @@ func TestApigwRouteCreate(t *testing.T) {
 		End()
 }
 
+func TestApigwRouteCreate_invalidSchema(t *testing.T) {
+	h := newHelper(t)
+	h.clearRoutes()
+
+	helpers.AllowMe(h, types.ComponentRbacResource(), "apigw-route.create")
+
+	h.apiInit().
+		Post(fmt.Sprintf("/apigw/route")).
+		Header("Accept", "application/json").
+		FormData("endpoint", "/test").
+		FormData("method", "GET").
+		FormData("enabled", "false").
+		FormData("meta", `{"schema":{"parameters":[{"name":"foo","in":"cookie"}]}}`).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertError("apigwRoute.errors.invalidSchema")).
+		End()
+}
+
 func TestApigwRouteCreate_forbiden(t *testing.T) {
 	h := newHelper(t)
 	h.clearRoutes()
@@ -63,6 +63,14 @@ func cleanup(t *testing.T) {
 	if err := defStore.TruncateAutomationWorkflows(ctx); err != nil {
 		t.Fatalf("failed to decode scenario data: %v", err)
 	}
+
+	if err := defStore.TruncateAutomationWorkflowRevisions(ctx); err != nil {
+		t.Fatalf("failed to truncate workflow revisions: %v", err)
+	}
+
+	if err := defStore.TruncateAutomationWorkflowTestCases(ctx); err != nil {
+		t.Fatalf("failed to truncate workflow test cases: %v", err)
+	}
 }
 
 func loadScenario(ctx context.Context, t *testing.T) {
@@ -0,0 +1,50 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parallel_iterator(t *testing.T) {
+	var (
+		ctx = bypassRBAC(context.Background())
+		req = require.New(t)
+
+		aux = struct {
+			Visited int64
+			Done    bool
+		}{}
+
+		iterations = func(trace types.Stacktrace) (n int) {
+			for _, f := range trace {
+				if f.StepID == 11 {
+					n++
+				}
+			}
+
+			return
+		}
+	)
+
+	loadNewScenario(ctx, t)
+
+	t.Run("all iterations", func(t *testing.T) {
+		vars, trace := mustExecWorkflow(ctx, t, "parallel_iterator", types.WorkflowExecParams{})
+		req.NoError(vars.Decode(&aux))
+		req.True(aux.Done)
+
+		// scopes of iterations are merged in the order of iterations
+		req.Equal(int64(5), aux.Visited)
+		req.Equal(6, iterations(trace))
+	})
+
+	t.Run("break", func(t *testing.T) {
+		vars, trace := mustExecWorkflow(ctx, t, "parallel_iterator_break", types.WorkflowExecParams{})
+		req.NoError(vars.Decode(&aux))
+		req.True(aux.Done)
+		req.Less(iterations(trace), 100)
+	})
+}
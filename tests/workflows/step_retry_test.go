@@ -0,0 +1,46 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_step_retry(t *testing.T) {
+	var (
+		ctx = bypassRBAC(context.Background())
+		req = require.New(t)
+
+		aux = struct {
+			Handled string
+		}{}
+
+		retries = func(trace types.Stacktrace) (n int) {
+			for _, f := range trace {
+				if f.StepID == 11 && f.Action == "retry scheduled" {
+					n++
+				}
+			}
+
+			return
+		}
+	)
+
+	loadNewScenario(ctx, t)
+
+	t.Run("retried", func(t *testing.T) {
+		vars, trace := mustExecWorkflow(ctx, t, "step_retry", types.WorkflowExecParams{})
+		req.NoError(vars.Decode(&aux))
+		req.Contains(aux.Handled, "gave up after 3 attempts")
+		req.Equal(2, retries(trace))
+	})
+
+	t.Run("not retryable", func(t *testing.T) {
+		vars, trace := mustExecWorkflow(ctx, t, "step_retry_not_retryable", types.WorkflowExecParams{})
+		req.NoError(vars.Decode(&aux))
+		req.NotContains(aux.Handled, "gave up")
+		req.Zero(retries(trace))
+	})
+}
@@ -0,0 +1,56 @@
+package workflows
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/cortezaproject/corteza-server/pkg/filter"
+	"github.com/cortezaproject/corteza-server/store"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_sub_workflow(t *testing.T) {
+	var (
+		ctx = bypassRBAC(context.Background())
+		req = require.New(t)
+	)
+
+	loadNewScenario(ctx, t)
+
+	t.Run("sync", func(t *testing.T) {
+		var (
+			aux = struct {
+				Out            int64
+				ChildSessionID uint64
+			}{}
+		)
+
+		vars, trace := mustExecWorkflow(ctx, t, "sub_workflow_sync", types.WorkflowExecParams{})
+		req.NoError(vars.Decode(&aux))
+		req.Equal(int64(42), aux.Out)
+		req.NotZero(aux.ChildSessionID)
+
+		// child session is linked with the caller
+		ss, _, err := store.SearchAutomationSessions(ctx, defStore, types.SessionFilter{
+			CallerSessionID: []uint64{trace[0].SessionID},
+			Completed:       filter.StateInclusive,
+		})
+		req.NoError(err)
+		req.Len(ss, 1)
+		req.Equal(aux.ChildSessionID, ss[0].ID)
+		req.Equal(uint64(10), ss[0].CallerStepID)
+	})
+
+	t.Run("error handled by caller", func(t *testing.T) {
+		var (
+			aux = struct {
+				Handled string
+			}{}
+		)
+
+		vars, _ := mustExecWorkflow(ctx, t, "sub_workflow_error", types.WorkflowExecParams{})
+		req.NoError(vars.Decode(&aux))
+		req.Contains(aux.Handled, "child failed")
+	})
+}
@@ -0,0 +1,109 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/cortezaproject/corteza-server/automation/service"
+	"github.com/cortezaproject/corteza-server/automation/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_workflow_test_case(t *testing.T) {
+	var (
+		ctx = bypassRBAC(context.Background())
+		req = require.New(t)
+
+		create = func(wfID uint64, def string) *types.WorkflowTestCase {
+			tc := &types.WorkflowTestCase{}
+			req.NoError(json.Unmarshal([]byte(def), tc))
+			tc.WorkflowID = wfID
+
+			tc, err := service.DefaultWorkflow.CreateTestCase(ctx, tc)
+			req.NoError(err)
+			return tc
+		}
+
+		run = func(tc *types.WorkflowTestCase) *types.WorkflowTestResult {
+			rr, err := service.DefaultWorkflow.RunTestCases(ctx, tc.WorkflowID, tc.ID)
+			req.NoError(err)
+			req.Len(rr, 1)
+			return rr[0]
+		}
+	)
+
+	loadNewScenario(ctx, t)
+
+	wf, err := defStore.LookupAutomationWorkflowByHandle(ctx, "workflow_test_case")
+	req.NoError(err)
+
+	t.Run("mocked function", func(t *testing.T) {
+		res := run(create(wf.ID, `{
+			"meta": { "name": "status ok" },
+			"mocks": [{ "ref": "httpRequestSend", "results": { "status": { "@type": "String", "@value": "200 OK" } } }],
+			"assertions": [{ "expr": "ok", "error": "status is not ok" }]
+		}`))
+
+		req.True(res.Passed, "failures: %v, error: %s", res.Failures, res.Error)
+		req.Equal("status ok", res.Name)
+		req.NotEmpty(res.Trace)
+	})
+
+	t.Run("failed assertion", func(t *testing.T) {
+		res := run(create(wf.ID, `{
+			"mocks": [{ "ref": "httpRequestSend", "results": { "status": { "@type": "String", "@value": "500 Internal Server Error" } } }],
+			"assertions": [{ "expr": "ok", "error": "status is not ok" }]
+		}`))
+
+		req.False(res.Passed)
+		req.Equal([]string{"status is not ok"}, res.Failures)
+	})
+
+	t.Run("not mocked function is intercepted", func(t *testing.T) {
+		res := run(create(wf.ID, `{}`))
+
+		req.False(res.Passed)
+		req.Contains(res.Error, "neither mocked nor passed through")
+	})
+
+	t.Run("expected error", func(t *testing.T) {
+		res := run(create(wf.ID, `{
+			"mocks": [{ "ref": "httpRequestSend", "error": "offline" }],
+			"expectError": "offline"
+		}`))
+
+		req.True(res.Passed, "failures: %v, error: %s", res.Failures, res.Error)
+	})
+
+	t.Run("all test cases", func(t *testing.T) {
+		rr, err := service.DefaultWorkflow.RunTestCases(ctx, wf.ID)
+		req.NoError(err)
+		req.Len(rr, 4)
+	})
+
+	t.Run("delays are skipped", func(t *testing.T) {
+		wf, err := defStore.LookupAutomationWorkflowByHandle(ctx, "workflow_test_case_delay")
+		req.NoError(err)
+
+		res := run(create(wf.ID, `{ "assertions": [{ "expr": "done" }] }`))
+		req.True(res.Passed, "failures: %v, error: %s", res.Failures, res.Error)
+	})
+
+	t.Run("prompts fail", func(t *testing.T) {
+		wf, err := defStore.LookupAutomationWorkflowByHandle(ctx, "workflow_test_case_prompt")
+		req.NoError(err)
+
+		res := run(create(wf.ID, `{}`))
+		req.False(res.Passed)
+		req.Contains(res.Error, "prompts are not supported in test cases")
+	})
+
+	t.Run("invalid test case", func(t *testing.T) {
+		_, err := service.DefaultWorkflow.CreateTestCase(ctx, &types.WorkflowTestCase{
+			WorkflowID: wf.ID,
+			Mocks:      types.WorkflowTestMockSet{{Ref: "nonExistingFunction"}},
+		})
+		req.Error(err)
+	})
+}